@@ -19,12 +19,18 @@ require (
 require (
 	connectrpc.com/connect v1.20.0
 	connectrpc.com/cors v0.1.0
+	github.com/bufbuild/protocompile v0.14.1
+	github.com/dlclark/regexp2 v1.10.0
+	github.com/itchyny/gojq v0.12.19
 	github.com/pkoukk/tiktoken-go v0.1.8
 	github.com/rwcarlsen/goexif v0.0.0-20190401172101-9e8deecbddbd
 	go.abhg.dev/goldmark/mermaid v0.6.0
 )
 
-require github.com/dlclark/regexp2 v1.10.0 // indirect
+require (
+	github.com/itchyny/timefmt-go v0.1.8 // indirect
+	golang.org/x/sync v0.21.0 // indirect
+)
 
 require (
 	github.com/JohannesKaufmann/dom v0.2.0 // indirect
@@ -0,0 +1,1563 @@
+package api
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+// MessagePack, CBOR and BSON travel through Convert as base64 or hex text.
+//
+// Values JSON cannot express are decoded into MongoDB Extended JSON (relaxed)
+// wrappers, which the encoders recognise again, so a binary → JSON → binary
+// round trip keeps its types:
+//
+//	{"$binary": {"base64": "...", "subType": "00"}}  byte strings
+//	{"$date": "2024-01-02T03:04:05Z"}                  timestamps
+//	{"$numberLong": "9007199254740993"}                integers beyond ±2^53
+//	{"$oid": "..."}, {"$numberDecimal": "..."}, …      BSON-specific types
+//	{"$tag": 32, "$value": "https://…"}                CBOR tags
+//	{"$ext": {"type": 5, "base64": "..."}}             MessagePack extensions
+//
+// The protobuf style instead flattens them the way the proto3 JSON mapping
+// does: bytes as base64 strings, timestamps as RFC 3339 strings and 64-bit
+// integers as decimal strings.
+
+// maxBinaryDepth bounds container nesting in untrusted binary input.
+const maxBinaryDepth = 512
+
+// maxSafeJSONInt is the largest integer every JSON consumer reads exactly.
+const maxSafeJSONInt = 1<<53 - 1
+
+var binarySpaceRe = regexp.MustCompile(`[\s:]+`)
+
+// decodeBinaryText turns base64 or hex input into raw bytes.
+func decodeBinaryText(data string, enc pb.BinaryEncoding) ([]byte, error) {
+	s := binarySpaceRe.ReplaceAllString(data, "")
+	if enc == pb.BinaryEncoding_BINARY_HEX {
+		s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex input: %v", err)
+		}
+		return b, nil
+	}
+	s = strings.TrimRight(s, "=")
+	if strings.ContainsAny(s, "-_") {
+		s = strings.NewReplacer("-", "+", "_", "/").Replace(s)
+	}
+	b, err := base64.RawStdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64 input: %v", err)
+	}
+	return b, nil
+}
+
+// encodeBinaryText renders raw bytes as base64 or lower-case hex.
+func encodeBinaryText(b []byte, enc pb.BinaryEncoding) []byte {
+	if enc == pb.BinaryEncoding_BINARY_HEX {
+		return []byte(hex.EncodeToString(b))
+	}
+	return []byte(base64.StdEncoding.EncodeToString(b))
+}
+
+// parseBinarySource decodes a MessagePack, CBOR or BSON payload.
+func parseBinarySource(req *pb.ConvertRequest) (any, error) {
+	raw, err := decodeBinaryText(req.Data, req.BinaryEncoding)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("input is empty")
+	}
+	var v any
+	switch req.SourceFormat {
+	case pb.DataFormat_MSGPACK:
+		d := &msgpackDecoder{buf: raw}
+		if v, err = d.value(0); err == nil && d.pos != len(raw) {
+			err = fmt.Errorf("%d trailing bytes after MessagePack value", len(raw)-d.pos)
+		}
+	case pb.DataFormat_CBOR:
+		d := &cborDecoder{buf: raw}
+		if v, err = d.value(0); err == nil && d.pos != len(raw) {
+			err = fmt.Errorf("%d trailing bytes after CBOR value", len(raw)-d.pos)
+		}
+	case pb.DataFormat_BSON:
+		d := &bsonDecoder{buf: raw}
+		if v, err = d.document(0, false); err == nil && d.pos != len(raw) {
+			err = fmt.Errorf("%d trailing bytes after BSON document", len(raw)-d.pos)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if req.BinaryJsonStyle == pb.BinaryJsonStyle_BINARY_JSON_PROTOBUF {
+		v = protobufJSONStyle(v)
+	}
+	return v, nil
+}
+
+// marshalBinaryTarget encodes data as MessagePack, CBOR or BSON text.
+func marshalBinaryTarget(data any, req *pb.ConvertRequest) ([]byte, error) {
+	var (
+		out []byte
+		err error
+	)
+	switch req.TargetFormat {
+	case pb.DataFormat_MSGPACK:
+		e := &msgpackEncoder{}
+		err = e.value(data)
+		out = e.buf.Bytes()
+	case pb.DataFormat_CBOR:
+		e := &cborEncoder{}
+		err = e.value(data)
+		out = e.buf.Bytes()
+	case pb.DataFormat_BSON:
+		m, ok := data.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("BSON output requires an object at the root level")
+		}
+		out, err = bsonDocument(m)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return encodeBinaryText(out, req.BinaryEncoding), nil
+}
+
+// ── Extended JSON wrappers ────────────────────────────────────────────────────
+
+func binaryWrapper(b []byte, subtype byte) map[string]any {
+	return map[string]any{"$binary": map[string]any{
+		"base64":  base64.StdEncoding.EncodeToString(b),
+		"subType": fmt.Sprintf("%02x", subtype),
+	}}
+}
+
+func dateWrapper(t time.Time) map[string]any {
+	return map[string]any{"$date": t.UTC().Format(time.RFC3339Nano)}
+}
+
+// intValue returns n as a plain number when JSON can carry it exactly.
+func intValue(n int64) any {
+	if n > maxSafeJSONInt || n < -maxSafeJSONInt {
+		return map[string]any{"$numberLong": strconv.FormatInt(n, 10)}
+	}
+	return n
+}
+
+func uintValue(n uint64) any {
+	if n > maxSafeJSONInt {
+		return map[string]any{"$numberLong": strconv.FormatUint(n, 10)}
+	}
+	return int64(n)
+}
+
+// wrapperKind reports which extended JSON wrapper m is, if any.
+func wrapperKind(m map[string]any) string {
+	switch len(m) {
+	case 1:
+		for k := range m {
+			switch k {
+			case "$binary", "$date", "$numberLong", "$numberDecimal", "$oid", "$ext",
+				"$regularExpression", "$timestamp", "$minKey", "$maxKey", "$undefined", "$code":
+				return k
+			}
+		}
+	case 2:
+		if _, ok := m["$tag"]; ok {
+			if _, ok := m["$value"]; ok {
+				return "$tag"
+			}
+		}
+	}
+	return ""
+}
+
+// unwrapBinary decodes a $binary wrapper; the short form {"$binary": "b64"}
+// is accepted as well.
+func unwrapBinary(v any) ([]byte, byte, error) {
+	var (
+		b64     string
+		subtype byte
+	)
+	switch w := v.(type) {
+	case string:
+		b64 = w
+	case map[string]any:
+		b64, _ = w["base64"].(string)
+		if st, ok := w["subType"].(string); ok {
+			n, err := strconv.ParseUint(st, 16, 8)
+			if err != nil {
+				return nil, 0, fmt.Errorf("invalid $binary subType %q", st)
+			}
+			subtype = byte(n)
+		}
+	default:
+		return nil, 0, fmt.Errorf("invalid $binary value")
+	}
+	b, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid $binary base64: %v", err)
+	}
+	return b, subtype, nil
+}
+
+func unwrapDate(v any) (time.Time, error) {
+	switch d := v.(type) {
+	case string:
+		return time.Parse(time.RFC3339Nano, d)
+	case map[string]any:
+		if s, ok := d["$numberLong"].(string); ok {
+			ms, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return time.Time{}, err
+			}
+			return time.UnixMilli(ms).UTC(), nil
+		}
+	case float64:
+		return time.UnixMilli(int64(d)).UTC(), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid $date value")
+}
+
+// wideInt is an integer parsed from a $numberLong wrapper or a number.
+type wideInt struct {
+	neg bool
+	abs uint64
+}
+
+func parseWideInt(s string) (wideInt, error) {
+	neg := strings.HasPrefix(s, "-")
+	abs, err := strconv.ParseUint(strings.TrimPrefix(s, "-"), 10, 64)
+	if err != nil || (neg && abs > 1<<63) {
+		return wideInt{}, fmt.Errorf("invalid $numberLong %q", s)
+	}
+	return wideInt{neg: neg, abs: abs}, nil
+}
+
+// integral reports whether v is an integer-valued number and returns it.
+func integral(v any) (wideInt, bool) {
+	switch n := v.(type) {
+	case int:
+		return integral(int64(n))
+	case int8:
+		return integral(int64(n))
+	case int16:
+		return integral(int64(n))
+	case int32:
+		return integral(int64(n))
+	case int64:
+		if n < 0 {
+			return wideInt{neg: true, abs: uint64(-(n + 1)) + 1}, true
+		}
+		return wideInt{abs: uint64(n)}, true
+	case uint:
+		return wideInt{abs: uint64(n)}, true
+	case uint8:
+		return wideInt{abs: uint64(n)}, true
+	case uint16:
+		return wideInt{abs: uint64(n)}, true
+	case uint32:
+		return wideInt{abs: uint64(n)}, true
+	case uint64:
+		return wideInt{abs: n}, true
+	case float64:
+		if n == math.Trunc(n) && math.Abs(n) < 1<<63 {
+			return integral(int64(n))
+		}
+	}
+	return wideInt{}, false
+}
+
+// protobufJSONStyle replaces extended JSON wrappers with the plain values
+// the proto3 JSON mapping uses.
+func protobufJSONStyle(v any) any {
+	switch val := v.(type) {
+	case []any:
+		out := make([]any, len(val))
+		for i, item := range val {
+			out[i] = protobufJSONStyle(item)
+		}
+		return out
+	case map[string]any:
+		switch wrapperKind(val) {
+		case "$binary":
+			b, _, _ := unwrapBinary(val["$binary"])
+			return base64.StdEncoding.EncodeToString(b)
+		case "$date", "$numberLong", "$numberDecimal", "$oid", "$code":
+			for _, inner := range val {
+				return inner
+			}
+		case "$ext":
+			ext, _ := val["$ext"].(map[string]any)
+			return ext["base64"]
+		case "$tag":
+			return protobufJSONStyle(val["$value"])
+		case "$undefined", "$minKey", "$maxKey":
+			return nil
+		case "$regularExpression":
+			re, _ := val["$regularExpression"].(map[string]any)
+			return fmt.Sprintf("/%v/%v", re["pattern"], re["options"])
+		case "$timestamp":
+			ts, _ := val["$timestamp"].(map[string]any)
+			if t, ok := ts["t"].(int64); ok {
+				return time.Unix(t, 0).UTC().Format(time.RFC3339)
+			}
+		}
+		out := make(map[string]any, len(val))
+		for k, item := range val {
+			out[k] = protobufJSONStyle(item)
+		}
+		return out
+	case int64:
+		if val > maxSafeJSONInt || val < -maxSafeJSONInt {
+			return strconv.FormatInt(val, 10)
+		}
+	}
+	return v
+}
+
+// mapKeyString renders a non-string map key from MessagePack or CBOR.
+func mapKeyString(k any) string {
+	switch key := k.(type) {
+	case string:
+		return key
+	case map[string]any, []any:
+		return compactJSON(k)
+	}
+	s, _ := scalarText(k)
+	return s
+}
+
+// ── MessagePack ───────────────────────────────────────────────────────────────
+
+type msgpackDecoder struct {
+	buf []byte
+	pos int
+}
+
+func (d *msgpackDecoder) take(n int) ([]byte, error) {
+	if n < 0 || d.pos+n > len(d.buf) {
+		return nil, fmt.Errorf("unexpected end of MessagePack data at offset %d", d.pos)
+	}
+	b := d.buf[d.pos : d.pos+n]
+	d.pos += n
+	return b, nil
+}
+
+func (d *msgpackDecoder) uint(n int) (uint64, error) {
+	b, err := d.take(n)
+	if err != nil {
+		return 0, err
+	}
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v, nil
+}
+
+func (d *msgpackDecoder) value(depth int) (any, error) {
+	if depth > maxBinaryDepth {
+		return nil, fmt.Errorf("MessagePack nesting too deep")
+	}
+	start := d.pos
+	tb, err := d.take(1)
+	if err != nil {
+		return nil, err
+	}
+	t := tb[0]
+	switch {
+	case t <= 0x7f:
+		return int64(t), nil
+	case t >= 0xe0:
+		return int64(int8(t)), nil
+	case t&0xf0 == 0x80:
+		return d.mapN(int(t&0x0f), depth)
+	case t&0xf0 == 0x90:
+		return d.arrayN(int(t&0x0f), depth)
+	case t&0xe0 == 0xa0:
+		return d.str(int(t & 0x1f))
+	}
+	switch t {
+	case 0xc0:
+		return nil, nil
+	case 0xc2:
+		return false, nil
+	case 0xc3:
+		return true, nil
+	case 0xc4, 0xc5, 0xc6:
+		n, err := d.uint(1 << (t - 0xc4))
+		if err != nil {
+			return nil, err
+		}
+		b, err := d.take(int(n))
+		if err != nil {
+			return nil, err
+		}
+		return binaryWrapper(b, 0), nil
+	case 0xc7, 0xc8, 0xc9:
+		n, err := d.uint(1 << (t - 0xc7))
+		if err != nil {
+			return nil, err
+		}
+		return d.ext(int(n))
+	case 0xca:
+		n, err := d.uint(4)
+		if err != nil {
+			return nil, err
+		}
+		return float64(math.Float32frombits(uint32(n))), nil
+	case 0xcb:
+		n, err := d.uint(8)
+		if err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(n), nil
+	case 0xcc, 0xcd, 0xce, 0xcf:
+		n, err := d.uint(1 << (t - 0xcc))
+		if err != nil {
+			return nil, err
+		}
+		return uintValue(n), nil
+	case 0xd0, 0xd1, 0xd2, 0xd3:
+		size := 1 << (t - 0xd0)
+		n, err := d.uint(size)
+		if err != nil {
+			return nil, err
+		}
+		shift := 64 - 8*size
+		return intValue(int64(n<<shift) >> shift), nil
+	case 0xd4, 0xd5, 0xd6, 0xd7, 0xd8:
+		return d.ext(1 << (t - 0xd4))
+	case 0xd9, 0xda, 0xdb:
+		n, err := d.uint(1 << (t - 0xd9))
+		if err != nil {
+			return nil, err
+		}
+		return d.str(int(n))
+	case 0xdc, 0xdd:
+		n, err := d.uint(2 << (t - 0xdc))
+		if err != nil {
+			return nil, err
+		}
+		return d.arrayN(int(n), depth)
+	case 0xde, 0xdf:
+		n, err := d.uint(2 << (t - 0xde))
+		if err != nil {
+			return nil, err
+		}
+		return d.mapN(int(n), depth)
+	}
+	return nil, fmt.Errorf("invalid MessagePack type byte 0x%02x at offset %d", t, start)
+}
+
+func (d *msgpackDecoder) str(n int) (any, error) {
+	b, err := d.take(n)
+	if err != nil {
+		return nil, err
+	}
+	if !utf8.Valid(b) {
+		return binaryWrapper(b, 0), nil
+	}
+	return string(b), nil
+}
+
+func (d *msgpackDecoder) arrayN(n, depth int) (any, error) {
+	if n > len(d.buf)-d.pos {
+		return nil, fmt.Errorf("MessagePack array length %d exceeds input", n)
+	}
+	arr := make([]any, n)
+	for i := range arr {
+		v, err := d.value(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		arr[i] = v
+	}
+	return arr, nil
+}
+
+func (d *msgpackDecoder) mapN(n, depth int) (any, error) {
+	if n > len(d.buf)-d.pos {
+		return nil, fmt.Errorf("MessagePack map length %d exceeds input", n)
+	}
+	m := make(map[string]any, n)
+	for range n {
+		k, err := d.value(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		v, err := d.value(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		m[mapKeyString(k)] = v
+	}
+	return m, nil
+}
+
+// ext decodes an extension; type -1 is the standard timestamp.
+func (d *msgpackDecoder) ext(n int) (any, error) {
+	tb, err := d.take(1)
+	if err != nil {
+		return nil, err
+	}
+	typ := int8(tb[0])
+	b, err := d.take(n)
+	if err != nil {
+		return nil, err
+	}
+	if typ == -1 {
+		switch n {
+		case 4:
+			return dateWrapper(time.Unix(int64(binary.BigEndian.Uint32(b)), 0)), nil
+		case 8:
+			v := binary.BigEndian.Uint64(b)
+			return dateWrapper(time.Unix(int64(v&0x3ffffffff), int64(v>>34))), nil
+		case 12:
+			ns := binary.BigEndian.Uint32(b[:4])
+			sec := int64(binary.BigEndian.Uint64(b[4:]))
+			return dateWrapper(time.Unix(sec, int64(ns))), nil
+		}
+	}
+	return map[string]any{"$ext": map[string]any{
+		"type":   int64(typ),
+		"base64": base64.StdEncoding.EncodeToString(b),
+	}}, nil
+}
+
+type msgpackEncoder struct {
+	buf bytes.Buffer
+}
+
+// head writes a length header; sizes holds the type bytes for 8-, 16- and
+// 32-bit lengths, with a zero entry when a width does not exist.
+func (e *msgpackEncoder) head(n uint64, sizes ...byte) {
+	switch {
+	case n <= math.MaxUint8 && sizes[0] != 0:
+		e.buf.WriteByte(sizes[0])
+		e.buf.WriteByte(byte(n))
+	case n <= math.MaxUint16:
+		e.buf.WriteByte(sizes[1])
+		_ = binary.Write(&e.buf, binary.BigEndian, uint16(n))
+	default:
+		e.buf.WriteByte(sizes[2])
+		_ = binary.Write(&e.buf, binary.BigEndian, uint32(n))
+	}
+}
+
+func (e *msgpackEncoder) int(w wideInt) {
+	switch {
+	case !w.neg && w.abs <= 0x7f:
+		e.buf.WriteByte(byte(w.abs))
+	case !w.neg && w.abs <= math.MaxUint8:
+		e.buf.Write([]byte{0xcc, byte(w.abs)})
+	case !w.neg && w.abs <= math.MaxUint16:
+		e.buf.WriteByte(0xcd)
+		_ = binary.Write(&e.buf, binary.BigEndian, uint16(w.abs))
+	case !w.neg && w.abs <= math.MaxUint32:
+		e.buf.WriteByte(0xce)
+		_ = binary.Write(&e.buf, binary.BigEndian, uint32(w.abs))
+	case !w.neg:
+		e.buf.WriteByte(0xcf)
+		_ = binary.Write(&e.buf, binary.BigEndian, w.abs)
+	default:
+		n := -int64(w.abs-1) - 1
+		switch {
+		case n >= -32:
+			e.buf.WriteByte(byte(int8(n)))
+		case n >= math.MinInt8:
+			e.buf.Write([]byte{0xd0, byte(int8(n))})
+		case n >= math.MinInt16:
+			e.buf.WriteByte(0xd1)
+			_ = binary.Write(&e.buf, binary.BigEndian, int16(n))
+		case n >= math.MinInt32:
+			e.buf.WriteByte(0xd2)
+			_ = binary.Write(&e.buf, binary.BigEndian, int32(n))
+		default:
+			e.buf.WriteByte(0xd3)
+			_ = binary.Write(&e.buf, binary.BigEndian, n)
+		}
+	}
+}
+
+func (e *msgpackEncoder) str(s string) {
+	if len(s) <= 31 {
+		e.buf.WriteByte(0xa0 | byte(len(s)))
+	} else {
+		e.head(uint64(len(s)), 0xd9, 0xda, 0xdb)
+	}
+	e.buf.WriteString(s)
+}
+
+func (e *msgpackEncoder) time(t time.Time) {
+	sec, ns := t.Unix(), uint64(t.Nanosecond())
+	switch {
+	case sec >= 0 && sec <= math.MaxUint32 && ns == 0:
+		e.buf.Write([]byte{0xd6, 0xff})
+		_ = binary.Write(&e.buf, binary.BigEndian, uint32(sec))
+	case sec >= 0 && sec < 1<<34:
+		e.buf.Write([]byte{0xd7, 0xff})
+		_ = binary.Write(&e.buf, binary.BigEndian, ns<<34|uint64(sec))
+	default:
+		e.buf.Write([]byte{0xc7, 12, 0xff})
+		_ = binary.Write(&e.buf, binary.BigEndian, uint32(ns))
+		_ = binary.Write(&e.buf, binary.BigEndian, sec)
+	}
+}
+
+func (e *msgpackEncoder) value(v any) error {
+	if w, ok := integral(v); ok {
+		e.int(w)
+		return nil
+	}
+	switch val := v.(type) {
+	case nil:
+		e.buf.WriteByte(0xc0)
+	case bool:
+		if val {
+			e.buf.WriteByte(0xc3)
+		} else {
+			e.buf.WriteByte(0xc2)
+		}
+	case float64:
+		e.buf.WriteByte(0xcb)
+		_ = binary.Write(&e.buf, binary.BigEndian, val)
+	case float32:
+		e.buf.WriteByte(0xca)
+		_ = binary.Write(&e.buf, binary.BigEndian, val)
+	case string:
+		e.str(val)
+	case []byte:
+		e.head(uint64(len(val)), 0xc4, 0xc5, 0xc6)
+		e.buf.Write(val)
+	case time.Time:
+		e.time(val)
+	case []any:
+		if len(val) <= 15 {
+			e.buf.WriteByte(0x90 | byte(len(val)))
+		} else {
+			e.head(uint64(len(val)), 0, 0xdc, 0xdd)
+		}
+		for _, item := range val {
+			if err := e.value(item); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		return e.object(val)
+	default:
+		s, _ := scalarText(v)
+		e.str(s)
+	}
+	return nil
+}
+
+func (e *msgpackEncoder) object(m map[string]any) error {
+	switch wrapperKind(m) {
+	case "$binary":
+		b, _, err := unwrapBinary(m["$binary"])
+		if err != nil {
+			return err
+		}
+		return e.value(b)
+	case "$date":
+		t, err := unwrapDate(m["$date"])
+		if err != nil {
+			return err
+		}
+		e.time(t)
+		return nil
+	case "$numberLong":
+		s, _ := m["$numberLong"].(string)
+		w, err := parseWideInt(s)
+		if err != nil {
+			return err
+		}
+		e.int(w)
+		return nil
+	case "$ext":
+		ext, _ := m["$ext"].(map[string]any)
+		typ, ok := integral(ext["type"])
+		b64, _ := ext["base64"].(string)
+		data, err := base64.StdEncoding.DecodeString(b64)
+		if !ok || err != nil {
+			return fmt.Errorf("invalid $ext wrapper")
+		}
+		t := byte(int8(typ.abs))
+		if typ.neg {
+			t = byte(-int8(typ.abs))
+		}
+		switch len(data) {
+		case 1, 2, 4, 8, 16:
+			e.buf.WriteByte(0xd4 + byte(bitsLen(len(data))))
+		default:
+			e.head(uint64(len(data)), 0xc7, 0xc8, 0xc9)
+		}
+		e.buf.WriteByte(t)
+		e.buf.Write(data)
+		return nil
+	}
+	if len(m) <= 15 {
+		e.buf.WriteByte(0x80 | byte(len(m)))
+	} else {
+		e.head(uint64(len(m)), 0, 0xde, 0xdf)
+	}
+	for _, k := range sortedMapKeys(m) {
+		e.str(k)
+		if err := e.value(m[k]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bitsLen returns log2 of a power-of-two length.
+func bitsLen(n int) int {
+	i := 0
+	for n > 1 {
+		n >>= 1
+		i++
+	}
+	return i
+}
+
+// ── CBOR ──────────────────────────────────────────────────────────────────────
+
+type cborDecoder struct {
+	buf []byte
+	pos int
+}
+
+// cborBreak marks the end of an indefinite-length item.
+type cborBreak struct{}
+
+func (d *cborDecoder) take(n uint64) ([]byte, error) {
+	if n > uint64(len(d.buf)-d.pos) {
+		return nil, fmt.Errorf("unexpected end of CBOR data at offset %d", d.pos)
+	}
+	b := d.buf[d.pos : d.pos+int(n)]
+	d.pos += int(n)
+	return b, nil
+}
+
+// head reads an initial byte and its argument. indefinite is set for
+// additional information 31.
+func (d *cborDecoder) head() (major byte, info byte, arg uint64, err error) {
+	b, err := d.take(1)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	major, info = b[0]>>5, b[0]&0x1f
+	switch {
+	case info < 24:
+		arg = uint64(info)
+	case info <= 27:
+		ab, err := d.take(1 << (info - 24))
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		for _, c := range ab {
+			arg = arg<<8 | uint64(c)
+		}
+	case info == 31:
+	default:
+		return 0, 0, 0, fmt.Errorf("invalid CBOR additional information %d at offset %d", info, d.pos-1)
+	}
+	return major, info, arg, nil
+}
+
+func (d *cborDecoder) value(depth int) (any, error) {
+	if depth > maxBinaryDepth {
+		return nil, fmt.Errorf("CBOR nesting too deep")
+	}
+	major, info, arg, err := d.head()
+	if err != nil {
+		return nil, err
+	}
+	indefinite := info == 31
+	switch major {
+	case 0:
+		return uintValue(arg), nil
+	case 1:
+		if arg >= 1<<63 {
+			return map[string]any{"$numberLong": "-" + new(big.Int).Add(new(big.Int).SetUint64(arg), big.NewInt(1)).String()}, nil
+		}
+		return intValue(-1 - int64(arg)), nil
+	case 2, 3:
+		var b []byte
+		if indefinite {
+			for {
+				chunk, err := d.value(depth + 1)
+				if err != nil {
+					return nil, err
+				}
+				if _, done := chunk.(cborBreak); done {
+					break
+				}
+				switch c := chunk.(type) {
+				case string:
+					b = append(b, c...)
+				case map[string]any:
+					cb, _, err := unwrapBinary(c["$binary"])
+					if err != nil {
+						return nil, err
+					}
+					b = append(b, cb...)
+				}
+			}
+		} else if b, err = d.take(arg); err != nil {
+			return nil, err
+		}
+		if major == 3 && utf8.Valid(b) {
+			return string(b), nil
+		}
+		return binaryWrapper(b, 0), nil
+	case 4:
+		if !indefinite && arg > uint64(len(d.buf)-d.pos) {
+			return nil, fmt.Errorf("CBOR array length %d exceeds input", arg)
+		}
+		arr := []any{}
+		for i := uint64(0); indefinite || i < arg; i++ {
+			v, err := d.value(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			if _, done := v.(cborBreak); done {
+				break
+			}
+			arr = append(arr, v)
+		}
+		return arr, nil
+	case 5:
+		// Every entry takes at least a byte for its key and one for its value.
+		if !indefinite && arg > uint64(len(d.buf)-d.pos)/2 {
+			return nil, fmt.Errorf("CBOR map length %d exceeds input", arg)
+		}
+		m := map[string]any{}
+		for i := uint64(0); indefinite || i < arg; i++ {
+			k, err := d.value(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			if _, done := k.(cborBreak); done {
+				break
+			}
+			v, err := d.value(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			m[mapKeyString(k)] = v
+		}
+		return m, nil
+	case 6:
+		content, err := d.value(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		return cborTagged(arg, content), nil
+	case 7:
+		switch info {
+		case 20:
+			return false, nil
+		case 21:
+			return true, nil
+		case 22:
+			return nil, nil
+		case 23:
+			return map[string]any{"$undefined": true}, nil
+		case 25:
+			return float16to64(uint16(arg)), nil
+		case 26:
+			return float64(math.Float32frombits(uint32(arg))), nil
+		case 27:
+			return math.Float64frombits(arg), nil
+		case 31:
+			return cborBreak{}, nil
+		}
+		return map[string]any{"$tag": "simple", "$value": int64(arg)}, nil
+	}
+	return nil, fmt.Errorf("invalid CBOR major type %d", major)
+}
+
+// cborTagged interprets the well-known date and bignum tags and shows any
+// other tag as {"$tag": n, "$value": content}.
+func cborTagged(tag uint64, content any) any {
+	switch tag {
+	case 0:
+		if s, ok := content.(string); ok {
+			if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+				return dateWrapper(t)
+			}
+		}
+	case 1:
+		switch n := content.(type) {
+		case int64:
+			return dateWrapper(time.Unix(n, 0))
+		case float64:
+			sec, frac := math.Modf(n)
+			return dateWrapper(time.Unix(int64(sec), int64(frac*1e9)))
+		}
+	case 2, 3:
+		if m, ok := content.(map[string]any); ok && wrapperKind(m) == "$binary" {
+			b, _, _ := unwrapBinary(m["$binary"])
+			n := new(big.Int).SetBytes(b)
+			if tag == 3 {
+				n.Neg(n).Sub(n, big.NewInt(1))
+			}
+			return map[string]any{"$tag": int64(tag), "$value": n.String()}
+		}
+	}
+	return map[string]any{"$tag": uintValue(tag), "$value": content}
+}
+
+func float16to64(h uint16) float64 {
+	sign := 1.0
+	if h&0x8000 != 0 {
+		sign = -1
+	}
+	exp := int(h>>10) & 0x1f
+	frac := float64(h & 0x3ff)
+	switch exp {
+	case 0:
+		return sign * math.Ldexp(frac, -24)
+	case 31:
+		if frac == 0 {
+			return sign * math.Inf(1)
+		}
+		return math.NaN()
+	}
+	return sign * math.Ldexp(frac+1024, exp-25)
+}
+
+type cborEncoder struct {
+	buf bytes.Buffer
+}
+
+func (e *cborEncoder) head(major byte, n uint64) {
+	switch {
+	case n < 24:
+		e.buf.WriteByte(major<<5 | byte(n))
+	case n <= math.MaxUint8:
+		e.buf.Write([]byte{major<<5 | 24, byte(n)})
+	case n <= math.MaxUint16:
+		e.buf.WriteByte(major<<5 | 25)
+		_ = binary.Write(&e.buf, binary.BigEndian, uint16(n))
+	case n <= math.MaxUint32:
+		e.buf.WriteByte(major<<5 | 26)
+		_ = binary.Write(&e.buf, binary.BigEndian, uint32(n))
+	default:
+		e.buf.WriteByte(major<<5 | 27)
+		_ = binary.Write(&e.buf, binary.BigEndian, n)
+	}
+}
+
+func (e *cborEncoder) int(w wideInt) {
+	if w.neg {
+		e.head(1, w.abs-1)
+	} else {
+		e.head(0, w.abs)
+	}
+}
+
+func (e *cborEncoder) float(f float64) {
+	if f32 := float32(f); float64(f32) == f || math.IsNaN(f) {
+		e.buf.WriteByte(0xfa)
+		_ = binary.Write(&e.buf, binary.BigEndian, f32)
+		return
+	}
+	e.buf.WriteByte(0xfb)
+	_ = binary.Write(&e.buf, binary.BigEndian, f)
+}
+
+// time writes tag 1 with integer or fractional epoch seconds.
+func (e *cborEncoder) time(t time.Time) {
+	e.head(6, 1)
+	if t.Nanosecond() == 0 {
+		e.int(wideIntOf(t.Unix()))
+	} else {
+		e.float(float64(t.UnixNano()) / 1e9)
+	}
+}
+
+func wideIntOf(n int64) wideInt {
+	w, _ := integral(n)
+	return w
+}
+
+func (e *cborEncoder) value(v any) error {
+	if w, ok := integral(v); ok {
+		e.int(w)
+		return nil
+	}
+	switch val := v.(type) {
+	case nil:
+		e.buf.WriteByte(0xf6)
+	case bool:
+		if val {
+			e.buf.WriteByte(0xf5)
+		} else {
+			e.buf.WriteByte(0xf4)
+		}
+	case float64:
+		e.float(val)
+	case float32:
+		e.float(float64(val))
+	case string:
+		e.head(3, uint64(len(val)))
+		e.buf.WriteString(val)
+	case []byte:
+		e.head(2, uint64(len(val)))
+		e.buf.Write(val)
+	case time.Time:
+		e.time(val)
+	case []any:
+		e.head(4, uint64(len(val)))
+		for _, item := range val {
+			if err := e.value(item); err != nil {
+				return err
+			}
+		}
+	case map[string]any:
+		return e.object(val)
+	default:
+		s, _ := scalarText(v)
+		return e.value(s)
+	}
+	return nil
+}
+
+func (e *cborEncoder) object(m map[string]any) error {
+	switch wrapperKind(m) {
+	case "$binary":
+		b, _, err := unwrapBinary(m["$binary"])
+		if err != nil {
+			return err
+		}
+		return e.value(b)
+	case "$date":
+		t, err := unwrapDate(m["$date"])
+		if err != nil {
+			return err
+		}
+		e.time(t)
+		return nil
+	case "$numberLong":
+		s, _ := m["$numberLong"].(string)
+		n, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			return fmt.Errorf("invalid $numberLong %q", s)
+		}
+		return e.bigint(n)
+	case "$undefined":
+		e.buf.WriteByte(0xf7)
+		return nil
+	case "$tag":
+		tag, ok := integral(m["$tag"])
+		if !ok || tag.neg {
+			if m["$tag"] == "simple" {
+				if n, ok := integral(m["$value"]); ok && n.abs < 256 && !n.neg {
+					e.head(7, n.abs)
+					return nil
+				}
+			}
+			return fmt.Errorf("invalid $tag %v", m["$tag"])
+		}
+		if s, isStr := m["$value"].(string); isStr && (tag.abs == 2 || tag.abs == 3) {
+			if n, ok := new(big.Int).SetString(s, 10); ok {
+				return e.bigint(n)
+			}
+		}
+		e.head(6, tag.abs)
+		return e.value(m["$value"])
+	}
+	e.head(5, uint64(len(m)))
+	for _, k := range sortedMapKeys(m) {
+		e.head(3, uint64(len(k)))
+		e.buf.WriteString(k)
+		if err := e.value(m[k]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bigint writes n as a plain integer when it fits in 64 bits and as a
+// tag 2/3 bignum otherwise.
+func (e *cborEncoder) bigint(n *big.Int) error {
+	if n.Sign() >= 0 && n.IsUint64() {
+		e.head(0, n.Uint64())
+		return nil
+	}
+	if n.Sign() < 0 {
+		m := new(big.Int).Neg(n)
+		m.Sub(m, big.NewInt(1))
+		if m.IsUint64() {
+			e.head(1, m.Uint64())
+			return nil
+		}
+		e.head(6, 3)
+		b := m.Bytes()
+		e.head(2, uint64(len(b)))
+		e.buf.Write(b)
+		return nil
+	}
+	e.head(6, 2)
+	b := n.Bytes()
+	e.head(2, uint64(len(b)))
+	e.buf.Write(b)
+	return nil
+}
+
+// ── BSON ──────────────────────────────────────────────────────────────────────
+
+type bsonDecoder struct {
+	buf []byte
+	pos int
+}
+
+func (d *bsonDecoder) take(n int) ([]byte, error) {
+	if n < 0 || d.pos+n > len(d.buf) {
+		return nil, fmt.Errorf("unexpected end of BSON data at offset %d", d.pos)
+	}
+	b := d.buf[d.pos : d.pos+n]
+	d.pos += n
+	return b, nil
+}
+
+func (d *bsonDecoder) int32() (int32, error) {
+	b, err := d.take(4)
+	if err != nil {
+		return 0, err
+	}
+	return int32(binary.LittleEndian.Uint32(b)), nil
+}
+
+func (d *bsonDecoder) int64() (int64, error) {
+	b, err := d.take(8)
+	if err != nil {
+		return 0, err
+	}
+	return int64(binary.LittleEndian.Uint64(b)), nil
+}
+
+func (d *bsonDecoder) cstring() (string, error) {
+	end := bytes.IndexByte(d.buf[d.pos:], 0)
+	if end < 0 {
+		return "", fmt.Errorf("unterminated BSON cstring at offset %d", d.pos)
+	}
+	s := string(d.buf[d.pos : d.pos+end])
+	d.pos += end + 1
+	return s, nil
+}
+
+func (d *bsonDecoder) string() (string, error) {
+	n, err := d.int32()
+	if err != nil {
+		return "", err
+	}
+	if n < 1 {
+		return "", fmt.Errorf("invalid BSON string length %d", n)
+	}
+	b, err := d.take(int(n))
+	if err != nil {
+		return "", err
+	}
+	if b[len(b)-1] != 0 {
+		return "", fmt.Errorf("BSON string is not NUL-terminated")
+	}
+	return string(b[:len(b)-1]), nil
+}
+
+// document decodes an embedded document, or an array when asArray is set.
+func (d *bsonDecoder) document(depth int, asArray bool) (any, error) {
+	if depth > maxBinaryDepth {
+		return nil, fmt.Errorf("BSON nesting too deep")
+	}
+	start := d.pos
+	size, err := d.int32()
+	if err != nil {
+		return nil, err
+	}
+	if size < 5 || start+int(size) > len(d.buf) {
+		return nil, fmt.Errorf("invalid BSON document size %d at offset %d", size, start)
+	}
+	end := start + int(size)
+	m := map[string]any{}
+	var arr []any
+	for {
+		if d.pos >= end {
+			return nil, fmt.Errorf("BSON document at offset %d is missing its terminator", start)
+		}
+		t := d.buf[d.pos]
+		d.pos++
+		if t == 0 {
+			break
+		}
+		name, err := d.cstring()
+		if err != nil {
+			return nil, err
+		}
+		v, err := d.element(t, depth)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %v", name, err)
+		}
+		if asArray {
+			arr = append(arr, v)
+		} else {
+			m[name] = v
+		}
+	}
+	if d.pos != end {
+		return nil, fmt.Errorf("BSON document size mismatch at offset %d", start)
+	}
+	if asArray {
+		if arr == nil {
+			arr = []any{}
+		}
+		return arr, nil
+	}
+	return m, nil
+}
+
+func (d *bsonDecoder) element(t byte, depth int) (any, error) {
+	switch t {
+	case 0x01:
+		n, err := d.int64()
+		return math.Float64frombits(uint64(n)), err
+	case 0x02:
+		return d.string()
+	case 0x03:
+		return d.document(depth+1, false)
+	case 0x04:
+		return d.document(depth+1, true)
+	case 0x05:
+		n, err := d.int32()
+		if err != nil {
+			return nil, err
+		}
+		st, err := d.take(1)
+		if err != nil {
+			return nil, err
+		}
+		b, err := d.take(int(n))
+		if err != nil {
+			return nil, err
+		}
+		return binaryWrapper(b, st[0]), nil
+	case 0x06:
+		return map[string]any{"$undefined": true}, nil
+	case 0x07:
+		b, err := d.take(12)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"$oid": hex.EncodeToString(b)}, nil
+	case 0x08:
+		b, err := d.take(1)
+		if err != nil {
+			return nil, err
+		}
+		return b[0] != 0, nil
+	case 0x09:
+		ms, err := d.int64()
+		if err != nil {
+			return nil, err
+		}
+		return dateWrapper(time.UnixMilli(ms)), nil
+	case 0x0A:
+		return nil, nil
+	case 0x0B:
+		pattern, err := d.cstring()
+		if err != nil {
+			return nil, err
+		}
+		opts, err := d.cstring()
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"$regularExpression": map[string]any{"pattern": pattern, "options": opts}}, nil
+	case 0x0D:
+		code, err := d.string()
+		return map[string]any{"$code": code}, err
+	case 0x10:
+		n, err := d.int32()
+		return int64(n), err
+	case 0x11:
+		n, err := d.int64()
+		return map[string]any{"$timestamp": map[string]any{
+			"t": int64(uint64(n) >> 32), "i": int64(uint32(n)),
+		}}, err
+	case 0x12:
+		n, err := d.int64()
+		return intValue(n), err
+	case 0x13:
+		b, err := d.take(16)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"$numberDecimal": decimal128String(b)}, nil
+	case 0x7F:
+		return map[string]any{"$maxKey": int64(1)}, nil
+	case 0xFF:
+		return map[string]any{"$minKey": int64(1)}, nil
+	}
+	return nil, fmt.Errorf("unsupported BSON element type 0x%02x", t)
+}
+
+// decimal128String formats an IEEE 754-2008 decimal128 (BID encoding).
+func decimal128String(b []byte) string {
+	lo := binary.LittleEndian.Uint64(b[:8])
+	hi := binary.LittleEndian.Uint64(b[8:])
+	neg := hi>>63 == 1
+	sign := ""
+	if neg {
+		sign = "-"
+	}
+	var exp int
+	coef := new(big.Int)
+	switch {
+	case hi>>58&0x1f == 0x1f:
+		return "NaN"
+	case hi>>58&0x1f == 0x1e:
+		return sign + "Infinity"
+	case hi>>61&0x3 == 0x3:
+		// Coefficients needing the implicit 100 prefix exceed 10^34-1 and
+		// are therefore non-canonical zeros.
+		exp = int(hi>>47&0x3fff) - 6176
+	default:
+		exp = int(hi>>49&0x3fff) - 6176
+		coef.SetUint64(hi & (1<<49 - 1))
+		coef.Lsh(coef, 64).Or(coef, new(big.Int).SetUint64(lo))
+	}
+	digits := coef.String()
+	switch {
+	case exp == 0:
+		return sign + digits
+	case exp > 0 || len(digits)+exp < -5:
+		adj := exp + len(digits) - 1
+		mant := digits[:1]
+		if len(digits) > 1 {
+			mant += "." + digits[1:]
+		}
+		return fmt.Sprintf("%s%sE%+d", sign, mant, adj)
+	case -exp >= len(digits):
+		return sign + "0." + strings.Repeat("0", -exp-len(digits)) + digits
+	}
+	return sign + digits[:len(digits)+exp] + "." + digits[len(digits)+exp:]
+}
+
+var decimalRe = regexp.MustCompile(`^([+-]?)(\d*)(?:\.(\d*))?(?:[eE]([+-]?\d+))?$`)
+
+// decimal128Bytes parses a decimal string into decimal128 (BID encoding).
+func decimal128Bytes(s string) ([]byte, error) {
+	var hi, lo uint64
+	switch strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+") {
+	case "NaN":
+		hi = 0x7c00000000000000
+	case "Infinity", "Inf":
+		hi = 0x7800000000000000
+		if strings.HasPrefix(s, "-") {
+			hi |= 1 << 63
+		}
+	default:
+		m := decimalRe.FindStringSubmatch(s)
+		if m == nil || m[2]+m[3] == "" {
+			return nil, fmt.Errorf("invalid $numberDecimal %q", s)
+		}
+		coef, _ := new(big.Int).SetString(m[2]+m[3], 10)
+		exp := -len(m[3])
+		if m[4] != "" {
+			e, err := strconv.Atoi(m[4])
+			if err != nil {
+				return nil, fmt.Errorf("invalid $numberDecimal %q", s)
+			}
+			exp += e
+		}
+		if coef.BitLen() > 113 || exp < -6176 || exp > 6111 {
+			return nil, fmt.Errorf("$numberDecimal %q is out of range", s)
+		}
+		words := new(big.Int).Rsh(coef, 64)
+		lo = new(big.Int).And(coef, new(big.Int).SetUint64(math.MaxUint64)).Uint64()
+		hi = words.Uint64() | uint64(exp+6176)<<49
+		if m[1] == "-" {
+			hi |= 1 << 63
+		}
+	}
+	b := make([]byte, 16)
+	binary.LittleEndian.PutUint64(b[:8], lo)
+	binary.LittleEndian.PutUint64(b[8:], hi)
+	return b, nil
+}
+
+// bsonDocument encodes m as a BSON document with keys in sorted order.
+func bsonDocument(m map[string]any) ([]byte, error) {
+	return bsonElements(sortedMapKeys(m), func(k string) any { return m[k] }, 0)
+}
+
+func bsonArray(arr []any, depth int) ([]byte, error) {
+	keys := make([]string, len(arr))
+	for i := range arr {
+		keys[i] = strconv.Itoa(i)
+	}
+	return bsonElements(keys, func(k string) any {
+		i, _ := strconv.Atoi(k)
+		return arr[i]
+	}, depth)
+}
+
+func bsonElements(keys []string, get func(string) any, depth int) ([]byte, error) {
+	if depth > maxBinaryDepth {
+		return nil, fmt.Errorf("BSON nesting too deep")
+	}
+	var body bytes.Buffer
+	for _, k := range keys {
+		if strings.IndexByte(k, 0) >= 0 {
+			return nil, fmt.Errorf("BSON keys cannot contain NUL bytes")
+		}
+		t, payload, err := bsonValue(get(k), depth)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %v", k, err)
+		}
+		body.WriteByte(t)
+		body.WriteString(k)
+		body.WriteByte(0)
+		body.Write(payload)
+	}
+	out := make([]byte, 4, body.Len()+5)
+	binary.LittleEndian.PutUint32(out, uint32(body.Len()+5))
+	out = append(out, body.Bytes()...)
+	return append(out, 0), nil
+}
+
+func bsonString(s string) []byte {
+	b := make([]byte, 4, len(s)+5)
+	binary.LittleEndian.PutUint32(b, uint32(len(s)+1))
+	b = append(b, s...)
+	return append(b, 0)
+}
+
+func le64(n uint64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, n)
+	return b
+}
+
+func bsonValue(v any, depth int) (byte, []byte, error) {
+	if w, ok := integral(v); ok {
+		switch {
+		case !w.neg && w.abs <= math.MaxInt32, w.neg && w.abs <= 1<<31:
+			n := int32(int64(w.abs))
+			if w.neg {
+				n = int32(-int64(w.abs))
+			}
+			b := make([]byte, 4)
+			binary.LittleEndian.PutUint32(b, uint32(n))
+			return 0x10, b, nil
+		case !w.neg && w.abs <= math.MaxInt64:
+			return 0x12, le64(w.abs), nil
+		case w.neg:
+			return 0x12, le64(-w.abs), nil
+		}
+		return 0, nil, fmt.Errorf("integer %d does not fit in a BSON int64", w.abs)
+	}
+	switch val := v.(type) {
+	case nil:
+		return 0x0A, nil, nil
+	case bool:
+		if val {
+			return 0x08, []byte{1}, nil
+		}
+		return 0x08, []byte{0}, nil
+	case float64:
+		return 0x01, le64(math.Float64bits(val)), nil
+	case float32:
+		return 0x01, le64(math.Float64bits(float64(val))), nil
+	case string:
+		return 0x02, bsonString(val), nil
+	case []byte:
+		return 0x05, bsonBinary(val, 0), nil
+	case time.Time:
+		return 0x09, le64(uint64(val.UnixMilli())), nil
+	case []any:
+		b, err := bsonArray(val, depth+1)
+		return 0x04, b, err
+	case map[string]any:
+		return bsonObject(val, depth)
+	}
+	s, _ := scalarText(v)
+	return 0x02, bsonString(s), nil
+}
+
+func bsonBinary(b []byte, subtype byte) []byte {
+	out := make([]byte, 5, len(b)+5)
+	binary.LittleEndian.PutUint32(out, uint32(len(b)))
+	out[4] = subtype
+	return append(out, b...)
+}
+
+func bsonObject(m map[string]any, depth int) (byte, []byte, error) {
+	switch wrapperKind(m) {
+	case "$binary":
+		b, st, err := unwrapBinary(m["$binary"])
+		return 0x05, bsonBinary(b, st), err
+	case "$date":
+		t, err := unwrapDate(m["$date"])
+		return 0x09, le64(uint64(t.UnixMilli())), err
+	case "$numberLong":
+		s, _ := m["$numberLong"].(string)
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return 0, nil, fmt.Errorf("invalid $numberLong %q", s)
+		}
+		return 0x12, le64(uint64(n)), nil
+	case "$numberDecimal":
+		s, _ := m["$numberDecimal"].(string)
+		b, err := decimal128Bytes(s)
+		return 0x13, b, err
+	case "$oid":
+		s, _ := m["$oid"].(string)
+		b, err := hex.DecodeString(s)
+		if err != nil || len(b) != 12 {
+			return 0, nil, fmt.Errorf("invalid $oid %q", s)
+		}
+		return 0x07, b, nil
+	case "$regularExpression":
+		re, _ := m["$regularExpression"].(map[string]any)
+		pattern, _ := re["pattern"].(string)
+		opts, _ := re["options"].(string)
+		return 0x0B, []byte(pattern + "\x00" + opts + "\x00"), nil
+	case "$timestamp":
+		ts, _ := m["$timestamp"].(map[string]any)
+		t, ok1 := integral(ts["t"])
+		i, ok2 := integral(ts["i"])
+		if !ok1 || !ok2 {
+			return 0, nil, fmt.Errorf("invalid $timestamp")
+		}
+		return 0x11, le64(t.abs<<32 | i.abs&math.MaxUint32), nil
+	case "$code":
+		s, _ := m["$code"].(string)
+		return 0x0D, bsonString(s), nil
+	case "$undefined":
+		return 0x06, nil, nil
+	case "$minKey":
+		return 0xFF, nil, nil
+	case "$maxKey":
+		return 0x7F, nil, nil
+	}
+	b, err := bsonElements(sortedMapKeys(m), func(k string) any { return m[k] }, depth+1)
+	return 0x03, b, err
+}
@@ -0,0 +1,164 @@
+package api
+
+import (
+	"context"
+	"encoding/hex"
+	"reflect"
+	"strings"
+	"testing"
+
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+func convertBinary(t *testing.T, req *pb.ConvertRequest) string {
+	t.Helper()
+	resp, _ := NewServer().Convert(context.Background(), req)
+	if resp.Error != "" {
+		t.Fatalf("convert %v → %v: %s", req.SourceFormat, req.TargetFormat, resp.Error)
+	}
+	return resp.Data
+}
+
+func TestConvert_BinaryDecode(t *testing.T) {
+	tests := []struct {
+		name   string
+		format pb.DataFormat
+		hex    string
+		want   string
+	}{
+		{"msgpack map", pb.DataFormat_MSGPACK, "82 a1 61 01 a1 62 92 c3 c0", `{"a":1,"b":[true,null]}`},
+		{"msgpack bin", pb.DataFormat_MSGPACK, "c4 03 01 02 03", `{"$binary":{"base64":"AQID","subType":"00"}}`},
+		{"msgpack int64", pb.DataFormat_MSGPACK, "d3 7f ff ff ff ff ff ff ff", `{"$numberLong":"9223372036854775807"}`},
+		{"msgpack timestamp", pb.DataFormat_MSGPACK, "d6 ff 51 4b 67 b0", `{"$date":"2013-03-21T20:04:00Z"}`},
+		{"msgpack ext", pb.DataFormat_MSGPACK, "d4 05 2a", `{"$ext":{"type":5,"base64":"Kg=="}}`},
+		{"cbor epoch", pb.DataFormat_CBOR, "c1 1a 51 4b 67 b0", `{"$date":"2013-03-21T20:04:00Z"}`},
+		{"cbor bignum", pb.DataFormat_CBOR, "c2 49 01 00 00 00 00 00 00 00 00", `{"$tag":2,"$value":"18446744073709551616"}`},
+		{"cbor uri tag", pb.DataFormat_CBOR, "d8 20 76 687474703a2f2f7777772e6578616d706c652e636f6d", `{"$tag":32,"$value":"http://www.example.com"}`},
+		{"cbor half floats", pb.DataFormat_CBOR, "83 f9 3c 00 f9 c4 00 f9 7b ff", `[1,-4,65504]`},
+		{"cbor array", pb.DataFormat_CBOR, "83 01 02 03", `[1,2,3]`},
+		{"cbor map of mixed sizes", pb.DataFormat_CBOR, "a4 61 61 6b 68656c6c6f20776f726c64 61 62 01 61 63 02 61 64 03", `{"a":"hello world","b":1,"c":2,"d":3}`},
+		{"msgpack array", pb.DataFormat_MSGPACK, "93 01 02 03", `[1,2,3]`},
+		{"cbor indefinite", pb.DataFormat_CBOR, "bf 61 61 9f 01 02 ff 61 62 7f 62 68 69 61 21 ff ff", `{"a":[1,2],"b":"hi!"}`},
+		{"bson", pb.DataFormat_BSON, "16000000 02 68656c6c6f00 06000000 776f726c6400 00", `{"hello":"world"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := convertBinary(t, &pb.ConvertRequest{
+				Data: tt.hex, SourceFormat: tt.format, TargetFormat: pb.DataFormat_JSON,
+				BinaryEncoding: pb.BinaryEncoding_BINARY_HEX,
+			})
+			if want := decodeJSON(t, tt.want); !reflect.DeepEqual(decodeJSON(t, got), want) {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvert_BinaryEncode(t *testing.T) {
+	tests := []struct {
+		format pb.DataFormat
+		data   string
+		want   string
+	}{
+		{pb.DataFormat_MSGPACK, `{"a":1,"b":[true,null]}`, "82a16101a16292c3c0"},
+		{pb.DataFormat_MSGPACK, `[-1,-200,1.5]`, "93ffd1ff38cb3ff8000000000000"},
+		{pb.DataFormat_CBOR, `{"a":[1,-1],"b":1.5}`, "a261618201206162fa3fc00000"},
+		{pb.DataFormat_CBOR, `{"$date":"2013-03-21T20:04:00Z"}`, "c11a514b67b0"},
+		{pb.DataFormat_BSON, `{"hello":"world"}`, "160000000268656c6c6f0006000000776f726c640000"},
+	}
+	for _, tt := range tests {
+		got := convertBinary(t, &pb.ConvertRequest{
+			Data: tt.data, SourceFormat: pb.DataFormat_JSON, TargetFormat: tt.format,
+			BinaryEncoding: pb.BinaryEncoding_BINARY_HEX,
+		})
+		if got != tt.want {
+			t.Errorf("%v %s = %s, want %s", tt.format, tt.data, got, tt.want)
+		}
+	}
+}
+
+func TestConvert_BinaryRoundTrip(t *testing.T) {
+	common := `{
+		"bin": {"$binary": {"base64": "AQID", "subType": "00"}},
+		"when": {"$date": "2024-01-02T03:04:05.5Z"},
+		"big": {"$numberLong": "-9223372036854775808"},
+		"n": -42, "f": 1.25, "s": "héllo",
+		"list": [1, "x", null, false, {"deep": []}],
+		"zz": [1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12], "zzz": {"a": 1, "b": 2, "c": 3, "d": 4}
+	}`
+	extra := map[pb.DataFormat]string{
+		pb.DataFormat_MSGPACK: `{"ext": {"$ext": {"type": 7, "base64": "AAEC"}}}`,
+		pb.DataFormat_CBOR:    `{"tagged": {"$tag": 32, "$value": "https://example.com"}, "huge": {"$tag": 2, "$value": "18446744073709551616"}, "u": {"$undefined": true}}`,
+		pb.DataFormat_BSON: `{"id": {"$oid": "507f1f77bcf86cd799439011"}, "price": {"$numberDecimal": "12.345"},
+			"re": {"$regularExpression": {"pattern": "^a", "options": "i"}}, "ts": {"$timestamp": {"t": 1700000000, "i": 3}},
+			"uuid": {"$binary": {"base64": "AAAAAAAAAAAAAAAAAAAAAA==", "subType": "04"}}, "min": {"$minKey": 1}}`,
+	}
+	for format, more := range extra {
+		want := decodeJSON(t, common).(map[string]any)
+		for k, v := range decodeJSON(t, more).(map[string]any) {
+			want[k] = v
+		}
+		src := compactJSON(want)
+		for _, enc := range []pb.BinaryEncoding{pb.BinaryEncoding_BINARY_BASE64, pb.BinaryEncoding_BINARY_HEX} {
+			encoded := convertBinary(t, &pb.ConvertRequest{
+				Data: src, SourceFormat: pb.DataFormat_JSON, TargetFormat: format, BinaryEncoding: enc,
+			})
+			back := convertBinary(t, &pb.ConvertRequest{
+				Data: encoded, SourceFormat: format, TargetFormat: pb.DataFormat_JSON, BinaryEncoding: enc,
+			})
+			if got := decodeJSON(t, back); !reflect.DeepEqual(got, want) {
+				t.Errorf("%v/%v round trip:\ngot  %s\nwant %s", format, enc, back, src)
+			}
+		}
+	}
+}
+
+func TestConvert_BinaryProtobufStyle(t *testing.T) {
+	// 93 c4 02 ab cd, d6 ff 514b67b0, cf ffffffffffffffff
+	got := convertBinary(t, &pb.ConvertRequest{
+		Data: "93c402abcdd6ff514b67b0cfffffffffffffffff", SourceFormat: pb.DataFormat_MSGPACK,
+		TargetFormat: pb.DataFormat_JSON, BinaryEncoding: pb.BinaryEncoding_BINARY_HEX,
+		BinaryJsonStyle: pb.BinaryJsonStyle_BINARY_JSON_PROTOBUF,
+	})
+	want := `["q80=","2013-03-21T20:04:00Z","18446744073709551615"]`
+	if !reflect.DeepEqual(decodeJSON(t, got), decodeJSON(t, want)) {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestDecimal128(t *testing.T) {
+	for _, s := range []string{"0", "1", "-1", "12.345", "0.001", "1.000", "1E+3", "-1.5E-10", "9999999999999999999999999999999999", "NaN", "-Infinity"} {
+		b, err := decimal128Bytes(s)
+		if err != nil {
+			t.Fatalf("%s: %v", s, err)
+		}
+		if got := decimal128String(b); got != s {
+			t.Errorf("decimal128 %s round trip = %s", s, got)
+		}
+	}
+	b, _ := decimal128Bytes("1")
+	if got := hex.EncodeToString(b); got != "01000000000000000000000000004030" {
+		t.Errorf("encoding of 1 = %s", got)
+	}
+}
+
+func TestConvert_BinaryErrors(t *testing.T) {
+	tests := []struct {
+		req  *pb.ConvertRequest
+		want string
+	}{
+		{&pb.ConvertRequest{Data: "zz", SourceFormat: pb.DataFormat_MSGPACK, BinaryEncoding: pb.BinaryEncoding_BINARY_HEX}, "invalid hex"},
+		{&pb.ConvertRequest{Data: "!!", SourceFormat: pb.DataFormat_CBOR}, "invalid base64"},
+		{&pb.ConvertRequest{Data: "a5 68 69", SourceFormat: pb.DataFormat_MSGPACK, BinaryEncoding: pb.BinaryEncoding_BINARY_HEX}, "unexpected end"},
+		{&pb.ConvertRequest{Data: "01 02", SourceFormat: pb.DataFormat_CBOR, BinaryEncoding: pb.BinaryEncoding_BINARY_HEX}, "trailing bytes"},
+		{&pb.ConvertRequest{Data: "06000000 08 6100 01", SourceFormat: pb.DataFormat_BSON, BinaryEncoding: pb.BinaryEncoding_BINARY_HEX}, "missing its terminator"},
+		{&pb.ConvertRequest{Data: "[1]", TargetFormat: pb.DataFormat_BSON}, "requires an object"},
+		{&pb.ConvertRequest{Data: `{"a":{"$oid":"xyz"}}`, TargetFormat: pb.DataFormat_BSON}, "invalid $oid"},
+	}
+	for _, tt := range tests {
+		resp, _ := NewServer().Convert(context.Background(), tt.req)
+		if !strings.Contains(resp.Error, tt.want) {
+			t.Errorf("%q: error = %q, want it to contain %q", tt.req.Data, resp.Error, tt.want)
+		}
+	}
+}
@@ -0,0 +1,645 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+// JsonToCode generates type definitions for a JSON document in the requested
+// target language. All targets share the inference used by JsonToGo.
+func (s *Server) JsonToCode(_ context.Context, req *pb.JsonToCodeRequest) (*pb.JsonToCodeResponse, error) {
+	data, err := decodeOrderedJSON(req.Json)
+	if err != nil {
+		return &pb.JsonToCodeResponse{Error: fmt.Sprintf("Invalid JSON: %v", err)}, nil
+	}
+	m := inferTypes(data, rootTypeName(req.TypeName))
+
+	var code string
+	switch req.Target {
+	case pb.CodeTarget_CODE_TYPESCRIPT:
+		code = renderTypeScript(m)
+	case pb.CodeTarget_CODE_ZOD:
+		code = renderZod(m)
+	case pb.CodeTarget_CODE_RUST_SERDE:
+		code = renderRust(m)
+	case pb.CodeTarget_CODE_PYTHON_DATACLASS:
+		code = renderPython(m, false)
+	case pb.CodeTarget_CODE_PYTHON_PYDANTIC:
+		code = renderPython(m, true)
+	case pb.CodeTarget_CODE_KOTLIN:
+		code = renderKotlin(m)
+	case pb.CodeTarget_CODE_PROTO3:
+		code = renderProto3(m)
+	default:
+		return &pb.JsonToCodeResponse{Error: "unsupported target language"}, nil
+	}
+	return &pb.JsonToCodeResponse{Code: code}, nil
+}
+
+// rootTypeName returns the root type name for a user-supplied name.
+func rootTypeName(name string) string {
+	if strings.TrimSpace(name) == "" {
+		return "AutoGenerated"
+	}
+	return toPascalCase(name)
+}
+
+// identWords splits a JSON key into lower-case words, honouring camelCase
+// boundaries as well as separators.
+func identWords(key string) []string {
+	words := splitIntoWords(strings.Join(splitIdentWords(key), " "))
+	for i, w := range words {
+		words[i] = strings.ToLower(w)
+	}
+	return words
+}
+
+// snakeIdent converts a key to snake_case, falling back to "field".
+func snakeIdent(key string) string {
+	name := strings.Join(identWords(key), "_")
+	if name == "" {
+		return "field"
+	}
+	if unicode.IsDigit([]rune(name)[0]) {
+		name = "n" + name
+	}
+	return name
+}
+
+// camelIdent converts a key to lowerCamelCase, falling back to "field".
+func camelIdent(key string) string {
+	words := identWords(key)
+	if len(words) == 0 {
+		return "field"
+	}
+	var b strings.Builder
+	b.WriteString(words[0])
+	for _, w := range words[1:] {
+		r := []rune(w)
+		b.WriteString(string(unicode.ToUpper(r[0])) + string(r[1:]))
+	}
+	name := b.String()
+	if unicode.IsDigit([]rune(name)[0]) {
+		name = "n" + name
+	}
+	return name
+}
+
+// childrenFirst returns the named object types ordered so every type is
+// declared after the types it references.
+func childrenFirst(m *typeModel) []*inferredType {
+	objs := slices.Clone(m.Objects)
+	slices.Reverse(objs)
+	return objs
+}
+
+// isNamedObject reports whether t is rendered as a reference to a declared type.
+func isNamedObject(t *inferredType) bool {
+	return t.Kind == kindObject && len(t.Fields) > 0
+}
+
+// ── TypeScript ───────────────────────────────────────────────────────────────
+
+var jsIdentRe = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+func tsType(t *inferredType) string {
+	var expr string
+	switch t.Kind {
+	case kindString, kindTime:
+		expr = "string"
+	case kindInt, kindFloat:
+		expr = "number"
+	case kindBool:
+		expr = "boolean"
+	case kindArray:
+		elem := tsType(t.Elem)
+		if strings.Contains(elem, " ") {
+			elem = "(" + elem + ")"
+		}
+		expr = elem + "[]"
+	case kindObject:
+		expr = "Record<string, unknown>"
+		if isNamedObject(t) {
+			expr = t.Name
+		}
+	default:
+		return "unknown"
+	}
+	if t.Nullable {
+		expr += " | null"
+	}
+	return expr
+}
+
+func renderTypeScript(m *typeModel) string {
+	var blocks []string
+	if !isNamedObject(m.Root) {
+		blocks = append(blocks, fmt.Sprintf("export type %s = %s;", m.Name, tsType(m.Root)))
+	}
+	for _, obj := range m.Objects {
+		if !isNamedObject(obj) {
+			continue
+		}
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "export interface %s {\n", obj.Name)
+		for _, f := range obj.Fields {
+			key := f.Key
+			if !jsIdentRe.MatchString(key) {
+				key = strconv.Quote(key)
+			}
+			if f.Optional {
+				key += "?"
+			}
+			fmt.Fprintf(&sb, "  %s: %s;\n", key, tsType(f.Type))
+		}
+		sb.WriteString("}")
+		blocks = append(blocks, sb.String())
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+// ── Zod ──────────────────────────────────────────────────────────────────────
+
+func zodType(t *inferredType) string {
+	var expr string
+	switch t.Kind {
+	case kindString:
+		expr = "z.string()"
+	case kindTime:
+		expr = "z.string().datetime({ offset: true })"
+	case kindInt:
+		expr = "z.number().int()"
+	case kindFloat:
+		expr = "z.number()"
+	case kindBool:
+		expr = "z.boolean()"
+	case kindArray:
+		expr = "z.array(" + zodType(t.Elem) + ")"
+	case kindObject:
+		expr = "z.record(z.string(), z.unknown())"
+		if isNamedObject(t) {
+			expr = t.Name + "Schema"
+		}
+	default:
+		return "z.unknown()"
+	}
+	if t.Nullable {
+		expr += ".nullable()"
+	}
+	return expr
+}
+
+func renderZod(m *typeModel) string {
+	blocks := []string{`import { z } from "zod";`}
+	for _, obj := range childrenFirst(m) {
+		if !isNamedObject(obj) {
+			continue
+		}
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "export const %sSchema = z.object({\n", obj.Name)
+		for _, f := range obj.Fields {
+			key := f.Key
+			if !jsIdentRe.MatchString(key) {
+				key = strconv.Quote(key)
+			}
+			expr := zodType(f.Type)
+			if f.Optional {
+				expr += ".optional()"
+			}
+			fmt.Fprintf(&sb, "  %s: %s,\n", key, expr)
+		}
+		sb.WriteString("});\n")
+		fmt.Fprintf(&sb, "export type %s = z.infer<typeof %sSchema>;", obj.Name, obj.Name)
+		blocks = append(blocks, sb.String())
+	}
+	if !isNamedObject(m.Root) {
+		blocks = append(blocks, fmt.Sprintf("export const %sSchema = %s;\nexport type %s = z.infer<typeof %sSchema>;",
+			m.Name, zodType(m.Root), m.Name, m.Name))
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+// ── Rust (serde) ─────────────────────────────────────────────────────────────
+
+var rustKeywords = map[string]bool{
+	"as": true, "async": true, "await": true, "break": true, "const": true, "continue": true,
+	"crate": true, "dyn": true, "else": true, "enum": true, "extern": true, "false": true,
+	"fn": true, "for": true, "if": true, "impl": true, "in": true, "let": true, "loop": true,
+	"match": true, "mod": true, "move": true, "mut": true, "pub": true, "ref": true,
+	"return": true, "self": true, "static": true, "struct": true, "super": true, "trait": true,
+	"true": true, "type": true, "unsafe": true, "use": true, "where": true, "while": true,
+	"abstract": true, "become": true, "box": true, "do": true, "final": true, "macro": true,
+	"override": true, "priv": true, "try": true, "typeof": true, "unsized": true,
+	"virtual": true, "yield": true,
+}
+
+func rustType(t *inferredType) string {
+	var expr string
+	switch t.Kind {
+	case kindString, kindTime:
+		expr = "String"
+	case kindInt:
+		expr = "i64"
+	case kindFloat:
+		expr = "f64"
+	case kindBool:
+		expr = "bool"
+	case kindArray:
+		expr = "Vec<" + rustType(t.Elem) + ">"
+	case kindObject:
+		expr = "serde_json::Map<String, serde_json::Value>"
+		if isNamedObject(t) {
+			expr = t.Name
+		}
+	default:
+		return "serde_json::Value"
+	}
+	if t.Nullable {
+		expr = "Option<" + expr + ">"
+	}
+	return expr
+}
+
+func renderRust(m *typeModel) string {
+	blocks := []string{"use serde::{Deserialize, Serialize};"}
+	if !isNamedObject(m.Root) {
+		blocks = append(blocks, fmt.Sprintf("pub type %s = %s;", m.Name, rustType(m.Root)))
+	}
+	for _, obj := range m.Objects {
+		if !isNamedObject(obj) {
+			continue
+		}
+		var sb strings.Builder
+		sb.WriteString("#[derive(Debug, Clone, Serialize, Deserialize)]\n")
+		fmt.Fprintf(&sb, "pub struct %s {\n", obj.Name)
+		used := map[string]bool{}
+		for _, f := range obj.Fields {
+			name := snakeIdent(f.Key)
+			if rustKeywords[name] {
+				name += "_"
+			}
+			name = uniqueIdent(name, used)
+
+			typ := rustType(f.Type)
+			var attrs []string
+			if name != f.Key {
+				attrs = append(attrs, fmt.Sprintf("rename = %q", f.Key))
+			}
+			if f.Optional {
+				if f.Type.Kind != kindAny && !f.Type.Nullable {
+					typ = "Option<" + typ + ">"
+				}
+				attrs = append(attrs, "default")
+				if strings.HasPrefix(typ, "Option<") {
+					attrs = append(attrs, `skip_serializing_if = "Option::is_none"`)
+				}
+			}
+			if len(attrs) > 0 {
+				fmt.Fprintf(&sb, "    #[serde(%s)]\n", strings.Join(attrs, ", "))
+			}
+			fmt.Fprintf(&sb, "    pub %s: %s,\n", name, typ)
+		}
+		sb.WriteString("}")
+		blocks = append(blocks, sb.String())
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+// ── Python (dataclasses / pydantic) ──────────────────────────────────────────
+
+var pythonKeywords = map[string]bool{
+	"false": true, "none": true, "true": true, "and": true, "as": true, "assert": true,
+	"async": true, "await": true, "break": true, "class": true, "continue": true, "def": true,
+	"del": true, "elif": true, "else": true, "except": true, "finally": true, "for": true,
+	"from": true, "global": true, "if": true, "import": true, "in": true, "is": true,
+	"lambda": true, "nonlocal": true, "not": true, "or": true, "pass": true, "raise": true,
+	"return": true, "try": true, "while": true, "with": true, "yield": true,
+}
+
+// pyWriter renders Python type hints and records which imports they need.
+type pyWriter struct {
+	any, optional, datetime, field bool
+}
+
+func (w *pyWriter) typ(t *inferredType, nullable bool) string {
+	var expr string
+	switch t.Kind {
+	case kindString:
+		expr = "str"
+	case kindTime:
+		w.datetime = true
+		expr = "datetime"
+	case kindInt:
+		expr = "int"
+	case kindFloat:
+		expr = "float"
+	case kindBool:
+		expr = "bool"
+	case kindArray:
+		expr = "list[" + w.typ(t.Elem, t.Elem.Nullable) + "]"
+	case kindObject:
+		if isNamedObject(t) {
+			expr = t.Name
+		} else {
+			w.any = true
+			expr = "dict[str, Any]"
+		}
+	default:
+		w.any = true
+		return "Any"
+	}
+	if nullable {
+		w.optional = true
+		expr = "Optional[" + expr + "]"
+	}
+	return expr
+}
+
+func renderPython(m *typeModel, pydantic bool) string {
+	w := &pyWriter{}
+	var classes []string
+	for _, obj := range childrenFirst(m) {
+		if !isNamedObject(obj) {
+			continue
+		}
+		var required, defaulted []string
+		used := map[string]bool{}
+		for _, f := range obj.Fields {
+			name := snakeIdent(f.Key)
+			if pythonKeywords[name] {
+				name += "_"
+			}
+			name = uniqueIdent(name, used)
+			line := fmt.Sprintf("    %s: %s", name, w.typ(f.Type, f.Type.Nullable || f.Optional))
+
+			renamed := name != f.Key
+			switch {
+			case pydantic && renamed && f.Optional:
+				w.field = true
+				line += fmt.Sprintf(" = Field(None, alias=%q)", f.Key)
+			case pydantic && renamed:
+				w.field = true
+				line += fmt.Sprintf(" = Field(alias=%q)", f.Key)
+			case f.Optional:
+				line += " = None"
+			}
+			if renamed && !pydantic {
+				line += fmt.Sprintf("  # JSON key: %q", f.Key)
+			}
+			// Dataclass fields with defaults must follow those without.
+			if f.Optional && !pydantic {
+				defaulted = append(defaulted, line)
+			} else {
+				required = append(required, line)
+			}
+		}
+		header := "@dataclass\nclass " + obj.Name + ":"
+		if pydantic {
+			header = "class " + obj.Name + "(BaseModel):"
+		}
+		classes = append(classes, header+"\n"+strings.Join(append(required, defaulted...), "\n"))
+	}
+	if !isNamedObject(m.Root) {
+		classes = append(classes, fmt.Sprintf("%s = %s", m.Name, w.typ(m.Root, m.Root.Nullable)))
+	}
+
+	imports := []string{"from __future__ import annotations", ""}
+	if !pydantic {
+		imports = append(imports, "from dataclasses import dataclass")
+	}
+	if w.datetime {
+		imports = append(imports, "from datetime import datetime")
+	}
+	var typing []string
+	if w.any {
+		typing = append(typing, "Any")
+	}
+	if w.optional {
+		typing = append(typing, "Optional")
+	}
+	if len(typing) > 0 {
+		imports = append(imports, "from typing import "+strings.Join(typing, ", "))
+	}
+	if pydantic {
+		if w.field {
+			imports = append(imports, "", "from pydantic import BaseModel, Field")
+		} else {
+			imports = append(imports, "", "from pydantic import BaseModel")
+		}
+	}
+	return strings.Join(imports, "\n") + "\n\n\n" + strings.Join(classes, "\n\n\n")
+}
+
+// ── Kotlin (kotlinx.serialization) ───────────────────────────────────────────
+
+var kotlinKeywords = map[string]bool{
+	"as": true, "break": true, "class": true, "continue": true, "do": true, "else": true,
+	"false": true, "for": true, "fun": true, "if": true, "in": true, "interface": true,
+	"is": true, "null": true, "object": true, "package": true, "return": true, "super": true,
+	"this": true, "throw": true, "true": true, "try": true, "typealias": true, "typeof": true,
+	"val": true, "var": true, "when": true, "while": true,
+}
+
+// ktWriter renders Kotlin types and records which JSON element types they need.
+type ktWriter struct {
+	element, object bool
+}
+
+func (w *ktWriter) typ(t *inferredType) string {
+	var expr string
+	switch t.Kind {
+	case kindString, kindTime:
+		expr = "String"
+	case kindInt:
+		expr = "Long"
+	case kindFloat:
+		expr = "Double"
+	case kindBool:
+		expr = "Boolean"
+	case kindArray:
+		expr = "List<" + w.typ(t.Elem) + ">"
+	case kindObject:
+		if isNamedObject(t) {
+			expr = t.Name
+		} else {
+			w.object = true
+			expr = "JsonObject"
+		}
+	default:
+		w.element = true
+		expr = "JsonElement"
+	}
+	if t.Nullable {
+		expr += "?"
+	}
+	return expr
+}
+
+func renderKotlin(m *typeModel) string {
+	w := &ktWriter{}
+	var blocks []string
+	serialName := false
+	if !isNamedObject(m.Root) {
+		blocks = append(blocks, fmt.Sprintf("typealias %s = %s", m.Name, w.typ(m.Root)))
+	}
+	for _, obj := range m.Objects {
+		if !isNamedObject(obj) {
+			continue
+		}
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "@Serializable\ndata class %s(\n", obj.Name)
+		used := map[string]bool{}
+		for _, f := range obj.Fields {
+			name := uniqueIdent(camelIdent(f.Key), used)
+			if name != f.Key {
+				serialName = true
+				fmt.Fprintf(&sb, "    @SerialName(%q)\n", f.Key)
+			}
+			if kotlinKeywords[name] {
+				name = "`" + name + "`"
+			}
+			typ := w.typ(f.Type)
+			if f.Optional {
+				if !strings.HasSuffix(typ, "?") {
+					typ += "?"
+				}
+				typ += " = null"
+			}
+			fmt.Fprintf(&sb, "    val %s: %s,\n", name, typ)
+		}
+		sb.WriteString(")")
+		blocks = append(blocks, sb.String())
+	}
+
+	var imports []string
+	if serialName {
+		imports = append(imports, "import kotlinx.serialization.SerialName")
+	}
+	imports = append(imports, "import kotlinx.serialization.Serializable")
+	if w.element {
+		imports = append(imports, "import kotlinx.serialization.json.JsonElement")
+	}
+	if w.object {
+		imports = append(imports, "import kotlinx.serialization.json.JsonObject")
+	}
+	return strings.Join(imports, "\n") + "\n\n" + strings.Join(blocks, "\n\n")
+}
+
+// ── Protocol Buffers (proto3) ────────────────────────────────────────────────
+
+// protoWriter renders proto3 field types and records the well-known types
+// they import.
+type protoWriter struct {
+	structs, timestamp bool
+}
+
+func (w *protoWriter) typ(t *inferredType) string {
+	switch t.Kind {
+	case kindString:
+		return "string"
+	case kindTime:
+		w.timestamp = true
+		return "google.protobuf.Timestamp"
+	case kindInt:
+		return "int64"
+	case kindFloat:
+		return "double"
+	case kindBool:
+		return "bool"
+	case kindArray:
+		// Repeated fields cannot nest, so inner lists stay dynamic.
+		w.structs = true
+		return "google.protobuf.ListValue"
+	case kindObject:
+		if isNamedObject(t) {
+			return t.Name
+		}
+		w.structs = true
+		return "google.protobuf.Struct"
+	default:
+		w.structs = true
+		return "google.protobuf.Value"
+	}
+}
+
+// protoField renders one field declaration for t.
+func (w *protoWriter) field(name, key string, t *inferredType, optional bool, num int) string {
+	var label, typ string
+	switch {
+	case t.Kind == kindArray:
+		label, typ = "repeated ", w.typ(t.Elem)
+	case optional && t.Kind != kindAny && t.Kind != kindObject:
+		label, typ = "optional ", w.typ(t)
+	default:
+		typ = w.typ(t)
+	}
+	line := fmt.Sprintf("  %s%s %s = %d", label, typ, name, num)
+	if key != "" && key != protoJSONName(name) {
+		line += fmt.Sprintf(" [json_name = %q]", key)
+	}
+	return line + ";"
+}
+
+// protoJSONName is the JSON name protoc derives from a field name.
+func protoJSONName(name string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range name {
+		switch {
+		case r == '_':
+			upper = true
+		case upper:
+			b.WriteRune(unicode.ToUpper(r))
+			upper = false
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func renderProto3(m *typeModel) string {
+	w := &protoWriter{}
+	var blocks []string
+	if !isNamedObject(m.Root) {
+		name := "value"
+		if m.Root.Kind == kindArray {
+			name = "items"
+		}
+		blocks = append(blocks, fmt.Sprintf("message %s {\n%s\n}", m.Name, w.field(name, "", m.Root, m.Root.Nullable, 1)))
+	}
+	for _, obj := range m.Objects {
+		if !isNamedObject(obj) {
+			continue
+		}
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "message %s {\n", obj.Name)
+		used := map[string]bool{}
+		for i, f := range obj.Fields {
+			name := uniqueIdent(snakeIdent(f.Key), used)
+			sb.WriteString(w.field(name, f.Key, f.Type, f.Optional || f.Type.Nullable, i+1) + "\n")
+		}
+		sb.WriteString("}")
+		blocks = append(blocks, sb.String())
+	}
+
+	header := []string{`syntax = "proto3";`}
+	var imports []string
+	if w.structs {
+		imports = append(imports, `import "google/protobuf/struct.proto";`)
+	}
+	if w.timestamp {
+		imports = append(imports, `import "google/protobuf/timestamp.proto";`)
+	}
+	if len(imports) > 0 {
+		header = append(header, "", strings.Join(imports, "\n"))
+	}
+	return strings.Join(header, "\n") + "\n\n" + strings.Join(blocks, "\n\n")
+}
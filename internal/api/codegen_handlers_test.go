@@ -0,0 +1,127 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+var codegenSrv = &Server{}
+
+const codegenSample = `{"id": 1, "userName": "a", "type": "x", "created_at": "2024-01-15T10:30:00Z",
+	"address": {"city": "x", "zip": null},
+	"items": [{"sku": "a", "qty": 1}, {"sku": "b", "price": 2.5}],
+	"meta": {}}`
+
+func TestJsonToCode_Targets(t *testing.T) {
+	tests := []struct {
+		target pb.CodeTarget
+		want   []string
+	}{
+		{pb.CodeTarget_CODE_TYPESCRIPT, []string{
+			"export interface AutoGenerated {",
+			"  items: Item[];",
+			"  qty?: number;",
+			"  meta: Record<string, unknown>;",
+			"export interface Address {",
+		}},
+		{pb.CodeTarget_CODE_ZOD, []string{
+			`import { z } from "zod";`,
+			"  qty: z.number().int().optional(),",
+			"  created_at: z.string().datetime({ offset: true }),",
+			"  items: z.array(ItemSchema),",
+			"export type AutoGenerated = z.infer<typeof AutoGeneratedSchema>;",
+		}},
+		{pb.CodeTarget_CODE_RUST_SERDE, []string{
+			"pub struct AutoGenerated {",
+			"    #[serde(rename = \"userName\")]\n    pub user_name: String,",
+			"    #[serde(rename = \"type\")]\n    pub type_: String,",
+			"    pub items: Vec<Item>,",
+			"    pub qty: Option<i64>,",
+		}},
+		{pb.CodeTarget_CODE_PYTHON_DATACLASS, []string{
+			"from datetime import datetime",
+			"@dataclass\nclass Item:",
+			"    price: Optional[float] = None",
+			"    created_at: datetime",
+			"    items: list[Item]",
+		}},
+		{pb.CodeTarget_CODE_PYTHON_PYDANTIC, []string{
+			"from pydantic import BaseModel, Field",
+			"class AutoGenerated(BaseModel):",
+			`    user_name: str = Field(alias="userName")`,
+		}},
+		{pb.CodeTarget_CODE_KOTLIN, []string{
+			"data class AutoGenerated(",
+			"    @SerialName(\"created_at\")\n    val createdAt: String,",
+			"    val qty: Long? = null,",
+			"    val items: List<Item>,",
+		}},
+		{pb.CodeTarget_CODE_PROTO3, []string{
+			`syntax = "proto3";`,
+			`import "google/protobuf/timestamp.proto";`,
+			"  string user_name = 2;",
+			`  google.protobuf.Timestamp created_at = 4 [json_name = "created_at"];`,
+			"  repeated Item items = 6;",
+			"  optional int64 qty = 2;",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target.String(), func(t *testing.T) {
+			resp, err := codegenSrv.JsonToCode(context.Background(), &pb.JsonToCodeRequest{Json: codegenSample, Target: tt.target})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.Error != "" {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(resp.Code, want) {
+					t.Errorf("output missing %q:\n%s", want, resp.Code)
+				}
+			}
+		})
+	}
+}
+
+func TestJsonToCode_DependencyOrder(t *testing.T) {
+	for _, target := range []pb.CodeTarget{pb.CodeTarget_CODE_ZOD, pb.CodeTarget_CODE_PYTHON_DATACLASS} {
+		resp, _ := codegenSrv.JsonToCode(context.Background(), &pb.JsonToCodeRequest{Json: codegenSample, Target: target})
+		if strings.Index(resp.Code, "Item") > strings.Index(resp.Code, "AutoGenerated") {
+			t.Errorf("%s: nested types must be declared before the root:\n%s", target, resp.Code)
+		}
+	}
+}
+
+func TestJsonToCode_RootArray(t *testing.T) {
+	want := map[pb.CodeTarget]string{
+		pb.CodeTarget_CODE_TYPESCRIPT:       "export type Rows = Row[];",
+		pb.CodeTarget_CODE_RUST_SERDE:       "pub type Rows = Vec<Row>;",
+		pb.CodeTarget_CODE_PYTHON_DATACLASS: "Rows = list[Row]",
+		pb.CodeTarget_CODE_KOTLIN:           "typealias Rows = List<Row>",
+		pb.CodeTarget_CODE_PROTO3:           "message Rows {\n  repeated Row items = 1;\n}",
+	}
+	for target, w := range want {
+		resp, _ := codegenSrv.JsonToCode(context.Background(), &pb.JsonToCodeRequest{
+			Json:     `[{"a": 1}, {"b": "x"}]`,
+			TypeName: "rows",
+			Target:   target,
+		})
+		if !strings.Contains(resp.Code, w) {
+			t.Errorf("%s: output missing %q:\n%s", target, w, resp.Code)
+		}
+	}
+}
+
+func TestJsonToCode_Errors(t *testing.T) {
+	resp, _ := codegenSrv.JsonToCode(context.Background(), &pb.JsonToCodeRequest{Json: `{bad`})
+	if resp.Error == "" {
+		t.Error("expected error for invalid JSON")
+	}
+	resp, _ = codegenSrv.JsonToCode(context.Background(), &pb.JsonToCodeRequest{Json: `{}`, Target: pb.CodeTarget(99)})
+	if resp.Error == "" {
+		t.Error("expected error for unknown target")
+	}
+}
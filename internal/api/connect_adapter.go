@@ -630,3 +630,163 @@ func (a *ConnectServer) SpellLanguages(ctx context.Context, r *connect.Request[p
 	}
 	return connect.NewResponse(resp), nil
 }
+
+func (a *ConnectServer) InferSchema(ctx context.Context, r *connect.Request[pb.InferSchemaRequest]) (*connect.Response[pb.InferSchemaResponse], error) {
+	resp, err := a.s.InferSchema(ctx, r.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectServer) JsonToCode(ctx context.Context, r *connect.Request[pb.JsonToCodeRequest]) (*connect.Response[pb.JsonToCodeResponse], error) {
+	resp, err := a.s.JsonToCode(ctx, r.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectServer) DataQuery(ctx context.Context, r *connect.Request[pb.DataQueryRequest]) (*connect.Response[pb.DataQueryResponse], error) {
+	resp, err := a.s.DataQuery(ctx, r.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectServer) DataDiff(ctx context.Context, r *connect.Request[pb.DataDiffRequest]) (*connect.Response[pb.DataDiffResponse], error) {
+	resp, err := a.s.DataDiff(ctx, r.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectServer) DataPatch(ctx context.Context, r *connect.Request[pb.DataPatchRequest]) (*connect.Response[pb.DataPatchResponse], error) {
+	resp, err := a.s.DataPatch(ctx, r.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectServer) DataToSql(ctx context.Context, r *connect.Request[pb.DataToSqlRequest]) (*connect.Response[pb.DataToSqlResponse], error) {
+	resp, err := a.s.DataToSql(ctx, r.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectServer) SqlToGo(ctx context.Context, r *connect.Request[pb.SqlToGoRequest]) (*connect.Response[pb.SqlToGoResponse], error) {
+	resp, err := a.s.SqlToGo(ctx, r.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectServer) GenerateFakeData(ctx context.Context, r *connect.Request[pb.FakeDataRequest]) (*connect.Response[pb.FakeDataResponse], error) {
+	resp, err := a.s.GenerateFakeData(ctx, r.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectServer) XmlFormat(ctx context.Context, r *connect.Request[pb.XmlFormatRequest]) (*connect.Response[pb.XmlFormatResponse], error) {
+	resp, err := a.s.XmlFormat(ctx, r.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectServer) XmlXPath(ctx context.Context, r *connect.Request[pb.XPathRequest]) (*connect.Response[pb.XPathResponse], error) {
+	resp, err := a.s.XmlXPath(ctx, r.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectServer) XmlValidate(ctx context.Context, r *connect.Request[pb.XmlValidateRequest]) (*connect.Response[pb.XmlValidateResponse], error) {
+	resp, err := a.s.XmlValidate(ctx, r.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectServer) ProtobufDecode(ctx context.Context, r *connect.Request[pb.ProtobufDecodeRequest]) (*connect.Response[pb.ProtobufDecodeResponse], error) {
+	resp, err := a.s.ProtobufDecode(ctx, r.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectServer) ColorContrast(ctx context.Context, r *connect.Request[pb.ColorContrastRequest]) (*connect.Response[pb.ColorContrastResponse], error) {
+	resp, err := a.s.ColorContrast(ctx, r.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectServer) ColorPalette(ctx context.Context, r *connect.Request[pb.ColorPaletteRequest]) (*connect.Response[pb.ColorPaletteResponse], error) {
+	resp, err := a.s.ColorPalette(ctx, r.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectServer) ColorBlindness(ctx context.Context, r *connect.Request[pb.ColorBlindnessRequest]) (*connect.Response[pb.ColorBlindnessResponse], error) {
+	resp, err := a.s.ColorBlindness(ctx, r.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectServer) TableQuery(ctx context.Context, r *connect.Request[pb.TableQueryRequest]) (*connect.Response[pb.TableQueryResponse], error) {
+	resp, err := a.s.TableQuery(ctx, r.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectServer) Merge3(ctx context.Context, r *connect.Request[pb.Merge3Request]) (*connect.Response[pb.Merge3Response], error) {
+	resp, err := a.s.Merge3(ctx, r.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectServer) PatchApply(ctx context.Context, r *connect.Request[pb.PatchApplyRequest]) (*connect.Response[pb.PatchApplyResponse], error) {
+	resp, err := a.s.PatchApply(ctx, r.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectServer) RegexExplain(ctx context.Context, r *connect.Request[pb.RegexExplainRequest]) (*connect.Response[pb.RegexExplainResponse], error) {
+	resp, err := a.s.RegexExplain(ctx, r.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func (a *ConnectServer) RegexGenerate(ctx context.Context, r *connect.Request[pb.RegexGenerateRequest]) (*connect.Response[pb.RegexGenerateResponse], error) {
+	resp, err := a.s.RegexGenerate(ctx, r.Msg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+	return connect.NewResponse(resp), nil
+}
@@ -0,0 +1,694 @@
+package api
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+// Convert keeps the source key order for JSON, YAML, CSV and Markdown table
+// input unless sort_keys is set. Ordered objects travel as *orderedObject;
+// the JSON, YAML, XML, CSV and table writers honour that order and every other writer gets
+// plain maps, which it emits sorted.
+
+// parseConvertSource is parseSource, except that key order is preserved
+// where the source format records it.
+func parseConvertSource(req *pb.ConvertRequest) (any, error) {
+	if req.SortKeys {
+		return parseSource(req)
+	}
+	switch req.SourceFormat {
+	case pb.DataFormat_JSON:
+		data, err := decodeOrderedJSON(req.Data)
+		if err != nil {
+			return nil, err
+		}
+		return convertJSONNumbers(data), nil
+	case pb.DataFormat_YAML:
+		return decodeOrderedYAML(req.Data)
+	case pb.DataFormat_CSV, pb.DataFormat_TSV:
+		if !req.CsvFlatten {
+			return parseCSV(req, true)
+		}
+	case pb.DataFormat_MARKDOWN_TABLE:
+		return parseMarkdownTable(req, true)
+	}
+	return parseSource(req)
+}
+
+// convertJSONNumbers replaces json.Number with int64 where the value is an
+// integer that fits, and float64 otherwise.
+func convertJSONNumbers(v any) any {
+	switch val := v.(type) {
+	case json.Number:
+		if n, err := val.Int64(); err == nil {
+			return n
+		}
+		f, _ := val.Float64()
+		return f
+	case *orderedObject:
+		for k, item := range val.values {
+			val.values[k] = convertJSONNumbers(item)
+		}
+	case []any:
+		for i, item := range val {
+			val[i] = convertJSONNumbers(item)
+		}
+	}
+	return v
+}
+
+// decodeOrderedYAML decodes a YAML document with mappings as *orderedObject.
+func decodeOrderedYAML(data string) (any, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(data), &doc); err != nil {
+		return nil, err
+	}
+	if doc.Kind == 0 {
+		return nil, nil
+	}
+	return orderedYAMLValue(&doc, 0)
+}
+
+func orderedYAMLValue(n *yaml.Node, depth int) (any, error) {
+	if depth > maxBinaryDepth {
+		return nil, fmt.Errorf("YAML nesting too deep")
+	}
+	switch n.Kind {
+	case yaml.DocumentNode:
+		if len(n.Content) == 0 {
+			return nil, nil
+		}
+		return orderedYAMLValue(n.Content[0], depth)
+	case yaml.AliasNode:
+		return orderedYAMLValue(n.Alias, depth+1)
+	case yaml.SequenceNode:
+		arr := make([]any, len(n.Content))
+		for i, item := range n.Content {
+			v, err := orderedYAMLValue(item, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			arr[i] = v
+		}
+		return arr, nil
+	case yaml.MappingNode:
+		obj := &orderedObject{values: map[string]any{}}
+		if err := addYAMLEntries(obj, n, depth); err != nil {
+			return nil, err
+		}
+		return obj, nil
+	}
+	var v any
+	if err := n.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// addYAMLEntries copies a mapping into obj, expanding "<<" merge keys.
+// Explicit keys win over merged ones regardless of position.
+func addYAMLEntries(obj *orderedObject, n *yaml.Node, depth int) error {
+	var merges []*yaml.Node
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		kn, vn := n.Content[i], n.Content[i+1]
+		if kn.Tag == "!!merge" {
+			merges = append(merges, vn)
+			continue
+		}
+		var key any
+		if err := kn.Decode(&key); err != nil {
+			return err
+		}
+		v, err := orderedYAMLValue(vn, depth+1)
+		if err != nil {
+			return err
+		}
+		k := mapKeyString(key)
+		if _, dup := obj.values[k]; !dup {
+			obj.keys = append(obj.keys, k)
+		}
+		obj.values[k] = v
+	}
+	for _, m := range merges {
+		if m.Kind == yaml.AliasNode {
+			m = m.Alias
+		}
+		sources := []*yaml.Node{m}
+		if m.Kind == yaml.SequenceNode {
+			sources = m.Content
+		}
+		for _, src := range sources {
+			if src.Kind == yaml.AliasNode {
+				src = src.Alias
+			}
+			if src.Kind != yaml.MappingNode {
+				return fmt.Errorf("line %d: merge key value must be a mapping", m.Line)
+			}
+			merged := &orderedObject{values: map[string]any{}}
+			if err := addYAMLEntries(merged, src, depth+1); err != nil {
+				return err
+			}
+			for _, k := range merged.keys {
+				if _, exists := obj.values[k]; !exists {
+					obj.keys = append(obj.keys, k)
+					obj.values[k] = merged.values[k]
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// MarshalJSON writes the object with its keys in source order. HTML is left
+// unescaped here; the outer encoder applies its own setting.
+func (o *orderedObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	buf.WriteByte('{')
+	for i, k := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := enc.Encode(k); err != nil {
+			return nil, err
+		}
+		buf.Truncate(buf.Len() - 1)
+		buf.WriteByte(':')
+		if err := enc.Encode(o.values[k]); err != nil {
+			return nil, err
+		}
+		buf.Truncate(buf.Len() - 1)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// MarshalYAML emits the object as a mapping with its keys in source order.
+func (o *orderedObject) MarshalYAML() (any, error) {
+	n := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	for _, k := range o.keys {
+		kn, vn := &yaml.Node{}, &yaml.Node{}
+		if err := kn.Encode(k); err != nil {
+			return nil, err
+		}
+		if err := vn.Encode(o.values[k]); err != nil {
+			return nil, err
+		}
+		n.Content = append(n.Content, kn, vn)
+	}
+	return n, nil
+}
+
+// plainValue converts ordered objects back into plain maps.
+func plainValue(v any) any {
+	switch val := v.(type) {
+	case *orderedObject:
+		m := make(map[string]any, len(val.keys))
+		for _, k := range val.keys {
+			m[k] = plainValue(val.values[k])
+		}
+		return m
+	case []any:
+		out := make([]any, len(val))
+		for i, item := range val {
+			out[i] = plainValue(item)
+		}
+		return out
+	}
+	return v
+}
+
+// objectFields returns the keys of an object in output order.
+func objectFields(v any) ([]string, map[string]any, bool) {
+	switch o := v.(type) {
+	case *orderedObject:
+		return o.keys, o.values, true
+	case map[string]any:
+		return sortedMapKeys(o), o, true
+	}
+	return nil, nil, false
+}
+
+// ── YAML ──────────────────────────────────────────────────────────────────────
+
+// marshalYAML encodes data with the requested indent and, optionally, in
+// flow style throughout.
+func marshalYAML(data any, req *pb.ConvertRequest) ([]byte, error) {
+	indent := int(req.YamlIndent)
+	if indent == 0 {
+		indent = 4
+	}
+	if indent < 2 || indent > 9 {
+		return nil, fmt.Errorf("YAML indent must be between 2 and 9")
+	}
+	var node yaml.Node
+	if err := node.Encode(data); err != nil {
+		return nil, err
+	}
+	if req.YamlFlowStyle {
+		node.Style |= yaml.FlowStyle
+	}
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(indent)
+	if err := enc.Encode(&node); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ── XML ───────────────────────────────────────────────────────────────────────
+
+// xmlOptions are the naming conventions shared by the XML reader and writer.
+type xmlOptions struct {
+	attrPrefix string
+	textKey    string
+	root       string
+	item       string
+}
+
+func xmlOptionsFor(req *pb.ConvertRequest) xmlOptions {
+	o := xmlOptions{attrPrefix: "-", textKey: "#text", root: req.XmlRoot, item: "item"}
+	if req.XmlAttrPrefix != "" {
+		o.attrPrefix = req.XmlAttrPrefix
+	}
+	if req.XmlTextKey != "" {
+		o.textKey = req.XmlTextKey
+	}
+	if req.XmlArrayItem != "" {
+		o.item = req.XmlArrayItem
+	}
+	return o
+}
+
+// renameXMLKeys rewrites mxj's "-attr" and "#text" keys to the requested
+// conventions.
+func renameXMLKeys(v any, o xmlOptions) any {
+	switch val := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(val))
+		for k, item := range val {
+			switch {
+			case k == "#text":
+				k = o.textKey
+			case strings.HasPrefix(k, "-"):
+				k = o.attrPrefix + k[1:]
+			}
+			out[k] = renameXMLKeys(item, o)
+		}
+		return out
+	case []any:
+		for i, item := range val {
+			val[i] = renameXMLKeys(item, o)
+		}
+	}
+	return v
+}
+
+var xmlNameRe = regexp.MustCompile(`^[\p{L}_][\p{L}\p{N}_.\-]*(?::[\p{L}_][\p{L}\p{N}_.\-]*)?$`)
+
+var (
+	xmlTextEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	xmlAttrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;", "\n", "&#xA;", "\t", "&#x9;")
+)
+
+// marshalXML writes data as indented XML. Without an explicit root name a
+// single top-level key becomes the root element; anything else is wrapped
+// in <doc>. Arrays inside objects repeat their element; arrays directly
+// inside arrays or at the root use the array item name.
+func marshalXML(data any, req *pb.ConvertRequest) ([]byte, error) {
+	o := xmlOptionsFor(req)
+	name, value := o.root, data
+	if name == "" {
+		name = "doc"
+		if keys, values, ok := objectFields(data); ok && len(keys) == 1 {
+			if _, isArray := values[keys[0]].([]any); !isArray {
+				name, value = keys[0], values[keys[0]]
+			}
+		}
+	}
+	var b strings.Builder
+	if err := writeXMLElement(&b, name, value, "", o); err != nil {
+		return nil, err
+	}
+	return []byte(strings.TrimSuffix(b.String(), "\n")), nil
+}
+
+func writeXMLElement(b *strings.Builder, name string, v any, indent string, o xmlOptions) error {
+	if !xmlNameRe.MatchString(name) {
+		return fmt.Errorf("%q is not a valid XML element name", name)
+	}
+	b.WriteString(indent + "<" + name)
+	switch val := v.(type) {
+	case nil:
+		b.WriteString("/>\n")
+		return nil
+	case []any:
+		if len(val) == 0 {
+			b.WriteString("/>\n")
+			return nil
+		}
+		b.WriteString(">\n")
+		for _, item := range val {
+			if err := writeXMLElement(b, o.item, item, indent+"  ", o); err != nil {
+				return err
+			}
+		}
+		b.WriteString(indent + "</" + name + ">\n")
+		return nil
+	}
+	keys, values, isObject := objectFields(v)
+	if !isObject {
+		b.WriteString(">" + xmlTextEscaper.Replace(cellText(v)) + "</" + name + ">\n")
+		return nil
+	}
+	var text string
+	var children []string
+	for _, k := range keys {
+		switch {
+		case k == o.textKey:
+			text = cellText(values[k])
+		case strings.HasPrefix(k, o.attrPrefix) && len(k) > len(o.attrPrefix):
+			attr := k[len(o.attrPrefix):]
+			if !xmlNameRe.MatchString(attr) {
+				return fmt.Errorf("%q is not a valid XML attribute name", attr)
+			}
+			b.WriteString(" " + attr + `="` + xmlAttrEscaper.Replace(cellText(values[k])) + `"`)
+		default:
+			children = append(children, k)
+		}
+	}
+	switch {
+	case len(children) == 0 && text == "":
+		b.WriteString("/>\n")
+		return nil
+	case len(children) == 0:
+		b.WriteString(">" + xmlTextEscaper.Replace(text) + "</" + name + ">\n")
+		return nil
+	}
+	b.WriteString(">" + xmlTextEscaper.Replace(text) + "\n")
+	for _, k := range children {
+		items, repeated := values[k].([]any)
+		if !repeated {
+			items = []any{values[k]}
+		}
+		for _, item := range items {
+			if err := writeXMLElement(b, k, item, indent+"  ", o); err != nil {
+				return err
+			}
+		}
+	}
+	b.WriteString(indent + "</" + name + ">\n")
+	return nil
+}
+
+// cellText renders a value as a single text field: scalars as plain text,
+// objects and arrays as compact JSON.
+func cellText(v any) string {
+	if _, _, isObject := objectFields(v); !isObject {
+		if s, ok := scalarText(v); ok {
+			return s
+		}
+	}
+	return compactJSON(v)
+}
+
+// ── CSV ───────────────────────────────────────────────────────────────────────
+
+var csvNumberRe = regexp.MustCompile(`^-?(?:0|[1-9]\d*)(?:\.\d+)?(?:[eE][+-]?\d+)?$`)
+
+// inferCSVValue turns a CSV field into a number, boolean or null where it
+// unambiguously is one. Numbers with leading zeros stay strings so that
+// codes such as ZIPs survive.
+func inferCSVValue(s string) any {
+	switch s {
+	case "", "null", "NULL":
+		return nil
+	case "true", "TRUE", "True":
+		return true
+	case "false", "FALSE", "False":
+		return false
+	}
+	if !csvNumberRe.MatchString(s) {
+		return s
+	}
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return n
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f
+	}
+	return s
+}
+
+// parseCSV reads CSV or TSV into an array of objects (or arrays without a
+// header). With csv_flatten, dotted headers such as "a.b" and "tags[0]"
+// rebuild nested values.
+func parseCSV(req *pb.ConvertRequest, ordered bool) (any, error) {
+	delim := csvDelimiter(req.CsvDelimiter)
+	if req.SourceFormat == pb.DataFormat_TSV {
+		delim = '\t'
+	}
+	r := csv.NewReader(strings.NewReader(req.Data))
+	r.Comma = delim
+	r.TrimLeadingSpace = true
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	cell := func(s string) any {
+		if req.CsvInferTypes {
+			return inferCSVValue(s)
+		}
+		return s
+	}
+	rows := []any{}
+	if len(records) == 0 {
+		return rows, nil
+	}
+	if req.CsvNoHeader {
+		for _, row := range records {
+			cells := make([]any, len(row))
+			for j, c := range row {
+				cells[j] = cell(c)
+			}
+			rows = append(rows, cells)
+		}
+		return rows, nil
+	}
+	headers := records[0]
+	for i, row := range records[1:] {
+		field := func(j int) any {
+			if j < len(row) {
+				return cell(row[j])
+			}
+			return cell("")
+		}
+		switch {
+		case req.CsvFlatten:
+			m := map[string]any{}
+			for j, h := range headers {
+				if err := setPropertyPath(m, h, field(j)); err != nil {
+					return nil, fmt.Errorf("row %d: %v", i+2, err)
+				}
+			}
+			rows = append(rows, m)
+		case ordered:
+			obj := &orderedObject{values: make(map[string]any, len(headers))}
+			for j, h := range headers {
+				if _, dup := obj.values[h]; !dup {
+					obj.keys = append(obj.keys, h)
+				}
+				obj.values[h] = field(j)
+			}
+			rows = append(rows, obj)
+		default:
+			m := make(map[string]any, len(headers))
+			for j, h := range headers {
+				m[h] = field(j)
+			}
+			rows = append(rows, m)
+		}
+	}
+	return rows, nil
+}
+
+// csvField is one output value with the type information quoting needs.
+type csvField struct {
+	text    string
+	numeric bool // numbers, booleans and nulls
+}
+
+func csvFieldOf(v any) csvField {
+	f := csvField{text: cellText(v)}
+	switch v.(type) {
+	case nil, bool, float32, float64, int, int8, int16, int32, int64,
+		uint, uint8, uint16, uint32, uint64, json.Number:
+		f.numeric = true
+	}
+	return f
+}
+
+// flattenCSV lists the leaves of v as dotted paths, using [n] for array
+// indexes. Empty objects and arrays are kept as leaves.
+func flattenCSV(prefix string, v any, out *[]string, values map[string]any) {
+	if keys, fields, ok := objectFields(v); ok && len(keys) > 0 {
+		for _, k := range keys {
+			path := k
+			if prefix != "" {
+				path = prefix + "." + k
+			}
+			flattenCSV(path, fields[k], out, values)
+		}
+		return
+	}
+	if arr, ok := v.([]any); ok && len(arr) > 0 && prefix != "" {
+		for i, item := range arr {
+			flattenCSV(fmt.Sprintf("%s[%d]", prefix, i), item, out, values)
+		}
+		return
+	}
+	if _, seen := values[prefix]; !seen {
+		*out = append(*out, prefix)
+	}
+	values[prefix] = v
+}
+
+// tabulate lays out an array of objects or arrays as rows of fields for the
+// CSV and table writers. headers is nil for arrays of arrays.
+func tabulate(data any, req *pb.ConvertRequest, format string) (headers []string, records [][]csvField, err error) {
+	// Unwrap single-key map (common from XML via mxj)
+	if keys, values, ok := objectFields(data); ok && len(keys) == 1 {
+		data = values[keys[0]]
+	}
+
+	rows, ok := data.([]any)
+	if !ok {
+		return nil, nil, fmt.Errorf("%s output requires an array at the root level", format)
+	}
+	if len(rows) == 0 {
+		return nil, nil, nil
+	}
+
+	switch rows[0].(type) {
+	case map[string]any, *orderedObject:
+		// Headers are the union across all rows, in first-seen order.
+		seen := map[string]bool{}
+		flat := make([]map[string]any, 0, len(rows))
+		for _, row := range rows {
+			keys, values, ok := objectFields(row)
+			if !ok {
+				continue
+			}
+			if req.CsvFlatten {
+				values = map[string]any{}
+				keys = nil
+				flattenCSV("", row, &keys, values)
+			}
+			for _, k := range keys {
+				if !seen[k] {
+					seen[k] = true
+					headers = append(headers, k)
+				}
+			}
+			flat = append(flat, values)
+		}
+		if req.SortKeys && !req.CsvFlatten {
+			sort.Strings(headers)
+		}
+		for _, m := range flat {
+			record := make([]csvField, len(headers))
+			for i, h := range headers {
+				if v, ok := m[h]; ok {
+					record[i] = csvFieldOf(v)
+				} else {
+					record[i] = csvField{numeric: true}
+				}
+			}
+			records = append(records, record)
+		}
+
+	case []any:
+		for _, row := range rows {
+			cells, ok := row.([]any)
+			if !ok {
+				continue
+			}
+			record := make([]csvField, len(cells))
+			for i, c := range cells {
+				record[i] = csvFieldOf(c)
+			}
+			records = append(records, record)
+		}
+
+	default:
+		return nil, nil, fmt.Errorf("%s output requires an array of objects or array of arrays", format)
+	}
+	return headers, records, nil
+}
+
+// marshalCSV writes an array of objects or arrays as CSV or TSV.
+func marshalCSV(data any, req *pb.ConvertRequest) ([]byte, error) {
+	delim := csvDelimiter(req.CsvDelimiter)
+	if req.TargetFormat == pb.DataFormat_TSV {
+		delim = '\t'
+	}
+	headers, records, err := tabulate(data, req, "CSV")
+	if err != nil {
+		return nil, err
+	}
+	if headers != nil && !req.CsvNoHeader {
+		header := make([]csvField, len(headers))
+		for i, h := range headers {
+			header[i] = csvField{text: h}
+		}
+		records = append([][]csvField{header}, records...)
+	}
+
+	var b strings.Builder
+	for _, record := range records {
+		for i, f := range record {
+			if i > 0 {
+				b.WriteRune(delim)
+			}
+			b.WriteString(csvQuote(f, delim, req.CsvQuoting))
+		}
+		b.WriteByte('\n')
+	}
+	return []byte(b.String()), nil
+}
+
+// csvQuote applies the quoting policy to one field. Minimal quoting follows
+// encoding/csv: only fields containing the delimiter, quotes, line breaks
+// or leading whitespace are quoted.
+func csvQuote(f csvField, delim rune, policy pb.CsvQuoting) string {
+	quote := false
+	switch policy {
+	case pb.CsvQuoting_CSV_QUOTE_ALL:
+		quote = true
+	case pb.CsvQuoting_CSV_QUOTE_NON_NUMERIC:
+		quote = !f.numeric
+	}
+	if !quote {
+		quote = strings.ContainsRune(f.text, delim) || strings.ContainsAny(f.text, "\"\r\n") ||
+			strings.HasPrefix(f.text, " ") || strings.HasPrefix(f.text, "\t")
+	}
+	if !quote {
+		return f.text
+	}
+	return `"` + strings.ReplaceAll(f.text, `"`, `""`) + `"`
+}
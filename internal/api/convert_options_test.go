@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+func convertWith(t *testing.T, req *pb.ConvertRequest) string {
+	t.Helper()
+	resp, _ := NewServer().Convert(context.Background(), req)
+	if resp.Error != "" {
+		t.Fatalf("convert %v → %v: %s", req.SourceFormat, req.TargetFormat, resp.Error)
+	}
+	return resp.Data
+}
+
+func TestConvert_KeyOrder(t *testing.T) {
+	src := `{"z":1,"a":{"y":[1,2],"b":"x"}}`
+	tests := []struct {
+		name string
+		req  *pb.ConvertRequest
+		want string
+	}{
+		{"json keeps order", &pb.ConvertRequest{Data: src}, "{\n  \"z\": 1,\n  \"a\": {\n    \"y\": [\n      1,\n      2\n    ],\n    \"b\": \"x\"\n  }\n}"},
+		{"json sorted", &pb.ConvertRequest{Data: src, SortKeys: true}, "{\n  \"a\": {\n    \"b\": \"x\",\n    \"y\": [\n      1,\n      2\n    ]\n  },\n  \"z\": 1\n}"},
+		{"yaml indent", &pb.ConvertRequest{Data: src, TargetFormat: pb.DataFormat_YAML, YamlIndent: 2}, "z: 1\na:\n  \"y\":\n    - 1\n    - 2\n  b: x\n"},
+		{"yaml flow", &pb.ConvertRequest{Data: src, TargetFormat: pb.DataFormat_YAML, YamlFlowStyle: true, SortKeys: true}, "{a: {b: x, \"y\": [1, 2]}, z: 1}\n"},
+		{"yaml source", &pb.ConvertRequest{Data: "base: &b\n  x: 1\nchild:\n  <<: *b\n  w: 2\n", SourceFormat: pb.DataFormat_YAML, TargetFormat: pb.DataFormat_NDJSON},
+			"{\"base\":{\"x\":1},\"child\":{\"w\":2,\"x\":1}}\n"},
+		{"csv source", &pb.ConvertRequest{Data: "b,a\n1,2\n", SourceFormat: pb.DataFormat_CSV, TargetFormat: pb.DataFormat_NDJSON}, "{\"b\":\"1\",\"a\":\"2\"}\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := convertWith(t, tt.req); got != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvert_XMLOptions(t *testing.T) {
+	got := convertWith(t, &pb.ConvertRequest{
+		Data:          `{"person":{"@id":"7","name":"A & B","_t":"hi","tags":["x","y"],"empty":null,"grid":[[1,2]]}}`,
+		TargetFormat:  pb.DataFormat_XML,
+		XmlAttrPrefix: "@",
+		XmlTextKey:    "_t",
+	})
+	want := `<person id="7">hi
+  <name>A &amp; B</name>
+  <tags>x</tags>
+  <tags>y</tags>
+  <empty/>
+  <grid>
+    <item>1</item>
+    <item>2</item>
+  </grid>
+</person>`
+	if got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+
+	got = convertWith(t, &pb.ConvertRequest{Data: `[1,{"a":"b"}]`, TargetFormat: pb.DataFormat_XML, XmlRoot: "list", XmlArrayItem: "entry"})
+	if want := "<list>\n  <entry>1</entry>\n  <entry>\n    <a>b</a>\n  </entry>\n</list>"; got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+
+	got = convertWith(t, &pb.ConvertRequest{
+		Data: `<a id="1"><c k="v">t</c></a>`, SourceFormat: pb.DataFormat_XML, TargetFormat: pb.DataFormat_NDJSON,
+		XmlAttrPrefix: "@", XmlTextKey: "$",
+	})
+	if want := "{\"a\":{\"@id\":\"1\",\"c\":{\"$\":\"t\",\"@k\":\"v\"}}}\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	resp, _ := NewServer().Convert(context.Background(), &pb.ConvertRequest{Data: `{"bad name":1}`, TargetFormat: pb.DataFormat_XML})
+	if resp.Error == "" {
+		t.Error("expected an error for an invalid element name")
+	}
+}
+
+func TestConvert_CSVOptions(t *testing.T) {
+	src := `[{"id":1,"user":{"name":"A","tags":["x","y"]},"ok":true,"note":"a,b"},{"id":2,"extra":null}]`
+	tests := []struct {
+		name string
+		req  *pb.ConvertRequest
+		want string
+	}{
+		{"nested as json", &pb.ConvertRequest{Data: `[{"id":1,"user":{"name":"A"}}]`},
+			"id,user\n1,\"{\"\"name\"\":\"\"A\"\"}\"\n"},
+		{"flatten", &pb.ConvertRequest{Data: src, CsvFlatten: true},
+			"id,user.name,user.tags[0],user.tags[1],ok,note,extra\n1,A,x,y,true,\"a,b\",\n2,,,,,,\n"},
+		{"quote all", &pb.ConvertRequest{Data: `[{"a":1,"b":"x"}]`, CsvQuoting: pb.CsvQuoting_CSV_QUOTE_ALL},
+			"\"a\",\"b\"\n\"1\",\"x\"\n"},
+		{"quote non-numeric", &pb.ConvertRequest{Data: `[{"a":1,"b":"x","c":false}]`, CsvQuoting: pb.CsvQuoting_CSV_QUOTE_NON_NUMERIC, CsvNoHeader: true},
+			"1,\"x\",false\n"},
+		{"sorted headers", &pb.ConvertRequest{Data: `[{"b":1},{"a":2}]`, SortKeys: true}, "a,b\n,1\n2,\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.TargetFormat = pb.DataFormat_CSV
+			if got := convertWith(t, tt.req); got != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvert_CSVInput(t *testing.T) {
+	got := convertWith(t, &pb.ConvertRequest{
+		Data:          "id,user.name,user.tags[0],ok,zip,ratio\n1,A,x,true,007,0.5\n2,,y,FALSE,,1e3\n",
+		SourceFormat:  pb.DataFormat_CSV,
+		CsvFlatten:    true,
+		CsvInferTypes: true,
+	})
+	want := decodeJSON(t, `[
+		{"id":1,"user":{"name":"A","tags":["x"]},"ok":true,"zip":"007","ratio":0.5},
+		{"id":2,"user":{"name":null,"tags":["y"]},"ok":false,"zip":null,"ratio":1000}
+	]`)
+	if !reflect.DeepEqual(decodeJSON(t, got), want) {
+		t.Errorf("got %s", got)
+	}
+}
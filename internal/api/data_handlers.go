@@ -1,55 +1,30 @@
 package api
 
 import (
+	"cmp"
 	"context"
-	"encoding/csv"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"go/format"
 	"io"
-	"regexp"
-	"sort"
+	"maps"
+	"math"
+	"slices"
 	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/clbanning/mxj/v2"
 	toml "github.com/pelletier/go-toml/v2"
 	"gopkg.in/yaml.v3"
 
+	"github.com/odinnordico/privutil/internal/csscolor"
+	"github.com/odinnordico/privutil/internal/sqlfmt"
 	pb "github.com/odinnordico/privutil/proto"
 )
 
-func (s *Server) JsonFormat(ctx context.Context, req *pb.JsonFormatRequest) (*pb.JsonFormatResponse, error) {
-	var data any
-	if err := json.Unmarshal([]byte(req.Text), &data); err != nil {
-		return &pb.JsonFormatResponse{Error: fmt.Sprintf("Invalid JSON: %v", err)}, nil
-	}
-
-	var (
-		formatted []byte
-		err       error
-	)
-
-	if req.Indent == "min" {
-		formatted, err = json.Marshal(data)
-	} else {
-		indent := "  "
-		switch req.Indent {
-		case "4":
-			indent = "    "
-		case "tab":
-			indent = "\t"
-		}
-		formatted, err = json.MarshalIndent(data, "", indent)
-	}
-
-	if err != nil {
-		return &pb.JsonFormatResponse{Error: fmt.Sprintf("Formatting failed: %v", err)}, nil
-	}
-
-	return &pb.JsonFormatResponse{Text: string(formatted)}, nil
-}
-
 // csvDelimiter returns the configured delimiter rune, defaulting to comma.
 func csvDelimiter(raw string) rune {
 	if raw == `\t` || raw == "tab" {
@@ -81,51 +56,42 @@ func parseSource(req *pb.ConvertRequest) (any, error) {
 		if err != nil {
 			return nil, err
 		}
-		data = map[string]any(mv)
+		data = renameXMLKeys(map[string]any(mv), xmlOptionsFor(req))
 
 	case pb.DataFormat_TOML:
 		if err := toml.Unmarshal([]byte(req.Data), &data); err != nil {
 			return nil, err
 		}
 
-	case pb.DataFormat_CSV:
-		delim := csvDelimiter(req.CsvDelimiter)
-		r := csv.NewReader(strings.NewReader(req.Data))
-		r.Comma = delim
-		r.TrimLeadingSpace = true
-		records, err := r.ReadAll()
-		if err != nil {
-			return nil, err
-		}
-		if len(records) == 0 {
-			return []any{}, nil
-		}
-		if req.CsvNoHeader {
-			rows := make([]any, len(records))
-			for i, row := range records {
-				cells := make([]any, len(row))
-				for j, cell := range row {
-					cells[j] = cell
-				}
-				rows[i] = cells
-			}
-			data = rows
-		} else {
-			headers := records[0]
-			rows := make([]any, len(records)-1)
-			for i, row := range records[1:] {
-				m := make(map[string]any, len(headers))
-				for j, h := range headers {
-					if j < len(row) {
-						m[h] = row[j]
-					} else {
-						m[h] = ""
-					}
-				}
-				rows[i] = m
-			}
-			data = rows
-		}
+	case pb.DataFormat_CSV, pb.DataFormat_TSV:
+		return parseCSV(req, false)
+
+	case pb.DataFormat_NDJSON:
+		return parseNDJSON(req.Data)
+
+	case pb.DataFormat_INI:
+		return parseINI(req.Data)
+
+	case pb.DataFormat_ENV:
+		return parseEnv(req.Data)
+
+	case pb.DataFormat_PROPERTIES:
+		return parseProperties(req.Data)
+
+	case pb.DataFormat_HCL:
+		return parseHCL(req.Data)
+
+	case pb.DataFormat_JSON5:
+		return parseJSON5(req.Data)
+
+	case pb.DataFormat_MSGPACK, pb.DataFormat_CBOR, pb.DataFormat_BSON:
+		return parseBinarySource(req)
+
+	case pb.DataFormat_MARKDOWN_TABLE:
+		return parseMarkdownTable(req, false)
+
+	case pb.DataFormat_HTML_TABLE, pb.DataFormat_ASCII_TABLE:
+		return nil, fmt.Errorf("%v is an output-only format", req.SourceFormat)
 
 	default:
 		return nil, fmt.Errorf("unsupported source format")
@@ -136,119 +102,70 @@ func parseSource(req *pb.ConvertRequest) (any, error) {
 
 // marshalTarget serialises data into the requested target format.
 func marshalTarget(data any, req *pb.ConvertRequest) ([]byte, error) {
+	switch req.TargetFormat {
+	case pb.DataFormat_JSON, pb.DataFormat_YAML, pb.DataFormat_XML, pb.DataFormat_CSV,
+		pb.DataFormat_TSV, pb.DataFormat_NDJSON, pb.DataFormat_MARKDOWN_TABLE,
+		pb.DataFormat_HTML_TABLE, pb.DataFormat_ASCII_TABLE:
+	default:
+		data = plainValue(data)
+	}
+
+	switch req.TargetFormat {
+	case pb.DataFormat_JSON, pb.DataFormat_NDJSON:
+		if f, path, ok := nonFinite(data, ""); ok {
+			where := "at the root"
+			if path != "" {
+				where = fmt.Sprintf("at %q", path)
+			}
+			return nil, fmt.Errorf("%s %s cannot be represented in JSON; convert to JSON5, YAML or TOML instead", nonFiniteName(f), where)
+		}
+	}
+
 	switch req.TargetFormat {
 	case pb.DataFormat_JSON:
 		return json.MarshalIndent(data, "", "  ")
 
 	case pb.DataFormat_YAML:
-		return yaml.Marshal(data)
+		return marshalYAML(data, req)
 
 	case pb.DataFormat_XML:
-		var mv mxj.Map
-		switch m := data.(type) {
-		case map[string]any:
-			mv = mxj.Map(m)
-		default:
-			b, err := json.Marshal(data)
-			if err != nil {
-				return nil, err
-			}
-			var err2 error
-			mv, err2 = mxj.NewMapJson(b)
-			if err2 != nil {
-				return nil, err2
-			}
-		}
-		return mv.XmlIndent("", "  ")
+		return marshalXML(data, req)
 
 	case pb.DataFormat_TOML:
 		return toml.Marshal(data)
 
-	case pb.DataFormat_CSV:
-		delim := csvDelimiter(req.CsvDelimiter)
-		var buf strings.Builder
-		w := csv.NewWriter(&buf)
-		w.Comma = delim
+	case pb.DataFormat_CSV, pb.DataFormat_TSV:
+		return marshalCSV(data, req)
 
-		// Unwrap single-key map (common from XML via mxj)
-		if m, ok := data.(map[string]any); ok && len(m) == 1 {
-			for _, v := range m {
-				data = v
-			}
-		}
+	case pb.DataFormat_NDJSON:
+		return marshalNDJSON(data)
 
-		rows, ok := data.([]any)
-		if !ok {
-			return nil, fmt.Errorf("CSV output requires an array at the root level")
-		}
-		if len(rows) == 0 {
-			return []byte{}, nil
-		}
+	case pb.DataFormat_INI:
+		return marshalINI(data)
 
-		switch firstRow := rows[0].(type) {
-		case map[string]any:
-			// Collect all keys (union across all rows, sorted for determinism)
-			keySet := make(map[string]struct{})
-			for _, row := range rows {
-				if m, ok := row.(map[string]any); ok {
-					for k := range m {
-						keySet[k] = struct{}{}
-					}
-				}
-			}
-			headers := make([]string, 0, len(keySet))
-			for k := range keySet {
-				headers = append(headers, k)
-			}
-			sort.Strings(headers)
+	case pb.DataFormat_ENV:
+		return marshalEnv(data)
 
-			if !req.CsvNoHeader {
-				if err := w.Write(headers); err != nil {
-					return nil, err
-				}
-			}
-			for _, row := range rows {
-				m, ok := row.(map[string]any)
-				if !ok {
-					continue
-				}
-				record := make([]string, len(headers))
-				for i, h := range headers {
-					if v, ok := m[h]; ok {
-						record[i] = fmt.Sprintf("%v", v)
-					}
-				}
-				if err := w.Write(record); err != nil {
-					return nil, err
-				}
-			}
-			_ = firstRow
+	case pb.DataFormat_PROPERTIES:
+		return marshalProperties(data)
 
-		case []any:
-			for _, row := range rows {
-				cells, ok := row.([]any)
-				if !ok {
-					continue
-				}
-				record := make([]string, len(cells))
-				for i, c := range cells {
-					record[i] = fmt.Sprintf("%v", c)
-				}
-				if err := w.Write(record); err != nil {
-					return nil, err
-				}
-			}
-			_ = firstRow
+	case pb.DataFormat_HCL:
+		return marshalHCL(data)
 
-		default:
-			return nil, fmt.Errorf("CSV output requires an array of objects or array of arrays")
-		}
+	case pb.DataFormat_JSON5:
+		return marshalJSON5(data)
 
-		w.Flush()
-		if err := w.Error(); err != nil {
-			return nil, err
-		}
-		return []byte(buf.String()), nil
+	case pb.DataFormat_MSGPACK, pb.DataFormat_CBOR, pb.DataFormat_BSON:
+		return marshalBinaryTarget(data, req)
+
+	case pb.DataFormat_MARKDOWN_TABLE:
+		return marshalMarkdownTable(data, req)
+
+	case pb.DataFormat_HTML_TABLE:
+		return marshalHTMLTable(data, req)
+
+	case pb.DataFormat_ASCII_TABLE:
+		return marshalASCIITable(data, req)
 
 	default:
 		return nil, fmt.Errorf("unsupported target format")
@@ -256,7 +173,7 @@ func marshalTarget(data any, req *pb.ConvertRequest) ([]byte, error) {
 }
 
 func (s *Server) Convert(ctx context.Context, req *pb.ConvertRequest) (*pb.ConvertResponse, error) {
-	data, err := parseSource(req)
+	data, err := parseConvertSource(req)
 	if err != nil {
 		return &pb.ConvertResponse{Error: fmt.Sprintf("Parse failed: %v", err)}, nil
 	}
@@ -292,7 +209,7 @@ func offsetToLineCol(s string, offset int) (line, col int) {
 
 func (s *Server) ValidateData(ctx context.Context, req *pb.ValidateRequest) (*pb.ValidateResponse, error) {
 	if strings.TrimSpace(req.Data) == "" {
-		return &pb.ValidateResponse{Valid: false, Error: "input is empty"}, nil
+		return validationFailure("input is empty", 0, 0), nil
 	}
 
 	switch req.Format {
@@ -306,22 +223,14 @@ func (s *Server) ValidateData(ctx context.Context, req *pb.ValidateRequest) (*pb
 			if err != nil {
 				if se, ok := err.(*json.SyntaxError); ok { //nolint:errorlint
 					l, c := offsetToLineCol(req.Data, int(se.Offset))
-					return &pb.ValidateResponse{
-						Valid:  false,
-						Error:  err.Error(),
-						Line:   int32(l), // #nosec G115
-						Column: int32(c), // #nosec G115
-					}, nil
+					return validationFailure(err.Error(), l, c), nil
 				}
-				return &pb.ValidateResponse{Valid: false, Error: err.Error()}, nil
+				return validationFailure(err.Error(), 0, 0), nil
 			}
 		}
 
 	case pb.DataFormat_YAML:
-		var node yaml.Node
-		if err := yaml.Unmarshal([]byte(req.Data), &node); err != nil {
-			return &pb.ValidateResponse{Valid: false, Error: err.Error()}, nil
-		}
+		return validateYAML(req.Data), nil
 
 	case pb.DataFormat_XML:
 		dec := xml.NewDecoder(strings.NewReader(req.Data))
@@ -332,190 +241,434 @@ func (s *Server) ValidateData(ctx context.Context, req *pb.ValidateRequest) (*pb
 			}
 			if err != nil {
 				if se, ok := err.(*xml.SyntaxError); ok { //nolint:errorlint
-					return &pb.ValidateResponse{
-						Valid: false,
-						Error: se.Msg,
-						Line:  int32(se.Line), // #nosec G115
-					}, nil
+					return validationFailure(se.Msg, se.Line, 0), nil
 				}
-				return &pb.ValidateResponse{Valid: false, Error: err.Error()}, nil
+				return validationFailure(err.Error(), 0, 0), nil
 			}
 		}
 
 	case pb.DataFormat_TOML:
-		var v any
-		if err := toml.Unmarshal([]byte(req.Data), &v); err != nil {
-			return &pb.ValidateResponse{Valid: false, Error: err.Error()}, nil
+		return validateTOML(req.Data), nil
+
+	case pb.DataFormat_NDJSON, pb.DataFormat_TSV, pb.DataFormat_INI, pb.DataFormat_ENV,
+		pb.DataFormat_PROPERTIES, pb.DataFormat_HCL, pb.DataFormat_JSON5,
+		pb.DataFormat_MSGPACK, pb.DataFormat_CBOR, pb.DataFormat_BSON, pb.DataFormat_MARKDOWN_TABLE:
+		if _, err := parseSource(&pb.ConvertRequest{Data: req.Data, SourceFormat: req.Format}); err != nil {
+			return validationFailure(err.Error(), 0, 0), nil
 		}
 
 	default:
-		return &pb.ValidateResponse{Valid: false, Error: "unsupported format for validation"}, nil
+		return validationFailure("unsupported format for validation", 0, 0), nil
 	}
 
 	return &pb.ValidateResponse{Valid: true}, nil
 }
 
 func (s *Server) JsonToGo(ctx context.Context, req *pb.JsonToGoRequest) (*pb.JsonToGoResponse, error) {
-	var data any
-	if err := json.Unmarshal([]byte(req.Json), &data); err != nil {
+	data, err := decodeOrderedJSON(req.Json)
+	if err != nil {
 		return &pb.JsonToGoResponse{Error: fmt.Sprintf("Invalid JSON: %v", err)}, nil
 	}
 
-	name := req.StructName
-	if name == "" {
-		name = "AutoGenerated"
+	g := goTypeWriter{
+		inline:   req.InlineTypes,
+		pointers: req.UsePointers,
+		yaml:     req.YamlTags,
+		toml:     req.TomlTags,
 	}
+	code, err := g.render(inferTypes(data, rootTypeName(req.StructName)))
+	if err != nil {
+		return &pb.JsonToGoResponse{Error: fmt.Sprintf("Generation failed: %v", err)}, nil
+	}
+	return &pb.JsonToGoResponse{GoCode: code}, nil
+}
 
-	var sb strings.Builder
-	sb.WriteString(fmt.Sprintf("type %s struct {\n", name))
+// goTypeWriter renders an inferred typeModel as Go type declarations.
+type goTypeWriter struct {
+	inline   bool // nest anonymous structs instead of declaring named types
+	pointers bool // optional and nullable fields become pointers
+	yaml     bool
+	toml     bool
+}
 
-	m, ok := data.(map[string]any)
-	if ok {
-		var keys []string
-		for k := range m {
-			keys = append(keys, k)
-		}
-		sort.Strings(keys)
-
-		for _, k := range keys {
-			val := m[k]
-			fieldName := toPascalCase(k)
-			typeName := "any"
-
-			switch v := val.(type) {
-			case string:
-				typeName = "string"
-			case float64:
-				typeName = "float64"
-				if v == float64(int64(v)) {
-					typeName = "int"
-				}
-			case bool:
-				typeName = "bool"
-			case []any:
-				typeName = "[]any"
-			case map[string]any:
-				typeName = "struct { ... }"
+func (g goTypeWriter) render(m *typeModel) (string, error) {
+	var sb strings.Builder
+	root := m.Root
+	rootExpr := g.typeExpr(root, false)
+	if root.Kind == kindObject && len(root.Fields) > 0 {
+		rootExpr = g.structBody(root)
+	}
+	fmt.Fprintf(&sb, "type %s %s\n", m.Name, rootExpr)
+	if !g.inline {
+		for _, obj := range m.Objects {
+			if obj == root || len(obj.Fields) == 0 {
+				continue
 			}
+			fmt.Fprintf(&sb, "\ntype %s %s\n", obj.Name, g.structBody(obj))
+		}
+	}
+	out, err := format.Source([]byte(sb.String()))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
 
-			fmt.Fprintf(&sb, "\t%s %s `json:\"%s\"`\n", fieldName, typeName, k)
+func (g goTypeWriter) typeExpr(t *inferredType, pointer bool) string {
+	var expr string
+	switch t.Kind {
+	case kindString:
+		expr = "string"
+	case kindInt:
+		expr = "int"
+	case kindFloat:
+		expr = "float64"
+	case kindBool:
+		expr = "bool"
+	case kindTime:
+		expr = "time.Time"
+	case kindArray:
+		return "[]" + g.typeExpr(t.Elem, g.pointers && t.Elem.Nullable)
+	case kindObject:
+		if len(t.Fields) == 0 {
+			return "struct{}"
+		}
+		expr = t.Name
+		if g.inline {
+			expr = g.structBody(t)
 		}
-	} else {
-		sb.WriteString("\t// Root must be an object\n")
+	default:
+		return "any"
 	}
+	if pointer {
+		return "*" + expr
+	}
+	return expr
+}
 
+func (g goTypeWriter) structBody(t *inferredType) string {
+	var sb strings.Builder
+	sb.WriteString("struct {\n")
+	used := map[string]bool{}
+	for _, f := range t.Fields {
+		optional := f.Optional || f.Type.Nullable
+		tag := f.Key
+		if optional {
+			tag += ",omitempty"
+		}
+		encodings := []string{"json"}
+		if g.yaml {
+			encodings = append(encodings, "yaml")
+		}
+		if g.toml {
+			encodings = append(encodings, "toml")
+		}
+		fmt.Fprintf(&sb, "%s %s %s\n",
+			uniqueIdent(toPascalCase(f.Key), used),
+			g.typeExpr(f.Type, g.pointers && optional),
+			goStructTag(tag, encodings...))
+	}
 	sb.WriteString("}")
-	return &pb.JsonToGoResponse{GoCode: sb.String()}, nil
+	return sb.String()
 }
 
+var sqlDialects = map[pb.SqlDialect]sqlfmt.Dialect{
+	pb.SqlDialect_SQL_STANDARD:   sqlfmt.Standard,
+	pb.SqlDialect_SQL_POSTGRESQL: sqlfmt.PostgreSQL,
+	pb.SqlDialect_SQL_MYSQL:      sqlfmt.MySQL,
+	pb.SqlDialect_SQL_SQLITE:     sqlfmt.SQLite,
+	pb.SqlDialect_SQL_BIGQUERY:   sqlfmt.BigQuery,
+}
+
+var sqlKeywordCases = map[pb.SqlKeywordCase]sqlfmt.KeywordCase{
+	pb.SqlKeywordCase_SQL_KEYWORDS_UPPER:    sqlfmt.Upper,
+	pb.SqlKeywordCase_SQL_KEYWORDS_LOWER:    sqlfmt.Lower,
+	pb.SqlKeywordCase_SQL_KEYWORDS_PRESERVE: sqlfmt.Preserve,
+}
+
+// SqlFormat pretty-prints or minifies SQL in the chosen dialect. Literals,
+// quoted identifiers and comments are copied unchanged.
 func (s *Server) SqlFormat(ctx context.Context, req *pb.SqlRequest) (*pb.SqlResponse, error) {
-	sql := req.Query
-	keywords := []string{
-		"select", "from", "where", "insert", "update", "delete", "create",
-		"drop", "alter", "table", "into", "values", "join", "on",
-		"order by", "group by", "limit", "offset", "and", "or", "not", "null", "as",
+	dialect, ok := sqlDialects[req.Dialect]
+	if !ok {
+		return &pb.SqlResponse{Error: "unsupported SQL dialect"}, nil
 	}
-
-	formatted := sql
-	for _, kw := range keywords {
-		re := regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(kw) + `\b`)
-		formatted = re.ReplaceAllStringFunc(formatted, strings.ToUpper)
+	kwCase, ok := sqlKeywordCases[req.KeywordCase]
+	if !ok {
+		return &pb.SqlResponse{Error: "unsupported keyword case"}, nil
 	}
-
-	for _, kw := range []string{"SELECT", "FROM", "WHERE", "INSERT", "UPDATE", "DELETE", "ORDER BY", "GROUP BY", "LIMIT"} {
-		if strings.Contains(formatted, kw) {
-			formatted = strings.ReplaceAll(formatted, kw, "\n"+kw)
+	indent := "  "
+	switch req.Indent {
+	case "4":
+		indent = "    "
+	case "tab":
+		indent = "\t"
+	}
+	formatted, err := sqlfmt.Format(req.Query, sqlfmt.Options{
+		Dialect:     dialect,
+		KeywordCase: kwCase,
+		Indent:      indent,
+		Width:       int(req.LineWidth),
+		Minify:      req.Minify,
+	})
+	if err != nil {
+		resp := &pb.SqlResponse{Error: err.Error()}
+		var se *sqlfmt.Error
+		if errors.As(err, &se) {
+			resp.ErrorLine, resp.ErrorColumn = int32(se.Line), int32(se.Column) // #nosec G115
 		}
+		return resp, nil
 	}
-	formatted = strings.TrimSpace(formatted)
-
 	return &pb.SqlResponse{Formatted: formatted}, nil
 }
 
+// ColorConvert reads a color in any CSS Color 4 notation, or hsv()/cmyk(),
+// and writes it in all of them. Lab and LCH colors outside sRGB are mapped
+// into it for the sRGB notations.
 func (s *Server) ColorConvert(ctx context.Context, req *pb.ColorRequest) (*pb.ColorResponse, error) {
-	input := strings.TrimSpace(req.Input)
-	var r, g, b uint8
-	var err error
+	c, err := csscolor.Parse(req.Input)
+	if err != nil {
+		return &pb.ColorResponse{Error: err.Error()}, nil
+	}
+	nearest, _ := c.NearestName()
+	return &pb.ColorResponse{
+		Hex:         c.Hex(),
+		Hex8:        c.Hex8(),
+		Rgb:         c.RGBString(),
+		Hsl:         c.HSLString(),
+		Hsv:         c.HSVString(),
+		Hwb:         c.HWBString(),
+		Cmyk:        c.CMYKString(),
+		Lab:         c.LabString(),
+		Lch:         c.LCHString(),
+		Oklab:       c.OKLabString(),
+		Oklch:       c.OKLCHString(),
+		Alpha:       c.A,
+		Name:        c.Name(),
+		NearestName: nearest,
+		OutOfGamut:  !c.InGamut(),
+	}, nil
+}
 
-	switch {
-	case strings.HasPrefix(input, "#"):
-		input = strings.TrimPrefix(input, "#")
-		if len(input) == 3 {
-			input = string([]byte{input[0], input[0], input[1], input[1], input[2], input[2]})
-		}
-		if len(input) == 6 {
-			if v, e := strconv.ParseUint(input, 16, 32); e == nil {
-				r = uint8(v >> 16) // #nosec G115
-				g = uint8(v >> 8)  // #nosec G115
-				b = uint8(v)       // #nosec G115
-			} else {
-				err = e
-			}
-		} else {
-			err = fmt.Errorf("invalid hex length")
+// ColorContrast computes the WCAG 2.x contrast ratio of text on a
+// background and, for failing levels, the nearest foreground shade that
+// passes.
+func (s *Server) ColorContrast(ctx context.Context, req *pb.ColorContrastRequest) (*pb.ColorContrastResponse, error) {
+	fg, err := csscolor.Parse(req.Foreground)
+	if err != nil {
+		return &pb.ColorContrastResponse{Error: fmt.Sprintf("foreground: %v", err)}, nil
+	}
+	bg, err := csscolor.Parse(req.Background)
+	if err != nil {
+		return &pb.ColorContrastResponse{Error: fmt.Sprintf("background: %v", err)}, nil
+	}
+	ratio := csscolor.Contrast(fg, bg)
+	resp := &pb.ColorContrastResponse{
+		// WCAG forbids rounding up to a passing ratio, so truncate.
+		Ratio:    math.Floor(ratio*100) / 100,
+		Aa:       ratio >= csscolor.AA,
+		AaLarge:  ratio >= csscolor.AALarge,
+		Aaa:      ratio >= csscolor.AAA,
+		AaaLarge: ratio >= csscolor.AAALarge,
+	}
+	if !resp.Aa {
+		if c, ok := csscolor.Suggest(fg, bg, csscolor.AA); ok {
+			resp.SuggestionAa, resp.SuggestionAaRatio = c.Hex(), math.Floor(csscolor.Contrast(c, bg)*100)/100
 		}
-	case strings.HasPrefix(input, "rgb"):
-		re := regexp.MustCompile(`rgb\(\s*(\d+)\s*,\s*(\d+)\s*,\s*(\d+)\s*\)`)
-		matches := re.FindStringSubmatch(input)
-		if len(matches) == 4 {
-			ri, _ := strconv.Atoi(matches[1])
-			gi, _ := strconv.Atoi(matches[2])
-			bi, _ := strconv.Atoi(matches[3])
-			r, g, b = uint8(ri), uint8(gi), uint8(bi) // #nosec G115
-		} else {
-			err = fmt.Errorf("invalid rgb format")
+	}
+	if !resp.Aaa {
+		if c, ok := csscolor.Suggest(fg, bg, csscolor.AAA); ok {
+			resp.SuggestionAaa, resp.SuggestionAaaRatio = c.Hex(), math.Floor(csscolor.Contrast(c, bg)*100)/100
 		}
-	default:
-		err = fmt.Errorf("unsupported format (use #Hex or rgb(...))")
 	}
+	return resp, nil
+}
+
+// paletteMaxSteps bounds tints, shades and gradients.
+const paletteMaxSteps = 64
 
+// ColorPalette derives a palette from a color: tints and shades, hue
+// harmonies, an OKLab gradient to a second color, or a 50–950 scale.
+func (s *Server) ColorPalette(ctx context.Context, req *pb.ColorPaletteRequest) (*pb.ColorPaletteResponse, error) {
+	base, err := csscolor.Parse(req.Color)
 	if err != nil {
-		return &pb.ColorResponse{Error: err.Error()}, nil
+		return &pb.ColorPaletteResponse{Error: err.Error()}, nil
+	}
+	steps := int(req.Steps)
+	if steps <= 0 {
+		steps = 5
 	}
+	if steps > paletteMaxSteps {
+		return &pb.ColorPaletteResponse{Error: fmt.Sprintf("steps must be at most %d", paletteMaxSteps)}, nil
+	}
+	var swatches []csscolor.Swatch
+	switch kind := strings.ToLower(strings.TrimSpace(req.Kind)); kind {
+	case "tints", "":
+		swatches = csscolor.Tints(base, steps)
+	case "shades":
+		swatches = csscolor.Shades(base, steps)
+	case "scale":
+		swatches = csscolor.Scale(base)
+	case "gradient":
+		end, err := csscolor.Parse(req.End)
+		if err != nil {
+			return &pb.ColorPaletteResponse{Error: fmt.Sprintf("gradient end: %v", err)}, nil
+		}
+		swatches = csscolor.Gradient(base, end, steps)
+	default:
+		if swatches, err = csscolor.Harmony(base, kind); err != nil {
+			return &pb.ColorPaletteResponse{Error: fmt.Sprintf("unknown palette kind %q", req.Kind)}, nil
+		}
+	}
+	resp := &pb.ColorPaletteResponse{}
+	for _, sw := range swatches {
+		resp.Colors = append(resp.Colors, &pb.PaletteColor{
+			Label: sw.Label, Hex: sw.Color.Hex(), Rgb: sw.Color.RGBString(), Oklch: sw.Color.OKLCHString(),
+		})
+	}
+	return resp, nil
+}
 
-	rf, gf, bf := float64(r)/255.0, float64(g)/255.0, float64(b)/255.0
-	maxC := max(rf, gf, bf)
-	minC := min(rf, gf, bf)
-	delta := maxC - minC
+// cvdMaxColors bounds the palette; every pair is compared per deficiency.
+const cvdMaxColors = 64
 
-	var hue, sat, lum float64
-	lum = (maxC + minC) / 2
+// ColorBlindness simulates a palette under each color-vision deficiency
+// and reports the pairs that become hard to tell apart.
+func (s *Server) ColorBlindness(ctx context.Context, req *pb.ColorBlindnessRequest) (*pb.ColorBlindnessResponse, error) {
+	if len(req.Colors) == 0 {
+		return &pb.ColorBlindnessResponse{Error: "At least one color is required"}, nil
+	}
+	if len(req.Colors) > cvdMaxColors {
+		return &pb.ColorBlindnessResponse{Error: fmt.Sprintf("At most %d colors are supported", cvdMaxColors)}, nil
+	}
+	colors := make([]csscolor.Color, len(req.Colors))
+	for i, in := range req.Colors {
+		c, err := csscolor.Parse(in)
+		if err != nil {
+			return &pb.ColorBlindnessResponse{Error: fmt.Sprintf("color %d: %v", i+1, err)}, nil
+		}
+		colors[i] = c
+	}
+	threshold := req.Threshold
+	if threshold <= 0 {
+		threshold = 10
+	}
+	severity := req.Severity
+	if severity <= 0 {
+		severity = 1
+	}
 
-	if delta != 0 {
-		if lum < 0.5 {
-			sat = delta / (maxC + minC)
-		} else {
-			sat = delta / (2 - maxC - minC)
+	resp := &pb.ColorBlindnessResponse{}
+	for _, d := range csscolor.Deficiencies {
+		sim := &pb.CvdSimulation{Deficiency: string(d)}
+		seen := make([]csscolor.Color, len(colors))
+		for i, c := range colors {
+			seen[i], _ = csscolor.Simulate(c, d, severity)
+			sim.Colors = append(sim.Colors, seen[i].Hex())
 		}
-		switch maxC {
-		case rf:
-			hue = (gf - bf) / delta
-			if gf < bf {
-				hue += 6
+		resp.Simulations = append(resp.Simulations, sim)
+
+		var conflicts []*pb.CvdConflict
+		for i := range seen {
+			for j := i + 1; j < len(seen); j++ {
+				if de := csscolor.DeltaE2000(seen[i], seen[j]); de < threshold {
+					conflicts = append(conflicts, &pb.CvdConflict{
+						Deficiency: string(d),
+						IndexA:     int32(i), IndexB: int32(j), // #nosec G115
+						ColorA: colors[i].Hex(), ColorB: colors[j].Hex(),
+						DeltaE: math.Round(de*100) / 100,
+					})
+				}
 			}
-		case gf:
-			hue = (bf-rf)/delta + 2
-		case bf:
-			hue = (rf-gf)/delta + 4
 		}
-		hue /= 6
+		slices.SortStableFunc(conflicts, func(a, b *pb.CvdConflict) int { return cmp.Compare(a.DeltaE, b.DeltaE) })
+		resp.Conflicts = append(resp.Conflicts, conflicts...)
 	}
+	return resp, nil
+}
 
-	return &pb.ColorResponse{
-		Hex: fmt.Sprintf("#%02x%02x%02x", r, g, b),
-		Rgb: fmt.Sprintf("rgb(%d, %d, %d)", r, g, b),
-		Hsl: fmt.Sprintf("hsl(%.0f, %.0f%%, %.0f%%)", hue*360, sat*100, lum*100),
-	}, nil
+// nonFinite finds the first NaN or infinite number in v, which JSON can't
+// represent, and its JSON Pointer path.
+func nonFinite(v any, path string) (float64, string, bool) {
+	switch val := v.(type) {
+	case float64:
+		if math.IsNaN(val) || math.IsInf(val, 0) {
+			return val, path, true
+		}
+	case float32:
+		return nonFinite(float64(val), path)
+	case []any:
+		for i, item := range val {
+			if f, p, ok := nonFinite(item, path+"/"+strconv.Itoa(i)); ok {
+				return f, p, true
+			}
+		}
+	case *orderedObject:
+		for _, k := range val.keys {
+			if f, p, ok := nonFinite(val.values[k], path+"/"+escapePointer(k)); ok {
+				return f, p, true
+			}
+		}
+	case map[string]any:
+		for _, k := range slices.Sorted(maps.Keys(val)) {
+			if f, p, ok := nonFinite(val[k], path+"/"+escapePointer(k)); ok {
+				return f, p, true
+			}
+		}
+	}
+	return 0, "", false
+}
+
+// nonFiniteName spells a non-finite number as JSON5 does.
+func nonFiniteName(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case f > 0:
+		return "Infinity"
+	}
+	return "-Infinity"
+}
+
+// goInitialisms are words Go style writes in all capitals.
+var goInitialisms = map[string]bool{
+	"API": true, "ID": true, "IP": true, "JSON": true, "HTML": true, "HTTP": true,
+	"SQL": true, "URI": true, "URL": true, "UUID": true, "XML": true,
 }
 
-func toPascalCase(s string) string {
-	s = strings.ReplaceAll(s, "_", " ")
-	s = strings.ReplaceAll(s, "-", " ")
-	words := strings.Fields(s)
-	for i, w := range words {
-		if len(w) > 0 {
-			words[i] = strings.ToUpper(w[:1]) + w[1:]
+// toPascalCase turns a JSON key or SQL name into an exported identifier,
+// falling back to "Field". It names both types and fields, so "api_url"
+// is APIURL either way.
+func toPascalCase(key string) string {
+	var b strings.Builder
+	for _, w := range identWords(key) {
+		if up := strings.ToUpper(w); goInitialisms[up] {
+			b.WriteString(up)
+			continue
 		}
+		r := []rune(w)
+		b.WriteString(string(unicode.ToUpper(r[0])) + string(r[1:]))
+	}
+	name := b.String()
+	if name == "" {
+		return "Field"
+	}
+	if unicode.IsDigit([]rune(name)[0]) {
+		name = "N" + name
+	}
+	return name
+}
+
+// goStructTag renders the struct tag naming a field value in each of the
+// encodings. A raw string can't hold a backtick, so a value with one gets a
+// comment instead and the encoders fall back to the field name.
+func goStructTag(value string, encodings ...string) string {
+	if strings.Contains(value, "`") {
+		return fmt.Sprintf("// key %q can't be written in a struct tag", value)
+	}
+	tags := make([]string, len(encodings))
+	for i, e := range encodings {
+		tags[i] = fmt.Sprintf("%s:%q", e, value)
 	}
-	return strings.Join(words, "")
+	return "`" + strings.Join(tags, " ") + "`"
 }
@@ -157,6 +157,108 @@ func TestJsonToGo(t *testing.T) {
 	}
 }
 
+func TestJsonToGo_NestedTypes(t *testing.T) {
+	s := NewServer()
+	resp, _ := s.JsonToGo(context.Background(), &pb.JsonToGoRequest{
+		Json: `{"id": 1, "created_at": "2024-01-15T10:30:00Z", "address": {"city": "x"},
+			"items": [{"sku": "a", "qty": 1}, {"sku": "b", "price": 2.5}]}`,
+	})
+	if resp.Error != "" {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	for _, want := range []string{
+		"type AutoGenerated struct",
+		"CreatedAt time.Time",
+		"Address   Address",
+		"Items     []Item",
+		"type Address struct",
+		"type Item struct",
+		"Qty   int     `json:\"qty,omitempty\"`",
+		"Price float64 `json:\"price,omitempty\"`",
+		"Sku   string  `json:\"sku\"`",
+	} {
+		if !strings.Contains(resp.GoCode, want) {
+			t.Errorf("output missing %q:\n%s", want, resp.GoCode)
+		}
+	}
+}
+
+func TestJsonToGo_RootArrayAndPointers(t *testing.T) {
+	s := NewServer()
+	resp, _ := s.JsonToGo(context.Background(), &pb.JsonToGoRequest{
+		Json:        `[{"a": 1}, {"a": 2, "b": "x"}, null]`,
+		StructName:  "users",
+		UsePointers: true,
+		YamlTags:    true,
+	})
+	for _, want := range []string{
+		"type Users []*User",
+		"type User struct",
+		"B *string `json:\"b,omitempty\" yaml:\"b,omitempty\"`",
+	} {
+		if !strings.Contains(resp.GoCode, want) {
+			t.Errorf("output missing %q:\n%s", want, resp.GoCode)
+		}
+	}
+}
+
+func TestJsonToGo_Inline(t *testing.T) {
+	s := NewServer()
+	resp, _ := s.JsonToGo(context.Background(), &pb.JsonToGoRequest{
+		Json:        `{"x": {"y": [1, 2.5]}}`,
+		InlineTypes: true,
+		TomlTags:    true,
+	})
+	if strings.Count(resp.GoCode, "type ") != 1 {
+		t.Errorf("inline output should declare a single type:\n%s", resp.GoCode)
+	}
+	if !strings.Contains(resp.GoCode, "Y []float64 `json:\"y\" toml:\"y\"`") {
+		t.Errorf("unexpected output:\n%s", resp.GoCode)
+	}
+}
+
+func TestJsonToGo_NameCollisions(t *testing.T) {
+	s := NewServer()
+	resp, _ := s.JsonToGo(context.Background(), &pb.JsonToGoRequest{
+		Json: `{"a": {"id": 1}, "b": {"a": {"id": 2}}}`,
+	})
+	if !strings.Contains(resp.GoCode, "type A struct") || !strings.Contains(resp.GoCode, "type BA struct") {
+		t.Errorf("nested types with the same key should get distinct names:\n%s", resp.GoCode)
+	}
+
+	resp, _ = s.JsonToGo(context.Background(), &pb.JsonToGoRequest{Json: `{bad`})
+	if resp.Error == "" {
+		t.Error("expected error for invalid JSON")
+	}
+}
+
+func TestJsonToGo_Names(t *testing.T) {
+	s := NewServer()
+	resp, _ := s.JsonToGo(context.Background(), &pb.JsonToGoRequest{
+		Json: "{\"userId\": 1, \"api_url\": \"x\", \"odd`key\": true}",
+	})
+	if resp.Error != "" {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	for _, want := range []string{
+		"UserID int    `json:\"userId\"`",
+		"APIURL string `json:\"api_url\"`",
+		"OddKey bool   // key \"odd`key\" can't be written in a struct tag",
+	} {
+		if !strings.Contains(resp.GoCode, want) {
+			t.Errorf("output missing %q:\n%s", want, resp.GoCode)
+		}
+	}
+
+	// Types and fields share one naming rule.
+	resp, _ = s.JsonToGo(context.Background(), &pb.JsonToGoRequest{Json: `{"home_url": {"a": 1}}`, StructName: "api_response"})
+	for _, want := range []string{"type APIResponse struct", "HomeURL HomeURL `json:\"home_url\"`", "type HomeURL struct"} {
+		if !strings.Contains(resp.GoCode, want) {
+			t.Errorf("output missing %q:\n%s", want, resp.GoCode)
+		}
+	}
+}
+
 func TestSqlFormat(t *testing.T) {
 	s := NewServer()
 	ctx := context.Background()
@@ -168,6 +270,26 @@ func TestSqlFormat(t *testing.T) {
 	if !strings.Contains(resp.Formatted, "SELECT") {
 		t.Error("SqlFormat() expected uppercase SELECT")
 	}
+
+	resp, _ = s.SqlFormat(ctx, &pb.SqlRequest{
+		Query:       "SELECT `from` FROM t WHERE note = 'select * from x' # why",
+		Dialect:     pb.SqlDialect_SQL_MYSQL,
+		KeywordCase: pb.SqlKeywordCase_SQL_KEYWORDS_LOWER,
+		Indent:      "4",
+	})
+	if want := "select `from`\nfrom t\nwhere\n    note = 'select * from x' # why"; resp.Formatted != want {
+		t.Errorf("SqlFormat() = %q, want %q", resp.Formatted, want)
+	}
+
+	resp, _ = s.SqlFormat(ctx, &pb.SqlRequest{Query: "select a,\n  b -- note\nfrom t", Minify: true})
+	if resp.Formatted != "SELECT a,b FROM t" {
+		t.Errorf("SqlFormat() minified = %q", resp.Formatted)
+	}
+
+	resp, _ = s.SqlFormat(ctx, &pb.SqlRequest{Query: "select 1\nfrom (select 2"})
+	if resp.Error == "" || resp.ErrorLine != 2 || resp.ErrorColumn != 6 {
+		t.Errorf("SqlFormat() error = %q at %d:%d, want line 2 column 6", resp.Error, resp.ErrorLine, resp.ErrorColumn)
+	}
 }
 
 func TestColorConvert(t *testing.T) {
@@ -177,12 +299,16 @@ func TestColorConvert(t *testing.T) {
 	tests := []struct {
 		name      string
 		input     string
+		wantHex   string
 		wantError bool
 	}{
-		{"hex", "#ff0000", false},
-		{"short hex", "#f00", false},
-		{"rgb", "rgb(255, 0, 0)", false},
-		{"invalid", "invalid", true},
+		{"hex", "#ff0000", "#ff0000", false},
+		{"short hex", "#f00", "#ff0000", false},
+		{"rgb", "rgb(255, 0, 0)", "#ff0000", false},
+		{"named", "tomato", "#ff6347", false},
+		{"oklch", "oklch(62.8% 0.2577 29.23)", "#ff0000", false},
+		{"cmyk", "cmyk(0%, 100%, 100%, 0%)", "#ff0000", false},
+		{"invalid", "invalid", "", true},
 	}
 
 	for _, tt := range tests {
@@ -194,9 +320,128 @@ func TestColorConvert(t *testing.T) {
 			if tt.wantError && resp.Error == "" {
 				t.Error("ColorConvert() expected error")
 			}
-			if !tt.wantError && resp.Hex == "" {
-				t.Error("ColorConvert() expected hex output")
+			if resp.Hex != tt.wantHex {
+				t.Errorf("ColorConvert() hex = %q, want %q", resp.Hex, tt.wantHex)
 			}
 		})
 	}
+
+	resp, _ := s.ColorConvert(ctx, &pb.ColorRequest{Input: "rgba(255, 0, 0, 0.5)"})
+	if resp.Rgb != "rgba(255, 0, 0, 0.5)" || resp.Hsl != "hsla(0, 100%, 50%, 0.5)" || resp.Hex8 != "#ff000080" || resp.Alpha != 0.5 {
+		t.Errorf("alpha: %+v", resp)
+	}
+	if resp.Lab != "lab(54.29 80.8 69.89 / 0.5)" || resp.Oklch != "oklch(0.628 0.2577 29.23 / 0.5)" || resp.Name != "" || resp.NearestName != "red" {
+		t.Errorf("alpha notations: %+v", resp)
+	}
+
+	resp, _ = s.ColorConvert(ctx, &pb.ColorRequest{Input: "lch(50 150 270)"})
+	if !resp.OutOfGamut || resp.Lch != "lch(50 150 270)" {
+		t.Errorf("out of gamut: %+v", resp)
+	}
+}
+
+func TestColorContrast(t *testing.T) {
+	s := NewServer()
+	ctx := context.Background()
+
+	resp, err := s.ColorContrast(ctx, &pb.ColorContrastRequest{Foreground: "#777", Background: "white"})
+	if err != nil || resp.Error != "" {
+		t.Fatalf("err=%v resp=%v", err, resp.Error)
+	}
+	if resp.Ratio != 4.47 || resp.Aa || !resp.AaLarge || resp.Aaa || resp.AaaLarge {
+		t.Errorf("verdicts: %+v", resp)
+	}
+	if resp.SuggestionAa != "#767676" || resp.SuggestionAaRatio < 4.5 || resp.SuggestionAaa != "#595959" {
+		t.Errorf("suggestions: %+v", resp)
+	}
+
+	resp, _ = s.ColorContrast(ctx, &pb.ColorContrastRequest{Foreground: "black", Background: "#fff"})
+	if resp.Ratio != 21 || !resp.Aaa || resp.SuggestionAa != "" || resp.SuggestionAaa != "" {
+		t.Errorf("black on white: %+v", resp)
+	}
+
+	resp, _ = s.ColorContrast(ctx, &pb.ColorContrastRequest{Foreground: "black", Background: "nope"})
+	if !strings.HasPrefix(resp.Error, "background:") {
+		t.Errorf("error: %q", resp.Error)
+	}
+}
+
+func TestColorPalette(t *testing.T) {
+	s := NewServer()
+	ctx := context.Background()
+
+	tests := []struct {
+		req   *pb.ColorPaletteRequest
+		count int
+		first string
+	}{
+		{&pb.ColorPaletteRequest{Color: "#3b82f6"}, 6, "base"},
+		{&pb.ColorPaletteRequest{Color: "#3b82f6", Kind: "shades", Steps: 2}, 3, "base"},
+		{&pb.ColorPaletteRequest{Color: "#3b82f6", Kind: "complementary"}, 2, "base"},
+		{&pb.ColorPaletteRequest{Color: "#3b82f6", Kind: "Split-Complementary"}, 3, "base"},
+		{&pb.ColorPaletteRequest{Color: "#3b82f6", Kind: "scale"}, 11, "50"},
+		{&pb.ColorPaletteRequest{Color: "black", Kind: "gradient", End: "white", Steps: 3}, 3, "0%"},
+	}
+	for _, tt := range tests {
+		resp, err := s.ColorPalette(ctx, tt.req)
+		if err != nil || resp.Error != "" {
+			t.Errorf("%s: err=%v resp=%v", tt.req.Kind, err, resp.Error)
+			continue
+		}
+		if len(resp.Colors) != tt.count || resp.Colors[0].Label != tt.first || resp.Colors[0].Hex == "" || resp.Colors[0].Oklch == "" {
+			t.Errorf("%s: %v", tt.req.Kind, resp.Colors)
+		}
+	}
+
+	for req, msg := range map[*pb.ColorPaletteRequest]string{
+		{Color: "#3b82f6", Kind: "pentadic"}:  "unknown palette kind",
+		{Color: "#3b82f6", Kind: "gradient"}:  "gradient end",
+		{Color: "#3b82f6", Steps: 1000}:       "steps must be at most",
+		{Color: "#3b82f6zz", Kind: "triadic"}: "invalid hex color",
+	} {
+		resp, _ := s.ColorPalette(ctx, req)
+		if !strings.Contains(resp.Error, msg) {
+			t.Errorf("%+v: got %q, want %q", req, resp.Error, msg)
+		}
+	}
+}
+
+func TestColorBlindness(t *testing.T) {
+	s := NewServer()
+	ctx := context.Background()
+
+	// Red and green apart, blue well away from both.
+	resp, err := s.ColorBlindness(ctx, &pb.ColorBlindnessRequest{Colors: []string{"#d62728", "#2ca02c", "#1f77b4"}, Threshold: 15})
+	if err != nil || resp.Error != "" {
+		t.Fatalf("err=%v resp=%v", err, resp.Error)
+	}
+	if len(resp.Simulations) != 5 || resp.Simulations[0].Deficiency != "normal" || resp.Simulations[0].Colors[0] != "#d62728" {
+		t.Fatalf("simulations: %v", resp.Simulations)
+	}
+	found := map[string]bool{}
+	for _, c := range resp.Conflicts {
+		if c.IndexA == 0 && c.IndexB == 1 {
+			found[c.Deficiency] = true
+		}
+		if c.Deficiency == "normal" {
+			t.Errorf("conflict under normal vision: %v", c)
+		}
+	}
+	if !found["deuteranopia"] || found["tritanopia"] {
+		t.Errorf("red/green conflicts: %v", resp.Conflicts)
+	}
+	if c := resp.Conflicts[0]; c.Deficiency != "deuteranopia" || c.DeltaE != 4.56 {
+		t.Errorf("first conflict: %v", c)
+	}
+
+	for req, msg := range map[*pb.ColorBlindnessRequest]string{
+		{}:                                "At least one color",
+		{Colors: []string{"red", "nope"}}: "color 2:",
+		{Colors: make([]string, 65)}:      "At most 64",
+	} {
+		resp, _ := s.ColorBlindness(ctx, req)
+		if !strings.Contains(resp.Error, msg) {
+			t.Errorf("got %q, want %q", resp.Error, msg)
+		}
+	}
 }
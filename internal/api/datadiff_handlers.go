@@ -0,0 +1,594 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/odinnordico/privutil/internal/query"
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+// DataDiff compares two structured documents semantically: object key order
+// is ignored and arrays are matched by index, or by ArrayKey when set.
+func (s *Server) DataDiff(_ context.Context, req *pb.DataDiffRequest) (*pb.DataDiffResponse, error) {
+	left, err := parseDocument(req.Left, req.LeftFormat)
+	if err != nil {
+		return &pb.DataDiffResponse{Error: fmt.Sprintf("Left document: %v", err)}, nil
+	}
+	right, err := parseDocument(req.Right, req.RightFormat)
+	if err != nil {
+		return &pb.DataDiffResponse{Error: fmt.Sprintf("Right document: %v", err)}, nil
+	}
+
+	d := &structDiff{arrayKey: req.ArrayKey, ops: []patchOp{}}
+	d.diff("", left, right)
+
+	jsonPatch, err := json.MarshalIndent(d.ops, "", "  ")
+	if err != nil {
+		return &pb.DataDiffResponse{Error: err.Error()}, nil
+	}
+	mergePatch, err := json.MarshalIndent(mergeDiff(left, right), "", "  ")
+	if err != nil {
+		return &pb.DataDiffResponse{Error: err.Error()}, nil
+	}
+
+	resp := &pb.DataDiffResponse{
+		Equal:      len(d.ops) == 0,
+		JsonPatch:  string(jsonPatch),
+		MergePatch: string(mergePatch),
+	}
+	lines := make([]string, 0, len(d.ops))
+	for _, op := range d.ops {
+		c := &pb.DataChange{Op: op.Op, Path: op.Path, From: op.From}
+		if op.old != nil {
+			c.OldValue = compactJSON(*op.old)
+		}
+		if op.Value != nil {
+			c.NewValue = compactJSON(*op.Value)
+		}
+		resp.Changes = append(resp.Changes, c)
+		lines = append(lines, summaryLine(c))
+	}
+	resp.Summary = strings.Join(lines, "\n")
+	if resp.Equal {
+		resp.Summary = "No differences"
+	}
+	return resp, nil
+}
+
+// DataPatch applies an RFC 6902 JSON Patch or RFC 7386 Merge Patch to a
+// document and returns the result in the document's own format.
+func (s *Server) DataPatch(_ context.Context, req *pb.DataPatchRequest) (*pb.DataPatchResponse, error) {
+	doc, err := parseDocument(req.Document, req.Format)
+	if err != nil {
+		return &pb.DataPatchResponse{Error: fmt.Sprintf("Parse failed: %v", err)}, nil
+	}
+	var patch any
+	if err := json.Unmarshal([]byte(req.Patch), &patch); err != nil {
+		return &pb.DataPatchResponse{Error: fmt.Sprintf("Invalid patch JSON: %v", err)}, nil
+	}
+
+	var result any
+	switch req.PatchType {
+	case pb.PatchType_PATCH_JSON:
+		result, err = applyJSONPatch(doc, patch)
+	case pb.PatchType_PATCH_MERGE:
+		result = applyMergePatch(doc, patch)
+	default:
+		return &pb.DataPatchResponse{Error: "unsupported patch type"}, nil
+	}
+	if err != nil {
+		return &pb.DataPatchResponse{Error: err.Error()}, nil
+	}
+
+	out, err := marshalTarget(result, &pb.ConvertRequest{TargetFormat: req.Format})
+	if err != nil {
+		return &pb.DataPatchResponse{Error: fmt.Sprintf("Conversion failed: %v", err)}, nil
+	}
+	return &pb.DataPatchResponse{Result: string(out)}, nil
+}
+
+// parseDocument decodes data in format into the JSON value model.
+func parseDocument(data string, format pb.DataFormat) (any, error) {
+	v, err := parseSource(&pb.ConvertRequest{Data: data, SourceFormat: format})
+	if err != nil {
+		return nil, err
+	}
+	return query.Normalize(v), nil
+}
+
+func compactJSON(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
+
+func summaryLine(c *pb.DataChange) string {
+	path := c.Path
+	if path == "" {
+		path = "(root)"
+	}
+	switch c.Op {
+	case "add":
+		return fmt.Sprintf("+ %s: %s", path, c.NewValue)
+	case "remove":
+		return fmt.Sprintf("- %s: %s", path, c.OldValue)
+	case "move":
+		return fmt.Sprintf("> %s → %s", c.From, path)
+	}
+	return fmt.Sprintf("~ %s: %s → %s", path, c.OldValue, c.NewValue)
+}
+
+// ── Structural diff ───────────────────────────────────────────────────────────
+
+// patchOp is one RFC 6902 operation. Value is a pointer so that a JSON null
+// is still emitted; old is kept for the change list only.
+type patchOp struct {
+	Op    string `json:"op"`
+	From  string `json:"from,omitempty"`
+	Path  string `json:"path"`
+	Value *any   `json:"value,omitempty"`
+	old   *any
+}
+
+type structDiff struct {
+	arrayKey string
+	ops      []patchOp
+}
+
+func (d *structDiff) emit(op, path string, old, value any, hasOld, hasValue bool) {
+	p := patchOp{Op: op, Path: path}
+	if hasOld {
+		p.old = &old
+	}
+	if hasValue {
+		p.Value = &value
+	}
+	d.ops = append(d.ops, p)
+}
+
+func (d *structDiff) diff(path string, a, b any) {
+	switch av := a.(type) {
+	case map[string]any:
+		if bv, ok := b.(map[string]any); ok {
+			d.diffObjects(path, av, bv)
+			return
+		}
+	case []any:
+		if bv, ok := b.([]any); ok {
+			if d.arrayKey != "" && keyedArray(av, d.arrayKey) && keyedArray(bv, d.arrayKey) {
+				d.diffKeyedArrays(path, av, bv)
+			} else {
+				d.diffArrays(path, av, bv)
+			}
+			return
+		}
+	}
+	if !reflect.DeepEqual(a, b) {
+		d.emit("replace", path, a, b, true, true)
+	}
+}
+
+func (d *structDiff) diffObjects(path string, a, b map[string]any) {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		p := path + "/" + escapePointer(k)
+		av, inA := a[k]
+		bv, inB := b[k]
+		switch {
+		case !inB:
+			d.emit("remove", p, av, nil, true, false)
+		case !inA:
+			d.emit("add", p, nil, bv, false, true)
+		default:
+			d.diff(p, av, bv)
+		}
+	}
+}
+
+// diffArrays compares arrays position by position. Surplus elements are
+// removed from the end first so every index stays valid as ops apply.
+func (d *structDiff) diffArrays(path string, a, b []any) {
+	n := min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		d.diff(path+"/"+strconv.Itoa(i), a[i], b[i])
+	}
+	for i := len(a) - 1; i >= n; i-- {
+		d.emit("remove", path+"/"+strconv.Itoa(i), a[i], nil, true, false)
+	}
+	for i := n; i < len(b); i++ {
+		d.emit("add", path+"/"+strconv.Itoa(i), nil, b[i], false, true)
+	}
+}
+
+// keyedArray reports whether every element is an object carrying a unique
+// scalar value under key.
+func keyedArray(arr []any, key string) bool {
+	seen := make(map[string]bool, len(arr))
+	for _, item := range arr {
+		m, ok := item.(map[string]any)
+		if !ok {
+			return false
+		}
+		k, ok := m[key]
+		if !ok {
+			return false
+		}
+		switch k.(type) {
+		case map[string]any, []any:
+			return false
+		}
+		id := compactJSON(k)
+		if seen[id] {
+			return false
+		}
+		seen[id] = true
+	}
+	return true
+}
+
+// diffKeyedArrays matches elements by their key field. Unmatched elements
+// of a are removed, the survivors are moved into b's order while new
+// elements are inserted, and finally matched pairs are diffed in place.
+func (d *structDiff) diffKeyedArrays(path string, a, b []any) {
+	id := func(item any) string { return compactJSON(item.(map[string]any)[d.arrayKey]) }
+	inB := make(map[string]bool, len(b))
+	for _, item := range b {
+		inB[id(item)] = true
+	}
+	byID := make(map[string]any, len(a))
+	var work []string
+	for _, item := range a {
+		byID[id(item)] = item
+		work = append(work, id(item))
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		if !inB[work[i]] {
+			d.emit("remove", path+"/"+strconv.Itoa(i), a[i], nil, true, false)
+			work = slices.Delete(work, i, i+1)
+		}
+	}
+	for i, item := range b {
+		key := id(item)
+		if _, matched := byID[key]; !matched {
+			d.emit("add", path+"/"+strconv.Itoa(i), nil, item, false, true)
+			work = slices.Insert(work, i, key)
+			continue
+		}
+		j := slices.Index(work, key)
+		if j != i {
+			d.ops = append(d.ops, patchOp{Op: "move", From: path + "/" + strconv.Itoa(j), Path: path + "/" + strconv.Itoa(i)})
+			work = slices.Delete(work, j, j+1)
+			work = slices.Insert(work, i, key)
+		}
+	}
+	for i, item := range b {
+		if old, matched := byID[id(item)]; matched {
+			d.diff(path+"/"+strconv.Itoa(i), old, item)
+		}
+	}
+}
+
+// mergeDiff builds the RFC 7386 merge patch turning a into b. Object members
+// whose new value is null cannot be expressed, as null means removal.
+func mergeDiff(a, b any) any {
+	am, ok1 := a.(map[string]any)
+	bm, ok2 := b.(map[string]any)
+	if !ok1 || !ok2 {
+		return b
+	}
+	patch := map[string]any{}
+	for k := range am {
+		if _, ok := bm[k]; !ok {
+			patch[k] = nil
+		}
+	}
+	for k, bv := range bm {
+		av, ok := am[k]
+		if ok && reflect.DeepEqual(av, bv) {
+			continue
+		}
+		if _, isObj := av.(map[string]any); ok && isObj {
+			patch[k] = mergeDiff(av, bv)
+		} else {
+			patch[k] = bv
+		}
+	}
+	return patch
+}
+
+// ── Patch application ─────────────────────────────────────────────────────────
+
+func applyMergePatch(target, patch any) any {
+	pm, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	tm, ok := target.(map[string]any)
+	if !ok {
+		tm = map[string]any{}
+	}
+	out := make(map[string]any, len(tm))
+	for k, v := range tm {
+		out[k] = v
+	}
+	for k, v := range pm {
+		if v == nil {
+			delete(out, k)
+		} else {
+			out[k] = applyMergePatch(out[k], v)
+		}
+	}
+	return out
+}
+
+// applyJSONPatch applies the operations in order. The patch is atomic: on
+// any failure the original document is left untouched.
+func applyJSONPatch(doc, patch any) (any, error) {
+	ops, ok := patch.([]any)
+	if !ok {
+		return nil, fmt.Errorf("JSON Patch must be an array of operations")
+	}
+	doc = deepCopy(doc)
+	for i, raw := range ops {
+		op, ok := raw.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("operation %d: not an object", i)
+		}
+		var err error
+		doc, err = applyPatchOp(doc, op)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d (%v): %v", i, op["op"], err)
+		}
+	}
+	return doc, nil
+}
+
+func applyPatchOp(doc any, op map[string]any) (any, error) {
+	name, _ := op["op"].(string)
+	path, ok := op["path"].(string)
+	if !ok {
+		return nil, fmt.Errorf("missing \"path\"")
+	}
+	tokens, err := parsePointer(path)
+	if err != nil {
+		return nil, err
+	}
+	fromTokens := func() ([]string, error) {
+		from, ok := op["from"].(string)
+		if !ok {
+			return nil, fmt.Errorf("missing \"from\"")
+		}
+		return parsePointer(from)
+	}
+	value, hasValue := op["value"]
+
+	switch name {
+	case "add":
+		if !hasValue {
+			return nil, fmt.Errorf("missing \"value\"")
+		}
+		return pointerAdd(doc, tokens, deepCopy(value))
+	case "remove":
+		doc, _, err = pointerRemove(doc, tokens)
+		return doc, err
+	case "replace":
+		if !hasValue {
+			return nil, fmt.Errorf("missing \"value\"")
+		}
+		if doc, _, err = pointerRemove(doc, tokens); err != nil {
+			return nil, err
+		}
+		return pointerAdd(doc, tokens, deepCopy(value))
+	case "move", "copy":
+		from, err := fromTokens()
+		if err != nil {
+			return nil, err
+		}
+		if name == "move" && len(tokens) > len(from) && slices.Equal(tokens[:len(from)], from) {
+			return nil, fmt.Errorf("cannot move a value into one of its children")
+		}
+		var v any
+		if name == "move" {
+			doc, v, err = pointerRemove(doc, from)
+		} else {
+			v, err = pointerGet(doc, from)
+			v = deepCopy(v)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return pointerAdd(doc, tokens, v)
+	case "test":
+		if !hasValue {
+			return nil, fmt.Errorf("missing \"value\"")
+		}
+		v, err := pointerGet(doc, tokens)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(v, query.Normalize(value)) {
+			return nil, fmt.Errorf("test failed: %s is %s", path, compactJSON(v))
+		}
+		return doc, nil
+	}
+	return nil, fmt.Errorf("unknown op %q", name)
+}
+
+func escapePointer(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1")
+}
+
+// parsePointer splits an RFC 6901 JSON Pointer into unescaped tokens.
+func parsePointer(p string) ([]string, error) {
+	if p == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(p, "/") {
+		return nil, fmt.Errorf("invalid JSON Pointer %q: must start with /", p)
+	}
+	parts := strings.Split(p[1:], "/")
+	for i, part := range parts {
+		parts[i] = strings.ReplaceAll(strings.ReplaceAll(part, "~1", "/"), "~0", "~")
+	}
+	return parts, nil
+}
+
+// arrayIndex resolves token against an array of length n; allowEnd admits
+// the position one past the last element (and "-").
+func arrayIndex(token string, n int, allowEnd bool) (int, error) {
+	if token == "-" && allowEnd {
+		return n, nil
+	}
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	if i > n || (i == n && !allowEnd) {
+		return 0, fmt.Errorf("array index %d out of range", i)
+	}
+	return i, nil
+}
+
+func pointerGet(doc any, tokens []string) (any, error) {
+	cur := doc
+	for _, t := range tokens {
+		switch c := cur.(type) {
+		case map[string]any:
+			v, ok := c[t]
+			if !ok {
+				return nil, fmt.Errorf("path member %q not found", t)
+			}
+			cur = v
+		case []any:
+			i, err := arrayIndex(t, len(c), false)
+			if err != nil {
+				return nil, err
+			}
+			cur = c[i]
+		default:
+			return nil, fmt.Errorf("cannot descend into %s at %q", compactJSON(cur), t)
+		}
+	}
+	return cur, nil
+}
+
+// pointerAdd returns doc with v added at tokens. Containers are rebuilt on
+// the way back up because inserting into a slice may reallocate it.
+func pointerAdd(doc any, tokens []string, v any) (any, error) {
+	if len(tokens) == 0 {
+		return v, nil
+	}
+	t := tokens[0]
+	switch c := doc.(type) {
+	case map[string]any:
+		if len(tokens) == 1 {
+			c[t] = v
+			return c, nil
+		}
+		child, ok := c[t]
+		if !ok {
+			return nil, fmt.Errorf("path member %q not found", t)
+		}
+		nc, err := pointerAdd(child, tokens[1:], v)
+		if err != nil {
+			return nil, err
+		}
+		c[t] = nc
+		return c, nil
+	case []any:
+		if len(tokens) == 1 {
+			i, err := arrayIndex(t, len(c), true)
+			if err != nil {
+				return nil, err
+			}
+			return slices.Insert(c, i, v), nil
+		}
+		i, err := arrayIndex(t, len(c), false)
+		if err != nil {
+			return nil, err
+		}
+		nc, err := pointerAdd(c[i], tokens[1:], v)
+		if err != nil {
+			return nil, err
+		}
+		c[i] = nc
+		return c, nil
+	}
+	return nil, fmt.Errorf("cannot add into %s at %q", compactJSON(doc), t)
+}
+
+// pointerRemove returns doc without the value at tokens, and that value.
+func pointerRemove(doc any, tokens []string) (any, any, error) {
+	if len(tokens) == 0 {
+		return nil, doc, nil
+	}
+	t := tokens[0]
+	switch c := doc.(type) {
+	case map[string]any:
+		child, ok := c[t]
+		if !ok {
+			return nil, nil, fmt.Errorf("path member %q not found", t)
+		}
+		if len(tokens) == 1 {
+			delete(c, t)
+			return c, child, nil
+		}
+		nc, removed, err := pointerRemove(child, tokens[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+		c[t] = nc
+		return c, removed, nil
+	case []any:
+		i, err := arrayIndex(t, len(c), false)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(tokens) == 1 {
+			removed := c[i]
+			return slices.Delete(c, i, i+1), removed, nil
+		}
+		nc, removed, err := pointerRemove(c[i], tokens[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+		c[i] = nc
+		return c, removed, nil
+	}
+	return nil, nil, fmt.Errorf("cannot remove from %s at %q", compactJSON(doc), t)
+}
+
+func deepCopy(v any) any {
+	switch c := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(c))
+		for k, item := range c {
+			out[k] = deepCopy(item)
+		}
+		return out
+	case []any:
+		out := make([]any, len(c))
+		for i, item := range c {
+			out[i] = deepCopy(item)
+		}
+		return out
+	}
+	return v
+}
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+var datadiffSrv = &Server{}
+
+func TestDataDiff_KeyOrderInsensitive(t *testing.T) {
+	resp, err := datadiffSrv.DataDiff(context.Background(), &pb.DataDiffRequest{
+		Left:  `{"a":1,"b":{"x":[1,2]}}`,
+		Right: `{"b":{"x":[1,2]},"a":1}`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Equal || resp.Summary != "No differences" || resp.JsonPatch != "[]" || resp.MergePatch != "{}" {
+		t.Errorf("expected no differences, got %+v", resp)
+	}
+}
+
+func TestDataDiff_Changes(t *testing.T) {
+	resp, _ := datadiffSrv.DataDiff(context.Background(), &pb.DataDiffRequest{
+		Left:        `{"name":"a","port":80,"tags":["x","y","z"],"old":true}`,
+		Right:       "name: b\nport: 80\ntags: [x]\nnew: null\n",
+		RightFormat: pb.DataFormat_YAML,
+	})
+	if resp.Error != "" {
+		t.Fatal(resp.Error)
+	}
+	want := []string{
+		`~ /name: "a" → "b"`,
+		`+ /new: null`,
+		`- /old: true`,
+		`- /tags/2: "z"`,
+		`- /tags/1: "y"`,
+	}
+	if resp.Summary != strings.Join(want, "\n") {
+		t.Errorf("summary =\n%s\nwant\n%s", resp.Summary, strings.Join(want, "\n"))
+	}
+	var merge map[string]any
+	if err := json.Unmarshal([]byte(resp.MergePatch), &merge); err != nil {
+		t.Fatal(err)
+	}
+	wantMerge := map[string]any{"name": "b", "old": nil, "tags": []any{"x"}, "new": nil}
+	if !reflect.DeepEqual(merge, wantMerge) {
+		t.Errorf("merge patch = %v", merge)
+	}
+}
+
+func TestDataDiff_ArrayKey(t *testing.T) {
+	left := `[{"id":1,"v":"a"},{"id":2,"v":"b"},{"id":3,"v":"c"}]`
+	right := `[{"id":3,"v":"c"},{"id":4,"v":"d"},{"id":1,"v":"A"}]`
+	resp, _ := datadiffSrv.DataDiff(context.Background(), &pb.DataDiffRequest{Left: left, Right: right, ArrayKey: "id"})
+	if resp.Error != "" {
+		t.Fatal(resp.Error)
+	}
+	ops := make([]string, len(resp.Changes))
+	for i, c := range resp.Changes {
+		ops[i] = c.Op + " " + c.Path
+	}
+	want := "remove /1,move /0,add /1,replace /2/v"
+	if strings.Join(ops, ",") != want {
+		t.Errorf("ops = %v, want %s", ops, want)
+	}
+	assertRoundTrip(t, left, right, resp.JsonPatch)
+}
+
+func TestDataDiff_PatchRoundTrip(t *testing.T) {
+	tests := []struct{ left, right string }{
+		{`{"a":[1,2,3],"b":{"c":null}}`, `{"a":[1,5],"b":{"c":null,"d/e":"~"}}`},
+		{`[1,2]`, `[1,2,3,4]`},
+		{`{"a":1}`, `["root","replaced"]`},
+		{`{"a":{"b":[{"c":1}]}}`, `{"a":{"b":[{"c":2},{}]}}`},
+	}
+	for _, tt := range tests {
+		resp, _ := datadiffSrv.DataDiff(context.Background(), &pb.DataDiffRequest{Left: tt.left, Right: tt.right})
+		if resp.Error != "" {
+			t.Fatal(resp.Error)
+		}
+		assertRoundTrip(t, tt.left, tt.right, resp.JsonPatch)
+	}
+}
+
+func assertRoundTrip(t *testing.T, left, right, patch string) {
+	t.Helper()
+	resp, _ := datadiffSrv.DataPatch(context.Background(), &pb.DataPatchRequest{Document: left, Patch: patch})
+	if resp.Error != "" {
+		t.Fatalf("apply %s: %s", patch, resp.Error)
+	}
+	var got, want any
+	json.Unmarshal([]byte(resp.Result), &got)
+	json.Unmarshal([]byte(right), &want)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("patched %s with %s = %s, want %s", left, patch, resp.Result, right)
+	}
+}
+
+func TestDataPatch_JSONPatch(t *testing.T) {
+	patch := `[
+		{"op":"test","path":"/a","value":1},
+		{"op":"copy","from":"/a","path":"/b"},
+		{"op":"move","from":"/list/0","path":"/list/-"},
+		{"op":"add","path":"/list/0","value":"first"},
+		{"op":"remove","path":"/gone"}
+	]`
+	resp, _ := datadiffSrv.DataPatch(context.Background(), &pb.DataPatchRequest{
+		Document: `{"a":1,"list":["x","y"],"gone":0}`,
+		Patch:    patch,
+	})
+	if resp.Error != "" {
+		t.Fatal(resp.Error)
+	}
+	var got any
+	json.Unmarshal([]byte(resp.Result), &got)
+	want := map[string]any{"a": 1.0, "b": 1.0, "list": []any{"first", "y", "x"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("result = %s", resp.Result)
+	}
+}
+
+func TestDataPatch_Errors(t *testing.T) {
+	tests := []struct {
+		patch string
+		want  string
+	}{
+		{`{"op":"add"}`, "must be an array"},
+		{`[{"op":"test","path":"/a","value":2}]`, "test failed"},
+		{`[{"op":"remove","path":"/missing"}]`, "not found"},
+		{`[{"op":"add","path":"/l/5","value":0}]`, "out of range"},
+		{`[{"op":"move","from":"/l","path":"/l/0"}]`, "into one of its children"},
+		{`[{"op":"frobnicate","path":""}]`, "unknown op"},
+	}
+	for _, tt := range tests {
+		resp, _ := datadiffSrv.DataPatch(context.Background(), &pb.DataPatchRequest{
+			Document: `{"a":1,"l":[]}`,
+			Patch:    tt.patch,
+		})
+		if !strings.Contains(resp.Error, tt.want) {
+			t.Errorf("%s: error = %q, want it to contain %q", tt.patch, resp.Error, tt.want)
+		}
+	}
+}
+
+func TestDataPatch_MergePatchYAML(t *testing.T) {
+	resp, _ := datadiffSrv.DataPatch(context.Background(), &pb.DataPatchRequest{
+		Document:  "title: Goodbye!\nauthor:\n  givenName: John\n  familyName: Doe\ntags: [example, sample]\n",
+		Format:    pb.DataFormat_YAML,
+		Patch:     `{"title":"Hello!","author":{"familyName":null},"tags":["example"]}`,
+		PatchType: pb.PatchType_PATCH_MERGE,
+	})
+	want := "author:\n    givenName: John\ntags:\n    - example\ntitle: Hello!\n"
+	if resp.Error != "" || resp.Result != want {
+		t.Errorf("result = %q, error = %q", resp.Result, resp.Error)
+	}
+}
@@ -0,0 +1,648 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/odinnordico/privutil/internal/fakedata"
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+// ── Fake data ─────────────────────────────────────────────────────────────────
+//
+// GenerateFakeData accepts either a JSON Schema or a field template. In a
+// template, each value says how to fill its field:
+//
+//	"person.fullName", "number.int(18, 65)"  a generator, with optional arguments
+//	"{{person.firstName}}-{{string.numeric(4)}}"  text with embedded generators
+//	"/[A-Z]{3}-\\d{4}/"                      a string matching the pattern
+//	["red", "green", "blue"]                 one of the listed values
+//	[{"sku": "string.alphanumeric(8)"}]      1–3 items built from the template
+//	{"city": "location.city"}                a nested object
+//	42, true, null                           the literal value
+//
+// A JSON Schema is recognised by $schema, a type keyword or properties
+// holding schemas; it honours type, properties, required, items, enum, const,
+// minimum/maximum, minLength/maxLength, minItems/maxItems, pattern, format,
+// $ref to local definitions and anyOf/oneOf/allOf. Where a string property
+// has no format or pattern, its name picks a fitting generator (email,
+// city, phone…); an "x-faker" keyword selects one explicitly.
+
+const (
+	fakeMaxRecords = 10000
+	fakeMaxDepth   = 32
+	fakeMaxItems   = 1000  // per generated array
+	fakeMaxLength  = 10000 // per generated string
+	// fakeMaxWork bounds a whole request, which the limits above do not:
+	// every generated value costs one, plus one per byte of text.
+	fakeMaxWork = 1 << 22
+)
+
+var errFakeTooLarge = fmt.Errorf("the data would be too large (over %d values and bytes of text); lower count, minItems or maxLength", fakeMaxWork)
+
+func (s *Server) GenerateFakeData(ctx context.Context, req *pb.FakeDataRequest) (*pb.FakeDataResponse, error) {
+	resp := &pb.FakeDataResponse{Generators: fakedata.Generators()}
+	for _, l := range fakedata.Locales() {
+		resp.Locales = append(resp.Locales, l.Code)
+	}
+	fail := func(format string, args ...any) (*pb.FakeDataResponse, error) {
+		resp.Error = fmt.Sprintf(format, args...)
+		return resp, nil
+	}
+
+	count := int(req.Count)
+	if count <= 0 {
+		count = 10
+	}
+	if count > fakeMaxRecords {
+		return fail("at most %d records can be generated", fakeMaxRecords)
+	}
+	if strings.TrimSpace(req.Schema) == "" {
+		return fail("a JSON Schema or field template is required")
+	}
+	spec, err := decodeOrderedJSON(req.Schema)
+	if err != nil {
+		return fail("Invalid template: %v", err)
+	}
+
+	ref := time.Now().UTC().Truncate(24 * time.Hour)
+	if req.ReferenceDate != "" {
+		if ref, err = time.Parse(time.DateOnly, req.ReferenceDate); err != nil {
+			if ref, err = time.Parse(time.RFC3339, req.ReferenceDate); err != nil {
+				return fail("reference date must be YYYY-MM-DD or RFC 3339")
+			}
+			ref = ref.UTC()
+		}
+	}
+	resp.Seed = req.Seed
+	if resp.Seed == 0 {
+		var b [8]byte
+		_, _ = rand.Read(b[:])
+		resp.Seed = int64(binary.BigEndian.Uint64(b[:]) >> 1) // #nosec G115
+	}
+	f, err := fakedata.New(resp.Seed, req.Locale, ref)
+	if err != nil {
+		return fail("%v", err)
+	}
+
+	g := &fakeGen{f: f}
+	build := g.template
+	if isJSONSchema(spec) {
+		g.root = spec
+		build = func(v any, depth int) (any, error) { return g.schema(v, "", depth) }
+	}
+	records := make([]any, count)
+	for i := range records {
+		if err := ctx.Err(); err != nil {
+			return fail("%v", err)
+		}
+		f.NextRecord()
+		if records[i], err = build(spec, 0); err != nil {
+			return fail("%v", err)
+		}
+	}
+
+	var data any = records
+	switch req.Format {
+	case pb.DataFormat_TOML, pb.DataFormat_HCL, pb.DataFormat_INI, pb.DataFormat_ENV, pb.DataFormat_PROPERTIES:
+		data = &orderedObject{keys: []string{"records"}, values: map[string]any{"records": records}}
+	}
+	out, err := marshalTarget(data, &pb.ConvertRequest{
+		TargetFormat: req.Format,
+		CsvFlatten:   true,
+		XmlRoot:      "records",
+		XmlArrayItem: "record",
+	})
+	if err != nil {
+		return fail("Conversion failed: %v", err)
+	}
+	resp.Data = string(out)
+	return resp, nil
+}
+
+// isJSONSchema tells a schema from a template: a schema has $schema, a
+// type naming a JSON type, or properties that are all schemas. Templates
+// can use any of those words as field names, but not with those values.
+func isJSONSchema(spec any) bool {
+	obj, ok := spec.(*orderedObject)
+	if !ok {
+		return false
+	}
+	if _, ok := obj.values["$schema"]; ok {
+		return true
+	}
+	switch t := obj.values["type"].(type) {
+	case string:
+		return schemaTypes[t]
+	case []any:
+		for _, name := range t {
+			if s, _ := name.(string); !schemaTypes[s] {
+				return false
+			}
+		}
+		return len(t) > 0
+	}
+	props, ok := obj.values["properties"].(*orderedObject)
+	if !ok || len(props.keys) == 0 {
+		return false
+	}
+	for _, v := range props.values {
+		if _, isObj := v.(*orderedObject); !isObj {
+			return false
+		}
+	}
+	return true
+}
+
+var schemaTypes = map[string]bool{
+	"object": true, "array": true, "string": true, "number": true, "integer": true, "boolean": true, "null": true,
+}
+
+type fakeGen struct {
+	f    *fakedata.Faker
+	root any // the schema, for $ref
+	work int // spent so far, against fakeMaxWork
+}
+
+// spend charges n to the request's work budget.
+func (g *fakeGen) spend(n int) error {
+	if g.work += n; g.work > fakeMaxWork {
+		return errFakeTooLarge
+	}
+	return nil
+}
+
+// text charges a generated string by its length.
+func (g *fakeGen) text(v any, err error) (any, error) {
+	if s, ok := v.(string); ok && err == nil {
+		err = g.spend(len(s))
+	}
+	return v, err
+}
+
+var interpolationRe = regexp.MustCompile(`\{\{\s*([^{}]+?)\s*\}\}`)
+
+func (g *fakeGen) template(spec any, depth int) (any, error) {
+	if depth > fakeMaxDepth {
+		return nil, fmt.Errorf("template nesting exceeds %d levels", fakeMaxDepth)
+	}
+	if err := g.spend(1); err != nil {
+		return nil, err
+	}
+	switch t := spec.(type) {
+	case string:
+		switch {
+		case len(t) >= 2 && strings.HasPrefix(t, "/") && strings.HasSuffix(t, "/"):
+			return g.text(g.f.Pattern(t[1 : len(t)-1]))
+		case strings.Contains(t, "{{"):
+			var firstErr error
+			out := interpolationRe.ReplaceAllStringFunc(t, func(m string) string {
+				v, err := g.f.Generate(interpolationRe.FindStringSubmatch(m)[1])
+				if err != nil {
+					firstErr = cmp.Or(firstErr, err)
+					return ""
+				}
+				return cellText(v)
+			})
+			return g.text(out, firstErr)
+		}
+		return g.text(g.f.Generate(t))
+	case []any:
+		if len(t) == 1 && isContainer(t[0]) {
+			items := make([]any, 1+g.f.Intn(3))
+			for i := range items {
+				v, err := g.template(t[0], depth+1)
+				if err != nil {
+					return nil, err
+				}
+				items[i] = v
+			}
+			return items, nil
+		}
+		if len(t) == 0 {
+			return []any{}, nil
+		}
+		return convertJSONNumbers(t[g.f.Intn(len(t))]), nil
+	case *orderedObject:
+		obj := &orderedObject{keys: t.keys, values: make(map[string]any, len(t.keys))}
+		for _, k := range t.keys {
+			v, err := g.template(t.values[k], depth+1)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			obj.values[k] = v
+		}
+		return obj, nil
+	}
+	return convertJSONNumbers(spec), nil
+}
+
+func isContainer(v any) bool {
+	switch v.(type) {
+	case []any, *orderedObject:
+		return true
+	}
+	return false
+}
+
+// schema generates a value for a JSON Schema. name is the property name,
+// used to pick a realistic generator for plain strings.
+func (g *fakeGen) schema(spec any, name string, depth int) (any, error) {
+	if depth > fakeMaxDepth {
+		return nil, fmt.Errorf("schema nesting exceeds %d levels (recursive $ref?)", fakeMaxDepth)
+	}
+	if err := g.spend(1); err != nil {
+		return nil, err
+	}
+	s, ok := spec.(*orderedObject)
+	if !ok {
+		if b, isBool := spec.(bool); isBool && b {
+			return g.text(g.f.Generate("lorem.word"))
+		}
+		return nil, fmt.Errorf("%s: schema must be an object", schemaPath(name))
+	}
+	if ref, ok := s.values["$ref"].(string); ok {
+		target, err := g.resolveRef(ref)
+		if err != nil {
+			return nil, err
+		}
+		return g.schema(target, name, depth+1)
+	}
+	if v, ok := s.values["const"]; ok {
+		return convertJSONNumbers(v), nil
+	}
+	if enum, ok := s.values["enum"].([]any); ok && len(enum) > 0 {
+		return convertJSONNumbers(enum[g.f.Intn(len(enum))]), nil
+	}
+	for _, k := range []string{"oneOf", "anyOf"} {
+		if alts, ok := s.values[k].([]any); ok && len(alts) > 0 {
+			return g.schema(alts[g.f.Intn(len(alts))], name, depth+1)
+		}
+	}
+	if all, ok := s.values["allOf"].([]any); ok && len(all) > 0 {
+		merged := &orderedObject{values: map[string]any{}}
+		mergeSchema(merged, s)
+		for _, part := range all {
+			if p, ok := part.(*orderedObject); ok {
+				mergeSchema(merged, p)
+			}
+		}
+		delete(merged.values, "allOf")
+		return g.schema(merged, name, depth+1)
+	}
+
+	switch typ := schemaType(s, g.f); typ {
+	case "null":
+		return nil, nil
+	case "boolean":
+		return g.f.Chance(0.5), nil
+	case "integer", "number":
+		return g.number(s, name, typ == "integer")
+	case "array":
+		return g.array(s, name, depth)
+	case "object":
+		return g.object(s, depth)
+	}
+	return g.text(g.str(s, name))
+}
+
+// schemaType picks the type to generate. A nullable type list yields null
+// one time in ten.
+func schemaType(s *orderedObject, f *fakedata.Faker) string {
+	switch t := s.values["type"].(type) {
+	case string:
+		return t
+	case []any:
+		var types []string
+		nullable := false
+		for _, v := range t {
+			if name, _ := v.(string); name == "null" {
+				nullable = true
+			} else if name != "" {
+				types = append(types, name)
+			}
+		}
+		if len(types) == 0 || nullable && f.Chance(0.1) {
+			return "null"
+		}
+		return types[f.Intn(len(types))]
+	}
+	switch {
+	case s.values["properties"] != nil:
+		return "object"
+	case s.values["items"] != nil:
+		return "array"
+	case s.values["minimum"] != nil || s.values["maximum"] != nil:
+		return "number"
+	}
+	return "string"
+}
+
+// mergeSchema adds the keywords of src to dst, for allOf. Properties and
+// required lists are combined; other keywords are replaced. The schemas
+// themselves are left untouched.
+func mergeSchema(dst, src *orderedObject) {
+	for _, k := range src.keys {
+		v := src.values[k]
+		if prev, ok := dst.values[k].(*orderedObject); ok && k == "properties" {
+			if next, ok := v.(*orderedObject); ok {
+				props := &orderedObject{keys: slices.Clone(prev.keys), values: maps.Clone(prev.values)}
+				for _, name := range next.keys {
+					if _, exists := props.values[name]; !exists {
+						props.keys = append(props.keys, name)
+					}
+					props.values[name] = next.values[name]
+				}
+				dst.values[k] = props
+				continue
+			}
+		}
+		if prev, ok := dst.values[k].([]any); ok && k == "required" {
+			if next, ok := v.([]any); ok {
+				dst.values[k] = append(append([]any{}, prev...), next...)
+				continue
+			}
+		}
+		if _, exists := dst.values[k]; !exists {
+			dst.keys = append(dst.keys, k)
+		}
+		dst.values[k] = v
+	}
+}
+
+// resolveRef follows a local JSON Pointer reference such as
+// "#/$defs/address".
+func (g *fakeGen) resolveRef(ref string) (any, error) {
+	if !strings.HasPrefix(ref, "#") {
+		return nil, fmt.Errorf("only local $ref is supported, got %q", ref)
+	}
+	cur := g.root
+	for _, part := range strings.Split(strings.TrimPrefix(ref, "#"), "/")[1:] {
+		part = strings.NewReplacer("~1", "/", "~0", "~").Replace(part)
+		obj, ok := cur.(*orderedObject)
+		if !ok {
+			return nil, fmt.Errorf("$ref %q does not resolve", ref)
+		}
+		if cur, ok = obj.values[part]; !ok {
+			return nil, fmt.Errorf("$ref %q does not resolve", ref)
+		}
+	}
+	return cur, nil
+}
+
+func (g *fakeGen) object(s *orderedObject, depth int) (any, error) {
+	obj := &orderedObject{values: map[string]any{}}
+	props, _ := s.values["properties"].(*orderedObject)
+	if props == nil {
+		return obj, nil
+	}
+	required := map[string]bool{}
+	if req, ok := s.values["required"].([]any); ok {
+		for _, r := range req {
+			if k, ok := r.(string); ok {
+				required[k] = true
+			}
+		}
+	}
+	for _, k := range props.keys {
+		// Optional properties are left out now and then, as real data does.
+		if !required[k] && g.f.Chance(0.2) {
+			continue
+		}
+		v, err := g.schema(props.values[k], k, depth+1)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		obj.keys = append(obj.keys, k)
+		obj.values[k] = v
+	}
+	return obj, nil
+}
+
+func (g *fakeGen) array(s *orderedObject, name string, depth int) (any, error) {
+	lo, hi := schemaInt(s, "minItems", 1), schemaInt(s, "maxItems", -1)
+	if hi < 0 {
+		hi = lo + 2
+	}
+	if lo > fakeMaxItems {
+		return nil, fmt.Errorf("%s: minItems is limited to %d", schemaPath(name), fakeMaxItems)
+	}
+	n := int(g.f.Int(lo, min(max(lo, hi), fakeMaxItems)))
+	prefix, _ := s.values["prefixItems"].([]any)
+	items := s.values["items"]
+	if tuple, ok := items.([]any); ok { // draft 4–7 tuple form
+		prefix, items = tuple, s.values["additionalItems"]
+	}
+	if items == nil || items == false {
+		n = min(n, len(prefix))
+	}
+	unique, _ := s.values["uniqueItems"].(bool)
+
+	out := make([]any, 0, n)
+	seen := map[string]bool{}
+	for attempts := 0; len(out) < n && attempts < n*10; attempts++ {
+		item := items
+		if len(out) < len(prefix) {
+			item = prefix[len(out)]
+		}
+		if item == nil {
+			item = &orderedObject{values: map[string]any{}}
+		}
+		v, err := g.schema(item, singularize(name), depth+1)
+		if err != nil {
+			return nil, err
+		}
+		if unique {
+			key := compactJSON(v)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+func (g *fakeGen) number(s *orderedObject, name string, integer bool) (any, error) {
+	lo, hi := schemaFloat(s, "minimum", math.NaN()), schemaFloat(s, "maximum", math.NaN())
+	if x := schemaFloat(s, "exclusiveMinimum", math.NaN()); !math.IsNaN(x) {
+		lo = x + exclusiveStep(integer)
+	} else if b, _ := s.values["exclusiveMinimum"].(bool); b && !math.IsNaN(lo) { // draft 4
+		lo += exclusiveStep(integer)
+	}
+	if x := schemaFloat(s, "exclusiveMaximum", math.NaN()); !math.IsNaN(x) {
+		hi = x - exclusiveStep(integer)
+	} else if b, _ := s.values["exclusiveMaximum"].(bool); b && !math.IsNaN(hi) {
+		hi -= exclusiveStep(integer)
+	}
+	defLo, defHi := 0.0, 1000.0
+	switch key := strings.ToLower(name); {
+	case key == "age":
+		defLo, defHi = 18, 90
+	case strings.Contains(key, "year"):
+		defLo, defHi = 1970, float64(g.f.Reference().Year())
+	case strings.Contains(key, "price") || strings.Contains(key, "amount") || strings.Contains(key, "total"):
+		defLo, defHi = 1, 500
+	}
+	switch {
+	case math.IsNaN(lo) && math.IsNaN(hi):
+		lo, hi = defLo, defHi
+	case math.IsNaN(lo):
+		lo = math.Min(defLo, hi)
+	case math.IsNaN(hi):
+		hi = lo + (defHi - defLo)
+	}
+	if hi < lo {
+		return nil, fmt.Errorf("%s: minimum %v is above maximum %v", schemaPath(name), lo, hi)
+	}
+
+	if step := schemaFloat(s, "multipleOf", 0); step > 0 {
+		first, last := math.Ceil(lo/step), math.Floor(hi/step)
+		if last < first {
+			return nil, fmt.Errorf("%s: no multiple of %v between %v and %v", schemaPath(name), step, lo, hi)
+		}
+		v := float64(g.f.Int(int64(first), int64(last))) * step
+		if integer {
+			return int64(math.Round(v)), nil
+		}
+		return v, nil
+	}
+	if integer {
+		return g.f.Int(int64(math.Ceil(lo)), int64(math.Floor(hi))), nil
+	}
+	return math.Round(g.f.Float(lo, hi)*100) / 100, nil
+}
+
+func exclusiveStep(integer bool) float64 {
+	if integer {
+		return 1
+	}
+	return 0.01
+}
+
+// stringFormats maps JSON Schema formats to generators.
+var stringFormats = map[string]string{
+	"email": "internet.email", "idn-email": "internet.email",
+	"uri": "internet.url", "url": "internet.url", "iri": "internet.url",
+	"hostname": "internet.domainName", "idn-hostname": "internet.domainName",
+	"ipv4": "internet.ipv4", "ipv6": "internet.ipv6", "uuid": "string.uuid",
+	"date-time": "date.past(2)", "date": "date.past(2)", "time": "date.past",
+}
+
+// nameGenerators picks a generator from a property name, compared in lower
+// case without separators.
+var nameGenerators = map[string]string{
+	"email": "internet.email", "emailaddress": "internet.email", "mail": "internet.email",
+	"firstname": "person.firstName", "givenname": "person.firstName", "lastname": "person.lastName",
+	"surname": "person.lastName", "familyname": "person.lastName", "name": "person.fullName",
+	"fullname": "person.fullName", "displayname": "person.fullName", "username": "internet.userName",
+	"login": "internet.userName", "handle": "internet.userName", "password": "internet.password",
+	"gender": "person.sex", "sex": "person.sex", "jobtitle": "person.jobTitle", "occupation": "person.jobTitle",
+	"phone": "phone.number", "phonenumber": "phone.number", "mobile": "phone.number", "tel": "phone.number",
+	"telephone": "phone.number", "street": "location.streetAddress", "streetaddress": "location.streetAddress",
+	"address": "location.streetAddress", "address1": "location.streetAddress",
+	"addressline1": "location.streetAddress", "city": "location.city", "town": "location.city",
+	"state": "location.state", "region": "location.state", "province": "location.state",
+	"zip": "location.zipCode", "zipcode": "location.zipCode", "postcode": "location.zipCode",
+	"postalcode": "location.zipCode", "country": "location.country", "countrycode": "location.countryCode",
+	"company": "company.name", "companyname": "company.name", "organization": "company.name",
+	"employer": "company.name", "url": "internet.url", "website": "internet.url", "homepage": "internet.url",
+	"domain": "internet.domainName", "hostname": "internet.domainName", "ip": "internet.ipv4",
+	"ipaddress": "internet.ipv4", "mac": "internet.mac", "macaddress": "internet.mac",
+	"useragent": "internet.userAgent", "id": "string.uuid", "uuid": "string.uuid", "guid": "string.uuid",
+	"color": "color.hex", "colour": "color.hex", "currency": "finance.currencyCode",
+	"currencycode": "finance.currencyCode", "product": "commerce.productName",
+	"productname": "commerce.productName", "category": "commerce.department",
+	"department": "commerce.department", "description": "lorem.sentence", "summary": "lorem.sentence",
+	"bio": "lorem.paragraph", "comment": "lorem.sentence", "notes": "lorem.sentence", "title": "lorem.words(3)",
+	"birthdate": "date.birthdate", "dateofbirth": "date.birthdate", "dob": "date.birthdate",
+	"birthday": "date.birthdate", "createdat": "date.past(2)", "updatedat": "date.recent(30)",
+	"created": "date.past(2)", "updated": "date.recent(30)", "timestamp": "date.past",
+	"latitude": "location.latitude", "lat": "location.latitude", "longitude": "location.longitude",
+	"lng": "location.longitude", "lon": "location.longitude",
+}
+
+func (g *fakeGen) str(s *orderedObject, name string) (any, error) {
+	minLen, maxLen := int(schemaInt(s, "minLength", 0)), int(schemaInt(s, "maxLength", -1))
+	if minLen > fakeMaxLength {
+		return nil, fmt.Errorf("%s: minLength is limited to %d", schemaPath(name), fakeMaxLength)
+	}
+	fits := func(v string) bool {
+		n := len([]rune(v))
+		return n >= minLen && (maxLen < 0 || n <= maxLen)
+	}
+
+	if pattern, ok := s.values["pattern"].(string); ok {
+		return g.f.Pattern(pattern)
+	}
+	expr, _ := s.values["x-faker"].(string)
+	format, _ := s.values["format"].(string)
+	if expr == "" {
+		expr = stringFormats[format]
+	}
+	if expr == "" {
+		expr = nameGenerators[strings.NewReplacer("_", "", "-", "", " ", "").Replace(strings.ToLower(name))]
+	}
+	if expr != "" {
+		v, err := g.f.Generate(expr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", schemaPath(name), err)
+		}
+		text := cellText(v)
+		if t, err := time.Parse(time.RFC3339, text); err == nil {
+			switch format {
+			case "date":
+				text = t.Format(time.DateOnly)
+			case "time":
+				text = t.Format(time.TimeOnly)
+			}
+		}
+		if fits(text) {
+			return text, nil
+		}
+	}
+	if maxLen >= 0 && maxLen < minLen {
+		return nil, fmt.Errorf("%s: minLength %d is above maxLength %d", schemaPath(name), minLen, maxLen)
+	}
+	return g.f.Text(minLen, maxLen), nil
+}
+
+func schemaPath(name string) string {
+	if name == "" {
+		return "schema"
+	}
+	return name
+}
+
+func schemaFloat(s *orderedObject, key string, def float64) float64 {
+	switch v := s.values[key].(type) {
+	case json.Number:
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+	case float64:
+		return v
+	case int64:
+		return float64(v)
+	}
+	return def
+}
+
+func schemaInt(s *orderedObject, key string, def int64) int64 {
+	f := schemaFloat(s, key, math.NaN())
+	if math.IsNaN(f) {
+		return def
+	}
+	return int64(f)
+}
@@ -0,0 +1,157 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"testing"
+
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+func generateFake(t *testing.T, req *pb.FakeDataRequest) []map[string]any {
+	t.Helper()
+	if req.ReferenceDate == "" {
+		req.ReferenceDate = "2026-01-01"
+	}
+	resp, err := NewServer().GenerateFakeData(context.Background(), req)
+	if err != nil || resp.Error != "" {
+		t.Fatalf("GenerateFakeData() error = %v %s", err, resp.Error)
+	}
+	var records []map[string]any
+	if err := json.Unmarshal([]byte(resp.Data), &records); err != nil {
+		t.Fatalf("output is not a JSON array: %v\n%s", err, resp.Data)
+	}
+	return records
+}
+
+func TestGenerateFakeData_Template(t *testing.T) {
+	tpl := `{"id": "string.uuid", "name": "person.fullName", "email": "internet.email",
+		"age": "number.int(18, 65)", "created": "date.past", "sku": "/^[A-Z]{3}-\\d{4}$/",
+		"code": "{{string.alpha(2)}}-{{number.int(1, 9)}}", "plan": ["free", "pro"], "active": true,
+		"address": {"city": "location.city", "zip": "location.zipCode"}, "items": [{"qty": "number.int(1, 3)"}]}`
+	records := generateFake(t, &pb.FakeDataRequest{Schema: tpl, Count: 25, Seed: 5, Locale: "es_MX"})
+	if len(records) != 25 {
+		t.Fatalf("got %d records", len(records))
+	}
+	for _, r := range records {
+		if age := r["age"].(float64); age < 18 || age > 65 {
+			t.Errorf("age %v out of range", age)
+		}
+		if !regexp.MustCompile(`^[A-Z]{3}-\d{4}$`).MatchString(r["sku"].(string)) {
+			t.Errorf("sku %q does not match the pattern", r["sku"])
+		}
+		if !regexp.MustCompile(`^[A-Za-z]{2}-[1-9]$`).MatchString(r["code"].(string)) {
+			t.Errorf("code %q not interpolated", r["code"])
+		}
+		if p := r["plan"]; p != "free" && p != "pro" {
+			t.Errorf("plan %v not from the list", p)
+		}
+		if zip := r["address"].(map[string]any)["zip"].(string); len(zip) != 5 {
+			t.Errorf("zip %q is not a Mexican postcode", zip)
+		}
+		if n := len(r["items"].([]any)); n < 1 || n > 3 {
+			t.Errorf("got %d items", n)
+		}
+		if r["active"] != true || !strings.HasPrefix(r["created"].(string), "2025-") && !strings.HasPrefix(r["created"].(string), "2026-01-01") {
+			t.Errorf("unexpected record %v", r)
+		}
+	}
+
+	again := generateFake(t, &pb.FakeDataRequest{Schema: tpl, Count: 25, Seed: 5, Locale: "es_MX"})
+	if a, b := compactJSON(records), compactJSON(again); a != b {
+		t.Error("same seed produced different records")
+	}
+}
+
+func TestGenerateFakeData_Schema(t *testing.T) {
+	schema := `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"required": ["id", "email", "tags", "status", "home", "price", "code", "born", "nick"],
+		"properties": {
+			"id": {"type": "integer", "minimum": 1, "exclusiveMaximum": 10},
+			"email": {"type": "string", "format": "email"},
+			"price": {"type": "number", "minimum": 0, "maximum": 5, "multipleOf": 0.25},
+			"tags": {"type": "array", "items": {"enum": ["x", "y", "z"]}, "uniqueItems": true, "minItems": 3},
+			"status": {"const": "active"},
+			"code": {"type": "string", "pattern": "^[a-f0-9]{6}$"},
+			"born": {"type": "string", "format": "date"},
+			"nick": {"type": "string", "minLength": 3, "maxLength": 6},
+			"home": {"$ref": "#/$defs/address"}
+		},
+		"$defs": {"address": {"type": "object", "required": ["city"], "properties": {"city": {"type": "string"}}}}
+	}`
+	for _, r := range generateFake(t, &pb.FakeDataRequest{Schema: schema, Count: 30, Seed: 11}) {
+		if id := r["id"].(float64); id < 1 || id > 9 {
+			t.Errorf("id %v out of range", id)
+		}
+		if p := r["price"].(float64); p < 0 || p > 5 || p*4 != float64(int(p*4)) {
+			t.Errorf("price %v breaks minimum/maximum/multipleOf", p)
+		}
+		if tags := r["tags"].([]any); len(tags) != 3 {
+			t.Errorf("tags %v are not 3 unique items", tags)
+		}
+		if !strings.Contains(r["email"].(string), "@") || r["status"] != "active" {
+			t.Errorf("unexpected record %v", r)
+		}
+		if !regexp.MustCompile(`^[a-f0-9]{6}$`).MatchString(r["code"].(string)) {
+			t.Errorf("code %q does not match the pattern", r["code"])
+		}
+		if !regexp.MustCompile(`^\d{4}-\d\d-\d\d$`).MatchString(r["born"].(string)) {
+			t.Errorf("born %q is not a date", r["born"])
+		}
+		if n := len(r["nick"].(string)); n < 3 || n > 6 {
+			t.Errorf("nick %q breaks minLength/maxLength", r["nick"])
+		}
+		if r["home"].(map[string]any)["city"] == "" {
+			t.Error("$ref not followed")
+		}
+	}
+}
+
+func TestGenerateFakeData_Formats(t *testing.T) {
+	s := NewServer()
+	for _, f := range []pb.DataFormat{pb.DataFormat_CSV, pb.DataFormat_YAML, pb.DataFormat_XML, pb.DataFormat_TOML, pb.DataFormat_NDJSON} {
+		resp, _ := s.GenerateFakeData(context.Background(), &pb.FakeDataRequest{
+			Schema: `{"name": "person.firstName", "address": {"city": "location.city"}}`, Count: 2, Format: f,
+		})
+		if resp.Error != "" || resp.Data == "" || resp.Seed == 0 {
+			t.Errorf("format %v: %q, seed %d", f, resp.Error, resp.Seed)
+		}
+		if f == pb.DataFormat_CSV && !strings.HasPrefix(resp.Data, "name,address.city\n") {
+			t.Errorf("CSV header: %q", resp.Data)
+		}
+	}
+}
+
+func TestGenerateFakeData_Errors(t *testing.T) {
+	s := NewServer()
+	tests := []struct {
+		req  *pb.FakeDataRequest
+		want string
+	}{
+		{&pb.FakeDataRequest{Schema: `{"a": "person.nmae"}`}, `a: unknown generator "person.nmae"`},
+		{&pb.FakeDataRequest{Schema: `{"a": "person.name"}`, Locale: "xx"}, "unsupported locale"},
+		{&pb.FakeDataRequest{Schema: `{"a": "person.name"}`, Count: 10001}, "at most 10000"},
+		{&pb.FakeDataRequest{Schema: `{"a": `}, "Invalid template"},
+		{&pb.FakeDataRequest{Schema: `{"type": "object", "properties": {"n": {"type": "integer", "minimum": 5, "maximum": 1}}}`}, "minimum 5 is above maximum 1"},
+		{&pb.FakeDataRequest{Schema: `{"$defs": {"a": {"$ref": "#/$defs/a"}}, "$ref": "#/$defs/a", "type": "object"}`}, "recursive $ref"},
+		{&pb.FakeDataRequest{Schema: `{"a": "/[/"}`}, "invalid pattern"},
+		{&pb.FakeDataRequest{Schema: `{"type": "array", "minItems": 1000, "items": {"type": "array", "minItems": 1000, "items": {"type": "string", "minLength": 1000}}}`, Count: 3}, "too large"},
+		{&pb.FakeDataRequest{Schema: `{"type": "object", "properties": {"a": {"type": "string", "minLength": 5000}}}`, Count: 10000}, "too large"},
+	}
+	for _, tt := range tests {
+		resp, _ := s.GenerateFakeData(context.Background(), tt.req)
+		if !strings.Contains(resp.Error, tt.want) {
+			t.Errorf("%s: error = %q, want %q", tt.req.Schema, resp.Error, tt.want)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if resp, _ := s.GenerateFakeData(ctx, &pb.FakeDataRequest{Schema: `{"a": "person.name"}`}); !strings.Contains(resp.Error, "canceled") {
+		t.Errorf("cancelled request: error = %q", resp.Error)
+	}
+}
@@ -0,0 +1,1584 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Readers and writers for the line-oriented and config formats accepted by
+// Convert besides JSON, YAML, XML, TOML and CSV. Readers produce the same
+// generic values as the other decoders; writers reject structures the
+// format has no way to express rather than silently flattening them.
+
+// scalarText renders a scalar value as plain text. ok is false for objects
+// and arrays.
+func scalarText(v any) (s string, ok bool) {
+	switch val := v.(type) {
+	case nil:
+		return "", true
+	case string:
+		return val, true
+	case bool:
+		return strconv.FormatBool(val), true
+	case float64:
+		return numberText(val), true
+	case float32:
+		return numberText(float64(val)), true
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprint(val), true
+	case json.Number:
+		return val.String(), true
+	case time.Time:
+		return val.Format(time.RFC3339Nano), true
+	case map[string]any, []any:
+		return "", false
+	}
+	return fmt.Sprint(v), true
+}
+
+// numberText renders a number without exponent for integral values.
+func numberText(f float64) string {
+	if f == math.Trunc(f) && math.Abs(f) < 1e15 {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// sortedMapKeys returns the keys of m in lexical order.
+func sortedMapKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ── NDJSON ────────────────────────────────────────────────────────────────────
+
+// parseNDJSON decodes one JSON value per non-blank line into an array.
+func parseNDJSON(data string) (any, error) {
+	rows := []any{}
+	sc := bufio.NewScanner(strings.NewReader(data))
+	sc.Buffer(make([]byte, 64*1024), len(data)+1)
+	for line := 1; sc.Scan(); line++ {
+		text := strings.TrimSpace(sc.Text())
+		if text == "" {
+			continue
+		}
+		var v any
+		if err := json.Unmarshal([]byte(text), &v); err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		rows = append(rows, v)
+	}
+	return rows, sc.Err()
+}
+
+// marshalNDJSON writes each element of a root array as one compact line; any
+// other root value becomes a single line.
+func marshalNDJSON(data any) ([]byte, error) {
+	rows, ok := data.([]any)
+	if !ok {
+		rows = []any{data}
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	for _, row := range rows {
+		if err := enc.Encode(row); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// ── INI ───────────────────────────────────────────────────────────────────────
+
+// parseINI reads keys before the first section into the root object and
+// each [section] into a nested object. All values are strings.
+func parseINI(data string) (any, error) {
+	root := map[string]any{}
+	cur := root
+	for i, raw := range strings.Split(data, "\n") {
+		line := strings.TrimSpace(raw)
+		if line == "" || line[0] == ';' || line[0] == '#' {
+			continue
+		}
+		if line[0] == '[' {
+			end := strings.IndexByte(line, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated section header", i+1)
+			}
+			name := strings.TrimSpace(line[1:end])
+			if name == "" {
+				return nil, fmt.Errorf("line %d: empty section name", i+1)
+			}
+			sec, ok := root[name].(map[string]any)
+			if !ok {
+				sec = map[string]any{}
+				root[name] = sec
+			}
+			cur = sec
+			continue
+		}
+		sep := strings.IndexAny(line, "=:")
+		if sep <= 0 {
+			return nil, fmt.Errorf("line %d: expected key = value", i+1)
+		}
+		key := strings.TrimSpace(line[:sep])
+		cur[key] = iniValue(strings.TrimSpace(line[sep+1:]))
+	}
+	return root, nil
+}
+
+// iniValue unquotes a quoted value or strips an inline comment from a bare one.
+func iniValue(v string) string {
+	if len(v) >= 2 && (v[0] == '"' || v[0] == '\'') {
+		if end := strings.IndexByte(v[1:], v[0]); end >= 0 {
+			return v[1 : end+1]
+		}
+	}
+	for _, marker := range []string{" ;", " #", "\t;", "\t#"} {
+		if i := strings.Index(v, marker); i >= 0 {
+			v = v[:i]
+		}
+	}
+	return strings.TrimSpace(v)
+}
+
+// marshalINI writes root scalars first, then one section per nested object.
+func marshalINI(data any) ([]byte, error) {
+	root, ok := data.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("INI output requires an object at the root level")
+	}
+	var b strings.Builder
+	var sections []string
+	for _, k := range sortedMapKeys(root) {
+		if _, isObj := root[k].(map[string]any); isObj {
+			sections = append(sections, k)
+			continue
+		}
+		if err := writeINIPair(&b, k, root[k], k); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range sections {
+		if b.Len() > 0 {
+			b.WriteByte('\n')
+		}
+		fmt.Fprintf(&b, "[%s]\n", name)
+		sec := root[name].(map[string]any)
+		for _, k := range sortedMapKeys(sec) {
+			if err := writeINIPair(&b, k, sec[k], name+"."+k); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return []byte(b.String()), nil
+}
+
+func writeINIPair(b *strings.Builder, key string, v any, path string) error {
+	s, ok := scalarText(v)
+	if !ok {
+		return fmt.Errorf("INI cannot represent the nested value at %q (only one level of sections is supported)", path)
+	}
+	if s != strings.TrimSpace(s) || strings.ContainsAny(s, ";#\"\n") {
+		if strings.ContainsAny(s, "\"\n") {
+			return fmt.Errorf("INI cannot represent the value at %q", path)
+		}
+		s = `"` + s + `"`
+	}
+	fmt.Fprintf(b, "%s = %s\n", key, s)
+	return nil
+}
+
+// ── .env ──────────────────────────────────────────────────────────────────────
+
+var envKeyRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`)
+
+// parseEnv reads KEY=VALUE lines with optional "export", # comments and
+// single- or double-quoted values; double quotes may span lines.
+func parseEnv(data string) (any, error) {
+	out := map[string]any{}
+	lines := strings.Split(data, "\n")
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		line = strings.TrimSpace(strings.TrimPrefix(line, "export "))
+		eq := strings.IndexByte(line, '=')
+		if eq <= 0 {
+			return nil, fmt.Errorf("line %d: expected KEY=VALUE", i+1)
+		}
+		key := strings.TrimSpace(line[:eq])
+		if !envKeyRe.MatchString(key) {
+			return nil, fmt.Errorf("line %d: invalid variable name %q", i+1, key)
+		}
+		val := strings.TrimSpace(line[eq+1:])
+		switch {
+		case strings.HasPrefix(val, `"`):
+			start := i
+			text := val[1:]
+			for {
+				if end := closingQuote(text); end >= 0 {
+					val = unescapeEnv(text[:end])
+					break
+				}
+				i++
+				if i >= len(lines) {
+					return nil, fmt.Errorf("line %d: unterminated double-quoted value", start+1)
+				}
+				text += "\n" + lines[i]
+			}
+		case strings.HasPrefix(val, "'"):
+			end := strings.IndexByte(val[1:], '\'')
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated single-quoted value", i+1)
+			}
+			val = val[1 : end+1]
+		default:
+			if c := strings.Index(val, " #"); c >= 0 {
+				val = strings.TrimSpace(val[:c])
+			}
+		}
+		out[key] = val
+	}
+	return out, nil
+}
+
+// closingQuote returns the index of the first unescaped double quote in s.
+func closingQuote(s string) int {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			return i
+		}
+	}
+	return -1
+}
+
+func unescapeEnv(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
+
+var envBareRe = regexp.MustCompile(`^[A-Za-z0-9_./:@+,%-]*$`)
+
+// marshalEnv writes a flat object as KEY=VALUE lines.
+func marshalEnv(data any) ([]byte, error) {
+	root, ok := data.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf(".env output requires an object at the root level")
+	}
+	var b strings.Builder
+	for _, k := range sortedMapKeys(root) {
+		if !envKeyRe.MatchString(k) {
+			return nil, fmt.Errorf("%q is not a valid .env variable name", k)
+		}
+		s, ok := scalarText(root[k])
+		if !ok {
+			return nil, fmt.Errorf("nested value at %q cannot be represented in .env", k)
+		}
+		if !envBareRe.MatchString(s) {
+			s = `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`, "$", `\$`).Replace(s) + `"`
+		}
+		fmt.Fprintf(&b, "%s=%s\n", k, s)
+	}
+	return []byte(b.String()), nil
+}
+
+// ── Java properties ───────────────────────────────────────────────────────────
+
+// parseProperties follows java.util.Properties.load and then expands dotted
+// keys and [n] indexes into nested objects and arrays, the way Spring binds
+// them: "server.ports[0]=80" becomes {"server": {"ports": ["80"]}}.
+func parseProperties(data string) (any, error) {
+	root := map[string]any{}
+	lines := strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n")
+	for i := 0; i < len(lines); i++ {
+		lineNo := i + 1
+		line := strings.TrimLeft(lines[i], " \t\f")
+		if line == "" || line[0] == '#' || line[0] == '!' {
+			continue
+		}
+		for continued(line) && i+1 < len(lines) {
+			i++
+			line = line[:len(line)-1] + strings.TrimLeft(lines[i], " \t\f")
+		}
+		key, val := splitProperty(line)
+		if err := setPropertyPath(root, unescapeProperty(key), unescapeProperty(val)); err != nil {
+			return nil, fmt.Errorf("line %d: %v", lineNo, err)
+		}
+	}
+	return root, nil
+}
+
+// continued reports whether line ends in an odd number of backslashes.
+func continued(line string) bool {
+	n := 0
+	for i := len(line) - 1; i >= 0 && line[i] == '\\'; i-- {
+		n++
+	}
+	return n%2 == 1
+}
+
+func splitProperty(line string) (key, val string) {
+	end := len(line)
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		if c == '\\' {
+			i++
+			continue
+		}
+		if c == '=' || c == ':' || c == ' ' || c == '\t' || c == '\f' {
+			end = i
+			break
+		}
+	}
+	key = line[:end]
+	rest := strings.TrimLeft(line[end:], " \t\f")
+	if rest != "" && (rest[0] == '=' || rest[0] == ':') {
+		rest = strings.TrimLeft(rest[1:], " \t\f")
+	}
+	return key, rest
+}
+
+func unescapeProperty(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 't':
+			b.WriteByte('\t')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 'f':
+			b.WriteByte('\f')
+		case 'u':
+			if i+4 < len(s) {
+				if n, err := strconv.ParseUint(s[i+1:i+5], 16, 16); err == nil {
+					r := rune(n)
+					i += 4
+					if utf16.IsSurrogate(r) && i+6 < len(s) && s[i+1] == '\\' && s[i+2] == 'u' {
+						if lo, err := strconv.ParseUint(s[i+3:i+7], 16, 16); err == nil {
+							r = utf16.DecodeRune(r, rune(lo))
+							i += 6
+						}
+					}
+					b.WriteRune(r)
+					continue
+				}
+			}
+			b.WriteByte('u')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
+
+var (
+	propSegmentRe = regexp.MustCompile(`^([^\[\]]*)((?:\[\d+\])*)$`)
+	propIndexRe   = regexp.MustCompile(`\d+`)
+)
+
+// propertyPath splits "a.b[0][1].c" into ["a", "b", 0, 1, "c"].
+func propertyPath(key string) []any {
+	var path []any
+	for _, part := range strings.Split(key, ".") {
+		m := propSegmentRe.FindStringSubmatch(part)
+		if m == nil || (m[1] == "" && m[2] == "") {
+			path = append(path, part)
+			continue
+		}
+		if m[1] != "" {
+			path = append(path, m[1])
+		}
+		for _, idx := range propIndexRe.FindAllString(m[2], -1) {
+			n, _ := strconv.Atoi(idx)
+			path = append(path, n)
+		}
+	}
+	return path
+}
+
+const maxPropertyIndex = 10000
+
+func setPropertyPath(root map[string]any, key string, val any) error {
+	path := propertyPath(key)
+	if len(path) == 0 {
+		path = []any{key}
+	}
+	if _, ok := path[0].(int); ok {
+		return fmt.Errorf("property %q cannot start with an index", key)
+	}
+	// set stores v under step in container c, returning the (possibly grown) container.
+	var set func(c any, steps []any) (any, error)
+	set = func(c any, steps []any) (any, error) {
+		switch step := steps[0].(type) {
+		case string:
+			m, ok := c.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("property %q conflicts with an earlier value", key)
+			}
+			if len(steps) == 1 {
+				if _, exists := m[step]; exists {
+					if _, scalar := scalarText(m[step]); !scalar {
+						return nil, fmt.Errorf("property %q conflicts with an earlier value", key)
+					}
+				}
+				m[step] = val
+				return m, nil
+			}
+			child, err := set(containerFor(m[step], steps[1]), steps[1:])
+			if err != nil {
+				return nil, err
+			}
+			m[step] = child
+			return m, nil
+		case int:
+			arr, ok := c.([]any)
+			if !ok {
+				return nil, fmt.Errorf("property %q conflicts with an earlier value", key)
+			}
+			if step > maxPropertyIndex {
+				return nil, fmt.Errorf("index %d in %q is too large", step, key)
+			}
+			for len(arr) <= step {
+				arr = append(arr, nil)
+			}
+			if len(steps) == 1 {
+				arr[step] = val
+				return arr, nil
+			}
+			child, err := set(containerFor(arr[step], steps[1]), steps[1:])
+			if err != nil {
+				return nil, err
+			}
+			arr[step] = child
+			return arr, nil
+		}
+		return nil, fmt.Errorf("invalid property %q", key)
+	}
+	_, err := set(root, path)
+	return err
+}
+
+// containerFor returns existing, or a new container suited to the next step.
+func containerFor(existing, next any) any {
+	if existing != nil {
+		return existing
+	}
+	if _, ok := next.(int); ok {
+		return []any{}
+	}
+	return map[string]any{}
+}
+
+// marshalProperties flattens nested objects into dotted keys and arrays
+// into [n] indexes. A key that itself holds a dot or index, such as
+// "a.b" next to {"a":{"b":…}}, can flatten to a line already written;
+// that is an error rather than a file with the key twice.
+func marshalProperties(data any) ([]byte, error) {
+	if _, ok := data.(map[string]any); !ok {
+		return nil, fmt.Errorf("properties output requires an object at the root level")
+	}
+	var lines []string
+	seen := map[string]bool{}
+	var walk func(prefix string, v any) error
+	walk = func(prefix string, v any) error {
+		switch val := v.(type) {
+		case map[string]any:
+			for _, k := range sortedMapKeys(val) {
+				name := escapePropertyKey(k)
+				if prefix != "" {
+					name = prefix + "." + name
+				}
+				if err := walk(name, val[k]); err != nil {
+					return err
+				}
+			}
+		case []any:
+			for i, item := range val {
+				if err := walk(fmt.Sprintf("%s[%d]", prefix, i), item); err != nil {
+					return err
+				}
+			}
+		default:
+			if seen[prefix] {
+				return fmt.Errorf("key %q collides with a nested path of the same name in properties", prefix)
+			}
+			seen[prefix] = true
+			s, _ := scalarText(val)
+			lines = append(lines, prefix+"="+escapePropertyValue(s))
+		}
+		return nil
+	}
+	if err := walk("", data); err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return []byte{}, nil
+	}
+	return []byte(strings.Join(lines, "\n") + "\n"), nil
+}
+
+func escapePropertyKey(s string) string {
+	return escapeProperty(s, true)
+}
+
+func escapePropertyValue(s string) string {
+	return escapeProperty(s, false)
+}
+
+func escapeProperty(s string, key bool) string {
+	var b strings.Builder
+	for i, r := range s {
+		switch {
+		case r == '\\':
+			b.WriteString(`\\`)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r == '\f':
+			b.WriteString(`\f`)
+		case r == ' ' && (key || i == 0):
+			b.WriteString(`\ `)
+		case key && strings.ContainsRune("=:#!", r):
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r > 0x7e || r < 0x20:
+			for _, u := range utf16.Encode([]rune{r}) {
+				fmt.Fprintf(&b, `\u%04X`, u)
+			}
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// ── HCL ───────────────────────────────────────────────────────────────────────
+
+// HCL maps to JSON the way Terraform's JSON syntax does: attributes become
+// members, blocks become objects nested under their type and labels, and a
+// repeated block becomes an array. Expressions that are not literals —
+// references, function calls, operators — are kept as "${...}" strings.
+
+type hclParser struct {
+	src []rune
+	pos int
+}
+
+func parseHCL(data string) (any, error) {
+	p := &hclParser{src: []rune(data)}
+	body, err := p.body(false)
+	if err != nil {
+		line, col := p.lineCol()
+		return nil, fmt.Errorf("line %d, column %d: %v", line, col, err)
+	}
+	return body, nil
+}
+
+func (p *hclParser) lineCol() (int, int) {
+	return offsetToLineCol(string(p.src), len(string(p.src[:min(p.pos, len(p.src))])))
+}
+
+func (p *hclParser) peek() rune {
+	if p.pos < len(p.src) {
+		return p.src[p.pos]
+	}
+	return 0
+}
+
+func (p *hclParser) hasPrefix(s string) bool {
+	return strings.HasPrefix(string(p.src[p.pos:min(p.pos+len(s), len(p.src))]), s)
+}
+
+// skip consumes whitespace and comments; newlines only when multiline is set.
+func (p *hclParser) skip(multiline bool) {
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch {
+		case c == '\n' && !multiline:
+			return
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			p.pos++
+		case c == '#' || p.hasPrefix("//"):
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' {
+				p.pos++
+			}
+		case p.hasPrefix("/*"):
+			end := strings.Index(string(p.src[p.pos+2:]), "*/")
+			if end < 0 {
+				p.pos = len(p.src)
+				return
+			}
+			p.pos += 2 + utf8.RuneCountInString(string(p.src[p.pos+2:])[:end]) + 2
+		default:
+			return
+		}
+	}
+}
+
+func isHCLIdentStart(r rune) bool { return unicode.IsLetter(r) || r == '_' }
+func isHCLIdentChar(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-'
+}
+
+func (p *hclParser) ident() string {
+	start := p.pos
+	if !isHCLIdentStart(p.peek()) {
+		return ""
+	}
+	for p.pos < len(p.src) && isHCLIdentChar(p.src[p.pos]) {
+		p.pos++
+	}
+	return string(p.src[start:p.pos])
+}
+
+func (p *hclParser) body(nested bool) (map[string]any, error) {
+	out := map[string]any{}
+	for {
+		p.skip(true)
+		if p.pos >= len(p.src) {
+			if nested {
+				return nil, fmt.Errorf("unterminated block, expected }")
+			}
+			return out, nil
+		}
+		if p.peek() == '}' {
+			if !nested {
+				return nil, fmt.Errorf("unexpected }")
+			}
+			p.pos++
+			return out, nil
+		}
+		name := p.ident()
+		if name == "" {
+			return nil, fmt.Errorf("expected attribute or block name, found %q", p.peek())
+		}
+		p.skip(false)
+		if p.peek() == '=' {
+			p.pos++
+			v, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			out[name] = v
+			p.skip(false)
+			if c := p.peek(); c != '\n' && c != '}' && c != 0 {
+				return nil, fmt.Errorf("unexpected %q after attribute %s", c, name)
+			}
+			continue
+		}
+		var labels []string
+		for p.peek() != '{' {
+			switch {
+			case p.peek() == '"':
+				s, err := p.quoted()
+				if err != nil {
+					return nil, err
+				}
+				labels = append(labels, s)
+			case isHCLIdentStart(p.peek()):
+				labels = append(labels, p.ident())
+			default:
+				return nil, fmt.Errorf("expected = or { after %s", name)
+			}
+			p.skip(false)
+		}
+		p.pos++
+		block, err := p.body(true)
+		if err != nil {
+			return nil, err
+		}
+		addHCLBlock(out, append([]string{name}, labels...), block)
+	}
+}
+
+// addHCLBlock nests block under its type and labels; a second block at the
+// same place turns the entry into an array.
+func addHCLBlock(out map[string]any, keys []string, block map[string]any) {
+	parent := out
+	for _, k := range keys[:len(keys)-1] {
+		next, ok := parent[k].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			parent[k] = next
+		}
+		parent = next
+	}
+	last := keys[len(keys)-1]
+	switch existing := parent[last].(type) {
+	case nil:
+		parent[last] = block
+	case []any:
+		parent[last] = append(existing, block)
+	default:
+		parent[last] = []any{existing, block}
+	}
+}
+
+// expr parses a literal value, falling back to capturing the raw expression.
+func (p *hclParser) expr() (any, error) {
+	p.skip(false)
+	start := p.pos
+	v, err := p.literal()
+	if err == nil {
+		save := p.pos
+		p.skip(false)
+		if c := p.peek(); c == 0 || c == '\n' || c == ',' || c == '}' || c == ']' || c == ')' {
+			p.pos = save
+			return v, nil
+		}
+	}
+	p.pos = start
+	raw, err := p.raw()
+	if err != nil {
+		return nil, err
+	}
+	return "${" + raw + "}", nil
+}
+
+func (p *hclParser) literal() (any, error) {
+	c := p.peek()
+	switch {
+	case c == '"':
+		return p.quoted()
+	case p.hasPrefix("<<"):
+		return p.heredoc()
+	case c == '-' || (c >= '0' && c <= '9'):
+		return p.number()
+	case c == '[':
+		p.pos++
+		arr := []any{}
+		for {
+			p.skip(true)
+			if p.peek() == ']' {
+				p.pos++
+				return arr, nil
+			}
+			v, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+			p.skip(true)
+			if p.peek() == ',' {
+				p.pos++
+			} else if p.peek() != ']' {
+				return nil, fmt.Errorf("expected , or ] in list")
+			}
+		}
+	case c == '{':
+		p.pos++
+		obj := map[string]any{}
+		for {
+			p.skip(true)
+			if p.peek() == '}' {
+				p.pos++
+				return obj, nil
+			}
+			var key string
+			if p.peek() == '"' {
+				k, err := p.quoted()
+				if err != nil {
+					return nil, err
+				}
+				key = k
+			} else if key = p.ident(); key == "" {
+				return nil, fmt.Errorf("expected object key")
+			}
+			p.skip(false)
+			if c := p.peek(); c != '=' && c != ':' {
+				return nil, fmt.Errorf("expected = after object key %s", key)
+			}
+			p.pos++
+			v, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			obj[key] = v
+			p.skip(false)
+			if p.peek() == ',' {
+				p.pos++
+			}
+		}
+	case isHCLIdentStart(c):
+		switch id := p.ident(); id {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		}
+	}
+	return nil, fmt.Errorf("not a literal")
+}
+
+var hclNumberRe = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?([eE][+-]?[0-9]+)?`)
+
+func (p *hclParser) number() (any, error) {
+	m := hclNumberRe.FindString(string(p.src[p.pos:min(p.pos+64, len(p.src))]))
+	if m == "" {
+		return nil, fmt.Errorf("invalid number")
+	}
+	p.pos += len(m)
+	return strconv.ParseFloat(m, 64)
+}
+
+// quoted reads a template string. Interpolations and directives are kept
+// verbatim; "$${" and "%%{" escapes are preserved too so they survive a
+// round trip.
+func (p *hclParser) quoted() (string, error) {
+	p.pos++
+	var b strings.Builder
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch {
+		case c == '"':
+			p.pos++
+			return b.String(), nil
+		case c == '\n':
+			return "", fmt.Errorf("unterminated string")
+		case c == '\\' && p.pos+1 < len(p.src):
+			p.pos++
+			switch e := p.src[p.pos]; e {
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case 'u', 'U':
+				n := 4
+				if e == 'U' {
+					n = 8
+				}
+				if p.pos+n >= len(p.src) {
+					return "", fmt.Errorf("invalid unicode escape")
+				}
+				cp, err := strconv.ParseUint(string(p.src[p.pos+1:p.pos+1+n]), 16, 32)
+				if err != nil {
+					return "", fmt.Errorf("invalid unicode escape")
+				}
+				b.WriteRune(rune(cp))
+				p.pos += n
+			default:
+				b.WriteRune(e)
+			}
+			p.pos++
+		case (c == '$' || c == '%') && p.pos+1 < len(p.src) && p.src[p.pos+1] == '{':
+			depth := 0
+			for p.pos < len(p.src) {
+				r := p.src[p.pos]
+				b.WriteRune(r)
+				p.pos++
+				if r == '{' {
+					depth++
+				} else if r == '}' {
+					if depth--; depth == 0 {
+						break
+					}
+				}
+			}
+		default:
+			b.WriteRune(c)
+			p.pos++
+		}
+	}
+	return "", fmt.Errorf("unterminated string")
+}
+
+func (p *hclParser) heredoc() (string, error) {
+	p.pos += 2
+	indent := false
+	if p.peek() == '-' {
+		indent = true
+		p.pos++
+	}
+	marker := p.ident()
+	if marker == "" {
+		return "", fmt.Errorf("expected heredoc marker")
+	}
+	nl := strings.IndexRune(string(p.src[p.pos:]), '\n')
+	if nl < 0 {
+		return "", fmt.Errorf("unterminated heredoc")
+	}
+	p.pos += utf8.RuneCountInString(string(p.src[p.pos:])[:nl]) + 1
+	var lines []string
+	for p.pos < len(p.src) {
+		end := p.pos
+		for end < len(p.src) && p.src[end] != '\n' {
+			end++
+		}
+		line := string(p.src[p.pos:end])
+		p.pos = min(end+1, len(p.src))
+		if strings.TrimSpace(line) == marker {
+			if end < len(p.src) {
+				p.pos = end
+			}
+			if indent {
+				lines = dedent(lines)
+			}
+			if len(lines) == 0 {
+				return "", nil
+			}
+			return strings.Join(lines, "\n") + "\n", nil
+		}
+		lines = append(lines, line)
+	}
+	return "", fmt.Errorf("unterminated heredoc, expected %s", marker)
+}
+
+// dedent removes the indentation shared by all non-blank lines.
+func dedent(lines []string) []string {
+	common := -1
+	for _, l := range lines {
+		if strings.TrimSpace(l) == "" {
+			continue
+		}
+		n := len(l) - len(strings.TrimLeft(l, " \t"))
+		if common < 0 || n < common {
+			common = n
+		}
+	}
+	out := make([]string, len(lines))
+	for i, l := range lines {
+		if len(l) >= common && common > 0 {
+			out[i] = l[common:]
+		} else {
+			out[i] = strings.TrimLeft(l, " \t")
+		}
+	}
+	return out
+}
+
+// raw captures an expression up to the end of the line or the closing
+// delimiter of the enclosing collection, balancing brackets and strings.
+func (p *hclParser) raw() (string, error) {
+	start := p.pos
+	depth := 0
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch {
+		case c == '"':
+			if _, err := p.quoted(); err != nil {
+				return "", err
+			}
+			continue
+		case c == '(' || c == '[' || c == '{':
+			depth++
+		case c == ')' || c == ']' || c == '}':
+			if depth == 0 {
+				return p.rawText(start)
+			}
+			depth--
+		case (c == '\n' || c == ',' || c == '#') && depth == 0:
+			return p.rawText(start)
+		case p.hasPrefix("//") && depth == 0:
+			return p.rawText(start)
+		}
+		p.pos++
+	}
+	if depth > 0 {
+		return "", fmt.Errorf("unbalanced brackets in expression")
+	}
+	return p.rawText(start)
+}
+
+func (p *hclParser) rawText(start int) (string, error) {
+	s := strings.TrimSpace(string(p.src[start:p.pos]))
+	if s == "" {
+		return "", fmt.Errorf("expected expression")
+	}
+	return s, nil
+}
+
+var hclIdentRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// marshalHCL writes scalars and lists as attributes and objects as blocks;
+// an array of objects becomes a repeated block.
+func marshalHCL(data any) ([]byte, error) {
+	root, ok := data.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("HCL output requires an object at the root level")
+	}
+	var b strings.Builder
+	if err := writeHCLBody(&b, root, ""); err != nil {
+		return nil, err
+	}
+	return []byte(b.String()), nil
+}
+
+func isHCLBlockList(v any) bool {
+	arr, ok := v.([]any)
+	if !ok || len(arr) == 0 {
+		return false
+	}
+	for _, item := range arr {
+		if _, ok := item.(map[string]any); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func writeHCLBody(b *strings.Builder, m map[string]any, indent string) error {
+	var attrs, blocks []string
+	width := 0
+	for _, k := range sortedMapKeys(m) {
+		if !hclIdentRe.MatchString(k) {
+			return fmt.Errorf("%q is not a valid HCL identifier", k)
+		}
+		if _, isObj := m[k].(map[string]any); isObj || isHCLBlockList(m[k]) {
+			blocks = append(blocks, k)
+			continue
+		}
+		attrs = append(attrs, k)
+		width = max(width, len(k))
+	}
+	for _, k := range attrs {
+		fmt.Fprintf(b, "%s%-*s = %s\n", indent, width, k, hclExpr(m[k], indent))
+	}
+	for i, k := range blocks {
+		if i > 0 || len(attrs) > 0 {
+			b.WriteByte('\n')
+		}
+		items, ok := m[k].([]any)
+		if !ok {
+			items = []any{m[k]}
+		}
+		for j, item := range items {
+			if j > 0 {
+				b.WriteByte('\n')
+			}
+			fmt.Fprintf(b, "%s%s {\n", indent, k)
+			if err := writeHCLBody(b, item.(map[string]any), indent+"  "); err != nil {
+				return err
+			}
+			fmt.Fprintf(b, "%s}\n", indent)
+		}
+	}
+	return nil
+}
+
+func hclExpr(v any, indent string) string {
+	switch val := v.(type) {
+	case string:
+		return hclQuote(val)
+	case []any:
+		if len(val) == 0 {
+			return "[]"
+		}
+		parts := make([]string, len(val))
+		multiline := false
+		for i, item := range val {
+			parts[i] = hclExpr(item, indent+"  ")
+			if strings.Contains(parts[i], "\n") {
+				multiline = true
+			}
+		}
+		if !multiline {
+			return "[" + strings.Join(parts, ", ") + "]"
+		}
+		return "[\n" + indent + "  " + strings.Join(parts, ",\n"+indent+"  ") + ",\n" + indent + "]"
+	case map[string]any:
+		if len(val) == 0 {
+			return "{}"
+		}
+		var b strings.Builder
+		b.WriteString("{\n")
+		for _, k := range sortedMapKeys(val) {
+			key := k
+			if !hclIdentRe.MatchString(k) {
+				key = hclQuote(k)
+			}
+			fmt.Fprintf(&b, "%s  %s = %s\n", indent, key, hclExpr(val[k], indent+"  "))
+		}
+		b.WriteString(indent + "}")
+		return b.String()
+	case nil:
+		return "null"
+	}
+	s, _ := scalarText(v)
+	if _, isTime := v.(time.Time); isTime {
+		return hclQuote(s)
+	}
+	return s
+}
+
+func hclQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`).Replace(s) + `"`
+}
+
+// ── JSON5 ─────────────────────────────────────────────────────────────────────
+
+// json5Parser accepts JSON5 (and therefore JSONC): comments, trailing
+// commas, single-quoted strings, unquoted keys, hexadecimal and signed
+// numbers, leading or trailing decimal points and line continuations.
+//
+// With ordered set, objects come back as *orderedObject and numbers as
+// json.Number in strict JSON syntax, so that JsonFormat can normalize
+// without losing key order or precision.
+type json5Parser struct {
+	src     string
+	pos     int
+	ordered bool
+}
+
+func parseJSON5(data string) (any, error) {
+	p := &json5Parser{src: data}
+	v, err := p.document()
+	if err != nil {
+		line, col := offsetToLineCol(data, p.pos)
+		return nil, fmt.Errorf("line %d, column %d: %v", line, col, err)
+	}
+	return v, nil
+}
+
+// document reads a single value. On error p.pos is where parsing stopped.
+func (p *json5Parser) document() (any, error) {
+	p.skip()
+	v, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	p.skip()
+	if p.pos < len(p.src) {
+		return nil, fmt.Errorf("unexpected %q after value", p.src[p.pos])
+	}
+	return v, nil
+}
+
+func (p *json5Parser) skip() {
+	for p.pos < len(p.src) {
+		switch {
+		case strings.HasPrefix(p.src[p.pos:], "//"):
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' {
+				p.pos++
+			}
+		case strings.HasPrefix(p.src[p.pos:], "/*"):
+			end := strings.Index(p.src[p.pos+2:], "*/")
+			if end < 0 {
+				p.pos = len(p.src)
+				return
+			}
+			p.pos += end + 4
+		default:
+			r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+			if !unicode.IsSpace(r) && r != '\uFEFF' {
+				return
+			}
+			p.pos += size
+		}
+	}
+}
+
+func (p *json5Parser) value() (any, error) {
+	if p.pos >= len(p.src) {
+		return nil, fmt.Errorf("unexpected end of input")
+	}
+	switch c := p.src[p.pos]; {
+	case c == '{':
+		return p.object()
+	case c == '[':
+		return p.array()
+	case c == '"' || c == '\'':
+		return p.str()
+	case c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9'):
+		return p.number()
+	}
+	id := p.identifier()
+	switch id {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	case "Infinity", "NaN":
+		if p.ordered {
+			p.pos -= len(id)
+			return nil, fmt.Errorf("%s has no JSON equivalent", id)
+		}
+		if id == "NaN" {
+			return math.NaN(), nil
+		}
+		return math.Inf(1), nil
+	}
+	p.pos -= len(id)
+	return nil, fmt.Errorf("unexpected %q", p.src[p.pos])
+}
+
+func (p *json5Parser) identifier() string {
+	start := p.pos
+	for p.pos < len(p.src) {
+		r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+		if !(unicode.IsLetter(r) || r == '_' || r == '$' || (p.pos > start && unicode.IsDigit(r))) {
+			break
+		}
+		p.pos += size
+	}
+	return p.src[start:p.pos]
+}
+
+func (p *json5Parser) object() (any, error) {
+	p.pos++
+	obj := &orderedObject{values: map[string]any{}}
+	for {
+		p.skip()
+		if p.pos >= len(p.src) {
+			return nil, fmt.Errorf("unterminated object")
+		}
+		if p.src[p.pos] == '}' {
+			p.pos++
+			if !p.ordered {
+				return obj.values, nil
+			}
+			return obj, nil
+		}
+		var key string
+		if c := p.src[p.pos]; c == '"' || c == '\'' {
+			k, err := p.str()
+			if err != nil {
+				return nil, err
+			}
+			key = k
+		} else if key = p.identifier(); key == "" {
+			return nil, fmt.Errorf("expected object key")
+		}
+		p.skip()
+		if p.pos >= len(p.src) || p.src[p.pos] != ':' {
+			return nil, fmt.Errorf("expected : after key %q", key)
+		}
+		p.pos++
+		p.skip()
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		if _, dup := obj.values[key]; !dup {
+			obj.keys = append(obj.keys, key)
+		}
+		obj.values[key] = v
+		p.skip()
+		if p.pos < len(p.src) && p.src[p.pos] == ',' {
+			p.pos++
+		} else if p.pos < len(p.src) && p.src[p.pos] != '}' {
+			return nil, fmt.Errorf("expected , or } in object")
+		}
+	}
+}
+
+func (p *json5Parser) array() (any, error) {
+	p.pos++
+	arr := []any{}
+	for {
+		p.skip()
+		if p.pos >= len(p.src) {
+			return nil, fmt.Errorf("unterminated array")
+		}
+		if p.src[p.pos] == ']' {
+			p.pos++
+			return arr, nil
+		}
+		v, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		arr = append(arr, v)
+		p.skip()
+		if p.pos < len(p.src) && p.src[p.pos] == ',' {
+			p.pos++
+		} else if p.pos < len(p.src) && p.src[p.pos] != ']' {
+			return nil, fmt.Errorf("expected , or ] in array")
+		}
+	}
+}
+
+func (p *json5Parser) str() (string, error) {
+	quote := p.src[p.pos]
+	p.pos++
+	var b strings.Builder
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch {
+		case c == quote:
+			p.pos++
+			return b.String(), nil
+		case c == '\n':
+			return "", fmt.Errorf("unterminated string")
+		case c == '\\' && p.pos+1 < len(p.src):
+			p.pos++
+			switch e := p.src[p.pos]; e {
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case 'b':
+				b.WriteByte('\b')
+			case 'f':
+				b.WriteByte('\f')
+			case 'v':
+				b.WriteByte('\v')
+			case '0':
+				b.WriteByte(0)
+			case '\n':
+				// line continuation
+			case '\r':
+				if p.pos+1 < len(p.src) && p.src[p.pos+1] == '\n' {
+					p.pos++
+				}
+			case 'x', 'u':
+				n := 2
+				if e == 'u' {
+					n = 4
+				}
+				if p.pos+n >= len(p.src) {
+					return "", fmt.Errorf("invalid escape")
+				}
+				cp, err := strconv.ParseUint(p.src[p.pos+1:p.pos+1+n], 16, 32)
+				if err != nil {
+					return "", fmt.Errorf("invalid escape \\%c%s", e, p.src[p.pos+1:p.pos+1+n])
+				}
+				r := rune(cp)
+				p.pos += n
+				if utf16.IsSurrogate(r) && strings.HasPrefix(p.src[p.pos+1:], `\u`) && p.pos+7 <= len(p.src) {
+					if lo, err := strconv.ParseUint(p.src[p.pos+3:p.pos+7], 16, 32); err == nil {
+						r = utf16.DecodeRune(r, rune(lo))
+						p.pos += 6
+					}
+				}
+				b.WriteRune(r)
+			default:
+				b.WriteByte(e)
+			}
+			p.pos++
+		default:
+			b.WriteByte(c)
+			p.pos++
+		}
+	}
+	return "", fmt.Errorf("unterminated string")
+}
+
+func (p *json5Parser) number() (any, error) {
+	start := p.pos
+	sign := 1.0
+	if c := p.src[p.pos]; c == '+' || c == '-' {
+		if c == '-' {
+			sign = -1
+		}
+		p.pos++
+	}
+	rest := p.src[p.pos:]
+	switch {
+	case strings.HasPrefix(rest, "Infinity"), strings.HasPrefix(rest, "NaN"):
+		word := "Infinity"
+		if rest[0] == 'N' {
+			word = "NaN"
+		}
+		if p.ordered {
+			p.pos = start
+			return nil, fmt.Errorf("%s has no JSON equivalent", word)
+		}
+		p.pos += len(word)
+		if word == "NaN" {
+			return math.NaN(), nil
+		}
+		return sign * math.Inf(1), nil
+	case strings.HasPrefix(rest, "0x") || strings.HasPrefix(rest, "0X"):
+		end := p.pos + 2
+		for end < len(p.src) && strings.IndexByte("0123456789abcdefABCDEF", p.src[end]) >= 0 {
+			end++
+		}
+		if p.ordered {
+			n, ok := new(big.Int).SetString(p.src[p.pos+2:end], 16)
+			if !ok {
+				return nil, fmt.Errorf("invalid hexadecimal number %q", p.src[start:end])
+			}
+			p.pos = end
+			if sign < 0 && n.Sign() != 0 {
+				n.Neg(n)
+			}
+			return json.Number(n.String()), nil
+		}
+		n, err := strconv.ParseUint(p.src[p.pos+2:end], 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hexadecimal number %q", p.src[start:end])
+		}
+		p.pos = end
+		return sign * float64(n), nil
+	}
+	end := p.pos
+	for end < len(p.src) && strings.IndexByte("0123456789.eE+-", p.src[end]) >= 0 {
+		if (p.src[end] == '+' || p.src[end] == '-') && end > p.pos && p.src[end-1] != 'e' && p.src[end-1] != 'E' {
+			break
+		}
+		end++
+	}
+	f, err := strconv.ParseFloat(p.src[p.pos:end], 64)
+	if err != nil && !(p.ordered && errors.Is(err, strconv.ErrRange)) {
+		return nil, fmt.Errorf("invalid number %q", p.src[start:end])
+	}
+	if p.ordered {
+		n := strictNumber(p.src[p.pos:end], sign < 0)
+		p.pos = end
+		return n, nil
+	}
+	p.pos = end
+	return sign * f, nil
+}
+
+// strictNumber rewrites a JSON5 decimal literal (".5", "5.", "007") in JSON
+// syntax, digit for digit.
+func strictNumber(lit string, neg bool) json.Number {
+	mant, exp, _ := strings.Cut(strings.ToLower(lit), "e")
+	whole, frac, _ := strings.Cut(mant, ".")
+	whole = strings.TrimLeft(whole, "0")
+	if whole == "" {
+		whole = "0"
+	}
+	n := whole
+	if frac != "" {
+		n += "." + frac
+	}
+	if exp != "" {
+		n += "e" + strings.TrimPrefix(exp, "+")
+	}
+	if neg {
+		n = "-" + n
+	}
+	return json.Number(n)
+}
+
+var json5IdentRe = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// marshalJSON5 pretty-prints with unquoted identifier keys and trailing
+// commas.
+func marshalJSON5(data any) ([]byte, error) {
+	var b strings.Builder
+	if err := writeJSON5(&b, data, ""); err != nil {
+		return nil, err
+	}
+	b.WriteByte('\n')
+	return []byte(b.String()), nil
+}
+
+func writeJSON5(b *strings.Builder, v any, indent string) error {
+	switch val := v.(type) {
+	case map[string]any:
+		if len(val) == 0 {
+			b.WriteString("{}")
+			return nil
+		}
+		b.WriteString("{\n")
+		for _, k := range sortedMapKeys(val) {
+			b.WriteString(indent + "  ")
+			if json5IdentRe.MatchString(k) {
+				b.WriteString(k)
+			} else {
+				b.WriteString(jsonString(k))
+			}
+			b.WriteString(": ")
+			if err := writeJSON5(b, val[k], indent+"  "); err != nil {
+				return err
+			}
+			b.WriteString(",\n")
+		}
+		b.WriteString(indent + "}")
+	case []any:
+		if len(val) == 0 {
+			b.WriteString("[]")
+			return nil
+		}
+		b.WriteString("[\n")
+		for _, item := range val {
+			b.WriteString(indent + "  ")
+			if err := writeJSON5(b, item, indent+"  "); err != nil {
+				return err
+			}
+			b.WriteString(",\n")
+		}
+		b.WriteString(indent + "]")
+	case string:
+		b.WriteString(jsonString(val))
+	case float64:
+		if math.IsNaN(val) || math.IsInf(val, 0) {
+			b.WriteString(nonFiniteName(val))
+		} else {
+			b.WriteString(numberText(val))
+		}
+	case nil:
+		b.WriteString("null")
+	case time.Time:
+		b.WriteString(jsonString(val.Format(time.RFC3339Nano)))
+	default:
+		s, ok := scalarText(val)
+		if !ok {
+			return fmt.Errorf("unsupported value %T", v)
+		}
+		b.WriteString(s)
+	}
+	return nil
+}
+
+func jsonString(s string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
@@ -0,0 +1,227 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+// convert runs Convert and fails the test on a conversion error.
+func convert(t *testing.T, data string, from, to pb.DataFormat) string {
+	t.Helper()
+	resp, err := NewServer().Convert(context.Background(), &pb.ConvertRequest{
+		Data: data, SourceFormat: from, TargetFormat: to,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Error != "" {
+		t.Fatalf("convert %v → %v: %s", from, to, resp.Error)
+	}
+	return resp.Data
+}
+
+func decodeJSON(t *testing.T, s string) any {
+	t.Helper()
+	var v any
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		t.Fatalf("decode %q: %v", s, err)
+	}
+	return v
+}
+
+func TestConvert_Read(t *testing.T) {
+	tests := []struct {
+		name   string
+		format pb.DataFormat
+		data   string
+		want   string
+	}{
+		{"ndjson", pb.DataFormat_NDJSON, "{\"a\":1}\n\n{\"a\":2}\n", `[{"a":1},{"a":2}]`},
+		{"tsv", pb.DataFormat_TSV, "name\tage\nAda\t36\n", `[{"name":"Ada","age":"36"}]`},
+		{"ini", pb.DataFormat_INI, "; comment\nname = app\n\n[db]\nhost = localhost ; inline\nport: 5432\nmotd = \"a ; b\"\n",
+			`{"name":"app","db":{"host":"localhost","port":"5432","motd":"a ; b"}}`},
+		{"env", pb.DataFormat_ENV, "# comment\nexport A=1\nB=\"two\\nlines\"\nC='lit $x'\nD=bare # note\nE=\"multi\nline\"\n",
+			`{"A":"1","B":"two\nlines","C":"lit $x","D":"bare","E":"multi\nline"}`},
+		{"properties", pb.DataFormat_PROPERTIES, "# c\n! c\nserver.port=8080\nserver.hosts[0]=a\nserver.hosts[1] = b\nmsg : hello \\\n    world\nkey\\ with\\ space value\nuni=caf\\u00e9\n",
+			`{"server":{"port":"8080","hosts":["a","b"]},"msg":"hello world","key with space":"value","uni":"café"}`},
+		{"json5", pb.DataFormat_JSON5, "// config\n{\n  unquoted: 'single',\n  hex: 0xFF, pos: +1, frac: .5,\n  /* block */ list: [1, 2,],\n  \"quoted\": \"a\\\nb\",\n}\n",
+			`{"unquoted":"single","hex":255,"pos":1,"frac":0.5,"list":[1,2],"quoted":"ab"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := decodeJSON(t, convert(t, tt.data, tt.format, pb.DataFormat_JSON))
+			if want := decodeJSON(t, tt.want); !reflect.DeepEqual(got, want) {
+				t.Errorf("got %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestConvert_ReadHCL(t *testing.T) {
+	src := `# Terraform
+variable "region" {
+  default = "eu-west-1"
+}
+
+resource "aws_instance" "web" {
+  ami           = "ami-123"
+  count         = 2
+  monitoring    = true
+  subnet_id     = aws_subnet.main.id
+  tags = {
+    Name = "web-${var.env}"
+    "kubernetes.io/role" = "node"
+  }
+  ports = [80, 443]
+  user_data = <<-EOT
+    #!/bin/sh
+    echo hi
+  EOT
+
+  ebs_block_device { device_name = "/dev/sdb" }
+  ebs_block_device { device_name = "/dev/sdc" }
+}
+`
+	got := decodeJSON(t, convert(t, src, pb.DataFormat_HCL, pb.DataFormat_JSON))
+	want := decodeJSON(t, `{
+		"variable": {"region": {"default": "eu-west-1"}},
+		"resource": {"aws_instance": {"web": {
+			"ami": "ami-123", "count": 2, "monitoring": true,
+			"subnet_id": "${aws_subnet.main.id}",
+			"tags": {"Name": "web-${var.env}", "kubernetes.io/role": "node"},
+			"ports": [80, 443],
+			"user_data": "#!/bin/sh\necho hi\n",
+			"ebs_block_device": [{"device_name": "/dev/sdb"}, {"device_name": "/dev/sdc"}]
+		}}}
+	}`)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v\nwant %v", got, want)
+	}
+}
+
+func TestConvert_Write(t *testing.T) {
+	src := `{"name":"app","port":8080,"debug":false,"db":{"host":"db.local","pass":"p@ss word"}}`
+	tests := []struct {
+		format pb.DataFormat
+		want   string
+	}{
+		{pb.DataFormat_INI, "debug = false\nname = app\nport = 8080\n\n[db]\nhost = db.local\npass = p@ss word\n"},
+		{pb.DataFormat_PROPERTIES, "db.host=db.local\ndb.pass=p@ss word\ndebug=false\nname=app\nport=8080\n"},
+		{pb.DataFormat_HCL, "debug = false\nname  = \"app\"\nport  = 8080\n\ndb {\n  host = \"db.local\"\n  pass = \"p@ss word\"\n}\n"},
+		{pb.DataFormat_JSON5, "{\n  db: {\n    host: \"db.local\",\n    pass: \"p@ss word\",\n  },\n  debug: false,\n  name: \"app\",\n  port: 8080,\n}\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format.String(), func(t *testing.T) {
+			if got := convert(t, src, pb.DataFormat_JSON, tt.format); got != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvert_WriteLineFormats(t *testing.T) {
+	if got := convert(t, `[{"a":"<b>"},2]`, pb.DataFormat_JSON, pb.DataFormat_NDJSON); got != "{\"a\":\"<b>\"}\n2\n" {
+		t.Errorf("ndjson = %q", got)
+	}
+	if got := convert(t, `[{"a":1,"b":"x y"}]`, pb.DataFormat_JSON, pb.DataFormat_TSV); got != "a\tb\n1\tx y\n" {
+		t.Errorf("tsv = %q", got)
+	}
+	if got := convert(t, `{"A":"1","B":"two words","C":"$HOME"}`, pb.DataFormat_JSON, pb.DataFormat_ENV); got != "A=1\nB=\"two words\"\nC=\"\\$HOME\"\n" {
+		t.Errorf("env = %q", got)
+	}
+}
+
+func TestConvert_RoundTrip(t *testing.T) {
+	src := `{"app":{"name":"x","ports":[80,443],"nested":{"deep":"v"}},"list":[{"k":"a"},{"k":"b"}],"note":"multi\nline \"q\" ü"}`
+	for _, f := range []pb.DataFormat{pb.DataFormat_NDJSON, pb.DataFormat_HCL, pb.DataFormat_JSON5} {
+		back := convert(t, convert(t, src, pb.DataFormat_JSON, f), f, pb.DataFormat_JSON)
+		want := decodeJSON(t, src)
+		if f == pb.DataFormat_NDJSON {
+			want = []any{want}
+		}
+		if got := decodeJSON(t, back); !reflect.DeepEqual(got, want) {
+			t.Errorf("%v round trip = %v", f, got)
+		}
+	}
+	// Properties values are untyped, so compare against the stringified source.
+	props := `{"app":{"name":"x","ports":["80","443"]},"note":"multi\nline ü"}`
+	back := convert(t, convert(t, props, pb.DataFormat_JSON, pb.DataFormat_PROPERTIES), pb.DataFormat_PROPERTIES, pb.DataFormat_JSON)
+	if got := decodeJSON(t, back); !reflect.DeepEqual(got, decodeJSON(t, props)) {
+		t.Errorf("properties round trip = %v", got)
+	}
+}
+
+func TestConvert_Unrepresentable(t *testing.T) {
+	tests := []struct {
+		data   string
+		target pb.DataFormat
+		want   string
+	}{
+		{`{"db":{"host":"x"}}`, pb.DataFormat_ENV, `nested value at "db" cannot be represented in .env`},
+		{`{"bad-key":"x"}`, pb.DataFormat_ENV, "not a valid .env variable name"},
+		{`[1,2]`, pb.DataFormat_ENV, "requires an object"},
+		{`{"a":{"b":{"c":1}}}`, pb.DataFormat_INI, `nested value at "a.b"`},
+		{`{"a":[1]}`, pb.DataFormat_INI, `nested value at "a"`},
+		{`{"has space":1}`, pb.DataFormat_HCL, "not a valid HCL identifier"},
+		{`"scalar"`, pb.DataFormat_PROPERTIES, "requires an object"},
+		{`{"a.b":"v","a":{"b":"w"}}`, pb.DataFormat_PROPERTIES, `key "a.b" collides with a nested path`},
+		{`{"a[0]":"v","a":["w"]}`, pb.DataFormat_PROPERTIES, `key "a[0]" collides`},
+	}
+	for _, tt := range tests {
+		resp, _ := NewServer().Convert(context.Background(), &pb.ConvertRequest{
+			Data: tt.data, SourceFormat: pb.DataFormat_JSON, TargetFormat: tt.target,
+		})
+		if !strings.Contains(resp.Error, tt.want) {
+			t.Errorf("%s → %v: error = %q, want it to contain %q", tt.data, tt.target, resp.Error, tt.want)
+		}
+	}
+}
+
+func TestConvert_NonFiniteToJSON(t *testing.T) {
+	tests := []struct {
+		data   string
+		source pb.DataFormat
+		target pb.DataFormat
+		want   string
+	}{
+		{`{a: [1, Infinity], b: NaN}`, pb.DataFormat_JSON5, pb.DataFormat_JSON, `Infinity at "/a/1" cannot be represented in JSON`},
+		{`-Infinity`, pb.DataFormat_JSON5, pb.DataFormat_JSON, "-Infinity at the root"},
+		{"x: [{y: .nan}]\n", pb.DataFormat_YAML, pb.DataFormat_NDJSON, `NaN at "/x/0/y"`},
+	}
+	for _, tt := range tests {
+		resp, _ := NewServer().Convert(context.Background(), &pb.ConvertRequest{
+			Data: tt.data, SourceFormat: tt.source, TargetFormat: tt.target,
+		})
+		if !strings.Contains(resp.Error, tt.want) {
+			t.Errorf("%s → %v: error = %q, want it to contain %q", tt.data, tt.target, resp.Error, tt.want)
+		}
+	}
+}
+
+func TestValidateData_NewFormats(t *testing.T) {
+	tests := []struct {
+		format pb.DataFormat
+		data   string
+		valid  bool
+		want   string
+	}{
+		{pb.DataFormat_NDJSON, "{}\n{bad}\n", false, "line 2"},
+		{pb.DataFormat_ENV, "OK=1\nnot a pair\n", false, "line 2"},
+		{pb.DataFormat_INI, "[unterminated\n", false, "line 1"},
+		{pb.DataFormat_PROPERTIES, "a=1\na.b=2\n", false, "conflicts"},
+		{pb.DataFormat_HCL, "a = {\n", false, "line"},
+		{pb.DataFormat_JSON5, "{a: 1,,}", false, "column 7"},
+		{pb.DataFormat_HCL, "a = 1\n", true, ""},
+	}
+	for _, tt := range tests {
+		resp, _ := NewServer().ValidateData(context.Background(), &pb.ValidateRequest{Data: tt.data, Format: tt.format})
+		if resp.Valid != tt.valid || !strings.Contains(resp.Error, tt.want) {
+			t.Errorf("%v %q: valid = %v, error = %q", tt.format, tt.data, resp.Valid, resp.Error)
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+// JsonFormat re-indents or minifies JSON. Key order and number literals are
+// kept exactly as written; sort_keys sorts keys recursively and canonical
+// writes RFC 8785 (JCS) output for hashing and signing. With lenient set,
+// JSONC and JSON5 input is accepted and normalized to strict JSON.
+func (s *Server) JsonFormat(ctx context.Context, req *pb.JsonFormatRequest) (*pb.JsonFormatResponse, error) {
+	data, line, col, err := parseJSONDocument(req.Text, req.Lenient)
+	if err != nil {
+		kind := "JSON"
+		if req.Lenient {
+			kind = "JSON5"
+		}
+		msg := fmt.Sprintf("Invalid %s at line %d, column %d: %v", kind, line, col, err)
+		if !req.Lenient {
+			if _, _, _, lerr := parseJSONDocument(req.Text, true); lerr == nil {
+				msg += " (the input is valid JSONC/JSON5; enable lenient parsing to normalize it)"
+			}
+		}
+		return &pb.JsonFormatResponse{Error: msg, ErrorLine: int32(line), ErrorColumn: int32(col)}, nil // #nosec G115
+	}
+
+	w := &jsonWriter{sortKeys: req.SortKeys, canonical: req.Canonical}
+	if !req.Canonical {
+		switch req.Indent {
+		case "min":
+		case "4":
+			w.indent = "    "
+		case "tab":
+			w.indent = "\t"
+		default:
+			w.indent = "  "
+		}
+	}
+	if err := w.value(data, 0); err != nil {
+		return &pb.JsonFormatResponse{Error: fmt.Sprintf("Formatting failed: %v", err)}, nil
+	}
+	return &pb.JsonFormatResponse{Text: w.b.String()}, nil
+}
+
+// parseJSONDocument decodes text with objects as *orderedObject and numbers
+// as json.Number. Errors carry the 1-based position they occurred at.
+func parseJSONDocument(text string, lenient bool) (v any, line, col int, err error) {
+	if lenient {
+		p := &json5Parser{src: text, ordered: true}
+		if v, err = p.document(); err != nil {
+			line, col = offsetToLineCol(text, p.pos)
+			return nil, line, col, err
+		}
+		return v, 0, 0, nil
+	}
+	var raw json.RawMessage
+	if err := json.Unmarshal([]byte(text), &raw); err != nil {
+		off := len(text)
+		var se *json.SyntaxError
+		if errors.As(err, &se) {
+			off = int(se.Offset)
+			// The offset is just past the offending byte.
+			if strings.HasPrefix(se.Error(), "invalid character") && off > 0 {
+				off--
+			}
+		}
+		line, col = offsetToLineCol(text, off)
+		return nil, line, col, err
+	}
+	v, err = decodeOrderedJSON(text)
+	return v, 0, 0, err
+}
+
+// jsonWriter writes decoded JSON. An empty indent writes compact output.
+type jsonWriter struct {
+	b         strings.Builder
+	indent    string
+	sortKeys  bool
+	canonical bool
+}
+
+func (w *jsonWriter) newline(depth int) {
+	if w.indent != "" {
+		w.b.WriteByte('\n')
+		w.b.WriteString(strings.Repeat(w.indent, depth))
+	}
+}
+
+func (w *jsonWriter) value(v any, depth int) error {
+	switch val := v.(type) {
+	case nil:
+		w.b.WriteString("null")
+	case bool:
+		w.b.WriteString(strconv.FormatBool(val))
+	case string:
+		writeJSONString(&w.b, val)
+	case json.Number:
+		if !w.canonical {
+			w.b.WriteString(string(val))
+			return nil
+		}
+		n, err := canonicalNumber(string(val))
+		if err != nil {
+			return err
+		}
+		w.b.WriteString(n)
+	case []any:
+		if len(val) == 0 {
+			w.b.WriteString("[]")
+			return nil
+		}
+		w.b.WriteByte('[')
+		for i, item := range val {
+			if i > 0 {
+				w.b.WriteByte(',')
+			}
+			w.newline(depth + 1)
+			if err := w.value(item, depth+1); err != nil {
+				return err
+			}
+		}
+		w.newline(depth)
+		w.b.WriteByte(']')
+	case *orderedObject:
+		if len(val.keys) == 0 {
+			w.b.WriteString("{}")
+			return nil
+		}
+		keys := val.keys
+		switch {
+		case w.canonical:
+			keys = slices.SortedFunc(slices.Values(keys), compareUTF16)
+		case w.sortKeys:
+			keys = slices.Sorted(slices.Values(keys))
+		}
+		w.b.WriteByte('{')
+		for i, k := range keys {
+			if i > 0 {
+				w.b.WriteByte(',')
+			}
+			w.newline(depth + 1)
+			writeJSONString(&w.b, k)
+			w.b.WriteByte(':')
+			if w.indent != "" {
+				w.b.WriteByte(' ')
+			}
+			if err := w.value(val.values[k], depth+1); err != nil {
+				return err
+			}
+		}
+		w.newline(depth)
+		w.b.WriteByte('}')
+	default:
+		return fmt.Errorf("unexpected %T", v)
+	}
+	return nil
+}
+
+// writeJSONString quotes s with the minimal escaping RFC 8785 prescribes:
+// quote, backslash and control characters only.
+func writeJSONString(b *strings.Builder, s string) {
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(b, `\u%04x`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+}
+
+// compareUTF16 orders strings by UTF-16 code units, as RFC 8785 sorts keys.
+func compareUTF16(a, b string) int {
+	return slices.Compare(utf16.Encode([]rune(a)), utf16.Encode([]rune(b)))
+}
+
+// canonicalNumber formats a number as ECMAScript's Number.prototype.toString
+// does, which is what RFC 8785 requires. Like JavaScript, it rounds to the
+// nearest double, so integers beyond 2^53 lose precision.
+func canonicalNumber(lit string) (string, error) {
+	f, err := strconv.ParseFloat(lit, 64)
+	if err != nil || math.IsInf(f, 0) {
+		return "", fmt.Errorf("number %s is out of range for canonical JSON", lit)
+	}
+	if f == 0 {
+		return "0", nil
+	}
+	sign := ""
+	if f < 0 {
+		sign, f = "-", -f
+	}
+	// Shortest round-tripping digits and the decimal exponent n, such that
+	// the value is 0.digits × 10^n.
+	mant, exp, _ := strings.Cut(strconv.FormatFloat(f, 'e', -1, 64), "e")
+	digits := strings.Replace(mant, ".", "", 1)
+	e, _ := strconv.Atoi(exp)
+	k, n := len(digits), e+1
+	switch {
+	case k <= n && n <= 21:
+		return sign + digits + strings.Repeat("0", n-k), nil
+	case 0 < n && n <= 21:
+		return sign + digits[:n] + "." + digits[n:], nil
+	case -6 < n && n <= 0:
+		return sign + "0." + strings.Repeat("0", -n) + digits, nil
+	}
+	s := digits[:1]
+	if k > 1 {
+		s += "." + digits[1:]
+	}
+	if n-1 >= 0 {
+		return sign + s + "e+" + strconv.Itoa(n-1), nil
+	}
+	return sign + s + "e" + strconv.Itoa(n-1), nil
+}
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+func TestJsonFormat_Output(t *testing.T) {
+	s := NewServer()
+
+	tests := []struct {
+		name string
+		req  *pb.JsonFormatRequest
+		want string
+	}{
+		{"key order and numbers preserved", &pb.JsonFormatRequest{Text: `{"b":12345678901234567890,"a":1.50,"c":[]}`},
+			"{\n  \"b\": 12345678901234567890,\n  \"a\": 1.50,\n  \"c\": []\n}"},
+		{"sort keys recursively", &pb.JsonFormatRequest{Text: `{"b":{"z":1,"y":[{"d":1,"c":2}]},"a":{}}`, SortKeys: true, Indent: "min"},
+			`{"a":{},"b":{"y":[{"c":2,"d":1}],"z":1}}`},
+		{"tab indent", &pb.JsonFormatRequest{Text: `[1,{"a":"<&>"}]`, Indent: "tab"},
+			"[\n\t1,\n\t{\n\t\t\"a\": \"<&>\"\n\t}\n]"},
+		{"canonical RFC 8785 example", &pb.JsonFormatRequest{Canonical: true, Indent: "4",
+			Text: `{"numbers":[333333333.33333329,1E30,4.50,2e-3,0.000000000000000000000000001],` +
+				`"string":"\u20ac$\u000F\u000aA'\u0042\u0022\u005c\\\"\/","literals":[null,true,false]}`},
+			`{"literals":[null,true,false],"numbers":[333333333.3333333,1e+30,4.5,0.002,1e-27],"string":"€$\u000f\nA'B\"\\\\\"/"}`},
+		{"canonical key order is UTF-16", &pb.JsonFormatRequest{Canonical: true,
+			Text: `{"\u20ac":1,"\r":2,"\ufb33":3,"1":4,"\ud83d\ude00":5,"\u0080":6,"\u00f6":7}`},
+			"{\"\\r\":2,\"1\":4,\"\u0080\":6,\"ö\":7,\"€\":1,\"😀\":5,\"\ufb33\":3}"},
+		{"canonical numbers", &pb.JsonFormatRequest{Canonical: true, Text: `[-0, 1e21, 1e20, 123e-20, 0.000001, 9007199254740993]`},
+			`[0,1e+21,100000000000000000000,1.23e-18,0.000001,9007199254740992]`},
+		{"lenient normalizes JSON5", &pb.JsonFormatRequest{Lenient: true, Indent: "min",
+			Text: "// config\n{unquoted: 'it\\'s', trailing: [1, 2,], hex: 0xFF, half: .5, big: 123456789012345678901234567890, /* c */}"},
+			`{"unquoted":"it's","trailing":[1,2],"hex":255,"half":0.5,"big":123456789012345678901234567890}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.JsonFormat(context.Background(), tt.req)
+			if err != nil {
+				t.Fatalf("JsonFormat() error = %v", err)
+			}
+			if resp.Error != "" {
+				t.Fatalf("JsonFormat() error = %s", resp.Error)
+			}
+			if resp.Text != tt.want {
+				t.Errorf("JsonFormat() =\n%s\nwant\n%s", resp.Text, tt.want)
+			}
+		})
+	}
+}
+
+func TestJsonFormat_Errors(t *testing.T) {
+	s := NewServer()
+
+	tests := []struct {
+		name      string
+		req       *pb.JsonFormatRequest
+		line, col int32
+		contains  string
+	}{
+		{"bad character", &pb.JsonFormatRequest{Text: "{\n  \"a\": 1,\n  b: 2\n}"}, 3, 3, "invalid character 'b'"},
+		{"trailing comma hints lenient", &pb.JsonFormatRequest{Text: "[1,\n 2,\n]"}, 3, 1, "enable lenient parsing"},
+		{"unexpected end", &pb.JsonFormatRequest{Text: `{"a": [1`}, 1, 9, "unexpected end"},
+		{"lenient error", &pb.JsonFormatRequest{Text: "{a: 1,\n b: }", Lenient: true}, 2, 5, "unexpected '}'"},
+		{"NaN is not JSON", &pb.JsonFormatRequest{Text: "[NaN]", Lenient: true}, 1, 2, "no JSON equivalent"},
+		{"canonical out of range", &pb.JsonFormatRequest{Text: "[1e400]", Canonical: true}, 0, 0, "out of range"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, _ := s.JsonFormat(context.Background(), tt.req)
+			if !strings.Contains(resp.Error, tt.contains) {
+				t.Errorf("JsonFormat() error = %q, want %q", resp.Error, tt.contains)
+			}
+			if resp.ErrorLine != tt.line || resp.ErrorColumn != tt.col {
+				t.Errorf("JsonFormat() position = %d:%d, want %d:%d", resp.ErrorLine, resp.ErrorColumn, tt.line, tt.col)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/odinnordico/privutil/internal/query"
+	"github.com/odinnordico/privutil/internal/textdiff"
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+// Merge3 merges the changes from base to mine and from base to theirs,
+// either line by line with git-style conflict markers or, for structured
+// documents, value by value so that only competing changes to the same key
+// conflict.
+func (s *Server) Merge3(_ context.Context, req *pb.Merge3Request) (*pb.Merge3Response, error) {
+	if req.Mode == pb.MergeMode_MERGE_STRUCTURED {
+		return mergeStructured(req), nil
+	}
+
+	regions, err := textdiff.Merge3(textdiff.Lines(req.Base), textdiff.Lines(req.Mine), textdiff.Lines(req.Theirs),
+		textdiff.LineKey(textdiff.Options{}))
+	if err != nil {
+		return &pb.Merge3Response{Error: err.Error()}, nil
+	}
+	mineLabel := cmp.Or(req.MineLabel, "mine")
+	baseLabel := cmp.Or(req.BaseLabel, "base")
+	theirsLabel := cmp.Or(req.TheirsLabel, "theirs")
+
+	resp := &pb.Merge3Response{}
+	var b strings.Builder
+	line := 1
+	write := func(lines []string, closeLast bool) {
+		for _, l := range lines {
+			b.WriteString(l)
+			line++
+		}
+		if closeLast && len(lines) > 0 && !strings.HasSuffix(lines[len(lines)-1], "\n") {
+			b.WriteByte('\n') // markers must start on their own line
+		}
+	}
+	marker := func(m, label string) {
+		b.WriteString(m + " " + label + "\n")
+		line++
+	}
+	for _, r := range regions {
+		if !r.Conflict {
+			write(r.Lines, false)
+			continue
+		}
+		n := r.BaseEnd - r.BaseStart
+		resp.Conflicts = append(resp.Conflicts, &pb.MergeConflict{
+			Line:      int32(line),                      // #nosec G115
+			BaseStart: int32(hunkStart(r.BaseStart, n)), // #nosec G115
+			BaseLines: int32(n),                         // #nosec G115
+			Base:      strings.Join(r.Base, ""),
+			Mine:      strings.Join(r.Mine, ""),
+			Theirs:    strings.Join(r.Theirs, ""),
+		})
+		marker("<<<<<<<", mineLabel)
+		write(r.Mine, true)
+		if req.Diff3Style {
+			marker("|||||||", baseLabel)
+			write(r.Base, true)
+		}
+		b.WriteString("=======\n")
+		line++
+		write(r.Theirs, true)
+		marker(">>>>>>>", theirsLabel)
+	}
+	resp.Merged = b.String()
+	resp.Clean = len(resp.Conflicts) == 0
+	return resp, nil
+}
+
+// ── Structured merge ──────────────────────────────────────────────────────────
+
+// absentValue stands for a key missing from one of the three documents.
+type absentValue struct{}
+
+var mergeAbsent any = absentValue{}
+
+func mergeStructured(req *pb.Merge3Request) *pb.Merge3Response {
+	docs := make([]any, 3)
+	for i, src := range []string{req.Base, req.Mine, req.Theirs} {
+		if i == 0 && strings.TrimSpace(src) == "" {
+			docs[i] = mergeAbsent // no common ancestor: everything is an addition
+			continue
+		}
+		v, err := parseConvertSource(&pb.ConvertRequest{Data: src, SourceFormat: req.Format})
+		if err != nil {
+			return &pb.Merge3Response{Error: fmt.Sprintf("Parse failed (%s): %v", []string{"base", "mine", "theirs"}[i], err)}
+		}
+		docs[i] = v
+	}
+
+	m := &structMerge{}
+	merged := m.merge("", docs[0], docs[1], docs[2])
+	if merged == mergeAbsent {
+		merged = nil
+	}
+	out, err := marshalTarget(merged, &pb.ConvertRequest{TargetFormat: req.Format})
+	if err != nil {
+		return &pb.Merge3Response{Error: fmt.Sprintf("Conversion failed: %v", err)}
+	}
+	return &pb.Merge3Response{Merged: string(out), Clean: len(m.conflicts) == 0, Conflicts: m.conflicts}
+}
+
+type structMerge struct {
+	conflicts []*pb.MergeConflict
+}
+
+// merge returns the merged value at path. Objects merge key by key, keeping
+// mine's key order with theirs' new keys after it; arrays merge element by
+// element like lines. Anything else that both sides changed differently
+// is a conflict, resolved to mine's value.
+func (m *structMerge) merge(path string, base, mine, theirs any) any {
+	switch {
+	case sameValue(mine, theirs), sameValue(base, theirs):
+		return mine
+	case sameValue(base, mine):
+		return theirs
+	}
+
+	mk, mv, mok := objectFields(mine)
+	tk, tv, tok := objectFields(theirs)
+	if mok && tok {
+		_, bv, _ := objectFields(base)
+		out := &orderedObject{values: map[string]any{}}
+		keys := append([]string(nil), mk...)
+		for _, k := range tk {
+			if _, ok := mv[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		field := func(values map[string]any, k string) any {
+			if v, ok := values[k]; ok {
+				return v
+			}
+			return mergeAbsent
+		}
+		for _, k := range keys {
+			v := m.merge(path+"/"+escapePointer(k), field(bv, k), field(mv, k), field(tv, k))
+			if v != mergeAbsent {
+				out.keys = append(out.keys, k)
+				out.values[k] = v
+			}
+		}
+		return out
+	}
+
+	ma, mok := mine.([]any)
+	ta, tok := theirs.([]any)
+	ba, bok := base.([]any)
+	if mok && tok && bok {
+		if merged, ok := mergeArrays(ba, ma, ta); ok {
+			return merged
+		}
+	}
+
+	m.conflicts = append(m.conflicts, &pb.MergeConflict{
+		Path:   path,
+		Base:   mergeText(base),
+		Mine:   mergeText(mine),
+		Theirs: mergeText(theirs),
+	})
+	return mine
+}
+
+// mergeArrays runs a three-way merge over the elements' JSON encodings;
+// ok is false when the element changes conflict.
+func mergeArrays(base, mine, theirs []any) ([]any, bool) {
+	values := map[string]any{}
+	encode := func(arr []any) []string {
+		out := make([]string, len(arr))
+		for i, v := range arr {
+			out[i] = compactJSON(v)
+			values[out[i]] = v
+		}
+		return out
+	}
+	regions, err := textdiff.Merge3(encode(base), encode(mine), encode(theirs), func(s string) string { return s })
+	if err != nil {
+		return nil, false
+	}
+	merged := []any{}
+	for _, r := range regions {
+		if r.Conflict {
+			return nil, false
+		}
+		for _, e := range r.Lines {
+			merged = append(merged, values[e])
+		}
+	}
+	return merged, true
+}
+
+func sameValue(a, b any) bool {
+	if a == mergeAbsent || b == mergeAbsent {
+		return a == b
+	}
+	return reflect.DeepEqual(query.Normalize(plainValue(a)), query.Normalize(plainValue(b)))
+}
+
+func mergeText(v any) string {
+	if v == mergeAbsent {
+		return ""
+	}
+	return compactJSON(v)
+}
@@ -0,0 +1,119 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+func TestMerge3_Lines(t *testing.T) {
+	base := "host: db\nport: 5432\nuser: app\n"
+	tests := []struct {
+		name   string
+		req    *pb.Merge3Request
+		want   string
+		clean  bool
+		line   int32
+		bstart int32
+	}{
+		{"clean", &pb.Merge3Request{Mine: "host: db1\nport: 5432\nuser: app\n", Theirs: "host: db\nport: 5432\nuser: svc\n"},
+			"host: db1\nport: 5432\nuser: svc\n", true, 0, 0},
+		{"conflict", &pb.Merge3Request{Mine: "host: db\nport: 6432\nuser: app\n", Theirs: "host: db\nport: 7432\nuser: app\n"},
+			"host: db\n<<<<<<< mine\nport: 6432\n=======\nport: 7432\n>>>>>>> theirs\nuser: app\n", false, 2, 2},
+		{"diff3 style and labels", &pb.Merge3Request{
+			Mine: "host: db\nport: 6432\nuser: app\n", Theirs: "host: db\nport: 7432\nuser: app\n",
+			Diff3Style: true, MineLabel: "HEAD", TheirsLabel: "feature",
+		}, "host: db\n<<<<<<< HEAD\nport: 6432\n||||||| base\nport: 5432\n=======\nport: 7432\n>>>>>>> feature\nuser: app\n", false, 2, 2},
+		{"missing final newline", &pb.Merge3Request{Mine: "host: db\nport: 5432\nuser: a", Theirs: "host: db\nport: 5432\nuser: b"},
+			"host: db\nport: 5432\n<<<<<<< mine\nuser: a\n=======\nuser: b\n>>>>>>> theirs\n", false, 3, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Base = base
+			resp, err := NewServer().Merge3(context.Background(), tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.Merged != tt.want {
+				t.Errorf("merged = %q, want %q", resp.Merged, tt.want)
+			}
+			if resp.Clean != tt.clean {
+				t.Errorf("clean = %v", resp.Clean)
+			}
+			if !tt.clean {
+				if len(resp.Conflicts) != 1 {
+					t.Fatalf("conflicts = %v", resp.Conflicts)
+				}
+				c := resp.Conflicts[0]
+				if c.Line != tt.line || c.BaseStart != tt.bstart || c.BaseLines != 1 {
+					t.Errorf("conflict = %+v", c)
+				}
+			}
+		})
+	}
+}
+
+func TestMerge3_Structured(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *pb.Merge3Request
+		want      string
+		conflicts []string // paths
+	}{
+		{"json keys", &pb.Merge3Request{
+			Base:   `{"name": "svc", "port": 80, "tags": ["a", "b"]}`,
+			Mine:   `{"name": "svc", "port": 8080, "tags": ["a", "b", "c"]}`,
+			Theirs: `{"name": "api", "port": 80, "tags": ["z", "a", "b"], "debug": true}`,
+		}, "{\n  \"name\": \"api\",\n  \"port\": 8080,\n  \"tags\": [\n    \"z\",\n    \"a\",\n    \"b\",\n    \"c\"\n  ],\n  \"debug\": true\n}", nil},
+		{"yaml conflict keeps mine", &pb.Merge3Request{
+			Format: pb.DataFormat_YAML,
+			Base:   "db:\n  host: a\n  port: 1\nlog: info\n",
+			Mine:   "db:\n  host: b\n  port: 1\n",
+			Theirs: "db:\n  host: c\n  port: 2\nlog: debug\n",
+		}, "db:\n    host: b\n    port: 2\n", []string{"/db/host", "/log"}},
+		{"no base", &pb.Merge3Request{Mine: `{"a": 1}`, Theirs: `{"b": 2}`}, "{\n  \"a\": 1,\n  \"b\": 2\n}", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Mode = pb.MergeMode_MERGE_STRUCTURED
+			resp, err := NewServer().Merge3(context.Background(), tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.Error != "" {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+			if resp.Merged != tt.want {
+				t.Errorf("merged = %q, want %q", resp.Merged, tt.want)
+			}
+			if resp.Clean != (len(tt.conflicts) == 0) || len(resp.Conflicts) != len(tt.conflicts) {
+				t.Fatalf("clean = %v, conflicts = %v", resp.Clean, resp.Conflicts)
+			}
+			for i, c := range resp.Conflicts {
+				if c.Path != tt.conflicts[i] {
+					t.Errorf("conflict %d path = %s, want %s", i, c.Path, tt.conflicts[i])
+				}
+			}
+		})
+	}
+}
+
+func TestMerge3_StructuredConflictValues(t *testing.T) {
+	resp, _ := NewServer().Merge3(context.Background(), &pb.Merge3Request{
+		Mode:   pb.MergeMode_MERGE_STRUCTURED,
+		Base:   `{"a": 1}`,
+		Mine:   `{}`,
+		Theirs: `{"a": 2}`,
+	})
+	if len(resp.Conflicts) != 1 {
+		t.Fatalf("conflicts = %v", resp.Conflicts)
+	}
+	c := resp.Conflicts[0]
+	if c.Path != "/a" || c.Base != "1" || c.Mine != "" || c.Theirs != "2" {
+		t.Errorf("conflict = %+v", c)
+	}
+	if resp.Merged != "{}" {
+		t.Errorf("merged = %q", resp.Merged)
+	}
+}
@@ -0,0 +1,169 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/odinnordico/privutil/internal/textdiff"
+	pb "github.com/odinnordico/privutil/proto"
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+// patchFuzz is how many context lines a unified hunk may ignore at each end
+// when the request leaves fuzz at 0; patch(1) uses the same default.
+const patchFuzz = 2
+
+// PatchApply applies a unified diff or a diff-match-patch patch to text,
+// reporting for each hunk whether and where it applied.
+func (s *Server) PatchApply(_ context.Context, req *pb.PatchApplyRequest) (*pb.PatchApplyResponse, error) {
+	if strings.TrimSpace(req.Patch) == "" {
+		return &pb.PatchApplyResponse{Error: "patch is empty"}, nil
+	}
+	if req.Format == pb.TextPatchFormat_TEXT_PATCH_DMP {
+		return applyDMPPatch(req), nil
+	}
+
+	files, err := textdiff.ParseUnified(req.Patch)
+	if err != nil {
+		return &pb.PatchApplyResponse{Error: fmt.Sprintf("Invalid patch: %v", err)}, nil
+	}
+	file, err := patchFile(files, req.File)
+	if err != nil {
+		return &pb.PatchApplyResponse{Error: err.Error()}, nil
+	}
+	oldName, newName := cmp.Or(file.OldName, "a"), cmp.Or(file.NewName, "b")
+	hunks := file.Hunks
+	if req.Reverse {
+		hunks = make([]textdiff.PatchHunk, len(file.Hunks))
+		for i, h := range file.Hunks {
+			hunks[i] = h.Reverse()
+		}
+		oldName, newName = newName, oldName
+	}
+	fuzz := int(req.Fuzz)
+	if fuzz == 0 {
+		fuzz = patchFuzz
+	}
+
+	lines := textdiff.Lines(req.Text)
+	out, results := textdiff.Apply(lines, hunks, max(fuzz, 0))
+	resp := &pb.PatchApplyResponse{Result: strings.Join(out, ""), Clean: true}
+	for i, r := range results {
+		resp.Hunks = append(resp.Hunks, &pb.PatchHunkResult{
+			Index:  int32(i + 1), // #nosec G115
+			Header: hunks[i].Header,
+			Status: string(r.Status),
+			Offset: int32(r.Offset), // #nosec G115
+			Fuzz:   int32(r.Fuzz),   // #nosec G115
+			Line:   int32(r.Line),   // #nosec G115
+		})
+		resp.Clean = resp.Clean && (r.Status == textdiff.Applied || r.Status == textdiff.Fuzzy)
+	}
+
+	edits, err := textdiff.Diff(out, lines, textdiff.LineKey(textdiff.Options{}))
+	if err != nil {
+		return &pb.PatchApplyResponse{Error: fmt.Sprintf("Reverse patch failed: %v", err)}, nil
+	}
+	resp.ReversePatch = textdiff.Unified(out, lines, newName, oldName, textdiff.Hunks(edits, diffContextLines))
+	return resp, nil
+}
+
+// patchFile picks the file to apply from a parsed patch; name may carry
+// git's a/ and b/ prefixes or not.
+func patchFile(files []textdiff.FilePatch, name string) (textdiff.FilePatch, error) {
+	if name == "" {
+		if len(files) > 1 {
+			return textdiff.FilePatch{}, fmt.Errorf("patch changes %d files; set file to one of: %s", len(files), strings.Join(patchFileNames(files), ", "))
+		}
+		return files[0], nil
+	}
+	strip := func(s string) string {
+		if rest, ok := strings.CutPrefix(s, "a/"); ok {
+			return rest
+		}
+		return strings.TrimPrefix(s, "b/")
+	}
+	for _, f := range files {
+		for _, n := range []string{f.OldName, f.NewName} {
+			if n != "" && (n == name || strip(n) == strip(name)) {
+				return f, nil
+			}
+		}
+	}
+	return textdiff.FilePatch{}, fmt.Errorf("patch does not change %q; it changes: %s", name, strings.Join(patchFileNames(files), ", "))
+}
+
+func patchFileNames(files []textdiff.FilePatch) []string {
+	names := make([]string, len(files))
+	for i, f := range files {
+		names[i] = cmp.Or(f.NewName, f.OldName, "(unnamed)")
+		if names[i] == "/dev/null" {
+			names[i] = f.OldName // a deletion
+		}
+	}
+	return names
+}
+
+// ── diff-match-patch ──────────────────────────────────────────────────────────
+
+var dmpHeaderRe = regexp.MustCompile(`^@@ -(\S+) \+(\S+) @@$`)
+
+// applyDMPPatch applies patch_toText output. The library places hunks by
+// fuzzy character matching, so only success or failure is reported.
+func applyDMPPatch(req *pb.PatchApplyRequest) *pb.PatchApplyResponse {
+	text := req.Patch
+	if req.Reverse {
+		text = reverseDMPText(text)
+	}
+	dmp := diffmatchpatch.New()
+	patches, err := dmp.PatchFromText(text)
+	if err != nil {
+		return &pb.PatchApplyResponse{Error: fmt.Sprintf("Invalid patch: %v", err)}
+	}
+	// PatchApply splits long hunks before applying them; splitting here
+	// keeps the reported hunks in step with its results.
+	patches = dmp.PatchSplitMax(patches)
+	out, applied := dmp.PatchApply(patches, req.Text)
+
+	resp := &pb.PatchApplyResponse{Result: out, Clean: true}
+	for i, ok := range applied {
+		header, _, _ := strings.Cut(patches[i].String(), "\n")
+		status := textdiff.Applied
+		if !ok {
+			status = textdiff.Failed
+			resp.Clean = false
+		}
+		resp.Hunks = append(resp.Hunks, &pb.PatchHunkResult{
+			Index:  int32(i + 1), // #nosec G115
+			Header: header,
+			Status: string(status),
+		})
+	}
+	resp.ReversePatch = dmp.PatchToText(dmp.PatchMake(out, req.Text))
+	return resp
+}
+
+// reverseDMPText swaps the ranges in each header and the - and + lines,
+// which turns a diff-match-patch patch into the one that undoes it.
+func reverseDMPText(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, l := range lines {
+		if m := dmpHeaderRe.FindStringSubmatch(l); m != nil {
+			lines[i] = "@@ -" + m[2] + " +" + m[1] + " @@"
+			continue
+		}
+		if l == "" {
+			continue
+		}
+		switch l[0] {
+		case '-':
+			lines[i] = "+" + l[1:]
+		case '+':
+			lines[i] = "-" + l[1:]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
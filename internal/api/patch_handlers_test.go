@@ -0,0 +1,152 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/odinnordico/privutil/proto"
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+const patchTestText = "alpha\nbeta\ngamma\ndelta\nepsilon\n"
+
+const patchTestDiff = `diff --git a/greek.txt b/greek.txt
+--- a/greek.txt
++++ b/greek.txt
+@@ -1,3 +1,3 @@
+ alpha
+-beta
++BETA
+ gamma
+@@ -4,2 +4,3 @@
+ delta
+ epsilon
++zeta
+`
+
+func TestPatchApply_Unified(t *testing.T) {
+	patched := "alpha\nBETA\ngamma\ndelta\nepsilon\nzeta\n"
+	tests := []struct {
+		name     string
+		req      *pb.PatchApplyRequest
+		want     string
+		clean    bool
+		statuses string
+	}{
+		{"applies", &pb.PatchApplyRequest{Text: patchTestText, Patch: patchTestDiff}, patched, true, "applied,applied"},
+		{"offset", &pb.PatchApplyRequest{Text: "header\n" + patchTestText, Patch: patchTestDiff},
+			"header\n" + patched, true, "applied,applied"},
+		{"fuzz", &pb.PatchApplyRequest{Text: strings.Replace(patchTestText, "gamma", "GAMMA", 1), Patch: patchTestDiff},
+			"alpha\nBETA\nGAMMA\ndelta\nepsilon\nzeta\n", true, "fuzzy,applied"},
+		{"exact refuses fuzz", &pb.PatchApplyRequest{Text: strings.Replace(patchTestText, "gamma", "GAMMA", 1), Patch: patchTestDiff, Fuzz: -1},
+			"alpha\nbeta\nGAMMA\ndelta\nepsilon\nzeta\n", false, "failed,applied"},
+		{"already applied", &pb.PatchApplyRequest{Text: patched, Patch: patchTestDiff}, patched, false, "already applied,already applied"},
+		{"reverse", &pb.PatchApplyRequest{Text: patched, Patch: patchTestDiff, Reverse: true}, patchTestText, true, "applied,applied"},
+		{"file by name", &pb.PatchApplyRequest{Text: patchTestText, Patch: patchTestDiff, File: "greek.txt"}, patched, true, "applied,applied"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := NewServer().PatchApply(context.Background(), tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.Error != "" {
+				t.Fatal(resp.Error)
+			}
+			if resp.Result != tt.want {
+				t.Errorf("result = %q, want %q", resp.Result, tt.want)
+			}
+			if resp.Clean != tt.clean {
+				t.Errorf("clean = %v", resp.Clean)
+			}
+			var statuses []string
+			for _, h := range resp.Hunks {
+				statuses = append(statuses, h.Status)
+			}
+			if got := strings.Join(statuses, ","); got != tt.statuses {
+				t.Errorf("statuses = %s, want %s", got, tt.statuses)
+			}
+		})
+	}
+}
+
+func TestPatchApply_ReversePatch(t *testing.T) {
+	srv := NewServer()
+	resp, err := srv.PatchApply(context.Background(), &pb.PatchApplyRequest{Text: patchTestText, Patch: patchTestDiff})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(resp.ReversePatch, "--- b/greek.txt\n+++ a/greek.txt\n") {
+		t.Errorf("reverse patch = %q", resp.ReversePatch)
+	}
+	back, err := srv.PatchApply(context.Background(), &pb.PatchApplyRequest{Text: resp.Result, Patch: resp.ReversePatch})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back.Result != patchTestText || !back.Clean {
+		t.Errorf("round trip = %q (clean %v)", back.Result, back.Clean)
+	}
+	if h := resp.Hunks[0]; h.Index != 1 || h.Header != "@@ -1,3 +1,3 @@" || h.Line != 1 {
+		t.Errorf("hunk = %+v", h)
+	}
+}
+
+func TestPatchApply_DMP(t *testing.T) {
+	dmp := diffmatchpatch.New()
+	text, changed := "The quick brown fox jumps over the lazy dog.", "The quick red fox jumps over the sleepy dog."
+	patch := dmp.PatchToText(dmp.PatchMake(text, changed))
+
+	srv := NewServer()
+	resp, err := srv.PatchApply(context.Background(), &pb.PatchApplyRequest{Text: text, Patch: patch, Format: pb.TextPatchFormat_TEXT_PATCH_DMP})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Error != "" || resp.Result != changed || !resp.Clean || len(resp.Hunks) == 0 {
+		t.Fatalf("resp = %+v", resp)
+	}
+	if !strings.HasPrefix(resp.Hunks[0].Header, "@@ -") {
+		t.Errorf("header = %q", resp.Hunks[0].Header)
+	}
+
+	back, err := srv.PatchApply(context.Background(), &pb.PatchApplyRequest{Text: changed, Patch: patch, Format: pb.TextPatchFormat_TEXT_PATCH_DMP, Reverse: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back.Result != text || !back.Clean {
+		t.Errorf("reverse = %q (clean %v)", back.Result, back.Clean)
+	}
+	undo, err := srv.PatchApply(context.Background(), &pb.PatchApplyRequest{Text: changed, Patch: resp.ReversePatch, Format: pb.TextPatchFormat_TEXT_PATCH_DMP})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if undo.Result != text {
+		t.Errorf("reverse patch gives %q", undo.Result)
+	}
+}
+
+func TestPatchApply_Errors(t *testing.T) {
+	twoFiles := patchTestDiff + "--- a/other.txt\n+++ b/other.txt\n@@ -1 +1 @@\n-x\n+y\n"
+	tests := []struct {
+		name string
+		req  *pb.PatchApplyRequest
+		want string
+	}{
+		{"empty", &pb.PatchApplyRequest{Text: "x"}, "patch is empty"},
+		{"malformed", &pb.PatchApplyRequest{Patch: "@@ -1,2 +1,2 @@\n a\n"}, "Invalid patch"},
+		{"several files", &pb.PatchApplyRequest{Patch: twoFiles}, "b/greek.txt, b/other.txt"},
+		{"unknown file", &pb.PatchApplyRequest{Patch: twoFiles, File: "nope.txt"}, `does not change "nope.txt"`},
+		{"bad dmp", &pb.PatchApplyRequest{Patch: "@@ bogus", Format: pb.TextPatchFormat_TEXT_PATCH_DMP}, "Invalid patch"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := NewServer().PatchApply(context.Background(), tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(resp.Error, tt.want) {
+				t.Errorf("error = %q, want %q", resp.Error, tt.want)
+			}
+		})
+	}
+}
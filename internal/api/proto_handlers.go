@@ -0,0 +1,173 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/odinnordico/privutil/internal/protodec"
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+// protobufMaxFields caps the fields listed in a response; the JSON tree
+// still covers the whole payload.
+const protobufMaxFields = 5000
+
+// ProtobufDecode decodes Protocol Buffers wire bytes. Without a schema the
+// payload is walked into field numbers and wire types, with each value's
+// plausible readings; with a schema it is converted to protojson. Setting
+// encode converts protojson back to binary. A gRPC length-prefix header in
+// front of the message is skipped.
+func (s *Server) ProtobufDecode(ctx context.Context, req *pb.ProtobufDecodeRequest) (*pb.ProtobufDecodeResponse, error) {
+	resp := &pb.ProtobufDecodeResponse{}
+	var schema *protodec.Schema
+	if strings.TrimSpace(req.Schema) != "" {
+		var err error
+		if schema, err = protodec.LoadSchema(ctx, req.Schema); err != nil {
+			return &pb.ProtobufDecodeResponse{Error: fmt.Sprintf("Invalid schema: %v", err)}, nil
+		}
+		resp.MessageTypes = schema.MessageNames()
+	}
+
+	if req.Encode {
+		if schema == nil {
+			resp.Error = "Encoding JSON requires a schema"
+			return resp, nil
+		}
+		md, err := schema.Message(req.MessageType)
+		if err != nil {
+			resp.Error = err.Error()
+			return resp, nil
+		}
+		b, err := schema.FromJSON(md, []byte(req.Data))
+		if err != nil {
+			resp.Error = fmt.Sprintf("Invalid JSON for %s: %v", md.FullName(), err)
+			return resp, nil
+		}
+		resp.Base64, resp.Hex = base64.StdEncoding.EncodeToString(b), hex.EncodeToString(b)
+		if fields, err := protodec.Decode(b); err == nil {
+			resp.Fields = protobufFields(fields)
+		}
+		return resp, nil
+	}
+
+	b, err := protodec.DecodeInput(req.Data, req.Encoding)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, nil
+	}
+	if stripped, ok := protodec.StripGRPCFrame(b); ok {
+		b = stripped
+		resp.Warnings = append(resp.Warnings, "Skipped the 5-byte gRPC message header")
+	}
+	resp.Base64, resp.Hex = base64.StdEncoding.EncodeToString(b), hex.EncodeToString(b)
+
+	fields, err := protodec.Decode(b)
+	if err != nil {
+		resp.Error = fmt.Sprintf("Invalid wire data at %v", err)
+		return resp, nil
+	}
+	resp.Fields = protobufFields(fields)
+
+	if schema == nil {
+		resp.Json, err = protobufTreeJSON(fields)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+		return resp, nil
+	}
+	md, err := schema.Message(req.MessageType)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, nil
+	}
+	js, unknown, err := schema.ToJSON(md, b)
+	if err != nil {
+		resp.Error = fmt.Sprintf("Payload is not a valid %s: %v", md.FullName(), err)
+		return resp, nil
+	}
+	if unknown {
+		resp.Warnings = append(resp.Warnings, fmt.Sprintf("The payload has fields %s does not declare; they are left out of the JSON", md.FullName()))
+	}
+	// protojson randomizes its whitespace, so lay the output out the same
+	// way JsonFormat does.
+	v, _, _, err := parseJSONDocument(string(js), false)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp, nil
+	}
+	w := &jsonWriter{indent: "  "}
+	if err := w.value(v, 0); err != nil {
+		resp.Error = err.Error()
+		return resp, nil
+	}
+	resp.Json = w.b.String()
+	return resp, nil
+}
+
+// protobufFields flattens decoded fields depth first.
+func protobufFields(fields []protodec.Field) []*pb.ProtobufField {
+	var out []*pb.ProtobufField
+	var walk func(fields []protodec.Field, prefix string, depth int)
+	walk = func(fields []protodec.Field, prefix string, depth int) {
+		for _, f := range fields {
+			if len(out) >= protobufMaxFields {
+				return
+			}
+			path := prefix + strconv.Itoa(f.Number)
+			out = append(out, &pb.ProtobufField{
+				Path: path, Depth: int32(depth), Number: int32(f.Number), // #nosec G115
+				WireType: protodec.WireTypeName(f.WireType),
+				Offset:   int32(f.Offset), Length: int32(f.Length), // #nosec G115
+				Kind: string(f.Kind), Value: f.Value,
+				Interpretations: f.Interpretations,
+			})
+			walk(f.Fields, path+".", depth+1)
+		}
+	}
+	walk(fields, "", 0)
+	return out
+}
+
+// protobufTreeJSON renders schemaless fields as JSON keyed by field
+// number. Repeated numbers become arrays and bytes are base64, as in
+// protojson.
+func protobufTreeJSON(fields []protodec.Field) (string, error) {
+	w := &jsonWriter{indent: "  "}
+	if err := w.value(protobufTree(fields), 0); err != nil {
+		return "", err
+	}
+	return w.b.String(), nil
+}
+
+func protobufTree(fields []protodec.Field) *orderedObject {
+	obj := &orderedObject{values: map[string]any{}}
+	for _, f := range fields {
+		var v any
+		switch f.Kind {
+		case protodec.KindMessage, protodec.KindGroup:
+			v = protobufTree(f.Fields)
+		case protodec.KindString:
+			v = string(f.Bytes())
+		case protodec.KindBytes:
+			v = base64.StdEncoding.EncodeToString(f.Bytes())
+		default:
+			v = json.Number(f.Value)
+		}
+		key := strconv.Itoa(f.Number)
+		prev, ok := obj.values[key]
+		if !ok {
+			obj.keys = append(obj.keys, key)
+			obj.values[key] = v
+		} else if list, repeated := prev.([]any); repeated {
+			obj.values[key] = append(list, v)
+		} else {
+			obj.values[key] = []any{prev, v}
+		}
+	}
+	return obj
+}
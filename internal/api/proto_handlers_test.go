@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+const testOrderProto = `syntax = "proto3";
+package shop;
+message Order {
+  int64 id = 1;
+  string customer = 2;
+  repeated string tags = 3;
+}
+`
+
+func TestProtobufDecode(t *testing.T) {
+	s := NewServer()
+	// Order{id: 150, customer: "Ada", tags: ["a", "b"]} behind a gRPC header.
+	wire := "00 00 00 00 0e 08 96 01 12 03 41 64 61 1a 01 61 1a 01 62"
+
+	resp, err := s.ProtobufDecode(context.Background(), &pb.ProtobufDecodeRequest{Data: wire})
+	if err != nil || resp.Error != "" {
+		t.Fatalf("err=%v resp=%v", err, resp.Error)
+	}
+	if len(resp.Warnings) != 1 || resp.Hex != "08960112034164611a01611a0162" {
+		t.Errorf("frame: %v %s", resp.Warnings, resp.Hex)
+	}
+	if want := "{\n  \"1\": 150,\n  \"2\": \"Ada\",\n  \"3\": [\n    \"a\",\n    \"b\"\n  ]\n}"; resp.Json != want {
+		t.Errorf("tree:\n%s", resp.Json)
+	}
+	if len(resp.Fields) != 4 || resp.Fields[0].WireType != "VARINT" || resp.Fields[1].Offset != 3 || resp.Fields[1].Kind != "string" {
+		t.Errorf("fields: %v", resp.Fields)
+	}
+
+	resp, _ = s.ProtobufDecode(context.Background(), &pb.ProtobufDecodeRequest{Data: wire, Schema: testOrderProto})
+	if resp.Error != "" || resp.Json != "{\n  \"id\": \"150\",\n  \"customer\": \"Ada\",\n  \"tags\": [\n    \"a\",\n    \"b\"\n  ]\n}" {
+		t.Errorf("schema: %q %s", resp.Error, resp.Json)
+	}
+	if len(resp.MessageTypes) != 1 || resp.MessageTypes[0] != "shop.Order" {
+		t.Errorf("message types: %v", resp.MessageTypes)
+	}
+
+	resp, _ = s.ProtobufDecode(context.Background(), &pb.ProtobufDecodeRequest{
+		Data: `{"id": 150, "customer": "Ada", "tags": ["a", "b"]}`, Schema: testOrderProto, MessageType: "Order", Encode: true,
+	})
+	if resp.Error != "" || resp.Base64 != "CJYBEgNBZGEaAWEaAWI=" || len(resp.Fields) != 4 {
+		t.Errorf("encode: %+v", resp)
+	}
+
+	// A field of the wrong wire type is unknown to the schema.
+	resp, _ = s.ProtobufDecode(context.Background(), &pb.ProtobufDecodeRequest{Data: "0a0141", Schema: testOrderProto})
+	if resp.Error != "" || resp.Json != "{}" || len(resp.Warnings) != 1 {
+		t.Errorf("unknown field: %+v", resp)
+	}
+
+	// A nested message decodes as a group of fields with dotted paths.
+	resp, _ = s.ProtobufDecode(context.Background(), &pb.ProtobufDecodeRequest{Data: "GgQIARAC", Encoding: "base64"})
+	if len(resp.Fields) != 3 || resp.Fields[2].Path != "3.2" || resp.Fields[2].Depth != 1 {
+		t.Errorf("nested: %v", resp.Fields)
+	}
+}
+
+func TestProtobufDecodeErrors(t *testing.T) {
+	s := NewServer()
+	tests := []struct {
+		name string
+		req  *pb.ProtobufDecodeRequest
+		want string
+	}{
+		{"bad input", &pb.ProtobufDecodeRequest{Data: "%%"}, "neither hex nor base64"},
+		{"bad wire data", &pb.ProtobufDecodeRequest{Data: "0896"}, "Invalid wire data at offset 0"},
+		{"bad schema", &pb.ProtobufDecodeRequest{Data: "0801", Schema: "message {"}, "Invalid schema: input.proto:1:"},
+		{"unknown type", &pb.ProtobufDecodeRequest{Data: "0801", Schema: testOrderProto, MessageType: "Nope"}, `"Nope" is not declared`},
+		{"encode without schema", &pb.ProtobufDecodeRequest{Data: "{}", Encode: true}, "requires a schema"},
+		{"bad JSON", &pb.ProtobufDecodeRequest{Data: `{"id": "x"}`, Schema: testOrderProto, Encode: true}, "Invalid JSON for shop.Order"},
+		{"invalid UTF-8", &pb.ProtobufDecodeRequest{Data: "1201ff", Schema: testOrderProto}, "Payload is not a valid shop.Order"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.ProtobufDecode(context.Background(), tt.req)
+			if err != nil || !strings.Contains(resp.Error, tt.want) {
+				t.Errorf("got %v %q, want %q", err, resp.Error, tt.want)
+			}
+		})
+	}
+}
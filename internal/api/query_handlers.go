@@ -0,0 +1,207 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/odinnordico/privutil/internal/query"
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+// DataQuery evaluates a JSONPath or jq expression against a document in any
+// format Convert accepts and renders the results in the requested format.
+func (s *Server) DataQuery(_ context.Context, req *pb.DataQueryRequest) (*pb.DataQueryResponse, error) {
+	if strings.TrimSpace(req.Expression) == "" {
+		return &pb.DataQueryResponse{Error: "Expression is required"}, nil
+	}
+	src, err := parseSource(&pb.ConvertRequest{
+		Data:         req.Data,
+		SourceFormat: req.Format,
+		CsvDelimiter: req.CsvDelimiter,
+	})
+	if err != nil {
+		return &pb.DataQueryResponse{Error: fmt.Sprintf("Parse failed: %v", err)}, nil
+	}
+	doc := query.Normalize(src)
+
+	var (
+		results []any
+		paths   []string
+	)
+	switch req.Language {
+	case pb.QueryLanguage_QUERY_JSONPATH:
+		nodes, qerr := query.JSONPath(req.Expression, doc)
+		err = qerr
+		for _, n := range nodes {
+			results = append(results, n.Value)
+			paths = append(paths, n.Path)
+		}
+	case pb.QueryLanguage_QUERY_JQ:
+		results, err = query.JQ(req.Expression, doc)
+	default:
+		return &pb.DataQueryResponse{Error: "unsupported query language"}, nil
+	}
+	if err != nil {
+		resp := &pb.DataQueryResponse{Error: err.Error()}
+		var qe *query.Error
+		if errors.As(err, &qe) {
+			resp.ErrorPosition = int32(qe.Pos + 1)
+		}
+		return resp, nil
+	}
+
+	out, err := renderQueryResults(results, req)
+	if err != nil {
+		return &pb.DataQueryResponse{Error: fmt.Sprintf("Conversion failed: %v", err)}, nil
+	}
+	return &pb.DataQueryResponse{Result: out, Count: int32(len(results)), Paths: paths}, nil
+}
+
+// renderQueryResults formats query output. JSONPath always yields a node
+// list, so it renders as an array; jq renders a lone result as itself, the
+// way the jq CLI prints it.
+func renderQueryResults(results []any, req *pb.DataQueryRequest) (string, error) {
+	if req.RawOutput {
+		if lines, ok := rawLines(results); ok {
+			return strings.Join(lines, "\n"), nil
+		}
+	}
+	var data any = results
+	if results == nil {
+		data = []any{}
+	}
+	if req.Language == pb.QueryLanguage_QUERY_JQ && len(results) == 1 {
+		data = results[0]
+	}
+	b, err := marshalTarget(data, &pb.ConvertRequest{
+		TargetFormat: req.OutputFormat,
+		CsvDelimiter: req.CsvDelimiter,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// rawLines returns the results one per line when every result is a string.
+func rawLines(results []any) ([]string, bool) {
+	lines := make([]string, len(results))
+	for i, r := range results {
+		s, ok := r.(string)
+		if !ok {
+			return nil, false
+		}
+		lines[i] = s
+	}
+	return lines, true
+}
+
+// TableQuery runs a SQL-like SELECT over the rows of a CSV, JSON, YAML or
+// other tabular document and renders the result in the requested format.
+// CSV cells are typed first so numeric columns sort and sum as numbers.
+func (s *Server) TableQuery(_ context.Context, req *pb.TableQueryRequest) (*pb.TableQueryResponse, error) {
+	src, err := parseConvertSource(&pb.ConvertRequest{
+		Data:          req.Data,
+		SourceFormat:  req.Format,
+		CsvDelimiter:  req.CsvDelimiter,
+		CsvInferTypes: true,
+	})
+	if err != nil {
+		return &pb.TableQueryResponse{Error: fmt.Sprintf("Parse failed: %v", err)}, nil
+	}
+	table, err := queryTable(src)
+	if err != nil {
+		return &pb.TableQueryResponse{Error: err.Error()}, nil
+	}
+
+	result, err := query.SQL(req.Query, table)
+	if err != nil {
+		resp := &pb.TableQueryResponse{Error: err.Error()}
+		var qe *query.Error
+		if errors.As(err, &qe) {
+			resp.ErrorPosition = int32(qe.Pos + 1) // #nosec G115 -- bounded by the query length
+		}
+		return resp, nil
+	}
+
+	rows := make([]any, len(result.Rows))
+	for i, r := range result.Rows {
+		o := &orderedObject{keys: result.Columns, values: make(map[string]any, len(r))}
+		for j, c := range result.Columns {
+			o.values[c] = r[j]
+		}
+		rows[i] = o
+	}
+	b, err := marshalTarget(rows, &pb.ConvertRequest{
+		TargetFormat: req.OutputFormat,
+		CsvDelimiter: req.CsvDelimiter,
+	})
+	if err != nil {
+		return &pb.TableQueryResponse{Error: fmt.Sprintf("Conversion failed: %v", err)}, nil
+	}
+	return &pb.TableQueryResponse{
+		Result:    string(b),
+		Columns:   result.Columns,
+		RowCount:  int32(len(result.Rows)), // #nosec G115 -- bounded by the input size
+		InputRows: int32(len(table.Rows)),  // #nosec G115 -- bounded by the input size
+	}, nil
+}
+
+// queryTable lays parsed data out as rows. The root is an array, or an
+// object holding exactly one array (as XML and wrapped JSON APIs produce).
+// Object rows give the union of their keys as columns, array rows give
+// "Column n" columns as the table writers do, and scalars a "value" column.
+func queryTable(data any) (*query.Table, error) {
+	if keys, values, ok := objectFields(data); ok {
+		var arrays []string
+		for _, k := range keys {
+			if _, isArray := values[k].([]any); isArray {
+				arrays = append(arrays, k)
+			}
+		}
+		if len(arrays) != 1 {
+			return nil, errors.New("input must be an array of rows, or an object holding one array")
+		}
+		data = values[arrays[0]]
+	}
+	items, ok := data.([]any)
+	if !ok {
+		return nil, errors.New("input must be an array of rows, or an object holding one array")
+	}
+
+	t := &query.Table{Rows: make([][]any, len(items))}
+	index := map[string]int{}
+	column := func(name string) int {
+		i, ok := index[name]
+		if !ok {
+			i = len(t.Columns)
+			index[name] = i
+			t.Columns = append(t.Columns, name)
+		}
+		return i
+	}
+	for n, item := range items {
+		var row []any
+		set := func(i int, v any) {
+			for len(row) <= i {
+				row = append(row, nil)
+			}
+			row[i] = query.Normalize(plainValue(v))
+		}
+		if keys, values, ok := objectFields(item); ok {
+			for _, k := range keys {
+				set(column(k), values[k])
+			}
+		} else if cells, ok := item.([]any); ok {
+			for j, c := range cells {
+				set(column(fmt.Sprintf("Column %d", j+1)), c)
+			}
+		} else {
+			set(column("value"), item)
+		}
+		t.Rows[n] = row
+	}
+	return t, nil
+}
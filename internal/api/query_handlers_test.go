@@ -0,0 +1,170 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+var querySrv = &Server{}
+
+const querySample = `{"users": [{"name": "ana", "age": 31}, {"name": "bo", "age": 17}]}`
+
+func TestDataQuery_JSONPath(t *testing.T) {
+	resp, err := querySrv.DataQuery(context.Background(), &pb.DataQueryRequest{
+		Data:       querySample,
+		Expression: "$.users[?@.age >= 18].name",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Error != "" {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	if resp.Count != 1 || strings.Join(resp.Paths, ",") != "$['users'][0]['name']" {
+		t.Errorf("count = %d, paths = %v", resp.Count, resp.Paths)
+	}
+	if resp.Result != "[\n  \"ana\"\n]" {
+		t.Errorf("result = %q", resp.Result)
+	}
+}
+
+func TestDataQuery_JQ(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *pb.DataQueryRequest
+		want string
+	}{
+		{"single result", &pb.DataQueryRequest{Expression: "[.users[].age] | add"}, "48"},
+		{"multiple results", &pb.DataQueryRequest{Expression: ".users[].name"}, "[\n  \"ana\",\n  \"bo\"\n]"},
+		{"raw output", &pb.DataQueryRequest{Expression: ".users[].name", RawOutput: true}, "ana\nbo"},
+		{"yaml output", &pb.DataQueryRequest{Expression: ".users[0]", OutputFormat: pb.DataFormat_YAML}, "age: 31\nname: ana\n"},
+		{"csv output", &pb.DataQueryRequest{Expression: ".users", OutputFormat: pb.DataFormat_CSV}, "age,name\n31,ana\n17,bo\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Data = querySample
+			tt.req.Language = pb.QueryLanguage_QUERY_JQ
+			resp, err := querySrv.DataQuery(context.Background(), tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.Error != "" {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+			if resp.Result != tt.want {
+				t.Errorf("result = %q, want %q", resp.Result, tt.want)
+			}
+		})
+	}
+}
+
+func TestDataQuery_YAMLInput(t *testing.T) {
+	resp, _ := querySrv.DataQuery(context.Background(), &pb.DataQueryRequest{
+		Data:       "servers:\n  - host: a\n    port: 80\n  - host: b\n    port: 443\n",
+		Format:     pb.DataFormat_YAML,
+		Language:   pb.QueryLanguage_QUERY_JQ,
+		Expression: `.servers | map(select(.port > 100)) | .[0].host`,
+	})
+	if resp.Error != "" || resp.Result != `"b"` {
+		t.Errorf("result = %q, error = %q", resp.Result, resp.Error)
+	}
+}
+
+func TestDataQuery_ErrorPosition(t *testing.T) {
+	tests := []struct {
+		lang pb.QueryLanguage
+		expr string
+		pos  int32
+	}{
+		{pb.QueryLanguage_QUERY_JSONPATH, "$.users[", 9},
+		{pb.QueryLanguage_QUERY_JQ, ".users | )", 10},
+	}
+	for _, tt := range tests {
+		resp, _ := querySrv.DataQuery(context.Background(), &pb.DataQueryRequest{
+			Data: querySample, Language: tt.lang, Expression: tt.expr,
+		})
+		if resp.Error == "" || resp.ErrorPosition != tt.pos {
+			t.Errorf("%s: error = %q, position = %d, want %d", tt.expr, resp.Error, resp.ErrorPosition, tt.pos)
+		}
+	}
+}
+
+func TestDataQuery_InvalidInput(t *testing.T) {
+	resp, _ := querySrv.DataQuery(context.Background(), &pb.DataQueryRequest{Data: "{", Expression: "$"})
+	if !strings.HasPrefix(resp.Error, "Parse failed") || resp.ErrorPosition != 0 {
+		t.Errorf("error = %q, position = %d", resp.Error, resp.ErrorPosition)
+	}
+}
+
+const tableSample = "name,team,age\nana,eng,31\nbo,ops,17\ncy,eng,40\n"
+
+func TestTableQuery(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *pb.TableQueryRequest
+		want string
+		rows int32
+	}{
+		{"filter and sort", &pb.TableQueryRequest{
+			Query: "SELECT name, age WHERE age > 20 ORDER BY age DESC", OutputFormat: pb.DataFormat_CSV,
+		}, "name,age\ncy,40\nana,31\n", 2},
+		{"group to json", &pb.TableQueryRequest{
+			Query: "SELECT team, count(*) AS n, avg(age) AS mean GROUP BY team",
+		}, "[\n  {\n    \"team\": \"eng\",\n    \"n\": 2,\n    \"mean\": 35.5\n  },\n  {\n    \"team\": \"ops\",\n    \"n\": 1,\n    \"mean\": 17\n  }\n]", 2},
+		{"markdown table", &pb.TableQueryRequest{
+			Query: "SELECT name WHERE team = 'ops'", OutputFormat: pb.DataFormat_MARKDOWN_TABLE,
+		}, "| name |\n| ---- |\n| bo   |\n", 1},
+		{"json wrapper input", &pb.TableQueryRequest{
+			Data: querySample, Format: pb.DataFormat_JSON, Query: "SELECT max(age) AS oldest", OutputFormat: pb.DataFormat_CSV,
+		}, "oldest\n31\n", 1},
+		{"empty query keeps every row", &pb.TableQueryRequest{OutputFormat: pb.DataFormat_TSV}, "name\tteam\tage\nana\teng\t31\nbo\tops\t17\ncy\teng\t40\n", 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.req.Data == "" {
+				tt.req.Data, tt.req.Format = tableSample, pb.DataFormat_CSV
+			}
+			resp, err := querySrv.TableQuery(context.Background(), tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.Error != "" {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+			if resp.Result != tt.want {
+				t.Errorf("result = %q, want %q", resp.Result, tt.want)
+			}
+			if resp.RowCount != tt.rows {
+				t.Errorf("row_count = %d, want %d", resp.RowCount, tt.rows)
+			}
+		})
+	}
+}
+
+func TestTableQuery_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *pb.TableQueryRequest
+		want string
+		pos  int32
+	}{
+		{"unknown column", &pb.TableQueryRequest{Data: tableSample, Format: pb.DataFormat_CSV, Query: "SELECT nope"}, "unknown column", 8},
+		{"syntax", &pb.TableQueryRequest{Data: tableSample, Format: pb.DataFormat_CSV, Query: "SELECT name WHERE"}, "expected an expression", 18},
+		{"not tabular", &pb.TableQueryRequest{Data: `{"a": 1}`, Format: pb.DataFormat_JSON}, "array of rows", 0},
+		{"bad input", &pb.TableQueryRequest{Data: `{`, Format: pb.DataFormat_JSON}, "Parse failed", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := querySrv.TableQuery(context.Background(), tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(resp.Error, tt.want) || resp.ErrorPosition != tt.pos {
+				t.Errorf("error = %q at %d, want %q at %d", resp.Error, resp.ErrorPosition, tt.want, tt.pos)
+			}
+		})
+	}
+}
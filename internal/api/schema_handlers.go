@@ -0,0 +1,346 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+// defaultMaxEnumValues is how many distinct strings a location may hold before
+// it stops being reported as an enum.
+const defaultMaxEnumValues = 5
+
+var schemaDraftURIs = map[pb.SchemaDraft]string{
+	pb.SchemaDraft_DRAFT_2020_12: "https://json-schema.org/draft/2020-12/schema",
+	pb.SchemaDraft_DRAFT_2019_09: "https://json-schema.org/draft/2019-09/schema",
+	pb.SchemaDraft_DRAFT_07:      "http://json-schema.org/draft-07/schema#",
+	pb.SchemaDraft_DRAFT_04:      "http://json-schema.org/draft-04/schema#",
+}
+
+// jsonSchema is the subset of JSON Schema emitted by InferSchema. Field order
+// controls the key order of the rendered document.
+type jsonSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Type                 any                    `json:"type,omitempty"` // string or []string
+	Format               string                 `json:"format,omitempty"`
+	Enum                 []string               `json:"enum,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties *bool                  `json:"additionalProperties,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+}
+
+// schemaNode accumulates every value observed at one location across all
+// samples, so the emitted schema describes their union.
+type schemaNode struct {
+	seen    int            // values observed at this location
+	types   map[string]int // JSON Schema type → occurrences
+	objects int            // objects observed, for required-key detection
+	props   map[string]*schemaNode
+	items   *schemaNode
+	strs    map[string]int // string value → occurrences
+	formats []string       // formats matched by every string seen so far
+}
+
+func newSchemaNode() *schemaNode {
+	return &schemaNode{types: map[string]int{}}
+}
+
+func (n *schemaNode) observe(v any) {
+	if m, ok := v.(map[string]any); ok && wrapperKind(m) != "" {
+		v = wrappedValue(m)
+	}
+	n.seen++
+	switch val := v.(type) {
+	case nil:
+		n.types["null"]++
+	case bool:
+		n.types["boolean"]++
+	case float64:
+		if val == math.Trunc(val) && !math.IsInf(val, 0) {
+			n.types["integer"]++
+		} else {
+			n.types["number"]++
+		}
+	case float32:
+		n.types["number"]++
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		n.types["integer"]++
+	case json.Number:
+		if strings.ContainsAny(string(val), ".eEnN") { // also NaN and Infinity
+			n.types["number"]++
+		} else {
+			n.types["integer"]++
+		}
+	case string:
+		n.observeString(val)
+	case time.Time:
+		n.observeString(val.Format(time.RFC3339Nano))
+	case []any:
+		n.types["array"]++
+		if n.items == nil {
+			n.items = newSchemaNode()
+		}
+		for _, item := range val {
+			n.items.observe(item)
+		}
+	case map[string]any:
+		n.observeObject(val)
+	case map[any]any:
+		m := make(map[string]any, len(val))
+		for k, item := range val {
+			m[fmt.Sprint(k)] = item
+		}
+		n.observeObject(m)
+	default:
+		// TOML local dates/times and other scalars render as strings.
+		n.observeString(fmt.Sprint(val))
+	}
+}
+
+// wrappedValue returns the value an extended JSON wrapper from a binary
+// source stands for, so it is described as that rather than as an object:
+// $numberLong and $numberDecimal as numbers, $date as a date-time, and the
+// rest as protobufJSONStyle flattens them (bytes as base64 strings).
+func wrappedValue(m map[string]any) any {
+	switch wrapperKind(m) {
+	case "$numberLong", "$numberDecimal":
+		for _, s := range m {
+			if s, ok := s.(string); ok {
+				return json.Number(s)
+			}
+		}
+	case "$date":
+		if t, err := unwrapDate(m["$date"]); err == nil {
+			return t
+		}
+	case "$tag":
+		if inner, ok := m["$value"].(map[string]any); ok && wrapperKind(inner) != "" {
+			return wrappedValue(inner)
+		}
+		return m["$value"]
+	}
+	return protobufJSONStyle(m)
+}
+
+func (n *schemaNode) observeObject(m map[string]any) {
+	n.types["object"]++
+	n.objects++
+	if n.props == nil {
+		n.props = map[string]*schemaNode{}
+	}
+	for k, item := range m {
+		child, ok := n.props[k]
+		if !ok {
+			child = newSchemaNode()
+			n.props[k] = child
+		}
+		child.observe(item)
+	}
+}
+
+func (n *schemaNode) observeString(s string) {
+	n.types["string"]++
+	if n.strs == nil {
+		n.strs = map[string]int{}
+		n.formats = detectFormats(s)
+	} else if len(n.formats) > 0 {
+		matched := detectFormats(s)
+		kept := n.formats[:0]
+		for _, f := range n.formats {
+			for _, m := range matched {
+				if f == m {
+					kept = append(kept, f)
+					break
+				}
+			}
+		}
+		n.formats = kept
+	}
+	n.strs[s]++
+}
+
+var (
+	uuidRe  = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	dateRe  = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	emailRe = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+)
+
+// detectFormats returns every JSON Schema string format s satisfies, in
+// order of preference.
+func detectFormats(s string) []string {
+	var out []string
+	if _, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		out = append(out, "date-time")
+	}
+	if dateRe.MatchString(s) {
+		if _, err := time.Parse(time.DateOnly, s); err == nil {
+			out = append(out, "date")
+		}
+	}
+	if uuidRe.MatchString(s) {
+		out = append(out, "uuid")
+	}
+	if emailRe.MatchString(s) {
+		if _, err := mail.ParseAddress(s); err == nil {
+			out = append(out, "email")
+		}
+	}
+	if ip := net.ParseIP(s); ip != nil {
+		if ip.To4() != nil && strings.Contains(s, ".") {
+			out = append(out, "ipv4")
+		} else {
+			out = append(out, "ipv6")
+		}
+	}
+	if u, err := url.Parse(s); err == nil && u.Scheme != "" && (u.Host != "" || u.Opaque != "") && !strings.ContainsAny(s, " \t\n") {
+		out = append(out, "uri")
+	}
+	return out
+}
+
+// draftFormats lists the formats each draft defines; anything else is left
+// out so validators for older drafts don't reject the schema.
+var draftFormats = map[pb.SchemaDraft]map[string]bool{
+	pb.SchemaDraft_DRAFT_04: {"date-time": true, "email": true, "ipv4": true, "ipv6": true, "uri": true},
+	pb.SchemaDraft_DRAFT_07: {"date-time": true, "date": true, "email": true, "ipv4": true, "ipv6": true, "uri": true},
+}
+
+// schemaTypeOrder is the order types are listed in when a location holds
+// more than one.
+var schemaTypeOrder = []string{"object", "array", "string", "number", "integer", "boolean", "null"}
+
+type schemaOptions struct {
+	draft     pb.SchemaDraft
+	strict    bool
+	maxEnum   int
+	noFormats bool
+}
+
+func (n *schemaNode) build(opts schemaOptions) *jsonSchema {
+	out := &jsonSchema{}
+	if n.seen == 0 {
+		return out
+	}
+
+	types := make([]string, 0, len(n.types))
+	for _, t := range schemaTypeOrder {
+		if n.types[t] == 0 {
+			continue
+		}
+		// Integers widen to number when both appear at the same location.
+		if t == "integer" && n.types["number"] > 0 {
+			continue
+		}
+		types = append(types, t)
+	}
+	if len(types) == 1 {
+		out.Type = types[0]
+	} else {
+		out.Type = types
+	}
+
+	if n.types["object"] > 0 {
+		out.Properties = make(map[string]*jsonSchema, len(n.props))
+		for k, child := range n.props {
+			out.Properties[k] = child.build(opts)
+			if child.seen == n.objects {
+				out.Required = append(out.Required, k)
+			}
+		}
+		sort.Strings(out.Required)
+		if opts.strict {
+			f := false
+			out.AdditionalProperties = &f
+		}
+	}
+
+	if n.types["array"] > 0 && n.items != nil && n.items.seen > 0 {
+		out.Items = n.items.build(opts)
+	}
+
+	if n.types["string"] > 0 {
+		if !opts.noFormats {
+			allowed := draftFormats[opts.draft]
+			for _, f := range n.formats {
+				if allowed == nil || allowed[f] {
+					out.Format = f
+					break
+				}
+			}
+		}
+		stringsOnly := len(types) == 1
+		if stringsOnly && opts.maxEnum > 0 && len(n.strs) <= opts.maxEnum && n.types["string"] > len(n.strs) {
+			for s := range n.strs {
+				out.Enum = append(out.Enum, s)
+			}
+			sort.Strings(out.Enum)
+		}
+	}
+
+	return out
+}
+
+// InferSchema derives a JSON Schema describing the union of one or more
+// sample documents.
+func (s *Server) InferSchema(_ context.Context, req *pb.InferSchemaRequest) (*pb.InferSchemaResponse, error) {
+	opts := schemaOptions{
+		draft:     req.Draft,
+		strict:    req.Strict,
+		maxEnum:   int(req.MaxEnumValues),
+		noFormats: req.NoFormats,
+	}
+	if opts.maxEnum == 0 {
+		opts.maxEnum = defaultMaxEnumValues
+	}
+	uri, ok := schemaDraftURIs[req.Draft]
+	if !ok {
+		return &pb.InferSchemaResponse{Error: "unsupported schema draft"}, nil
+	}
+
+	root := newSchemaNode()
+	count := 0
+	for i, sample := range req.Samples {
+		if strings.TrimSpace(sample) == "" {
+			continue
+		}
+		data, err := parseSource(&pb.ConvertRequest{Data: sample, SourceFormat: req.Format})
+		if err != nil {
+			return &pb.InferSchemaResponse{Error: fmt.Sprintf("sample %d: %v", i+1, err)}, nil
+		}
+		root.observe(plainValue(data))
+		count++
+	}
+	if count == 0 {
+		return &pb.InferSchemaResponse{Error: "at least one sample is required"}, nil
+	}
+
+	schema := root.build(opts)
+	schema.Schema = uri
+	schema.Title = req.Title
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(schema); err != nil {
+		return &pb.InferSchemaResponse{Error: fmt.Sprintf("Encoding failed: %v", err)}, nil
+	}
+
+	return &pb.InferSchemaResponse{
+		Schema:      strings.TrimSuffix(buf.String(), "\n"),
+		SampleCount: int32(count), // #nosec G115
+	}, nil
+}
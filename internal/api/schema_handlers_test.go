@@ -0,0 +1,213 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+var schemaSrv = &Server{}
+
+// inferSchema runs InferSchema and decodes the resulting document.
+func inferSchema(t *testing.T, req *pb.InferSchemaRequest) map[string]any {
+	t.Helper()
+	resp, err := schemaSrv.InferSchema(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Error != "" {
+		t.Fatalf("unexpected error: %s", resp.Error)
+	}
+	var doc map[string]any
+	if err := json.Unmarshal([]byte(resp.Schema), &doc); err != nil {
+		t.Fatalf("schema is not valid JSON: %v\n%s", err, resp.Schema)
+	}
+	return doc
+}
+
+func prop(t *testing.T, schema map[string]any, name string) map[string]any {
+	t.Helper()
+	props, ok := schema["properties"].(map[string]any)
+	if !ok {
+		t.Fatalf("schema has no properties: %v", schema)
+	}
+	p, ok := props[name].(map[string]any)
+	if !ok {
+		t.Fatalf("property %q missing: %v", name, props)
+	}
+	return p
+}
+
+func TestInferSchema_MergesSamples(t *testing.T) {
+	doc := inferSchema(t, &pb.InferSchemaRequest{Samples: []string{
+		`{"id": 1, "name": "a", "score": 1}`,
+		`{"id": 2, "score": 2.5, "tags": []}`,
+	}})
+	if doc["$schema"] != "https://json-schema.org/draft/2020-12/schema" {
+		t.Errorf("$schema = %v", doc["$schema"])
+	}
+	if doc["type"] != "object" {
+		t.Errorf("type = %v, want object", doc["type"])
+	}
+	req, _ := doc["required"].([]any)
+	if len(req) != 2 || req[0] != "id" || req[1] != "score" {
+		t.Errorf("required = %v, want [id score]", req)
+	}
+	if got := prop(t, doc, "id")["type"]; got != "integer" {
+		t.Errorf("id type = %v, want integer", got)
+	}
+	if got := prop(t, doc, "score")["type"]; got != "number" {
+		t.Errorf("score type = %v, want number (integer widened)", got)
+	}
+	if _, ok := prop(t, doc, "tags")["items"]; ok {
+		t.Error("empty array should not declare items")
+	}
+}
+
+func TestInferSchema_NullableUnion(t *testing.T) {
+	doc := inferSchema(t, &pb.InferSchemaRequest{Samples: []string{`{"v": "x"}`, `{"v": null}`}})
+	types, ok := prop(t, doc, "v")["type"].([]any)
+	if !ok || len(types) != 2 || types[0] != "string" || types[1] != "null" {
+		t.Errorf("type = %v, want [string null]", prop(t, doc, "v")["type"])
+	}
+}
+
+func TestInferSchema_Enum(t *testing.T) {
+	doc := inferSchema(t, &pb.InferSchemaRequest{Samples: []string{
+		`[{"status": "open"}, {"status": "closed"}, {"status": "open"}]`,
+	}})
+	items := doc["items"].(map[string]any)
+	enum, _ := prop(t, items, "status")["enum"].([]any)
+	if len(enum) != 2 || enum[0] != "closed" || enum[1] != "open" {
+		t.Errorf("enum = %v, want [closed open]", enum)
+	}
+
+	doc = inferSchema(t, &pb.InferSchemaRequest{
+		Samples:       []string{`[{"status": "open"}, {"status": "open"}]`},
+		MaxEnumValues: -1,
+	})
+	items = doc["items"].(map[string]any)
+	if _, ok := prop(t, items, "status")["enum"]; ok {
+		t.Error("negative max_enum_values should disable enums")
+	}
+}
+
+func TestInferSchema_UniqueStringsAreNotEnum(t *testing.T) {
+	doc := inferSchema(t, &pb.InferSchemaRequest{Samples: []string{`[{"n": "a"}, {"n": "b"}]`}})
+	items := doc["items"].(map[string]any)
+	if _, ok := prop(t, items, "n")["enum"]; ok {
+		t.Error("strings that never repeat should not become an enum")
+	}
+}
+
+func TestInferSchema_Formats(t *testing.T) {
+	doc := inferSchema(t, &pb.InferSchemaRequest{Samples: []string{`{
+		"created": "2024-01-15T10:30:00Z",
+		"day": "2024-01-15",
+		"id": "123e4567-e89b-12d3-a456-426614174000",
+		"email": "alice@example.com",
+		"site": "https://example.com/a",
+		"ip": "10.0.0.1",
+		"plain": "hello"
+	}`}})
+	want := map[string]string{
+		"created": "date-time",
+		"day":     "date",
+		"id":      "uuid",
+		"email":   "email",
+		"site":    "uri",
+		"ip":      "ipv4",
+	}
+	for name, format := range want {
+		if got := prop(t, doc, name)["format"]; got != format {
+			t.Errorf("%s format = %v, want %s", name, got, format)
+		}
+	}
+	if _, ok := prop(t, doc, "plain")["format"]; ok {
+		t.Error("plain string should have no format")
+	}
+}
+
+func TestInferSchema_FormatMustHoldForAllSamples(t *testing.T) {
+	doc := inferSchema(t, &pb.InferSchemaRequest{Samples: []string{`{"v": "alice@example.com"}`, `{"v": "nope"}`}})
+	if _, ok := prop(t, doc, "v")["format"]; ok {
+		t.Error("format should be dropped when a sample does not match")
+	}
+}
+
+func TestInferSchema_NoFormats(t *testing.T) {
+	doc := inferSchema(t, &pb.InferSchemaRequest{Samples: []string{`{"v": "alice@example.com"}`}, NoFormats: true})
+	if _, ok := prop(t, doc, "v")["format"]; ok {
+		t.Error("no_formats should disable format detection")
+	}
+}
+
+func TestInferSchema_DraftAndStrict(t *testing.T) {
+	doc := inferSchema(t, &pb.InferSchemaRequest{
+		Samples: []string{`{"a": {"id": "123e4567-e89b-12d3-a456-426614174000"}}`},
+		Draft:   pb.SchemaDraft_DRAFT_07,
+		Strict:  true,
+		Title:   "Thing",
+	})
+	if doc["$schema"] != "http://json-schema.org/draft-07/schema#" {
+		t.Errorf("$schema = %v", doc["$schema"])
+	}
+	if doc["title"] != "Thing" {
+		t.Errorf("title = %v", doc["title"])
+	}
+	if doc["additionalProperties"] != false {
+		t.Error("strict should set additionalProperties false on the root")
+	}
+	nested := prop(t, doc, "a")
+	if nested["additionalProperties"] != false {
+		t.Error("strict should set additionalProperties false on nested objects")
+	}
+	if _, ok := prop(t, nested, "id")["format"]; ok {
+		t.Error("uuid is not a draft-07 format and should be omitted")
+	}
+}
+
+func TestInferSchema_YAML(t *testing.T) {
+	doc := inferSchema(t, &pb.InferSchemaRequest{
+		Samples: []string{"name: a\ncount: 3\n", "name: b\n"},
+		Format:  pb.DataFormat_YAML,
+	})
+	if got := prop(t, doc, "count")["type"]; got != "integer" {
+		t.Errorf("count type = %v, want integer", got)
+	}
+	req, _ := doc["required"].([]any)
+	if len(req) != 1 || req[0] != "name" {
+		t.Errorf("required = %v, want [name]", req)
+	}
+}
+
+func TestInferSchema_Binary(t *testing.T) {
+	src := `{"n": 3, "big": {"$numberLong": "9007199254740993"}, "when": {"$date": "2024-01-02T03:04:05Z"},` +
+		` "bin": {"$binary": {"base64": "AQI=", "subType": "00"}}}`
+	for _, f := range []pb.DataFormat{pb.DataFormat_MSGPACK, pb.DataFormat_CBOR, pb.DataFormat_BSON} {
+		sample := convert(t, src, pb.DataFormat_JSON, f)
+		doc := inferSchema(t, &pb.InferSchemaRequest{Samples: []string{sample}, Format: f})
+		for name, want := range map[string]string{"n": "integer", "big": "integer", "when": "string", "bin": "string"} {
+			if got := prop(t, doc, name)["type"]; got != want {
+				t.Errorf("%v: %s type = %v, want %s", f, name, got, want)
+			}
+		}
+		if got := prop(t, doc, "when")["format"]; got != "date-time" {
+			t.Errorf("%v: when format = %v, want date-time", f, got)
+		}
+	}
+}
+
+func TestInferSchema_Errors(t *testing.T) {
+	resp, _ := schemaSrv.InferSchema(context.Background(), &pb.InferSchemaRequest{Samples: []string{"  "}})
+	if resp.Error == "" {
+		t.Error("expected error when no samples are given")
+	}
+	resp, _ = schemaSrv.InferSchema(context.Background(), &pb.InferSchemaRequest{Samples: []string{`{}`, `{bad`}})
+	if !strings.HasPrefix(resp.Error, "sample 2:") {
+		t.Errorf("error = %q, want it to name sample 2", resp.Error)
+	}
+}
@@ -0,0 +1,580 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"go/format"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/odinnordico/privutil/internal/sqlfmt"
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+// ── Data → SQL ────────────────────────────────────────────────────────────────
+
+// sqlKind is the inferred type of a column, before it is named per dialect.
+type sqlKind int
+
+const (
+	sqlNull sqlKind = iota // only nulls seen
+	sqlBool
+	sqlInt
+	sqlBigInt
+	sqlNumeric // integers beyond int64
+	sqlFloat
+	sqlDate
+	sqlTimestamp
+	sqlTimestampTZ
+	sqlUUID
+	sqlText
+	sqlJSON
+)
+
+var (
+	sqlDateRe      = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	sqlTimestampRe = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}(:\d{2}(\.\d+)?)?(Z|[+-]\d{2}(:?\d{2})?)?$`)
+	sqlUUIDRe      = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
+// sqlColumn accumulates what was seen in one column.
+type sqlColumn struct {
+	name     string
+	kinds    map[sqlKind]int
+	nullable bool
+	maxLen   int
+	kind     sqlKind
+	primary  bool
+}
+
+// valueKind classifies one non-null value.
+func valueKind(v any) sqlKind {
+	switch val := v.(type) {
+	case bool:
+		return sqlBool
+	case string:
+		switch {
+		case sqlDateRe.MatchString(val):
+			if _, err := time.Parse(time.DateOnly, val); err == nil {
+				return sqlDate
+			}
+		case sqlTimestampRe.MatchString(val):
+			if _, ok := parseSQLTimestamp(val); ok {
+				if strings.HasSuffix(val, "Z") || strings.ContainsAny(val[10:], "+-") {
+					return sqlTimestampTZ
+				}
+				return sqlTimestamp
+			}
+		case sqlUUIDRe.MatchString(val):
+			return sqlUUID
+		}
+		return sqlText
+	case float64, float32:
+		if _, ok := integral(v); !ok {
+			return sqlFloat
+		}
+	}
+	if n, ok := integral(v); ok {
+		switch {
+		case !n.neg && n.abs <= math.MaxInt32, n.neg && n.abs <= -math.MinInt32:
+			return sqlInt
+		case !n.neg && n.abs <= math.MaxInt64, n.neg && n.abs <= 1<<63:
+			return sqlBigInt
+		}
+		return sqlNumeric
+	}
+	if _, _, ok := objectFields(v); ok {
+		return sqlJSON
+	}
+	if _, ok := v.([]any); ok {
+		return sqlJSON
+	}
+	return sqlText
+}
+
+var sqlTimestampLayouts = []string{
+	"2006-01-02T15:04:05.999999999Z07:00", "2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999Z0700", "2006-01-02 15:04:05.999999999Z0700",
+	"2006-01-02T15:04:05.999999999Z07", "2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02T15:04:05.999999999", "2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04", "2006-01-02 15:04",
+}
+
+func parseSQLTimestamp(s string) (time.Time, bool) {
+	for _, layout := range sqlTimestampLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// resolve picks one kind that holds every value seen: numbers widen to the
+// largest numeric kind, timestamps with and without zones become zoned, and
+// any other mix falls back to text.
+func (c *sqlColumn) resolve() {
+	if len(c.kinds) == 0 {
+		c.kind, c.nullable = sqlText, true
+		return
+	}
+	has := func(allowed ...sqlKind) bool {
+		for k := range c.kinds {
+			if !slices.Contains(allowed, k) {
+				return false
+			}
+		}
+		return true
+	}
+	switch {
+	case len(c.kinds) == 1:
+		for k := range c.kinds {
+			c.kind = k
+		}
+	case has(sqlInt, sqlBigInt):
+		c.kind = sqlBigInt
+	case has(sqlInt, sqlBigInt, sqlNumeric):
+		c.kind = sqlNumeric
+	case has(sqlInt, sqlBigInt, sqlNumeric, sqlFloat):
+		c.kind = sqlFloat
+	case has(sqlTimestamp, sqlTimestampTZ):
+		c.kind = sqlTimestampTZ
+	default:
+		c.kind = sqlText
+	}
+}
+
+// sqlTypeName names a kind in the dialect. maxLen sizes VARCHAR columns.
+func sqlTypeName(k sqlKind, maxLen int, d sqlfmt.Dialect) string {
+	varchar := func() string {
+		if maxLen <= 255 {
+			return "VARCHAR(255)"
+		}
+		return fmt.Sprintf("VARCHAR(%d)", maxLen)
+	}
+	switch d {
+	case sqlfmt.PostgreSQL:
+		return [...]string{"TEXT", "BOOLEAN", "INTEGER", "BIGINT", "NUMERIC", "DOUBLE PRECISION", "DATE",
+			"TIMESTAMP", "TIMESTAMPTZ", "UUID", "TEXT", "JSONB"}[k]
+	case sqlfmt.MySQL:
+		switch k {
+		case sqlNull, sqlText:
+			switch {
+			case maxLen <= 255:
+				return "VARCHAR(255)"
+			case maxLen <= 65535/4: // utf8mb4 rows are limited to 65,535 bytes
+				return fmt.Sprintf("VARCHAR(%d)", maxLen)
+			case maxLen <= 16777215/4:
+				return "MEDIUMTEXT"
+			}
+			return "LONGTEXT"
+		}
+		return [...]string{"", "BOOLEAN", "INT", "BIGINT", "DECIMAL(20, 0)", "DOUBLE", "DATE",
+			"DATETIME(6)", "DATETIME(6)", "CHAR(36)", "", "JSON"}[k]
+	case sqlfmt.SQLite:
+		return [...]string{"TEXT", "INTEGER", "INTEGER", "INTEGER", "NUMERIC", "REAL", "TEXT",
+			"TEXT", "TEXT", "TEXT", "TEXT", "TEXT"}[k]
+	case sqlfmt.BigQuery:
+		return [...]string{"STRING", "BOOL", "INT64", "INT64", "BIGNUMERIC", "FLOAT64", "DATE",
+			"DATETIME", "TIMESTAMP", "STRING", "STRING", "JSON"}[k]
+	}
+	switch k {
+	case sqlNull, sqlText, sqlJSON:
+		return varchar()
+	}
+	return [...]string{"", "BOOLEAN", "INTEGER", "BIGINT", "NUMERIC(20)", "DOUBLE PRECISION", "DATE",
+		"TIMESTAMP", "TIMESTAMP WITH TIME ZONE", "CHAR(36)"}[k]
+}
+
+// sqlRows returns the rows of an array of objects, or of a single object.
+func sqlRows(data any) ([][]string, []map[string]any, error) {
+	items, ok := data.([]any)
+	if !ok {
+		items = []any{data}
+	}
+	keys := make([][]string, 0, len(items))
+	values := make([]map[string]any, 0, len(items))
+	for i, item := range items {
+		k, v, ok := objectFields(item)
+		if !ok {
+			return nil, nil, fmt.Errorf("row %d is not an object: expected an array of objects", i+1)
+		}
+		keys, values = append(keys, k), append(values, v)
+	}
+	if len(items) == 0 {
+		return nil, nil, fmt.Errorf("no rows to load")
+	}
+	return keys, values, nil
+}
+
+// inferSQLColumns collects the columns in first-seen order and types them.
+// A non-null, unique column named "id" or "<table>_id" is taken as the
+// primary key.
+func inferSQLColumns(keys [][]string, rows []map[string]any, table string) []*sqlColumn {
+	var cols []*sqlColumn
+	byName := map[string]*sqlColumn{}
+	for _, rk := range keys {
+		for _, k := range rk {
+			if byName[k] == nil {
+				byName[k] = &sqlColumn{name: k, kinds: map[sqlKind]int{}}
+				cols = append(cols, byName[k])
+			}
+		}
+	}
+	for _, row := range rows {
+		for _, c := range cols {
+			v, present := row[c.name]
+			if !present || v == nil {
+				c.nullable = true
+				continue
+			}
+			c.kinds[valueKind(v)]++
+			c.maxLen = max(c.maxLen, utf8.RuneCountInString(cellText(v)))
+		}
+	}
+	for _, c := range cols {
+		c.resolve()
+	}
+
+	base := table[strings.LastIndexByte(table, '.')+1:]
+	candidates := []string{"id", base + "_id", strings.TrimSuffix(singularize(base), "Item") + "_id"}
+	for _, want := range candidates {
+		for _, c := range cols {
+			if !strings.EqualFold(c.name, want) || c.nullable {
+				continue
+			}
+			switch c.kind {
+			case sqlInt, sqlBigInt, sqlNumeric, sqlUUID, sqlText:
+			default:
+				continue
+			}
+			seen := map[string]bool{}
+			unique := true
+			for _, row := range rows {
+				text := cellText(row[c.name])
+				unique = unique && !seen[text]
+				seen[text] = true
+			}
+			if unique {
+				c.primary = true
+				return cols
+			}
+		}
+	}
+	return cols
+}
+
+// qualifiedName quotes each dot-separated part of a table name.
+func qualifiedName(name string, d sqlfmt.Dialect) string {
+	parts := strings.Split(name, ".")
+	for i, p := range parts {
+		parts[i] = sqlfmt.QuoteIdent(p, d)
+	}
+	return strings.Join(parts, ".")
+}
+
+func createTableSQL(table string, cols []*sqlColumn, d sqlfmt.Dialect) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "CREATE TABLE %s (\n", qualifiedName(table, d))
+	var pk string
+	for i, c := range cols {
+		fmt.Fprintf(&b, "  %s %s", sqlfmt.QuoteIdent(c.name, d), sqlTypeName(c.kind, c.maxLen, d))
+		if !c.nullable {
+			b.WriteString(" NOT NULL")
+		}
+		if c.primary {
+			pk = sqlfmt.QuoteIdent(c.name, d)
+		}
+		if i < len(cols)-1 || pk != "" {
+			b.WriteString(",")
+		}
+		b.WriteString("\n")
+	}
+	if pk != "" {
+		fmt.Fprintf(&b, "  PRIMARY KEY (%s)", pk)
+		if d == sqlfmt.BigQuery {
+			b.WriteString(" NOT ENFORCED")
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString(");")
+	return b.String()
+}
+
+// sqlLiteral writes one value for a column of the given kind.
+func sqlLiteral(v any, c *sqlColumn, d sqlfmt.Dialect) string {
+	if v == nil {
+		return "NULL"
+	}
+	switch c.kind {
+	case sqlBool:
+		b, _ := v.(bool)
+		if d == sqlfmt.SQLite {
+			return map[bool]string{true: "1", false: "0"}[b]
+		}
+		return strings.ToUpper(strconv.FormatBool(b))
+	case sqlInt, sqlBigInt, sqlNumeric, sqlFloat:
+		s, _ := scalarText(v)
+		return s
+	case sqlTimestamp, sqlTimestampTZ:
+		if t, ok := parseSQLTimestamp(cellText(v)); ok && d == sqlfmt.MySQL {
+			return sqlfmt.QuoteString(t.UTC().Format("2006-01-02 15:04:05.999999"), d)
+		}
+	case sqlJSON:
+		if d == sqlfmt.BigQuery {
+			return "JSON " + sqlfmt.QuoteString(cellText(v), d)
+		}
+	}
+	return sqlfmt.QuoteString(cellText(v), d)
+}
+
+func insertStatements(table string, cols []*sqlColumn, rows []map[string]any, batch int, d sqlfmt.Dialect) string {
+	names := make([]string, len(cols))
+	for i, c := range cols {
+		names[i] = sqlfmt.QuoteIdent(c.name, d)
+	}
+	head := fmt.Sprintf("INSERT INTO %s (%s) VALUES\n", qualifiedName(table, d), strings.Join(names, ", "))
+	var b strings.Builder
+	for start := 0; start < len(rows); start += batch {
+		if start > 0 {
+			b.WriteString("\n\n")
+		}
+		b.WriteString(head)
+		end := min(start+batch, len(rows))
+		for i, row := range rows[start:end] {
+			vals := make([]string, len(cols))
+			for j, c := range cols {
+				vals[j] = sqlLiteral(row[c.name], c, d)
+			}
+			fmt.Fprintf(&b, "  (%s)", strings.Join(vals, ", "))
+			if start+i < end-1 {
+				b.WriteString(",\n")
+			}
+		}
+		b.WriteString(";")
+	}
+	return b.String()
+}
+
+var copyEscaper = strings.NewReplacer(`\`, `\\`, "\t", `\t`, "\n", `\n`, "\r", `\r`)
+
+// copyStatement writes a PostgreSQL COPY in text format: tab-separated
+// fields, \N for null, terminated by \. on its own line.
+func copyStatement(table string, cols []*sqlColumn, rows []map[string]any) string {
+	names := make([]string, len(cols))
+	for i, c := range cols {
+		names[i] = sqlfmt.QuoteIdent(c.name, sqlfmt.PostgreSQL)
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "COPY %s (%s) FROM stdin;\n", qualifiedName(table, sqlfmt.PostgreSQL), strings.Join(names, ", "))
+	for _, row := range rows {
+		fields := make([]string, len(cols))
+		for j, c := range cols {
+			if v := row[c.name]; v == nil {
+				fields[j] = `\N`
+			} else {
+				fields[j] = copyEscaper.Replace(cellText(v))
+			}
+		}
+		b.WriteString(strings.Join(fields, "\t") + "\n")
+	}
+	b.WriteString(`\.`)
+	return b.String()
+}
+
+// DataToSql infers a CREATE TABLE from rows of JSON, CSV or any other format
+// Convert reads, and writes the rows as batched INSERTs or a PostgreSQL COPY.
+func (s *Server) DataToSql(_ context.Context, req *pb.DataToSqlRequest) (*pb.DataToSqlResponse, error) {
+	d, ok := sqlDialects[req.Dialect]
+	if !ok {
+		return &pb.DataToSqlResponse{Error: "unsupported SQL dialect"}, nil
+	}
+	if req.Style == pb.SqlLoadStyle_SQL_LOAD_COPY && d != sqlfmt.PostgreSQL {
+		return &pb.DataToSqlResponse{Error: "COPY output requires the PostgreSQL dialect"}, nil
+	}
+	data, err := parseConvertSource(&pb.ConvertRequest{
+		Data:          req.Data,
+		SourceFormat:  req.Format,
+		CsvDelimiter:  req.CsvDelimiter,
+		CsvInferTypes: true,
+	})
+	if err != nil {
+		return &pb.DataToSqlResponse{Error: fmt.Sprintf("Parse failed: %v", err)}, nil
+	}
+	keys, rows, err := sqlRows(data)
+	if err != nil {
+		return &pb.DataToSqlResponse{Error: err.Error()}, nil
+	}
+	table := strings.TrimSpace(req.TableName)
+	if table == "" {
+		table = "data"
+	}
+	batch := int(req.BatchSize)
+	if batch <= 0 {
+		batch = 100
+	}
+
+	cols := inferSQLColumns(keys, rows, table)
+	resp := &pb.DataToSqlResponse{
+		CreateTable: createTableSQL(table, cols, d),
+		Rows:        int32(len(rows)), // #nosec G115
+	}
+	for _, c := range cols {
+		resp.Columns = append(resp.Columns, &pb.SqlColumn{
+			Name:       c.name,
+			Type:       sqlTypeName(c.kind, c.maxLen, d),
+			Nullable:   c.nullable,
+			PrimaryKey: c.primary,
+		})
+	}
+	if req.Style == pb.SqlLoadStyle_SQL_LOAD_COPY {
+		resp.Statements = copyStatement(table, cols, rows)
+	} else {
+		resp.Statements = insertStatements(table, cols, rows, batch, d)
+	}
+	return resp, nil
+}
+
+// ── DDL → Go ──────────────────────────────────────────────────────────────────
+
+var sqlParamsRe = regexp.MustCompile(`\s*\([^)]*\)`)
+
+// goTypeForSQL maps a declared column type to a Go type and the import it
+// needs, if any.
+func goTypeForSQL(declared string, d sqlfmt.Dialect) (goType, pkg string) {
+	t := strings.ToUpper(strings.Join(strings.Fields(declared), " "))
+	switch {
+	case strings.HasSuffix(t, "[]"):
+		elem, pkg := goTypeForSQL(strings.TrimSuffix(declared, "[]"), d)
+		return "[]" + elem, pkg
+	case strings.HasPrefix(t, "ARRAY<") && strings.HasSuffix(t, ">"):
+		elem, pkg := goTypeForSQL(declared[6:len(declared)-1], d)
+		return "[]" + elem, pkg
+	case strings.HasPrefix(t, "TINYINT(1)"):
+		return "bool", ""
+	}
+	unsigned := strings.Contains(t, "UNSIGNED")
+	t = strings.TrimSpace(strings.NewReplacer(" UNSIGNED", "", " ZEROFILL", "").Replace(sqlParamsRe.ReplaceAllString(t, "")))
+	integer := func(bits string) (string, string) {
+		if unsigned {
+			return "uint" + bits, ""
+		}
+		return "int" + bits, ""
+	}
+	switch t {
+	case "BOOL", "BOOLEAN", "BIT":
+		return "bool", ""
+	case "TINYINT", "INT1":
+		return integer("8")
+	case "SMALLINT", "INT2", "SMALLSERIAL", "SERIAL2", "YEAR":
+		return integer("16")
+	case "INT", "INTEGER", "INT4", "MEDIUMINT", "SERIAL", "SERIAL4":
+		if d == sqlfmt.SQLite && t == "INTEGER" {
+			return integer("64") // SQLite integers are 64-bit
+		}
+		return integer("32")
+	case "BIGINT", "INT8", "BIGSERIAL", "SERIAL8", "INT64":
+		return integer("64")
+	case "REAL", "FLOAT4":
+		if d == sqlfmt.SQLite {
+			return "float64", ""
+		}
+		return "float32", ""
+	case "FLOAT", "FLOAT8", "FLOAT64", "DOUBLE", "DOUBLE PRECISION":
+		return "float64", ""
+	case "DATE", "DATETIME", "TIMESTAMP", "TIMESTAMPTZ", "SMALLDATETIME", "DATETIME2",
+		"TIMESTAMP WITH TIME ZONE", "TIMESTAMP WITHOUT TIME ZONE":
+		return "time.Time", "time"
+	case "BYTEA", "BLOB", "TINYBLOB", "MEDIUMBLOB", "LONGBLOB", "BINARY", "VARBINARY", "BYTES":
+		return "[]byte", ""
+	case "JSON", "JSONB":
+		return "json.RawMessage", "encoding/json"
+	}
+	// DECIMAL and NUMERIC stay strings to keep their precision; character,
+	// UUID, enum, time-of-day and unknown types are strings too.
+	return "string", ""
+}
+
+// sqlNullTypes are the database/sql wrappers for nullable columns.
+var sqlNullTypes = map[string]string{
+	"string": "sql.NullString", "int64": "sql.NullInt64", "int32": "sql.NullInt32", "int16": "sql.NullInt16",
+	"uint8": "sql.NullByte", "float64": "sql.NullFloat64", "bool": "sql.NullBool", "time.Time": "sql.NullTime",
+}
+
+// SqlToGo turns CREATE TABLE statements into Go structs with db tags.
+// Nullable columns use database/sql null types, or pointers on request.
+func (s *Server) SqlToGo(_ context.Context, req *pb.SqlToGoRequest) (*pb.SqlToGoResponse, error) {
+	d, ok := sqlDialects[req.Dialect]
+	if !ok {
+		return &pb.SqlToGoResponse{Error: "unsupported SQL dialect"}, nil
+	}
+	tables, err := sqlfmt.ParseCreateTables(req.Ddl, d)
+	if err != nil {
+		return &pb.SqlToGoResponse{Error: err.Error()}, nil
+	}
+
+	imports := map[string]bool{}
+	var body strings.Builder
+	usedTypes := map[string]bool{}
+	for i, t := range tables {
+		if i > 0 {
+			body.WriteString("\n")
+		}
+		base := t.Name[strings.LastIndexByte(t.Name, '.')+1:]
+		if single := singularize(base); !strings.HasSuffix(single, "Item") {
+			base = single
+		}
+		fmt.Fprintf(&body, "type %s struct {\n", uniqueIdent(toPascalCase(base), usedTypes))
+		used := map[string]bool{}
+		for _, c := range t.Columns {
+			typ, pkg := goTypeForSQL(c.Type, d)
+			if !c.NotNull {
+				switch {
+				case req.UsePointers && !strings.HasPrefix(typ, "[]") && typ != "json.RawMessage":
+					typ = "*" + typ
+				case req.UsePointers:
+				case sqlNullTypes[typ] != "":
+					typ = sqlNullTypes[typ]
+					imports["database/sql"] = true
+				case !strings.HasPrefix(typ, "[]") && typ != "json.RawMessage":
+					typ = "sql.Null[" + typ + "]"
+					imports["database/sql"] = true
+				}
+			}
+			if pkg != "" {
+				imports[pkg] = true
+			}
+			encodings := []string{"db"}
+			if req.JsonTags {
+				encodings = append(encodings, "json")
+			}
+			fmt.Fprintf(&body, "%s %s %s\n", uniqueIdent(toPascalCase(c.Name), used), typ, goStructTag(c.Name, encodings...))
+		}
+		body.WriteString("}\n")
+	}
+
+	var src strings.Builder
+	if len(imports) > 0 {
+		pkgs := make([]string, 0, len(imports))
+		for p := range imports {
+			pkgs = append(pkgs, p)
+		}
+		sort.Strings(pkgs)
+		src.WriteString("import (\n")
+		for _, p := range pkgs {
+			fmt.Fprintf(&src, "%q\n", p)
+		}
+		src.WriteString(")\n\n")
+	}
+	src.WriteString(body.String())
+	out, err := format.Source([]byte(src.String()))
+	if err != nil {
+		return &pb.SqlToGoResponse{Error: fmt.Sprintf("Generation failed: %v", err)}, nil
+	}
+	return &pb.SqlToGoResponse{GoCode: strings.TrimSuffix(string(out), "\n")}, nil
+}
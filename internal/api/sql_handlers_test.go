@@ -0,0 +1,162 @@
+package api
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+func TestDataToSql(t *testing.T) {
+	s := NewServer()
+	rows := `[{"id":1,"name":"O'Brien \\ x","active":true,"meta":{"a":1},"seen":"2024-01-02T03:04:05+02:00"},` +
+		`{"id":2,"name":null,"active":false,"meta":[1],"seen":"2024-01-02T03:04:05Z"}]`
+
+	tests := []struct {
+		name    string
+		req     *pb.DataToSqlRequest
+		want    []string
+		wantErr string
+	}{
+		{"postgres", &pb.DataToSqlRequest{Data: rows, TableName: "Users", Dialect: pb.SqlDialect_SQL_POSTGRESQL},
+			[]string{
+				"CREATE TABLE \"Users\" (\n  id INTEGER NOT NULL,\n  name TEXT,\n  active BOOLEAN NOT NULL,\n  meta JSONB NOT NULL,\n  seen TIMESTAMPTZ NOT NULL,\n  PRIMARY KEY (id)\n);",
+				`(1, 'O''Brien \ x', TRUE, '{"a":1}', '2024-01-02T03:04:05+02:00'),`,
+				"(2, NULL, FALSE, '[1]', '2024-01-02T03:04:05Z');",
+			}, ""},
+		{"mysql escapes and UTC timestamps", &pb.DataToSqlRequest{Data: rows, Dialect: pb.SqlDialect_SQL_MYSQL},
+			[]string{"CREATE TABLE data (", "meta JSON NOT NULL", `(1, 'O\'Brien \\ x', TRUE, '{"a":1}', '2024-01-02 01:04:05'),`}, ""},
+		{"sqlite booleans", &pb.DataToSqlRequest{Data: rows, Dialect: pb.SqlDialect_SQL_SQLITE},
+			[]string{"active INTEGER NOT NULL", "(2, NULL, 0, '[1]', '2024-01-02T03:04:05Z');"}, ""},
+		{"bigquery", &pb.DataToSqlRequest{Data: rows, Dialect: pb.SqlDialect_SQL_BIGQUERY},
+			[]string{"id INT64 NOT NULL", "PRIMARY KEY (id) NOT ENFORCED", `JSON '{"a":1}'`}, ""},
+		{"batches", &pb.DataToSqlRequest{Data: rows, BatchSize: 1},
+			[]string{"VALUES\n  (1, ", ";\n\nINSERT INTO data"}, ""},
+		{"csv copy", &pb.DataToSqlRequest{Data: "user_id,note\n7,\"a\tb\"\n8,\n", Format: pb.DataFormat_CSV,
+			TableName: "users", Dialect: pb.SqlDialect_SQL_POSTGRESQL, Style: pb.SqlLoadStyle_SQL_LOAD_COPY},
+			[]string{"PRIMARY KEY (user_id)", "COPY users (user_id, note) FROM stdin;\n7\ta\\tb\n8\t\\N\n\\."}, ""},
+		{"copy needs postgres", &pb.DataToSqlRequest{Data: rows, Style: pb.SqlLoadStyle_SQL_LOAD_COPY}, nil,
+			"COPY output requires the PostgreSQL dialect"},
+		{"not rows", &pb.DataToSqlRequest{Data: `[1, 2]`}, nil, "row"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.DataToSql(context.Background(), tt.req)
+			if err != nil {
+				t.Fatalf("DataToSql() error = %v", err)
+			}
+			if tt.wantErr != "" {
+				if !strings.Contains(resp.Error, tt.wantErr) {
+					t.Errorf("DataToSql() error = %q, want %q", resp.Error, tt.wantErr)
+				}
+				return
+			}
+			if resp.Error != "" {
+				t.Fatalf("DataToSql() error = %s", resp.Error)
+			}
+			got := resp.CreateTable + "\n" + resp.Statements
+			for _, w := range tt.want {
+				if !strings.Contains(got, w) {
+					t.Errorf("DataToSql() missing %q in\n%s", w, got)
+				}
+			}
+		})
+	}
+}
+
+func TestValueKind_Integers(t *testing.T) {
+	tests := []struct {
+		v    any
+		want sqlKind
+	}{
+		{int64(math.MinInt32), sqlInt},
+		{int64(math.MinInt32) - 1, sqlBigInt},
+		{int64(math.MinInt64), sqlBigInt},
+		{uint64(math.MaxInt64), sqlBigInt},
+		{uint64(math.MaxUint64), sqlNumeric},
+		{-1e19, sqlFloat},
+	}
+	for _, tt := range tests {
+		if got := valueKind(tt.v); got != tt.want {
+			t.Errorf("valueKind(%v) = %v, want %v", tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestDataToSql_Columns(t *testing.T) {
+	s := NewServer()
+	resp, _ := s.DataToSql(context.Background(), &pb.DataToSqlRequest{
+		Data:      `[{"order_id":1,"code":"a"},{"order_id":2,"code":"a"}]`,
+		TableName: "orders",
+	})
+	if resp.Error != "" {
+		t.Fatal(resp.Error)
+	}
+	if resp.Rows != 2 || len(resp.Columns) != 2 {
+		t.Fatalf("got %d rows, %d columns", resp.Rows, len(resp.Columns))
+	}
+	if id, code := resp.Columns[0], resp.Columns[1]; !id.PrimaryKey || id.Nullable || code.PrimaryKey {
+		t.Errorf("primary key guess: %+v, %+v", id, code)
+	}
+}
+
+func TestSqlToGo(t *testing.T) {
+	s := NewServer()
+	ddl := `-- schema
+CREATE TABLE IF NOT EXISTS public.users (
+  id BIGSERIAL PRIMARY KEY,
+  email VARCHAR(255) NOT NULL UNIQUE,
+  "Display Name" text,
+  balance numeric(10, 2) DEFAULT 0,
+  tags text[],
+  meta jsonb,
+  created_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE TABLE order_items (order_id int, item_id int, PRIMARY KEY (order_id, item_id));`
+
+	resp, err := s.SqlToGo(context.Background(), &pb.SqlToGoRequest{Ddl: ddl, Dialect: pb.SqlDialect_SQL_POSTGRESQL})
+	if err != nil || resp.Error != "" {
+		t.Fatalf("SqlToGo() error = %v %s", err, resp.Error)
+	}
+	for _, w := range []string{
+		"\"database/sql\"", "\"encoding/json\"", "\"time\"",
+		"type User struct {",
+		"ID          int64           `db:\"id\"`",
+		"DisplayName sql.NullString  `db:\"Display Name\"`",
+		"Tags        []string        `db:\"tags\"`",
+		"Meta        json.RawMessage `db:\"meta\"`",
+		"CreatedAt   time.Time       `db:\"created_at\"`",
+		"type OrderItem struct {",
+		"OrderID int32 `db:\"order_id\"`",
+	} {
+		if !strings.Contains(resp.GoCode, w) {
+			t.Errorf("SqlToGo() missing %q in\n%s", w, resp.GoCode)
+		}
+	}
+
+	resp, _ = s.SqlToGo(context.Background(), &pb.SqlToGoRequest{
+		Ddl: "CREATE TABLE t (flag tinyint(1), n int unsigned)", Dialect: pb.SqlDialect_SQL_MYSQL, UsePointers: true, JsonTags: true,
+	})
+	for _, w := range []string{"Flag *bool   `db:\"flag\" json:\"flag\"`", "N    *uint32 `db:\"n\" json:\"n\"`"} {
+		if !strings.Contains(resp.GoCode, w) {
+			t.Errorf("SqlToGo() missing %q in\n%s", w, resp.GoCode)
+		}
+	}
+
+	resp, _ = s.SqlToGo(context.Background(), &pb.SqlToGoRequest{
+		Ddl: "CREATE TABLE t (userId int NOT NULL, `odd``col` int NOT NULL)", Dialect: pb.SqlDialect_SQL_MYSQL,
+	})
+	for _, w := range []string{"UserID int32 `db:\"userId\"`", "OddCol int32 // key \"odd`col\" can't be written in a struct tag"} {
+		if !strings.Contains(resp.GoCode, w) {
+			t.Errorf("SqlToGo() missing %q in\n%s %s", w, resp.GoCode, resp.Error)
+		}
+	}
+
+	resp, _ = s.SqlToGo(context.Background(), &pb.SqlToGoRequest{Ddl: "SELECT 1"})
+	if resp.Error == "" {
+		t.Error("SqlToGo() expected error without CREATE TABLE")
+	}
+}
@@ -0,0 +1,349 @@
+package api
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/width"
+
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+// Table outputs render the same rows as CSV: an array of objects (headers
+// are the union of keys) or an array of arrays (headers are "Column n").
+// Columns whose non-empty cells are all numbers are right-aligned.
+
+// textTable is a tabulated value ready for rendering.
+type textTable struct {
+	headers []string
+	rows    [][]string
+	right   []bool // right-align column i
+}
+
+func buildTable(data any, req *pb.ConvertRequest, format string) (*textTable, error) {
+	headers, records, err := tabulate(data, req, format)
+	if err != nil {
+		return nil, err
+	}
+	cols := len(headers)
+	for _, r := range records {
+		cols = max(cols, len(r))
+	}
+	if headers == nil {
+		headers = make([]string, cols)
+		for i := range headers {
+			headers[i] = "Column " + strconv.Itoa(i+1)
+		}
+	}
+	t := &textTable{headers: headers, rows: make([][]string, len(records)), right: make([]bool, cols)}
+	numeric := make([]int, cols)
+	filled := make([]int, cols)
+	for i, r := range records {
+		row := make([]string, cols)
+		for j, f := range r {
+			row[j] = f.text
+			if f.text != "" {
+				filled[j]++
+				if isNumberText(f.text) {
+					numeric[j]++
+				}
+			}
+		}
+		t.rows[i] = row
+	}
+	for j := range cols {
+		t.right[j] = filled[j] > 0 && numeric[j] == filled[j]
+	}
+	return t, nil
+}
+
+// isNumberText reports whether a field reads as a number, whether it was
+// typed or came from untyped CSV text.
+func isNumberText(s string) bool {
+	return csvNumberRe.MatchString(s)
+}
+
+// displayWidth is the number of terminal columns s occupies.
+func displayWidth(s string) int {
+	w := 0
+	for _, r := range s {
+		switch {
+		case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		case width.LookupRune(r).Kind() == width.EastAsianWide, width.LookupRune(r).Kind() == width.EastAsianFullwidth:
+			w += 2
+		default:
+			w++
+		}
+	}
+	return w
+}
+
+func pad(s string, w int, right bool) string {
+	fill := strings.Repeat(" ", max(0, w-displayWidth(s)))
+	if right {
+		return fill + s
+	}
+	return s + fill
+}
+
+// ── Markdown ──────────────────────────────────────────────────────────────────
+
+var mdCellEscaper = strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>")
+
+// marshalMarkdownTable writes a GitHub-flavored Markdown table with padded
+// columns.
+func marshalMarkdownTable(data any, req *pb.ConvertRequest) ([]byte, error) {
+	t, err := buildTable(data, req, "Markdown table")
+	if err != nil {
+		return nil, err
+	}
+	if len(t.headers) == 0 {
+		return []byte{}, nil
+	}
+	cells := func(row []string) []string {
+		out := make([]string, len(row))
+		for i, c := range row {
+			out[i] = mdCellEscaper.Replace(c)
+		}
+		return out
+	}
+	header := cells(t.headers)
+	body := make([][]string, len(t.rows))
+	widths := make([]int, len(header))
+	for i, h := range header {
+		widths[i] = max(3, displayWidth(h))
+	}
+	for i, r := range t.rows {
+		body[i] = cells(r)
+		for j, c := range body[i] {
+			widths[j] = max(widths[j], displayWidth(c))
+		}
+	}
+	var b strings.Builder
+	writeRow := func(row []string) {
+		b.WriteString("|")
+		for j, c := range row {
+			b.WriteString(" " + pad(c, widths[j], t.right[j]) + " |")
+		}
+		b.WriteString("\n")
+	}
+	writeRow(header)
+	b.WriteString("|")
+	for j, w := range widths {
+		if t.right[j] {
+			b.WriteString(" " + strings.Repeat("-", w-1) + ": |")
+		} else {
+			b.WriteString(" " + strings.Repeat("-", w) + " |")
+		}
+	}
+	b.WriteString("\n")
+	for _, r := range body {
+		writeRow(r)
+	}
+	return []byte(b.String()), nil
+}
+
+var mdDelimiterCellRe = regexp.MustCompile(`^:?-+:?$`)
+
+// splitMarkdownRow splits "| a | b \| c |" into ["a", "b | c"]. ok is false
+// for lines that are not table rows.
+func splitMarkdownRow(line string) (cells []string, ok bool) {
+	line = strings.TrimSpace(line)
+	if !strings.Contains(line, "|") {
+		return nil, false
+	}
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, `\|`) {
+		line = line[:len(line)-1]
+	}
+	var cur strings.Builder
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '\\' && i+1 < len(line) && line[i+1] == '|':
+			cur.WriteByte('|')
+			i++
+		case line[i] == '|':
+			cells = append(cells, strings.TrimSpace(cur.String()))
+			cur.Reset()
+		default:
+			cur.WriteByte(line[i])
+		}
+	}
+	cells = append(cells, strings.TrimSpace(cur.String()))
+	return cells, true
+}
+
+var mdBreakRe = regexp.MustCompile(`(?i)<br\s*/?>`)
+
+// parseMarkdownTable reads the first GFM table in data into an array of
+// objects keyed by the header row. Text around the table is ignored.
+func parseMarkdownTable(req *pb.ConvertRequest, ordered bool) (any, error) {
+	lines := strings.Split(strings.ReplaceAll(req.Data, "\r\n", "\n"), "\n")
+	start := -1
+	var headers []string
+	for i := 0; i+1 < len(lines); i++ {
+		h, ok := splitMarkdownRow(lines[i])
+		if !ok {
+			continue
+		}
+		d, ok := splitMarkdownRow(lines[i+1])
+		if !ok || len(d) != len(h) {
+			continue
+		}
+		delimiter := true
+		for _, c := range d {
+			delimiter = delimiter && mdDelimiterCellRe.MatchString(c)
+		}
+		if delimiter {
+			headers, start = h, i+2
+			break
+		}
+	}
+	if start < 0 {
+		return nil, fmt.Errorf("no Markdown table found: expected a header row followed by a |---| delimiter row")
+	}
+	cell := func(s string) any {
+		s = mdBreakRe.ReplaceAllString(s, "\n")
+		if req.CsvInferTypes {
+			return inferCSVValue(s)
+		}
+		return s
+	}
+	rows := []any{}
+	for _, line := range lines[start:] {
+		cells, ok := splitMarkdownRow(line)
+		if !ok {
+			break
+		}
+		obj := &orderedObject{values: make(map[string]any, len(headers))}
+		for j, h := range headers {
+			v := ""
+			if j < len(cells) {
+				v = cells[j]
+			}
+			if _, dup := obj.values[h]; !dup {
+				obj.keys = append(obj.keys, h)
+			}
+			obj.values[h] = cell(v)
+		}
+		if ordered {
+			rows = append(rows, obj)
+		} else {
+			rows = append(rows, plainValue(obj))
+		}
+	}
+	return rows, nil
+}
+
+// ── HTML ──────────────────────────────────────────────────────────────────────
+
+// marshalHTMLTable writes a <table> with a <thead> and <tbody>.
+func marshalHTMLTable(data any, req *pb.ConvertRequest) ([]byte, error) {
+	t, err := buildTable(data, req, "HTML table")
+	if err != nil {
+		return nil, err
+	}
+	if len(t.headers) == 0 {
+		return []byte("<table></table>\n"), nil
+	}
+	esc := func(s string) string {
+		return strings.ReplaceAll(html.EscapeString(s), "\n", "<br>")
+	}
+	var b strings.Builder
+	writeRow := func(row []string, tag string) {
+		b.WriteString("    <tr>\n")
+		for j, c := range row {
+			b.WriteString("      <" + tag)
+			if t.right[j] {
+				b.WriteString(` style="text-align: right"`)
+			}
+			b.WriteString(">" + esc(c) + "</" + tag + ">\n")
+		}
+		b.WriteString("    </tr>\n")
+	}
+	b.WriteString("<table>\n  <thead>\n")
+	writeRow(t.headers, "th")
+	b.WriteString("  </thead>\n  <tbody>\n")
+	for _, r := range t.rows {
+		writeRow(r, "td")
+	}
+	b.WriteString("  </tbody>\n</table>\n")
+	return []byte(b.String()), nil
+}
+
+// ── ASCII ─────────────────────────────────────────────────────────────────────
+
+// marshalASCIITable writes a box-drawn table. Multi-line cells span several
+// text lines within their row.
+func marshalASCIITable(data any, req *pb.ConvertRequest) ([]byte, error) {
+	t, err := buildTable(data, req, "ASCII table")
+	if err != nil {
+		return nil, err
+	}
+	if len(t.headers) == 0 {
+		return []byte{}, nil
+	}
+	split := func(row []string) [][]string {
+		out := make([][]string, len(row))
+		for i, c := range row {
+			out[i] = strings.Split(strings.ReplaceAll(c, "\r\n", "\n"), "\n")
+		}
+		return out
+	}
+	header := split(t.headers)
+	body := make([][][]string, len(t.rows))
+	widths := make([]int, len(header))
+	measure := func(row [][]string) {
+		for j, lines := range row {
+			for _, l := range lines {
+				widths[j] = max(widths[j], displayWidth(l))
+			}
+		}
+	}
+	measure(header)
+	for i, r := range t.rows {
+		body[i] = split(r)
+		measure(body[i])
+	}
+
+	var b strings.Builder
+	rule := func(left, mid, right string) {
+		b.WriteString(left)
+		for j, w := range widths {
+			if j > 0 {
+				b.WriteString(mid)
+			}
+			b.WriteString(strings.Repeat("─", w+2))
+		}
+		b.WriteString(right + "\n")
+	}
+	writeRow := func(row [][]string, align bool) {
+		height := 1
+		for _, lines := range row {
+			height = max(height, len(lines))
+		}
+		for line := range height {
+			b.WriteString("│")
+			for j, lines := range row {
+				text := ""
+				if line < len(lines) {
+					text = lines[line]
+				}
+				b.WriteString(" " + pad(text, widths[j], align && t.right[j]) + " │")
+			}
+			b.WriteString("\n")
+		}
+	}
+	rule("┌", "┬", "┐")
+	writeRow(header, false)
+	rule("├", "┼", "┤")
+	for _, r := range body {
+		writeRow(r, true)
+	}
+	rule("└", "┴", "┘")
+	return []byte(b.String()), nil
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+const tableSrc = `[{"name":"Ada","age":36,"note":"a|b"},{"name":"李雷","age":7,"note":"two\nlines"}]`
+
+func TestConvert_MarkdownTable(t *testing.T) {
+	got := convertWith(t, &pb.ConvertRequest{Data: tableSrc, TargetFormat: pb.DataFormat_MARKDOWN_TABLE})
+	want := "| name | age | note         |\n" +
+		"| ---- | --: | ------------ |\n" +
+		"| Ada  |  36 | a\\|b         |\n" +
+		"| 李雷 |   7 | two<br>lines |\n"
+	if got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+
+	back := convertWith(t, &pb.ConvertRequest{
+		Data: "Results:\n\n" + got + "\nTrailing text", SourceFormat: pb.DataFormat_MARKDOWN_TABLE,
+		CsvInferTypes: true,
+	})
+	if !reflect.DeepEqual(decodeJSON(t, back), decodeJSON(t, tableSrc)) {
+		t.Errorf("round trip = %s", back)
+	}
+}
+
+func TestConvert_HTMLTable(t *testing.T) {
+	got := convertWith(t, &pb.ConvertRequest{Data: `[{"k":"<b>","n":"1.5"}]`, TargetFormat: pb.DataFormat_HTML_TABLE})
+	want := `<table>
+  <thead>
+    <tr>
+      <th>k</th>
+      <th style="text-align: right">n</th>
+    </tr>
+  </thead>
+  <tbody>
+    <tr>
+      <td>&lt;b&gt;</td>
+      <td style="text-align: right">1.5</td>
+    </tr>
+  </tbody>
+</table>
+`
+	if got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestConvert_ASCIITable(t *testing.T) {
+	got := convertWith(t, &pb.ConvertRequest{Data: tableSrc, TargetFormat: pb.DataFormat_ASCII_TABLE})
+	want := `┌──────┬─────┬───────┐
+│ name │ age │ note  │
+├──────┼─────┼───────┤
+│ Ada  │  36 │ a|b   │
+│ 李雷 │   7 │ two   │
+│      │     │ lines │
+└──────┴─────┴───────┘
+`
+	if got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+
+	got = convertWith(t, &pb.ConvertRequest{Data: "x,y\n1,2\n", SourceFormat: pb.DataFormat_CSV, TargetFormat: pb.DataFormat_ASCII_TABLE, CsvNoHeader: true})
+	want = "┌──────────┬──────────┐\n│ Column 1 │ Column 2 │\n├──────────┼──────────┤\n│ x        │ y        │\n│ 1        │ 2        │\n└──────────┴──────────┘\n"
+	if got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestConvert_TableErrors(t *testing.T) {
+	tests := []struct {
+		req  *pb.ConvertRequest
+		want string
+	}{
+		{&pb.ConvertRequest{Data: "just text", SourceFormat: pb.DataFormat_MARKDOWN_TABLE}, "no Markdown table found"},
+		{&pb.ConvertRequest{Data: "<table></table>", SourceFormat: pb.DataFormat_HTML_TABLE}, "output-only"},
+		{&pb.ConvertRequest{Data: `{"a":1,"b":2}`, TargetFormat: pb.DataFormat_MARKDOWN_TABLE}, "requires an array"},
+	}
+	for _, tt := range tests {
+		resp, _ := NewServer().Convert(context.Background(), tt.req)
+		if !strings.Contains(resp.Error, tt.want) {
+			t.Errorf("%q: error = %q, want %q", tt.req.Data, resp.Error, tt.want)
+		}
+	}
+}
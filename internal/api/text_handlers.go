@@ -1,46 +1,138 @@
 package api
 
 import (
+	"cmp"
 	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"html"
-	"regexp"
 	"sort"
 	"strings"
+	"time"
 	"unicode"
+	"unicode/utf8"
 
-	"github.com/sergi/go-diff/diffmatchpatch"
-
+	"github.com/odinnordico/privutil/internal/regex"
+	"github.com/odinnordico/privutil/internal/textdiff"
 	pb "github.com/odinnordico/privutil/proto"
 )
 
+// diffContextLines is the default number of unchanged lines around each
+// hunk, as in diff -u.
+const diffContextLines = 3
+
+// Diff compares two texts by characters, words or lines. diff_html marks
+// the differences at the requested granularity; the unified diff, hunks
+// and line counts always compare lines.
 func (s *Server) Diff(ctx context.Context, req *pb.DiffRequest) (*pb.DiffResponse, error) {
-	dmp := diffmatchpatch.New()
-	diffs := dmp.DiffMain(req.Text1, req.Text2, false)
+	opts := textdiff.Options{IgnoreCase: req.IgnoreCase, IgnoreWhitespace: req.IgnoreWhitespace}
+	aLines, bLines := textdiff.Lines(req.Text1), textdiff.Lines(req.Text2)
+	lineEdits, err := textdiff.Diff(aLines, bLines, textdiff.LineKey(opts))
+	if err != nil {
+		return &pb.DiffResponse{Error: fmt.Sprintf("Diff failed: %v", err)}, nil
+	}
 
-	var buffer strings.Builder
-	for _, diff := range diffs {
-		escapedText := html.EscapeString(diff.Text)
+	a, b, edits := aLines, bLines, lineEdits
+	if req.Mode != pb.DiffMode_DIFF_LINE {
+		split := textdiff.Chars
+		if req.Mode == pb.DiffMode_DIFF_WORD {
+			split = textdiff.Words
+		}
+		a, b = split(req.Text1), split(req.Text2)
+		if edits, err = textdiff.Diff(a, b, textdiff.TokenKey(opts)); err != nil {
+			return &pb.DiffResponse{Error: fmt.Sprintf("Diff failed: %v", err)}, nil
+		}
+	}
 
-		switch diff.Type {
-		case diffmatchpatch.DiffInsert:
+	resp := &pb.DiffResponse{}
+	var buffer strings.Builder
+	for _, e := range edits {
+		switch e.Op {
+		case textdiff.Insert:
+			resp.Inserted += diffUnits(b[e.BStart:e.BEnd], req.Mode)
 			buffer.WriteString("<ins style='background:#ccffd8; color:#004d0d; text-decoration:none; padding:1px 2px; border-radius:2px;'>")
-			buffer.WriteString(escapedText)
+			buffer.WriteString(html.EscapeString(strings.Join(b[e.BStart:e.BEnd], "")))
 			buffer.WriteString("</ins>")
-		case diffmatchpatch.DiffDelete:
+		case textdiff.Delete:
+			resp.Deleted += diffUnits(a[e.AStart:e.AEnd], req.Mode)
 			buffer.WriteString("<del style='background:#ffd7d5; color:#991b1b; text-decoration:line-through; padding:1px 2px; border-radius:2px;'>")
-			buffer.WriteString(escapedText)
+			buffer.WriteString(html.EscapeString(strings.Join(a[e.AStart:e.AEnd], "")))
 			buffer.WriteString("</del>")
-		case diffmatchpatch.DiffEqual:
+		case textdiff.Equal:
 			buffer.WriteString("<span>")
-			buffer.WriteString(escapedText)
+			buffer.WriteString(html.EscapeString(strings.Join(b[e.BStart:e.BEnd], "")))
 			buffer.WriteString("</span>")
 		}
 	}
+	resp.DiffHtml = fmt.Sprintf("<div class='diff-output' style='white-space: pre-wrap; font-family: monospace;'>%s</div>", buffer.String())
 
-	return &pb.DiffResponse{
-		DiffHtml: fmt.Sprintf("<div class='diff-output' style='white-space: pre-wrap; font-family: monospace;'>%s</div>", buffer.String()),
-	}, nil
+	for _, e := range lineEdits {
+		switch e.Op {
+		case textdiff.Insert:
+			resp.LinesAdded += int32(e.BEnd - e.BStart) // #nosec G115
+		case textdiff.Delete:
+			resp.LinesRemoved += int32(e.AEnd - e.AStart) // #nosec G115
+		}
+	}
+	resp.Identical = resp.LinesAdded == 0 && resp.LinesRemoved == 0
+
+	contextLines := int(req.ContextLines)
+	if contextLines == 0 {
+		contextLines = diffContextLines
+	}
+	hunks := textdiff.Hunks(lineEdits, contextLines)
+	label1, label2 := cmp.Or(req.Label1, "a"), cmp.Or(req.Label2, "b")
+	resp.Unified = textdiff.Unified(aLines, bLines, label1, label2, hunks)
+	for _, h := range hunks {
+		ph := &pb.DiffHunk{
+			OldStart: int32(hunkStart(h.AStart, h.ALines)), // #nosec G115
+			OldLines: int32(h.ALines),                      // #nosec G115
+			NewStart: int32(hunkStart(h.BStart, h.BLines)), // #nosec G115
+			NewLines: int32(h.BLines),                      // #nosec G115
+			Header:   h.Header(),
+		}
+		for _, r := range textdiff.Rows(aLines, bLines, h) {
+			ph.Rows = append(ph.Rows, &pb.DiffRow{
+				Kind:    r.Op.String(),
+				OldLine: int32(r.ALine), // #nosec G115
+				OldText: r.A,
+				NewLine: int32(r.BLine), // #nosec G115
+				NewText: r.B,
+			})
+		}
+		resp.Hunks = append(resp.Hunks, ph)
+	}
+	return resp, nil
+}
+
+// hunkStart is the 1-based start of a unified range; an empty range names
+// the line before it.
+func hunkStart(start, n int) int {
+	if n == 0 {
+		return start
+	}
+	return start + 1
+}
+
+// diffUnits counts changed tokens in the units of the mode: characters,
+// words without the whitespace between them, or lines.
+func diffUnits(tokens []string, mode pb.DiffMode) int32 {
+	n := 0
+	for _, t := range tokens {
+		switch mode {
+		case pb.DiffMode_DIFF_CHARACTER:
+			n += utf8.RuneCountInString(t)
+		case pb.DiffMode_DIFF_WORD:
+			if strings.TrimSpace(t) != "" {
+				n++
+			}
+		default:
+			n++
+		}
+	}
+	return int32(n) // #nosec G115
 }
 
 func (s *Server) TextInspect(ctx context.Context, req *pb.TextInspectRequest) (*pb.TextInspectResponse, error) {
@@ -145,17 +237,170 @@ func (s *Server) TextSimilarity(ctx context.Context, req *pb.SimilarityRequest)
 	}, nil
 }
 
+// Regex test limits: the default and largest search time for the
+// backtracking engines, and how many matches are listed.
+const (
+	regexTimeout    = time.Second
+	maxRegexTimeout = 10 * time.Second
+	maxRegexMatches = 1000
+)
+
+// RegexTest runs a pattern over text and lists every match with its
+// groups and offsets, optionally previewing a replacement.
 func (s *Server) RegexTest(ctx context.Context, req *pb.RegexRequest) (*pb.RegexResponse, error) {
-	re, err := regexp.Compile(req.Pattern)
+	engine := regex.RE2
+	switch req.Engine {
+	case pb.RegexEngine_REGEX_BACKTRACKING:
+		engine = regex.Backtracking
+	case pb.RegexEngine_REGEX_ECMASCRIPT:
+		engine = regex.ECMAScript
+	}
+	timeout := regexTimeout
+	if req.TimeoutMs > 0 {
+		timeout = min(time.Duration(req.TimeoutMs)*time.Millisecond, maxRegexTimeout)
+	}
+	re, err := regex.Compile(req.Pattern, req.Flags, engine)
 	if err != nil {
 		return &pb.RegexResponse{Error: fmt.Sprintf("Invalid Pattern: %v", err)}, nil
 	}
 
-	matches := re.FindAllString(req.Text, -1)
-	return &pb.RegexResponse{
-		Match:   len(matches) > 0,
-		Matches: matches,
-	}, nil
+	// One deadline covers the matches and the replacement together.
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	matches, truncated, err := re.FindAll(ctx, req.Text, maxRegexMatches)
+	if err != nil {
+		return &pb.RegexResponse{Error: regexSearchError(err, timeout)}, nil
+	}
+	resp := &pb.RegexResponse{
+		Match:      len(matches) > 0,
+		GroupNames: re.GroupNames(),
+		Truncated:  truncated,
+	}
+	for _, m := range matches {
+		d := &pb.RegexMatchDetail{}
+		for _, g := range m.Groups {
+			d.Groups = append(d.Groups, &pb.RegexGroup{
+				Number:    int32(g.Number), // #nosec G115
+				Name:      g.Name,
+				Matched:   g.Matched,
+				Text:      g.Text,
+				Start:     int32(g.Start),     // #nosec G115
+				End:       int32(g.End),       // #nosec G115
+				RuneStart: int32(g.RuneStart), // #nosec G115
+				RuneEnd:   int32(g.RuneEnd),   // #nosec G115
+			})
+		}
+		resp.Matches = append(resp.Matches, m.Groups[0].Text)
+		resp.Details = append(resp.Details, d)
+	}
+	if req.Replacement != nil {
+		if resp.Replaced, err = re.Replace(ctx, req.Text, *req.Replacement); err != nil {
+			return &pb.RegexResponse{Error: regexSearchError(err, timeout)}, nil
+		}
+	}
+	return resp, nil
+}
+
+func regexSearchError(err error, timeout time.Duration) string {
+	if errors.Is(err, regex.ErrTimeout) {
+		return fmt.Sprintf("Match timeout after %v", timeout)
+	}
+	return err.Error()
+}
+
+// RegexExplain describes a pattern token by token and warns about
+// constructs that backtrack badly or are likely mistakes.
+func (s *Server) RegexExplain(_ context.Context, req *pb.RegexExplainRequest) (*pb.RegexExplainResponse, error) {
+	engine := regex.RE2
+	switch req.Engine {
+	case pb.RegexEngine_REGEX_BACKTRACKING:
+		engine = regex.Backtracking
+	case pb.RegexEngine_REGEX_ECMASCRIPT:
+		engine = regex.ECMAScript
+	}
+	ex, err := regex.Explain(req.Pattern, req.Flags, engine)
+	if err != nil {
+		return &pb.RegexExplainResponse{Error: fmt.Sprintf("Invalid Pattern: %v", err)}, nil
+	}
+	resp := &pb.RegexExplainResponse{
+		Tree:       regexToken(ex.Root),
+		Summary:    "Matches " + ex.Root.Description + ".",
+		GroupCount: int32(ex.Groups), // #nosec G115
+	}
+	for _, w := range ex.Warnings {
+		resp.Warnings = append(resp.Warnings, &pb.RegexWarning{
+			Code:    w.Code,
+			Start:   int32(w.Start), // #nosec G115
+			End:     int32(w.End),   // #nosec G115
+			Message: w.Message,
+		})
+	}
+	return resp, nil
+}
+
+// Regex sample limits: strings of each kind and repetitions past a
+// quantifier's minimum, by default and at most.
+const (
+	regexSamples    = 10
+	maxRegexSamples = 1000
+	regexRepeat     = 5
+	maxRegexRepeat  = 100
+)
+
+// RegexGenerate produces random strings that match an RE2 pattern and
+// near misses that don't, for exercising validators.
+func (s *Server) RegexGenerate(_ context.Context, req *pb.RegexGenerateRequest) (*pb.RegexGenerateResponse, error) {
+	resp := &pb.RegexGenerateResponse{Seed: req.Seed}
+	count := int(req.Count)
+	if count == 0 {
+		count = regexSamples
+	}
+	if count < 0 || count > maxRegexSamples {
+		resp.Error = fmt.Sprintf("count must be between 1 and %d", maxRegexSamples)
+		return resp, nil
+	}
+	repeat := int(req.MaxRepeat)
+	switch {
+	case repeat == 0:
+		repeat = regexRepeat
+	case repeat < 0:
+		repeat = 0
+	}
+	repeat = min(repeat, maxRegexRepeat)
+	if resp.Seed == 0 {
+		var b [8]byte
+		_, _ = rand.Read(b[:])
+		resp.Seed = int64(binary.BigEndian.Uint64(b[:]) >> 1) // #nosec G115
+	}
+
+	samples, err := regex.Generate(req.Pattern, req.Flags, count, repeat, uint64(resp.Seed)) // #nosec G115
+	switch {
+	case errors.Is(err, regex.ErrNoMatch), errors.Is(err, regex.ErrTooLong):
+		resp.Error = err.Error()
+		return resp, nil
+	case err != nil:
+		resp.Error = fmt.Sprintf("Invalid Pattern: %v", err)
+		return resp, nil
+	}
+	resp.Matches, resp.Truncated = samples.Matches, samples.Truncated
+	for _, m := range samples.NearMisses {
+		resp.NearMisses = append(resp.NearMisses, &pb.RegexNearMiss{Text: m.Text, Source: m.Source, Change: m.Change})
+	}
+	return resp, nil
+}
+
+func regexToken(t *regex.Token) *pb.RegexToken {
+	out := &pb.RegexToken{
+		Kind:        t.Kind,
+		Start:       int32(t.Start), // #nosec G115
+		End:         int32(t.End),   // #nosec G115
+		Text:        t.Text,
+		Description: t.Description,
+	}
+	for _, c := range t.Children {
+		out.Children = append(out.Children, regexToken(c))
+	}
+	return out
 }
 
 func (s *Server) CaseConvert(ctx context.Context, req *pb.CaseRequest) (*pb.CaseResponse, error) {
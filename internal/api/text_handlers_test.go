@@ -2,9 +2,14 @@ package api
 
 import (
 	"context"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	pb "github.com/odinnordico/privutil/proto"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestDiff(t *testing.T) {
@@ -39,6 +44,75 @@ func TestDiff(t *testing.T) {
 	}
 }
 
+func TestDiff_Modes(t *testing.T) {
+	s := NewServer()
+	tests := []struct {
+		name      string
+		req       *pb.DiffRequest
+		wantHTML  string
+		ins, del  int32
+		identical bool
+	}{
+		{"characters", &pb.DiffRequest{Text1: "cat", Text2: "cut"}, "<span>c</span><del style='background:#ffd7d5; color:#991b1b; text-decoration:line-through; padding:1px 2px; border-radius:2px;'>a</del><ins style='background:#ccffd8; color:#004d0d; text-decoration:none; padding:1px 2px; border-radius:2px;'>u</ins><span>t</span>", 1, 1, false},
+		{"words", &pb.DiffRequest{Text1: "the quick fox", Text2: "the slow brown fox", Mode: pb.DiffMode_DIFF_WORD}, "", 2, 1, false},
+		{"lines", &pb.DiffRequest{Text1: "a\nb\n", Text2: "a\nc\n", Mode: pb.DiffMode_DIFF_LINE}, "", 1, 1, false},
+		{"ignore case", &pb.DiffRequest{Text1: "Hello", Text2: "hello", IgnoreCase: true}, "", 0, 0, true},
+		{"ignore whitespace", &pb.DiffRequest{Text1: "a  =  1\n", Text2: "a = 1\n", Mode: pb.DiffMode_DIFF_LINE, IgnoreWhitespace: true}, "", 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.Diff(context.Background(), tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.wantHTML != "" && !strings.Contains(resp.DiffHtml, tt.wantHTML) {
+				t.Errorf("html = %s", resp.DiffHtml)
+			}
+			if resp.Inserted != tt.ins || resp.Deleted != tt.del || resp.Identical != tt.identical {
+				t.Errorf("inserted = %d, deleted = %d, identical = %v", resp.Inserted, resp.Deleted, resp.Identical)
+			}
+		})
+	}
+}
+
+func TestDiff_Unified(t *testing.T) {
+	resp, err := NewServer().Diff(context.Background(), &pb.DiffRequest{
+		Text1:        "one\ntwo\nthree\nfour\n",
+		Text2:        "one\n2\nthree\nfour\nfive\n",
+		Mode:         pb.DiffMode_DIFF_LINE,
+		ContextLines: 1,
+		Label1:       "old.txt",
+		Label2:       "new.txt",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "--- old.txt\n+++ new.txt\n@@ -1,4 +1,5 @@\n one\n-two\n+2\n three\n four\n+five\n"
+	if resp.Unified != want {
+		t.Errorf("unified = %q, want %q", resp.Unified, want)
+	}
+	if resp.LinesAdded != 2 || resp.LinesRemoved != 1 {
+		t.Errorf("lines added = %d, removed = %d", resp.LinesAdded, resp.LinesRemoved)
+	}
+	if len(resp.Hunks) != 1 {
+		t.Fatalf("hunks = %v", resp.Hunks)
+	}
+	h := resp.Hunks[0]
+	if h.OldStart != 1 || h.OldLines != 4 || h.NewStart != 1 || h.NewLines != 5 || h.Header != "@@ -1,4 +1,5 @@" {
+		t.Errorf("hunk = %+v", h)
+	}
+	var kinds []string
+	for _, r := range h.Rows {
+		kinds = append(kinds, r.Kind)
+	}
+	if got := strings.Join(kinds, ","); got != "equal,change,equal,equal,insert" {
+		t.Errorf("row kinds = %s", got)
+	}
+	if r := h.Rows[1]; r.OldLine != 2 || r.OldText != "two" || r.NewLine != 2 || r.NewText != "2" {
+		t.Errorf("change row = %+v", r)
+	}
+}
+
 func TestTextInspect(t *testing.T) {
 	s := NewServer()
 	ctx := context.Background()
@@ -148,6 +222,68 @@ func TestRegexTest(t *testing.T) {
 	}
 }
 
+func TestRegexTest_Details(t *testing.T) {
+	s := NewServer()
+	ctx := context.Background()
+
+	resp, err := s.RegexTest(ctx, &pb.RegexRequest{Pattern: `(?P<user>\w+)@(\w+)`, Text: "née: ann@example, bob@test", Replacement: proto.String("${user} at $2")})
+	if err != nil {
+		t.Fatalf("RegexTest() error = %v", err)
+	}
+	if len(resp.Details) != 2 || !reflect.DeepEqual(resp.GroupNames, []string{"", "user", ""}) {
+		t.Fatalf("RegexTest() details = %v, group names = %q", resp.Details, resp.GroupNames)
+	}
+	if g := resp.Details[0].Groups[1]; g.Text != "ann" || g.Name != "user" || g.Start != 6 || g.End != 9 || g.RuneStart != 5 || g.RuneEnd != 8 {
+		t.Errorf("RegexTest() user group = %v", g)
+	}
+	if resp.Replaced != "née: ann at example, bob at test" {
+		t.Errorf("RegexTest() replaced = %q", resp.Replaced)
+	}
+
+	tests := []struct {
+		name    string
+		req     *pb.RegexRequest
+		matches []string
+		wantErr string
+	}{
+		{"flags", &pb.RegexRequest{Pattern: `^b`, Text: "a\nB", Flags: "im"}, []string{"B"}, ""},
+		{"lookahead", &pb.RegexRequest{Pattern: `\d+(?= USD)`, Text: "5 EUR, 7 USD", Engine: pb.RegexEngine_REGEX_BACKTRACKING}, []string{"7"}, ""},
+		{"ecmascript", &pb.RegexRequest{Pattern: `(?<=\$)\d+`, Text: "$5 and 7", Engine: pb.RegexEngine_REGEX_ECMASCRIPT}, []string{"5"}, ""},
+		{"lookahead needs backtracking", &pb.RegexRequest{Pattern: `a(?=b)`, Text: "ab"}, nil, "Invalid Pattern"},
+		{"unknown flag", &pb.RegexRequest{Pattern: `a`, Text: "a", Flags: "g"}, nil, "unknown flag"},
+		{"timeout", &pb.RegexRequest{Pattern: `(a+)+$`, Text: strings.Repeat("a", 40) + "!", Engine: pb.RegexEngine_REGEX_BACKTRACKING, TimeoutMs: 20}, nil, "timeout"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.RegexTest(ctx, tt.req)
+			if err != nil {
+				t.Fatalf("RegexTest() error = %v", err)
+			}
+			if !strings.Contains(resp.Error, tt.wantErr) || (tt.wantErr == "") != (resp.Error == "") {
+				t.Fatalf("RegexTest() error = %q, want %q", resp.Error, tt.wantErr)
+			}
+			if !reflect.DeepEqual(resp.Matches, tt.matches) {
+				t.Errorf("RegexTest() matches = %q, want %q", resp.Matches, tt.matches)
+			}
+		})
+	}
+
+	// The timeout covers every match and the replacement together, and
+	// the error leaves the text out.
+	text := strings.Repeat(strings.Repeat("a", 16)+"c", 40)
+	start := time.Now()
+	resp, err = s.RegexTest(ctx, &pb.RegexRequest{Pattern: `(a+)+b|c`, Text: text, Engine: pb.RegexEngine_REGEX_BACKTRACKING, TimeoutMs: 100, Replacement: proto.String("x")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(resp.Error, "timeout") || strings.Contains(resp.Error, text) {
+		t.Errorf("RegexTest() error = %q", resp.Error)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("RegexTest() took %v", d)
+	}
+}
+
 func TestCaseConvert(t *testing.T) {
 	s := NewServer()
 	ctx := context.Background()
@@ -169,3 +305,86 @@ func TestCaseConvert(t *testing.T) {
 		t.Errorf("CaseConvert() Kebab = %v, want hello-world", resp.Kebab)
 	}
 }
+
+func TestRegexExplain(t *testing.T) {
+	s := NewServer()
+	ctx := context.Background()
+
+	resp, err := s.RegexExplain(ctx, &pb.RegexExplainRequest{Pattern: `(?P<year>\d+)-(a+)+`})
+	if err != nil {
+		t.Fatalf("RegexExplain() error = %v", err)
+	}
+	if resp.Error != "" || resp.GroupCount != 2 || resp.Tree.Kind != "sequence" || len(resp.Tree.Children) != 3 {
+		t.Fatalf("RegexExplain() = %v", resp)
+	}
+	if g := resp.Tree.Children[0]; g.Start != 0 || g.End != 13 || g.Description != "one or more digits, captured as group 'year'" {
+		t.Errorf("RegexExplain() group = %v", g)
+	}
+	if !strings.HasPrefix(resp.Summary, "Matches one or more digits") {
+		t.Errorf("RegexExplain() summary = %q", resp.Summary)
+	}
+	if len(resp.Warnings) != 1 || resp.Warnings[0].Code != "catastrophic-backtracking" || resp.Warnings[0].Start != 14 {
+		t.Errorf("RegexExplain() warnings = %v", resp.Warnings)
+	}
+
+	resp, err = s.RegexExplain(ctx, &pb.RegexExplainRequest{Pattern: `a(?=b`, Engine: pb.RegexEngine_REGEX_BACKTRACKING})
+	if err != nil {
+		t.Fatalf("RegexExplain() error = %v", err)
+	}
+	if !strings.HasPrefix(resp.Error, "Invalid Pattern") {
+		t.Errorf("RegexExplain() error = %q", resp.Error)
+	}
+}
+
+func TestRegexGenerate(t *testing.T) {
+	s := NewServer()
+	ctx := context.Background()
+
+	req := &pb.RegexGenerateRequest{Pattern: `^[a-f]{2}\d{1,3}$`, Count: 5, Seed: 42}
+	resp, err := s.RegexGenerate(ctx, req)
+	if err != nil {
+		t.Fatalf("RegexGenerate() error = %v", err)
+	}
+	if resp.Error != "" || resp.Seed != 42 || len(resp.Matches) != 5 || len(resp.NearMisses) != 5 {
+		t.Fatalf("RegexGenerate() = %v", resp)
+	}
+	re := regexp.MustCompile(req.Pattern)
+	for _, m := range resp.Matches {
+		if !re.MatchString(m) {
+			t.Errorf("RegexGenerate() match %q does not match", m)
+		}
+	}
+	for _, nm := range resp.NearMisses {
+		if re.MatchString(nm.Text) || nm.Change == "" {
+			t.Errorf("RegexGenerate() near miss = %v", nm)
+		}
+	}
+	again, _ := s.RegexGenerate(ctx, req)
+	if !reflect.DeepEqual(again.Matches, resp.Matches) {
+		t.Errorf("RegexGenerate() with the same seed = %v, want %v", again.Matches, resp.Matches)
+	}
+
+	resp, _ = s.RegexGenerate(ctx, &pb.RegexGenerateRequest{Pattern: `[a-z]+`})
+	if resp.Seed == 0 || len(resp.Matches) != regexSamples {
+		t.Errorf("RegexGenerate() defaults = %v", resp)
+	}
+
+	tests := []struct {
+		req  *pb.RegexGenerateRequest
+		want string
+	}{
+		{&pb.RegexGenerateRequest{Pattern: `(`}, "Invalid Pattern"},
+		{&pb.RegexGenerateRequest{Pattern: `a\bb`}, "no string matching"},
+		{&pb.RegexGenerateRequest{Pattern: `a`, Count: maxRegexSamples + 1}, "count"},
+		{&pb.RegexGenerateRequest{Pattern: `x{600}y{600}`}, "shortest match is too long"},
+	}
+	for _, tt := range tests {
+		resp, err := s.RegexGenerate(ctx, tt.req)
+		if err != nil {
+			t.Fatalf("RegexGenerate() error = %v", err)
+		}
+		if !strings.Contains(resp.Error, tt.want) {
+			t.Errorf("RegexGenerate(%q) error = %q, want %q", tt.req.Pattern, resp.Error, tt.want)
+		}
+	}
+}
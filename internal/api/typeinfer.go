@@ -0,0 +1,298 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ── Type inference from JSON samples ─────────────────────────────────────────
+//
+// The code generators (JsonToGo and friends) share one inference pass so every
+// target agrees on nullability, optional fields and nested type names.
+
+// orderedObject is a decoded JSON object that remembers its key order.
+type orderedObject struct {
+	keys   []string
+	values map[string]any
+}
+
+// decodeOrderedJSON decodes a JSON document, returning objects as
+// *orderedObject and numbers as json.Number.
+func decodeOrderedJSON(data string) (any, error) {
+	dec := json.NewDecoder(strings.NewReader(data))
+	dec.UseNumber()
+	v, err := decodeOrderedValue(dec)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("unexpected data after top-level value at offset %d", dec.InputOffset())
+	}
+	return v, nil
+}
+
+func decodeOrderedValue(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch t := tok.(type) {
+	case json.Delim:
+		switch t {
+		case '{':
+			obj := &orderedObject{values: map[string]any{}}
+			for dec.More() {
+				kt, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				key, _ := kt.(string)
+				v, err := decodeOrderedValue(dec)
+				if err != nil {
+					return nil, err
+				}
+				if _, dup := obj.values[key]; !dup {
+					obj.keys = append(obj.keys, key)
+				}
+				obj.values[key] = v
+			}
+			_, err := dec.Token()
+			return obj, err
+		case '[':
+			arr := []any{}
+			for dec.More() {
+				v, err := decodeOrderedValue(dec)
+				if err != nil {
+					return nil, err
+				}
+				arr = append(arr, v)
+			}
+			_, err := dec.Token()
+			return arr, err
+		}
+		return nil, fmt.Errorf("unexpected delimiter %q", t)
+	default:
+		return tok, nil
+	}
+}
+
+// typeKind is the inferred kind of a value location.
+type typeKind int
+
+const (
+	kindAny typeKind = iota // mixed or unknown
+	kindString
+	kindInt
+	kindFloat
+	kindBool
+	kindTime
+	kindObject
+	kindArray
+)
+
+// inferredType describes every value observed at one location.
+type inferredType struct {
+	Kind     typeKind
+	Nullable bool             // null was observed
+	Name     string           // type name, for objects
+	Fields   []*inferredField // object fields, in first-seen order
+	Elem     *inferredType    // array element type
+}
+
+// inferredField is one object member.
+type inferredField struct {
+	Key      string // original JSON key
+	Type     *inferredType
+	Optional bool // missing from some of the objects observed
+}
+
+// typeModel is the result of inference: the root type plus every named
+// object type in declaration order (root first, then depth-first).
+type typeModel struct {
+	Name    string // requested root type name
+	Root    *inferredType
+	Objects []*inferredType
+}
+
+// shapeNode accumulates observations for one location before it is resolved
+// into an inferredType.
+type shapeNode struct {
+	seen    int
+	nulls   int
+	strs    int
+	times   int // strings that parse as RFC 3339 timestamps
+	ints    int
+	floats  int
+	bools   int
+	objects int
+	arrays  int
+	keys    []string
+	props   map[string]*shapeNode
+	elem    *shapeNode
+}
+
+func (n *shapeNode) observe(v any) {
+	n.seen++
+	switch val := v.(type) {
+	case nil:
+		n.nulls++
+	case bool:
+		n.bools++
+	case json.Number:
+		if _, err := strconv.ParseInt(string(val), 10, 64); err == nil {
+			n.ints++
+		} else {
+			n.floats++
+		}
+	case string:
+		n.strs++
+		if _, err := time.Parse(time.RFC3339Nano, val); err == nil {
+			n.times++
+		}
+	case []any:
+		n.arrays++
+		if n.elem == nil {
+			n.elem = &shapeNode{}
+		}
+		for _, item := range val {
+			n.elem.observe(item)
+		}
+	case *orderedObject:
+		n.objects++
+		if n.props == nil {
+			n.props = map[string]*shapeNode{}
+		}
+		for _, k := range val.keys {
+			child, ok := n.props[k]
+			if !ok {
+				child = &shapeNode{}
+				n.props[k] = child
+				n.keys = append(n.keys, k)
+			}
+			child.observe(val.values[k])
+		}
+	}
+}
+
+// typeNamer hands out unique type names.
+type typeNamer struct {
+	used map[string]bool
+}
+
+func (tn *typeNamer) claim(name, parent string) string {
+	if name == "" {
+		name = "Item"
+	}
+	if !tn.used[name] {
+		tn.used[name] = true
+		return name
+	}
+	if parent != "" && !strings.HasPrefix(name, parent) {
+		return tn.claim(parent+name, "")
+	}
+	for i := 2; ; i++ {
+		candidate := name + strconv.Itoa(i)
+		if !tn.used[candidate] {
+			tn.used[candidate] = true
+			return candidate
+		}
+	}
+}
+
+// inferTypes resolves a decoded document into a typeModel whose root type is
+// called rootName.
+func inferTypes(data any, rootName string) *typeModel {
+	root := &shapeNode{}
+	root.observe(data)
+	m := &typeModel{Name: rootName}
+	namer := &typeNamer{used: map[string]bool{}}
+	if root.objects == 0 || root.arrays > 0 {
+		namer.used[rootName] = true
+	}
+	m.Root = m.resolve(root, rootName, "", namer)
+	return m
+}
+
+func (m *typeModel) resolve(n *shapeNode, name, parent string, namer *typeNamer) *inferredType {
+	t := &inferredType{Nullable: n.nulls > 0}
+	kinds := 0
+	for _, c := range []int{n.strs, n.ints + n.floats, n.bools, n.objects, n.arrays} {
+		if c > 0 {
+			kinds++
+		}
+	}
+	if kinds != 1 {
+		t.Kind = kindAny
+		return t
+	}
+
+	switch {
+	case n.strs > 0:
+		t.Kind = kindString
+		if n.times == n.strs {
+			t.Kind = kindTime
+		}
+	case n.floats > 0:
+		t.Kind = kindFloat
+	case n.ints > 0:
+		t.Kind = kindInt
+	case n.bools > 0:
+		t.Kind = kindBool
+	case n.arrays > 0:
+		t.Kind = kindArray
+		elem := n.elem
+		if elem == nil {
+			elem = &shapeNode{}
+		}
+		t.Elem = m.resolve(elem, singularize(name), parent, namer)
+	case n.objects > 0:
+		t.Kind = kindObject
+		t.Name = namer.claim(name, parent)
+		m.Objects = append(m.Objects, t)
+		for _, k := range n.keys {
+			child := n.props[k]
+			t.Fields = append(t.Fields, &inferredField{
+				Key:      k,
+				Type:     m.resolve(child, toPascalCase(k), t.Name, namer),
+				Optional: child.seen < n.objects,
+			})
+		}
+	}
+	return t
+}
+
+// splitIdentWords splits s on anything that isn't a letter or digit.
+func splitIdentWords(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// singularize derives an element type name from a plural collection name.
+func singularize(name string) string {
+	switch {
+	case strings.HasSuffix(name, "ies") && len(name) > 4:
+		return name[:len(name)-3] + "y"
+	case strings.HasSuffix(name, "sses"), strings.HasSuffix(name, "xes"):
+		return name[:len(name)-2]
+	case strings.HasSuffix(name, "s") && !strings.HasSuffix(name, "ss") && len(name) > 3:
+		return name[:len(name)-1]
+	}
+	return name + "Item"
+}
+
+// uniqueIdent returns name, suffixed with a number if it is already in used.
+func uniqueIdent(name string, used map[string]bool) string {
+	candidate := name
+	for i := 2; used[candidate]; i++ {
+		candidate = name + strconv.Itoa(i)
+	}
+	used[candidate] = true
+	return candidate
+}
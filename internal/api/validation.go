@@ -0,0 +1,221 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	toml "github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+// validationFailure reports a single error; line and col may be 0 when the
+// parser does not know them.
+func validationFailure(msg string, line, col int) *pb.ValidateResponse {
+	issue := &pb.ValidationIssue{Message: msg, Line: int32(line), Column: int32(col)} // #nosec G115
+	return &pb.ValidateResponse{
+		Valid:  false,
+		Error:  msg,
+		Line:   issue.Line,
+		Column: issue.Column,
+		Errors: []*pb.ValidationIssue{issue},
+	}
+}
+
+// issueResponse builds the response from collected errors and warnings,
+// mirroring the first error into the top-level fields.
+func issueResponse(errs, warnings []*pb.ValidationIssue, documents int) *pb.ValidateResponse {
+	resp := &pb.ValidateResponse{
+		Valid:     len(errs) == 0,
+		Errors:    errs,
+		Warnings:  warnings,
+		Documents: int32(documents), // #nosec G115
+	}
+	if len(errs) > 0 {
+		resp.Error = errs[0].Message
+		if documents > 1 {
+			resp.Error = fmt.Sprintf("document %d: %s", errs[0].Document, errs[0].Message)
+		}
+		resp.Line, resp.Column = errs[0].Line, errs[0].Column
+	}
+	return resp
+}
+
+// ── YAML ──────────────────────────────────────────────────────────────────────
+
+var (
+	yamlErrorLineRe = regexp.MustCompile(`^yaml: (?:line (\d+): )?(.*)$`)
+	// yamlParserErrorRe matches the parser's errors, as opposed to the
+	// scanner's: yaml.v3 numbers their lines from 0, and leaves the
+	// number out for the first line of either.
+	yamlParserErrorRe = regexp.MustCompile(`^(did not find expected (key|node content|'-' indicator|',' or '[\]}]'|<document start>)|found (undefined tag handle|incompatible YAML document|duplicate %(YAML|TAG) directive))$`)
+)
+
+// maxYAMLDepth is the nesting yaml.v3 itself accepts, so the walk reaches
+// every node it is given.
+const maxYAMLDepth = 10000
+
+// yaml11Booleans are plain scalars that YAML 1.1 parsers (PyYAML, Psych,
+// older Go and Java libraries) read as booleans while YAML 1.2 reads them
+// as strings: the "Norway problem".
+var yaml11Booleans = map[string]bool{
+	"y": true, "Y": true, "yes": true, "Yes": true, "YES": true, "on": true, "On": true, "ON": true,
+	"n": false, "N": false, "no": false, "No": false, "NO": false, "off": false, "Off": false, "OFF": false,
+}
+
+// yamlValidator walks each document of a stream, collecting issues.
+type yamlValidator struct {
+	errs, warnings []*pb.ValidationIssue
+	blockLines     map[int]bool // lines inside literal or folded scalars
+	doc            int
+}
+
+func (v *yamlValidator) add(list *[]*pb.ValidationIssue, line, col int, format string, args ...any) {
+	*list = append(*list, &pb.ValidationIssue{
+		Message:  fmt.Sprintf(format, args...),
+		Line:     int32(line),  // #nosec G115
+		Column:   int32(col),   // #nosec G115
+		Document: int32(v.doc), // #nosec G115
+	})
+}
+
+// validateYAML checks every document in a YAML stream for syntax errors and
+// duplicate keys, and warns about tab indentation and YAML 1.1 booleans.
+func validateYAML(data string) *pb.ValidateResponse {
+	v := &yamlValidator{blockLines: map[int]bool{}}
+	dec := yaml.NewDecoder(strings.NewReader(data))
+	for {
+		var node yaml.Node
+		err := dec.Decode(&node)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		v.doc++
+		if err != nil {
+			msg, line, col := err.Error(), 0, 0
+			if m := yamlErrorLineRe.FindStringSubmatch(msg); m != nil {
+				msg = m[2]
+				line, col = yamlErrorPosition(data, m[1], msg)
+			}
+			v.add(&v.errs, line, col, "%s", msg)
+			break
+		}
+		v.walk(&node, 0)
+	}
+	v.checkTabs(data)
+	return issueResponse(v.errs, v.warnings, v.doc)
+}
+
+func (v *yamlValidator) walk(n *yaml.Node, depth int) {
+	if depth > maxYAMLDepth {
+		return
+	}
+	switch n.Kind {
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for _, c := range n.Content {
+			v.walk(c, depth+1)
+		}
+	case yaml.MappingNode:
+		seen := map[string]*yaml.Node{}
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			k := n.Content[i]
+			if k.Kind == yaml.ScalarNode && k.Tag != "!!merge" {
+				id := k.ShortTag() + "\x00" + k.Value
+				if first, dup := seen[id]; dup {
+					v.add(&v.errs, k.Line, k.Column, "duplicate key %q (first defined at line %d, column %d)", k.Value, first.Line, first.Column)
+				} else {
+					seen[id] = k
+				}
+			}
+			v.walk(k, depth+1)
+			v.walk(n.Content[i+1], depth+1)
+		}
+	case yaml.ScalarNode:
+		if n.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0 {
+			for l := n.Line + 1; l <= n.Line+strings.Count(n.Value, "\n")+1; l++ {
+				v.blockLines[l] = true
+			}
+			return
+		}
+		if b, ok := yaml11Booleans[n.Value]; ok && n.Style == 0 && n.ShortTag() == "!!str" {
+			v.add(&v.warnings, n.Line, n.Column, "unquoted %q is the boolean %t in YAML 1.1 parsers; quote it to keep it a string", n.Value, b)
+		}
+	}
+}
+
+// yamlErrorPosition locates a syntax error from the line yaml.v3 gives,
+// which carries no column: it points at the character the message names,
+// or else at the start of the line. Errors found after parsing, such as an
+// unknown anchor or excessive nesting, have no position.
+func yamlErrorPosition(data, lineNum, msg string) (line, col int) {
+	line, _ = strconv.Atoi(lineNum)
+	switch {
+	case yamlParserErrorRe.MatchString(msg):
+		line++
+	case lineNum == "" && (strings.Contains(msg, "anchor") || strings.Contains(msg, "alias") || strings.Contains(msg, "depth")):
+		return 0, 0
+	case line == 0:
+		line = 1
+	}
+	lines := strings.Split(data, "\n")
+	if line > len(lines) {
+		return line, 0
+	}
+	text := strings.TrimSuffix(lines[line-1], "\r")
+	i := -1
+	switch {
+	case strings.HasPrefix(msg, "found character that cannot start any token"):
+		i = strings.IndexAny(text, "@`")
+	case strings.HasPrefix(msg, "found a tab character"):
+		i = strings.IndexByte(text, '\t')
+	case strings.HasPrefix(msg, "mapping values are not allowed"):
+		// The last colon, as the second in "a: b: c".
+		if i = strings.LastIndex(text, ": "); strings.HasSuffix(text, ":") {
+			i = len(text) - 1
+		}
+	}
+	if i < 0 {
+		i = len(text) - len(strings.TrimLeft(text, " \t"))
+	}
+	return line, utf8.RuneCountInString(text[:i]) + 1
+}
+
+// checkTabs warns about tab characters in indentation, which YAML forbids
+// and parsers handle inconsistently.
+func (v *yamlValidator) checkTabs(data string) {
+	for i, line := range strings.Split(data, "\n") {
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		tab := strings.IndexByte(indent, '\t')
+		if tab < 0 || strings.TrimSpace(line) == "" || v.blockLines[i+1] {
+			continue
+		}
+		v.warnings = append(v.warnings, &pb.ValidationIssue{
+			Message: "tab character used for indentation",
+			Line:    int32(i + 1),                                    // #nosec G115
+			Column:  int32(utf8.RuneCountInString(indent[:tab]) + 1), // #nosec G115
+		})
+	}
+}
+
+// ── TOML ──────────────────────────────────────────────────────────────────────
+
+// validateTOML reports TOML errors with their row and column.
+func validateTOML(data string) *pb.ValidateResponse {
+	var v any
+	err := toml.Unmarshal([]byte(data), &v)
+	if err == nil {
+		return &pb.ValidateResponse{Valid: true}
+	}
+	var de *toml.DecodeError
+	if errors.As(err, &de) {
+		line, col := de.Position()
+		return validationFailure(strings.TrimPrefix(de.Error(), "toml: "), line, col)
+	}
+	return validationFailure(err.Error(), 0, 0)
+}
@@ -0,0 +1,75 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+func TestValidateData_YAMLMultiDocument(t *testing.T) {
+	data := "a: 1\nb:\n  x: 1\n  x: 2\n---\ncountry: NO\nquoted: \"no\"\n---\nk: : v\n"
+	resp, _ := NewServer().ValidateData(context.Background(), &pb.ValidateRequest{Data: data, Format: pb.DataFormat_YAML})
+	if resp.Valid || resp.Documents != 3 {
+		t.Fatalf("valid = %v, documents = %d", resp.Valid, resp.Documents)
+	}
+	if len(resp.Errors) != 2 {
+		t.Fatalf("errors = %v", resp.Errors)
+	}
+	dup := resp.Errors[0]
+	if dup.Line != 4 || dup.Column != 3 || dup.Document != 1 || !strings.Contains(dup.Message, `duplicate key "x" (first defined at line 3, column 3)`) {
+		t.Errorf("duplicate key issue = %v", dup)
+	}
+	if resp.Error != "document 1: "+dup.Message || resp.Line != 4 || resp.Column != 3 {
+		t.Errorf("top-level error = %q at %d:%d", resp.Error, resp.Line, resp.Column)
+	}
+	if syntax := resp.Errors[1]; syntax.Line != 9 || syntax.Document != 3 {
+		t.Errorf("syntax issue = %v", syntax)
+	}
+	if len(resp.Warnings) != 1 || resp.Warnings[0].Line != 6 || resp.Warnings[0].Document != 2 ||
+		!strings.Contains(resp.Warnings[0].Message, `"NO" is the boolean false`) {
+		t.Errorf("warnings = %v", resp.Warnings)
+	}
+}
+
+func TestValidateData_YAMLWarningsOnly(t *testing.T) {
+	data := "s: |\n  keep\ttab\nflow: [\n\t1]\nenabled: on\n"
+	resp, _ := NewServer().ValidateData(context.Background(), &pb.ValidateRequest{Data: data, Format: pb.DataFormat_YAML})
+	if !resp.Valid || resp.Error != "" {
+		t.Fatalf("expected valid, got %q", resp.Error)
+	}
+	var got []string
+	for _, w := range resp.Warnings {
+		got = append(got, w.Message)
+	}
+	if len(got) != 2 || got[0] != `unquoted "on" is the boolean true in YAML 1.1 parsers; quote it to keep it a string` ||
+		got[1] != "tab character used for indentation" || resp.Warnings[1].Line != 4 {
+		t.Errorf("warnings = %v", resp.Warnings)
+	}
+}
+
+func TestValidateData_Positions(t *testing.T) {
+	tests := []struct {
+		format    pb.DataFormat
+		data      string
+		line, col int32
+		want      string
+	}{
+		{pb.DataFormat_YAML, "a: 1\nb: c: d\n", 2, 5, "mapping values are not allowed"},
+		{pb.DataFormat_YAML, "a: b: c\n", 1, 5, "mapping values are not allowed"},
+		{pb.DataFormat_YAML, "x: 1\ny: @z\n", 2, 4, "cannot start any token"},
+		{pb.DataFormat_YAML, "a:\n  b: 1\n c: 2\n", 3, 2, "did not find expected key"},
+		{pb.DataFormat_YAML, "- a\nb: c\n", 2, 1, "did not find expected '-' indicator"},
+		{pb.DataFormat_YAML, "a: *x\n", 0, 0, "unknown anchor"},
+		{pb.DataFormat_TOML, "a = 1\nb = [1,\nc = 3\n", 3, 1, "unexpected character"},
+		{pb.DataFormat_TOML, "a = 1\na = 2\n", 2, 1, "already defined"},
+		{pb.DataFormat_JSON, "{\n  \"a\": x\n}", 2, 9, "invalid character"},
+	}
+	for _, tt := range tests {
+		resp, _ := NewServer().ValidateData(context.Background(), &pb.ValidateRequest{Data: tt.data, Format: tt.format})
+		if resp.Valid || resp.Line != tt.line || resp.Column != tt.col || !strings.Contains(resp.Error, tt.want) || len(resp.Errors) != 1 {
+			t.Errorf("%v %q: got %q at %d:%d (errors %d)", tt.format, tt.data, resp.Error, resp.Line, resp.Column, len(resp.Errors))
+		}
+	}
+}
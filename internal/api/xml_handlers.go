@@ -0,0 +1,159 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/odinnordico/privutil/internal/xmldoc"
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+// xpathMaxNodes caps the nodes returned for one expression; count still
+// reports the full size of the node-set.
+const xpathMaxNodes = 1000
+
+// XmlFormat pretty-prints or minifies XML. Attribute order, comments, CDATA
+// sections and processing instructions are kept, and whitespace is only
+// touched between elements. The response also lists the document's
+// namespace declarations with how often each is used.
+func (s *Server) XmlFormat(_ context.Context, req *pb.XmlFormatRequest) (*pb.XmlFormatResponse, error) {
+	doc, err := xmldoc.Parse(req.Text)
+	if err != nil {
+		resp := &pb.XmlFormatResponse{Error: fmt.Sprintf("Invalid XML: %v", err)}
+		var xe *xmldoc.Error
+		if errors.As(err, &xe) {
+			resp.ErrorLine, resp.ErrorColumn = int32(xe.Line), int32(xe.Column) // #nosec G115
+		}
+		return resp, nil
+	}
+	opts := xmldoc.FormatOptions{StripComments: req.StripComments}
+	switch req.Indent {
+	case "min":
+	case "4":
+		opts.Indent = "    "
+	case "tab":
+		opts.Indent = "\t"
+	default:
+		opts.Indent = "  "
+	}
+	resp := &pb.XmlFormatResponse{Text: xmldoc.Format(doc, opts)}
+	for _, d := range xmldoc.Namespaces(doc) {
+		resp.Namespaces = append(resp.Namespaces, &pb.XmlNamespace{
+			Prefix: d.Prefix, Uri: d.URI, Element: d.Element,
+			Line: int32(d.Line), Uses: int32(d.Uses), // #nosec G115
+		})
+	}
+	return resp, nil
+}
+
+// XmlXPath evaluates an XPath 1.0 expression. Node-set results list each
+// node with its path, string value and XML; other results come back as
+// their string form.
+func (s *Server) XmlXPath(_ context.Context, req *pb.XPathRequest) (*pb.XPathResponse, error) {
+	if strings.TrimSpace(req.Expression) == "" {
+		return &pb.XPathResponse{Error: "Expression is required"}, nil
+	}
+	doc, err := xmldoc.Parse(req.Xml)
+	if err != nil {
+		return &pb.XPathResponse{Error: fmt.Sprintf("Invalid XML: %v", err)}, nil
+	}
+	bindings := map[string]string{}
+	for _, b := range req.Namespaces {
+		prefix, uri, ok := strings.Cut(b, "=")
+		prefix = strings.TrimPrefix(strings.TrimSpace(prefix), "xmlns:")
+		if !ok || prefix == "" {
+			return &pb.XPathResponse{Error: fmt.Sprintf("Invalid namespace binding %q; use prefix=uri", b)}, nil
+		}
+		bindings[prefix] = strings.Trim(strings.TrimSpace(uri), `"'`)
+	}
+
+	v, err := xmldoc.Evaluate(doc, req.Expression, bindings)
+	if err != nil {
+		resp := &pb.XPathResponse{Error: err.Error()}
+		var xe *xmldoc.ExprError
+		if errors.As(err, &xe) {
+			resp.ErrorPosition = int32(xe.Pos + 1) // #nosec G115
+		}
+		return resp, nil
+	}
+	switch val := v.(type) {
+	case []*xmldoc.Node:
+		resp := &pb.XPathResponse{ResultType: "node-set", Count: int32(len(val))} // #nosec G115
+		if len(val) > 0 {
+			resp.Value = val[0].Text()
+		}
+		for _, n := range val[:min(len(val), xpathMaxNodes)] {
+			resp.Nodes = append(resp.Nodes, &pb.XPathNode{
+				Type:  xpathNodeType(n.Type),
+				Path:  xmldoc.Path(n),
+				Value: n.Text(),
+				Xml:   xmldoc.Serialize(n),
+				Line:  int32(n.Line), // #nosec G115
+			})
+		}
+		return resp, nil
+	case float64:
+		return &pb.XPathResponse{ResultType: "number", Value: xmldoc.FormatNumber(val)}, nil
+	case bool:
+		return &pb.XPathResponse{ResultType: "boolean", Value: fmt.Sprint(val)}, nil
+	default:
+		return &pb.XPathResponse{ResultType: "string", Value: fmt.Sprint(val)}, nil
+	}
+}
+
+func xpathNodeType(t xmldoc.NodeType) string {
+	switch t {
+	case xmldoc.DocumentNode:
+		return "document"
+	case xmldoc.ElementNode:
+		return "element"
+	case xmldoc.AttributeNode:
+		return "attribute"
+	case xmldoc.CommentNode:
+		return "comment"
+	case xmldoc.ProcInstNode:
+		return "processing-instruction"
+	}
+	return "text"
+}
+
+// XmlValidate checks that XML is well-formed and, given an XSD, that its
+// structure matches the schema. A SOAP envelope validates its payload: the
+// outermost elements the schema declares are checked.
+func (s *Server) XmlValidate(_ context.Context, req *pb.XmlValidateRequest) (*pb.XmlValidateResponse, error) {
+	doc, err := xmldoc.Parse(req.Xml)
+	if err != nil {
+		issue := &pb.XmlIssue{Message: err.Error()}
+		var xe *xmldoc.Error
+		if errors.As(err, &xe) {
+			issue.Line, issue.Column, issue.Message = int32(xe.Line), int32(xe.Column), xe.Msg // #nosec G115
+		}
+		return &pb.XmlValidateResponse{Issues: []*pb.XmlIssue{issue}}, nil
+	}
+	if strings.TrimSpace(req.Xsd) == "" {
+		return &pb.XmlValidateResponse{Valid: true}, nil
+	}
+	sdoc, err := xmldoc.Parse(req.Xsd)
+	if err != nil {
+		return &pb.XmlValidateResponse{Error: fmt.Sprintf("Invalid XSD: %v", err)}, nil
+	}
+	schema, err := xmldoc.ParseSchema(sdoc)
+	if err != nil {
+		return &pb.XmlValidateResponse{Error: fmt.Sprintf("Unsupported XSD: %v", err)}, nil
+	}
+	report := schema.Validate(doc)
+	resp := &pb.XmlValidateResponse{
+		Valid:    len(report.Issues) == 0,
+		Roots:    report.Roots,
+		Warnings: schema.Warnings,
+	}
+	for _, is := range report.Issues {
+		resp.Issues = append(resp.Issues, &pb.XmlIssue{
+			Line: int32(is.Line), Column: int32(is.Column), // #nosec G115
+			Path: is.Path, Message: is.Msg,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,119 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/odinnordico/privutil/proto"
+)
+
+func TestXmlFormat(t *testing.T) {
+	s := NewServer()
+	src := `<?xml version="1.0"?><r b="2" a="1" xmlns:x="urn:x"><!-- note --><x:c><![CDATA[1 < 2]]></x:c><d/></r>`
+	tests := []struct {
+		name string
+		req  *pb.XmlFormatRequest
+		want string
+	}{
+		{"default indent", &pb.XmlFormatRequest{Text: src},
+			"<?xml version=\"1.0\"?>\n<r b=\"2\" a=\"1\" xmlns:x=\"urn:x\">\n  <!-- note -->\n  <x:c><![CDATA[1 < 2]]></x:c>\n  <d/>\n</r>\n"},
+		{"minify without comments", &pb.XmlFormatRequest{Text: "<r>\n  <!-- c -->\n  <a> x </a>\n</r>", Indent: "min", StripComments: true},
+			"<r><a> x </a></r>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := s.XmlFormat(context.Background(), tt.req)
+			if err != nil || resp.Error != "" {
+				t.Fatalf("err=%v resp=%v", err, resp.Error)
+			}
+			if resp.Text != tt.want {
+				t.Errorf("got %q\nwant %q", resp.Text, tt.want)
+			}
+		})
+	}
+
+	resp, _ := s.XmlFormat(context.Background(), &pb.XmlFormatRequest{Text: src})
+	if len(resp.Namespaces) != 1 || resp.Namespaces[0].Prefix != "x" || resp.Namespaces[0].Uses != 1 {
+		t.Errorf("namespaces: %v", resp.Namespaces)
+	}
+
+	resp, _ = s.XmlFormat(context.Background(), &pb.XmlFormatRequest{Text: "<a>\n<b></a>"})
+	if resp.ErrorLine != 2 || resp.ErrorColumn != 4 || !strings.Contains(resp.Error, "does not match") {
+		t.Errorf("error: %q at %d:%d", resp.Error, resp.ErrorLine, resp.ErrorColumn)
+	}
+}
+
+func TestXmlXPath(t *testing.T) {
+	s := NewServer()
+	xml := `<feed xmlns="urn:feed"><entry id="1"><t>A</t></entry><entry id="2"><t>B</t></entry></feed>`
+
+	resp, err := s.XmlXPath(context.Background(), &pb.XPathRequest{Xml: xml, Expression: "//f:entry[@id=2]", Namespaces: []string{"f=urn:feed"}})
+	if err != nil || resp.Error != "" {
+		t.Fatalf("err=%v resp=%v", err, resp.Error)
+	}
+	if resp.ResultType != "node-set" || resp.Count != 1 || len(resp.Nodes) != 1 {
+		t.Fatalf("got %+v", resp)
+	}
+	if n := resp.Nodes[0]; n.Type != "element" || n.Path != "/feed/entry[2]" || n.Value != "B" || n.Xml != `<entry id="2"><t>B</t></entry>` {
+		t.Errorf("node: %+v", n)
+	}
+
+	resp, _ = s.XmlXPath(context.Background(), &pb.XPathRequest{Xml: xml, Expression: "count(//*[local-name()='t'])"})
+	if resp.ResultType != "number" || resp.Value != "2" {
+		t.Errorf("count: %+v", resp)
+	}
+
+	resp, _ = s.XmlXPath(context.Background(), &pb.XPathRequest{Xml: xml, Expression: "//entry["})
+	if resp.Error == "" || resp.ErrorPosition != 9 {
+		t.Errorf("syntax error: %q at %d", resp.Error, resp.ErrorPosition)
+	}
+
+	resp, _ = s.XmlXPath(context.Background(), &pb.XPathRequest{Xml: xml, Expression: "/", Namespaces: []string{"bad"}})
+	if !strings.Contains(resp.Error, "prefix=uri") {
+		t.Errorf("binding error: %q", resp.Error)
+	}
+}
+
+func TestXmlValidate(t *testing.T) {
+	s := NewServer()
+	xsd := `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" targetNamespace="urn:q" elementFormDefault="qualified">
+  <xs:import namespace="urn:other"/>
+  <xs:element name="GetQuote">
+    <xs:complexType>
+      <xs:sequence><xs:element name="Symbol" type="xs:string" maxOccurs="3"/></xs:sequence>
+    </xs:complexType>
+  </xs:element>
+</xs:schema>`
+	envelope := func(body string) string {
+		return `<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/"><s:Body>` + body + `</s:Body></s:Envelope>`
+	}
+
+	resp, err := s.XmlValidate(context.Background(), &pb.XmlValidateRequest{Xml: envelope(`<GetQuote xmlns="urn:q"><Symbol>ACME</Symbol></GetQuote>`), Xsd: xsd})
+	if err != nil || resp.Error != "" || !resp.Valid {
+		t.Fatalf("err=%v resp=%+v", err, resp)
+	}
+	if len(resp.Roots) != 1 || resp.Roots[0] != "/s:Envelope/s:Body/GetQuote" || len(resp.Warnings) != 1 {
+		t.Errorf("roots %v warnings %v", resp.Roots, resp.Warnings)
+	}
+
+	resp, _ = s.XmlValidate(context.Background(), &pb.XmlValidateRequest{Xml: envelope(`<GetQuote xmlns="urn:q"/>`), Xsd: xsd})
+	if resp.Valid || len(resp.Issues) != 1 || !strings.Contains(resp.Issues[0].Message, "incomplete") || resp.Issues[0].Line != 1 {
+		t.Errorf("invalid payload: %+v", resp)
+	}
+
+	resp, _ = s.XmlValidate(context.Background(), &pb.XmlValidateRequest{Xml: "<a>\n</b>"})
+	if resp.Valid || len(resp.Issues) != 1 || resp.Issues[0].Line != 2 {
+		t.Errorf("well-formedness: %+v", resp)
+	}
+
+	resp, _ = s.XmlValidate(context.Background(), &pb.XmlValidateRequest{Xml: "<a/>"})
+	if !resp.Valid {
+		t.Errorf("well-formed document: %+v", resp)
+	}
+
+	resp, _ = s.XmlValidate(context.Background(), &pb.XmlValidateRequest{Xml: "<a/>", Xsd: "<a/>"})
+	if !strings.Contains(resp.Error, "xs:schema") {
+		t.Errorf("bad schema: %q", resp.Error)
+	}
+}
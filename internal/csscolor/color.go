@@ -0,0 +1,372 @@
+// Package csscolor parses and serializes colors the way CSS Color Level 4
+// defines them — hex, named colors, rgb(), hsl(), hwb(), lab(), lch(),
+// oklab() and oklch() — plus the hsv() and cmyk() notations design tools
+// use. It also computes WCAG 2.x contrast, builds palettes and simulates
+// color-vision deficiencies.
+//
+// Colors are held as gamma-encoded sRGB components that may fall outside
+// [0, 1] when a Lab or LCH color has no sRGB equivalent; ToGamut maps such
+// a color into range by reducing its OKLCH chroma, as CSS does.
+package csscolor
+
+import "math"
+
+// Color is an sRGB color with straight (not premultiplied) alpha.
+type Color struct {
+	R, G, B float64
+	A       float64
+}
+
+// RGB returns an opaque color from 8-bit channels.
+func RGB(r, g, b uint8) Color {
+	return Color{float64(r) / 255, float64(g) / 255, float64(b) / 255, 1}
+}
+
+// InGamut reports whether every channel is within sRGB range, allowing for
+// the rounding in colors written with two decimals.
+func (c Color) InGamut() bool {
+	const eps = 0.0005
+	for _, v := range [3]float64{c.R, c.G, c.B} {
+		if v < -eps || v > 1+eps {
+			return false
+		}
+	}
+	return true
+}
+
+func (c Color) clip() Color {
+	return Color{clamp01(c.R), clamp01(c.G), clamp01(c.B), c.A}
+}
+
+// ToGamut maps c into sRGB with the CSS Color 4 algorithm: chroma is
+// reduced in OKLCH, keeping lightness and hue, until clipping the result
+// changes it by less than a just-noticeable difference.
+func (c Color) ToGamut() Color {
+	if c.InGamut() {
+		return c.clip()
+	}
+	l, ch, h := c.OKLCH()
+	if l >= 1 {
+		return Color{1, 1, 1, c.A}
+	}
+	if l <= 0 {
+		return Color{0, 0, 0, c.A}
+	}
+	const jnd, epsilon = 0.02, 0.0001
+	lo, hi := 0.0, ch
+	current := FromOKLCH(l, ch, h, c.A)
+	clipped := current.clip()
+	if deltaEOK(clipped, current) < jnd {
+		return clipped
+	}
+	for hi-lo > epsilon {
+		mid := (lo + hi) / 2
+		current = FromOKLCH(l, mid, h, c.A)
+		if current.InGamut() {
+			lo = mid
+			continue
+		}
+		clipped = current.clip()
+		e := deltaEOK(clipped, current)
+		if e < jnd {
+			if jnd-e < epsilon {
+				break
+			}
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return current.clip()
+}
+
+// Bytes returns the 8-bit channels of the gamut-mapped color.
+func (c Color) Bytes() (r, g, b, a uint8) {
+	m := c.ToGamut()
+	return to8(m.R), to8(m.G), to8(m.B), to8(m.A)
+}
+
+func to8(v float64) uint8 {
+	return uint8(math.Round(clamp01(v) * 255)) // #nosec G115
+}
+
+func clamp01(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
+
+// linear converts a gamma-encoded sRGB channel to linear light, extended
+// to negative values by symmetry.
+func linear(v float64) float64 {
+	a := math.Abs(v)
+	if a <= 0.04045 {
+		return v / 12.92
+	}
+	return math.Copysign(math.Pow((a+0.055)/1.055, 2.4), v)
+}
+
+func gamma(v float64) float64 {
+	a := math.Abs(v)
+	if a <= 0.0031308 {
+		return v * 12.92
+	}
+	return math.Copysign(1.055*math.Pow(a, 1/2.4)-0.055, v)
+}
+
+func (c Color) linearRGB() (r, g, b float64) {
+	return linear(c.R), linear(c.G), linear(c.B)
+}
+
+func fromLinear(r, g, b, alpha float64) Color {
+	return Color{gamma(r), gamma(g), gamma(b), alpha}
+}
+
+// HSL returns hue in degrees and saturation and lightness in [0, 1].
+func (c Color) HSL() (h, s, l float64) {
+	maxC, minC := max(c.R, c.G, c.B), min(c.R, c.G, c.B)
+	l = (maxC + minC) / 2
+	d := maxC - minC
+	if d == 0 {
+		return 0, 0, l
+	}
+	if l < 0.5 {
+		s = d / (maxC + minC)
+	} else {
+		s = d / (2 - maxC - minC)
+	}
+	return c.hue(maxC, d), s, l
+}
+
+func (c Color) hue(maxC, d float64) float64 {
+	var h float64
+	switch maxC {
+	case c.R:
+		h = (c.G - c.B) / d
+		if c.G < c.B {
+			h += 6
+		}
+	case c.G:
+		h = (c.B-c.R)/d + 2
+	default:
+		h = (c.R-c.G)/d + 4
+	}
+	return h * 60
+}
+
+// FromHSL builds a color from hue in degrees and saturation and lightness
+// in [0, 1].
+func FromHSL(h, s, l, alpha float64) Color {
+	f := func(n float64) float64 {
+		k := math.Mod(n+h/30, 12)
+		a := s * math.Min(l, 1-l)
+		return l - a*math.Max(-1, math.Min(k-3, math.Min(9-k, 1)))
+	}
+	return Color{f(0), f(8), f(4), alpha}
+}
+
+// HSV returns hue in degrees and saturation and value in [0, 1].
+func (c Color) HSV() (h, s, v float64) {
+	maxC, minC := max(c.R, c.G, c.B), min(c.R, c.G, c.B)
+	d := maxC - minC
+	if maxC > 0 {
+		s = d / maxC
+	}
+	if d == 0 {
+		return 0, s, maxC
+	}
+	return c.hue(maxC, d), s, maxC
+}
+
+// FromHSV builds a color from hue in degrees and saturation and value in
+// [0, 1].
+func FromHSV(h, s, v, alpha float64) Color {
+	f := func(n float64) float64 {
+		k := math.Mod(n+h/60, 6)
+		return v - v*s*math.Max(0, math.Min(k, math.Min(4-k, 1)))
+	}
+	return Color{f(5), f(3), f(1), alpha}
+}
+
+// HWB returns hue in degrees and whiteness and blackness in [0, 1].
+func (c Color) HWB() (h, w, b float64) {
+	h, _, _ = c.HSV()
+	return h, min(c.R, c.G, c.B), 1 - max(c.R, c.G, c.B)
+}
+
+// FromHWB builds a color from hue in degrees and whiteness and blackness
+// in [0, 1]; whiteness and blackness that add up past 1 make a gray.
+func FromHWB(h, w, b, alpha float64) Color {
+	if w+b >= 1 {
+		g := w / (w + b)
+		return Color{g, g, g, alpha}
+	}
+	c := FromHSL(h, 1, 0.5, alpha)
+	scale := 1 - w - b
+	return Color{c.R*scale + w, c.G*scale + w, c.B*scale + w, alpha}
+}
+
+// CMYK returns the naive device-independent conversion used by design
+// tools; it ignores ink profiles.
+func (c Color) CMYK() (cy, m, y, k float64) {
+	m8 := c.ToGamut()
+	k = 1 - max(m8.R, m8.G, m8.B)
+	if k >= 1 {
+		return 0, 0, 0, 1
+	}
+	return (1 - m8.R - k) / (1 - k), (1 - m8.G - k) / (1 - k), (1 - m8.B - k) / (1 - k), k
+}
+
+// FromCMYK inverts CMYK.
+func FromCMYK(c, m, y, k, alpha float64) Color {
+	return Color{(1 - c) * (1 - k), (1 - m) * (1 - k), (1 - y) * (1 - k), alpha}
+}
+
+// D65 linear sRGB to XYZ, and the Bradford adaptation to the D50 white
+// point CIE Lab uses in CSS.
+var (
+	srgbToXYZ = [3][3]float64{
+		{0.41239079926595934, 0.357584339383878, 0.1804807884018343},
+		{0.21263900587151027, 0.715168678767756, 0.07219231536073371},
+		{0.01933081871559182, 0.11919477979462598, 0.9505321522496607},
+	}
+	xyzToSRGB = [3][3]float64{
+		{3.2409699419045226, -1.537383177570094, -0.4986107602930034},
+		{-0.9692436362808796, 1.8759675015077202, 0.04155505740717559},
+		{0.05563007969699366, -0.20397695888897652, 1.0569715142428786},
+	}
+	d65ToD50 = [3][3]float64{
+		{1.0479298208405488, 0.022946793341019088, -0.05019222954313557},
+		{0.029627815688159344, 0.990434484573249, -0.01707382502938514},
+		{-0.009243058152591178, 0.015055144896577895, 0.7518742899580008},
+	}
+	d50ToD65 = [3][3]float64{
+		{0.9554734527042182, -0.023098536874261423, 0.0632593086610217},
+		{-0.028369706963208136, 1.0099954580058226, 0.021041398966943008},
+		{0.012314001688319899, -0.020507696433477912, 1.3303659366080753},
+	}
+	d50White = [3]float64{0.3457 / 0.3585, 1, (1 - 0.3457 - 0.3585) / 0.3585}
+)
+
+func mul(m [3][3]float64, v [3]float64) [3]float64 {
+	return [3]float64{
+		m[0][0]*v[0] + m[0][1]*v[1] + m[0][2]*v[2],
+		m[1][0]*v[0] + m[1][1]*v[1] + m[1][2]*v[2],
+		m[2][0]*v[0] + m[2][1]*v[1] + m[2][2]*v[2],
+	}
+}
+
+const (
+	labEpsilon = 216.0 / 24389
+	labKappa   = 24389.0 / 27
+)
+
+// Lab returns CIE Lab (D50) as CSS defines it: L in [0, 100].
+func (c Color) Lab() (l, a, b float64) {
+	r, g, bl := c.linearRGB()
+	xyz := mul(d65ToD50, mul(srgbToXYZ, [3]float64{r, g, bl}))
+	f := func(t float64) float64 {
+		if t > labEpsilon {
+			return math.Cbrt(t)
+		}
+		return (labKappa*t + 16) / 116
+	}
+	fx, fy, fz := f(xyz[0]/d50White[0]), f(xyz[1]/d50White[1]), f(xyz[2]/d50White[2])
+	return 116*fy - 16, 500 * (fx - fy), 200 * (fy - fz)
+}
+
+// FromLab builds a color from CIE Lab (D50).
+func FromLab(l, a, b, alpha float64) Color {
+	fy := (l + 16) / 116
+	fx, fz := a/500+fy, fy-b/200
+	inv := func(f float64) float64 {
+		if f3 := f * f * f; f3 > labEpsilon {
+			return f3
+		}
+		return (116*f - 16) / labKappa
+	}
+	y := l / labKappa
+	if l > labKappa*labEpsilon {
+		y = fy * fy * fy
+	}
+	rgb := mul(xyzToSRGB, mul(d50ToD65, [3]float64{inv(fx) * d50White[0], y, inv(fz) * d50White[2]}))
+	return fromLinear(rgb[0], rgb[1], rgb[2], alpha)
+}
+
+// LCH returns the polar form of Lab: lightness, chroma and hue in degrees.
+func (c Color) LCH() (l, ch, h float64) {
+	l, a, b := c.Lab()
+	ch, h = polar(a, b)
+	return l, ch, h
+}
+
+// FromLCH builds a color from CIE LCH.
+func FromLCH(l, ch, h, alpha float64) Color {
+	a, b := cartesian(ch, h)
+	return FromLab(l, a, b, alpha)
+}
+
+// OKLab returns Björn Ottosson's OKLab coordinates: L in [0, 1].
+func (c Color) OKLab() (l, a, b float64) {
+	r, g, bl := c.linearRGB()
+	lc := math.Cbrt(0.4122214708*r + 0.5363325363*g + 0.0514459929*bl)
+	mc := math.Cbrt(0.2119034982*r + 0.6806995451*g + 0.1073969566*bl)
+	sc := math.Cbrt(0.0883024619*r + 0.2817188376*g + 0.6299787005*bl)
+	return 0.2104542553*lc + 0.7936177850*mc - 0.0040720468*sc,
+		1.9779984951*lc - 2.4285922050*mc + 0.4505937099*sc,
+		0.0259040371*lc + 0.7827717662*mc - 0.8086757660*sc
+}
+
+// FromOKLab builds a color from OKLab.
+func FromOKLab(l, a, b, alpha float64) Color {
+	lc := l + 0.3963377774*a + 0.2158037573*b
+	mc := l - 0.1055613458*a - 0.0638541728*b
+	sc := l - 0.0894841775*a - 1.2914855480*b
+	lc, mc, sc = lc*lc*lc, mc*mc*mc, sc*sc*sc
+	return fromLinear(
+		4.0767416621*lc-3.3077115913*mc+0.2309699292*sc,
+		-1.2684380046*lc+2.6097574011*mc-0.3413193965*sc,
+		-0.0041960863*lc-0.7034186147*mc+1.7076147010*sc,
+		alpha)
+}
+
+// OKLCH returns the polar form of OKLab.
+func (c Color) OKLCH() (l, ch, h float64) {
+	l, a, b := c.OKLab()
+	ch, h = polar(a, b)
+	return l, ch, h
+}
+
+// FromOKLCH builds a color from OKLCH.
+func FromOKLCH(l, ch, h, alpha float64) Color {
+	a, b := cartesian(ch, h)
+	return FromOKLab(l, a, b, alpha)
+}
+
+func polar(a, b float64) (ch, h float64) {
+	ch = math.Hypot(a, b)
+	h = math.Atan2(b, a) * 180 / math.Pi
+	if h < 0 {
+		h += 360
+	}
+	return ch, h
+}
+
+func cartesian(ch, h float64) (a, b float64) {
+	rad := h * math.Pi / 180
+	return ch * math.Cos(rad), ch * math.Sin(rad)
+}
+
+// deltaEOK is the Euclidean distance in OKLab, the difference metric CSS
+// gamut mapping uses.
+func deltaEOK(x, y Color) float64 {
+	l1, a1, b1 := x.OKLab()
+	l2, a2, b2 := y.OKLab()
+	return math.Sqrt((l1-l2)*(l1-l2) + (a1-a2)*(a1-a2) + (b1-b2)*(b1-b2))
+}
+
+// Mix interpolates from c to d in OKLab; t is the share of d.
+func Mix(c, d Color, t float64) Color {
+	l1, a1, b1 := c.OKLab()
+	l2, a2, b2 := d.OKLab()
+	lerp := func(x, y float64) float64 { return x + (y-x)*t }
+	return FromOKLab(lerp(l1, l2), lerp(a1, a2), lerp(b1, b2), lerp(c.A, d.A))
+}
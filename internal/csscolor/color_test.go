@@ -0,0 +1,127 @@
+package csscolor
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string // Hex8 of the parsed color
+	}{
+		{"#F00", "#ff0000ff"},
+		{"#f008", "#ff000088"},
+		{"3b82f6", "#3b82f6ff"},
+		{"#3b82f680", "#3b82f680"},
+		{"RebeccaPurple", "#663399ff"},
+		{"transparent", "#00000000"},
+		{"rgb(59, 130, 246)", "#3b82f6ff"},
+		{"rgba(255, 0, 0, 0.5)", "#ff000080"},
+		{"rgb(255 0 0 / 50%)", "#ff000080"},
+		{"rgb(100% 50% 0%)", "#ff8000ff"},
+		{"hsl(120deg 100% 50%)", "#00ff00ff"},
+		{"hsla(0.5turn, 100%, 50%, .25)", "#00ffff40"},
+		{"hsl(none 0% 50%)", "#808080ff"},
+		{"hsv(210, 76%, 96%)", "#3b98f5ff"},
+		{"hwb(120 20% 30%)", "#33b333ff"},
+		{"hwb(0 60% 60%)", "#808080ff"},
+		{"cmyk(0%, 100%, 100%, 0%)", "#ff0000ff"},
+		{"cmyk(76, 47, 0, 4)", "#3b82f5ff"},
+		{"device-cmyk(0 0 0 1)", "#000000ff"},
+		{"lab(54.29 80.8 69.89)", "#ff0000ff"},
+		{"lch(54.29 106.84 40.86)", "#ff0000ff"},
+		{"oklab(0.628 0.2249 0.1258)", "#ff0000ff"},
+		{"oklch(62.8% 0.2577 29.23 / 0.5)", "#ff000080"},
+		{"oklch(0.6231 0.188 4.5346rad)", "#3b82f6ff"},
+	}
+	for _, tt := range tests {
+		c, err := Parse(tt.in)
+		if err != nil {
+			t.Errorf("%s: %v", tt.in, err)
+			continue
+		}
+		if got := c.Hex8(); got != tt.want {
+			t.Errorf("%s = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+
+	errs := map[string]string{
+		"":                  "empty color",
+		"#12345":            "use 3, 4, 6 or 8 hex digits",
+		"blurple":           `unknown color "blurple"`,
+		"rgb(1, 2)":         "takes 3 values",
+		"rgb(1 2 x)":        `invalid number "x"`,
+		"hsl(1xdeg 0% 0%)":  `invalid angle "1xdeg"`,
+		"color(srgb 1 0 0)": "unknown color function color()",
+		"rgb(1 2 3":         "missing )",
+	}
+	for in, msg := range errs {
+		if _, err := Parse(in); err == nil || !strings.Contains(err.Error(), msg) {
+			t.Errorf("%q: got %v, want %q", in, err, msg)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	c, _ := Parse("#3b82f6")
+	got := []string{c.Hex(), c.RGBString(), c.HSLString(), c.HSVString(), c.HWBString(), c.CMYKString(),
+		c.LabString(), c.LCHString(), c.OKLabString(), c.OKLCHString()}
+	want := []string{"#3b82f6", "rgb(59, 130, 246)", "hsl(217, 91%, 60%)", "hsv(217, 76%, 96%)", "hwb(217 23% 4%)",
+		"cmyk(76%, 47%, 0%, 4%)", "lab(54.62 8.76 -65.79)", "lch(54.62 66.37 277.59)",
+		"oklab(0.6231 -0.0332 -0.1851)", "oklch(0.6231 0.188 259.81)"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("got %s, want %s", got[i], want[i])
+		}
+	}
+
+	c, _ = Parse("rgb(255 0 0 / 0.5)")
+	if got := c.RGBString() + " " + c.HSLString() + " " + c.OKLCHString(); got != "rgba(255, 0, 0, 0.5) hsla(0, 100%, 50%, 0.5) oklch(0.628 0.2577 29.23 / 0.5)" {
+		t.Errorf("translucent: %s", got)
+	}
+	c, _ = Parse("white")
+	if got := c.LabString() + " " + c.LCHString() + " " + c.OKLCHString(); got != "lab(100 0 0) lch(100 0 0) oklch(1 0 0)" {
+		t.Errorf("achromatic: %s", got)
+	}
+}
+
+func TestToGamut(t *testing.T) {
+	c, _ := Parse("oklch(0.7 0.4 150)")
+	if c.InGamut() {
+		t.Fatal("oklch(0.7 0.4 150) is outside sRGB")
+	}
+	m := c.ToGamut()
+	if !m.InGamut() {
+		t.Fatalf("mapped color out of gamut: %+v", m)
+	}
+	// Lightness and hue survive; only chroma drops.
+	l, ch, h := m.OKLCH()
+	if l < 0.69 || l > 0.71 || h < 145 || h > 155 || ch >= 0.4 {
+		t.Errorf("mapped to oklch(%g %g %g)", l, ch, h)
+	}
+	if got := c.Hex(); got != m.Hex() {
+		t.Errorf("Hex %s, mapped %s", got, m.Hex())
+	}
+	if w, _ := Parse("oklch(1.2 0.3 40)"); w.Hex() != "#ffffff" {
+		t.Errorf("over-white lightness: %s", w.Hex())
+	}
+}
+
+func TestNames(t *testing.T) {
+	c, _ := Parse("#808080")
+	if c.Name() != "gray" {
+		t.Errorf("alias: %s", c.Name())
+	}
+	c, _ = Parse("#3b82f6")
+	if c.Name() != "" {
+		t.Errorf("unnamed color: %s", c.Name())
+	}
+	if n, _ := c.NearestName(); n != "dodgerblue" {
+		t.Errorf("nearest: %s", n)
+	}
+	c, _ = Parse("#ff000080")
+	if c.Name() != "" {
+		t.Errorf("translucent color named %s", c.Name())
+	}
+}
@@ -0,0 +1,91 @@
+package csscolor
+
+import "math"
+
+// WCAG 2.x minimum contrast ratios. Large text is 18pt, or 14pt bold;
+// user-interface components and graphics use the large-text AA ratio.
+const (
+	AA       = 4.5
+	AALarge  = 3
+	AAA      = 7
+	AAALarge = 4.5
+)
+
+// Luminance returns the WCAG relative luminance of the gamut-mapped color,
+// ignoring alpha.
+func (c Color) Luminance() float64 {
+	m := c.ToGamut()
+	r, g, b := m.linearRGB()
+	return 0.2126*r + 0.7152*g + 0.0722*b
+}
+
+// Over composites c onto an opaque backdrop.
+func (c Color) Over(backdrop Color) Color {
+	m, bd := c.ToGamut(), backdrop.ToGamut()
+	mix := func(x, y float64) float64 { return x*c.A + y*(1-c.A) }
+	return Color{mix(m.R, bd.R), mix(m.G, bd.G), mix(m.B, bd.B), 1}
+}
+
+// Contrast returns the WCAG contrast ratio of text fg on background bg,
+// from 1 to 21. A translucent background is first composited onto white,
+// and a translucent foreground onto the background.
+func Contrast(fg, bg Color) float64 {
+	if bg.A < 1 {
+		bg = bg.Over(Color{1, 1, 1, 1})
+	}
+	if fg.A < 1 {
+		fg = fg.Over(bg)
+	}
+	l1, l2 := fg.Luminance(), bg.Luminance()
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+	return (l1 + 0.05) / (l2 + 0.05)
+}
+
+// Suggest returns the opaque color nearest to fg in OKLCH lightness, with
+// fg's hue and chroma, that reaches the target ratio on bg. It tries both
+// lightening and darkening and keeps the smaller change; ok is false when
+// neither reaches the target. The result is rounded to 8-bit channels and
+// still passes.
+func Suggest(fg, bg Color, target float64) (c Color, ok bool) {
+	if bg.A < 1 {
+		bg = bg.Over(Color{1, 1, 1, 1})
+	}
+	if fg.A < 1 {
+		fg = fg.Over(bg)
+	}
+	l, ch, h := fg.OKLCH()
+	if ch < 1e-4 {
+		ch = 0 // keep grays gray
+	}
+	at := func(nl float64) Color { return quantize(FromOKLCH(nl, ch, h, 1)) }
+	best := math.Inf(1)
+	for _, end := range []float64{1, 0} {
+		if Contrast(at(end), bg) < target {
+			continue
+		}
+		// lo fails and hi passes; the ratio grows monotonically toward
+		// either end.
+		lo, hi := l, end
+		for range 40 {
+			mid := (lo + hi) / 2
+			if Contrast(at(mid), bg) >= target {
+				hi = mid
+			} else {
+				lo = mid
+			}
+		}
+		if d := math.Abs(hi - l); d < best {
+			best, c, ok = d, at(hi), true
+		}
+	}
+	return c, ok
+}
+
+func quantize(c Color) Color {
+	r, g, b, a := c.Bytes()
+	q := RGB(r, g, b)
+	q.A = float64(a) / 255
+	return q
+}
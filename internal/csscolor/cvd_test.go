@@ -0,0 +1,71 @@
+package csscolor
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDeltaE2000(t *testing.T) {
+	// Pairs from Sharma, Wu and Dalal's CIEDE2000 test data.
+	tests := []struct {
+		l1, a1, b1, l2, a2, b2, want float64
+	}{
+		{50, 2.6772, -79.7751, 50, 0, -82.7485, 2.0425},
+		{50, -1.3802, -84.2814, 50, 0, -82.7485, 1.0000},
+		{50, 0, 0, 50, -1, 2, 2.3669},
+		{50, 2.49, -0.001, 50, -2.49, 0.0011, 7.2195},
+		{50, 2.5, 0, 73, 25, -18, 27.1492},
+		{60.2574, -34.0099, 36.2677, 60.4626, -34.1751, 39.4387, 1.2644},
+		{22.7233, 20.0904, -46.694, 23.0331, 14.973, -42.5619, 2.0373},
+		{90.8027, -2.0831, 1.441, 91.1528, -1.6435, 0.0447, 1.4441},
+	}
+	for _, tt := range tests {
+		if got := deltaE2000(tt.l1, tt.a1, tt.b1, tt.l2, tt.a2, tt.b2); math.Abs(got-tt.want) > 1e-4 {
+			t.Errorf("ΔE(%v,%v,%v / %v,%v,%v) = %.4f, want %.4f", tt.l1, tt.a1, tt.b1, tt.l2, tt.a2, tt.b2, got, tt.want)
+		}
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	red, _ := Parse("#d62728")
+	green, _ := Parse("#2ca02c")
+	blue, _ := Parse("#1f77b4")
+	normal := DeltaE2000(red, green)
+	for _, d := range Deficiencies {
+		r, err := Simulate(red, d, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		g, _ := Simulate(green, d, 1)
+		de := DeltaE2000(r, g)
+		switch d {
+		case Normal:
+			if r != red.ToGamut() || de < 50 {
+				t.Errorf("normal vision: %s, ΔE %.1f", r.Hex(), de)
+			}
+		case Protanopia, Deuteranopia:
+			// Red and green collapse toward the same olive.
+			if de > normal/2 {
+				t.Errorf("%s: red/green ΔE %.1f", d, de)
+			}
+		case Achromatopsia:
+			if h, s, _ := r.HSL(); s > 0.01 {
+				t.Errorf("achromatopsia left hue %g saturation %g", h, s)
+			}
+		}
+	}
+	// Tritanopes keep red and green apart but lose blue/green.
+	gt, _ := Simulate(green, Tritanopia, 1)
+	bt, _ := Simulate(blue, Tritanopia, 1)
+	if DeltaE2000(gt, bt) > DeltaE2000(green, blue) {
+		t.Error("tritanopia should bring blue and green closer")
+	}
+	half, _ := Simulate(red, Protanopia, 0.5)
+	full, _ := Simulate(red, Protanopia, 1)
+	if d1, d2 := DeltaE2000(red, half), DeltaE2000(red, full); d1 <= 0 || d1 >= d2 {
+		t.Errorf("severity: half %.1f, full %.1f", d1, d2)
+	}
+	if _, err := Simulate(red, "monochromacy", 1); err == nil {
+		t.Error("unknown deficiency accepted")
+	}
+}
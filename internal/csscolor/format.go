@@ -0,0 +1,119 @@
+package csscolor
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Hex returns #rrggbb, dropping alpha.
+func (c Color) Hex() string {
+	r, g, b, _ := c.Bytes()
+	return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+}
+
+// Hex8 returns #rrggbbaa.
+func (c Color) Hex8() string {
+	r, g, b, a := c.Bytes()
+	return fmt.Sprintf("#%02x%02x%02x%02x", r, g, b, a)
+}
+
+// The legacy notations below keep the comma syntax and integer rounding
+// design tools expect; they switch to the -a function forms when the
+// color is translucent.
+
+// RGBString returns rgb(r, g, b) or rgba(r, g, b, a).
+func (c Color) RGBString() string {
+	r, g, b, _ := c.Bytes()
+	if c.A < 1 {
+		return fmt.Sprintf("rgba(%d, %d, %d, %s)", r, g, b, num(c.A, 3))
+	}
+	return fmt.Sprintf("rgb(%d, %d, %d)", r, g, b)
+}
+
+// HSLString returns hsl(h, s%, l%) or hsla(h, s%, l%, a).
+func (c Color) HSLString() string {
+	h, s, l := c.ToGamut().HSL()
+	if c.A < 1 {
+		return fmt.Sprintf("hsla(%s, %s%%, %s%%, %s)", deg(h), num(s*100, 0), num(l*100, 0), num(c.A, 3))
+	}
+	return fmt.Sprintf("hsl(%s, %s%%, %s%%)", deg(h), num(s*100, 0), num(l*100, 0))
+}
+
+// HSVString returns hsv(h, s%, v%) or hsva(h, s%, v%, a).
+func (c Color) HSVString() string {
+	h, s, v := c.ToGamut().HSV()
+	if c.A < 1 {
+		return fmt.Sprintf("hsva(%s, %s%%, %s%%, %s)", deg(h), num(s*100, 0), num(v*100, 0), num(c.A, 3))
+	}
+	return fmt.Sprintf("hsv(%s, %s%%, %s%%)", deg(h), num(s*100, 0), num(v*100, 0))
+}
+
+// CMYKString returns cmyk(c%, m%, y%, k%); alpha is not representable.
+func (c Color) CMYKString() string {
+	cy, m, y, k := c.CMYK()
+	return fmt.Sprintf("cmyk(%s%%, %s%%, %s%%, %s%%)", num(cy*100, 0), num(m*100, 0), num(y*100, 0), num(k*100, 0))
+}
+
+// The CSS Color 4 notations are space separated with a "/ alpha" suffix.
+
+// HWBString returns hwb(h w% b%).
+func (c Color) HWBString() string {
+	h, w, b := c.ToGamut().HWB()
+	return fmt.Sprintf("hwb(%s %s%% %s%%%s)", deg(h), num(w*100, 0), num(b*100, 0), c.alphaSuffix())
+}
+
+// LabString returns lab(L a b) with CIE Lab under D50.
+func (c Color) LabString() string {
+	l, a, b := c.Lab()
+	return fmt.Sprintf("lab(%s %s %s%s)", num(l, 2), num(a, 2), num(b, 2), c.alphaSuffix())
+}
+
+// LCHString returns lch(L C h).
+func (c Color) LCHString() string {
+	l, ch, h := c.LCH()
+	if ch < 0.005 {
+		h = 0
+	}
+	return fmt.Sprintf("lch(%s %s %s%s)", num(l, 2), num(ch, 2), num(h, 2), c.alphaSuffix())
+}
+
+// OKLabString returns oklab(L a b).
+func (c Color) OKLabString() string {
+	l, a, b := c.OKLab()
+	return fmt.Sprintf("oklab(%s %s %s%s)", num(l, 4), num(a, 4), num(b, 4), c.alphaSuffix())
+}
+
+// OKLCHString returns oklch(L C h).
+func (c Color) OKLCHString() string {
+	l, ch, h := c.OKLCH()
+	if ch < 0.00005 {
+		h = 0
+	}
+	return fmt.Sprintf("oklch(%s %s %s%s)", num(l, 4), num(ch, 4), num(h, 2), c.alphaSuffix())
+}
+
+func (c Color) alphaSuffix() string {
+	if c.A < 1 {
+		return " / " + num(c.A, 3)
+	}
+	return ""
+}
+
+// num rounds v to at most places decimals without trailing zeros.
+func num(v float64, places int) string {
+	p := math.Pow(10, float64(places))
+	v = math.Round(v*p) / p
+	if v == 0 {
+		v = 0 // no "-0"
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// deg rounds a hue to whole degrees, wrapping 360 to 0.
+func deg(h float64) string {
+	if math.Round(h) >= 360 {
+		h = 0
+	}
+	return num(h, 0)
+}
@@ -0,0 +1,98 @@
+package csscolor
+
+import (
+	"fmt"
+	"math"
+)
+
+// Swatch is one palette entry.
+type Swatch struct {
+	Label string
+	Color Color
+}
+
+// Harmonies lists the hue rotations of each color harmony, in degrees.
+var Harmonies = map[string][]float64{
+	"complementary":       {0, 180},
+	"analogous":           {-30, 0, 30},
+	"triadic":             {0, 120, 240},
+	"split-complementary": {0, 150, 210},
+	"tetradic":            {0, 90, 180, 270},
+}
+
+// Harmony rotates the hue of base in OKLCH, so the colors keep the base's
+// perceived lightness and colorfulness.
+func Harmony(base Color, kind string) ([]Swatch, error) {
+	angles, ok := Harmonies[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown harmony %q", kind)
+	}
+	l, ch, h := base.OKLCH()
+	out := make([]Swatch, len(angles))
+	for i, a := range angles {
+		label := "base"
+		if a != 0 {
+			label = fmt.Sprintf("%+g°", a)
+		}
+		out[i] = Swatch{label, quantize(FromOKLCH(l, ch, math.Mod(h+a+360, 360), base.A))}
+	}
+	return out, nil
+}
+
+// Tints mixes base with white in n even steps, base first.
+func Tints(base Color, n int) []Swatch {
+	return mixSteps(base, Color{1, 1, 1, base.A}, n, "tint")
+}
+
+// Shades mixes base with black in n even steps, base first.
+func Shades(base Color, n int) []Swatch {
+	return mixSteps(base, Color{0, 0, 0, base.A}, n, "shade")
+}
+
+func mixSteps(base, toward Color, n int, label string) []Swatch {
+	out := []Swatch{{"base", quantize(base)}}
+	for i := 1; i <= n; i++ {
+		t := float64(i) / float64(n+1)
+		out = append(out, Swatch{fmt.Sprintf("%s %.0f%%", label, t*100), quantize(Mix(base, toward, t))})
+	}
+	return out
+}
+
+// Gradient interpolates from a to b in OKLab, giving n colors including
+// both ends. OKLab keeps the steps perceptually even and avoids the muddy
+// midpoints of sRGB interpolation.
+func Gradient(a, b Color, n int) []Swatch {
+	n = max(n, 2)
+	out := make([]Swatch, n)
+	for i := range out {
+		t := float64(i) / float64(n-1)
+		out[i] = Swatch{fmt.Sprintf("%.0f%%", t*100), quantize(Mix(a, b, t))}
+	}
+	return out
+}
+
+// scaleStops are the OKLCH lightness of each step of a design-system
+// scale, modelled on common 50–950 ramps.
+var scaleStops = []struct {
+	label string
+	l     float64
+}{
+	{"50", 0.97}, {"100", 0.93}, {"200", 0.88}, {"300", 0.81}, {"400", 0.71}, {"500", 0.62},
+	{"600", 0.55}, {"700", 0.49}, {"800", 0.42}, {"900", 0.38}, {"950", 0.28},
+}
+
+// Scale builds a 50–950 ramp at the base's hue. Chroma tapers toward white
+// and black in proportion to the lightness left, as hand-tuned ramps do.
+func Scale(base Color) []Swatch {
+	lb, ch, h := base.OKLCH()
+	lb = math.Min(math.Max(lb, 0.01), 0.99)
+	out := make([]Swatch, len(scaleStops))
+	for i, s := range scaleStops {
+		f := s.l / lb
+		if s.l > lb {
+			f = (1 - s.l) / (1 - lb)
+		}
+		out[i] = Swatch{s.label, quantize(FromOKLCH(s.l, ch*math.Min(f, 1), h, base.A))}
+	}
+	return out
+}
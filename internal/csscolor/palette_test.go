@@ -0,0 +1,106 @@
+package csscolor
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestContrast(t *testing.T) {
+	tests := []struct {
+		fg, bg string
+		want   float64
+	}{
+		{"black", "white", 21},
+		{"white", "white", 1},
+		{"#777", "white", 4.48},
+		{"#767676", "#fff", 4.54},
+		{"rgb(0 0 0 / 50%)", "white", 3.98},
+		{"white", "rgb(0 0 0 / 50%)", 3.98},
+	}
+	for _, tt := range tests {
+		fg, _ := Parse(tt.fg)
+		bg, _ := Parse(tt.bg)
+		if got := Contrast(fg, bg); math.Abs(got-tt.want) > 0.01 {
+			t.Errorf("%s on %s = %.3f, want %.2f", tt.fg, tt.bg, got, tt.want)
+		}
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	white, _ := Parse("white")
+	gray, _ := Parse("#777")
+	if c, ok := Suggest(gray, white, AA); !ok || c.Hex() != "#767676" {
+		t.Errorf("AA on white: %s %v", c.Hex(), ok)
+	}
+	// On a mid background the lighter direction is closer.
+	bg, _ := Parse("#4a4a4a")
+	fg, _ := Parse("#8a8a8a")
+	c, ok := Suggest(fg, bg, AA)
+	if !ok || Contrast(c, bg) < AA {
+		t.Fatalf("suggestion %s fails: %v", c.Hex(), ok)
+	}
+	if c.Luminance() < fg.Luminance() {
+		t.Errorf("suggestion %s is darker than %s", c.Hex(), fg.Hex())
+	}
+	// Hue is kept.
+	blue, _ := Parse("#3b82f6")
+	c, _ = Suggest(blue, white, AAA)
+	if _, _, h := c.OKLCH(); Contrast(c, white) < AAA || math.Abs(h-259.8) > 3 {
+		t.Errorf("blue for AAA: %s", c.OKLCHString())
+	}
+	// Nothing reaches 7:1 against mid gray.
+	mid, _ := Parse("#777")
+	if c, ok := Suggest(mid, mid, 21); ok {
+		t.Errorf("impossible target met by %s", c.Hex())
+	}
+}
+
+func hexes(sw []Swatch) string {
+	var parts []string
+	for _, s := range sw {
+		parts = append(parts, s.Label+"="+s.Color.Hex())
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestPalettes(t *testing.T) {
+	base, _ := Parse("#3b82f6")
+	black, _ := Parse("black")
+	white, _ := Parse("white")
+
+	if got := hexes(Tints(base, 3)); got != "base=#3b82f6 tint 25%=#6ea3fb tint 50%=#9ec3ff tint 75%=#cee1ff" {
+		t.Errorf("tints: %s", got)
+	}
+	if got := hexes(Shades(base, 1)); !strings.HasPrefix(got, "base=#3b82f6 shade 50%=") {
+		t.Errorf("shades: %s", got)
+	}
+	if got := hexes(Gradient(black, white, 3)); got != "0%=#000000 50%=#636363 100%=#ffffff" {
+		t.Errorf("gradient: %s", got)
+	}
+	tri, err := Harmony(base, "triadic")
+	if err != nil || len(tri) != 3 || tri[0].Label != "base" || tri[1].Label != "+120°" {
+		t.Fatalf("triadic: %v %s", err, hexes(tri))
+	}
+	l0, _, h0 := tri[0].Color.OKLCH()
+	l1, _, h1 := tri[1].Color.OKLCH()
+	if math.Abs(l0-l1) > 0.01 || math.Abs(math.Mod(h1-h0+360, 360)-120) > 2 {
+		t.Errorf("triadic rotation: %s", hexes(tri))
+	}
+	if _, err := Harmony(base, "pentadic"); err == nil {
+		t.Error("unknown harmony accepted")
+	}
+
+	scale := Scale(base)
+	if len(scale) != 11 || scale[0].Label != "50" || scale[10].Label != "950" {
+		t.Fatalf("scale: %s", hexes(scale))
+	}
+	prev := 2.0
+	for _, s := range scale {
+		l, ch, h := s.Color.OKLCH()
+		if l >= prev || (ch > 0.02 && math.Abs(h-259.8) > 2) {
+			t.Errorf("scale step %s: %s", s.Label, s.Color.OKLCHString())
+		}
+		prev = l
+	}
+}
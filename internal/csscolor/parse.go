@@ -0,0 +1,212 @@
+package csscolor
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Parse reads a color in any notation the package knows:
+//
+//	#rgb #rgba #rrggbb #rrggbbaa (the # may be left out)
+//	red, rebeccapurple, transparent
+//	rgb(255 0 0 / 50%)  rgba(255, 0, 0, 0.5)  rgb(100% 0% 0%)
+//	hsl(120deg 100% 50%)  hsla(120, 100%, 50%, .5)
+//	hsv(120, 100%, 100%)  hsb(…)
+//	hwb(120 0% 0%)
+//	cmyk(0%, 100%, 100%, 0%)  device-cmyk(0 1 1 0)
+//	lab(54.29 80.8 69.89)  lch(54.29 106.84 40.85)
+//	oklab(0.628 0.2249 0.1258)  oklch(62.8% 0.2577 29.23)
+//
+// Both the comma-separated legacy syntax and the space-separated syntax
+// with a "/ alpha" suffix are accepted for every function. Hues take the
+// deg, rad, grad and turn units, and "none" stands for zero.
+func Parse(s string) (Color, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return Color{}, errors.New("empty color")
+	}
+	if s == "transparent" {
+		return Color{}, nil
+	}
+	if v, ok := named[s]; ok {
+		return fromHex24(v), nil
+	}
+	if open := strings.IndexByte(s, '('); open > 0 {
+		if !strings.HasSuffix(s, ")") {
+			return Color{}, fmt.Errorf("missing ) in %s", s)
+		}
+		return parseFunction(strings.TrimSpace(s[:open]), s[open+1:len(s)-1])
+	}
+	if c, ok := parseHex(strings.TrimPrefix(s, "#")); ok {
+		return c, nil
+	}
+	if strings.HasPrefix(s, "#") {
+		return Color{}, fmt.Errorf("invalid hex color %s; use 3, 4, 6 or 8 hex digits", s)
+	}
+	return Color{}, fmt.Errorf("unknown color %q", s)
+}
+
+func parseHex(h string) (Color, bool) {
+	if n := len(h); n != 3 && n != 4 && n != 6 && n != 8 {
+		return Color{}, false
+	}
+	v, err := strconv.ParseUint(h, 16, 32)
+	if err != nil {
+		return Color{}, false
+	}
+	var r, g, b, a uint64
+	a = 255
+	switch len(h) {
+	case 3:
+		r, g, b = (v>>8&0xf)*17, (v>>4&0xf)*17, (v&0xf)*17
+	case 4:
+		r, g, b, a = (v>>12&0xf)*17, (v>>8&0xf)*17, (v>>4&0xf)*17, (v&0xf)*17
+	case 6:
+		r, g, b = v>>16&0xff, v>>8&0xff, v&0xff
+	case 8:
+		r, g, b, a = v>>24&0xff, v>>16&0xff, v>>8&0xff, v&0xff
+	}
+	return Color{float64(r) / 255, float64(g) / 255, float64(b) / 255, float64(a) / 255}, true
+}
+
+// arg is one function argument: a number, a percentage or an angle.
+type arg struct {
+	v       float64
+	percent bool
+}
+
+// scaled returns the argument as a number, reading 100% as full.
+func (a arg) scaled(full float64) float64 {
+	if a.percent {
+		return a.v / 100 * full
+	}
+	return a.v
+}
+
+func parseArgs(body string) (args []arg, alpha *arg, err error) {
+	main, alphaPart, hasSlash := strings.Cut(body, "/")
+	var fields []string
+	if strings.Contains(main, ",") {
+		for _, f := range strings.Split(main, ",") {
+			fields = append(fields, strings.TrimSpace(f))
+		}
+	} else {
+		fields = strings.Fields(main)
+	}
+	for _, f := range fields {
+		a, err := parseArg(f)
+		if err != nil {
+			return nil, nil, err
+		}
+		args = append(args, a)
+	}
+	if hasSlash {
+		a, err := parseArg(strings.TrimSpace(alphaPart))
+		if err != nil {
+			return nil, nil, err
+		}
+		alpha = &a
+	}
+	return args, alpha, nil
+}
+
+func parseArg(f string) (arg, error) {
+	if f == "none" {
+		return arg{}, nil
+	}
+	units := []struct {
+		suffix string
+		scale  float64
+	}{{"deg", 1}, {"grad", 0.9}, {"rad", 180 / math.Pi}, {"turn", 360}}
+	for _, u := range units {
+		if num, ok := strings.CutSuffix(f, u.suffix); ok {
+			v, err := strconv.ParseFloat(num, 64)
+			if err != nil {
+				return arg{}, fmt.Errorf("invalid angle %q", f)
+			}
+			return arg{v: v * u.scale}, nil
+		}
+	}
+	num, percent := strings.CutSuffix(f, "%")
+	v, err := strconv.ParseFloat(num, 64)
+	if err != nil || math.IsInf(v, 0) || math.IsNaN(v) {
+		return arg{}, fmt.Errorf("invalid number %q", f)
+	}
+	return arg{v: v, percent: percent}, nil
+}
+
+func hueArg(a arg) float64 {
+	h := math.Mod(a.v, 360)
+	if h < 0 {
+		h += 360
+	}
+	return h
+}
+
+// functionArity maps each color function to its number of values.
+var functionArity = map[string]int{
+	"rgb": 3, "rgba": 3, "hsl": 3, "hsla": 3, "hsv": 3, "hsva": 3, "hsb": 3, "hsba": 3,
+	"hwb": 3, "cmyk": 4, "device-cmyk": 4, "lab": 3, "lch": 3, "oklab": 3, "oklch": 3,
+}
+
+func parseFunction(name, body string) (Color, error) {
+	want, ok := functionArity[name]
+	if !ok {
+		return Color{}, fmt.Errorf("unknown color function %s()", name)
+	}
+	args, alpha, err := parseArgs(body)
+	if err != nil {
+		return Color{}, fmt.Errorf("%s(): %w", name, err)
+	}
+	// The legacy comma syntax passes alpha as one more argument.
+	if alpha == nil && len(args) == want+1 {
+		alpha, args = &args[want], args[:want]
+	}
+	if len(args) != want {
+		return Color{}, fmt.Errorf("%s() takes %d values and an optional alpha, got %d", name, want, len(args))
+	}
+	a := 1.0
+	if alpha != nil {
+		a = clamp01(alpha.scaled(1))
+	}
+	p := func(i int, full float64) float64 { return args[i].scaled(full) }
+
+	switch name {
+	case "rgb", "rgba":
+		return Color{p(0, 255) / 255, p(1, 255) / 255, p(2, 255) / 255, a}.clip(), nil
+	case "hsl", "hsla":
+		return FromHSL(hueArg(args[0]), clamp01(p(1, 100)/100), clamp01(p(2, 100)/100), a), nil
+	case "hsv", "hsva", "hsb", "hsba":
+		return FromHSV(hueArg(args[0]), clamp01(p(1, 100)/100), clamp01(p(2, 100)/100), a), nil
+	case "hwb":
+		return FromHWB(hueArg(args[0]), clamp01(p(1, 100)/100), clamp01(p(2, 100)/100), a), nil
+	case "cmyk", "device-cmyk":
+		// Plain numbers are fractions unless one of them is above 1, as in
+		// the cmyk(0, 100, 100, 0) design tools write.
+		full := 1.0
+		for _, x := range args {
+			if !x.percent && x.v > 1 {
+				full = 100
+			}
+		}
+		v := func(i int) float64 {
+			if args[i].percent {
+				return clamp01(args[i].v / 100)
+			}
+			return clamp01(args[i].v / full)
+		}
+		return FromCMYK(v(0), v(1), v(2), v(3), a), nil
+	case "lab":
+		return FromLab(math.Max(0, p(0, 100)), p(1, 125), p(2, 125), a), nil
+	case "lch":
+		return FromLCH(math.Max(0, p(0, 100)), math.Max(0, p(1, 150)), hueArg(args[2]), a), nil
+	case "oklab":
+		return FromOKLab(math.Max(0, p(0, 1)), p(1, 0.4), p(2, 0.4), a), nil
+	case "oklch":
+		return FromOKLCH(math.Max(0, p(0, 1)), math.Max(0, p(1, 0.4)), hueArg(args[2]), a), nil
+	}
+	return Color{}, fmt.Errorf("unknown color function %s()", name)
+}
@@ -0,0 +1,247 @@
+// Package fakedata generates realistic values for test fixtures. Names,
+// addresses, phone numbers and e-mail domains come from the locale files
+// embedded under locales/; everything else is delegated to gofakeit. All
+// randomness flows from one seeded source, so a seed, locale and reference
+// time reproduce the same output.
+//
+// Adding a locale: drop a "<code>.json" file under locales/ with the same
+// keys as en_US.json.
+package fakedata
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+//go:embed locales/*.json
+var localeFS embed.FS
+
+// DefaultLocale is used when no locale is requested.
+const DefaultLocale = "en_US"
+
+// locale is one embedded locale file. Regions are [name, code] pairs.
+// Formats use "#" for a digit, "?" for an upper-case letter and {field}
+// placeholders.
+type locale struct {
+	Name             string      `json:"name"`
+	Country          string      `json:"country"`
+	CountryCode      string      `json:"countryCode"`
+	MaleFirstNames   []string    `json:"maleFirstNames"`
+	FemaleFirstNames []string    `json:"femaleFirstNames"`
+	LastNames        []string    `json:"lastNames"`
+	NameFormats      []string    `json:"nameFormats"`
+	Streets          []string    `json:"streets"`
+	StreetFormats    []string    `json:"streetFormats"`
+	BuildingNumber   string      `json:"buildingNumber"`
+	Cities           []string    `json:"cities"`
+	Regions          [][2]string `json:"regions"`
+	Postcode         string      `json:"postcode"`
+	PhoneFormats     []string    `json:"phoneFormats"`
+	AddressFormat    string      `json:"addressFormat"`
+	CompanySuffixes  []string    `json:"companySuffixes"`
+	EmailDomains     []string    `json:"emailDomains"`
+	TLDs             []string    `json:"tlds"`
+	Currency         string      `json:"currency"`
+}
+
+var loadLocales = sync.OnceValues(func() (map[string]*locale, error) {
+	files, err := fs.Glob(localeFS, "locales/*.json")
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]*locale, len(files))
+	for _, f := range files {
+		data, err := localeFS.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		l := &locale{}
+		if err := json.Unmarshal(data, l); err != nil {
+			return nil, fmt.Errorf("%s: %w", f, err)
+		}
+		out[strings.TrimSuffix(path.Base(f), ".json")] = l
+	}
+	return out, nil
+})
+
+// LocaleInfo describes an available locale.
+type LocaleInfo struct {
+	Code string
+	Name string
+}
+
+// Locales lists the embedded locales, sorted by code.
+func Locales() []LocaleInfo {
+	all, _ := loadLocales()
+	out := make([]LocaleInfo, 0, len(all))
+	for code, l := range all {
+		out = append(out, LocaleInfo{Code: code, Name: l.Name})
+	}
+	slices.SortFunc(out, func(a, b LocaleInfo) int { return strings.Compare(a.Code, b.Code) })
+	return out
+}
+
+// Faker draws values for one generation run. It is not safe for concurrent
+// use.
+type Faker struct {
+	fk  *gofakeit.Faker
+	loc *locale
+	ref time.Time
+
+	person *person // the current record's person, drawn on first use
+}
+
+// person keeps the name-derived fields of a record consistent: the e-mail
+// address and user name match the full name.
+type person struct {
+	sex, first, last, full string
+}
+
+// New returns a Faker for the locale ("" for DefaultLocale). Relative dates
+// such as date.past are computed from ref.
+func New(seed int64, localeCode string, ref time.Time) (*Faker, error) {
+	all, err := loadLocales()
+	if err != nil {
+		return nil, err
+	}
+	if localeCode == "" {
+		localeCode = DefaultLocale
+	}
+	l, ok := all[strings.ReplaceAll(localeCode, "-", "_")]
+	if !ok {
+		codes := make([]string, 0, len(all))
+		for _, info := range Locales() {
+			codes = append(codes, info.Code)
+		}
+		return nil, fmt.Errorf("unsupported locale %q (available: %s)", localeCode, strings.Join(codes, ", "))
+	}
+	return &Faker{fk: gofakeit.NewUnlocked(seed), loc: l, ref: ref}, nil
+}
+
+// NextRecord starts a new record, so that the next name-derived value
+// belongs to a new person.
+func (f *Faker) NextRecord() { f.person = nil }
+
+// Reference returns the time relative dates are computed from.
+func (f *Faker) Reference() time.Time { return f.ref }
+
+// Intn returns a value in [0, n).
+func (f *Faker) Intn(n int) int { return f.fk.Rand.Intn(n) }
+
+// Chance reports true with probability p.
+func (f *Faker) Chance(p float64) bool { return f.fk.Rand.Float64() < p }
+
+// Int returns a value in [lo, hi].
+func (f *Faker) Int(lo, hi int64) int64 {
+	if hi <= lo {
+		return lo
+	}
+	span := uint64(hi - lo)
+	if span == 1<<64-1 {
+		return int64(f.fk.Rand.Uint64())
+	}
+	return lo + int64(f.fk.Rand.Uint64()%(span+1)) // #nosec G115
+}
+
+// Float returns a value in [lo, hi).
+func (f *Faker) Float(lo, hi float64) float64 {
+	if hi <= lo {
+		return lo
+	}
+	return lo + f.fk.Rand.Float64()*(hi-lo)
+}
+
+// Time returns a time in [from, to), truncated to the second.
+func (f *Faker) Time(from, to time.Time) time.Time {
+	if !to.After(from) {
+		return from
+	}
+	return from.Add(time.Duration(f.Int(0, int64(to.Sub(from)/time.Second)-1)) * time.Second)
+}
+
+// Text returns lorem ipsum words whose length, in runes, lies in
+// [minLen, maxLen]. maxLen <= 0 means no limit.
+func (f *Faker) Text(minLen, maxLen int) string {
+	target := max(minLen, 8+f.Intn(24))
+	if maxLen > 0 {
+		target = min(target, maxLen)
+	}
+	var b strings.Builder
+	for b.Len() < target {
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(f.fk.LoremIpsumWord())
+	}
+	s := b.String() // lorem words are ASCII
+	if maxLen > 0 && len(s) > maxLen {
+		s = strings.TrimRight(s[:maxLen], " ")
+		for len(s) < minLen {
+			s += f.fk.LetterN(1)
+		}
+	}
+	return s
+}
+
+func (f *Faker) pick(list []string) string {
+	if len(list) == 0 {
+		return ""
+	}
+	return list[f.Intn(len(list))]
+}
+
+// fill replaces "#" with a digit and "?" with an upper-case letter.
+func (f *Faker) fill(format string) string {
+	var b strings.Builder
+	for _, r := range format {
+		switch r {
+		case '#':
+			b.WriteByte(byte('0' + f.Intn(10)))
+		case '?':
+			b.WriteByte(byte('A' + f.Intn(26)))
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+func (f *Faker) currentPerson() *person {
+	if f.person == nil {
+		p := &person{sex: "female", last: f.pick(f.loc.LastNames)}
+		if f.Chance(0.5) {
+			p.sex = "male"
+		}
+		p.first = f.firstName(p.sex)
+		// The first {last} is the family name; formats with two surnames
+		// draw the second one.
+		full := strings.Replace(f.pick(f.loc.NameFormats), "{first}", p.first, 1)
+		full = strings.Replace(full, "{last}", p.last, 1)
+		for strings.Contains(full, "{last}") {
+			second := f.pick(f.loc.LastNames)
+			for tries := 0; second == p.last && tries < 3; tries++ {
+				second = f.pick(f.loc.LastNames)
+			}
+			full = strings.Replace(full, "{last}", second, 1)
+		}
+		p.full = full
+		f.person = p
+	}
+	return f.person
+}
+
+func (f *Faker) firstName(sex string) string {
+	if sex == "male" {
+		return f.pick(f.loc.MaleFirstNames)
+	}
+	return f.pick(f.loc.FemaleFirstNames)
+}
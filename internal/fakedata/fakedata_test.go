@@ -0,0 +1,135 @@
+package fakedata
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+var ref = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestLocales(t *testing.T) {
+	all, err := loadLocales()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for code, l := range all {
+		v := reflect.ValueOf(*l)
+		for i := range v.NumField() {
+			if v.Field(i).IsZero() {
+				t.Errorf("%s: %s is empty", code, v.Type().Field(i).Name)
+			}
+		}
+	}
+	if _, err := New(1, "xx_XX", ref); err == nil || !strings.Contains(err.Error(), "en_US") {
+		t.Errorf("unknown locale error = %v", err)
+	}
+	if _, err := New(1, "es-MX", ref); err != nil {
+		t.Errorf("es-MX: %v", err)
+	}
+}
+
+func TestGenerate_Reproducible(t *testing.T) {
+	run := func() []any {
+		f, _ := New(42, "de_DE", ref)
+		var out []any
+		for _, name := range Generators() {
+			if name == "date.between" {
+				name += "(2020-01-01, 2021-01-01)"
+			}
+			v, err := f.Generate(name)
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			out = append(out, v)
+		}
+		return out
+	}
+	if a, b := run(), run(); !reflect.DeepEqual(a, b) {
+		t.Errorf("same seed gave different values:\n%v\n%v", a, b)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	f, _ := New(7, "es_ES", ref)
+	tests := []struct {
+		expr  string
+		match string
+	}{
+		{"number.int(5, 7)", `^[5-7]$`},
+		{"number.float(1, 2, 1)", `^1(\.\d)?$|^2$`},
+		{"string.numeric(4)", `^\d{4}$`},
+		{"date.past", `^2025-\d\d-\d\dT.*Z$|^2026-01-01T00:00:00Z$`},
+		{"date.future(2)", `^202[678]-`},
+		{"date.between('2020-01-01', '2020-02-01')", `^2020-01-`},
+		{"date.birthdate(30, 30)", `^199[56]-`},
+		{"location.zipCode", `^\d{5}$`},
+		{"location.countryCode", `^ES$`},
+		{"internet.email", `^[a-z0-9._]+@[a-z.]+$`},
+		{"datatype.boolean(1)", `^true$`},
+	}
+	for _, tt := range tests {
+		v, err := f.Generate(tt.expr)
+		if err != nil {
+			t.Errorf("%s: %v", tt.expr, err)
+			continue
+		}
+		for range 20 {
+			if s := fmt.Sprint(v); !regexp.MustCompile(tt.match).MatchString(s) {
+				t.Errorf("%s = %q, want match for %s", tt.expr, s, tt.match)
+				break
+			}
+			v, _ = f.Generate(tt.expr)
+		}
+	}
+
+	for _, bad := range []string{
+		"person.nope", "number.int(a)", "date.between(1)", "number.int(1",
+		"number.float(2, 1)", "date.between(2020-02-01, 2020-01-01)", "date.birthdate(60, 20)",
+	} {
+		if _, err := f.Generate(bad); err == nil {
+			t.Errorf("%s: expected error", bad)
+		}
+	}
+	if _, err := f.Generate("number.int(10, 5)"); err == nil || err.Error() != "number.int: min 10 is greater than max 5" {
+		t.Errorf("number.int(10, 5): error = %v", err)
+	}
+}
+
+func TestPersonConsistency(t *testing.T) {
+	f, _ := New(3, "fr_FR", ref)
+	for range 10 {
+		f.NextRecord()
+		first, _ := f.Generate("person.firstName")
+		full, _ := f.Generate("person.fullName")
+		email, _ := f.Generate("internet.email")
+		if !strings.HasPrefix(full.(string), first.(string)+" ") {
+			t.Errorf("full name %q does not start with %q", full, first)
+		}
+		if local := strings.Split(email.(string), "@")[0]; !strings.Contains(local, asciiFold(first.(string))[:1]) {
+			t.Errorf("e-mail %q does not match %q", email, full)
+		}
+	}
+}
+
+func TestPatternAndText(t *testing.T) {
+	f, _ := New(9, "", ref)
+	for range 20 {
+		s, err := f.Pattern(`^[A-Z]{2}\d{3}$`)
+		if err != nil || !regexp.MustCompile(`^[A-Z]{2}\d{3}$`).MatchString(s) {
+			t.Fatalf("Pattern = %q, %v", s, err)
+		}
+		if txt := f.Text(5, 12); len(txt) < 5 || len(txt) > 12 {
+			t.Fatalf("Text(5, 12) = %q", txt)
+		}
+	}
+	if _, err := f.Pattern("(["); err == nil {
+		t.Error("expected invalid pattern error")
+	}
+	if got := asciiFold("Müller-Straße Ñ"); got != "mullerstrassen" {
+		t.Errorf("asciiFold = %q", got)
+	}
+}
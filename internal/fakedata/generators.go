@@ -0,0 +1,389 @@
+package fakedata
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp/syntax"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// generator produces one value from its (possibly empty) arguments.
+type generator func(f *Faker, args []string) (any, error)
+
+// generators are addressed as "category.method", with optional arguments in
+// parentheses: "number.int(18, 65)".
+var generators = map[string]generator{
+	"person.firstName": func(f *Faker, args []string) (any, error) {
+		if len(args) > 0 {
+			return f.firstName(strings.ToLower(args[0])), nil
+		}
+		return f.currentPerson().first, nil
+	},
+	"person.lastName": func(f *Faker, _ []string) (any, error) { return f.currentPerson().last, nil },
+	"person.fullName": func(f *Faker, _ []string) (any, error) { return f.currentPerson().full, nil },
+	"person.name":     func(f *Faker, _ []string) (any, error) { return f.currentPerson().full, nil },
+	"person.sex":      func(f *Faker, _ []string) (any, error) { return f.currentPerson().sex, nil },
+	"person.jobTitle": func(f *Faker, _ []string) (any, error) { return f.fk.JobTitle(), nil },
+
+	"internet.email": func(f *Faker, _ []string) (any, error) {
+		return f.userName() + "@" + f.pick(f.loc.EmailDomains), nil
+	},
+	"internet.userName":   func(f *Faker, _ []string) (any, error) { return f.userName(), nil },
+	"internet.domainName": func(f *Faker, _ []string) (any, error) { return f.domainName(), nil },
+	"internet.url": func(f *Faker, _ []string) (any, error) {
+		u := "https://www." + f.domainName()
+		if f.Chance(0.5) {
+			u += "/" + f.fk.LoremIpsumWord()
+		}
+		return u, nil
+	},
+	"internet.ipv4":      func(f *Faker, _ []string) (any, error) { return f.fk.IPv4Address(), nil },
+	"internet.ipv6":      func(f *Faker, _ []string) (any, error) { return f.fk.IPv6Address(), nil },
+	"internet.mac":       func(f *Faker, _ []string) (any, error) { return f.fk.MacAddress(), nil },
+	"internet.userAgent": func(f *Faker, _ []string) (any, error) { return f.fk.UserAgent(), nil },
+	"internet.password": func(f *Faker, args []string) (any, error) {
+		n, err := countArg(args, 0, 16)
+		if err != nil {
+			return nil, err
+		}
+		return f.fk.Password(true, true, true, true, false, int(n)), nil
+	},
+
+	"location.street":         func(f *Faker, _ []string) (any, error) { return f.pick(f.loc.Streets), nil },
+	"location.buildingNumber": func(f *Faker, _ []string) (any, error) { return f.buildingNumber(), nil },
+	"location.streetAddress":  func(f *Faker, _ []string) (any, error) { return f.streetAddress(), nil },
+	"location.city":           func(f *Faker, _ []string) (any, error) { return f.pick(f.loc.Cities), nil },
+	"location.state":          func(f *Faker, _ []string) (any, error) { return f.region()[0], nil },
+	"location.stateAbbr":      func(f *Faker, _ []string) (any, error) { return f.region()[1], nil },
+	"location.zipCode":        func(f *Faker, _ []string) (any, error) { return f.fill(f.loc.Postcode), nil },
+	"location.country":        func(f *Faker, _ []string) (any, error) { return f.loc.Country, nil },
+	"location.countryCode":    func(f *Faker, _ []string) (any, error) { return f.loc.CountryCode, nil },
+	"location.address": func(f *Faker, _ []string) (any, error) {
+		region := f.region()
+		return strings.NewReplacer(
+			"{street}", f.streetAddress(),
+			"{city}", f.pick(f.loc.Cities),
+			"{region}", region[0],
+			"{regionCode}", region[1],
+			"{postcode}", f.fill(f.loc.Postcode),
+		).Replace(f.loc.AddressFormat), nil
+	},
+	"location.latitude":  func(f *Faker, _ []string) (any, error) { return round(f.Float(-90, 90), 6), nil },
+	"location.longitude": func(f *Faker, _ []string) (any, error) { return round(f.Float(-180, 180), 6), nil },
+
+	"phone.number": func(f *Faker, _ []string) (any, error) { return f.fill(f.pick(f.loc.PhoneFormats)), nil },
+
+	"company.name": func(f *Faker, _ []string) (any, error) {
+		name := f.pick(f.loc.LastNames)
+		if f.Chance(0.3) {
+			name += " & " + f.pick(f.loc.LastNames)
+		}
+		return name + " " + f.pick(f.loc.CompanySuffixes), nil
+	},
+	"company.catchPhrase": func(f *Faker, _ []string) (any, error) { return f.fk.Slogan(), nil },
+
+	"commerce.productName": func(f *Faker, _ []string) (any, error) { return f.fk.ProductName(), nil },
+	"commerce.department":  func(f *Faker, _ []string) (any, error) { return f.fk.ProductCategory(), nil },
+	"commerce.price":       amount,
+
+	"finance.amount":           amount,
+	"finance.currencyCode":     func(f *Faker, _ []string) (any, error) { return f.fk.CurrencyShort(), nil },
+	"finance.localCurrency":    func(f *Faker, _ []string) (any, error) { return f.loc.Currency, nil },
+	"finance.creditCardNumber": func(f *Faker, _ []string) (any, error) { return f.fk.CreditCardNumber(nil), nil },
+	"finance.bitcoinAddress":   func(f *Faker, _ []string) (any, error) { return f.fk.BitcoinAddress(), nil },
+
+	"date.past": func(f *Faker, args []string) (any, error) {
+		years, err := intArg(args, 0, 1)
+		return f.Time(f.ref.AddDate(-int(years), 0, 0), f.ref).Format(time.RFC3339), err
+	},
+	"date.future": func(f *Faker, args []string) (any, error) {
+		years, err := intArg(args, 0, 1)
+		return f.Time(f.ref.Add(time.Second), f.ref.AddDate(int(years), 0, 0)).Format(time.RFC3339), err
+	},
+	"date.recent": func(f *Faker, args []string) (any, error) {
+		days, err := intArg(args, 0, 1)
+		return f.Time(f.ref.AddDate(0, 0, -int(days)), f.ref).Format(time.RFC3339), err
+	},
+	"date.soon": func(f *Faker, args []string) (any, error) {
+		days, err := intArg(args, 0, 1)
+		return f.Time(f.ref.Add(time.Second), f.ref.AddDate(0, 0, int(days))).Format(time.RFC3339), err
+	},
+	"date.between": func(f *Faker, args []string) (any, error) {
+		if len(args) != 2 {
+			return nil, errors.New("expected two dates, as in date.between(2020-01-01, 2020-12-31)")
+		}
+		from, err1 := parseDate(args[0])
+		to, err2 := parseDate(args[1])
+		if err := errors.Join(err1, err2); err != nil {
+			return nil, err
+		}
+		if from.After(to) {
+			return nil, fmt.Errorf("start %s is after end %s", args[0], args[1])
+		}
+		return f.Time(from, to).Format(time.RFC3339), nil
+	},
+	"date.birthdate": func(f *Faker, args []string) (any, error) {
+		lo, err1 := intArg(args, 0, 18)
+		hi, err2 := intArg(args, 1, 80)
+		if err := errors.Join(err1, err2); err != nil {
+			return nil, err
+		}
+		if lo > hi {
+			return nil, fmt.Errorf("min age %d is greater than max age %d", lo, hi)
+		}
+		return f.Time(f.ref.AddDate(-int(hi)-1, 0, 1), f.ref.AddDate(-int(lo), 0, 0)).Format(time.DateOnly), nil
+	},
+
+	"number.int": func(f *Faker, args []string) (any, error) {
+		lo, err1 := intArg(args, 0, 0)
+		hi, err2 := intArg(args, 1, 10000)
+		if err := errors.Join(err1, err2); err != nil {
+			return nil, err
+		}
+		if lo > hi {
+			return nil, fmt.Errorf("min %d is greater than max %d", lo, hi)
+		}
+		return f.Int(lo, hi), nil
+	},
+	"number.float": func(f *Faker, args []string) (any, error) {
+		lo, err1 := floatArg(args, 0, 0)
+		hi, err2 := floatArg(args, 1, 1000)
+		dec, err3 := intArg(args, 2, 2)
+		if err := errors.Join(err1, err2, err3); err != nil {
+			return nil, err
+		}
+		if lo > hi {
+			return nil, fmt.Errorf("min %g is greater than max %g", lo, hi)
+		}
+		return round(f.Float(lo, hi), int(dec)), nil
+	},
+	"datatype.boolean": func(f *Faker, args []string) (any, error) {
+		p, err := floatArg(args, 0, 0.5)
+		return f.Chance(p), err
+	},
+
+	"string.uuid":         func(f *Faker, _ []string) (any, error) { return f.fk.UUID(), nil },
+	"string.alpha":        chars("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ", 10),
+	"string.alphanumeric": chars("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789", 10),
+	"string.numeric":      chars("0123456789", 6),
+	"string.hexadecimal":  chars("0123456789abcdef", 8),
+
+	"lorem.word": func(f *Faker, _ []string) (any, error) { return f.fk.LoremIpsumWord(), nil },
+	"lorem.words": func(f *Faker, args []string) (any, error) {
+		n, err := countArg(args, 0, 3)
+		words := make([]string, max(n, 1))
+		for i := range words {
+			words[i] = f.fk.LoremIpsumWord()
+		}
+		return strings.Join(words, " "), err
+	},
+	"lorem.sentence": func(f *Faker, args []string) (any, error) {
+		n, err := countArg(args, 0, 8)
+		return f.fk.LoremIpsumSentence(int(max(n, 1))), err
+	},
+	"lorem.paragraph": func(f *Faker, args []string) (any, error) {
+		n, err := countArg(args, 0, 3)
+		return f.fk.LoremIpsumParagraph(1, int(max(n, 1)), 8, ""), err
+	},
+
+	"color.hex":  func(f *Faker, _ []string) (any, error) { return strings.ToLower(f.fk.HexColor()), nil },
+	"color.name": func(f *Faker, _ []string) (any, error) { return f.fk.Color(), nil },
+}
+
+// Generators lists the generator names, sorted.
+func Generators() []string {
+	names := make([]string, 0, len(generators))
+	for name := range generators {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Generate evaluates a generator expression such as "person.fullName" or
+// "number.int(1, 10)". Arguments may be quoted with ' or ".
+func (f *Faker) Generate(expr string) (any, error) {
+	name, args, err := parseExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+	g, ok := generators[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown generator %q", name)
+	}
+	v, err := g(f, args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return v, nil
+}
+
+// IsGenerator reports whether expr names a known generator.
+func IsGenerator(expr string) bool {
+	name, _, err := parseExpr(expr)
+	return err == nil && generators[name] != nil
+}
+
+func parseExpr(expr string) (name string, args []string, err error) {
+	expr = strings.TrimSpace(expr)
+	name, rest, hasArgs := strings.Cut(expr, "(")
+	name = strings.TrimSpace(name)
+	if !hasArgs {
+		return name, nil, nil
+	}
+	rest = strings.TrimSpace(rest)
+	if !strings.HasSuffix(rest, ")") {
+		return "", nil, fmt.Errorf("%q: missing )", expr)
+	}
+	rest = strings.TrimSpace(strings.TrimSuffix(rest, ")"))
+	if rest == "" {
+		return name, nil, nil
+	}
+	for _, a := range strings.Split(rest, ",") {
+		a = strings.TrimSpace(a)
+		if len(a) >= 2 && (a[0] == '\'' || a[0] == '"') && a[len(a)-1] == a[0] {
+			a = a[1 : len(a)-1]
+		}
+		args = append(args, a)
+	}
+	return name, args, nil
+}
+
+// maxCount bounds length and count arguments such as string.alpha(n).
+const maxCount = 10000
+
+// countArg is intArg for lengths and counts.
+func countArg(args []string, i int, def int64) (int64, error) {
+	n, err := intArg(args, i, def)
+	if err == nil && (n < 0 || n > maxCount) {
+		return def, fmt.Errorf("argument %d must be between 0 and %d", i+1, maxCount)
+	}
+	return n, err
+}
+
+func intArg(args []string, i int, def int64) (int64, error) {
+	if i >= len(args) || args[i] == "" {
+		return def, nil
+	}
+	n, err := strconv.ParseInt(args[i], 10, 64)
+	if err != nil {
+		return def, fmt.Errorf("argument %d: %q is not an integer", i+1, args[i])
+	}
+	return n, nil
+}
+
+func floatArg(args []string, i int, def float64) (float64, error) {
+	if i >= len(args) || args[i] == "" {
+		return def, nil
+	}
+	n, err := strconv.ParseFloat(args[i], 64)
+	if err != nil {
+		return def, fmt.Errorf("argument %d: %q is not a number", i+1, args[i])
+	}
+	return n, nil
+}
+
+func parseDate(s string) (time.Time, error) {
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not a date (YYYY-MM-DD or RFC 3339)", s)
+	}
+	return t, nil
+}
+
+func amount(f *Faker, args []string) (any, error) {
+	lo, err1 := floatArg(args, 0, 1)
+	hi, err2 := floatArg(args, 1, 1000)
+	return round(f.Float(lo, hi), 2), errors.Join(err1, err2)
+}
+
+func chars(set string, defLen int64) generator {
+	return func(f *Faker, args []string) (any, error) {
+		n, err := countArg(args, 0, defLen)
+		b := make([]byte, max(n, 0))
+		for i := range b {
+			b[i] = set[f.Intn(len(set))]
+		}
+		return string(b), err
+	}
+}
+
+func round(v float64, decimals int) float64 {
+	p := math.Pow(10, float64(decimals))
+	return math.Round(v*p) / p
+}
+
+// Pattern returns a string matching the regular expression.
+func (f *Faker) Pattern(re string) (string, error) {
+	if _, err := syntax.Parse(re, syntax.Perl); err != nil {
+		return "", fmt.Errorf("invalid pattern %q: %w", re, err)
+	}
+	return f.fk.Regex(re), nil
+}
+
+func (f *Faker) region() [2]string {
+	if len(f.loc.Regions) == 0 {
+		return [2]string{}
+	}
+	return f.loc.Regions[f.Intn(len(f.loc.Regions))]
+}
+
+func (f *Faker) buildingNumber() string {
+	n := strings.TrimLeft(f.fill(f.loc.BuildingNumber), "0")
+	if n == "" {
+		n = "1"
+	}
+	return n
+}
+
+func (f *Faker) streetAddress() string {
+	return strings.NewReplacer("{street}", f.pick(f.loc.Streets), "{number}", f.buildingNumber()).
+		Replace(f.fill(f.pick(f.loc.StreetFormats)))
+}
+
+// userName derives a login from the current person's name.
+func (f *Faker) userName() string {
+	p := f.currentPerson()
+	first, last := asciiFold(p.first), asciiFold(p.last)
+	switch f.Intn(4) {
+	case 0:
+		return first + "." + last
+	case 1:
+		return first + "_" + last
+	case 2:
+		return first[:1] + last
+	}
+	return first + last + strconv.Itoa(f.Intn(100))
+}
+
+func (f *Faker) domainName() string {
+	return asciiFold(f.pick(f.loc.LastNames)) + "." + f.pick(f.loc.TLDs)
+}
+
+// asciiFold lower-cases s and strips accents and anything else that does
+// not belong in an e-mail local part or host name.
+func asciiFold(s string) string {
+	var b strings.Builder
+	for _, r := range norm.NFD.String(strings.ToLower(s)) {
+		switch {
+		case r == 'ß':
+			b.WriteString("ss")
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			b.WriteRune(r)
+		}
+	}
+	if b.Len() == 0 {
+		return "user"
+	}
+	return b.String()
+}
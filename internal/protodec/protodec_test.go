@@ -0,0 +1,291 @@
+package protodec
+
+import (
+	"context"
+	"encoding/base64"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const orderProto = `syntax = "proto3";
+package shop.v1;
+
+import "google/protobuf/timestamp.proto";
+
+message Order {
+  message Item {
+    string sku = 1;
+    int32 qty = 2;
+  }
+  int64 id = 1;
+  string customer = 2;
+  repeated Item items = 3;
+  sint32 delta = 4;
+  map<string, string> labels = 5;
+  google.protobuf.Timestamp placed = 6;
+}
+
+message Refund {
+  int64 order_id = 1;
+}
+`
+
+// orderBytes encodes Order{id: 150, customer: "Ada", items: [{sku: "AB-1",
+// qty: 2}], delta: -3}.
+func orderBytes() []byte {
+	var item []byte
+	item = protowire.AppendTag(item, 1, protowire.BytesType)
+	item = protowire.AppendString(item, "AB-1")
+	item = protowire.AppendTag(item, 2, protowire.VarintType)
+	item = protowire.AppendVarint(item, 2)
+
+	var b []byte
+	b = protowire.AppendTag(b, 1, protowire.VarintType)
+	b = protowire.AppendVarint(b, 150)
+	b = protowire.AppendTag(b, 2, protowire.BytesType)
+	b = protowire.AppendString(b, "Ada")
+	b = protowire.AppendTag(b, 3, protowire.BytesType)
+	b = protowire.AppendBytes(b, item)
+	b = protowire.AppendTag(b, 4, protowire.VarintType)
+	b = protowire.AppendVarint(b, protowire.EncodeZigZag(-3))
+	return b
+}
+
+func TestDecode(t *testing.T) {
+	fields, err := Decode(orderBytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fields) != 4 {
+		t.Fatalf("got %d fields", len(fields))
+	}
+	if f := fields[0]; f.Number != 1 || f.Kind != KindVarint || f.Value != "150" || f.Offset != 0 || f.Length != 3 {
+		t.Errorf("varint: %+v", f)
+	}
+	if f := fields[1]; f.Kind != KindString || f.Value != `"Ada"` {
+		t.Errorf("string: %+v", f)
+	}
+	item := fields[2]
+	if item.Kind != KindMessage || len(item.Fields) != 2 || item.Fields[0].Value != `"AB-1"` || item.Fields[1].Offset != 16 {
+		t.Errorf("nested message: %+v", item)
+	}
+	if f := fields[3]; !slices.Contains(f.Interpretations, "sint64: -3") {
+		t.Errorf("zigzag: %+v", f)
+	}
+
+	var b []byte
+	b = protowire.AppendTag(b, 1, protowire.Fixed32Type)
+	b = protowire.AppendFixed32(b, math.Float32bits(1.5))
+	b = protowire.AppendTag(b, 2, protowire.Fixed64Type)
+	b = protowire.AppendFixed64(b, math.Float64bits(-2))
+	b = protowire.AppendTag(b, 3, protowire.BytesType)
+	b = protowire.AppendBytes(b, []byte{0xff, 0x01, 0x96, 0x01})
+	b = protowire.AppendTag(b, 4, protowire.StartGroupType)
+	b = protowire.AppendTag(b, 5, protowire.VarintType)
+	b = protowire.AppendVarint(b, 1)
+	b = protowire.AppendTag(b, 4, protowire.EndGroupType)
+	b = protowire.AppendTag(b, 5, protowire.BytesType)
+	b = protowire.AppendString(b, "hi")
+	fields, err = Decode(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		kind   Kind
+		interp string
+	}{
+		{KindFixed32, "float: 1.5"},
+		{KindFixed64, "double: -2"},
+		{KindBytes, "packed varints: 255, 150"},
+		{KindGroup, ""},
+		{KindString, "message: 1 fields"},
+	}
+	for i, w := range want {
+		f := fields[i]
+		if f.Kind != w.kind || (w.interp != "" && !slices.Contains(f.Interpretations, w.interp)) {
+			t.Errorf("field %d: %+v, want %s with %q", f.Number, f, w.kind, w.interp)
+		}
+	}
+	if g := fields[3]; len(g.Fields) != 1 || !slices.Contains(g.Fields[0].Interpretations, "bool: true") {
+		t.Errorf("group: %+v", g)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	deep := protowire.AppendTag(nil, 1, protowire.VarintType)
+	deep = protowire.AppendVarint(deep, 1)
+	for range maxDepth + 2 {
+		deep = protowire.AppendBytes(protowire.AppendTag(nil, 1, protowire.BytesType), deep)
+	}
+	tests := []struct {
+		name   string
+		b      []byte
+		offset int
+		msg    string
+	}{
+		{"field zero", []byte{0x00, 0x01}, 0, "invalid tag"},
+		{"truncated length", []byte{0x08, 0x01, 0x12, 0x05, 'a'}, 2, "field 2 (LEN)"},
+		{"stray end group", []byte{0x0c}, 0, "end of group 1 without a start"},
+		{"unknown wire type", []byte{0x0e}, 0, "unknown wire type 6"},
+	}
+	for _, tt := range tests {
+		_, err := Decode(tt.b)
+		e, ok := err.(*Error)
+		if !ok || e.Offset != tt.offset || !strings.Contains(e.Msg, tt.msg) {
+			t.Errorf("%s: got %v, want offset %d %q", tt.name, err, tt.offset, tt.msg)
+		}
+	}
+	// Payloads nested past the limit are not decoded as messages.
+	fields, err := Decode(deep)
+	if err != nil {
+		t.Fatal(err)
+	}
+	depth := 0
+	for f := &fields[0]; f.Kind == KindMessage; f = &f.Fields[0] {
+		depth++
+	}
+	if depth != maxDepth {
+		t.Errorf("decoded %d nested messages, want %d", depth, maxDepth)
+	}
+}
+
+func TestStripGRPCFrame(t *testing.T) {
+	msg := orderBytes()
+	framed := append([]byte{0, 0, 0, 0, byte(len(msg))}, msg...)
+	if got, ok := StripGRPCFrame(framed); !ok || string(got) != string(msg) {
+		t.Errorf("framed: %v %x", ok, got)
+	}
+	if _, ok := StripGRPCFrame(msg); ok {
+		t.Error("unframed payload was stripped")
+	}
+}
+
+func TestDecodeInput(t *testing.T) {
+	for in, enc := range map[string]string{
+		"08 96 01":    "",
+		"0x08:96:01":  "hex",
+		"CJYB":        "",
+		"CJYB\n":      "base64",
+		"CJYB==":      "",
+		"-_8":         "",
+		"089601":      "hex",
+		"\t08\n9601 ": "",
+	} {
+		b, err := DecodeInput(in, enc)
+		if err != nil {
+			t.Errorf("%q: %v", in, err)
+			continue
+		}
+		want := []byte{0x08, 0x96, 0x01}
+		if in == "-_8" {
+			want = []byte{0xfb, 0xff}
+		}
+		if string(b) != string(want) {
+			t.Errorf("%q: got %x", in, b)
+		}
+	}
+	if _, err := DecodeInput("zz!", ""); err == nil {
+		t.Error("garbage input accepted")
+	}
+	if _, err := DecodeInput("08", "ascii85"); err == nil {
+		t.Error("unknown encoding accepted")
+	}
+}
+
+func TestSchema(t *testing.T) {
+	s, err := LoadSchema(context.Background(), orderProto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(s.MessageNames(), " "); got != "shop.v1.Order shop.v1.Order.Item shop.v1.Refund" {
+		t.Errorf("messages: %s", got)
+	}
+	md, err := s.Message("Order")
+	if err != nil {
+		t.Fatal(err)
+	}
+	js, unknown, err := s.ToJSON(md, orderBytes())
+	if err != nil || unknown {
+		t.Fatalf("ToJSON: %v unknown=%v", err, unknown)
+	}
+	compact := strings.Join(strings.Fields(string(js)), "")
+	if want := `{"id":"150","customer":"Ada","items":[{"sku":"AB-1","qty":2}],"delta":-3}`; compact != want {
+		t.Errorf("json: %s", compact)
+	}
+	b, err := s.FromJSON(md, js)
+	if err != nil || string(b) != string(orderBytes()) {
+		t.Errorf("round trip: %x, %v", b, err)
+	}
+
+	b, err = s.FromJSON(md, []byte(`{"labels": {"b": "2", "a": "1"}, "placed": "2024-05-01T10:00:00Z"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	js, _, _ = s.ToJSON(md, b)
+	if compact := strings.Join(strings.Fields(string(js)), ""); compact != `{"labels":{"a":"1","b":"2"},"placed":"2024-05-01T10:00:00Z"}` {
+		t.Errorf("map and timestamp: %s", compact)
+	}
+
+	refund, _ := s.Message("shop.v1.Refund")
+	if _, unknown, _ := s.ToJSON(refund, orderBytes()); !unknown {
+		t.Error("unknown fields not reported")
+	}
+	if _, err := s.FromJSON(md, []byte(`{"nope": 1}`)); err == nil {
+		t.Error("unknown JSON field accepted")
+	}
+}
+
+func TestSchemaLookup(t *testing.T) {
+	s, err := LoadSchema(context.Background(), `syntax = "proto3"; package a; message M {} message N { message M {} }`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, msg := range map[string]string{
+		"":      "choose a message type",
+		"M":     `"M" is ambiguous: a.M, a.N.M`,
+		"X":     `"X" is not declared`,
+		"N.M":   "",
+		".a.M":  "",
+		"a.N.M": "",
+	} {
+		_, err := s.Message(name)
+		if (msg == "") != (err == nil) || (err != nil && !strings.Contains(err.Error(), msg)) {
+			t.Errorf("%q: got %v, want %q", name, err, msg)
+		}
+	}
+
+	_, err = LoadSchema(context.Background(), "syntax = \"proto3\";\nmessage M { strin x = 1; }")
+	if err == nil || !strings.Contains(err.Error(), "input.proto:2:") {
+		t.Errorf("compile error: %v", err)
+	}
+}
+
+func TestSchemaFromDescriptorSet(t *testing.T) {
+	s, err := LoadSchema(context.Background(), orderProto)
+	if err != nil {
+		t.Fatal(err)
+	}
+	md, _ := s.Message("Order")
+	set := &descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{
+		protodesc.ToFileDescriptorProto(md.ParentFile().Imports().Get(0).FileDescriptor),
+		protodesc.ToFileDescriptorProto(md.ParentFile()),
+	}}
+	raw, err := proto.Marshal(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err = LoadSchema(context.Background(), base64.StdEncoding.EncodeToString(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(s.MessageNames()); got != 3 {
+		t.Errorf("messages: %v", s.MessageNames())
+	}
+}
@@ -0,0 +1,209 @@
+package protodec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/bufbuild/protocompile"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// sourceName is the file name a pasted .proto source is compiled as; it
+// shows up in compiler error positions.
+const sourceName = "input.proto"
+
+// Schema is a set of message types loaded from a .proto source or a
+// FileDescriptorSet.
+type Schema struct {
+	files    *protoregistry.Files
+	types    *dynamicpb.Types
+	messages []protoreflect.MessageDescriptor
+}
+
+// LoadSchema reads src as a base64 or hex FileDescriptorSet (the output of
+// protoc --descriptor_set_out) when it decodes as one, and otherwise
+// compiles it as .proto source. The source may import the well-known types
+// but no other files.
+func LoadSchema(ctx context.Context, src string) (*Schema, error) {
+	if strings.TrimSpace(src) == "" {
+		return nil, errors.New("schema is empty")
+	}
+	var files *protoregistry.Files
+	if b, err := DecodeInput(src, ""); err == nil && len(b) > 0 {
+		set := &descriptorpb.FileDescriptorSet{}
+		if proto.Unmarshal(b, set) == nil && len(set.File) > 0 {
+			if files, err = protodesc.NewFiles(set); err != nil {
+				return nil, fmt.Errorf("invalid FileDescriptorSet: %w", err)
+			}
+		}
+	}
+	if files == nil {
+		compiled, err := compileSource(ctx, src)
+		if err != nil {
+			return nil, err
+		}
+		files = compiled
+	}
+
+	s := &Schema{files: files, types: dynamicpb.NewTypes(files)}
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		if !strings.HasPrefix(fd.Path(), "google/protobuf/") || files.NumFiles() == 1 {
+			s.addMessages(fd.Messages())
+		}
+		return true
+	})
+	if len(s.messages) == 0 {
+		return nil, errors.New("schema declares no message types")
+	}
+	slices.SortFunc(s.messages, func(a, b protoreflect.MessageDescriptor) int {
+		return strings.Compare(string(a.FullName()), string(b.FullName()))
+	})
+	return s, nil
+}
+
+func compileSource(ctx context.Context, src string) (*protoregistry.Files, error) {
+	c := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			Accessor: protocompile.SourceAccessorFromMap(map[string]string{sourceName: src}),
+		}),
+	}
+	result, err := c.Compile(ctx, sourceName)
+	if err != nil {
+		return nil, err
+	}
+	files := &protoregistry.Files{}
+	var register func(fd protoreflect.FileDescriptor) error
+	register = func(fd protoreflect.FileDescriptor) error {
+		if _, err := files.FindFileByPath(fd.Path()); err == nil {
+			return nil
+		}
+		imports := fd.Imports()
+		for i := range imports.Len() {
+			if err := register(imports.Get(i).FileDescriptor); err != nil {
+				return err
+			}
+		}
+		return files.RegisterFile(fd)
+	}
+	for _, fd := range result {
+		if err := register(fd); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+func (s *Schema) addMessages(msgs protoreflect.MessageDescriptors) {
+	for i := range msgs.Len() {
+		md := msgs.Get(i)
+		if md.IsMapEntry() {
+			continue
+		}
+		s.messages = append(s.messages, md)
+		s.addMessages(md.Messages())
+	}
+}
+
+// MessageNames lists the full names of the schema's message types, nested
+// ones included, in sorted order.
+func (s *Schema) MessageNames() []string {
+	names := make([]string, len(s.messages))
+	for i, md := range s.messages {
+		names[i] = string(md.FullName())
+	}
+	return names
+}
+
+// Message finds a message type by full name or by a trailing part of it
+// ("Order" or "Order.Item"). An empty name selects the only message of a
+// single-message schema.
+func (s *Schema) Message(name string) (protoreflect.MessageDescriptor, error) {
+	name = strings.TrimPrefix(strings.TrimSpace(name), ".")
+	if name == "" {
+		if len(s.messages) == 1 {
+			return s.messages[0], nil
+		}
+		return nil, fmt.Errorf("choose a message type: the schema declares %d", len(s.messages))
+	}
+	var found []protoreflect.MessageDescriptor
+	for _, md := range s.messages {
+		full := string(md.FullName())
+		if full == name {
+			return md, nil
+		}
+		if strings.HasSuffix(full, "."+name) {
+			found = append(found, md)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("message type %q is not declared", name)
+	case 1:
+		return found[0], nil
+	}
+	names := make([]string, len(found))
+	for i, md := range found {
+		names[i] = string(md.FullName())
+	}
+	return nil, fmt.Errorf("message type %q is ambiguous: %s", name, strings.Join(names, ", "))
+}
+
+// ToJSON decodes b as a message of type md and returns it as protojson.
+// unknown reports whether the payload held fields the schema does not
+// declare; they are left out of the JSON.
+func (s *Schema) ToJSON(md protoreflect.MessageDescriptor, b []byte) (out []byte, unknown bool, err error) {
+	msg := dynamicpb.NewMessage(md)
+	if err := (proto.UnmarshalOptions{Resolver: s.types}).Unmarshal(b, msg); err != nil {
+		return nil, false, err
+	}
+	out, err = protojson.MarshalOptions{Resolver: s.types}.Marshal(msg)
+	return out, hasUnknown(msg), err
+}
+
+// FromJSON parses protojson as a message of type md and encodes it with
+// deterministic map ordering.
+func (s *Schema) FromJSON(md protoreflect.MessageDescriptor, js []byte) ([]byte, error) {
+	msg := dynamicpb.NewMessage(md)
+	if err := (protojson.UnmarshalOptions{Resolver: s.types}).Unmarshal(js, msg); err != nil {
+		return nil, err
+	}
+	return proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+}
+
+func hasUnknown(m protoreflect.Message) bool {
+	if len(m.GetUnknown()) > 0 {
+		return true
+	}
+	found := false
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if k := fd.MapValue().Kind(); k == protoreflect.MessageKind || k == protoreflect.GroupKind {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					found = hasUnknown(mv.Message())
+					return !found
+				})
+			}
+		case fd.IsList():
+			if k := fd.Kind(); k == protoreflect.MessageKind || k == protoreflect.GroupKind {
+				list := v.List()
+				for i := 0; i < list.Len() && !found; i++ {
+					found = hasUnknown(list.Get(i).Message())
+				}
+			}
+		case fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind:
+			found = hasUnknown(v.Message())
+		}
+		return !found
+	})
+	return found
+}
@@ -0,0 +1,337 @@
+// Package protodec decodes Protocol Buffers wire-format payloads. Without a
+// schema it walks the bytes into a tree of field numbers and wire types and
+// offers every plausible reading of each value; with a .proto source or a
+// serialized FileDescriptorSet it converts between binary and protojson.
+package protodec
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	// maxDepth bounds message and group nesting; protoc uses 100.
+	maxDepth = 64
+	// maxFields bounds the fields decoded from one payload, so a large
+	// blob of bytes that happens to parse as messages stays cheap.
+	maxFields = 50000
+)
+
+// Kind is the interpretation chosen for a field's value.
+type Kind string
+
+const (
+	KindVarint  Kind = "varint"
+	KindFixed64 Kind = "fixed64"
+	KindFixed32 Kind = "fixed32"
+	KindMessage Kind = "message"
+	KindGroup   Kind = "group"
+	KindString  Kind = "string"
+	KindBytes   Kind = "bytes"
+)
+
+// Field is one field of a decoded message. Offset and Length locate the
+// whole field, tag included, in the decoded payload.
+type Field struct {
+	Number   int
+	WireType protowire.Type
+	Offset   int
+	Length   int
+	Kind     Kind
+	// Value is the chosen interpretation as text: a decimal number, a
+	// quoted string, hex bytes, or empty for messages and groups.
+	Value string
+	// Interpretations lists the other plausible readings, such as
+	// "sint64: -3" or "float: 1.5".
+	Interpretations []string
+	// Fields holds the children of a message or group.
+	Fields []Field
+
+	raw    []byte // payload of a length-delimited field
+	varint uint64
+}
+
+// Uint returns the value of a varint or fixed field as an unsigned number.
+func (f *Field) Uint() uint64 { return f.varint }
+
+// Bytes returns the payload of a length-delimited field.
+func (f *Field) Bytes() []byte { return f.raw }
+
+// Error reports malformed wire data at a byte offset.
+type Error struct {
+	Offset int
+	Msg    string
+}
+
+func (e *Error) Error() string { return fmt.Sprintf("offset %d: %s", e.Offset, e.Msg) }
+
+// WireTypeName returns the name the protobuf encoding spec uses for t.
+func WireTypeName(t protowire.Type) string {
+	switch t {
+	case protowire.VarintType:
+		return "VARINT"
+	case protowire.Fixed64Type:
+		return "I64"
+	case protowire.BytesType:
+		return "LEN"
+	case protowire.StartGroupType:
+		return "SGROUP"
+	case protowire.EndGroupType:
+		return "EGROUP"
+	case protowire.Fixed32Type:
+		return "I32"
+	}
+	return fmt.Sprintf("wire type %d", t)
+}
+
+// Decode parses b as a message without a schema.
+func Decode(b []byte) ([]Field, error) {
+	d := &decoder{}
+	return d.message(b, 0, 0)
+}
+
+type decoder struct {
+	count int
+}
+
+func (d *decoder) message(b []byte, base, depth int) ([]Field, error) {
+	if depth > maxDepth {
+		return nil, &Error{base, fmt.Sprintf("messages nested deeper than %d levels", maxDepth)}
+	}
+	var fields []Field
+	for pos := 0; pos < len(b); {
+		if d.count++; d.count > maxFields {
+			return nil, &Error{base + pos, fmt.Sprintf("more than %d fields", maxFields)}
+		}
+		num, typ, n := protowire.ConsumeTag(b[pos:])
+		if n < 0 {
+			return nil, &Error{base + pos, "invalid tag: " + protowire.ParseError(n).Error()}
+		}
+		f := Field{Number: int(num), WireType: typ, Offset: base + pos}
+		body := b[pos+n:]
+		var m int
+		switch typ {
+		case protowire.VarintType:
+			f.varint, m = protowire.ConsumeVarint(body)
+			if m >= 0 {
+				describeVarint(&f)
+			}
+		case protowire.Fixed64Type:
+			f.varint, m = protowire.ConsumeFixed64(body)
+			if m >= 0 {
+				describeFixed64(&f)
+			}
+		case protowire.Fixed32Type:
+			var v uint32
+			v, m = protowire.ConsumeFixed32(body)
+			if m >= 0 {
+				f.varint = uint64(v)
+				describeFixed32(&f)
+			}
+		case protowire.BytesType:
+			f.raw, m = protowire.ConsumeBytes(body)
+			if m >= 0 {
+				d.describeBytes(&f, base+pos+n+m-len(f.raw), depth)
+			}
+		case protowire.StartGroupType:
+			var inner []byte
+			inner, m = protowire.ConsumeGroup(num, body)
+			if m >= 0 {
+				f.Kind = KindGroup
+				sub, err := d.message(inner, base+pos+n, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				f.Fields = sub
+			}
+		case protowire.EndGroupType:
+			return nil, &Error{base + pos, fmt.Sprintf("end of group %d without a start", num)}
+		default:
+			return nil, &Error{base + pos, fmt.Sprintf("field %d has unknown wire type %d", num, typ)}
+		}
+		if m < 0 {
+			return nil, &Error{base + pos, fmt.Sprintf("field %d (%s): %v", num, WireTypeName(typ), protowire.ParseError(m))}
+		}
+		f.Length = n + m
+		fields = append(fields, f)
+		pos += n + m
+	}
+	return fields, nil
+}
+
+func describeVarint(f *Field) {
+	v := f.varint
+	f.Kind, f.Value = KindVarint, strconv.FormatUint(v, 10)
+	if int64(v) < 0 {
+		f.Interpretations = append(f.Interpretations, "int64: "+strconv.FormatInt(int64(v), 10))
+		if v>>32 == math.MaxUint32 {
+			f.Interpretations = append(f.Interpretations, "int32: "+strconv.FormatInt(int64(int32(v)), 10))
+		}
+	}
+	f.Interpretations = append(f.Interpretations, "sint64: "+strconv.FormatInt(protowire.DecodeZigZag(v), 10))
+	if v <= 1 {
+		f.Interpretations = append(f.Interpretations, "bool: "+strconv.FormatBool(v == 1))
+	}
+}
+
+func describeFixed64(f *Field) {
+	v := f.varint
+	f.Kind, f.Value = KindFixed64, strconv.FormatUint(v, 10)
+	if int64(v) < 0 {
+		f.Interpretations = append(f.Interpretations, "sfixed64: "+strconv.FormatInt(int64(v), 10))
+	}
+	f.Interpretations = append(f.Interpretations, "double: "+strconv.FormatFloat(math.Float64frombits(v), 'g', -1, 64))
+}
+
+func describeFixed32(f *Field) {
+	v := uint32(f.varint)
+	f.Kind, f.Value = KindFixed32, strconv.FormatUint(uint64(v), 10)
+	if int32(v) < 0 {
+		f.Interpretations = append(f.Interpretations, "sfixed32: "+strconv.FormatInt(int64(int32(v)), 10))
+	}
+	f.Interpretations = append(f.Interpretations, "float: "+strconv.FormatFloat(float64(math.Float32frombits(v)), 'g', -1, 32))
+}
+
+// describeBytes guesses what a length-delimited payload holds. A payload
+// that parses as a message is taken as one unless it reads as ordinary
+// text: short strings often happen to be valid wire data ("hi" is field 13
+// with varint 105), while a message whose first tag is a printable byte and
+// whose every byte is printable is rare.
+func (d *decoder) describeBytes(f *Field, start, depth int) {
+	raw := f.raw
+	if len(raw) == 0 {
+		f.Kind, f.Value = KindString, `""`
+		f.Interpretations = []string{"empty message", "empty bytes"}
+		return
+	}
+	saved := d.count
+	sub, err := d.message(raw, start, depth+1)
+	isMessage := err == nil
+	text := printable(raw)
+	switch {
+	case isMessage && !(text && raw[0] >= 0x20):
+		f.Kind, f.Fields = KindMessage, sub
+		if text {
+			f.Interpretations = append(f.Interpretations, "string: "+strconv.Quote(string(raw)))
+		}
+		return
+	case text:
+		f.Kind, f.Value = KindString, strconv.Quote(string(raw))
+	default:
+		f.Kind, f.Value = KindBytes, hex.EncodeToString(raw)
+	}
+	d.count = saved
+	if isMessage {
+		f.Interpretations = append(f.Interpretations, fmt.Sprintf("message: %d fields", len(sub)))
+	}
+	if f.Kind == KindString {
+		f.Interpretations = append(f.Interpretations, "bytes: "+hex.EncodeToString(raw))
+	} else if vals, ok := packedVarints(raw); ok && len(vals) > 1 {
+		f.Interpretations = append(f.Interpretations, "packed varints: "+strings.Join(vals, ", "))
+	}
+}
+
+// printable reports whether b is UTF-8 text without control characters
+// other than tab, newline and carriage return.
+func printable(b []byte) bool {
+	if !utf8.Valid(b) {
+		return false
+	}
+	for _, r := range string(b) {
+		if r != '\t' && r != '\n' && r != '\r' && !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func packedVarints(b []byte) ([]string, bool) {
+	var vals []string
+	for len(b) > 0 {
+		v, n := protowire.ConsumeVarint(b)
+		if n < 0 {
+			return nil, false
+		}
+		vals = append(vals, strconv.FormatUint(v, 10))
+		b = b[n:]
+	}
+	return vals, true
+}
+
+// StripGRPCFrame removes the 5-byte gRPC length-prefixed message header
+// when b starts with one: an uncompressed flag byte of 0 followed by the
+// big-endian length of the rest. A protobuf message never starts with a
+// zero byte, since field number 0 is invalid.
+func StripGRPCFrame(b []byte) ([]byte, bool) {
+	if len(b) >= 5 && b[0] == 0 && int(binary.BigEndian.Uint32(b[1:5])) == len(b)-5 {
+		return b[5:], true
+	}
+	return b, false
+}
+
+// DecodeInput turns pasted text into bytes. encoding is "base64" (standard
+// or URL alphabet, padding optional), "hex" (spaces, colons and a 0x prefix
+// allowed) or empty to detect: text made only of an even number of hex
+// digits is read as hex, anything else as base64.
+func DecodeInput(s, encoding string) ([]byte, error) {
+	switch encoding {
+	case "hex":
+		return decodeHex(s)
+	case "base64":
+		return decodeBase64(s)
+	case "":
+		if b, err := decodeHex(s); err == nil {
+			return b, nil
+		}
+		b, err := decodeBase64(s)
+		if err != nil {
+			return nil, errors.New("input is neither hex nor base64")
+		}
+		return b, nil
+	}
+	return nil, fmt.Errorf("unknown encoding %q; use base64 or hex", encoding)
+}
+
+func decodeHex(s string) ([]byte, error) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	s = strings.Map(func(r rune) rune {
+		if r == ':' || unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex: %w", err)
+	}
+	return b, nil
+}
+
+func decodeBase64(s string) ([]byte, error) {
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, s)
+	s = strings.TrimRight(s, "=")
+	if strings.ContainsAny(s, "-_") {
+		return base64.RawURLEncoding.DecodeString(s)
+	}
+	b, err := base64.RawStdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64: %w", err)
+	}
+	return b, nil
+}
@@ -0,0 +1,62 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/itchyny/gojq"
+)
+
+const (
+	// jqTimeout bounds evaluation so runaway programs such as range(1e12)
+	// or unbounded recursion fail instead of hanging the server.
+	jqTimeout = time.Second
+	// maxJQOutputs bounds the number of results collected by JQ.
+	maxJQOutputs = 100_000
+)
+
+// JQ evaluates a jq program against doc and returns every output in order.
+// Syntax errors are reported as *Error with the offending position.
+func JQ(expr string, doc any) ([]any, error) {
+	q, err := gojq.Parse(expr)
+	if err != nil {
+		var pe *gojq.ParseError
+		if errors.As(err, &pe) {
+			return nil, &Error{Pos: max(pe.Offset-len(pe.Token), 0), Msg: pe.Error()}
+		}
+		return nil, err
+	}
+	// Without options the program cannot read the environment, input
+	// streams or modules from disk.
+	code, err := gojq.Compile(q)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), jqTimeout)
+	defer cancel()
+	iter := code.RunWithContext(ctx, doc)
+	var results []any
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			return results, nil
+		}
+		if err, ok := v.(error); ok {
+			var he *gojq.HaltError
+			switch {
+			case errors.Is(err, context.DeadlineExceeded):
+				return results, fmt.Errorf("evaluation limit exceeded (%s)", jqTimeout)
+			case errors.As(err, &he) && he.Value() == nil:
+				return results, nil // halt
+			}
+			return results, err
+		}
+		results = append(results, Normalize(v))
+		if len(results) > maxJQOutputs {
+			return results, fmt.Errorf("too many results (limit %d)", maxJQOutputs)
+		}
+	}
+}
@@ -0,0 +1,994 @@
+package query
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Node is one JSONPath match: the value and its normalized path, e.g.
+// $['store']['book'][0].
+type Node struct {
+	Path  string
+	Value any
+}
+
+// JSONPath evaluates an RFC 9535 query against doc and returns the matched
+// nodes in document order.
+func JSONPath(expr string, doc any) ([]Node, error) {
+	p := &jpParser{src: expr}
+	q, err := p.parseQuery()
+	if err != nil {
+		return nil, err
+	}
+	return q.eval(doc, []Node{{Path: "$", Value: doc}}), nil
+}
+
+// ── AST ──────────────────────────────────────────────────────────────────────
+
+type jpQuery struct {
+	pos      int
+	relative bool // @-rooted (filter) query
+	segments []jpSegment
+}
+
+type jpSegment struct {
+	descendant bool
+	selectors  []jpSelector
+}
+
+type jpSelectorKind int
+
+const (
+	selName jpSelectorKind = iota
+	selWildcard
+	selIndex
+	selSlice
+	selFilter
+)
+
+type jpSelector struct {
+	kind             jpSelectorKind
+	name             string
+	index            int
+	start, end, step *int
+	filter           jpExpr
+}
+
+// jpExpr is a logical filter expression.
+type jpExpr interface {
+	test(root any, cur any) bool
+}
+
+type jpOr struct{ l, r jpExpr }
+type jpAnd struct{ l, r jpExpr }
+type jpNot struct{ e jpExpr }
+
+// jpExists is a test expression: a query that selects at least one node, or
+// a logical function call.
+type jpExists struct {
+	q  *jpQuery
+	fn *jpFunc
+}
+
+type jpCompare struct {
+	op   string
+	l, r jpComparable
+}
+
+// jpComparable yields a single value or Nothing.
+type jpComparable interface {
+	value(root, cur any) (any, bool)
+}
+
+type jpLiteral struct{ v any }
+
+type jpFunc struct {
+	pos  int
+	name string
+	args []any // *jpQuery, jpComparable or jpExpr
+}
+
+func (e jpOr) test(root, cur any) bool  { return e.l.test(root, cur) || e.r.test(root, cur) }
+func (e jpAnd) test(root, cur any) bool { return e.l.test(root, cur) && e.r.test(root, cur) }
+func (e jpNot) test(root, cur any) bool { return !e.e.test(root, cur) }
+
+func (e jpExists) test(root, cur any) bool {
+	if e.fn != nil {
+		v, _ := e.fn.value(root, cur)
+		b, _ := v.(bool)
+		return b
+	}
+	return len(e.q.nodes(root, cur)) > 0
+}
+
+func (e jpCompare) test(root, cur any) bool {
+	l, lok := e.l.value(root, cur)
+	r, rok := e.r.value(root, cur)
+	switch e.op {
+	case "==":
+		return jpEqual(l, lok, r, rok)
+	case "!=":
+		return !jpEqual(l, lok, r, rok)
+	case "<":
+		return jpLess(l, lok, r, rok)
+	case "<=":
+		return jpLess(l, lok, r, rok) || jpEqual(l, lok, r, rok)
+	case ">":
+		return jpLess(r, rok, l, lok)
+	case ">=":
+		return jpLess(r, rok, l, lok) || jpEqual(l, lok, r, rok)
+	}
+	return false
+}
+
+func jpEqual(l any, lok bool, r any, rok bool) bool {
+	if !lok || !rok {
+		return lok == rok
+	}
+	return reflectEqual(l, r)
+}
+
+func jpLess(l any, lok bool, r any, rok bool) bool {
+	if !lok || !rok {
+		return false
+	}
+	switch lv := l.(type) {
+	case float64:
+		rv, ok := r.(float64)
+		return ok && lv < rv
+	case string:
+		rv, ok := r.(string)
+		return ok && lv < rv
+	}
+	return false
+}
+
+// reflectEqual is deep JSON equality.
+func reflectEqual(a, b any) bool {
+	if typeName(a) != typeName(b) {
+		return false
+	}
+	return equalValues(a, b)
+}
+
+func (l jpLiteral) value(_, _ any) (any, bool) { return l.v, true }
+
+// value evaluates a singular query.
+func (q *jpQuery) value(root, cur any) (any, bool) {
+	nodes := q.nodes(root, cur)
+	if len(nodes) != 1 {
+		return nil, false
+	}
+	return nodes[0].Value, true
+}
+
+func (q *jpQuery) nodes(root, cur any) []Node {
+	start := root
+	path := "$"
+	if q.relative {
+		start = cur
+		path = "@"
+	}
+	return q.eval(root, []Node{{Path: path, Value: start}})
+}
+
+// singular reports whether the query can select at most one node.
+func (q *jpQuery) singular() bool {
+	for _, seg := range q.segments {
+		if seg.descendant || len(seg.selectors) != 1 {
+			return false
+		}
+		if k := seg.selectors[0].kind; k != selName && k != selIndex {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *jpFunc) value(root, cur any) (any, bool) {
+	switch f.name {
+	case "length":
+		v, ok := jpArgValue(f.args[0], root, cur)
+		if !ok {
+			return nil, false
+		}
+		switch val := v.(type) {
+		case string:
+			return float64(utf8.RuneCountInString(val)), true
+		case []any:
+			return float64(len(val)), true
+		case map[string]any:
+			return float64(len(val)), true
+		}
+		return nil, false
+	case "count":
+		return float64(len(f.args[0].(*jpQuery).nodes(root, cur))), true
+	case "value":
+		nodes := f.args[0].(*jpQuery).nodes(root, cur)
+		if len(nodes) != 1 {
+			return nil, false
+		}
+		return nodes[0].Value, true
+	case "match", "search":
+		s, ok1 := jpArgValue(f.args[0], root, cur)
+		pat, ok2 := jpArgValue(f.args[1], root, cur)
+		str, isStr := s.(string)
+		ps, isPat := pat.(string)
+		if !ok1 || !ok2 || !isStr || !isPat {
+			return false, true
+		}
+		re, err := compileIRegexp(ps, f.name == "match")
+		if err != nil {
+			return false, true
+		}
+		return re.MatchString(str), true
+	}
+	return nil, false
+}
+
+func jpArgValue(arg any, root, cur any) (any, bool) {
+	switch a := arg.(type) {
+	case jpComparable:
+		return a.value(root, cur)
+	case jpExpr:
+		return a.test(root, cur), true
+	}
+	return nil, false
+}
+
+// compileIRegexp compiles an RFC 9485 I-Regexp; in I-Regexp "." excludes
+// both \n and \r, which Go's default "." does not.
+func compileIRegexp(pattern string, full bool) (*regexp.Regexp, error) {
+	var b strings.Builder
+	inClass, escaped := false, false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			escaped = false
+			b.WriteRune(r)
+			continue
+		case r == '\\':
+			escaped = true
+		case r == '[':
+			inClass = true
+		case r == ']':
+			inClass = false
+		case r == '.' && !inClass:
+			b.WriteString(`[^\n\r]`)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	expr := b.String()
+	if full {
+		expr = `^(?:` + expr + `)$`
+	}
+	return regexp.Compile(expr)
+}
+
+// ── Evaluation ───────────────────────────────────────────────────────────────
+
+func (q *jpQuery) eval(root any, nodes []Node) []Node {
+	for _, seg := range q.segments {
+		var next []Node
+		for _, n := range nodes {
+			if seg.descendant {
+				walkDescendants(n, func(d Node) {
+					next = seg.apply(root, d, next)
+				})
+			} else {
+				next = seg.apply(root, n, next)
+			}
+		}
+		nodes = next
+	}
+	return nodes
+}
+
+// walkDescendants visits n and every node below it in document order.
+func walkDescendants(n Node, visit func(Node)) {
+	visit(n)
+	for _, child := range children(n) {
+		walkDescendants(child, visit)
+	}
+}
+
+func children(n Node) []Node {
+	switch v := n.Value.(type) {
+	case []any:
+		out := make([]Node, len(v))
+		for i, item := range v {
+			out[i] = Node{Path: n.Path + "[" + strconv.Itoa(i) + "]", Value: item}
+		}
+		return out
+	case map[string]any:
+		out := make([]Node, 0, len(v))
+		for _, k := range sortedKeys(v) {
+			out = append(out, Node{Path: n.Path + "[" + quotePathName(k) + "]", Value: v[k]})
+		}
+		return out
+	}
+	return nil
+}
+
+func (seg jpSegment) apply(root any, n Node, out []Node) []Node {
+	for _, sel := range seg.selectors {
+		switch sel.kind {
+		case selName:
+			if m, ok := n.Value.(map[string]any); ok {
+				if v, ok := m[sel.name]; ok {
+					out = append(out, Node{Path: n.Path + "[" + quotePathName(sel.name) + "]", Value: v})
+				}
+			}
+		case selWildcard:
+			out = append(out, children(n)...)
+		case selIndex:
+			if arr, ok := n.Value.([]any); ok {
+				i := sel.index
+				if i < 0 {
+					i += len(arr)
+				}
+				if i >= 0 && i < len(arr) {
+					out = append(out, Node{Path: n.Path + "[" + strconv.Itoa(i) + "]", Value: arr[i]})
+				}
+			}
+		case selSlice:
+			if arr, ok := n.Value.([]any); ok {
+				for _, i := range sliceIndices(len(arr), sel.start, sel.end, sel.step) {
+					out = append(out, Node{Path: n.Path + "[" + strconv.Itoa(i) + "]", Value: arr[i]})
+				}
+			}
+		case selFilter:
+			for _, child := range children(n) {
+				if sel.filter.test(root, child.Value) {
+					out = append(out, child)
+				}
+			}
+		}
+	}
+	return out
+}
+
+// sliceIndices implements the RFC 9535 array slice semantics.
+func sliceIndices(n int, start, end, step *int) []int {
+	st := 1
+	if step != nil {
+		st = *step
+	}
+	if st == 0 {
+		return nil
+	}
+	norm := func(i int) int {
+		if i < 0 {
+			return n + i
+		}
+		return i
+	}
+	var lo, hi int
+	if st > 0 {
+		s, e := 0, n
+		if start != nil {
+			s = norm(*start)
+		}
+		if end != nil {
+			e = norm(*end)
+		}
+		lo, hi = min(max(s, 0), n), min(max(e, 0), n)
+		var out []int
+		for i := lo; i < hi; i += st {
+			out = append(out, i)
+		}
+		return out
+	}
+	s, e := n-1, -n-1
+	if start != nil {
+		s = norm(*start)
+	}
+	if end != nil {
+		e = norm(*end)
+	}
+	hi, lo = min(max(s, -1), n-1), min(max(e, -1), n-1)
+	var out []int
+	for i := hi; lo < i; i += st {
+		out = append(out, i)
+	}
+	return out
+}
+
+// quotePathName renders a member name as a normalized-path string literal.
+func quotePathName(name string) string {
+	var b strings.Builder
+	b.WriteByte('\'')
+	for _, r := range name {
+		switch r {
+		case '\'':
+			b.WriteString(`\'`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				b.WriteString(`\u00`)
+				b.WriteString(strconv.FormatInt(int64(r)>>4, 16))
+				b.WriteString(strconv.FormatInt(int64(r)&0xf, 16))
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('\'')
+	return b.String()
+}
+
+// ── Parser ───────────────────────────────────────────────────────────────────
+
+type jpParser struct {
+	src string
+	pos int
+}
+
+func (p *jpParser) eof() bool { return p.pos >= len(p.src) }
+
+func (p *jpParser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *jpParser) skipSpace() {
+	for !p.eof() && strings.IndexByte(" \t\n\r", p.src[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+func (p *jpParser) consume(s string) bool {
+	if strings.HasPrefix(p.src[p.pos:], s) {
+		p.pos += len(s)
+		return true
+	}
+	return false
+}
+
+func (p *jpParser) expect(s string) error {
+	if !p.consume(s) {
+		return p.unexpected("expected %q", s)
+	}
+	return nil
+}
+
+func (p *jpParser) unexpected(format string, args ...any) error {
+	if p.eof() {
+		return errorf(p.pos, format+" but reached end of expression", args...)
+	}
+	r, _ := utf8.DecodeRuneInString(p.src[p.pos:])
+	return errorf(p.pos, format+", found %q", append(args, r)...)
+}
+
+func (p *jpParser) parseQuery() (*jpQuery, error) {
+	p.skipSpace()
+	if p.peek() != '$' {
+		return nil, p.unexpected("query must start with $")
+	}
+	q, err := p.parseSegments(false)
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if !p.eof() {
+		return nil, p.unexpected("unexpected trailing input")
+	}
+	return q, nil
+}
+
+// parseSegments parses $ or @ followed by any number of segments.
+func (p *jpParser) parseSegments(relative bool) (*jpQuery, error) {
+	q := &jpQuery{pos: p.pos, relative: relative}
+	p.pos++ // $ or @
+	for {
+		save := p.pos
+		p.skipSpace()
+		switch {
+		case p.consume(".."):
+			seg := jpSegment{descendant: true}
+			switch {
+			case p.peek() == '[':
+				sels, err := p.parseBracket()
+				if err != nil {
+					return nil, err
+				}
+				seg.selectors = sels
+			case p.consume("*"):
+				seg.selectors = []jpSelector{{kind: selWildcard}}
+			default:
+				name, err := p.parseMemberName()
+				if err != nil {
+					return nil, err
+				}
+				seg.selectors = []jpSelector{{kind: selName, name: name}}
+			}
+			q.segments = append(q.segments, seg)
+		case p.consume("."):
+			if p.consume("*") {
+				q.segments = append(q.segments, jpSegment{selectors: []jpSelector{{kind: selWildcard}}})
+				continue
+			}
+			name, err := p.parseMemberName()
+			if err != nil {
+				return nil, err
+			}
+			q.segments = append(q.segments, jpSegment{selectors: []jpSelector{{kind: selName, name: name}}})
+		case p.peek() == '[':
+			sels, err := p.parseBracket()
+			if err != nil {
+				return nil, err
+			}
+			q.segments = append(q.segments, jpSegment{selectors: sels})
+		default:
+			p.pos = save
+			return q, nil
+		}
+	}
+}
+
+func isNameFirst(r rune) bool {
+	return r == '_' || r >= 0x80 || (r < 0x80 && unicode.IsLetter(r))
+}
+
+func (p *jpParser) parseMemberName() (string, error) {
+	start := p.pos
+	for !p.eof() {
+		r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+		if isNameFirst(r) || (p.pos > start && r >= '0' && r <= '9') {
+			p.pos += size
+			continue
+		}
+		break
+	}
+	if p.pos == start {
+		return "", p.unexpected("expected member name")
+	}
+	return p.src[start:p.pos], nil
+}
+
+func (p *jpParser) parseBracket() ([]jpSelector, error) {
+	p.pos++ // [
+	var sels []jpSelector
+	for {
+		p.skipSpace()
+		sel, err := p.parseSelector()
+		if err != nil {
+			return nil, err
+		}
+		sels = append(sels, sel)
+		p.skipSpace()
+		if p.consume(",") {
+			continue
+		}
+		if err := p.expect("]"); err != nil {
+			return nil, err
+		}
+		return sels, nil
+	}
+}
+
+func (p *jpParser) parseSelector() (jpSelector, error) {
+	switch c := p.peek(); {
+	case c == '\'' || c == '"':
+		s, err := p.parseString()
+		return jpSelector{kind: selName, name: s}, err
+	case c == '*':
+		p.pos++
+		return jpSelector{kind: selWildcard}, nil
+	case c == '?':
+		p.pos++
+		p.skipSpace()
+		e, err := p.parseOr()
+		return jpSelector{kind: selFilter, filter: e}, err
+	case c == ':' || c == '-' || (c >= '0' && c <= '9'):
+		return p.parseIndexOrSlice()
+	}
+	return jpSelector{}, p.unexpected("expected selector")
+}
+
+func (p *jpParser) parseIndexOrSlice() (jpSelector, error) {
+	var parts [3]*int
+	n := 0
+	for {
+		p.skipSpace()
+		if c := p.peek(); c == '-' || (c >= '0' && c <= '9') {
+			v, err := p.parseInt()
+			if err != nil {
+				return jpSelector{}, err
+			}
+			parts[n] = &v
+		}
+		p.skipSpace()
+		if n < 2 && p.consume(":") {
+			n++
+			continue
+		}
+		break
+	}
+	if n == 0 {
+		if parts[0] == nil {
+			return jpSelector{}, p.unexpected("expected index")
+		}
+		return jpSelector{kind: selIndex, index: *parts[0]}, nil
+	}
+	return jpSelector{kind: selSlice, start: parts[0], end: parts[1], step: parts[2]}, nil
+}
+
+// parseInt parses an I-JSON integer: no leading zeros, no "-0".
+func (p *jpParser) parseInt() (int, error) {
+	start := p.pos
+	p.consume("-")
+	digits := p.pos
+	for !p.eof() && p.peek() >= '0' && p.peek() <= '9' {
+		p.pos++
+	}
+	lit := p.src[start:p.pos]
+	if p.pos == digits || (p.src[digits] == '0' && (p.pos-digits > 1 || digits > start)) {
+		return 0, errorf(start, "invalid integer %q", lit)
+	}
+	v, err := strconv.ParseInt(lit, 10, 64)
+	if err != nil || v > 1<<53-1 || v < -(1<<53-1) {
+		return 0, errorf(start, "integer %q out of range", lit)
+	}
+	return int(v), nil
+}
+
+func (p *jpParser) parseString() (string, error) {
+	start := p.pos
+	quote := p.src[p.pos]
+	p.pos++
+	var b strings.Builder
+	for {
+		if p.eof() {
+			return "", errorf(start, "unterminated string literal")
+		}
+		c := p.src[p.pos]
+		switch {
+		case c == quote:
+			p.pos++
+			return b.String(), nil
+		case c == '\\':
+			p.pos++
+			if p.eof() {
+				return "", errorf(start, "unterminated string literal")
+			}
+			esc := p.src[p.pos]
+			p.pos++
+			switch esc {
+			case 'b':
+				b.WriteByte('\b')
+			case 'f':
+				b.WriteByte('\f')
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			case '/', '\\', '\'', '"':
+				if (esc == '\'' || esc == '"') && esc != quote {
+					return "", errorf(p.pos-2, "invalid escape \\%c", esc)
+				}
+				b.WriteByte(esc)
+			case 'u':
+				r, err := p.parseUnicodeEscape()
+				if err != nil {
+					return "", err
+				}
+				b.WriteRune(r)
+			default:
+				return "", errorf(p.pos-2, "invalid escape \\%c", esc)
+			}
+		case c < 0x20:
+			return "", errorf(p.pos, "control character in string literal")
+		default:
+			b.WriteByte(c)
+			p.pos++
+		}
+	}
+}
+
+func (p *jpParser) parseUnicodeEscape() (rune, error) {
+	read := func() (rune, error) {
+		if p.pos+4 > len(p.src) {
+			return 0, errorf(p.pos, "invalid \\u escape")
+		}
+		v, err := strconv.ParseUint(p.src[p.pos:p.pos+4], 16, 32)
+		if err != nil {
+			return 0, errorf(p.pos, "invalid \\u escape")
+		}
+		p.pos += 4
+		return rune(v), nil
+	}
+	r, err := read()
+	if err != nil {
+		return 0, err
+	}
+	if r >= 0xD800 && r < 0xDC00 {
+		if !p.consume(`\u`) {
+			return 0, errorf(p.pos, "unpaired surrogate in \\u escape")
+		}
+		lo, err := read()
+		if err != nil {
+			return 0, err
+		}
+		if lo < 0xDC00 || lo > 0xDFFF {
+			return 0, errorf(p.pos-6, "invalid low surrogate")
+		}
+		return (r-0xD800)<<10 + (lo - 0xDC00) + 0x10000, nil
+	}
+	return r, nil
+}
+
+// ── Filter expressions ───────────────────────────────────────────────────────
+
+func (p *jpParser) parseOr() (jpExpr, error) {
+	l, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.skipSpace()
+		if !p.consume("||") {
+			return l, nil
+		}
+		p.skipSpace()
+		r, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l = jpOr{l, r}
+	}
+}
+
+func (p *jpParser) parseAnd() (jpExpr, error) {
+	l, err := p.parseBasic()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		p.skipSpace()
+		if !p.consume("&&") {
+			return l, nil
+		}
+		p.skipSpace()
+		r, err := p.parseBasic()
+		if err != nil {
+			return nil, err
+		}
+		l = jpAnd{l, r}
+	}
+}
+
+func (p *jpParser) parseBasic() (jpExpr, error) {
+	if p.consume("!") {
+		p.skipSpace()
+		start := p.pos
+		e, err := p.parseBasic()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := e.(jpCompare); ok {
+			return nil, errorf(start, "! cannot negate a comparison without parentheses")
+		}
+		return jpNot{e}, nil
+	}
+	if p.consume("(") {
+		p.skipSpace()
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+		return e, nil
+	}
+
+	start := p.pos
+	operand, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if !p.consume(op) {
+			continue
+		}
+		l, err := asComparable(operand, start)
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		rstart := p.pos
+		ro, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		r, err := asComparable(ro, rstart)
+		if err != nil {
+			return nil, err
+		}
+		return jpCompare{op: op, l: l, r: r}, nil
+	}
+
+	switch o := operand.(type) {
+	case *jpQuery:
+		return jpExists{q: o}, nil
+	case *jpFunc:
+		if o.name != "match" && o.name != "search" {
+			return nil, errorf(o.pos, "%s() result must be compared", o.name)
+		}
+		return jpExists{fn: o}, nil
+	}
+	return nil, errorf(start, "literal must be compared")
+}
+
+// asComparable checks that a parsed operand may appear in a comparison.
+func asComparable(operand any, pos int) (jpComparable, error) {
+	switch o := operand.(type) {
+	case jpLiteral:
+		return o, nil
+	case *jpQuery:
+		if !o.singular() {
+			return nil, errorf(pos, "comparison requires a singular query")
+		}
+		return o, nil
+	case *jpFunc:
+		if o.name == "match" || o.name == "search" {
+			return nil, errorf(pos, "%s() returns a logical value and cannot be compared", o.name)
+		}
+		return o, nil
+	}
+	return nil, errorf(pos, "invalid comparison operand")
+}
+
+// parseOperand parses a literal, filter query or function call.
+func (p *jpParser) parseOperand() (any, error) {
+	switch c := p.peek(); {
+	case c == '@':
+		return p.parseSegments(true)
+	case c == '$':
+		return p.parseSegments(false)
+	case c == '\'' || c == '"':
+		s, err := p.parseString()
+		return jpLiteral{s}, err
+	case c == '-' || (c >= '0' && c <= '9'):
+		return p.parseNumber()
+	case c >= 'a' && c <= 'z':
+		start := p.pos
+		for !p.eof() {
+			c := p.peek()
+			if c == '_' || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') {
+				p.pos++
+				continue
+			}
+			break
+		}
+		word := p.src[start:p.pos]
+		switch word {
+		case "true":
+			return jpLiteral{true}, nil
+		case "false":
+			return jpLiteral{false}, nil
+		case "null":
+			return jpLiteral{nil}, nil
+		}
+		if p.peek() != '(' {
+			p.pos = start
+			return nil, p.unexpected("expected literal, query or function")
+		}
+		return p.parseFunc(word, start)
+	}
+	return nil, p.unexpected("expected literal, query or function")
+}
+
+var numberRe = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][-+]?[0-9]+)?`)
+
+func (p *jpParser) parseNumber() (any, error) {
+	m := numberRe.FindString(p.src[p.pos:])
+	if m == "" || m == "-" {
+		return nil, p.unexpected("invalid number")
+	}
+	f, err := strconv.ParseFloat(m, 64)
+	if err != nil || math.IsInf(f, 0) {
+		return nil, errorf(p.pos, "invalid number %q", m)
+	}
+	p.pos += len(m)
+	return jpLiteral{f}, nil
+}
+
+// jpFuncArity lists the function extensions defined by RFC 9535.
+var jpFuncArity = map[string]int{"length": 1, "count": 1, "match": 2, "search": 2, "value": 1}
+
+func (p *jpParser) parseFunc(name string, start int) (any, error) {
+	arity, ok := jpFuncArity[name]
+	if !ok {
+		return nil, errorf(start, "unknown function %s()", name)
+	}
+	p.pos++ // (
+	f := &jpFunc{pos: start, name: name}
+	for {
+		p.skipSpace()
+		if p.consume(")") {
+			break
+		}
+		if len(f.args) > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+			p.skipSpace()
+		}
+		argStart := p.pos
+		arg, err := p.parseFuncArg()
+		if err != nil {
+			return nil, err
+		}
+		if err := checkFuncArg(name, len(f.args), arg, argStart); err != nil {
+			return nil, err
+		}
+		f.args = append(f.args, arg)
+	}
+	if len(f.args) != arity {
+		return nil, errorf(start, "%s() takes %d argument(s), got %d", name, arity, len(f.args))
+	}
+	return f, nil
+}
+
+// parseFuncArg parses a function argument, which may be a whole logical
+// expression as well as a single operand.
+func (p *jpParser) parseFuncArg() (any, error) {
+	save := p.pos
+	operand, err := p.parseOperand()
+	if err == nil {
+		p.skipSpace()
+		if c := p.peek(); c == ',' || c == ')' {
+			return operand, nil
+		}
+	}
+	p.pos = save
+	return p.parseOr()
+}
+
+func checkFuncArg(name string, i int, arg any, pos int) error {
+	switch name {
+	case "count", "value":
+		if _, ok := arg.(*jpQuery); !ok {
+			return errorf(pos, "%s() requires a query argument", name)
+		}
+	default:
+		switch a := arg.(type) {
+		case *jpQuery:
+			if !a.singular() {
+				return errorf(pos, "%s() argument %d requires a singular query", name, i+1)
+			}
+		case *jpFunc:
+			if a.name == "match" || a.name == "search" {
+				return errorf(pos, "%s() argument %d must be a value, not a logical expression", name, i+1)
+			}
+		case jpLiteral:
+		default:
+			return errorf(pos, "%s() argument %d must be a value", name, i+1)
+		}
+	}
+	return nil
+}
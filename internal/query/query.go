@@ -0,0 +1,269 @@
+// Package query evaluates JSONPath (RFC 9535) and jq expressions over decoded
+// documents, and SQL-like SELECT statements over tables of rows. The engines
+// work on the JSON value model — map[string]any, []any, float64, string,
+// bool and nil — so callers should pass input through Normalize first when
+// it comes from a YAML, TOML or XML decoder.
+//
+// jq programs run on gojq, so the whole language is available, including
+// definitions, assignment operators and path functions, except for what
+// needs I/O: $ENV is empty, and input, inputs and modules are unavailable.
+//
+// The SQL dialect covers a single-table SELECT with filtering, grouping,
+// aggregates, sorting and paging; joins and subqueries are not supported.
+package query
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Error is a query failure tied to a position in the expression.
+type Error struct {
+	Pos int // 0-based byte offset into the expression
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s (at position %d)", e.Msg, e.Pos+1)
+}
+
+func errorf(pos int, format string, args ...any) *Error {
+	return &Error{Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Normalize converts decoder-specific values — sized integers, time.Time,
+// map[any]any, json.Number — into the JSON value model.
+func Normalize(v any) any {
+	switch val := v.(type) {
+	case nil, bool, float64, string:
+		return val
+	case int:
+		return float64(val)
+	case int8:
+		return float64(val)
+	case int16:
+		return float64(val)
+	case int32:
+		return float64(val)
+	case int64:
+		return float64(val)
+	case uint:
+		return float64(val)
+	case uint8:
+		return float64(val)
+	case uint16:
+		return float64(val)
+	case uint32:
+		return float64(val)
+	case uint64:
+		return float64(val)
+	case float32:
+		return float64(val)
+	case json.Number:
+		f, err := val.Float64()
+		if err != nil {
+			return val.String()
+		}
+		return f
+	case time.Time:
+		return val.Format(time.RFC3339Nano)
+	case []any:
+		out := make([]any, len(val))
+		for i, item := range val {
+			out[i] = Normalize(item)
+		}
+		return out
+	case map[string]any:
+		out := make(map[string]any, len(val))
+		for k, item := range val {
+			out[k] = Normalize(item)
+		}
+		return out
+	case map[any]any:
+		out := make(map[string]any, len(val))
+		for k, item := range val {
+			out[fmt.Sprint(k)] = Normalize(item)
+		}
+		return out
+	default:
+		rv := reflect.ValueOf(v)
+		switch rv.Kind() {
+		case reflect.Slice, reflect.Array:
+			out := make([]any, rv.Len())
+			for i := range out {
+				out[i] = Normalize(rv.Index(i).Interface())
+			}
+			return out
+		case reflect.Map:
+			out := make(map[string]any, rv.Len())
+			iter := rv.MapRange()
+			for iter.Next() {
+				out[fmt.Sprint(iter.Key().Interface())] = Normalize(iter.Value().Interface())
+			}
+			return out
+		}
+		return fmt.Sprint(v)
+	}
+}
+
+// typeName is the jq name of a value's type.
+func typeName(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// describe renders a value for error messages, e.g. `number (42)`.
+func describe(v any) string {
+	if v == nil {
+		return "null"
+	}
+	s := toJSON(v)
+	if len(s) > 30 {
+		s = s[:27] + "..."
+	}
+	return fmt.Sprintf("%s (%s)", typeName(v), s)
+}
+
+// typeRank orders types the way jq sorts them.
+func typeRank(v any) int {
+	switch val := v.(type) {
+	case nil:
+		return 0
+	case bool:
+		if val {
+			return 2
+		}
+		return 1
+	case float64:
+		return 3
+	case string:
+		return 4
+	case []any:
+		return 5
+	case map[string]any:
+		return 6
+	}
+	return 7
+}
+
+// compareValues totally orders JSON values: null < false < true < numbers <
+// strings < arrays < objects.
+func compareValues(a, b any) int {
+	ra, rb := typeRank(a), typeRank(b)
+	if ra != rb {
+		return cmpInt(ra, rb)
+	}
+	switch av := a.(type) {
+	case float64:
+		bv := b.(float64)
+		switch {
+		case av < bv:
+			return -1
+		case av > bv:
+			return 1
+		}
+		return 0
+	case string:
+		bv := b.(string)
+		switch {
+		case av < bv:
+			return -1
+		case av > bv:
+			return 1
+		}
+		return 0
+	case []any:
+		bv := b.([]any)
+		for i := 0; i < len(av) && i < len(bv); i++ {
+			if c := compareValues(av[i], bv[i]); c != 0 {
+				return c
+			}
+		}
+		return cmpInt(len(av), len(bv))
+	case map[string]any:
+		bv := b.(map[string]any)
+		ka, kb := sortedKeys(av), sortedKeys(bv)
+		if c := compareValues(stringsToAny(ka), stringsToAny(kb)); c != 0 {
+			return c
+		}
+		for _, k := range ka {
+			if c := compareValues(av[k], bv[k]); c != 0 {
+				return c
+			}
+		}
+	}
+	return 0
+}
+
+func cmpInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func equalValues(a, b any) bool {
+	return compareValues(a, b) == 0
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func stringsToAny(ss []string) []any {
+	out := make([]any, len(ss))
+	for i, s := range ss {
+		out[i] = s
+	}
+	return out
+}
+
+// formatNumber renders a number the way JSON output would.
+func formatNumber(f float64) string {
+	if f == math.Trunc(f) && math.Abs(f) < 1e17 {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// toJSON renders v as compact JSON.
+func toJSON(v any) string {
+	if f, ok := v.(float64); ok {
+		return formatNumber(f)
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return fmt.Sprint(v)
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
@@ -0,0 +1,313 @@
+package query
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+const store = `{
+  "store": {
+    "book": [
+      {"category": "reference", "author": "Nigel Rees", "title": "Sayings of the Century", "price": 8.95},
+      {"category": "fiction", "author": "Evelyn Waugh", "title": "Sword of Honour", "price": 12.99},
+      {"category": "fiction", "author": "Herman Melville", "title": "Moby Dick", "isbn": "0-553-21311-3", "price": 8.99},
+      {"category": "fiction", "author": "J. R. R. Tolkien", "title": "The Lord of the Rings", "isbn": "0-395-19395-8", "price": 22.99}
+    ],
+    "bicycle": {"color": "red", "price": 399}
+  }
+}`
+
+func decode(t *testing.T, s string) any {
+	t.Helper()
+	var v any
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	return v
+}
+
+func TestJSONPath(t *testing.T) {
+	doc := decode(t, store)
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{`$.store.book[*].author`, `["Nigel Rees","Evelyn Waugh","Herman Melville","J. R. R. Tolkien"]`},
+		{`$..author`, `["Nigel Rees","Evelyn Waugh","Herman Melville","J. R. R. Tolkien"]`},
+		{`$.store.book[2]`, `[{"author":"Herman Melville","category":"fiction","isbn":"0-553-21311-3","price":8.99,"title":"Moby Dick"}]`},
+		{`$..book[-1].title`, `["The Lord of the Rings"]`},
+		{`$..book[0,1].title`, `["Sayings of the Century","Sword of Honour"]`},
+		{`$..book[:2].price`, `[8.95,12.99]`},
+		{`$..book[::-2].price`, `[22.99,12.99]`},
+		{`$..book[?@.isbn].title`, `["Moby Dick","The Lord of the Rings"]`},
+		{`$..book[?@.price<10].title`, `["Sayings of the Century","Moby Dick"]`},
+		{`$..book[?@.category=='fiction' && @.price>20].author`, `["J. R. R. Tolkien"]`},
+		{`$..book[?!@.isbn].price`, `[8.95,12.99]`},
+		{`$..book[?match(@.author, 'H.*')].title`, `["Moby Dick"]`},
+		{`$..book[?search(@.title, 'of')].price`, `[8.95,12.99,22.99]`},
+		{`$.store[?length(@) == 2].color`, `["red"]`},
+		{`$["store"]['bicycle'].price`, `[399]`},
+		{`$.missing`, `[]`},
+	}
+	for _, tt := range tests {
+		nodes, err := JSONPath(tt.expr, doc)
+		if err != nil {
+			t.Errorf("%s: %v", tt.expr, err)
+			continue
+		}
+		values := make([]any, len(nodes))
+		for i, n := range nodes {
+			values[i] = n.Value
+		}
+		if got := toJSON(values); got != tt.want {
+			t.Errorf("%s = %s, want %s", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestJSONPath_NormalizedPaths(t *testing.T) {
+	nodes, err := JSONPath(`$..book[?@.price>20]['title']`, decode(t, store))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nodes) != 1 || nodes[0].Path != `$['store']['book'][3]['title']` {
+		t.Errorf("paths = %+v", nodes)
+	}
+}
+
+func TestJSONPath_Errors(t *testing.T) {
+	tests := []struct {
+		expr string
+		pos  int
+	}{
+		{`store`, 0},
+		{`$.store[`, 8},
+		{`$[?@.a == @.*]`, 10},
+		{`$[?length(@.a)]`, 3},
+		{`$[1:2:0x]`, 7},
+	}
+	for _, tt := range tests {
+		_, err := JSONPath(tt.expr, map[string]any{})
+		var qe *Error
+		if !errors.As(err, &qe) {
+			t.Errorf("%s: expected *Error, got %v", tt.expr, err)
+			continue
+		}
+		if qe.Pos != tt.pos {
+			t.Errorf("%s: pos = %d, want %d (%v)", tt.expr, qe.Pos, tt.pos, err)
+		}
+	}
+}
+
+func TestJQ(t *testing.T) {
+	doc := decode(t, store)
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{`.store.bicycle.color`, `["red"]`},
+		{`.store.book[].price`, `[8.95,12.99,8.99,22.99]`},
+		{`[.store.book[] | select(.price < 10) | .title]`, `[["Sayings of the Century","Moby Dick"]]`},
+		{`.store.book | map(.price) | add`, `[53.92]`},
+		{`.store.book | length`, `[4]`},
+		{`.store.book | group_by(.category) | map({key: .[0].category, value: length}) | from_entries`, `[{"fiction":3,"reference":1}]`},
+		{`.store.book | sort_by(-.price) | .[0].author`, `["J. R. R. Tolkien"]`},
+		{`.store.book[0] | "\(.author): \(.title)"`, `["Nigel Rees: Sayings of the Century"]`},
+		{`.store.bicycle | to_entries | map("\(.key)=\(.value)") | join("&")`, `["color=red&price=399"]`},
+		{`.store.book[] | select(has("isbn")) | .isbn | split("-") | .[0]`, `["0","0"]`},
+		{`reduce .store.book[] as $b (0; . + $b.price) | floor`, `[53]`},
+		{`[.store.book[] | .price as $p | if $p > 10 then "high" elif $p > 9 then "mid" else "low" end]`, `[["low","high","low","high"]]`},
+		{`.store.book[1:3] | map(.title)`, `[["Sword of Honour","Moby Dick"]]`},
+		{`[paths(type == "number")] | length`, `[5]`},
+		{`.store.nope // "default"`, `["default"]`},
+		{`[limit(2; .store.book[].author)]`, `[["Nigel Rees","Evelyn Waugh"]]`},
+		{`.store.book[0].title | ascii_upcase | test("CENTURY")`, `[true]`},
+		{`.store.book[2].author | sub("(?<first>\\w+) (?<last>\\w+)"; "\(.last), \(.first)")`, `["Melville, Herman"]`},
+		{`[.store.book[].price] | @csv`, `["8.95,12.99,8.99,22.99"]`},
+		{`try error("boom") catch .`, `["boom"]`},
+		{`.store.bicycle | {color, wheels: 2}`, `[{"color":"red","wheels":2}]`},
+		{`. as {store: {bicycle: {$color}}} | $color`, `["red"]`},
+		{`[range(0; 10; 3)]`, `[[0,3,6,9]]`},
+		{`[foreach (1,2,3) as $x (0; . + $x)]`, `[[1,3,6]]`},
+		{`"a,b" | split(",") | .[1], length`, `["b",2]`},
+		{`{"a":{"b":1}} * {"a":{"c":2}}`, `[{"a":{"b":1,"c":2}}]`},
+		{`[.. | numbers] | max`, `[399]`},
+		{`"<&>" | @html`, `["&lt;&amp;&gt;"]`},
+		{`[1,[2,[3]]] | flatten`, `[[1,2,3]]`},
+		{`def double: . * 2; [.store.book[].price | floor | double]`, `[[16,24,16,44]]`},
+		{`.store.bicycle.price |= . + 1 | .store.bicycle.price`, `[400]`},
+		{`.store.book[0].price += 1 | .store.book[0].price`, `[9.95]`},
+		{`.store.bicycle.color = "blue" | .store.bicycle`, `[{"color":"blue","price":399}]`},
+		{`[path(.store.book[0].title)]`, `[[["store","book",0,"title"]]]`},
+		{`getpath(["store","bicycle","color"])`, `["red"]`},
+		{`del(.store.book) | .store | keys`, `[["bicycle"]]`},
+		{`$ENV | length`, `[0]`},
+		{`.[]?`, `[{"bicycle":{"color":"red","price":399},"book":[{"author":"Nigel Rees","category":"reference","price":8.95,"title":"Sayings of the Century"},{"author":"Evelyn Waugh","category":"fiction","price":12.99,"title":"Sword of Honour"},{"author":"Herman Melville","category":"fiction","isbn":"0-553-21311-3","price":8.99,"title":"Moby Dick"},{"author":"J. R. R. Tolkien","category":"fiction","isbn":"0-395-19395-8","price":22.99,"title":"The Lord of the Rings"}]}]`},
+	}
+	for _, tt := range tests {
+		got, err := JQ(tt.expr, doc)
+		if err != nil {
+			t.Errorf("%s: %v", tt.expr, err)
+			continue
+		}
+		if s := toJSON(got); s != tt.want {
+			t.Errorf("%s = %s, want %s", tt.expr, s, tt.want)
+		}
+	}
+}
+
+func TestJQ_Errors(t *testing.T) {
+	// Syntax errors carry a position; compile and runtime errors don't.
+	tests := []struct {
+		expr string
+		pos  int // -1 when the error is not an *Error
+		msg  string
+	}{
+		{`.store | `, 9, "unexpected EOF"},
+		{`.a + `, 5, "unexpected EOF"},
+		{`[1, 2`, 5, "unexpected EOF"},
+		{`.a | )`, 5, "unexpected token"},
+		{`.a | nosuch(1)`, -1, "nosuch/1"},
+		{`.store.bicycle.color | tonumber`, -1, "cannot be applied"},
+		{`.store.bicycle.color + 1`, -1, "cannot add"},
+		{`$nope`, -1, "$nope"},
+		{`"abc" | @nope`, -1, "@nope"},
+		{`input`, -1, "not allowed"},
+	}
+	for _, tt := range tests {
+		_, err := JQ(tt.expr, decode(t, store))
+		if err == nil {
+			t.Errorf("%s: expected an error", tt.expr)
+			continue
+		}
+		var qe *Error
+		switch {
+		case errors.As(err, &qe):
+			if qe.Pos != tt.pos {
+				t.Errorf("%s: pos = %d, want %d (%v)", tt.expr, qe.Pos, tt.pos, err)
+			}
+		case tt.pos != -1:
+			t.Errorf("%s: expected *Error, got %v", tt.expr, err)
+		}
+		if !strings.Contains(err.Error(), tt.msg) {
+			t.Errorf("%s: error = %q, want it to contain %q", tt.expr, err, tt.msg)
+		}
+	}
+}
+
+func TestJQ_Limits(t *testing.T) {
+	_, err := JQ(`[range(1e9)] | length`, nil)
+	if err == nil || !strings.Contains(err.Error(), "limit") {
+		t.Errorf("expected limit error, got %v", err)
+	}
+	_, err = JQ(`try last(range(1e9)) catch "caught"`, nil)
+	if err == nil {
+		t.Error("limit errors must not be catchable")
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	got := Normalize(map[string]any{
+		"n": int64(3),
+		"m": map[any]any{"k": uint8(1)},
+		"l": []string{"a"},
+	})
+	if s := toJSON(got); s != `{"l":["a"],"m":{"k":1},"n":3}` {
+		t.Errorf("Normalize = %s", s)
+	}
+}
+
+func TestSQL(t *testing.T) {
+	people := &Table{
+		Columns: []string{"name", "team", "age", "salary", "address"},
+		Rows: [][]any{
+			{"Ada", "eng", 36.0, "120000", map[string]any{"city": "London"}},
+			{"Grace", "eng", 45.0, "150000", map[string]any{"city": "New York"}},
+			{"Linus", "ops", 28.0, "90000", nil},
+			{"Margaret", "eng", 33.0, "130000", map[string]any{"city": "Boston"}},
+			{"Ken", "ops", 52.0, nil, map[string]any{"city": "Berkeley"}},
+			{"Barbara", nil, 41.0, "110000"},
+		},
+	}
+	tests := []struct {
+		expr    string
+		columns string
+		rows    string
+	}{
+		{``, `["name","team","age","salary","address"]`, ``},
+		{`SELECT name WHERE age > 40 ORDER BY name`, `["name"]`, `[["Barbara"],["Grace"],["Ken"]]`},
+		{`where team = 'ops' and age < 30`, `["name","team","age","salary","address"]`, `[["Linus","ops",28,"90000",null]]`},
+		{`SELECT name, salary WHERE salary >= 130000 ORDER BY salary DESC`, `["name","salary"]`, `[["Grace","150000"],["Margaret","130000"]]`},
+		{`SELECT name FROM people WHERE name LIKE '%a_a%' OR team IS NULL`, `["name"]`, `[["Ada"],["Barbara"]]`},
+		{`SELECT name WHERE team NOT IN ('eng') ORDER BY 1`, `["name"]`, `[["Ken"],["Linus"]]`},
+		{`SELECT name WHERE age BETWEEN 30 AND 40`, `["name"]`, `[["Ada"],["Margaret"]]`},
+		{`SELECT name, address.city AS city WHERE address.city LIKE 'b%' ORDER BY city`, `["name","city"]`, `[["Ken","Berkeley"],["Margaret","Boston"]]`},
+		{`SELECT team, count(*) AS n, avg(age), sum(salary), max(name) GROUP BY team ORDER BY n DESC, team`,
+			`["team","n","avg(age)","sum(salary)","max(name)"]`,
+			`[["eng",3,38,400000,"Margaret"],["ops",2,40,90000,"Linus"],[null,1,41,110000,"Barbara"]]`},
+		{`SELECT team, count(salary) n GROUP BY team HAVING n > 1`, `["team","n"]`, `[["eng",3]]`},
+		{`SELECT upper(team) AS t, address.city, count(*) GROUP BY team, address ORDER BY t, address.city LIMIT 2`,
+			`["t","address.city","count(*)"]`, `[[null,null,1],["ENG","Boston",1]]`},
+		{`SELECT count(*), count(DISTINCT team), min(age) WHERE age > 100`, `["count(*)","count(DISTINCT team)","min(age)"]`, `[[0,0,null]]`},
+		{`SELECT DISTINCT team ORDER BY team LIMIT 2`, `["team"]`, `[[null],["eng"]]`},
+		{`SELECT upper(name) || '!' AS shout, round(age / 7, 1) ORDER BY age LIMIT 2 OFFSET 1`, `["shout","round(age / 7, 1)"]`, `[["MARGARET!",4.7],["ADA!",5.1]]`},
+		{`SELECT name, name WHERE coalesce(salary, 0) = 0`, `["name","name_2"]`, `[["Ken","Ken"]]`},
+		{`SELECT "name" AS "full name" WHERE NOT age <> 36;`, `["full name"]`, `[["Ada"]]`},
+	}
+	for _, tt := range tests {
+		got, err := SQL(tt.expr, people)
+		if err != nil {
+			t.Errorf("%s: %v", tt.expr, err)
+			continue
+		}
+		if s := toJSON(got.Columns); s != tt.columns {
+			t.Errorf("%s: columns = %s, want %s", tt.expr, s, tt.columns)
+		}
+		if s := toJSON(got.Rows); tt.rows != "" && s != tt.rows {
+			t.Errorf("%s: rows = %s, want %s", tt.expr, s, tt.rows)
+		}
+	}
+}
+
+func TestSQL_Errors(t *testing.T) {
+	table := &Table{Columns: []string{"a", "b"}, Rows: [][]any{{1.0, "x"}}}
+	tests := []struct {
+		expr string
+		pos  int
+		msg  string
+	}{
+		{`SELECT nope`, 7, `unknown column "nope"`},
+		{`SELECT a FROM`, 13, "expected a name"},
+		{`SELECT a WHERE count(*) > 1`, 15, "not allowed in WHERE"},
+		{`SELECT frob(a)`, 7, "unknown function"},
+		{`SELECT round()`, 7, "round takes 1 to 2 arguments"},
+		{`SELECT a + b`, 9, "cannot apply +"},
+		{`SELECT sum(b)`, 7, "sum expects numbers"},
+		{`SELECT a ORDER BY 3`, 18, "out of range"},
+		{`SELECT a LIMIT -1`, 15, "non-negative integer"},
+		{`SELECT 'open`, 7, "unterminated"},
+		{`SELECT a WHERE a NOT 1`, 21, "expected LIKE, IN or BETWEEN"},
+		{`SELECT a b c`, 11, "expected end of query"},
+		{`SELECT a, b GROUP BY a`, 10, `column "b" must appear in GROUP BY`},
+		{`SELECT count(*), a`, 17, `column "a" must appear in GROUP BY`},
+		{`SELECT a, count(*) GROUP BY a HAVING b = 'x'`, 37, `column "b"`},
+		{`SELECT a, count(*) GROUP BY a ORDER BY b`, 39, `column "b"`},
+		{`SELECT * GROUP BY a`, 0, `column "b"`},
+	}
+	for _, tt := range tests {
+		_, err := SQL(tt.expr, table)
+		var qe *Error
+		if !errors.As(err, &qe) {
+			t.Errorf("%s: expected *Error, got %v", tt.expr, err)
+			continue
+		}
+		if qe.Pos != tt.pos {
+			t.Errorf("%s: pos = %d, want %d (%v)", tt.expr, qe.Pos, tt.pos, err)
+		}
+		if !strings.Contains(qe.Msg, tt.msg) {
+			t.Errorf("%s: msg = %q, want it to contain %q", tt.expr, qe.Msg, tt.msg)
+		}
+	}
+}
@@ -0,0 +1,1343 @@
+package query
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Table is a set of rows under named columns. Cells use the JSON value
+// model; a row shorter than Columns reads as null in the missing cells.
+type Table struct {
+	Columns []string
+	Rows    [][]any
+}
+
+// SQL runs a SELECT statement against t:
+//
+//	SELECT [DISTINCT] items [FROM name] [WHERE cond] [GROUP BY exprs]
+//	  [HAVING cond] [ORDER BY expr [ASC|DESC], ...] [LIMIT n [OFFSET m]]
+//
+// Every clause is optional, so "WHERE age > 30 ORDER BY name" is a complete
+// query and an empty one returns t unchanged; FROM is accepted for
+// familiarity and its name ignored. Items are expressions with optional
+// aliases, or * for every input column. A name containing dots reads into
+// nested objects when no column has that exact name.
+//
+// The aggregates count, sum, avg, min and max (each with optional DISTINCT)
+// fold the rows of each group, or of the whole table when there is no
+// GROUP BY. Outside the aggregates, a grouped select may only read the
+// columns it groups by, which hold one value per group; HAVING and ORDER
+// BY may also name a select column. Comparisons and arithmetic read numeric strings as numbers,
+// and a comparison with NULL is unknown, which fails WHERE and HAVING.
+func SQL(expr string, t *Table) (*Table, error) {
+	toks, err := lexSQL(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &sqlParser{src: expr, toks: toks}
+	stmt, err := p.parseStatement()
+	if err != nil {
+		return nil, err
+	}
+	return stmt.run(t)
+}
+
+// ── Lexer ─────────────────────────────────────────────────────────────────────
+
+type sqlTokKind int
+
+const (
+	sqlEOF    sqlTokKind = iota
+	sqlWord              // bare word: a keyword or a name
+	sqlQuoted            // "name", `name` or [name]
+	sqlNumber
+	sqlString
+	sqlOp
+)
+
+type sqlToken struct {
+	kind sqlTokKind
+	text string // word, operator or unquoted string
+	num  float64
+	pos  int
+}
+
+// is reports whether the token is one of the given keywords (matched case
+// insensitively) or operators.
+func (t sqlToken) is(words ...string) bool {
+	for _, w := range words {
+		switch t.kind {
+		case sqlWord:
+			if strings.EqualFold(t.text, w) {
+				return true
+			}
+		case sqlOp:
+			if t.text == w {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sqlReserved words cannot be bare column names or aliases; quote them.
+var sqlReserved = map[string]bool{
+	"SELECT": true, "DISTINCT": true, "FROM": true, "WHERE": true, "GROUP": true,
+	"BY": true, "HAVING": true, "ORDER": true, "ASC": true, "DESC": true,
+	"LIMIT": true, "OFFSET": true, "AND": true, "OR": true, "NOT": true,
+	"LIKE": true, "IN": true, "IS": true, "NULL": true, "TRUE": true,
+	"FALSE": true, "AS": true, "BETWEEN": true,
+}
+
+func (t sqlToken) reserved() bool {
+	return t.kind == sqlWord && sqlReserved[strings.ToUpper(t.text)]
+}
+
+func (t sqlToken) describe() string {
+	switch t.kind {
+	case sqlEOF:
+		return "end of query"
+	case sqlString:
+		return "string " + strconv.Quote(t.text)
+	}
+	return strconv.Quote(t.text)
+}
+
+var sqlOps = []string{"<=", ">=", "<>", "!=", "==", "||", "=", "<", ">", "+", "-", "*", "/", "%", "(", ")", ",", ";"}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentChar(c byte) bool {
+	return isIdentStart(c) || (c >= '0' && c <= '9')
+}
+
+func isSQLWordChar(c byte) bool {
+	return isIdentChar(c) || c == '.' || c >= utf8.RuneSelf
+}
+
+func lexSQL(src string) ([]sqlToken, error) {
+	var toks []sqlToken
+	i := 0
+	for {
+		for i < len(src) && strings.IndexByte(" \t\r\n", src[i]) >= 0 {
+			i++
+		}
+		if strings.HasPrefix(src[i:], "--") {
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+			continue
+		}
+		if i >= len(src) {
+			return append(toks, sqlToken{kind: sqlEOF, pos: i}), nil
+		}
+		start, c := i, src[i]
+		switch {
+		case isIdentStart(c) || c >= utf8.RuneSelf:
+			for i < len(src) && isSQLWordChar(src[i]) {
+				i++
+			}
+			toks = append(toks, sqlToken{kind: sqlWord, text: src[start:i], pos: start})
+
+		case c >= '0' && c <= '9' || c == '.' && i+1 < len(src) && src[i+1] >= '0' && src[i+1] <= '9':
+			for i < len(src) && (src[i] >= '0' && src[i] <= '9' || src[i] == '.') {
+				i++
+			}
+			if i < len(src) && (src[i] == 'e' || src[i] == 'E') {
+				j := i + 1
+				if j < len(src) && (src[j] == '+' || src[j] == '-') {
+					j++
+				}
+				if j < len(src) && src[j] >= '0' && src[j] <= '9' {
+					for i = j; i < len(src) && src[i] >= '0' && src[i] <= '9'; i++ {
+					}
+				}
+			}
+			n, err := strconv.ParseFloat(src[start:i], 64)
+			if err != nil {
+				return nil, errorf(start, "invalid number %q", src[start:i])
+			}
+			toks = append(toks, sqlToken{kind: sqlNumber, text: src[start:i], num: n, pos: start})
+
+		case c == '\'' || c == '"' || c == '`' || c == '[':
+			closer := c
+			if c == '[' {
+				closer = ']'
+			}
+			var b strings.Builder
+			for i++; ; i++ {
+				if i >= len(src) {
+					return nil, errorf(start, "unterminated %c", c)
+				}
+				if src[i] == closer {
+					if closer != ']' && i+1 < len(src) && src[i+1] == closer {
+						b.WriteByte(closer) // doubled quote
+						i++
+						continue
+					}
+					break
+				}
+				b.WriteByte(src[i])
+			}
+			i++
+			kind := sqlQuoted
+			if c == '\'' {
+				kind = sqlString
+			}
+			toks = append(toks, sqlToken{kind: kind, text: b.String(), pos: start})
+
+		default:
+			op := ""
+			for _, o := range sqlOps {
+				if strings.HasPrefix(src[i:], o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return nil, errorf(start, "unexpected character %q", src[i])
+			}
+			i += len(op)
+			toks = append(toks, sqlToken{kind: sqlOp, text: op, pos: start})
+		}
+	}
+}
+
+// ── Parser ────────────────────────────────────────────────────────────────────
+
+type sqlItem struct {
+	expr sqlExpr // nil for *
+	name string
+}
+
+type sqlOrder struct {
+	expr sqlExpr
+	desc bool
+	pos  int
+}
+
+type sqlSelect struct {
+	distinct bool
+	items    []sqlItem
+	where    sqlExpr
+	groupBy  []sqlExpr
+	having   sqlExpr
+	orderBy  []sqlOrder
+	limit    int // -1 for no limit
+	offset   int
+	grouped  bool
+}
+
+type sqlParser struct {
+	src  string
+	toks []sqlToken
+	i    int
+	aggs int // aggregate calls parsed so far
+}
+
+func (p *sqlParser) peek() sqlToken { return p.toks[p.i] }
+
+func (p *sqlParser) next() sqlToken {
+	t := p.toks[p.i]
+	if t.kind != sqlEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *sqlParser) accept(words ...string) bool {
+	if p.peek().is(words...) {
+		p.i++
+		return true
+	}
+	return false
+}
+
+func (p *sqlParser) expect(word string) error {
+	if !p.accept(word) {
+		return p.unexpected("expected %s", word)
+	}
+	return nil
+}
+
+func (p *sqlParser) unexpected(format string, args ...any) error {
+	t := p.peek()
+	return errorf(t.pos, "%s, found %s", fmt.Sprintf(format, args...), t.describe())
+}
+
+// name reads a column name or alias.
+func (p *sqlParser) name() (string, error) {
+	t := p.peek()
+	if t.kind == sqlQuoted || t.kind == sqlWord && !t.reserved() {
+		p.i++
+		return t.text, nil
+	}
+	return "", p.unexpected("expected a name")
+}
+
+func (p *sqlParser) parseStatement() (*sqlSelect, error) {
+	s := &sqlSelect{limit: -1}
+	if p.accept("SELECT") {
+		s.distinct = p.accept("DISTINCT")
+		for {
+			item, err := p.parseItem()
+			if err != nil {
+				return nil, err
+			}
+			s.items = append(s.items, item)
+			if !p.accept(",") {
+				break
+			}
+		}
+	} else {
+		s.items = []sqlItem{{}}
+	}
+	if p.accept("FROM") {
+		if _, err := p.name(); err != nil {
+			return nil, err
+		}
+	}
+	var err error
+	if p.accept("WHERE") {
+		if s.where, err = p.parseScalar("WHERE"); err != nil {
+			return nil, err
+		}
+	}
+	if p.accept("GROUP") {
+		if err := p.expect("BY"); err != nil {
+			return nil, err
+		}
+		for {
+			e, err := p.parseScalar("GROUP BY")
+			if err != nil {
+				return nil, err
+			}
+			s.groupBy = append(s.groupBy, e)
+			if !p.accept(",") {
+				break
+			}
+		}
+	}
+	if p.accept("HAVING") {
+		if s.having, err = p.parseExpr(); err != nil {
+			return nil, err
+		}
+	}
+	if p.accept("ORDER") {
+		if err := p.expect("BY"); err != nil {
+			return nil, err
+		}
+		for {
+			pos := p.peek().pos
+			e, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			o := sqlOrder{expr: e, pos: pos}
+			if p.accept("DESC") {
+				o.desc = true
+			} else {
+				p.accept("ASC")
+			}
+			s.orderBy = append(s.orderBy, o)
+			if !p.accept(",") {
+				break
+			}
+		}
+	}
+	if p.accept("LIMIT") {
+		if s.limit, err = p.count("LIMIT"); err != nil {
+			return nil, err
+		}
+		if p.accept("OFFSET") {
+			if s.offset, err = p.count("OFFSET"); err != nil {
+				return nil, err
+			}
+		}
+	}
+	p.accept(";")
+	if p.peek().kind != sqlEOF {
+		return nil, p.unexpected("expected end of query")
+	}
+	s.grouped = len(s.groupBy) > 0 || s.having != nil || p.aggs > 0
+	return s, nil
+}
+
+func (p *sqlParser) parseItem() (sqlItem, error) {
+	if p.accept("*") {
+		return sqlItem{}, nil
+	}
+	start := p.peek().pos
+	e, err := p.parseExpr()
+	if err != nil {
+		return sqlItem{}, err
+	}
+	item := sqlItem{expr: e, name: strings.TrimSpace(p.src[start:p.peek().pos])}
+	if col, ok := e.(*sqlColumn); ok {
+		item.name = col.name
+	}
+	if p.accept("AS") {
+		item.name, err = p.name()
+		return item, err
+	}
+	if t := p.peek(); t.kind == sqlQuoted || t.kind == sqlWord && !t.reserved() {
+		item.name, err = p.name()
+	}
+	return item, err
+}
+
+// parseScalar parses an expression of a clause that runs before grouping.
+func (p *sqlParser) parseScalar(clause string) (sqlExpr, error) {
+	before, pos := p.aggs, p.peek().pos
+	e, err := p.parseExpr()
+	if err == nil && p.aggs > before {
+		err = errorf(pos, "aggregate functions are not allowed in %s", clause)
+	}
+	return e, err
+}
+
+// count reads a non-negative integer literal.
+func (p *sqlParser) count(clause string) (int, error) {
+	t := p.peek()
+	if t.kind != sqlNumber || t.num != math.Trunc(t.num) || t.num > math.MaxInt32 {
+		return 0, p.unexpected("%s expects a non-negative integer", clause)
+	}
+	p.i++
+	return int(t.num), nil
+}
+
+func (p *sqlParser) parseExpr() (sqlExpr, error) {
+	l, err := p.parseAnd()
+	for err == nil && p.peek().is("OR") {
+		pos := p.next().pos
+		var r sqlExpr
+		if r, err = p.parseAnd(); err == nil {
+			l = &sqlBinary{op: "OR", l: l, r: r, pos: pos}
+		}
+	}
+	return l, err
+}
+
+func (p *sqlParser) parseAnd() (sqlExpr, error) {
+	l, err := p.parseNot()
+	for err == nil && p.peek().is("AND") {
+		pos := p.next().pos
+		var r sqlExpr
+		if r, err = p.parseNot(); err == nil {
+			l = &sqlBinary{op: "AND", l: l, r: r, pos: pos}
+		}
+	}
+	return l, err
+}
+
+func (p *sqlParser) parseNot() (sqlExpr, error) {
+	if t := p.peek(); t.is("NOT") {
+		p.i++
+		e, err := p.parseNot()
+		return &sqlUnary{op: "NOT", e: e, pos: t.pos}, err
+	}
+	return p.parseComparison()
+}
+
+func (p *sqlParser) parseComparison() (sqlExpr, error) {
+	l, err := p.parseAdditive()
+	if err != nil {
+		return nil, err
+	}
+	t := p.peek()
+	switch {
+	case t.is("=", "==", "!=", "<>", "<", "<=", ">", ">="):
+		p.i++
+		r, err := p.parseAdditive()
+		return &sqlBinary{op: t.text, l: l, r: r, pos: t.pos}, err
+	case t.is("IS"):
+		p.i++
+		not := p.accept("NOT")
+		return &sqlIsNull{e: l, not: not}, p.expect("NULL")
+	}
+	not := p.accept("NOT")
+	switch {
+	case p.accept("LIKE"):
+		r, err := p.parseAdditive()
+		return &sqlLike{e: l, pattern: r, not: not, cache: map[string]*regexp.Regexp{}}, err
+	case p.accept("IN"):
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		in := &sqlIn{e: l, not: not}
+		for {
+			e, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			in.list = append(in.list, e)
+			if !p.accept(",") {
+				break
+			}
+		}
+		return in, p.expect(")")
+	case p.accept("BETWEEN"):
+		lo, err := p.parseAdditive()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("AND"); err != nil {
+			return nil, err
+		}
+		hi, err := p.parseAdditive()
+		return &sqlBetween{e: l, lo: lo, hi: hi, not: not}, err
+	case not:
+		return nil, p.unexpected("expected LIKE, IN or BETWEEN after NOT")
+	}
+	return l, nil
+}
+
+func (p *sqlParser) parseAdditive() (sqlExpr, error) {
+	l, err := p.parseMultiplicative()
+	for err == nil && p.peek().is("+", "-", "||") {
+		t := p.next()
+		var r sqlExpr
+		if r, err = p.parseMultiplicative(); err == nil {
+			l = &sqlBinary{op: t.text, l: l, r: r, pos: t.pos}
+		}
+	}
+	return l, err
+}
+
+func (p *sqlParser) parseMultiplicative() (sqlExpr, error) {
+	l, err := p.parseUnary()
+	for err == nil && p.peek().is("*", "/", "%") {
+		t := p.next()
+		var r sqlExpr
+		if r, err = p.parseUnary(); err == nil {
+			l = &sqlBinary{op: t.text, l: l, r: r, pos: t.pos}
+		}
+	}
+	return l, err
+}
+
+func (p *sqlParser) parseUnary() (sqlExpr, error) {
+	if t := p.peek(); t.is("-") {
+		p.i++
+		e, err := p.parseUnary()
+		return &sqlUnary{op: "-", e: e, pos: t.pos}, err
+	}
+	return p.parsePrimary()
+}
+
+func (p *sqlParser) parsePrimary() (sqlExpr, error) {
+	t := p.peek()
+	switch {
+	case t.kind == sqlNumber:
+		p.i++
+		return &sqlLiteral{v: t.num}, nil
+	case t.kind == sqlString:
+		p.i++
+		return &sqlLiteral{v: t.text}, nil
+	case t.is("NULL"):
+		p.i++
+		return &sqlLiteral{}, nil
+	case t.is("TRUE", "FALSE"):
+		p.i++
+		return &sqlLiteral{v: strings.EqualFold(t.text, "TRUE")}, nil
+	case t.is("("):
+		p.i++
+		e, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		return e, p.expect(")")
+	case t.kind == sqlQuoted:
+		p.i++
+		return &sqlColumn{name: t.text, pos: t.pos}, nil
+	case t.kind == sqlWord && !t.reserved():
+		p.i++
+		if p.peek().is("(") {
+			return p.parseCall(t)
+		}
+		return &sqlColumn{name: t.text, pos: t.pos}, nil
+	}
+	return nil, p.unexpected("expected an expression")
+}
+
+func (p *sqlParser) parseCall(fn sqlToken) (sqlExpr, error) {
+	p.i++ // (
+	name := strings.ToLower(fn.text)
+	if sqlAggregates[name] {
+		agg := &sqlAggregate{name: name, pos: fn.pos}
+		p.aggs++
+		if name == "count" && p.accept("*") {
+			return agg, p.expect(")")
+		}
+		agg.distinct = p.accept("DISTINCT")
+		e, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		agg.arg = e
+		return agg, p.expect(")")
+	}
+	arity, ok := sqlFunctions[name]
+	if !ok {
+		return nil, errorf(fn.pos, "unknown function %s", fn.text)
+	}
+	call := &sqlCall{name: name, pos: fn.pos}
+	if !p.accept(")") {
+		for {
+			e, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			call.args = append(call.args, e)
+			if !p.accept(",") {
+				break
+			}
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+	}
+	if n := len(call.args); n < arity[0] || arity[1] >= 0 && n > arity[1] {
+		return nil, errorf(fn.pos, "%s takes %s", name, describeArity(arity))
+	}
+	return call, nil
+}
+
+func describeArity(a [2]int) string {
+	switch {
+	case a[1] < 0:
+		return fmt.Sprintf("at least %d arguments", a[0])
+	case a[0] == a[1] && a[0] == 1:
+		return "1 argument"
+	case a[0] == a[1]:
+		return fmt.Sprintf("%d arguments", a[0])
+	}
+	return fmt.Sprintf("%d to %d arguments", a[0], a[1])
+}
+
+// ── Evaluation ────────────────────────────────────────────────────────────────
+
+type sqlExpr interface {
+	eval(env *sqlEnv) (any, error)
+}
+
+// sqlEnv is the context an expression is evaluated in.
+type sqlEnv struct {
+	index  map[string]int // input column positions
+	row    []any
+	group  [][]any        // rows of the current group when grouping
+	output map[string]any // select values by name, for HAVING and ORDER BY
+}
+
+type sqlLiteral struct{ v any }
+
+func (e *sqlLiteral) eval(*sqlEnv) (any, error) { return e.v, nil }
+
+type sqlColumn struct {
+	name string
+	pos  int
+}
+
+func (e *sqlColumn) eval(env *sqlEnv) (any, error) {
+	if v, ok := env.output[e.name]; ok {
+		return v, nil
+	}
+	if v, ok := env.cell(e.name); ok {
+		return v, nil
+	}
+	// a.b.c reads into the longest column prefix that exists.
+	for i := strings.LastIndexByte(e.name, '.'); i > 0; i = strings.LastIndexByte(e.name[:i], '.') {
+		if v, ok := env.cell(e.name[:i]); ok {
+			for _, key := range strings.Split(e.name[i+1:], ".") {
+				v = childValue(v, key)
+			}
+			return v, nil
+		}
+	}
+	return nil, errorf(e.pos, "unknown column %q", e.name)
+}
+
+func (env *sqlEnv) cell(name string) (any, bool) {
+	i, ok := env.index[name]
+	if !ok {
+		return nil, false
+	}
+	if i < len(env.row) {
+		return env.row[i], true
+	}
+	return nil, true
+}
+
+func childValue(v any, key string) any {
+	switch val := v.(type) {
+	case map[string]any:
+		return val[key]
+	case []any:
+		if i, err := strconv.Atoi(key); err == nil && i >= 0 && i < len(val) {
+			return val[i]
+		}
+	}
+	return nil
+}
+
+type sqlUnary struct {
+	op  string
+	e   sqlExpr
+	pos int
+}
+
+func (e *sqlUnary) eval(env *sqlEnv) (any, error) {
+	v, err := e.e.eval(env)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	if e.op == "NOT" {
+		b, known := sqlTruth(v)
+		if !known {
+			return nil, nil
+		}
+		return !b, nil
+	}
+	n, ok := sqlNumeric(v)
+	if !ok {
+		return nil, errorf(e.pos, "cannot negate %s", describe(v))
+	}
+	return -n, nil
+}
+
+type sqlBinary struct {
+	op   string
+	l, r sqlExpr
+	pos  int
+}
+
+func (e *sqlBinary) eval(env *sqlEnv) (any, error) {
+	l, err := e.l.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	switch e.op {
+	case "AND", "OR":
+		lb, lk := sqlTruth(l)
+		if lk && lb == (e.op == "OR") {
+			return lb, nil // short circuit
+		}
+		r, err := e.r.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		rb, rk := sqlTruth(r)
+		switch {
+		case rk && rb == (e.op == "OR"):
+			return rb, nil
+		case !lk || !rk:
+			return nil, nil
+		}
+		return rb, nil
+	}
+	r, err := e.r.eval(env)
+	if err != nil || l == nil || r == nil {
+		return nil, err
+	}
+	switch e.op {
+	case "=", "==":
+		return sqlCompare(l, r) == 0, nil
+	case "!=", "<>":
+		return sqlCompare(l, r) != 0, nil
+	case "<":
+		return sqlCompare(l, r) < 0, nil
+	case "<=":
+		return sqlCompare(l, r) <= 0, nil
+	case ">":
+		return sqlCompare(l, r) > 0, nil
+	case ">=":
+		return sqlCompare(l, r) >= 0, nil
+	case "||":
+		return sqlText(l) + sqlText(r), nil
+	}
+	a, aok := sqlNumeric(l)
+	b, bok := sqlNumeric(r)
+	if !aok || !bok {
+		return nil, errorf(e.pos, "cannot apply %s to %s and %s", e.op, describe(l), describe(r))
+	}
+	switch e.op {
+	case "+":
+		return a + b, nil
+	case "-":
+		return a - b, nil
+	case "*":
+		return a * b, nil
+	case "/":
+		if b == 0 {
+			return nil, nil
+		}
+		return a / b, nil
+	default: // %
+		if b == 0 {
+			return nil, nil
+		}
+		return math.Mod(a, b), nil
+	}
+}
+
+type sqlIsNull struct {
+	e   sqlExpr
+	not bool
+}
+
+func (e *sqlIsNull) eval(env *sqlEnv) (any, error) {
+	v, err := e.e.eval(env)
+	return (v == nil) != e.not, err
+}
+
+type sqlLike struct {
+	e, pattern sqlExpr
+	not        bool
+	cache      map[string]*regexp.Regexp
+}
+
+// eval matches case-insensitively; % matches any run of characters and _
+// exactly one.
+func (e *sqlLike) eval(env *sqlEnv) (any, error) {
+	v, err := e.e.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	pv, err := e.pattern.eval(env)
+	if err != nil || v == nil || pv == nil {
+		return nil, err
+	}
+	pattern := sqlText(pv)
+	re, ok := e.cache[pattern]
+	if !ok {
+		var b strings.Builder
+		b.WriteString("(?is)^")
+		for _, r := range pattern {
+			switch r {
+			case '%':
+				b.WriteString(".*")
+			case '_':
+				b.WriteString(".")
+			default:
+				b.WriteString(regexp.QuoteMeta(string(r)))
+			}
+		}
+		b.WriteString("$")
+		re = regexp.MustCompile(b.String())
+		e.cache[pattern] = re
+	}
+	return re.MatchString(sqlText(v)) != e.not, nil
+}
+
+type sqlIn struct {
+	e    sqlExpr
+	list []sqlExpr
+	not  bool
+}
+
+func (e *sqlIn) eval(env *sqlEnv) (any, error) {
+	v, err := e.e.eval(env)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	sawNull := false
+	for _, item := range e.list {
+		w, err := item.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		if w == nil {
+			sawNull = true
+		} else if sqlCompare(v, w) == 0 {
+			return !e.not, nil
+		}
+	}
+	if sawNull {
+		return nil, nil
+	}
+	return e.not, nil
+}
+
+type sqlBetween struct {
+	e, lo, hi sqlExpr
+	not       bool
+}
+
+func (e *sqlBetween) eval(env *sqlEnv) (any, error) {
+	var vals [3]any
+	for i, x := range []sqlExpr{e.e, e.lo, e.hi} {
+		v, err := x.eval(env)
+		if err != nil || v == nil {
+			return nil, err
+		}
+		vals[i] = v
+	}
+	in := sqlCompare(vals[1], vals[0]) <= 0 && sqlCompare(vals[0], vals[2]) <= 0
+	return in != e.not, nil
+}
+
+// sqlFunctions maps each scalar function to its minimum and maximum
+// argument count; -1 means unbounded.
+var sqlFunctions = map[string][2]int{
+	"lower": {1, 1}, "upper": {1, 1}, "trim": {1, 1}, "length": {1, 1},
+	"abs": {1, 1}, "round": {1, 2}, "coalesce": {1, -1},
+}
+
+type sqlCall struct {
+	name string
+	args []sqlExpr
+	pos  int
+}
+
+func (e *sqlCall) eval(env *sqlEnv) (any, error) {
+	args := make([]any, len(e.args))
+	for i, a := range e.args {
+		v, err := a.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+	if e.name == "coalesce" {
+		for _, a := range args {
+			if a != nil {
+				return a, nil
+			}
+		}
+		return nil, nil
+	}
+	if args[0] == nil {
+		return nil, nil
+	}
+	switch e.name {
+	case "lower":
+		return strings.ToLower(sqlText(args[0])), nil
+	case "upper":
+		return strings.ToUpper(sqlText(args[0])), nil
+	case "trim":
+		return strings.TrimSpace(sqlText(args[0])), nil
+	case "length":
+		return float64(utf8.RuneCountInString(sqlText(args[0]))), nil
+	}
+	nums := make([]float64, len(args))
+	for i, a := range args {
+		n, ok := sqlNumeric(a)
+		if !ok {
+			return nil, errorf(e.pos, "%s expects numbers, got %s", e.name, describe(a))
+		}
+		nums[i] = n
+	}
+	if e.name == "abs" {
+		return math.Abs(nums[0]), nil
+	}
+	scale := 1.0
+	if len(nums) > 1 {
+		scale = math.Pow(10, math.Trunc(nums[1]))
+	}
+	return math.Round(nums[0]*scale) / scale, nil
+}
+
+var sqlAggregates = map[string]bool{"count": true, "sum": true, "avg": true, "min": true, "max": true}
+
+type sqlAggregate struct {
+	name     string
+	arg      sqlExpr // nil for count(*)
+	distinct bool
+	pos      int
+}
+
+func (e *sqlAggregate) eval(env *sqlEnv) (any, error) {
+	if e.arg == nil {
+		return float64(len(env.group)), nil
+	}
+	var values []any
+	seen := map[string]bool{}
+	for _, row := range env.group {
+		v, err := e.arg.eval(&sqlEnv{index: env.index, row: row})
+		if err != nil {
+			return nil, err
+		}
+		if v == nil {
+			continue
+		}
+		if e.distinct {
+			key := toJSON(v)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+		}
+		values = append(values, v)
+	}
+	switch e.name {
+	case "count":
+		return float64(len(values)), nil
+	case "min", "max":
+		var best any
+		for _, v := range values {
+			if best == nil {
+				best = v
+			} else if c := sqlCompare(v, best); e.name == "min" && c < 0 || e.name == "max" && c > 0 {
+				best = v
+			}
+		}
+		return best, nil
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	sum := 0.0
+	for _, v := range values {
+		n, ok := sqlNumeric(v)
+		if !ok {
+			return nil, errorf(e.pos, "%s expects numbers, got %s", e.name, describe(v))
+		}
+		sum += n
+	}
+	if e.name == "avg" {
+		return sum / float64(len(values)), nil
+	}
+	return sum, nil
+}
+
+// sqlNumberRe matches the strings read as numbers.
+var sqlNumberRe = regexp.MustCompile(`^\s*[+-]?(?:\d+\.?\d*|\.\d+)(?:[eE][+-]?\d+)?\s*$`)
+
+// sqlNumeric reads v as a number, accepting numeric strings.
+func sqlNumeric(v any) (float64, bool) {
+	switch val := v.(type) {
+	case float64:
+		return val, true
+	case string:
+		if sqlNumberRe.MatchString(val) {
+			f, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+			return f, err == nil
+		}
+	}
+	return 0, false
+}
+
+// sqlCompare orders two non-null values. A number and a numeric string
+// compare as numbers; otherwise values of different types fall back to
+// the jq type order.
+func sqlCompare(a, b any) int {
+	_, an := a.(float64)
+	_, bn := b.(float64)
+	if an || bn {
+		if x, ok := sqlNumeric(a); ok {
+			if y, ok := sqlNumeric(b); ok {
+				return compareValues(x, y)
+			}
+		}
+	}
+	return compareValues(a, b)
+}
+
+// sqlTruth reads v as a condition; known is false for NULL.
+func sqlTruth(v any) (b, known bool) {
+	switch val := v.(type) {
+	case nil:
+		return false, false
+	case bool:
+		return val, true
+	case float64:
+		return val != 0, true
+	case string:
+		return val != "", true
+	}
+	return true, true
+}
+
+// sqlText renders a value for string functions and concatenation.
+func sqlText(v any) string {
+	switch val := v.(type) {
+	case string:
+		return val
+	case nil:
+		return ""
+	}
+	return toJSON(v)
+}
+
+// ── Execution ─────────────────────────────────────────────────────────────────
+
+type sqlResultRow struct {
+	values []any
+	env    *sqlEnv
+	keys   []any // ORDER BY values
+}
+
+func (s *sqlSelect) run(t *Table) (*Table, error) {
+	index := make(map[string]int, len(t.Columns))
+	for i, c := range t.Columns {
+		if _, dup := index[c]; !dup {
+			index[c] = i
+		}
+	}
+
+	rows := t.Rows
+	if s.where != nil {
+		rows = nil
+		for _, row := range t.Rows {
+			v, err := s.where.eval(&sqlEnv{index: index, row: row})
+			if err != nil {
+				return nil, err
+			}
+			if ok, _ := sqlTruth(v); ok {
+				rows = append(rows, row)
+			}
+		}
+	}
+
+	// Expand * into the input columns.
+	var items []sqlItem
+	for _, item := range s.items {
+		if item.expr != nil {
+			items = append(items, item)
+			continue
+		}
+		for _, c := range t.Columns {
+			items = append(items, sqlItem{expr: &sqlColumn{name: c}, name: c})
+		}
+	}
+	columns := uniqueNames(items)
+	if s.grouped {
+		if err := s.checkGrouped(items, columns); err != nil {
+			return nil, err
+		}
+	}
+
+	var envs []*sqlEnv
+	if s.grouped {
+		groups, err := s.group(index, rows)
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range groups {
+			env := &sqlEnv{index: index, group: g}
+			if len(g) > 0 {
+				env.row = g[0]
+			}
+			envs = append(envs, env)
+		}
+	} else {
+		for _, row := range rows {
+			envs = append(envs, &sqlEnv{index: index, row: row})
+		}
+	}
+
+	var out []*sqlResultRow
+	for _, env := range envs {
+		r := &sqlResultRow{values: make([]any, len(items)), env: env}
+		output := make(map[string]any, len(items))
+		for i, item := range items {
+			v, err := item.expr.eval(env)
+			if err != nil {
+				return nil, err
+			}
+			r.values[i] = v
+			output[columns[i]] = v
+		}
+		env.output = output
+		if s.having != nil {
+			v, err := s.having.eval(env)
+			if err != nil {
+				return nil, err
+			}
+			if ok, _ := sqlTruth(v); !ok {
+				continue
+			}
+		}
+		out = append(out, r)
+	}
+
+	if len(s.orderBy) > 0 {
+		if err := s.sort(out, columns); err != nil {
+			return nil, err
+		}
+	}
+	if s.distinct {
+		seen := map[string]bool{}
+		kept := out[:0]
+		for _, r := range out {
+			key := toJSON(r.values)
+			if !seen[key] {
+				seen[key] = true
+				kept = append(kept, r)
+			}
+		}
+		out = kept
+	}
+	out = out[min(s.offset, len(out)):]
+	if s.limit >= 0 && s.limit < len(out) {
+		out = out[:s.limit]
+	}
+
+	result := &Table{Columns: columns, Rows: make([][]any, len(out))}
+	for i, r := range out {
+		result.Rows[i] = r.values
+	}
+	return result, nil
+}
+
+// checkGrouped rejects a column outside the aggregates of a grouped select
+// that it does not group by, since the column has no single value for a
+// group.
+func (s *sqlSelect) checkGrouped(items []sqlItem, columns []string) error {
+	grouped := map[string]bool{}
+	for _, e := range s.groupBy {
+		sqlColumns(e, func(c *sqlColumn) { grouped[c.name] = true })
+	}
+	var err error
+	check := func(selected bool) func(*sqlColumn) {
+		return func(c *sqlColumn) {
+			if err != nil || selected && slices.Contains(columns, c.name) {
+				return
+			}
+			// a.b reads from a grouped column a.
+			for name, i := c.name, len(c.name); i > 0; i = strings.LastIndexByte(name, '.') {
+				if name = name[:i]; grouped[name] {
+					return
+				}
+			}
+			err = errorf(c.pos, "column %q must appear in GROUP BY or be used in an aggregate", c.name)
+		}
+	}
+	for _, item := range items {
+		sqlColumns(item.expr, check(false))
+	}
+	if s.having != nil {
+		sqlColumns(s.having, check(true))
+	}
+	for _, o := range s.orderBy {
+		sqlColumns(o.expr, check(true))
+	}
+	return err
+}
+
+// sqlColumns calls fn for each column e reads outside aggregates.
+func sqlColumns(e sqlExpr, fn func(*sqlColumn)) {
+	var kids []sqlExpr
+	switch e := e.(type) {
+	case *sqlColumn:
+		fn(e)
+	case *sqlUnary:
+		kids = []sqlExpr{e.e}
+	case *sqlBinary:
+		kids = []sqlExpr{e.l, e.r}
+	case *sqlIsNull:
+		kids = []sqlExpr{e.e}
+	case *sqlLike:
+		kids = []sqlExpr{e.e, e.pattern}
+	case *sqlIn:
+		kids = append([]sqlExpr{e.e}, e.list...)
+	case *sqlBetween:
+		kids = []sqlExpr{e.e, e.lo, e.hi}
+	case *sqlCall:
+		kids = e.args
+	}
+	for _, k := range kids {
+		sqlColumns(k, fn)
+	}
+}
+
+// group splits rows by the GROUP BY values, in order of first appearance.
+// Without GROUP BY the whole table is one group, even when it is empty.
+func (s *sqlSelect) group(index map[string]int, rows [][]any) ([][][]any, error) {
+	if len(s.groupBy) == 0 {
+		return [][][]any{rows}, nil
+	}
+	var groups [][][]any
+	byKey := map[string]int{}
+	for _, row := range rows {
+		env := &sqlEnv{index: index, row: row}
+		key := make([]any, len(s.groupBy))
+		for i, e := range s.groupBy {
+			v, err := e.eval(env)
+			if err != nil {
+				return nil, err
+			}
+			key[i] = v
+		}
+		k := toJSON(key)
+		i, ok := byKey[k]
+		if !ok {
+			i = len(groups)
+			byKey[k] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], row)
+	}
+	return groups, nil
+}
+
+// sort orders the rows by ORDER BY, stably and with NULLs first. A bare
+// integer sorts by that output column, counting from 1.
+func (s *sqlSelect) sort(rows []*sqlResultRow, columns []string) error {
+	for _, r := range rows {
+		r.keys = make([]any, len(s.orderBy))
+		for i, o := range s.orderBy {
+			if lit, ok := o.expr.(*sqlLiteral); ok {
+				if n, ok := lit.v.(float64); ok {
+					if n != math.Trunc(n) || n < 1 || int(n) > len(columns) {
+						return errorf(o.pos, "ORDER BY position %s is out of range", formatNumber(n))
+					}
+					r.keys[i] = r.values[int(n)-1]
+					continue
+				}
+			}
+			v, err := o.expr.eval(r.env)
+			if err != nil {
+				return err
+			}
+			r.keys[i] = v
+		}
+	}
+	sort.SliceStable(rows, func(a, b int) bool {
+		for i, o := range s.orderBy {
+			x, y := rows[a].keys[i], rows[b].keys[i]
+			var c int
+			switch {
+			case x == nil && y == nil:
+			case x == nil:
+				c = -1
+			case y == nil:
+				c = 1
+			default:
+				c = sqlCompare(x, y)
+			}
+			if o.desc {
+				c = -c
+			}
+			if c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+	return nil
+}
+
+// uniqueNames returns the output column names, suffixing repeats with _2,
+// _3 and so on so that every column can become an object key.
+func uniqueNames(items []sqlItem) []string {
+	names := make([]string, len(items))
+	used := map[string]bool{}
+	for i, item := range items {
+		name := item.name
+		for n := 2; used[name]; n++ {
+			name = fmt.Sprintf("%s_%d", item.name, n)
+		}
+		used[name] = true
+		names[i] = name
+	}
+	return names
+}
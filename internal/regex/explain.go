@@ -0,0 +1,1261 @@
+package regex
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Token kinds.
+const (
+	KindLiteral       = "literal"
+	KindAny           = "any"
+	KindClass         = "class"
+	KindAnchor        = "anchor"
+	KindGroup         = "group"
+	KindLookaround    = "lookaround"
+	KindBackreference = "backreference"
+	KindQuantifier    = "quantifier"
+	KindAlternation   = "alternation"
+	KindSequence      = "sequence"
+	KindFlags         = "flags"
+	KindComment       = "comment"
+)
+
+// Token is one node of an explained pattern: a sequence or alternation,
+// a group or quantifier around its Children, or a single atom. Start and
+// End are byte offsets into the pattern.
+type Token struct {
+	Kind        string
+	Start, End  int
+	Text        string
+	Description string
+	Children    []*Token
+
+	plural   string          // describes several of a one-character atom
+	match    func(rune) bool // the characters a one-character atom matches
+	literal  string
+	fold     bool // the literal matches in any case
+	min, max int  // quantifier bounds; max < 0 is unbounded
+	lazy     bool
+	group    int // capture group number
+	name     string
+	capture  bool
+	atomic   bool
+	look     string // lookaround phrase, such as "followed by"
+	flagDesc string // flags a group turns on or off
+	ref      int    // backreference by number
+	refName  string // backreference by name
+}
+
+// Warning points at a construct that is probably a mistake or that can
+// make matching slow.
+type Warning struct {
+	Code       string
+	Start, End int
+	Message    string
+}
+
+// Explanation is a pattern parsed into described tokens.
+type Explanation struct {
+	Root     *Token
+	Groups   int // capture groups
+	Warnings []Warning
+}
+
+// Explain checks pattern with the engine itself, then parses it again
+// keeping every token's span so that each can be described in English.
+// The parser understands RE2 syntax and the Perl-style extras of the
+// backtracking engines: lookaround, backreferences, atomic groups, named
+// groups in all their spellings, inline comments and free-spacing mode.
+func Explain(pattern, flags string, engine Engine) (*Explanation, error) {
+	if err := validate(pattern, flags, engine); err != nil {
+		return nil, err
+	}
+	p := &parser{src: pattern, engine: engine}
+	for _, f := range flags {
+		p.flags.set(f, true)
+	}
+	root, err := p.parseAlt()
+	if err == nil && p.pos < len(p.src) {
+		err = p.errorf("unmatched )")
+	}
+	if err != nil {
+		return nil, err
+	}
+	groups := p.number()
+	p.describe(root)
+	p.check(root)
+	sort.SliceStable(p.warnings, func(i, j int) bool { return p.warnings[i].Start < p.warnings[j].Start })
+	return &Explanation{Root: root, Groups: groups, Warnings: p.warnings}, nil
+}
+
+// validate reports the engine's own error for a bad pattern. RE2 patterns
+// go through regexp/syntax, whose errors name the offending fragment; when
+// one fails only for want of a Perl extra, the error says so.
+func validate(pattern, flags string, engine Engine) error {
+	if engine != RE2 {
+		_, err := Compile(pattern, flags, engine)
+		return err
+	}
+	if _, err := parseRE2(pattern, flags); err != nil {
+		if _, btErr := Compile(pattern, strings.ReplaceAll(flags, "U", ""), Backtracking); btErr == nil {
+			return fmt.Errorf("%w (the backtracking engine supports this)", err)
+		}
+		return err
+	}
+	return nil
+}
+
+// parseRE2 parses pattern as regexp.Compile does, with flags applied.
+func parseRE2(pattern, flags string) (*syntax.Regexp, error) {
+	sflags := syntax.Perl
+	for _, f := range flags {
+		switch f {
+		case 'i':
+			sflags |= syntax.FoldCase
+		case 'm':
+			sflags &^= syntax.OneLine
+		case 's':
+			sflags |= syntax.DotNL
+		case 'U':
+			sflags |= syntax.NonGreedy
+		default:
+			return nil, fmt.Errorf("unknown flag %q; use i, m, s or U", f)
+		}
+	}
+	return syntax.Parse(pattern, sflags)
+}
+
+type flagSet struct {
+	i, m, s, x, n, U bool
+}
+
+func (f *flagSet) set(c rune, on bool) {
+	switch c {
+	case 'i':
+		f.i = on
+	case 'm':
+		f.m = on
+	case 's':
+		f.s = on
+	case 'x':
+		f.x = on
+	case 'n':
+		f.n = on
+	case 'U':
+		f.U = on
+	}
+}
+
+var flagNames = map[rune]string{
+	'i': "case-insensitive matching",
+	'm': "multi-line mode (^ and $ match at line breaks)",
+	's': "dot matches line breaks",
+	'x': "free-spacing mode",
+	'n': "explicit capture (only named groups capture)",
+	'U': "ungreedy quantifiers",
+}
+
+type parser struct {
+	src      string
+	pos      int
+	engine   Engine
+	flags    flagSet
+	groups   []*Token // capture groups in the order they open
+	warnings []Warning
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("offset %d: %s", p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) warn(code string, start, end int, format string, args ...any) {
+	msg := fmt.Sprintf(format, args...)
+	if p.engine == RE2 && strings.HasSuffix(code, "backtracking") {
+		msg += " RE2 matches in linear time, so this only matters if the pattern is reused with a backtracking engine such as PCRE, JavaScript, .NET, Java or Python."
+	}
+	p.warnings = append(p.warnings, Warning{Code: code, Start: start, End: end, Message: msg})
+}
+
+func (p *parser) peek() rune {
+	if p.pos >= len(p.src) {
+		return -1
+	}
+	r, _ := utf8.DecodeRuneInString(p.src[p.pos:])
+	return r
+}
+
+func (p *parser) next() rune {
+	r, n := utf8.DecodeRuneInString(p.src[p.pos:])
+	p.pos += n
+	return r
+}
+
+func (p *parser) eat(s string) bool {
+	if strings.HasPrefix(p.src[p.pos:], s) {
+		p.pos += len(s)
+		return true
+	}
+	return false
+}
+
+func (p *parser) token(kind string, start int) *Token {
+	return &Token{Kind: kind, Start: start, End: p.pos, Text: p.src[start:p.pos]}
+}
+
+// ── Parsing ───────────────────────────────────────────────────────────────────
+
+func (p *parser) parseAlt() (*Token, error) {
+	start := p.pos
+	var branches []*Token
+	for {
+		b, err := p.parseSeq()
+		if err != nil {
+			return nil, err
+		}
+		branches = append(branches, b)
+		if !p.eat("|") {
+			break
+		}
+	}
+	if len(branches) == 1 {
+		return branches[0], nil
+	}
+	for _, b := range branches {
+		if b.Kind == KindSequence && len(b.Children) == 0 {
+			p.warn("empty-alternative", start, p.pos,
+				"An empty alternative matches the empty string, so this alternation always succeeds; a ? after the group says that more clearly.")
+			break
+		}
+	}
+	t := p.token(KindAlternation, start)
+	t.Children = branches
+	return t, nil
+}
+
+func (p *parser) parseSeq() (*Token, error) {
+	start := p.pos
+	var items []*Token
+	for p.pos < len(p.src) && p.peek() != '|' && p.peek() != ')' {
+		atom, err := p.parseAtom()
+		if err != nil {
+			return nil, err
+		}
+		if atom == nil {
+			continue // free-spacing whitespace or comment
+		}
+		if atom, err = p.parseQuantifier(atom); err != nil {
+			return nil, err
+		}
+		items = append(items, atom)
+	}
+	items = p.mergeLiterals(items)
+	if len(items) == 1 {
+		return items[0], nil
+	}
+	t := p.token(KindSequence, start)
+	t.Children = items
+	return t, nil
+}
+
+// mergeLiterals joins adjacent literal characters into one literal run.
+func (p *parser) mergeLiterals(items []*Token) []*Token {
+	var out []*Token
+	for _, t := range items {
+		if n := len(out); n > 0 && t.Kind == KindLiteral && out[n-1].Kind == KindLiteral &&
+			out[n-1].End == t.Start && out[n-1].fold == t.fold {
+			prev := out[n-1]
+			prev.literal += t.literal
+			prev.End = t.End
+			prev.Text = p.src[prev.Start:prev.End]
+			continue
+		}
+		out = append(out, t)
+	}
+	return out
+}
+
+var repeatRe = regexp.MustCompile(`^\{(\d+)(,(\d*))?\}`)
+
+func (p *parser) parseQuantifier(atom *Token) (*Token, error) {
+	start := p.pos
+	q := &Token{Kind: KindQuantifier, Start: atom.Start, Children: []*Token{atom}}
+	switch p.peek() {
+	case '*':
+		q.min, q.max = 0, -1
+		p.pos++
+	case '+':
+		q.min, q.max = 1, -1
+		p.pos++
+	case '?':
+		q.min, q.max = 0, 1
+		p.pos++
+	case '{':
+		m := repeatRe.FindStringSubmatch(p.src[p.pos:])
+		if m == nil {
+			return atom, nil // a literal brace
+		}
+		q.min, _ = strconv.Atoi(m[1])
+		switch {
+		case m[2] == "":
+			q.max = q.min
+		case m[3] == "":
+			q.max = -1
+		default:
+			q.max, _ = strconv.Atoi(m[3])
+		}
+		if q.max >= 0 && q.max < q.min {
+			return nil, p.errorf("invalid repeat count %s", m[0])
+		}
+		p.pos += len(m[0])
+	default:
+		return atom, nil
+	}
+	switch atom.Kind {
+	case KindAnchor, KindFlags, KindComment:
+		p.pos = start
+		return nil, p.errorf("nothing to repeat")
+	}
+	q.lazy = p.eat("?") != p.flags.U
+	q.End, q.Text = p.pos, p.src[q.Start:p.pos]
+	return q, nil
+}
+
+func (p *parser) parseAtom() (*Token, error) {
+	start := p.pos
+	if p.flags.x {
+		if c := p.peek(); unicode.IsSpace(c) {
+			p.next()
+			return nil, nil
+		} else if c == '#' {
+			if i := strings.IndexByte(p.src[p.pos:], '\n'); i >= 0 {
+				p.pos += i + 1
+			} else {
+				p.pos = len(p.src)
+			}
+			return nil, nil
+		}
+	}
+	c := p.next()
+	switch c {
+	case '(':
+		return p.parseGroup(start)
+	case '[':
+		return p.parseClass(start)
+	case '\\':
+		return p.parseEscape(start)
+	case '.':
+		t := p.token(KindAny, start)
+		if p.flags.s {
+			t.Description, t.plural, t.match = "any character", "characters", func(rune) bool { return true }
+		} else {
+			t.Description, t.plural, t.match = "any character except a line break", "characters other than line breaks",
+				func(r rune) bool { return r != '\n' }
+		}
+		return t, nil
+	case '^':
+		t := p.token(KindAnchor, start)
+		t.Description = "the start of the text"
+		if p.flags.m {
+			t.Description = "the start of a line"
+		}
+		return t, nil
+	case '$':
+		t := p.token(KindAnchor, start)
+		switch {
+		case p.flags.m:
+			t.Description = "the end of a line"
+		case p.engine == Backtracking:
+			t.Description = "the end of the text, or just before a final line break"
+		default:
+			t.Description = "the end of the text"
+		}
+		return t, nil
+	case '*', '+', '?':
+		p.pos = start
+		return nil, p.errorf("missing argument to repetition operator %q", c)
+	case '{':
+		if repeatRe.MatchString(p.src[start:]) {
+			p.pos = start
+			return nil, p.errorf("missing argument to repetition operator")
+		}
+	}
+	return p.literal(start, c), nil
+}
+
+func (p *parser) literal(start int, c rune) *Token {
+	t := p.token(KindLiteral, start)
+	t.literal = string(c)
+	t.fold = p.flags.i && unicode.SimpleFold(c) != c
+	return t
+}
+
+func (p *parser) parseGroup(start int) (*Token, error) {
+	saved := p.flags
+	t := &Token{Kind: KindGroup, Start: start}
+	switch {
+	case p.eat("?#"):
+		i := strings.IndexByte(p.src[p.pos:], ')')
+		if i < 0 {
+			return nil, p.errorf("missing ) after comment")
+		}
+		p.pos += i + 1
+		t := p.token(KindComment, start)
+		t.Description = "a comment, ignored"
+		return t, nil
+	case p.eat("?:"):
+	case p.eat("?>"):
+		t.atomic = true
+	case p.eat("?="):
+		t.Kind, t.look = KindLookaround, "followed by"
+	case p.eat("?!"):
+		t.Kind, t.look = KindLookaround, "not followed by"
+	case p.eat("?<="):
+		t.Kind, t.look = KindLookaround, "preceded by"
+	case p.eat("?<!"):
+		t.Kind, t.look = KindLookaround, "not preceded by"
+	case p.eat("?P="):
+		name, err := p.until(')')
+		if err != nil {
+			return nil, err
+		}
+		t := p.token(KindBackreference, start)
+		t.refName = name
+		return t, nil
+	case p.eat("?P<"), p.eat("?<"):
+		name, err := p.until('>')
+		if err != nil {
+			return nil, err
+		}
+		t.capture, t.name = true, name
+	case p.eat("?'"):
+		name, err := p.until('\'')
+		if err != nil {
+			return nil, err
+		}
+		t.capture, t.name = true, name
+	case p.eat("?"):
+		var on, off []string
+		enable := true
+		for {
+			c := p.next()
+			switch {
+			case c == '-':
+				enable = false
+				continue
+			case c == ')':
+				t := p.token(KindFlags, start)
+				t.Description = flagChange(on, off) + " for the rest of the group"
+				return t, nil
+			case c == ':':
+			case flagNames[c] != "":
+				p.flags.set(c, enable)
+				if enable {
+					on = append(on, flagNames[c])
+				} else {
+					off = append(off, flagNames[c])
+				}
+				continue
+			default:
+				return nil, p.errorf("unknown group flag %q", c)
+			}
+			break
+		}
+		t.flagDesc = flagChange(on, off)
+	default:
+		t.capture = !p.flags.n
+	}
+	if t.capture {
+		p.groups = append(p.groups, t)
+	}
+
+	content, err := p.parseAlt()
+	if err != nil {
+		return nil, err
+	}
+	if !p.eat(")") {
+		return nil, p.errorf("missing closing )")
+	}
+	p.flags = saved
+	t.Children = []*Token{content}
+	t.End, t.Text = p.pos, p.src[start:p.pos]
+	return t, nil
+}
+
+// until reads up to the closing delimiter of a group name.
+func (p *parser) until(delim byte) (string, error) {
+	i := strings.IndexByte(p.src[p.pos:], delim)
+	if i < 0 {
+		return "", p.errorf("missing %q after group name", delim)
+	}
+	s := p.src[p.pos : p.pos+i]
+	p.pos += i + 1
+	return s, nil
+}
+
+func flagChange(on, off []string) string {
+	var parts []string
+	if len(on) > 0 {
+		parts = append(parts, "turn on "+joinList(on, "and"))
+	}
+	if len(off) > 0 {
+		parts = append(parts, "turn off "+joinList(off, "and"))
+	}
+	if len(parts) == 0 {
+		return "change no flags"
+	}
+	return strings.Join(parts, " and ")
+}
+
+var anchorEscapes = map[rune]string{
+	'b': "a word boundary",
+	'B': "a position that is not a word boundary",
+	'A': "the very start of the text",
+	'z': "the very end of the text",
+	'Z': "the end of the text, or just before a final line break",
+	'G': "the position where the previous match ended",
+}
+
+func (p *parser) parseEscape(start int) (*Token, error) {
+	if p.pos >= len(p.src) {
+		return nil, p.errorf("trailing backslash at end of expression")
+	}
+	c := p.next()
+	if d, ok := anchorEscapes[c]; ok {
+		t := p.token(KindAnchor, start)
+		t.Description = d
+		return t, nil
+	}
+	if item, ok, err := p.setEscape(c); ok || err != nil {
+		if err != nil {
+			return nil, err
+		}
+		t := p.token(KindClass, start)
+		t.Description, t.plural, t.match = item.one, item.many, item.match
+		return t, nil
+	}
+	switch {
+	case c == 'Q':
+		end := strings.Index(p.src[p.pos:], `\E`)
+		text := p.src[p.pos:]
+		if end >= 0 {
+			text = text[:end]
+			p.pos += end + 2
+		} else {
+			p.pos = len(p.src)
+		}
+		t := p.token(KindLiteral, start)
+		t.literal = text
+		return t, nil
+	case c == 'k' && strings.ContainsRune("<'{", p.peek()):
+		closer := map[rune]byte{'<': '>', '\'': '\'', '{': '}'}[p.next()]
+		name, err := p.until(closer)
+		if err != nil {
+			return nil, err
+		}
+		t := p.token(KindBackreference, start)
+		t.refName = name
+		return t, nil
+	case c >= '1' && c <= '9' && p.engine != RE2:
+		for p.pos < len(p.src) && p.src[p.pos] >= '0' && p.src[p.pos] <= '9' {
+			p.pos++
+		}
+		t := p.token(KindBackreference, start)
+		t.ref, _ = strconv.Atoi(p.src[start+1 : p.pos])
+		return t, nil
+	}
+	r, err := p.escapeChar(c)
+	if err != nil {
+		return nil, err
+	}
+	return p.literal(start, r), nil
+}
+
+// escapeChar decodes an escape that stands for one character.
+func (p *parser) escapeChar(c rune) (rune, error) {
+	switch c {
+	case 't':
+		return '\t', nil
+	case 'n':
+		return '\n', nil
+	case 'r':
+		return '\r', nil
+	case 'f':
+		return '\f', nil
+	case 'v':
+		return '\v', nil
+	case 'a':
+		return '\a', nil
+	case 'e':
+		return 0x1b, nil
+	case 'c':
+		if l := p.peek(); l >= 0 && l < utf8.RuneSelf && unicode.IsLetter(l) {
+			p.pos++
+			return l & 0x1f, nil
+		}
+	case 'x':
+		if p.eat("{") {
+			i := strings.IndexByte(p.src[p.pos:], '}')
+			if i < 0 {
+				return 0, p.errorf(`missing } in \x{...}`)
+			}
+			v, err := strconv.ParseUint(p.src[p.pos:p.pos+i], 16, 32)
+			if err != nil {
+				return 0, p.errorf(`invalid \x{%s}`, p.src[p.pos:p.pos+i])
+			}
+			p.pos += i + 1
+			return rune(v), nil
+		}
+		return p.hex(2)
+	case 'u':
+		return p.hex(4)
+	}
+	if c >= '0' && c <= '7' {
+		v := int(c - '0')
+		for i := 0; i < 2 && p.pos < len(p.src) && p.src[p.pos] >= '0' && p.src[p.pos] <= '7'; i++ {
+			v = v*8 + int(p.src[p.pos]-'0')
+			p.pos++
+		}
+		return rune(v), nil
+	}
+	if c < utf8.RuneSelf && !unicode.IsLetter(c) && !unicode.IsDigit(c) {
+		return c, nil // escaped punctuation
+	}
+	return 0, p.errorf(`unknown escape \%c`, c)
+}
+
+func (p *parser) hex(n int) (rune, error) {
+	if p.pos+n > len(p.src) {
+		return 0, p.errorf("expected %d hex digits", n)
+	}
+	v, err := strconv.ParseUint(p.src[p.pos:p.pos+n], 16, 32)
+	if err != nil {
+		return 0, p.errorf("expected %d hex digits", n)
+	}
+	p.pos += n
+	return rune(v), nil
+}
+
+// ── Character classes ─────────────────────────────────────────────────────────
+
+// classItem is one member of a bracketed class, or a shorthand such as \d.
+type classItem struct {
+	lo, hi    rune // a character or range when one is empty
+	one, many string
+	match     func(rune) bool
+}
+
+func not(f func(rune) bool) func(rune) bool { return func(r rune) bool { return !f(r) } }
+
+func isWord(r rune) bool { return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) }
+
+var shorthands = map[rune]classItem{
+	'd': {one: "a digit", many: "digits", match: unicode.IsDigit},
+	'D': {one: "a character other than a digit", many: "non-digits", match: not(unicode.IsDigit)},
+	'w': {one: "a word character (letter, digit or underscore)", many: "word characters", match: isWord},
+	'W': {one: "a character other than a letter, digit or underscore", many: "non-word characters", match: not(isWord)},
+	's': {one: "a whitespace character", many: "whitespace characters", match: unicode.IsSpace},
+	'S': {one: "a character other than whitespace", many: "non-whitespace characters", match: not(unicode.IsSpace)},
+}
+
+var posixClasses = map[string]classItem{
+	"alpha":  {one: "a letter", many: "letters", match: unicode.IsLetter},
+	"digit":  {one: "a digit", many: "digits", match: unicode.IsDigit},
+	"alnum":  {one: "a letter or digit", many: "letters and digits", match: func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) }},
+	"upper":  {one: "an uppercase letter", many: "uppercase letters", match: unicode.IsUpper},
+	"lower":  {one: "a lowercase letter", many: "lowercase letters", match: unicode.IsLower},
+	"space":  {one: "a whitespace character", many: "whitespace", match: unicode.IsSpace},
+	"blank":  {one: "a space or tab", many: "spaces and tabs", match: func(r rune) bool { return r == ' ' || r == '\t' }},
+	"punct":  {one: "a punctuation character", many: "punctuation", match: unicode.IsPunct},
+	"xdigit": {one: "a hexadecimal digit", many: "hexadecimal digits", match: func(r rune) bool { return strings.ContainsRune("0123456789abcdefABCDEF", r) }},
+	"word":   {one: "a word character", many: "word characters", match: isWord},
+	"cntrl":  {one: "a control character", many: "control characters", match: unicode.IsControl},
+	"print":  {one: "a printable character", many: "printable characters", match: unicode.IsPrint},
+	"graph":  {one: "a visible character", many: "visible characters", match: unicode.IsGraphic},
+	"ascii":  {one: "an ASCII character", many: "ASCII characters", match: func(r rune) bool { return r < utf8.RuneSelf }},
+}
+
+var unicodeClassNames = map[string]string{
+	"L": "letter", "Lu": "uppercase letter", "Ll": "lowercase letter", "Lt": "titlecase letter",
+	"Lm": "modifier letter", "Lo": "letter without case", "M": "combining mark", "N": "number",
+	"Nd": "decimal digit", "P": "punctuation character", "S": "symbol", "Sc": "currency symbol",
+	"Sm": "math symbol", "Z": "separator", "Zs": "space separator", "C": "control or other character",
+	"Cc": "control character",
+}
+
+// setEscape decodes an escape that stands for a set of characters.
+func (p *parser) setEscape(c rune) (classItem, bool, error) {
+	if item, ok := shorthands[c]; ok {
+		return item, true, nil
+	}
+	if c != 'p' && c != 'P' {
+		return classItem{}, false, nil
+	}
+	var name string
+	if p.eat("{") {
+		var err error
+		if name, err = p.until('}'); err != nil {
+			return classItem{}, true, err
+		}
+	} else if p.pos < len(p.src) {
+		name = string(p.next())
+	}
+	negate := c == 'P'
+	if n, ok := strings.CutPrefix(name, "^"); ok {
+		name, negate = n, !negate
+	}
+	noun := unicodeClassNames[name]
+	if noun == "" {
+		noun = name + " character"
+	}
+	item := classItem{one: "a " + noun, many: noun + "s"}
+	if strings.ContainsRune("aeiouAEIOU", rune(noun[0])) {
+		item.one = "an " + noun
+	}
+	if table := unicode.Categories[name]; table != nil {
+		item.match = func(r rune) bool { return unicode.Is(table, r) }
+	} else if table := unicode.Scripts[name]; table != nil {
+		item.match = func(r rune) bool { return unicode.Is(table, r) }
+	}
+	if negate {
+		item.one, item.many = "a character other than "+item.one, "characters other than "+item.many
+		if item.match != nil {
+			item.match = not(item.match)
+		}
+	}
+	return item, true, nil
+}
+
+var posixRe = regexp.MustCompile(`^\[:(\^?)([a-z]+):\]`)
+
+func (p *parser) classItem() (classItem, error) {
+	if m := posixRe.FindStringSubmatch(p.src[p.pos:]); m != nil {
+		item, ok := posixClasses[m[2]]
+		if !ok {
+			return classItem{}, p.errorf("unknown POSIX class %s", m[0])
+		}
+		p.pos += len(m[0])
+		if m[1] != "" {
+			item.one, item.many, item.match = "a character other than "+item.one, "characters other than "+item.many, not(item.match)
+		}
+		return item, nil
+	}
+	c := p.next()
+	if c != '\\' {
+		return classItem{lo: c, hi: c}, nil
+	}
+	if p.pos >= len(p.src) {
+		return classItem{}, p.errorf("trailing backslash in class")
+	}
+	c = p.next()
+	if item, ok, err := p.setEscape(c); ok || err != nil {
+		return item, err
+	}
+	if c == 'b' {
+		return classItem{lo: '\b', hi: '\b'}, nil // backspace inside a class
+	}
+	r, err := p.escapeChar(c)
+	return classItem{lo: r, hi: r}, err
+}
+
+// commonClasses names classes written as the usual ranges, keyed by their
+// members in sorted order.
+var commonClasses = map[string][2]string{
+	"0-9":           {"a digit", "digits"},
+	"a-z":           {"a lowercase letter", "lowercase letters"},
+	"A-Z":           {"an uppercase letter", "uppercase letters"},
+	"A-Z a-z":       {"a letter", "letters"},
+	"0-9 A-Z a-z":   {"a letter or digit", "letters or digits"},
+	"0-9 A-Z _ a-z": {"a word character (letter, digit or underscore)", "word characters"},
+	"0-9 A-F a-f":   {"a hexadecimal digit", "hexadecimal digits"},
+	"0-9 a-f":       {"a lowercase hexadecimal digit", "lowercase hexadecimal digits"},
+	"0-9 A-F":       {"an uppercase hexadecimal digit", "uppercase hexadecimal digits"},
+}
+
+func (p *parser) parseClass(start int) (*Token, error) {
+	negated := p.eat("^")
+	var items []classItem
+	for first := true; ; first = false {
+		if p.pos >= len(p.src) {
+			return nil, p.errorf("missing closing ]")
+		}
+		if p.peek() == ']' && !first {
+			p.pos++
+			break
+		}
+		itemStart := p.pos
+		item, err := p.classItem()
+		if err != nil {
+			return nil, err
+		}
+		if item.match == nil && strings.HasPrefix(p.src[p.pos:], "-") && !strings.HasPrefix(p.src[p.pos:], "-]") && p.pos+1 < len(p.src) {
+			p.pos++
+			hi, err := p.classItem()
+			if err != nil {
+				return nil, err
+			}
+			if hi.match != nil || hi.lo < item.lo {
+				return nil, p.errorf("invalid character class range %s", p.src[itemStart:p.pos])
+			}
+			item.hi = hi.lo
+			if unicode.IsUpper(item.lo) && unicode.IsLower(item.hi) {
+				p.warn("suspicious-range", itemStart, p.pos,
+					"The range %s also matches the punctuation between Z and a ([ \\ ] ^ _ `); write A-Za-z if you meant letters.", p.src[itemStart:p.pos])
+			}
+		}
+		if item.match == nil {
+			lo, hi := item.lo, item.hi
+			item.match = func(r rune) bool { return r >= lo && r <= hi }
+		}
+		items = append(items, item)
+	}
+
+	t := p.token(KindClass, start)
+	t.match = func(r rune) bool {
+		for _, it := range items {
+			if it.match(r) {
+				return !negated
+			}
+		}
+		return negated
+	}
+	if p.flags.i {
+		t.match = foldMatch(t.match)
+	}
+
+	var keys, list []string
+	for _, it := range items {
+		switch {
+		case it.many != "":
+			keys = append(keys, "?")
+			list = append(list, it.many)
+		case it.lo == it.hi:
+			keys = append(keys, string(it.lo))
+			list = append(list, quote(string(it.lo)))
+		default:
+			keys = append(keys, string(it.lo)+"-"+string(it.hi))
+			list = append(list, quote(string(it.lo))+" to "+quote(string(it.hi)))
+		}
+	}
+	sort.Strings(keys)
+	switch known, ok := commonClasses[strings.Join(keys, " ")]; {
+	case ok && !negated:
+		t.Description, t.plural = known[0], known[1]
+	case ok:
+		t.Description, t.plural = "a character other than "+known[0], "characters other than "+known[1]
+	case len(items) == 1 && items[0].one != "" && !negated:
+		t.Description, t.plural = items[0].one, items[0].many
+	case len(items) == 1 && items[0].lo == items[0].hi && items[0].many == "" && !negated:
+		t.Description, t.plural = charPhrases(items[0].lo)
+	case negated:
+		t.Description, t.plural = "a character other than "+joinList(list, "or"), "characters other than "+joinList(list, "or")
+	default:
+		t.Description, t.plural = "a character from "+joinList(list, "or"), "characters from "+joinList(list, "or")
+	}
+	return t, nil
+}
+
+// foldMatch extends a character test to every case of each character.
+func foldMatch(f func(rune) bool) func(rune) bool {
+	return func(r rune) bool {
+		for c := unicode.SimpleFold(r); ; c = unicode.SimpleFold(c) {
+			if f(c) {
+				return true
+			}
+			if c == r {
+				return false
+			}
+		}
+	}
+}
+
+// ── Description ───────────────────────────────────────────────────────────────
+
+// number assigns capture group numbers: in the order groups open, except
+// that .NET numbers named groups after all unnamed ones. It returns the
+// count of distinct groups.
+func (p *parser) number() int {
+	if p.engine != Backtracking {
+		for i, g := range p.groups {
+			g.group = i + 1
+		}
+		return len(p.groups)
+	}
+	n := 0
+	for _, g := range p.groups {
+		if g.name == "" {
+			n++
+			g.group = n
+		}
+	}
+	byName := map[string]int{}
+	for _, g := range p.groups {
+		if g.name == "" {
+			continue
+		}
+		if num, ok := byName[g.name]; ok {
+			g.group = num
+			continue
+		}
+		n++
+		g.group, byName[g.name] = n, n
+	}
+	return n
+}
+
+func (p *parser) describe(t *Token) {
+	for _, c := range t.Children {
+		p.describe(c)
+	}
+	switch t.Kind {
+	case KindLiteral:
+		t.Description, t.plural = literalPhrases(t.literal, t.fold)
+		first, _ := utf8.DecodeRuneInString(t.literal)
+		t.match = func(r rune) bool { return r == first }
+		if t.fold {
+			t.match = foldMatch(t.match)
+		}
+	case KindQuantifier:
+		t.Description = quantified(t)
+	case KindSequence:
+		if len(t.Children) == 0 {
+			t.Description = "nothing (the empty string)"
+			break
+		}
+		parts := make([]string, len(t.Children))
+		for i, c := range t.Children {
+			parts[i] = part(c)
+		}
+		t.Description = strings.Join(parts, ", then ")
+	case KindAlternation:
+		parts := make([]string, len(t.Children))
+		for i, c := range t.Children {
+			parts[i] = part(c)
+		}
+		t.Description = "either " + joinList(parts, "or")
+	case KindGroup:
+		content := t.Children[0]
+		switch {
+		case t.capture && t.name != "":
+			t.Description = fmt.Sprintf("%s, captured as group '%s'", part(content), t.name)
+		case t.capture:
+			t.Description = fmt.Sprintf("%s, captured as group %d", part(content), t.group)
+		case t.atomic:
+			t.Description = part(content) + ", matched atomically (no backtracking into it)"
+		case t.flagDesc != "":
+			t.Description = fmt.Sprintf("%s (%s)", part(content), t.flagDesc)
+		default:
+			t.Description, t.plural = content.Description, content.plural
+		}
+	case KindLookaround:
+		t.Description = t.look + " " + part(t.Children[0])
+	case KindBackreference:
+		if t.refName != "" {
+			t.Description = fmt.Sprintf("the same text that group '%s' matched", t.refName)
+		} else {
+			t.Description = fmt.Sprintf("the same text that group %d matched", t.ref)
+		}
+	}
+}
+
+func literalPhrases(s string, fold bool) (one, many string) {
+	if utf8.RuneCountInString(s) == 1 {
+		r, _ := utf8.DecodeRuneInString(s)
+		one, many = charPhrases(r)
+	} else {
+		one = "the text " + quote(s)
+	}
+	if fold {
+		one += " in any case"
+		if many != "" {
+			many += " in any case"
+		}
+	}
+	return one, many
+}
+
+func charPhrases(r rune) (one, many string) {
+	switch r {
+	case ' ':
+		return "a space", "spaces"
+	case '\t':
+		return "a tab", "tabs"
+	case '\n':
+		return "a line feed", "line feeds"
+	case '\r':
+		return "a carriage return", "carriage returns"
+	}
+	q := quote(string(r))
+	return "the character " + q, q + " characters"
+}
+
+// quote quotes s for a description, in single quotes when it holds a
+// double quote.
+func quote(s string) string {
+	if strings.Contains(s, `"`) && !strings.ContainsAny(s, "'\\") {
+		return "'" + s + "'"
+	}
+	return strconv.Quote(s)
+}
+
+// part is t's description, parenthesized when it has several parts of
+// its own, for use inside a longer description.
+func part(t *Token) string {
+	if (t.Kind == KindSequence && len(t.Children) > 1) || t.Kind == KindAlternation {
+		return "(" + t.Description + ")"
+	}
+	return t.Description
+}
+
+func quantified(q *Token) string {
+	child := q.Children[0]
+	var count string
+	switch {
+	case q.max < 0 && q.min == 0:
+		count = "zero or more"
+	case q.max < 0 && q.min == 1:
+		count = "one or more"
+	case q.max < 0:
+		count = fmt.Sprintf("%d or more", q.min)
+	case q.min == q.max:
+		count = fmt.Sprintf("exactly %d", q.min)
+	case q.min == 0:
+		count = fmt.Sprintf("up to %d", q.max)
+	default:
+		count = fmt.Sprintf("between %d and %d", q.min, q.max)
+	}
+
+	var s string
+	switch {
+	case q.min == 1 && q.max == 1:
+		s = child.Description
+	case q.min == 0 && q.max == 1 && child.Kind != KindGroup:
+		s = "optionally " + part(child)
+	case child.plural != "":
+		s = count + " " + child.plural
+	case strings.Contains(child.Description, ", "):
+		s = "(" + child.Description + "), repeated " + count + " times"
+	default:
+		s = child.Description + ", repeated " + count + " times"
+	}
+	if q.lazy && q.min != q.max {
+		s += " (as few as possible)"
+	}
+	return s
+}
+
+func joinList(items []string, conj string) string {
+	if len(items) <= 1 {
+		return strings.Join(items, "")
+	}
+	return strings.Join(items[:len(items)-1], ", ") + " " + conj + " " + items[len(items)-1]
+}
+
+// ── Pitfalls ──────────────────────────────────────────────────────────────────
+
+// check walks the tree for constructs that backtrack badly or that are
+// likely mistakes.
+func (p *parser) check(t *Token) {
+	switch t.Kind {
+	case KindQuantifier:
+		if t.max >= 0 {
+			break
+		}
+		subject := t.Children[0]
+		first := firstSet(subject)
+		flagged := false
+		for _, inner := range trailingRepeats(subject) {
+			if overlaps(firstSet(inner.Children[0]), first) {
+				p.warn("catastrophic-backtracking", t.Start, t.End,
+					"Nested quantifiers: %s repeats %s, which repeats without limit itself, so text that almost matches can be split between them in exponentially many ways (catastrophic backtracking). Remove one of the repetitions or make the inner one atomic.",
+					t.Text, inner.Text)
+				flagged = true
+				break
+			}
+		}
+		if alt := alternationOf(subject); !flagged && alt != nil {
+			for i, a := range alt.Children {
+				for _, b := range alt.Children[i+1:] {
+					if !flagged && overlaps(firstSet(a), firstSet(b)) {
+						p.warn("overlapping-backtracking", t.Start, t.End,
+							"The alternatives %s and %s can start with the same character; repeated by %s, a failing match may try every way of choosing between them. Make the alternatives mutually exclusive.",
+							strconv.Quote(a.Text), strconv.Quote(b.Text), strconv.Quote(t.Text[len(subject.Text):]))
+						flagged = true
+					}
+				}
+			}
+		}
+
+	case KindSequence:
+		for i, c := range t.Children {
+			if i+1 < len(t.Children) {
+				next := t.Children[i+1]
+				if c.Kind == KindQuantifier && next.Kind == KindQuantifier && c.max < 0 && next.max < 0 &&
+					overlaps(firstSet(c.Children[0]), firstSet(next.Children[0])) {
+					p.warn("polynomial-backtracking", c.Start, next.End,
+						"%s and %s can match the same characters, so a failing match tries every split between them (polynomial backtracking). Make one of them unable to match what the other does.",
+						c.Text, next.Text)
+				}
+			}
+			if c.Kind == KindAny && i > 0 && i+1 < len(t.Children) &&
+				endsAlnum(t.Children[i-1]) && startsAlnum(t.Children[i+1]) {
+				p.warn("unescaped-dot", c.Start, c.End,
+					`"." matches any character, so %s also matches text like %q; write \. to match only a dot.`,
+					t.Children[i-1].Text+"."+t.Children[i+1].Text,
+					t.Children[i-1].literal+"_"+t.Children[i+1].literal)
+			}
+		}
+	}
+	for _, c := range t.Children {
+		p.check(c)
+	}
+}
+
+func endsAlnum(t *Token) bool {
+	r, _ := utf8.DecodeLastRuneInString(t.literal)
+	return t.Kind == KindLiteral && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+func startsAlnum(t *Token) bool {
+	r, _ := utf8.DecodeRuneInString(t.literal)
+	return t.Kind == KindLiteral && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+// alternationOf returns the alternation a token consists of, looking
+// through plain groups.
+func alternationOf(t *Token) *Token {
+	for t.Kind == KindGroup && !t.atomic {
+		t = t.Children[0]
+	}
+	if t.Kind == KindAlternation {
+		return t
+	}
+	return nil
+}
+
+// trailingRepeats returns the unbounded quantifiers that can end a match
+// of t.
+func trailingRepeats(t *Token) []*Token {
+	switch t.Kind {
+	case KindQuantifier:
+		if t.max < 0 {
+			return []*Token{t}
+		}
+		return trailingRepeats(t.Children[0])
+	case KindGroup:
+		if t.atomic {
+			return nil
+		}
+		return trailingRepeats(t.Children[0])
+	case KindAlternation:
+		var out []*Token
+		for _, c := range t.Children {
+			out = append(out, trailingRepeats(c)...)
+		}
+		return out
+	case KindSequence:
+		var out []*Token
+		for i := len(t.Children) - 1; i >= 0; i-- {
+			out = append(out, trailingRepeats(t.Children[i])...)
+			if !canBeEmpty(t.Children[i]) {
+				break
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+func canBeEmpty(t *Token) bool {
+	switch t.Kind {
+	case KindLiteral, KindClass, KindAny:
+		return false
+	case KindQuantifier:
+		return t.min == 0 || canBeEmpty(t.Children[0])
+	case KindGroup:
+		return canBeEmpty(t.Children[0])
+	case KindSequence:
+		for _, c := range t.Children {
+			if !canBeEmpty(c) {
+				return false
+			}
+		}
+		return true
+	case KindAlternation:
+		for _, c := range t.Children {
+			if canBeEmpty(c) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// firstSet returns a test for the characters a match of t can start with,
+// or nil when that is unknown.
+func firstSet(t *Token) func(rune) bool {
+	switch t.Kind {
+	case KindLiteral, KindClass, KindAny:
+		return t.match
+	case KindQuantifier, KindGroup:
+		return firstSet(t.Children[0])
+	case KindSequence, KindAlternation:
+		var sets []func(rune) bool
+		for _, c := range t.Children {
+			switch c.Kind {
+			case KindAnchor, KindLookaround, KindFlags, KindComment:
+				continue // zero width
+			}
+			f := firstSet(c)
+			if f == nil {
+				return nil
+			}
+			sets = append(sets, f)
+			if t.Kind == KindSequence && !canBeEmpty(c) {
+				break
+			}
+		}
+		if len(sets) == 0 {
+			return nil
+		}
+		return func(r rune) bool {
+			for _, f := range sets {
+				if f(r) {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	return nil
+}
+
+// overlapProbes are the characters tried when checking whether two
+// character sets overlap: ASCII and a few letters, digits and spaces from
+// other scripts.
+var overlapProbes = func() []rune {
+	probes := []rune("éßλж中٣ ")
+	for r := rune(0); r < utf8.RuneSelf; r++ {
+		probes = append(probes, r)
+	}
+	return probes
+}()
+
+func overlaps(a, b func(rune) bool) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	for _, r := range overlapProbes {
+		if a(r) && b(r) {
+			return true
+		}
+	}
+	return false
+}
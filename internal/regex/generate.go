@@ -0,0 +1,355 @@
+package regex
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrNoMatch reports that Generate found no string the pattern matches.
+var ErrNoMatch = errors.New("no string matching the pattern was found")
+
+// ErrTooLong reports that a pattern's shortest match exceeds MaxSampleLen.
+var ErrTooLong = errors.New("the pattern's shortest match is too long")
+
+// Generate limits: the characters in one sample, and the work of making
+// and checking all the samples, and again all the near misses, of one
+// call. A string costs its length times the size of the compiled pattern.
+const (
+	MaxSampleLen    = 1000
+	maxGenerateWork = 1 << 25
+)
+
+// NearMiss is a string one edit away from a match that no longer matches.
+type NearMiss struct {
+	Text   string
+	Source string // the matching string it was made from
+	Change string
+}
+
+// Samples is the output of Generate.
+type Samples struct {
+	Matches    []string
+	NearMisses []NearMiss
+	Truncated  bool // maxGenerateWork ran out before n of each were found
+}
+
+// Generate returns up to n distinct random strings that the RE2 pattern
+// matches in full, and up to n near misses: single-character edits of them
+// that the pattern rejects. Repetitions go at most maxRepeat times beyond
+// their minimum, and samples are at most MaxSampleLen characters long. The
+// same seed gives the same output.
+//
+// A near miss contains no match at all, so it fails anchored and
+// unanchored use alike, unless the pattern also matches the empty string;
+// then it only fails to match in full.
+func Generate(pattern, flags string, n, maxRepeat int, seed uint64) (*Samples, error) {
+	re, err := parseRE2(pattern, flags)
+	if err != nil {
+		return nil, err
+	}
+	prefix := ""
+	if flags != "" {
+		prefix = "(?" + flags + ")"
+	}
+	check, err := regexp.Compile(prefix + pattern)
+	if err != nil {
+		return nil, err
+	}
+	check.Longest()
+	prog, err := syntax.Compile(re.Simplify())
+	if err != nil {
+		return nil, err
+	}
+	cost := func(s string) int { return max(utf8.RuneCountInString(s), 1) * len(prog.Inst) }
+	g := &generator{
+		rnd:       rand.New(rand.NewPCG(seed, seed^0x9e3779b97f4a7c15)),
+		maxRepeat: maxRepeat,
+		minLens:   map[*syntax.Regexp]int{},
+	}
+	switch m := g.minLen(re); {
+	case m == never:
+		return nil, fmt.Errorf("%w; it can never match", ErrNoMatch)
+	case m > MaxSampleLen:
+		return nil, fmt.Errorf("%w: %d characters, over the limit of %d", ErrTooLong, m, MaxSampleLen)
+	}
+	full := func(s string) bool {
+		loc := check.FindStringIndex(s)
+		return loc != nil && loc[0] == 0 && loc[1] == len(s)
+	}
+	miss := func(s string) bool { return !check.MatchString(s) }
+	if full("") {
+		miss = func(s string) bool { return !full(s) }
+	}
+
+	out := &Samples{}
+	seen := map[string]bool{}
+	budget := maxGenerateWork
+	// Word boundaries and anchors are ignored while generating, so some
+	// candidates fail the final check; a pattern with few matches also
+	// repeats itself. Both are bounded by the attempt and work budgets.
+	for attempts := 0; len(out.Matches) < n && attempts < 20*n+100; attempts++ {
+		var b strings.Builder
+		g.n, g.reserve = 0, 0
+		if !g.gen(re, &b) {
+			return nil, fmt.Errorf("%w; it can never match", ErrNoMatch)
+		}
+		s := b.String()
+		if budget -= cost(s); budget < 0 {
+			out.Truncated = true
+			break
+		}
+		if !seen[s] && full(s) {
+			seen[s] = true
+			out.Matches = append(out.Matches, s)
+		}
+	}
+	if len(out.Matches) == 0 {
+		return nil, fmt.Errorf("%w; word boundaries or anchors may contradict the rest of the pattern", ErrNoMatch)
+	}
+
+	budget = maxGenerateWork
+	for attempts := 0; len(out.NearMisses) < n && attempts < 20*n+100; attempts++ {
+		src := out.Matches[attempts%len(out.Matches)]
+		m := g.mutate(src)
+		if budget -= cost(m.Text); budget < 0 {
+			out.Truncated = true
+			break
+		}
+		if !seen[m.Text] && miss(m.Text) {
+			seen[m.Text] = true
+			out.NearMisses = append(out.NearMisses, m)
+		}
+	}
+	return out, nil
+}
+
+// generator builds one sample at a time. n counts the characters written
+// so far and reserve those the rest of the pattern still needs at least,
+// so optional parts are only added while the sample stays within
+// MaxSampleLen.
+type generator struct {
+	rnd        *rand.Rand
+	maxRepeat  int
+	n, reserve int
+	minLens    map[*syntax.Regexp]int
+}
+
+// gen appends a random match of re to b, reporting false for a pattern
+// that matches nothing.
+func (g *generator) gen(re *syntax.Regexp, b *strings.Builder) bool {
+	switch re.Op {
+	case syntax.OpNoMatch:
+		return false
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			if re.Flags&syntax.FoldCase != 0 {
+				r = g.fold(r)
+			}
+			g.write(b, r)
+		}
+	case syntax.OpCharClass:
+		if len(re.Rune) == 0 {
+			return false
+		}
+		g.write(b, g.pick(re.Rune))
+	case syntax.OpAnyCharNotNL:
+		g.write(b, g.pick([]rune{0, '\n' - 1, '\n' + 1, unicode.MaxRune}))
+	case syntax.OpAnyChar:
+		g.write(b, g.pick([]rune{0, unicode.MaxRune}))
+	case syntax.OpCapture:
+		return g.gen(re.Sub[0], b)
+	case syntax.OpStar:
+		return g.repeat(re.Sub[0], 0, -1, b)
+	case syntax.OpPlus:
+		return g.repeat(re.Sub[0], 1, -1, b)
+	case syntax.OpQuest:
+		return g.repeat(re.Sub[0], 0, 1, b)
+	case syntax.OpRepeat:
+		return g.repeat(re.Sub[0], re.Min, re.Max, b)
+	case syntax.OpConcat:
+		reserve := g.reserve
+		defer func() { g.reserve = reserve }()
+		rest := g.minLen(re)
+		for _, sub := range re.Sub {
+			rest -= g.minLen(sub)
+			g.reserve = reserve + rest
+			if !g.gen(sub, b) {
+				return false
+			}
+		}
+	case syntax.OpAlternate:
+		var fits []*syntax.Regexp
+		for _, sub := range re.Sub {
+			if g.fits(g.minLen(sub)) {
+				fits = append(fits, sub)
+			}
+		}
+		if len(fits) == 0 {
+			return false // only alternatives that match nothing
+		}
+		return g.gen(fits[g.rnd.IntN(len(fits))], b)
+	}
+	// Empty matches, anchors and word boundaries add nothing.
+	return true
+}
+
+func (g *generator) repeat(re *syntax.Regexp, lo, hi int, b *strings.Builder) bool {
+	if hi < 0 || hi > lo+g.maxRepeat {
+		hi = lo + g.maxRepeat
+	}
+	reps := lo + g.rnd.IntN(hi-lo+1)
+	m := g.minLen(re)
+	reserve := g.reserve
+	defer func() { g.reserve = reserve }()
+	for i := range reps {
+		g.reserve = reserve + max(lo-1-i, 0)*m
+		if i >= lo && !g.fits(m) {
+			break
+		}
+		if !g.gen(re, b) {
+			return false
+		}
+	}
+	return true
+}
+
+func (g *generator) write(b *strings.Builder, r rune) {
+	b.WriteRune(r)
+	g.n++
+}
+
+// fits reports whether m more characters keep the sample within
+// MaxSampleLen.
+func (g *generator) fits(m int) bool {
+	return g.n+m+g.reserve <= MaxSampleLen
+}
+
+// never is the minimum length of a pattern that matches nothing, so that
+// it never fits.
+const never = math.MaxInt32
+
+// minLen is the length in characters of the shortest string re matches,
+// at most never.
+func (g *generator) minLen(re *syntax.Regexp) int {
+	if m, ok := g.minLens[re]; ok {
+		return m
+	}
+	m := 0
+	switch re.Op {
+	case syntax.OpNoMatch:
+		m = never
+	case syntax.OpLiteral:
+		m = len(re.Rune)
+	case syntax.OpCharClass:
+		m = 1
+		if len(re.Rune) == 0 {
+			m = never
+		}
+	case syntax.OpAnyCharNotNL, syntax.OpAnyChar:
+		m = 1
+	case syntax.OpCapture, syntax.OpPlus:
+		m = g.minLen(re.Sub[0])
+	case syntax.OpRepeat:
+		m = min(re.Min*g.minLen(re.Sub[0]), never)
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			m = min(m+g.minLen(sub), never)
+		}
+	case syntax.OpAlternate:
+		m = never
+		for _, sub := range re.Sub {
+			m = min(m, g.minLen(sub))
+		}
+	}
+	g.minLens[re] = m
+	return m
+}
+
+// fold returns a random case of r.
+func (g *generator) fold(r rune) rune {
+	for range g.rnd.IntN(4) {
+		r = unicode.SimpleFold(r)
+	}
+	return r
+}
+
+// pick returns a random rune from a class given as lo-hi pairs, mostly
+// from its printable ASCII members so that samples stay readable.
+func (g *generator) pick(ranges []rune) rune {
+	if r, ok := g.pickIn(ranges, ' ', '~'); ok && g.rnd.IntN(10) > 0 {
+		return r
+	}
+	if r, ok := g.pickIn(ranges, 0xa1, 0x2fff); ok && g.rnd.IntN(2) > 0 {
+		return r // Latin, Greek, Cyrillic and other alphabets
+	}
+	for range 100 {
+		if r, _ := g.pickIn(ranges, 0, unicode.MaxRune); utf8.ValidRune(r) {
+			return r
+		}
+	}
+	return ranges[0] // a class of nothing but surrogates
+}
+
+// pickIn picks uniformly from the members of ranges between lo and hi.
+func (g *generator) pickIn(ranges []rune, lo, hi rune) (rune, bool) {
+	total := 0
+	for i := 0; i < len(ranges); i += 2 {
+		if a, b := max(ranges[i], lo), min(ranges[i+1], hi); a <= b {
+			total += int(b-a) + 1
+		}
+	}
+	if total == 0 {
+		return 0, false
+	}
+	k := g.rnd.IntN(total)
+	for i := 0; i < len(ranges); i += 2 {
+		a, b := max(ranges[i], lo), min(ranges[i+1], hi)
+		if a > b {
+			continue
+		}
+		if k <= int(b-a) {
+			return a + rune(k), true
+		}
+		k -= int(b-a) + 1
+	}
+	return 0, false
+}
+
+// mutateChars are the replacements and insertions near misses are made
+// of: one of each broad kind of character.
+var mutateChars = []rune("aZ5_ -.@!")
+
+func (g *generator) mutate(src string) NearMiss {
+	runes := []rune(src)
+	nm := NearMiss{Source: src}
+	at := g.rnd.IntN(len(runes) + 1)
+	c := mutateChars[g.rnd.IntN(len(mutateChars))]
+	switch kind := g.rnd.IntN(4); {
+	case len(runes) == 0 || kind == 0:
+		nm.Text = string(runes[:at]) + string(c) + string(runes[at:])
+		nm.Change = fmt.Sprintf("inserted %q at character %d", c, at+1)
+	case kind == 1:
+		at = min(at, len(runes)-1)
+		nm.Text = string(runes[:at]) + string(runes[at+1:])
+		nm.Change = fmt.Sprintf("deleted %q at character %d", runes[at], at+1)
+	case kind == 2:
+		at = min(at, len(runes)-1)
+		nm.Text = string(runes[:at]) + string(c) + string(runes[at+1:])
+		nm.Change = fmt.Sprintf("replaced %q at character %d with %q", runes[at], at+1, c)
+	default:
+		at = min(at, len(runes)-1)
+		nm.Text = string(runes[:at])
+		nm.Change = fmt.Sprintf("truncated after character %d", at)
+		if at == 0 {
+			nm.Change = "emptied"
+		}
+	}
+	return nm
+}
@@ -0,0 +1,297 @@
+// Package regex runs regular expressions on either Go's RE2 engine or the
+// backtracking regexp2 engine, which adds lookaround, backreferences and
+// atomic groups in its .NET (largely PCRE-compatible) and ECMAScript
+// dialects. Matches are reported group by group with byte and rune offsets.
+//
+// Explain parses a pattern into a tree of tokens with their spans and plain
+// English descriptions, and flags constructs prone to catastrophic
+// backtracking along with a few common mistakes. Generate walks the same
+// syntax tree to produce random matching strings and near misses.
+package regex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dlclark/regexp2"
+	"github.com/dlclark/regexp2/syntax"
+)
+
+// Engine selects the regular expression implementation.
+type Engine int
+
+const (
+	RE2          Engine = iota // Go's regexp: linear time, no lookaround or backreferences
+	Backtracking               // regexp2 with .NET syntax
+	ECMAScript                 // regexp2 with JavaScript syntax
+)
+
+// ErrTimeout reports that a backtracking search ran past the deadline of
+// its context.
+var ErrTimeout = errors.New("search timed out")
+
+// Regexp is a compiled expression for one of the engines.
+type Regexp struct {
+	re2  *regexp.Regexp
+	bt   *regexp2.Regexp
+	opts regexp2.RegexOptions
+}
+
+// Group is one capture group of a match. Start and End are byte offsets
+// into the text, RuneStart and RuneEnd code point offsets; all are zero
+// for a group that took no part in the match.
+type Group struct {
+	Number             int
+	Name               string // empty for unnamed groups
+	Matched            bool
+	Text               string
+	Start, End         int
+	RuneStart, RuneEnd int
+}
+
+// Match is one match; Groups[0] is the whole of it.
+type Match struct {
+	Groups []Group
+}
+
+// Compile compiles pattern for engine. Flags may hold i (ignore case),
+// m (^ and $ match at line breaks), s (. matches \n) and, for RE2 only,
+// U (swap greedy and lazy quantifiers).
+func Compile(pattern, flags string, engine Engine) (*Regexp, error) {
+	for _, f := range flags {
+		if !strings.ContainsRune("imsU", f) {
+			return nil, fmt.Errorf("unknown flag %q; use i, m, s or U", f)
+		}
+	}
+	if engine == RE2 {
+		if flags != "" {
+			pattern = "(?" + flags + ")" + pattern
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		return &Regexp{re2: re}, nil
+	}
+
+	var opts regexp2.RegexOptions
+	if engine == ECMAScript {
+		opts |= regexp2.ECMAScript
+	}
+	for _, f := range flags {
+		switch f {
+		case 'i':
+			opts |= regexp2.IgnoreCase
+		case 'm':
+			opts |= regexp2.Multiline
+		case 's':
+			opts |= regexp2.Singleline
+		case 'U':
+			return nil, fmt.Errorf("flag U is only supported by RE2; use lazy quantifiers such as *? instead")
+		}
+	}
+	re, err := regexp2.Compile(pattern, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Regexp{bt: re, opts: opts}, nil
+}
+
+// GroupNames returns the name of each group by number, with "" for group
+// 0 and for unnamed groups.
+func (r *Regexp) GroupNames() []string {
+	if r.re2 != nil {
+		return r.re2.SubexpNames()
+	}
+	nums := r.bt.GetGroupNumbers()
+	names := make([]string, 0, len(nums))
+	for _, n := range nums {
+		names = append(names, r.groupName(n))
+	}
+	return names
+}
+
+func (r *Regexp) groupName(n int) string {
+	if name := r.bt.GroupNameFromNumber(n); name != strconv.Itoa(n) {
+		return name
+	}
+	return ""
+}
+
+// FindAll returns up to limit matches from left to right; truncated
+// reports that there were more. The backtracking engines stop at the
+// deadline of ctx, which covers all the matches rather than each one;
+// RE2 runs in linear time and ignores it.
+func (r *Regexp) FindAll(ctx context.Context, text string, limit int) (matches []Match, truncated bool, err error) {
+	offsets := runeOffsets(text)
+	if r.re2 != nil {
+		names := r.re2.SubexpNames()
+		for _, idx := range r.re2.FindAllStringSubmatchIndex(text, limit+1) {
+			if len(matches) == limit {
+				return matches, true, nil
+			}
+			m := Match{Groups: make([]Group, len(names))}
+			for n := range names {
+				g := Group{Number: n, Name: names[n]}
+				if start, end := idx[2*n], idx[2*n+1]; start >= 0 {
+					g.Matched, g.Text, g.Start, g.End = true, text[start:end], start, end
+					g.RuneStart, g.RuneEnd = sort.SearchInts(offsets, start), sort.SearchInts(offsets, end)
+				}
+				m.Groups[n] = g
+			}
+			matches = append(matches, m)
+		}
+		return matches, false, nil
+	}
+
+	if err := r.arm(ctx); err != nil {
+		return nil, false, err
+	}
+	bm, err := r.bt.FindStringMatch(text)
+	for ; bm != nil && err == nil; bm, err = r.next(ctx, bm) {
+		if len(matches) == limit {
+			return matches, true, nil
+		}
+		groups := bm.Groups()
+		m := Match{Groups: make([]Group, len(groups))}
+		for i, bg := range groups {
+			n := r.bt.GroupNumberFromName(bg.Name)
+			g := Group{Number: n, Name: r.groupName(n)}
+			if len(bg.Captures) > 0 {
+				g.Matched, g.Text = true, bg.String()
+				g.RuneStart, g.RuneEnd = bg.Index, bg.Index+bg.Length
+				g.Start, g.End = offsets[g.RuneStart], offsets[g.RuneEnd]
+			}
+			m.Groups[i] = g
+		}
+		matches = append(matches, m)
+	}
+	return matches, false, searchErr(ctx, err)
+}
+
+// Replace replaces every match with repl, in which $1, ${1} and ${name}
+// stand for groups and $$ for a dollar sign. Like FindAll, the
+// backtracking engines stop at the deadline of ctx.
+func (r *Regexp) Replace(ctx context.Context, text, repl string) (string, error) {
+	if r.re2 != nil {
+		return r.re2.ReplaceAllString(text, repl), nil
+	}
+	data, err := r.replacer(repl)
+	if err != nil {
+		return "", err
+	}
+	if err := r.arm(ctx); err != nil {
+		return "", err
+	}
+	// regexp2's own Replace restarts the timeout for every match and
+	// drops errors after the first, so the matches are walked here.
+	offsets := runeOffsets(text)
+	var b strings.Builder
+	prev := 0
+	bm, err := r.bt.FindStringMatch(text)
+	for ; bm != nil && err == nil; bm, err = r.next(ctx, bm) {
+		start, end := offsets[bm.Index], offsets[bm.Index+bm.Length]
+		b.WriteString(text[prev:start])
+		expand(&b, data, bm, text, start, end)
+		prev = end
+	}
+	if err != nil {
+		return "", searchErr(ctx, err)
+	}
+	b.WriteString(text[prev:])
+	return b.String(), nil
+}
+
+// replacer parses repl with regexp2's own replacement syntax, which needs
+// the group numbering of the expression.
+func (r *Regexp) replacer(repl string) (*syntax.ReplacerData, error) {
+	nums := r.bt.GetGroupNumbers()
+	caps := make(map[int]int, len(nums))
+	names := make(map[string]int)
+	for i, n := range nums {
+		caps[n] = i
+		if name := r.groupName(n); name != "" {
+			names[name] = n
+		}
+	}
+	return syntax.NewReplacerData(repl, caps, len(nums), names, syntax.RegexOptions(r.opts))
+}
+
+// expand writes the replacement for the match spanning text[start:end].
+// Each rule is a literal, a group slot, or one of $` $' $+ $_ encoded as
+// -1 to -4 by regexp2.
+func expand(b *strings.Builder, data *syntax.ReplacerData, m *regexp2.Match, text string, start, end int) {
+	groups := m.Groups()
+	for _, rule := range data.Rules {
+		if rule >= 0 {
+			b.WriteString(data.Strings[rule])
+			continue
+		}
+		switch slot := -5 - rule; slot {
+		case -1:
+			b.WriteString(text[:start])
+		case -2:
+			b.WriteString(text[end:])
+		case -3:
+			b.WriteString(groups[len(groups)-1].String())
+		case -4:
+			b.WriteString(text)
+		default:
+			b.WriteString(groups[slot].String())
+		}
+	}
+}
+
+// arm gives the next backtracking search whatever time is left before
+// the deadline of ctx.
+func (r *Regexp) arm(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return searchErr(ctx, err)
+	}
+	r.bt.MatchTimeout = regexp2.DefaultMatchTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		left := time.Until(deadline)
+		if left <= 0 {
+			return ErrTimeout
+		}
+		r.bt.MatchTimeout = left
+	}
+	return nil
+}
+
+func (r *Regexp) next(ctx context.Context, m *regexp2.Match) (*regexp2.Match, error) {
+	if err := r.arm(ctx); err != nil {
+		return nil, err
+	}
+	return r.bt.FindNextMatch(m)
+}
+
+// searchErr replaces regexp2's timeout error, which quotes the whole
+// input, with ErrTimeout, and passes cancellation through.
+func searchErr(ctx context.Context, err error) error {
+	switch {
+	case err == nil, errors.Is(err, ErrTimeout):
+		return err
+	case errors.Is(err, context.Canceled), errors.Is(ctx.Err(), context.Canceled):
+		return context.Canceled
+	default:
+		return ErrTimeout
+	}
+}
+
+// runeOffsets returns the byte offset of every code point in text, and of
+// its end. Invalid bytes count as one code point each, as they do when a
+// string is converted to []rune.
+func runeOffsets(text string) []int {
+	offsets := make([]int, 0, len(text)+1)
+	for i := range text {
+		offsets = append(offsets, i)
+	}
+	return append(offsets, len(text))
+}
@@ -0,0 +1,388 @@
+package regex
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestFindAll(t *testing.T) {
+	for _, engine := range []Engine{RE2, Backtracking, ECMAScript} {
+		re, err := Compile(`(?P<key>[^=\s]+)=(\d+)?`, "", engine)
+		if engine != RE2 {
+			re, err = Compile(`(?<key>[^=\s]+)=(\d+)?`, "", engine)
+		}
+		if err != nil {
+			t.Fatalf("engine %d: %v", engine, err)
+		}
+		matches, truncated, err := re.FindAll(context.Background(), "héllo=1 x=", 10)
+		if err != nil || truncated || len(matches) != 2 {
+			t.Fatalf("engine %d: matches = %+v, truncated %v, err %v", engine, matches, truncated, err)
+		}
+		groups := map[string]Group{}
+		for _, g := range matches[0].Groups {
+			groups[g.Name] = g
+		}
+		if g := groups["key"]; g.Text != "héllo" || g.Start != 0 || g.End != 6 || g.RuneStart != 0 || g.RuneEnd != 5 {
+			t.Errorf("engine %d: key group = %+v", engine, g)
+		}
+		if g := matches[0].Groups[0]; g.Start != 0 || g.End != 8 || g.RuneEnd != 7 {
+			t.Errorf("engine %d: whole match = %+v", engine, g)
+		}
+		var unmatched int
+		for _, g := range matches[1].Groups {
+			if !g.Matched {
+				unmatched++
+			}
+		}
+		if unmatched != 1 {
+			t.Errorf("engine %d: second match groups = %+v", engine, matches[1].Groups)
+		}
+	}
+}
+
+func TestFindAll_Limit(t *testing.T) {
+	re, err := Compile(`a`, "", RE2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if matches, truncated, _ := re.FindAll(context.Background(), "aaaa", 3); len(matches) != 3 || !truncated {
+		t.Errorf("matches = %d, truncated %v", len(matches), truncated)
+	}
+	if matches, truncated, _ := re.FindAll(context.Background(), "aaa", 3); len(matches) != 3 || truncated {
+		t.Errorf("matches = %d, truncated %v", len(matches), truncated)
+	}
+}
+
+func TestCompile_Flags(t *testing.T) {
+	tests := []struct {
+		engine  Engine
+		pattern string
+		flags   string
+		text    string
+		want    []string
+	}{
+		{RE2, `^b.`, "ims", "a\nB\nc", []string{"B\n"}},
+		{RE2, `a+`, "U", "aaa", []string{"a", "a", "a"}},
+		{Backtracking, `^b.`, "ims", "a\nB\nc", []string{"B\n"}},
+		{Backtracking, `\w+(?=!)`, "", "hi! there", []string{"hi"}},
+		{Backtracking, `(\w)\1`, "", "abba", []string{"bb"}},
+		{ECMAScript, `(?<!\$)\b\d+`, "", "$5 and 7", []string{"7"}},
+	}
+	for _, tt := range tests {
+		re, err := Compile(tt.pattern, tt.flags, tt.engine)
+		if err != nil {
+			t.Errorf("%s: %v", tt.pattern, err)
+			continue
+		}
+		matches, _, err := re.FindAll(context.Background(), tt.text, 10)
+		if err != nil {
+			t.Errorf("%s: %v", tt.pattern, err)
+			continue
+		}
+		var got []string
+		for _, m := range matches {
+			got = append(got, m.Groups[0].Text)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s /%s: got %q, want %q", tt.pattern, tt.flags, got, tt.want)
+		}
+	}
+
+	for _, bad := range []struct {
+		engine Engine
+		flags  string
+	}{{RE2, "x"}, {Backtracking, "U"}} {
+		if _, err := Compile("a", bad.flags, bad.engine); err == nil {
+			t.Errorf("engine %d flags %q: expected an error", bad.engine, bad.flags)
+		}
+	}
+	if _, err := Compile(`(?=a)`, "", RE2); err == nil {
+		t.Error("RE2 should reject lookahead")
+	}
+}
+
+func TestReplace(t *testing.T) {
+	tests := []struct {
+		engine  Engine
+		pattern string
+		repl    string
+		want    string
+	}{
+		{RE2, `(?P<y>\d{4})-(\d\d)`, "${2}/${y} $$", "09/2024 $ rest"},
+		// .NET numbers unnamed groups before named ones.
+		{Backtracking, `(?<y>\d{4})-(\d\d)`, "${1}/${y} $$", "09/2024 $ rest"},
+		{Backtracking, `\d(?=-)|e`, "<$&$'>", "202<4-09 rest>-09 r<est>st"},
+		{ECMAScript, `(\d+)-(\d+)`, "$2-$1", "09-2024 rest"},
+	}
+	for _, tt := range tests {
+		re, err := Compile(tt.pattern, "", tt.engine)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := re.Replace(context.Background(), "2024-09 rest", tt.repl)
+		if err != nil || got != tt.want {
+			t.Errorf("engine %d: got %q (%v), want %q", tt.engine, got, err, tt.want)
+		}
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	re, err := Compile(`(a+)+$`, "", Backtracking)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	text := strings.Repeat("a", 40) + "!"
+	if _, _, err := re.FindAll(ctx, text, 10); !errors.Is(err, ErrTimeout) || strings.Contains(err.Error(), text) {
+		t.Errorf("FindAll() error = %v, want ErrTimeout without the input", err)
+	}
+}
+
+func TestTimeout_Total(t *testing.T) {
+	// Each segment takes a few tens of milliseconds to fail before "c"
+	// matches, so a deadline that restarted with every match would never
+	// be reached.
+	re, err := Compile(`(a+)+b|c`, "", Backtracking)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := strings.Repeat(strings.Repeat("a", 16)+"c", 20)
+	for name, run := range map[string]func(context.Context) error{
+		"find": func(ctx context.Context) error {
+			_, _, err := re.FindAll(ctx, text, 100)
+			return err
+		},
+		"replace": func(ctx context.Context) error {
+			_, err := re.Replace(ctx, text, "x")
+			return err
+		},
+	} {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		start := time.Now()
+		err := run(ctx)
+		cancel()
+		if !errors.Is(err, ErrTimeout) {
+			t.Errorf("%s: error = %v, want ErrTimeout", name, err)
+		}
+		if d := time.Since(start); d > time.Second {
+			t.Errorf("%s: took %v", name, d)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := re.FindAll(ctx, text, 100); !errors.Is(err, context.Canceled) {
+		t.Errorf("FindAll() on a cancelled context: error = %v", err)
+	}
+}
+
+func TestExplain(t *testing.T) {
+	tests := []struct {
+		pattern string
+		engine  Engine
+		want    string
+	}{
+		{`(?P<year>\d{4})`, RE2, "exactly 4 digits, captured as group 'year'"},
+		{`(?<year>\d+)`, Backtracking, "one or more digits, captured as group 'year'"},
+		{`https?://`, RE2, `the text "http", then optionally the character "s", then the text "://"`},
+		{`[^a-z0-9_-]`, RE2, `a character other than "a" to "z", "0" to "9", "_" or "-"`},
+		{`[A-Za-z]{2,3}?`, RE2, "between 2 and 3 letters (as few as possible)"},
+		{`(?:ab)*|\bx$`, RE2, `either the text "ab", repeated zero or more times or (a word boundary, then the character "x", then the end of the text)`},
+		{`(?<=\$)\d+(?!\.)`, Backtracking, `preceded by the character "$", then one or more digits, then not followed by the character "."`},
+		{`(\w)\1`, Backtracking, "a word character (letter, digit or underscore), captured as group 1, then the same text that group 1 matched"},
+		{`(?i:ok)`, RE2, `the text "ok" in any case (turn on case-insensitive matching)`},
+		{`(?x) a \# b # note`, Backtracking, `turn on free-spacing mode for the rest of the group, then the character "a", then the character "#", then the character "b"`},
+	}
+	for _, tt := range tests {
+		ex, err := Explain(tt.pattern, "", tt.engine)
+		if err != nil {
+			t.Errorf("%s: %v", tt.pattern, err)
+			continue
+		}
+		if ex.Root.Description != tt.want {
+			t.Errorf("%s:\n got %s\nwant %s", tt.pattern, ex.Root.Description, tt.want)
+		}
+	}
+}
+
+func TestExplain_Tree(t *testing.T) {
+	ex, err := Explain(`^(\d+)-(?<n>x)`, "m", Backtracking)
+	if err != nil {
+		t.Fatal(err)
+	}
+	type span struct {
+		Kind       string
+		Start, End int
+		Text       string
+	}
+	var got []span
+	for _, c := range ex.Root.Children {
+		got = append(got, span{c.Kind, c.Start, c.End, c.Text})
+	}
+	want := []span{{KindAnchor, 0, 1, "^"}, {KindGroup, 1, 6, `(\d+)`}, {KindLiteral, 6, 7, "-"}, {KindGroup, 7, 14, "(?<n>x)"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("children = %+v", got)
+	}
+	if ex.Groups != 2 || ex.Root.Children[0].Description != "the start of a line" {
+		t.Errorf("groups = %d, anchor = %q", ex.Groups, ex.Root.Children[0].Description)
+	}
+	// .NET numbers named groups after unnamed ones.
+	if ex, _ := Explain(`(?<n>a)(b)`, "", Backtracking); !strings.HasSuffix(ex.Root.Children[1].Description, "group 1") {
+		t.Errorf("unnamed group = %q", ex.Root.Children[1].Description)
+	}
+}
+
+func TestExplain_Warnings(t *testing.T) {
+	tests := []struct {
+		pattern string
+		engine  Engine
+		want    []string
+	}{
+		{`(a+)+$`, Backtracking, []string{"catastrophic-backtracking"}},
+		{`^(\w+\s?)*$`, Backtracking, []string{"catastrophic-backtracking"}},
+		{`(ab+)+`, Backtracking, nil},
+		{`(?>a+)+`, Backtracking, nil},
+		{`(a|ab)*c`, Backtracking, []string{"overlapping-backtracking"}},
+		{`(a|b)*c`, Backtracking, nil},
+		{`\d+\d*`, RE2, []string{"polynomial-backtracking"}},
+		{`\d+x\d+`, RE2, nil},
+		{`www.example.com`, RE2, []string{"unescaped-dot", "unescaped-dot"}},
+		{`www\.example\.com`, RE2, nil},
+		{`[A-z]`, RE2, []string{"suspicious-range"}},
+		{`(a|)`, RE2, []string{"empty-alternative"}},
+	}
+	for _, tt := range tests {
+		ex, err := Explain(tt.pattern, "", tt.engine)
+		if err != nil {
+			t.Errorf("%s: %v", tt.pattern, err)
+			continue
+		}
+		var got []string
+		for _, w := range ex.Warnings {
+			got = append(got, w.Code)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: warnings = %q, want %q", tt.pattern, got, tt.want)
+		}
+	}
+	ex, _ := Explain(`(a+)+`, "", RE2)
+	if w := ex.Warnings[0]; w.Start != 0 || w.End != 5 || !strings.Contains(w.Message, "linear time") {
+		t.Errorf("RE2 warning = %+v", w)
+	}
+}
+
+func TestExplain_Errors(t *testing.T) {
+	tests := []struct {
+		pattern string
+		engine  Engine
+		want    string
+	}{
+		{`(?=a)`, RE2, "the backtracking engine supports this"},
+		{`(abc`, RE2, "missing closing )"},
+		{`a{3,2}`, Backtracking, "invalid repeat count"},
+	}
+	for _, tt := range tests {
+		if _, err := Explain(tt.pattern, "", tt.engine); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: error = %v, want %q", tt.pattern, err, tt.want)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		pattern string
+		flags   string
+	}{
+		{`^\d{3}-\d{4}$`, ""},
+		{`[a-z]+@[a-z]+\.(com|org)`, ""},
+		{`hello\b`, "i"},
+		{`(?s)a.b`, ""},
+		{`[^a-z]{2,4}`, ""},
+		{`x*`, ""},
+		{`\p{Greek}+`, ""},
+	}
+	for _, tt := range tests {
+		re := regexp.MustCompile("(?" + tt.flags + `)^(?:` + tt.pattern + `)$`)
+		samples, err := Generate(tt.pattern, tt.flags, 10, 5, 7)
+		if err != nil {
+			t.Errorf("%s: %v", tt.pattern, err)
+			continue
+		}
+		if len(samples.Matches) == 0 || len(samples.NearMisses) == 0 {
+			t.Errorf("%s: samples = %+v", tt.pattern, samples)
+		}
+		seen := map[string]bool{}
+		for _, s := range samples.Matches {
+			if !re.MatchString(s) || seen[s] {
+				t.Errorf("%s: bad or repeated match %q", tt.pattern, s)
+			}
+			seen[s] = true
+		}
+		for _, m := range samples.NearMisses {
+			if re.MatchString(m.Text) || !seen[m.Source] || m.Change == "" {
+				t.Errorf("%s: bad near miss %+v", tt.pattern, m)
+			}
+		}
+	}
+}
+
+func TestGenerate_Options(t *testing.T) {
+	a, _ := Generate(`[a-z]{3}\d*`, "", 5, 2, 99)
+	b, _ := Generate(`[a-z]{3}\d*`, "", 5, 2, 99)
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("same seed gave %v and %v", a.Matches, b.Matches)
+	}
+	for _, s := range a.Matches {
+		if len(s) > 5 {
+			t.Errorf("%q repeats \\d more than twice", s)
+		}
+	}
+	// A pattern with one match yields it once; its near misses must not
+	// contain it anywhere.
+	one, err := Generate(`abc`, "", 5, 5, 1)
+	if err != nil || !reflect.DeepEqual(one.Matches, []string{"abc"}) {
+		t.Fatalf("matches = %v, %v", one, err)
+	}
+	for _, m := range one.NearMisses {
+		if strings.Contains(m.Text, "abc") {
+			t.Errorf("near miss %q contains a match", m.Text)
+		}
+	}
+
+	for _, bad := range []string{`[^\x00-\x{10FFFF}]`, `a\bb`} {
+		if _, err := Generate(bad, "", 5, 5, 1); !errors.Is(err, ErrNoMatch) {
+			t.Errorf("%s: error = %v", bad, err)
+		}
+	}
+	if _, err := Generate(`(`, "", 5, 5, 1); err == nil || errors.Is(err, ErrNoMatch) {
+		t.Errorf("invalid pattern: error = %v", err)
+	}
+
+	// Counted repeats are clamped too, and samples never exceed
+	// MaxSampleLen however many repeats are allowed.
+	c, _ := Generate(`a{2,500}`, "", 5, 3, 1)
+	for _, s := range c.Matches {
+		if len(s) < 2 || len(s) > 5 {
+			t.Errorf("%q repeats outside 2 to 5 times", s)
+		}
+	}
+	long, err := Generate(`.{0,1000}x{990}(y{5}|z)+`, "s", 50, 100, 1)
+	if err != nil || len(long.Matches) == 0 || !long.Truncated {
+		t.Fatalf("long = %+v, %v", long, err)
+	}
+	for _, s := range long.Matches {
+		if n := utf8.RuneCountInString(s); n > MaxSampleLen {
+			t.Errorf("sample of %d characters", n)
+		}
+	}
+	if _, err := Generate(`(x{500}){2}y`, "", 5, 5, 1); !errors.Is(err, ErrTooLong) {
+		t.Errorf("too long: error = %v", err)
+	}
+}
@@ -0,0 +1,214 @@
+package sqlfmt
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// Table is a table definition read from CREATE TABLE.
+type Table struct {
+	Name    string // unquoted; schema-qualified names keep their dots
+	Columns []Column
+}
+
+// Column is one column definition. Type is the declared type as written,
+// parameters included ("VARCHAR(255)", "NUMERIC(10, 2)", "text[]").
+type Column struct {
+	Name       string
+	Type       string
+	NotNull    bool
+	PrimaryKey bool
+	Default    string
+}
+
+// columnConstraints end a column's type. Some are not reserved words, so
+// they are matched by text.
+var columnConstraints = map[string]bool{
+	"NOT": true, "NULL": true, "DEFAULT": true, "PRIMARY": true, "UNIQUE": true, "REFERENCES": true,
+	"CHECK": true, "CONSTRAINT": true, "GENERATED": true, "AUTO_INCREMENT": true, "AUTOINCREMENT": true,
+	"COLLATE": true, "COMMENT": true, "ON": true, "OPTIONS": true, "IDENTITY": true, "AS": true,
+}
+
+// tableConstraints start a table-level item instead of a column.
+var tableConstraints = map[string]bool{
+	"CONSTRAINT": true, "PRIMARY": true, "UNIQUE": true, "FOREIGN": true, "CHECK": true,
+	"EXCLUDE": true, "FULLTEXT": true, "SPATIAL": true, "LIKE": true,
+}
+
+// ParseCreateTables reads every CREATE TABLE … (…) statement in src. Other
+// statements, and CREATE TABLE … AS SELECT, are skipped.
+func ParseCreateTables(src string, d Dialect) ([]Table, error) {
+	toks, err := lex(src, d)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := parse(src, toks)
+	if err != nil {
+		return nil, err
+	}
+	var tables []Table
+	for _, s := range splitStatements(nodes) {
+		if t, ok := createTable(withoutComments(s.nodes)); ok {
+			tables = append(tables, t)
+		}
+	}
+	if len(tables) == 0 {
+		return nil, errors.New("no CREATE TABLE statement found")
+	}
+	return tables, nil
+}
+
+func withoutComments(nodes []*node) []*node {
+	out := make([]*node, 0, len(nodes))
+	for _, n := range nodes {
+		if n.kind != leaf || !n.tok.comment() {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+func createTable(nodes []*node) (Table, bool) {
+	if len(nodes) == 0 || !nodes[0].is("CREATE") {
+		return Table{}, false
+	}
+	i := 1
+	for i < len(nodes) && !nodes[i].is("TABLE") {
+		if nodes[i].kind != leaf || nodes[i].tok.kind != tWord {
+			return Table{}, false
+		}
+		i++
+	}
+	i++
+	if i+2 < len(nodes) && nodes[i].is("IF") && nodes[i+1].is("NOT") && nodes[i+2].is("EXISTS") {
+		i += 3
+	}
+	var name []string
+	for ; i < len(nodes) && nodes[i].kind == leaf; i++ {
+		if t := nodes[i].tok; t.kind == tWord || t.kind == tQuoted {
+			name = append(name, unquote(t))
+		}
+	}
+	if len(name) == 0 || i >= len(nodes) || nodes[i].kind != group {
+		return Table{}, false
+	}
+	t := Table{Name: strings.Join(name, ".")}
+	var keys []string
+	for _, item := range splitDefinitions(withoutComments(nodes[i].kids)) {
+		first := item[0]
+		switch {
+		case first.kind != leaf:
+		case first.tok.kind == tWord && tableConstraints[strings.ToUpper(first.tok.text)]:
+			keys = append(keys, primaryKeyColumns(item)...)
+		case first.is("KEY", "INDEX") && len(item) > 1 && (item[1].kind == group || len(item) > 2 && item[2].kind == group):
+			// MySQL inline index
+		default:
+			t.Columns = append(t.Columns, column(item))
+		}
+	}
+	for _, k := range keys {
+		for c := range t.Columns {
+			if strings.EqualFold(t.Columns[c].Name, k) {
+				t.Columns[c].PrimaryKey, t.Columns[c].NotNull = true, true
+			}
+		}
+	}
+	return t, true
+}
+
+// splitDefinitions splits on top-level commas, treating BigQuery's
+// STRUCT<…> and ARRAY<…> as nested. The lexer reads ">>" as one operator,
+// so brackets are counted by character.
+func splitDefinitions(nodes []*node) [][]*node {
+	var items [][]*node
+	start, angle := 0, 0
+	for i, n := range nodes {
+		switch {
+		case n.kind != leaf:
+		case strings.Trim(n.tok.text, "<") == "":
+			angle += len(n.tok.text)
+		case strings.Trim(n.tok.text, ">") == "":
+			angle = max(angle-len(n.tok.text), 0)
+		case n.tok.kind == tComma && angle == 0:
+			if i > start {
+				items = append(items, nodes[start:i])
+			}
+			start = i + 1
+		}
+	}
+	if start < len(nodes) {
+		items = append(items, nodes[start:])
+	}
+	return items
+}
+
+// primaryKeyColumns returns the columns of a PRIMARY KEY (…) table
+// constraint.
+func primaryKeyColumns(item []*node) []string {
+	for i := 0; i+2 < len(item); i++ {
+		if item[i].is("PRIMARY") && item[i+1].is("KEY") && item[i+2].kind == group {
+			var cols []string
+			for _, k := range item[i+2].kids {
+				if k.kind == leaf && (k.tok.kind == tWord || k.tok.kind == tQuoted) {
+					cols = append(cols, unquote(k.tok))
+				}
+			}
+			return cols
+		}
+	}
+	return nil
+}
+
+var angleSpaceRe = regexp.MustCompile(`\s*([<>])\s*`)
+
+func column(item []*node) Column {
+	c := Column{Name: unquote(item[0].tok)}
+	i := 1
+	for i < len(item) && !isConstraint(item[i]) {
+		i++
+	}
+	c.Type = angleSpaceRe.ReplaceAllString(flatText(item[1:i]), "$1")
+	for ; i < len(item); i++ {
+		switch {
+		case item[i].is("NOT") && i+1 < len(item) && item[i+1].is("NULL"):
+			c.NotNull = true
+			i++
+		case item[i].is("PRIMARY"):
+			c.PrimaryKey, c.NotNull = true, true
+		case item[i].is("DEFAULT"):
+			j := i + 1
+			for j < len(item) && !isConstraint(item[j]) {
+				j++
+			}
+			c.Default = flatText(item[i+1 : j])
+			i = j - 1
+		}
+	}
+	return c
+}
+
+func isConstraint(n *node) bool {
+	return n.kind == leaf && n.tok.kind == tWord && columnConstraints[strings.ToUpper(n.tok.text)]
+}
+
+// flatText writes nodes on one line with the formatter's spacing and the
+// original keyword case.
+func flatText(nodes []*node) string {
+	p := &printer{opts: Options{KeywordCase: Preserve}, fresh: true, flat: true}
+	p.nodes(nodes, 0)
+	return string(p.buf)
+}
+
+// unquote strips identifier quotes and undoubles embedded ones.
+func unquote(t *token) string {
+	s := t.text
+	if t.kind != tQuoted || len(s) < 2 {
+		return s
+	}
+	q := s[:1]
+	if q == "[" {
+		return s[1 : len(s)-1]
+	}
+	return strings.ReplaceAll(s[1:len(s)-1], q+q, q)
+}
@@ -0,0 +1,71 @@
+package sqlfmt
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCreateTables(t *testing.T) {
+	src := "CREATE TABLE IF NOT EXISTS app.`Order Items`" + ` (
+  order_id int NOT NULL, -- parent
+  sku varchar(32) COLLATE utf8mb4_bin DEFAULT 'x',
+  price numeric(10, 2),
+  CONSTRAINT pk PRIMARY KEY (order_id, sku),
+  FOREIGN KEY (order_id) REFERENCES orders (id)
+);
+CREATE TABLE t AS SELECT 1;
+CREATE TEMPORARY TABLE ` + "`s`" + ` (id INT AUTO_INCREMENT PRIMARY KEY, KEY idx (id))`
+
+	got, err := ParseCreateTables(src, MySQL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Table{
+		{Name: "app.Order Items", Columns: []Column{
+			{Name: "order_id", Type: "int", NotNull: true, PrimaryKey: true},
+			{Name: "sku", Type: "varchar(32)", NotNull: true, PrimaryKey: true, Default: "'x'"},
+			{Name: "price", Type: "numeric(10, 2)"},
+		}},
+		{Name: "s", Columns: []Column{{Name: "id", Type: "INT", NotNull: true, PrimaryKey: true}}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got  %+v\nwant %+v", got, want)
+	}
+
+	got, err = ParseCreateTables("create table t (s STRUCT<a INT64, b ARRAY<STRING>>, n INT64)", BigQuery)
+	if err != nil || len(got[0].Columns) != 2 || got[0].Columns[0].Type != "STRUCT<a INT64, b ARRAY<STRING>>" {
+		t.Errorf("BigQuery STRUCT: %+v, %v", got, err)
+	}
+
+	if _, err := ParseCreateTables("select 1", Standard); err == nil {
+		t.Error("expected error without CREATE TABLE")
+	}
+}
+
+func TestQuote(t *testing.T) {
+	tests := []struct {
+		in      string
+		dialect Dialect
+		ident   string
+		str     string
+	}{
+		{"name", PostgreSQL, "name", "'name'"},
+		{"Name", PostgreSQL, `"Name"`, "'Name'"},
+		{"Name", MySQL, "Name", "'Name'"},
+		{"order", SQLite, `"order"`, "'order'"},
+		{"user", PostgreSQL, `"user"`, "'user'"},
+		{"a`b", BigQuery, "`a``b`", "'a`b'"},
+		{"it's\\", Standard, `"it's\"`, `'it''s\'`},
+		{"it's\\", MySQL, "`it's\\`", `'it\'s\\'`},
+		{"a\nb\x00\x01", MySQL, "`a\nb\x00\x01`", "'a\\nb\\0\x01'"},
+		{"a\x01", BigQuery, "`a\x01`", `'a\x01'`},
+	}
+	for _, tt := range tests {
+		if got := QuoteIdent(tt.in, tt.dialect); got != tt.ident {
+			t.Errorf("QuoteIdent(%q, %d) = %s, want %s", tt.in, tt.dialect, got, tt.ident)
+		}
+		if got := QuoteString(tt.in, tt.dialect); got != tt.str {
+			t.Errorf("QuoteString(%q, %d) = %s, want %s", tt.in, tt.dialect, got, tt.str)
+		}
+	}
+}
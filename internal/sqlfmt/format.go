@@ -0,0 +1,718 @@
+// Package sqlfmt formats and minifies SQL with a tokenizing lexer, so string
+// literals, quoted identifiers and comments pass through byte for byte.
+//
+// The formatter puts each clause (SELECT, FROM, WHERE…) on its own line and
+// keeps a clause's body beside it when it fits the line width; otherwise the
+// body moves below, one list item, join or AND/OR condition per line.
+// Subqueries are always indented inside their parentheses; other
+// parentheses and CASE expressions break only when they don't fit.
+package sqlfmt
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// KeywordCase controls how keywords are written.
+type KeywordCase int
+
+const (
+	Upper KeywordCase = iota
+	Lower
+	Preserve
+)
+
+// Options configures Format.
+type Options struct {
+	Dialect     Dialect
+	KeywordCase KeywordCase
+	Indent      string // one level of indentation; defaults to two spaces
+	Width       int    // target line width; defaults to 80
+	Minify      bool   // single line, minimal spacing, comments dropped except optimizer hints
+}
+
+const maxDepth = 256
+
+// Format reformats one or more SQL statements.
+func Format(src string, opts Options) (string, error) {
+	toks, err := lex(src, opts.Dialect)
+	if err != nil {
+		return "", err
+	}
+	if opts.Minify {
+		return minify(toks, opts), nil
+	}
+	nodes, err := parse(src, toks)
+	if err != nil {
+		return "", err
+	}
+	if opts.Indent == "" {
+		opts.Indent = "  "
+	}
+	if opts.Width <= 0 {
+		opts.Width = 80
+	}
+	p := &printer{opts: opts, fresh: true}
+	for i, s := range splitStatements(nodes) {
+		if i > 0 {
+			p.buf = append(p.buf, '\n')
+			p.fresh = false
+			p.newline(0)
+		}
+		p.statement(s, 0)
+	}
+	return strings.TrimRight(string(p.buf), " \t\n"), nil
+}
+
+// ── Tree ──────────────────────────────────────────────────────────────────────
+
+type nodeKind int
+
+const (
+	leaf nodeKind = iota
+	group
+	caseExpr
+)
+
+// node is a token, a bracketed group, or a CASE … END expression. Groups and
+// CASE keep their delimiters in tok and end.
+type node struct {
+	kind nodeKind
+	tok  *token
+	kids []*node
+	end  *token
+}
+
+func (n *node) is(words ...string) bool { return n.kind == leaf && n.tok.is(words...) }
+
+type parser struct {
+	src  string
+	toks []token
+	i    int
+}
+
+func parse(src string, toks []token) ([]*node, error) {
+	p := &parser{src: src, toks: toks}
+	nodes, _, err := p.seq(nil, 0)
+	return nodes, err
+}
+
+// seq reads nodes until the token closing open, or to the end when open is
+// nil.
+func (p *parser) seq(open *token, depth int) ([]*node, *token, error) {
+	if depth > maxDepth {
+		return nil, nil, errorAt(p.src, open.pos, "nesting is too deep")
+	}
+	var nodes []*node
+	for p.i < len(p.toks) {
+		t := &p.toks[p.i]
+		p.i++
+		switch {
+		case t.kind == tOpen:
+			kids, end, err := p.seq(t, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			nodes = append(nodes, &node{kind: group, tok: t, kids: kids, end: end})
+		case t.kind == tClose:
+			if open == nil || open.kind != tOpen || closer(open.text) != t.text {
+				return nil, nil, errorAt(p.src, t.pos, "unexpected %q", t.text)
+			}
+			return nodes, t, nil
+		case t.is("CASE"):
+			kids, end, err := p.seq(t, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			nodes = append(nodes, &node{kind: caseExpr, tok: t, kids: kids, end: end})
+		case t.is("END") && open != nil && open.is("CASE"):
+			return nodes, t, nil
+		default:
+			nodes = append(nodes, &node{kind: leaf, tok: t})
+		}
+	}
+	switch {
+	case open == nil:
+		return nodes, nil, nil
+	case open.kind == tOpen:
+		return nil, nil, errorAt(p.src, open.pos, "unclosed %q", open.text)
+	default:
+		return nil, nil, errorAt(p.src, open.pos, "CASE without END")
+	}
+}
+
+func closer(open string) string {
+	if open == "[" {
+		return "]"
+	}
+	return ")"
+}
+
+// statement is the nodes up to a semicolon, the semicolon itself, and any
+// comments on the same line after it.
+type statement struct {
+	nodes    []*node
+	semi     *node
+	trailing []*node
+}
+
+func splitStatements(nodes []*node) []statement {
+	var out []statement
+	cur := statement{}
+	for i := 0; i < len(nodes); i++ {
+		n := nodes[i]
+		if n.kind != leaf || n.tok.kind != tSemicolon {
+			cur.nodes = append(cur.nodes, n)
+			continue
+		}
+		cur.semi = n
+		for i+1 < len(nodes) && nodes[i+1].kind == leaf && nodes[i+1].tok.comment() && !nodes[i+1].tok.newlineBefore {
+			i++
+			cur.trailing = append(cur.trailing, nodes[i])
+		}
+		out = append(out, cur)
+		cur = statement{}
+	}
+	if len(cur.nodes) > 0 {
+		out = append(out, cur)
+	}
+	return out
+}
+
+// isQuery reports whether a group holds a subquery.
+func isQuery(kids []*node) bool {
+	for _, k := range kids {
+		if k.kind == leaf && k.tok.comment() {
+			continue
+		}
+		return k.is("SELECT", "WITH", "VALUES")
+	}
+	return false
+}
+
+// forced reports whether nodes can't be written on one line: they hold a
+// line comment, a subquery or a multi-line token.
+func forced(nodes []*node) bool {
+	for _, n := range nodes {
+		switch {
+		case n.kind == leaf:
+			if n.tok.kind == tLineComment || strings.Contains(n.tok.text, "\n") {
+				return true
+			}
+		case n.kind == group && isQuery(n.kids), forced(n.kids):
+			return true
+		}
+	}
+	return false
+}
+
+// ── Clauses ───────────────────────────────────────────────────────────────────
+
+type clauseKind int
+
+const (
+	inlineClause clauseKind = iota // body follows the keyword; only parentheses break
+	listClause                     // comma-separated items
+	condClause                     // AND/OR conditions
+	fromClause                     // tables, joins
+)
+
+type clauseDef struct {
+	words []string
+	kind  clauseKind
+	// first restricts the clause to the start of a statement (or right after
+	// WITH), so ALTER … DROP COLUMN or FOR UPDATE stay inline.
+	first bool
+}
+
+// clauseDefs lists longer phrases before their prefixes.
+var clauseDefs = []clauseDef{
+	{words: []string{"WITH", "RECURSIVE"}, kind: inlineClause, first: true},
+	{words: []string{"WITH"}, kind: inlineClause, first: true},
+	{words: []string{"SELECT", "DISTINCT"}, kind: listClause},
+	{words: []string{"SELECT", "ALL"}, kind: listClause},
+	{words: []string{"SELECT"}, kind: listClause},
+	{words: []string{"INSERT", "INTO"}, kind: inlineClause, first: true},
+	{words: []string{"INSERT", "OVERWRITE"}, kind: inlineClause, first: true},
+	{words: []string{"INSERT"}, kind: inlineClause, first: true},
+	{words: []string{"REPLACE", "INTO"}, kind: inlineClause, first: true},
+	{words: []string{"UPDATE"}, kind: fromClause, first: true},
+	{words: []string{"DELETE", "FROM"}, kind: fromClause, first: true},
+	{words: []string{"DELETE"}, kind: inlineClause, first: true},
+	{words: []string{"MERGE", "INTO"}, kind: inlineClause, first: true},
+	{words: []string{"CREATE"}, kind: inlineClause, first: true},
+	{words: []string{"ALTER"}, kind: inlineClause, first: true},
+	{words: []string{"DROP"}, kind: inlineClause, first: true},
+	{words: []string{"TRUNCATE"}, kind: inlineClause, first: true},
+	{words: []string{"VALUES"}, kind: listClause},
+	{words: []string{"SET"}, kind: listClause},
+	{words: []string{"FROM"}, kind: fromClause},
+	{words: []string{"WHERE"}, kind: condClause},
+	{words: []string{"GROUP", "BY"}, kind: listClause},
+	{words: []string{"HAVING"}, kind: condClause},
+	{words: []string{"WINDOW"}, kind: listClause},
+	{words: []string{"QUALIFY"}, kind: condClause},
+	{words: []string{"ORDER", "BY"}, kind: listClause},
+	{words: []string{"LIMIT"}, kind: inlineClause},
+	{words: []string{"OFFSET"}, kind: inlineClause},
+	{words: []string{"FETCH"}, kind: inlineClause},
+	{words: []string{"UNION", "ALL"}, kind: inlineClause},
+	{words: []string{"UNION", "DISTINCT"}, kind: inlineClause},
+	{words: []string{"UNION"}, kind: inlineClause},
+	{words: []string{"INTERSECT", "ALL"}, kind: inlineClause},
+	{words: []string{"INTERSECT", "DISTINCT"}, kind: inlineClause},
+	{words: []string{"INTERSECT"}, kind: inlineClause},
+	{words: []string{"EXCEPT", "ALL"}, kind: inlineClause},
+	{words: []string{"EXCEPT", "DISTINCT"}, kind: inlineClause},
+	{words: []string{"EXCEPT"}, kind: inlineClause},
+	{words: []string{"ON", "CONFLICT"}, kind: inlineClause},
+	{words: []string{"ON", "DUPLICATE", "KEY", "UPDATE"}, kind: listClause},
+	{words: []string{"DO", "UPDATE", "SET"}, kind: listClause},
+	{words: []string{"DO", "NOTHING"}, kind: inlineClause},
+	{words: []string{"RETURNING"}, kind: listClause},
+}
+
+type clause struct {
+	def  *clauseDef // nil for tokens before the first clause keyword
+	kw   []*node
+	body []*node
+}
+
+func (c *clause) name() string {
+	if c == nil || c.def == nil {
+		return ""
+	}
+	return strings.Join(c.def.words, " ")
+}
+
+func splitClauses(nodes []*node) []*clause {
+	cur := &clause{}
+	out := []*clause{}
+	var first, prev *clause
+	for i := 0; i < len(nodes); {
+		if def := matchClause(nodes, i, first, prev); def != nil {
+			if cur.def != nil || len(cur.body) > 0 {
+				out = append(out, cur)
+			}
+			cur = &clause{def: def, kw: nodes[i : i+len(def.words)]}
+			if first == nil {
+				first = cur
+			}
+			prev = cur
+			i += len(def.words)
+			continue
+		}
+		cur.body = append(cur.body, nodes[i])
+		i++
+	}
+	if cur.def != nil || len(cur.body) > 0 {
+		out = append(out, cur)
+	}
+	return out
+}
+
+func matchClause(nodes []*node, i int, first, prev *clause) *clauseDef {
+	for d := range clauseDefs {
+		def := &clauseDefs[d]
+		if i+len(def.words) > len(nodes) {
+			continue
+		}
+		ok := true
+		for j, w := range def.words {
+			ok = ok && nodes[i+j].is(w)
+		}
+		if !ok || def.first && first != nil && !(prev == first && strings.HasPrefix(first.name(), "WITH")) {
+			continue
+		}
+		var next *node
+		if k := i + len(def.words); k < len(nodes) {
+			next = nodes[k]
+		}
+		switch def.words[0] {
+		case "FROM":
+			ok = i == 0 || !nodes[i-1].is("DISTINCT")
+		case "SET":
+			ok = first != nil && first.def.words[0] == "UPDATE" || prev.name() == "INSERT INTO" || prev.name() == "REPLACE INTO"
+		case "VALUES":
+			ok = first == nil || prev.name() == "INSERT INTO" || prev.name() == "INSERT" || prev.name() == "REPLACE INTO"
+		case "ON", "DO":
+			ok = first != nil && first.def.words[0] == "INSERT"
+		case "EXCEPT":
+			// BigQuery's SELECT * EXCEPT (col)
+			ok = next == nil || next.kind != group || isQuery(next.kids)
+		}
+		if ok {
+			return def
+		}
+	}
+	return nil
+}
+
+// splitList splits after top-level commas.
+func splitList(nodes []*node) [][]*node {
+	var items [][]*node
+	start := 0
+	for i, n := range nodes {
+		if n.kind == leaf && n.tok.kind == tComma {
+			items = append(items, nodes[start:i+1])
+			start = i + 1
+		}
+	}
+	if start < len(nodes) {
+		items = append(items, nodes[start:])
+	}
+	return attachComments(items)
+}
+
+// splitConditions splits before top-level AND and OR, skipping the AND of
+// BETWEEN … AND.
+func splitConditions(nodes []*node) [][]*node {
+	var items [][]*node
+	start, between := 0, 0
+	for i, n := range nodes {
+		switch {
+		case n.is("BETWEEN"):
+			between++
+		case n.is("AND") && between > 0:
+			between--
+		case n.is("AND", "OR") && i > start:
+			items = append(items, nodes[start:i])
+			start = i
+		}
+	}
+	items = append(items, nodes[start:])
+	return attachComments(items)
+}
+
+// splitFrom splits after top-level commas and before joins.
+func splitFrom(nodes []*node) [][]*node {
+	var items [][]*node
+	start := 0
+	for i, n := range nodes {
+		switch {
+		case n.kind == leaf && n.tok.kind == tComma:
+			items = append(items, nodes[start:i+1])
+			start = i + 1
+		case i > start && joinAt(nodes, i):
+			items = append(items, nodes[start:i])
+			start = i
+		}
+	}
+	if start < len(nodes) {
+		items = append(items, nodes[start:])
+	}
+	return attachComments(items)
+}
+
+var joinModifiers = []string{"INNER", "LEFT", "RIGHT", "FULL", "OUTER", "CROSS", "NATURAL", "SEMI", "ANTI", "ASOF", "GLOBAL", "ANY"}
+
+// joinAt reports whether a join phrase (LEFT OUTER JOIN…) starts at i.
+func joinAt(nodes []*node, i int) bool {
+	if nodes[i].is("STRAIGHT_JOIN") {
+		return true
+	}
+	if i > 0 && nodes[i-1].is(joinModifiers...) {
+		return false
+	}
+	for j := i; j < len(nodes); j++ {
+		switch {
+		case nodes[j].is("JOIN"):
+			return true
+		case !nodes[j].is(joinModifiers...):
+			return false
+		}
+	}
+	return false
+}
+
+// splitCase splits a CASE body before each WHEN and ELSE.
+func splitCase(nodes []*node) [][]*node {
+	var items [][]*node
+	start := 0
+	for i, n := range nodes {
+		if n.is("WHEN", "ELSE") && i > start {
+			items = append(items, nodes[start:i])
+			start = i
+		}
+	}
+	items = append(items, nodes[start:])
+	return attachComments(items)
+}
+
+// attachComments moves comments that followed a separator on the same line
+// back to the end of the previous item.
+func attachComments(items [][]*node) [][]*node {
+	for k := 1; k < len(items); k++ {
+		for len(items[k]) > 0 && items[k][0].kind == leaf && items[k][0].tok.comment() && !items[k][0].tok.newlineBefore {
+			items[k-1] = append(items[k-1][:len(items[k-1]):len(items[k-1])], items[k][0])
+			items[k] = items[k][1:]
+		}
+		if len(items[k]) == 0 {
+			items = append(items[:k], items[k+1:]...)
+			k--
+		}
+	}
+	return items
+}
+
+// ── Printer ───────────────────────────────────────────────────────────────────
+
+type printer struct {
+	opts         Options
+	buf          []byte
+	col          int
+	lineStart    int  // offset in buf where the current line's indentation begins
+	fresh        bool // nothing but indentation on the current line
+	pendingBreak bool // a line comment was written; the next token needs a new line
+	flat         bool // measuring: nothing breaks
+	prev, prev2  *token
+}
+
+func (p *printer) indentWidth() int {
+	return len(strings.ReplaceAll(p.opts.Indent, "\t", "    "))
+}
+
+func (p *printer) newline(level int) {
+	p.pendingBreak = false
+	if p.fresh {
+		p.buf = p.buf[:p.lineStart]
+	} else {
+		p.buf = append(p.buf, '\n')
+	}
+	p.lineStart = len(p.buf)
+	p.buf = append(p.buf, strings.Repeat(p.opts.Indent, level)...)
+	p.col = level * p.indentWidth()
+	p.fresh = true
+}
+
+func (p *printer) write(s string) {
+	p.buf = append(p.buf, s...)
+	if i := strings.LastIndexByte(s, '\n'); i >= 0 {
+		p.col = utf8.RuneCountInString(s[i+1:])
+	} else {
+		p.col += utf8.RuneCountInString(s)
+	}
+	p.fresh = false
+}
+
+func (p *printer) emit(t *token, level int) {
+	if p.pendingBreak || t.kind == tLineComment && t.newlineBefore && !p.fresh {
+		p.newline(level)
+	}
+	if !p.fresh && p.space(t) {
+		p.write(" ")
+	}
+	p.write(tokenText(t, p.opts.KeywordCase))
+	p.prev2, p.prev = p.prev, t
+	p.pendingBreak = t.kind == tLineComment
+}
+
+func tokenText(t *token, c KeywordCase) string {
+	if !t.keyword {
+		return t.text
+	}
+	switch c {
+	case Upper:
+		return strings.ToUpper(t.text)
+	case Lower:
+		return strings.ToLower(t.text)
+	}
+	return t.text
+}
+
+// space reports whether a space goes between the previous token and t.
+func (p *printer) space(t *token) bool {
+	prev := p.prev
+	switch {
+	case prev == nil:
+		return false
+	case t.kind == tComma, t.kind == tSemicolon, t.kind == tDot, t.kind == tClose:
+		return false
+	case prev.kind == tOpen, prev.kind == tDot, prev.unary:
+		return false
+	case t.text == "::", prev.text == "::":
+		return false
+	case t.kind == tOpen && t.text == "[":
+		return !(prev.kind == tWord || prev.kind == tQuoted || prev.kind == tClose || prev.kind == tParam)
+	case t.kind == tOpen:
+		switch prev.kind {
+		case tWord:
+			if prev.keyword {
+				return !funcKeywords[strings.ToUpper(prev.text)]
+			}
+			return p.prev2 != nil && p.prev2.keyword && parenAfterName[strings.ToUpper(p.prev2.text)]
+		case tClose, tParam:
+			return false
+		}
+	}
+	return true
+}
+
+// fits reports whether nodes can be written on the current line.
+func (p *printer) fits(nodes []*node) bool {
+	if p.flat || len(nodes) == 0 {
+		return true
+	}
+	if forced(nodes) {
+		return false
+	}
+	m := &printer{opts: p.opts, fresh: true, flat: true}
+	m.nodes(nodes, 0)
+	width := m.col
+	if !p.fresh && p.space(nodes[0].tok) {
+		width++
+	}
+	return p.col+width <= p.opts.Width
+}
+
+// statement writes a statement and its semicolon, which goes before any
+// comments that end the statement so it isn't commented out.
+func (p *printer) statement(s statement, level int) {
+	body := s.nodes
+	var comments []*node
+	if s.semi != nil {
+		for len(body) > 0 && body[len(body)-1].kind == leaf && body[len(body)-1].tok.comment() {
+			comments = append([]*node{body[len(body)-1]}, comments...)
+			body = body[:len(body)-1]
+		}
+	}
+	p.query(body, level)
+	if s.semi != nil {
+		p.emit(s.semi.tok, level)
+	}
+	for _, c := range append(comments, s.trailing...) {
+		p.emit(c.tok, level)
+	}
+}
+
+// query writes clauses one per line at level.
+func (p *printer) query(nodes []*node, level int) {
+	for i, c := range splitClauses(nodes) {
+		if i > 0 {
+			p.newline(level)
+		}
+		for _, k := range c.kw {
+			p.emit(k.tok, level)
+		}
+		if c.def == nil || c.def.kind == inlineClause {
+			p.nodes(c.body, level)
+			continue
+		}
+		var items [][]*node
+		switch c.def.kind {
+		case listClause:
+			items = splitList(c.body)
+		case condClause:
+			items = splitConditions(c.body)
+		case fromClause:
+			items = splitFrom(c.body)
+		}
+		joins := false
+		for _, item := range items[min(1, len(items)):] {
+			joins = joins || joinAt(item, 0)
+		}
+		if !joins && p.fits(c.body) {
+			p.nodes(c.body, level)
+			continue
+		}
+		for _, item := range items {
+			p.newline(level + 1)
+			p.nodes(item, level+1)
+		}
+	}
+}
+
+func (p *printer) nodes(nodes []*node, level int) {
+	for _, n := range nodes {
+		switch n.kind {
+		case leaf:
+			p.emit(n.tok, level)
+		case group:
+			p.group(n, level)
+		case caseExpr:
+			p.caseExpr(n, level)
+		}
+	}
+}
+
+func (p *printer) group(n *node, level int) {
+	switch {
+	case isQuery(n.kids):
+		p.emit(n.tok, level)
+		p.newline(level + 1)
+		p.query(n.kids, level+1)
+		p.newline(level)
+	case p.fits([]*node{n}):
+		p.emit(n.tok, level)
+		p.nodes(n.kids, level)
+	default:
+		p.emit(n.tok, level)
+		for _, item := range splitList(n.kids) {
+			p.newline(level + 1)
+			p.nodes(item, level+1)
+		}
+		p.newline(level)
+	}
+	p.emit(n.end, level)
+}
+
+func (p *printer) caseExpr(n *node, level int) {
+	if p.fits([]*node{n}) {
+		p.emit(n.tok, level)
+		p.nodes(n.kids, level)
+		p.emit(n.end, level)
+		return
+	}
+	p.emit(n.tok, level)
+	for i, item := range splitCase(n.kids) {
+		if i > 0 || item[0].is("WHEN", "ELSE") {
+			p.newline(level + 1)
+		}
+		p.nodes(item, level+1)
+	}
+	p.newline(level)
+	p.emit(n.end, level)
+}
+
+// ── Minify ────────────────────────────────────────────────────────────────────
+
+// minify writes the statements on one line with only the spaces needed to
+// keep tokens apart. Comments are dropped except optimizer hints (/*+ … */)
+// and MySQL executable comments (/*! … */).
+func minify(toks []token, opts Options) string {
+	var b strings.Builder
+	var prev *token
+	for i := range toks {
+		t := &toks[i]
+		if t.kind == tLineComment || t.kind == tBlockComment && !strings.HasPrefix(t.text, "/*+") && !strings.HasPrefix(t.text, "/*!") {
+			continue
+		}
+		if prev != nil && minifySpace(prev, t) {
+			b.WriteByte(' ')
+		}
+		b.WriteString(tokenText(t, opts.KeywordCase))
+		prev = t
+	}
+	return b.String()
+}
+
+// unaryAfter are operators that can't merge with a following - + or ~.
+var unaryAfter = map[string]bool{"=": true, "<": true, ">": true, "<=": true, ">=": true, "<>": true, "!=": true, "*": true, "%": true, "||": true}
+
+func minifySpace(prev, t *token) bool {
+	punct := func(k *token) bool {
+		return k.kind == tOpen || k.kind == tClose || k.kind == tComma || k.kind == tSemicolon || k.kind == tDot
+	}
+	switch {
+	case prev.kind == tOperator && t.kind == tOperator:
+		// x=-1 is safe; - -1 must not become a comment
+		return !t.unary || !unaryAfter[prev.text]
+	case prev.kind == tClose && !punct(t) && t.kind != tOperator:
+		return true
+	case punct(prev), punct(t):
+		return false
+	case prev.kind == tOperator, t.kind == tOperator:
+		return false
+	}
+	return true
+}
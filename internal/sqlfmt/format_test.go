@@ -0,0 +1,120 @@
+package sqlfmt
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		opts Options
+		want string
+	}{
+		{"short clauses stay inline", "select * from users where id = 1", Options{},
+			"SELECT *\nFROM users\nWHERE id = 1"},
+		{"joins and conditions",
+			"select a, count(*) as n from users u left join orders o on o.user_id = u.id and o.note = 'from x' " +
+				"where u.created_at between '2020-01-01' and '2021-01-01' and (u.x = 1 or u.y = 2) and u.deleted_at is null group by a",
+			Options{},
+			"SELECT a, count(*) AS n\nFROM\n  users u\n  LEFT JOIN orders o ON o.user_id = u.id AND o.note = 'from x'\n" +
+				"WHERE\n  u.created_at BETWEEN '2020-01-01' AND '2021-01-01'\n  AND (u.x = 1 OR u.y = 2)\n  AND u.deleted_at IS NULL\nGROUP BY a"},
+		{"subquery", "select id from t where id in (select user_id from orders where total > 100)", Options{},
+			"SELECT id\nFROM t\nWHERE\n  id IN (\n    SELECT user_id\n    FROM orders\n    WHERE total > 100\n  )"},
+		{"long list and case",
+			"select very_long_column_name_one, very_long_column_name_two, case when status = 'active' then 'recent active user' " +
+				"when status = 'inactive' then 'dormant' else 'other' end as category from accounts",
+			Options{Width: 60},
+			"SELECT\n  very_long_column_name_one,\n  very_long_column_name_two,\n  CASE\n    WHEN status = 'active' THEN 'recent active user'\n" +
+				"    WHEN status = 'inactive' THEN 'dormant'\n    ELSE 'other'\n  END AS category\nFROM accounts"},
+		{"create table", "create table users (id serial primary key, email varchar(255) unique, created_at timestamptz default now())",
+			Options{Indent: "\t"},
+			"CREATE TABLE users (\n\tid SERIAL PRIMARY KEY,\n\temail VARCHAR(255) UNIQUE,\n\tcreated_at TIMESTAMPTZ DEFAULT now()\n)"},
+		{"insert upsert", "insert into t (a, b) values (1, 'x'), (2, 'y') on conflict (a) do update set b = excluded.b returning *",
+			Options{Dialect: PostgreSQL},
+			"INSERT INTO t (a, b)\nVALUES (1, 'x'), (2, 'y')\nON CONFLICT (a)\nDO UPDATE SET b = excluded.b\nRETURNING *"},
+		{"cte and set operation", "with recursive cte (n) as (select 1 union all select n + 1 from cte where n < 10) select * from cte", Options{},
+			"WITH RECURSIVE cte (n) AS (\n  SELECT 1\n  UNION ALL\n  SELECT n + 1\n  FROM cte\n  WHERE n < 10\n)\nSELECT *\nFROM cte"},
+		{"comments", "-- header\nselect a, -- first\n b /* inline */ from t -- end\n;\nselect 2; -- after", Options{},
+			"-- header\nSELECT\n  a, -- first\n  b /* inline */\nFROM t; -- end\n\nSELECT 2; -- after"},
+		{"operators", "select x::int, -1, a - 1, data->>'k', arr[1] from t", Options{Dialect: PostgreSQL, KeywordCase: Lower},
+			"select x::int, -1, a - 1, data ->> 'k', arr[1]\nfrom t"},
+		{"preserve case", "Select A From T Where b Is Null", Options{KeywordCase: Preserve},
+			"Select A\nFrom T\nWhere b Is Null"},
+		{"qualified keywords are names", "select t.from, t.order from t", Options{},
+			"SELECT t.from, t.order\nFROM t"},
+		{"minify", "select a , b -- note\nfrom t /*+ INDEX(t i) */ where x = - 1 and y in ( 1 , 2 );", Options{Minify: true},
+			"SELECT a,b FROM t /*+ INDEX(t i) */ WHERE x=-1 AND y IN(1,2);"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Format(tt.src, tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestFormat_Literals checks that dialect-specific literals and quoted
+// identifiers survive formatting untouched, even when they contain keywords,
+// comment markers or quotes.
+func TestFormat_Literals(t *testing.T) {
+	tests := []struct {
+		dialect  Dialect
+		src      string
+		literals []string
+	}{
+		{Standard, `select 'it''s from -- here', "Select ""x"" from" from t`, []string{`'it''s from -- here'`, `"Select ""x"" from"`}},
+		{PostgreSQL, "select $fn$ select 1; -- $fn$, E'a\\'b', $$x$$ from t /* a /* nested */ comment */",
+			[]string{"$fn$ select 1; -- $fn$", `E'a\'b'`, "$$x$$", "/* a /* nested */ comment */"}},
+		{MySQL, "select `order`, \"a\\\"b\", _utf8'x' from t # where 1\nwhere a--1", []string{"`order`", `"a\"b"`, "_utf8'x'", "# where 1", "a - -1"}},
+		{SQLite, "select [from], ?1, :name, $v from t", []string{"[from]", "?1", ":name", "$v"}},
+		{BigQuery, "select r'\\d+', '''a\n'b''', @p from `proj.ds.select`", []string{`r'\d+'`, "'''a\n'b'''", "@p", "`proj.ds.select`"}},
+	}
+	for _, tt := range tests {
+		for _, minify := range []bool{false, true} {
+			got, err := Format(tt.src, Options{Dialect: tt.dialect, Minify: minify})
+			if err != nil {
+				t.Fatalf("%q: %v", tt.src, err)
+			}
+			for _, lit := range tt.literals {
+				if minify && (strings.HasPrefix(lit, "#") || strings.HasPrefix(lit, "/*") || lit == "a - -1") {
+					continue
+				}
+				if !strings.Contains(got, lit) {
+					t.Errorf("dialect %d minify=%v: %q missing from\n%s", tt.dialect, minify, lit, got)
+				}
+			}
+		}
+	}
+}
+
+func TestFormat_Errors(t *testing.T) {
+	tests := []struct {
+		src       string
+		line, col int
+		msg       string
+	}{
+		{"select 'abc", 1, 8, "unterminated string literal"},
+		{"select a\nfrom (select 1", 2, 6, `unclosed "("`},
+		{"select a)", 1, 9, `unexpected ")"`},
+		{"select case when a then b", 1, 8, "CASE without END"},
+		{"select 1 /* open", 1, 10, "unterminated block comment"},
+	}
+	for _, tt := range tests {
+		_, err := Format(tt.src, Options{})
+		var e *Error
+		if !errors.As(err, &e) {
+			t.Fatalf("%q: error = %v, want *Error", tt.src, err)
+		}
+		if e.Line != tt.line || e.Column != tt.col || e.Msg != tt.msg {
+			t.Errorf("%q: got %d:%d %q, want %d:%d %q", tt.src, e.Line, e.Column, e.Msg, tt.line, tt.col, tt.msg)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package sqlfmt
+
+import "strings"
+
+// keywords are the words affected by keyword case: reserved words, clause
+// words and built-in type names. Common non-reserved words that double as
+// table or column names (name, status, user, value…) are deliberately left
+// out, since some engines compare table names case-sensitively.
+var keywords = func() map[string]bool {
+	m := map[string]bool{}
+	for _, w := range strings.Fields(`
+		ADD ALL ALTER ANALYZE AND ANTI ANY ARRAY AS ASC ASOF AUTO_INCREMENT
+		BEGIN BETWEEN BIGINT BINARY BLOB BOOL BOOLEAN BOTH BY BYTEA BYTES
+		CASCADE CASE CAST CHAR CHARACTER CHARSET CHECK COLLATE COLUMN COMMIT CONFLICT
+		CONSTRAINT CONVERT CREATE CROSS CURRENT CURRENT_DATE CURRENT_TIME CURRENT_TIMESTAMP
+		DATE DATETIME DECIMAL DEFAULT DELETE DESC DISTINCT DO DOUBLE DROP DUPLICATE
+		ELSE END ENGINE ESCAPE EXCEPT EXCLUDE EXISTS EXPLAIN EXTRACT
+		FALSE FETCH FILTER FIRST FLOAT FLOAT64 FOLLOWING FOR FOREIGN FROM FULL FUNCTION
+		GENERATED GLOBAL GRANT GROUP GROUPS HAVING IF IGNORE ILIKE IN INDEX INNER INSERT
+		INT INT64 INTEGER INTERSECT INTERVAL INTO IS ISNULL JOIN JSON JSONB
+		KEY LAST LATERAL LEADING LEFT LIKE LIMIT LOCK MATCHED MATERIALIZED MERGE
+		NATURAL NOT NOTHING NOTNULL NULL NULLIF NULLS NUMERIC
+		OFFSET ON ONLY OR ORDER OUTER OVER OVERWRITE PARTITION PRECEDING PRIMARY
+		QUALIFY RANGE REAL RECURSIVE REFERENCES REGEXP RENAME REPLACE RESTRICT RETURNING
+		REVOKE RIGHT RLIKE ROLLBACK ROW ROWS SAFE_CAST SCHEMA SELECT SEMI SERIAL SET
+		SIMILAR SMALLINT SOME STRAIGHT_JOIN STRING STRUCT TABLE TEMP TEMPORARY TEXT THEN
+		TIES TIMESTAMP TIMESTAMPTZ TINYINT TO TRAILING TRANSACTION TRIGGER TRUE TRUNCATE
+		UNBOUNDED UNION UNIQUE UNNEST UNSIGNED UPDATE USING UUID VALUES VARCHAR VARYING
+		VIEW WHEN WHERE WINDOW WITH WITHIN WITHOUT ZONE`) {
+		m[w] = true
+	}
+	return m
+}()
+
+// funcKeywords are keywords that call like functions or take type
+// arguments, so no space goes before their opening parenthesis.
+var funcKeywords = map[string]bool{
+	"ANY": true, "ARRAY": true, "BIGINT": true, "BINARY": true, "BYTES": true, "CAST": true, "CHAR": true,
+	"CHARACTER": true, "CONVERT": true, "DATE": true, "DATETIME": true, "DECIMAL": true,
+	"EXTRACT": true, "FLOAT": true, "IF": true, "INT": true, "INTEGER": true, "LEFT": true,
+	"NULLIF": true, "NUMERIC": true, "REPLACE": true, "RIGHT": true, "ROW": true,
+	"SAFE_CAST": true, "SMALLINT": true, "SOME": true, "STRING": true, "STRUCT": true,
+	"TIMESTAMP": true, "TIMESTAMPTZ": true, "TINYINT": true, "UNNEST": true, "VARCHAR": true,
+}
+
+// parenAfterName are keywords after which "name (" is a column list rather
+// than a function call, as in INSERT INTO t (a, b).
+var parenAfterName = map[string]bool{
+	"INTO": true, "TABLE": true, "EXISTS": true, "REFERENCES": true, "VIEW": true, "WITH": true, "RECURSIVE": true,
+}
@@ -0,0 +1,432 @@
+package sqlfmt
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Dialect selects the quoting, comment and parameter rules of the lexer.
+type Dialect int
+
+const (
+	Standard Dialect = iota
+	PostgreSQL
+	MySQL
+	SQLite
+	BigQuery
+)
+
+// Error is a lexing or nesting failure tied to a position in the input.
+type Error struct {
+	Line   int // 1-based
+	Column int // 1-based, in runes
+	Msg    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Msg)
+}
+
+func errorAt(src string, pos int, format string, args ...any) *Error {
+	before := src[:pos]
+	line := strings.Count(before, "\n") + 1
+	col := utf8.RuneCountInString(before[strings.LastIndexByte(before, '\n')+1:]) + 1
+	return &Error{Line: line, Column: col, Msg: fmt.Sprintf(format, args...)}
+}
+
+type kind int
+
+const (
+	tWord   kind = iota
+	tQuoted      // quoted identifier
+	tString
+	tNumber
+	tParam
+	tOperator
+	tOpen  // ( or [
+	tClose // ) or ]
+	tComma
+	tSemicolon
+	tDot
+	tLineComment
+	tBlockComment
+)
+
+type token struct {
+	kind          kind
+	text          string
+	pos           int
+	newlineBefore bool // the whitespace before the token contains a line break
+	keyword       bool // an unquoted reserved word, subject to keyword case
+	unary         bool // a prefix +, - or ~
+}
+
+func (t *token) is(words ...string) bool {
+	if t.kind != tWord || !t.keyword {
+		return false
+	}
+	for _, w := range words {
+		if strings.EqualFold(t.text, w) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *token) comment() bool { return t.kind == tLineComment || t.kind == tBlockComment }
+
+// operators are tried longest first; anything else is a one-rune operator.
+var operators = []string{
+	"->>", "#>>", "<=>", "!~*", "::", "->", "#>", "@>", "<@", "<=", ">=", "<>", "!=",
+	"||", ":=", "=>", "<<", ">>", "&&", "~*", "!~", "?|", "?&", "**",
+}
+
+type lexer struct {
+	src string
+	d   Dialect
+}
+
+func lex(src string, d Dialect) ([]token, error) {
+	l := &lexer{src: src, d: d}
+	var toks []token
+	newline := false
+	for i := 0; i < len(src); {
+		r, size := utf8.DecodeRuneInString(src[i:])
+		if unicode.IsSpace(r) {
+			newline = newline || r == '\n'
+			i += size
+			continue
+		}
+		k, end, err := l.next(i, r)
+		if err != nil {
+			return nil, err
+		}
+		text := src[i:end]
+		if k == tLineComment {
+			text = strings.TrimRight(text, " \t\r")
+		}
+		toks = append(toks, token{kind: k, text: text, pos: i, newlineBefore: newline})
+		newline = false
+		i = end
+	}
+	classify(toks)
+	return toks, nil
+}
+
+func (l *lexer) at(i int) byte {
+	if i < len(l.src) {
+		return l.src[i]
+	}
+	return 0
+}
+
+// next scans the token starting at i and returns its kind and end offset.
+func (l *lexer) next(i int, r rune) (kind, int, error) {
+	src, d := l.src, l.d
+	c := src[i]
+	switch {
+	case c == '-' && l.at(i+1) == '-' && (d != MySQL || l.at(i+2) == 0 || isSpaceByte(l.at(i+2))):
+		return tLineComment, l.lineEnd(i), nil
+	case c == '#' && (d == MySQL || d == BigQuery):
+		return tLineComment, l.lineEnd(i), nil
+	case c == '/' && l.at(i+1) == '*':
+		end, err := l.blockComment(i)
+		return tBlockComment, end, err
+	case c == '\'':
+		end, err := l.quoted(i, i, d == MySQL || d == BigQuery)
+		return tString, end, err
+	case c == '"' && (d == MySQL || d == BigQuery):
+		end, err := l.quoted(i, i, true)
+		return tString, end, err
+	case c == '"' || c == '`':
+		end, err := l.quoted(i, i, false)
+		return tQuoted, end, err
+	case c == '[' && d == SQLite:
+		end := strings.IndexByte(src[i+1:], ']')
+		if end < 0 {
+			return 0, 0, errorAt(src, i, "unterminated quoted identifier")
+		}
+		return tQuoted, i + end + 2, nil
+	case c == '$' && d == PostgreSQL && !isDigit(l.at(i+1)):
+		if end, ok, err := l.dollarQuoted(i); ok || err != nil {
+			return tString, end, err
+		}
+	case isDigit(c) || c == '.' && isDigit(l.at(i+1)):
+		return tNumber, l.number(i), nil
+	case isIdentStart(r):
+		end := l.ident(i)
+		if q := l.at(end); q == '\'' || q == '"' && d == BigQuery {
+			if backslash, ok := l.stringPrefix(src[i:end]); ok {
+				end, err := l.quoted(i, end, backslash)
+				return tString, end, err
+			}
+		}
+		return tWord, end, nil
+	}
+
+	switch c {
+	case '(', '[':
+		return tOpen, i + 1, nil
+	case ')', ']':
+		return tClose, i + 1, nil
+	case ',':
+		return tComma, i + 1, nil
+	case ';':
+		return tSemicolon, i + 1, nil
+	case '.':
+		return tDot, i + 1, nil
+	}
+	if end, ok := l.param(i); ok {
+		return tParam, end, nil
+	}
+	for _, op := range operators {
+		if strings.HasPrefix(src[i:], op) {
+			return tOperator, i + len(op), nil
+		}
+	}
+	_, size := utf8.DecodeRuneInString(src[i:])
+	return tOperator, i + size, nil
+}
+
+func (l *lexer) lineEnd(i int) int {
+	if n := strings.IndexByte(l.src[i:], '\n'); n >= 0 {
+		return i + n
+	}
+	return len(l.src)
+}
+
+// blockComment scans /* ... */; PostgreSQL comments nest.
+func (l *lexer) blockComment(start int) (int, error) {
+	depth := 0
+	for i := start; i+1 < len(l.src); i++ {
+		switch {
+		case l.src[i] == '/' && l.src[i+1] == '*':
+			if depth == 0 || l.d == PostgreSQL {
+				depth++
+			}
+			i++
+		case l.src[i] == '*' && l.src[i+1] == '/':
+			depth--
+			i++
+			if depth == 0 {
+				return i + 1, nil
+			}
+		}
+	}
+	return 0, errorAt(l.src, start, "unterminated block comment")
+}
+
+// quoted scans a quoted literal or identifier whose opening quote is at q;
+// start is where the token begins, before any string prefix. A doubled
+// quote stands for itself, and backslash escapes the next character when
+// the dialect uses C-style escapes. BigQuery also has triple-quoted
+// strings.
+func (l *lexer) quoted(start, q int, backslash bool) (int, error) {
+	src := l.src
+	quote := src[q]
+	what := "string literal"
+	if quote == '"' && l.d != MySQL && l.d != BigQuery || quote == '`' {
+		what = "quoted identifier"
+	}
+	if l.d == BigQuery && quote != '`' && strings.HasPrefix(src[q:], strings.Repeat(string(quote), 3)) {
+		delim := strings.Repeat(string(quote), 3)
+		for i := q + 3; i < len(src); i++ {
+			if backslash && src[i] == '\\' {
+				i++
+				continue
+			}
+			if strings.HasPrefix(src[i:], delim) {
+				return i + 3, nil
+			}
+		}
+		return 0, errorAt(src, start, "unterminated %s", what)
+	}
+	for i := q + 1; i < len(src); i++ {
+		switch src[i] {
+		case '\\':
+			if backslash {
+				i++
+			}
+		case quote:
+			if l.at(i+1) == quote {
+				i++
+				continue
+			}
+			return i + 1, nil
+		}
+	}
+	return 0, errorAt(src, start, "unterminated %s", what)
+}
+
+// stringPrefix reports whether word introduces a prefixed string literal
+// such as E'…', X'…', N'…', _utf8'…' or BigQuery's r'…' and b'…', and whether
+// backslash escapes apply inside it.
+func (l *lexer) stringPrefix(word string) (backslash, ok bool) {
+	p := strings.ToLower(word)
+	switch l.d {
+	case PostgreSQL:
+		switch p {
+		case "e":
+			return true, true
+		case "b", "x", "n":
+			return false, true
+		}
+	case MySQL:
+		if p == "x" || p == "b" || p == "n" || strings.HasPrefix(p, "_") {
+			return true, true
+		}
+	case BigQuery:
+		switch p {
+		case "b":
+			return true, true
+		case "r", "rb", "br":
+			return false, true
+		}
+	default:
+		if p == "x" || p == "b" || p == "n" {
+			return false, true
+		}
+	}
+	return false, false
+}
+
+// dollarQuoted scans a PostgreSQL $tag$…$tag$ string. ok is false when the
+// $ does not open one.
+func (l *lexer) dollarQuoted(i int) (end int, ok bool, err error) {
+	j := i + 1
+	for j < len(l.src) && (isIdentByte(l.src[j]) && l.src[j] != '$') {
+		j++
+	}
+	if l.at(j) != '$' || j > i+1 && isDigit(l.src[i+1]) {
+		return 0, false, nil
+	}
+	delim := l.src[i : j+1]
+	n := strings.Index(l.src[j+1:], delim)
+	if n < 0 {
+		return 0, true, errorAt(l.src, i, "unterminated dollar-quoted string")
+	}
+	return j + 1 + n + len(delim), true, nil
+}
+
+func (l *lexer) number(i int) int {
+	src := l.src
+	if src[i] == '0' && (l.at(i+1) == 'x' || l.at(i+1) == 'X') && isHex(l.at(i+2)) {
+		j := i + 2
+		for j < len(src) && isHex(src[j]) {
+			j++
+		}
+		return j
+	}
+	j := i
+	for j < len(src) && isDigit(src[j]) {
+		j++
+	}
+	if l.at(j) == '.' {
+		j++
+		for j < len(src) && isDigit(src[j]) {
+			j++
+		}
+	}
+	if c := l.at(j); c == 'e' || c == 'E' {
+		k := j + 1
+		if c := l.at(k); c == '+' || c == '-' {
+			k++
+		}
+		if isDigit(l.at(k)) {
+			for k < len(src) && isDigit(src[k]) {
+				k++
+			}
+			j = k
+		}
+	}
+	return j
+}
+
+func (l *lexer) ident(i int) int {
+	for i < len(l.src) {
+		r, size := utf8.DecodeRuneInString(l.src[i:])
+		if !isIdentStart(r) && !unicode.IsDigit(r) && r != '$' {
+			break
+		}
+		i += size
+	}
+	return i
+}
+
+// param scans bind parameters and variables: ?, ?1, $1, :name, @name and
+// @@name (PostgreSQL reads ? and @ as operators).
+func (l *lexer) param(i int) (int, bool) {
+	src := l.src
+	switch c := src[i]; {
+	case c == '?' && l.d != PostgreSQL:
+		j := i + 1
+		for j < len(src) && isDigit(src[j]) {
+			j++
+		}
+		return j, true
+	case c == '$' && isDigit(l.at(i+1)):
+		j := i + 1
+		for j < len(src) && isDigit(src[j]) {
+			j++
+		}
+		return j, true
+	case c == '$' && l.d == SQLite, c == ':' && l.at(i+1) != ':':
+		r, _ := utf8.DecodeRuneInString(src[i+1:])
+		if isIdentStart(r) {
+			return l.ident(i + 1), true
+		}
+	case c == '@' && l.d != PostgreSQL:
+		j := i + 1
+		if l.at(j) == '@' {
+			j++
+		}
+		if q := l.at(j); q == '`' || q == '\'' || q == '"' {
+			if end, err := l.quoted(i, j, l.d == MySQL); err == nil {
+				return end, true
+			}
+		}
+		r, _ := utf8.DecodeRuneInString(src[j:])
+		if isIdentStart(r) {
+			return l.ident(j), true
+		}
+	}
+	return 0, false
+}
+
+// valueKeywords end an operand, so a following + or - is binary.
+var valueKeywords = map[string]bool{"NULL": true, "TRUE": true, "FALSE": true, "END": true}
+
+// classify marks keywords and unary operators. A word next to a dot is a
+// qualified name, never a keyword.
+func classify(toks []token) {
+	var prev *token
+	for i := range toks {
+		t := &toks[i]
+		if t.comment() {
+			continue
+		}
+		if t.kind == tWord {
+			afterDot := prev != nil && prev.kind == tDot
+			beforeDot := i+1 < len(toks) && toks[i+1].kind == tDot
+			t.keyword = keywords[strings.ToUpper(t.text)] && !afterDot && !beforeDot
+		}
+		if t.kind == tOperator && (t.text == "-" || t.text == "+" || t.text == "~") {
+			t.unary = prev == nil || prev.kind == tOperator || prev.kind == tOpen || prev.kind == tComma ||
+				prev.keyword && !valueKeywords[strings.ToUpper(prev.text)]
+		}
+		prev = t
+	}
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+func isHex(c byte) bool   { return isDigit(c) || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F' }
+func isSpaceByte(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f' || c == '\v'
+}
+func isIdentByte(c byte) bool {
+	return c == '_' || c == '$' || isDigit(c) || c|0x20 >= 'a' && c|0x20 <= 'z'
+}
+
+func isIdentStart(r rune) bool { return r == '_' || unicode.IsLetter(r) }
@@ -0,0 +1,76 @@
+package sqlfmt
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var plainIdentRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// reservedNames are reserved somewhere but left out of keywords so that
+// formatting keeps their case.
+var reservedNames = map[string]bool{"USER": true, "CURRENT_USER": true, "SESSION_USER": true}
+
+// QuoteIdent returns name as an identifier, quoted only when it has to be:
+// when it is a keyword, contains other characters, or (in PostgreSQL and
+// standard SQL, which fold unquoted names) has upper-case letters.
+func QuoteIdent(name string, d Dialect) string {
+	needs := !plainIdentRe.MatchString(name) || keywords[strings.ToUpper(name)] || reservedNames[strings.ToUpper(name)]
+	if (d == Standard || d == PostgreSQL) && name != strings.ToLower(name) {
+		needs = true
+	}
+	if !needs {
+		return name
+	}
+	q := `"`
+	if d == MySQL || d == BigQuery {
+		q = "`"
+	}
+	return q + strings.ReplaceAll(name, q, q+q) + q
+}
+
+// QuoteString returns s as a string literal. MySQL and BigQuery treat
+// backslash as an escape character, so it and control characters are
+// escaped there; the other dialects only double the quote.
+func QuoteString(s string, d Dialect) string {
+	if d != MySQL && d != BigQuery {
+		return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+	}
+	var b strings.Builder
+	b.WriteByte('\'')
+	for _, r := range s {
+		switch r {
+		case '\\':
+			b.WriteString(`\\`)
+		case '\'':
+			b.WriteString(`\'`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case 0:
+			if d == MySQL {
+				b.WriteString(`\0`)
+			} else {
+				b.WriteString(`\x00`)
+			}
+		case 0x1a:
+			if d == MySQL {
+				b.WriteString(`\Z`)
+			} else {
+				b.WriteString(`\x1a`)
+			}
+		default:
+			if r < 0x20 && d == BigQuery {
+				fmt.Fprintf(&b, `\x%02x`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('\'')
+	return b.String()
+}
@@ -0,0 +1,109 @@
+package textdiff
+
+// Region is one stretch of a three-way merge. A clean region holds the
+// lines to keep in Lines; a conflicting one holds both competing versions
+// and the base they started from.
+type Region struct {
+	Conflict     bool
+	Lines        []string // clean regions only
+	BaseStart    int      // 0-based range of the region in base
+	BaseEnd      int
+	Base         []string // conflicts only
+	Mine, Theirs []string
+}
+
+// Merge3 merges the changes from base to mine and from base to theirs.
+// Stretches that only one side changed take that side; identical changes
+// on both sides are taken once; different changes to the same base lines,
+// or insertions at the same point, conflict. Adjacent clean regions are
+// joined.
+func Merge3(base, mine, theirs []string, key func(string) string) ([]Region, error) {
+	em, err := Diff(base, mine, key)
+	if err != nil {
+		return nil, err
+	}
+	et, err := Diff(base, theirs, key)
+	if err != nil {
+		return nil, err
+	}
+
+	var regions []Region
+	add := func(r Region) {
+		if !r.Conflict && len(r.Lines) == 0 {
+			return
+		}
+		if n := len(regions); n > 0 && !r.Conflict && !regions[n-1].Conflict {
+			regions[n-1].Lines = append(regions[n-1].Lines, r.Lines...)
+			regions[n-1].BaseEnd = r.BaseEnd
+			return
+		}
+		regions = append(regions, r)
+	}
+	same := func(x, y []string) bool {
+		if len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if key(x[i]) != key(y[i]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	ib, im, it := 0, 0, 0
+	for _, s := range syncRegions(em, et, len(base), len(mine), len(theirs)) {
+		b, m, t := base[ib:s.base], mine[im:s.mine], theirs[it:s.theirs]
+		r := Region{BaseStart: ib, BaseEnd: s.base}
+		switch {
+		case same(m, b):
+			r.Lines = t
+		case same(t, b), same(m, t):
+			r.Lines = m
+		default:
+			r.Conflict, r.Base, r.Mine, r.Theirs = true, b, m, t
+		}
+		add(r)
+		add(Region{Lines: mine[s.mine : s.mine+s.n], BaseStart: s.base, BaseEnd: s.base + s.n})
+		ib, im, it = s.base+s.n, s.mine+s.n, s.theirs+s.n
+	}
+	return regions, nil
+}
+
+// syncRegion is a run of n base lines that both sides left unchanged.
+type syncRegion struct {
+	base, mine, theirs, n int
+}
+
+// syncRegions intersects the unchanged runs of both diffs, ending with an
+// empty sentinel at the end of every text.
+func syncRegions(em, et []Edit, nb, nm, nt int) []syncRegion {
+	var out []syncRegion
+	i, j := 0, 0
+	for i < len(em) && j < len(et) {
+		a, b := em[i], et[j]
+		if a.Op != Equal {
+			i++
+			continue
+		}
+		if b.Op != Equal {
+			j++
+			continue
+		}
+		lo, hi := max(a.AStart, b.AStart), min(a.AEnd, b.AEnd)
+		if lo < hi {
+			out = append(out, syncRegion{
+				base:   lo,
+				mine:   a.BStart + lo - a.AStart,
+				theirs: b.BStart + lo - b.AStart,
+				n:      hi - lo,
+			})
+		}
+		if a.AEnd < b.AEnd {
+			i++
+		} else {
+			j++
+		}
+	}
+	return append(out, syncRegion{base: nb, mine: nm, theirs: nt})
+}
@@ -0,0 +1,305 @@
+package textdiff
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// FilePatch is the part of a unified diff that changes one file.
+type FilePatch struct {
+	OldName, NewName string // from the --- and +++ lines; empty when absent
+	Hunks            []PatchHunk
+}
+
+// PatchHunk is one @@ hunk. Starts are 1-based as in the header.
+type PatchHunk struct {
+	AStart, ALines int
+	BStart, BLines int
+	Header         string
+	Lines          []PatchLine
+}
+
+// PatchLine is a context (Equal), removed (Delete) or added (Insert) line,
+// with its line break unless a "\ No newline" marker followed it.
+type PatchLine struct {
+	Op   Op
+	Text string
+}
+
+var hunkHeaderRe = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@`)
+
+// ParseUnified reads a unified diff, as written by diff -u or git diff.
+// Lines outside hunks other than the --- and +++ file headers, such as
+// "diff --git" or "index", are ignored.
+func ParseUnified(patch string) ([]FilePatch, error) {
+	if !strings.HasSuffix(patch, "\n") {
+		patch += "\n" // pasted patches often lose the final line break
+	}
+	var files []FilePatch
+	lines := Lines(patch)
+	for i := 0; i < len(lines); i++ {
+		line := trimEOL(lines[i])
+		switch {
+		case strings.HasPrefix(line, "--- ") && i+1 < len(lines) && strings.HasPrefix(lines[i+1], "+++ "):
+			files = append(files, FilePatch{OldName: patchName(line[4:]), NewName: patchName(trimEOL(lines[i+1])[4:])})
+			i++
+
+		case strings.HasPrefix(line, "@@"):
+			m := hunkHeaderRe.FindStringSubmatch(line)
+			if m == nil {
+				return nil, fmt.Errorf("line %d: malformed hunk header %q", i+1, line)
+			}
+			h := PatchHunk{Header: line}
+			h.AStart, h.ALines = hunkRange(m[1], m[2])
+			h.BStart, h.BLines = hunkRange(m[3], m[4])
+			start := i
+			for a, b := h.ALines, h.BLines; a > 0 || b > 0; {
+				i++
+				if i >= len(lines) {
+					return nil, fmt.Errorf("line %d: hunk %s ends early", start+1, h.Header)
+				}
+				l := lines[i]
+				var pl PatchLine
+				switch {
+				case l == "\n" || l == "\r\n":
+					pl = PatchLine{Equal, l} // a context line whose space was stripped
+				case l[0] == ' ':
+					pl = PatchLine{Equal, l[1:]}
+				case l[0] == '-':
+					pl = PatchLine{Delete, l[1:]}
+				case l[0] == '+':
+					pl = PatchLine{Insert, l[1:]}
+				case l[0] == '\\':
+					noNewline(&h)
+					continue
+				default:
+					return nil, fmt.Errorf("line %d: unexpected %q in hunk %s", i+1, trimEOL(l), h.Header)
+				}
+				if pl.Op != Insert {
+					a--
+				}
+				if pl.Op != Delete {
+					b--
+				}
+				if a < 0 || b < 0 {
+					return nil, fmt.Errorf("line %d: hunk %s has more lines than its header says", i+1, h.Header)
+				}
+				h.Lines = append(h.Lines, pl)
+			}
+			if i+1 < len(lines) && strings.HasPrefix(lines[i+1], `\`) {
+				noNewline(&h)
+				i++
+			}
+			if len(files) == 0 {
+				files = append(files, FilePatch{})
+			}
+			f := &files[len(files)-1]
+			f.Hunks = append(f.Hunks, h)
+		}
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no hunks found")
+	}
+	return files, nil
+}
+
+// patchName drops the timestamp that may follow a tab.
+func patchName(s string) string {
+	name, _, _ := strings.Cut(s, "\t")
+	return strings.TrimSpace(name)
+}
+
+// headerRange formats a range as written in a hunk header.
+func headerRange(start, n int) string {
+	if n == 1 {
+		return strconv.Itoa(start)
+	}
+	return fmt.Sprintf("%d,%d", start, n)
+}
+
+func hunkRange(start, count string) (int, int) {
+	s, _ := strconv.Atoi(start)
+	if count == "" {
+		return s, 1
+	}
+	n, _ := strconv.Atoi(count)
+	return s, n
+}
+
+// noNewline applies a "\ No newline at end of file" marker to the last
+// line read.
+func noNewline(h *PatchHunk) {
+	if n := len(h.Lines); n > 0 {
+		h.Lines[n-1].Text = strings.TrimSuffix(h.Lines[n-1].Text, "\n")
+	}
+}
+
+// Reverse returns the hunk that undoes h.
+func (h PatchHunk) Reverse() PatchHunk {
+	r := PatchHunk{AStart: h.BStart, ALines: h.BLines, BStart: h.AStart, BLines: h.ALines, Lines: make([]PatchLine, len(h.Lines))}
+	r.Header = fmt.Sprintf("@@ -%s +%s @@", headerRange(r.AStart, r.ALines), headerRange(r.BStart, r.BLines))
+	for i, l := range h.Lines {
+		switch l.Op {
+		case Delete:
+			l.Op = Insert
+		case Insert:
+			l.Op = Delete
+		}
+		r.Lines[i] = l
+	}
+	return r
+}
+
+// HunkStatus is the outcome of applying one hunk.
+type HunkStatus string
+
+const (
+	Applied        HunkStatus = "applied"
+	Fuzzy          HunkStatus = "fuzzy"           // applied ignoring some context lines
+	AlreadyApplied HunkStatus = "already applied" // the hunk's result is already there
+	Failed         HunkStatus = "failed"
+)
+
+// HunkResult reports where a hunk applied. Offset is the distance in lines
+// from where the header placed it, after earlier hunks' growth; Line is
+// the 1-based input line the matched text starts at.
+type HunkResult struct {
+	Status HunkStatus
+	Offset int
+	Fuzz   int
+	Line   int
+}
+
+// Apply applies hunks to lines in order, as patch(1) does: each hunk is
+// looked for nearest to where its header and the drift of the previous
+// hunk place it, first with all of its context and then ignoring up to
+// fuzz context lines at each end. Hunks that cannot be placed are skipped
+// and reported as failed.
+func Apply(lines []string, hunks []PatchHunk, fuzz int) ([]string, []HunkResult) {
+	cur := append([]string(nil), lines...)
+	results := make([]HunkResult, len(hunks))
+	growth, drift, floor := 0, 0, 0
+	for i, h := range hunks {
+		var old, repl []string
+		for _, l := range h.Lines {
+			if l.Op != Insert {
+				old = append(old, l.Text)
+			}
+			if l.Op != Delete {
+				repl = append(repl, l.Text)
+			}
+		}
+		lead, trail := contextRun(h.Lines, false), contextRun(h.Lines, true)
+		// Uneven context means the diff ran into the start or end of the
+		// file, so, as in patch(1), the hunk only applies there unless fuzzed.
+		anchor := cmp.Compare(lead, trail)
+		want := h.AStart - 1 + growth
+		if h.ALines == 0 {
+			want = h.AStart + growth // an empty range names the line before it
+		}
+
+		res := HunkResult{Status: Failed}
+		try := func(f int) bool {
+			cutLead, cutTrail := min(f, lead), min(f, trail)
+			if cutLead+cutTrail > len(old) {
+				return false // a hunk of nothing but context
+			}
+			o := old[cutLead : len(old)-cutTrail]
+			a := anchor
+			if f > 0 {
+				a = 0
+			}
+			pos, ok := place(cur, o, want+drift+cutLead, floor, a)
+			if !ok {
+				return false
+			}
+			r := repl[cutLead : len(repl)-cutTrail]
+			cur = append(cur[:pos], append(append([]string(nil), r...), cur[pos+len(o):]...)...)
+			res = HunkResult{Status: Applied, Offset: pos - cutLead - want, Fuzz: f, Line: pos - growth + 1}
+			if f > 0 {
+				res.Status = Fuzzy
+			}
+			growth += len(r) - len(o)
+			floor = pos + len(r)
+			drift = res.Offset
+			return true
+		}
+		if !try(0) {
+			// As patch(1) does, look for the hunk's result before fuzzing,
+			// which could otherwise apply it a second time.
+			if _, ok := place(cur, repl, want+drift, 0, anchor); ok && len(repl) > 0 {
+				res.Status = AlreadyApplied
+			} else {
+				for f := 1; f <= min(fuzz, max(lead, trail)); f++ {
+					if try(f) {
+						break
+					}
+				}
+			}
+		}
+		results[i] = res
+	}
+	return cur, results
+}
+
+// contextRun counts the context lines at the start, or end, of a hunk.
+func contextRun(lines []PatchLine, fromEnd bool) int {
+	n := 0
+	for i := range lines {
+		l := lines[i]
+		if fromEnd {
+			l = lines[len(lines)-1-i]
+		}
+		if l.Op != Equal {
+			break
+		}
+		n++
+	}
+	return n
+}
+
+// place finds want in lines as find does, but when anchor is positive
+// only at the end of lines, and when it is negative only at the start.
+func place(lines, want []string, near, floor, anchor int) (int, bool) {
+	var pos int
+	switch {
+	case anchor > 0:
+		pos = len(lines) - len(want)
+	case anchor < 0:
+		pos = 0
+	default:
+		return find(lines, want, near, floor)
+	}
+	return pos, pos >= floor && pos+len(want) <= len(lines) && matchAt(lines, want, pos)
+}
+
+// find returns the position at or after floor where want occurs in lines,
+// nearest to near.
+func find(lines, want []string, near, floor int) (int, bool) {
+	last := len(lines) - len(want)
+	if last < floor {
+		return 0, false
+	}
+	near = min(max(near, floor), last)
+	for d := 0; near-d >= floor || near+d <= last; d++ {
+		if p := near - d; p >= floor && matchAt(lines, want, p) {
+			return p, true
+		}
+		if p := near + d; d > 0 && p <= last && matchAt(lines, want, p) {
+			return p, true
+		}
+	}
+	return 0, false
+}
+
+func matchAt(lines, want []string, p int) bool {
+	for i, w := range want {
+		if lines[p+i] != w {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,199 @@
+// Package textdiff compares texts as sequences of lines, words or
+// characters, renders line differences as unified diffs and side-by-side
+// hunks, and merges two sets of changes to a common base.
+//
+// The comparison itself is diff-match-patch's Myers implementation run over
+// token IDs, so any tokenization and any notion of token equality (such as
+// ignoring case or whitespace) can share one algorithm.
+package textdiff
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+// Op is the kind of an edit.
+type Op int
+
+const (
+	Equal Op = iota
+	Delete
+	Insert
+	// Change marks a side-by-side row that pairs a deleted line with the
+	// inserted line replacing it; Diff itself never returns it.
+	Change
+)
+
+func (op Op) String() string {
+	switch op {
+	case Delete:
+		return "delete"
+	case Insert:
+		return "insert"
+	case Change:
+		return "change"
+	}
+	return "equal"
+}
+
+// Edit is a run of tokens with the same fate: a[AStart:AEnd] and
+// b[BStart:BEnd]. Equal runs cover the same number of tokens on both sides,
+// a Delete has an empty b range and an Insert an empty a range.
+type Edit struct {
+	Op           Op
+	AStart, AEnd int
+	BStart, BEnd int
+}
+
+// Options relax token equality.
+type Options struct {
+	IgnoreCase bool
+	// IgnoreWhitespace drops all whitespace when comparing lines, and
+	// lets any run of whitespace match any other when comparing words or
+	// characters.
+	IgnoreWhitespace bool
+}
+
+// maxTokens is the number of distinct tokens that fit in the rune space
+// diff-match-patch works on, skipping the surrogate range.
+const maxTokens = unicode.MaxRune - 0x800
+
+// Diff compares two token sequences, treating tokens as equal when key
+// maps them to the same string.
+func Diff(a, b []string, key func(string) string) ([]Edit, error) {
+	ids := map[string]rune{}
+	encode := func(tokens []string) ([]rune, error) {
+		out := make([]rune, len(tokens))
+		for i, t := range tokens {
+			k := key(t)
+			r, ok := ids[k]
+			if !ok {
+				if len(ids) >= maxTokens {
+					return nil, errors.New("too many distinct tokens to compare")
+				}
+				r = rune(len(ids) + 1)
+				if r >= 0xD800 {
+					r += 0x800 // stay clear of surrogates, which do not survive string conversion
+				}
+				ids[k] = r
+			}
+			out[i] = r
+		}
+		return out, nil
+	}
+	ra, err := encode(a)
+	if err != nil {
+		return nil, err
+	}
+	rb, err := encode(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var edits []Edit
+	ai, bi := 0, 0
+	for _, d := range diffmatchpatch.New().DiffMainRunes(ra, rb, false) {
+		n := len([]rune(d.Text))
+		e := Edit{AStart: ai, AEnd: ai, BStart: bi, BEnd: bi}
+		switch d.Type {
+		case diffmatchpatch.DiffEqual:
+			e.Op, e.AEnd, e.BEnd = Equal, ai+n, bi+n
+		case diffmatchpatch.DiffDelete:
+			e.Op, e.AEnd = Delete, ai+n
+		case diffmatchpatch.DiffInsert:
+			e.Op, e.BEnd = Insert, bi+n
+		}
+		ai, bi = e.AEnd, e.BEnd
+		edits = append(edits, e)
+	}
+	return edits, nil
+}
+
+// LineKey returns the comparison key for lines under opts.
+func LineKey(opts Options) func(string) string {
+	return func(s string) string {
+		if opts.IgnoreWhitespace {
+			s = strings.Map(func(r rune) rune {
+				if unicode.IsSpace(r) {
+					return -1
+				}
+				return r
+			}, s)
+		}
+		if opts.IgnoreCase {
+			s = strings.ToLower(s)
+		}
+		return s
+	}
+}
+
+// TokenKey returns the comparison key for words or characters under opts.
+func TokenKey(opts Options) func(string) string {
+	return func(s string) string {
+		if opts.IgnoreWhitespace && strings.TrimSpace(s) == "" {
+			return " "
+		}
+		if opts.IgnoreCase {
+			s = strings.ToLower(s)
+		}
+		return s
+	}
+}
+
+// Lines splits s after each "\n", keeping the line breaks so that a
+// missing final newline is itself a difference.
+func Lines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// Words splits s into runs of letters and digits, runs of whitespace and
+// single other characters.
+func Words(s string) []string {
+	return split(s, func(r rune) int {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || unicode.Is(unicode.Mn, r):
+			return 1
+		case unicode.IsSpace(r):
+			return 2
+		}
+		return 0
+	})
+}
+
+// Chars splits s into characters, keeping each run of whitespace together.
+func Chars(s string) []string {
+	return split(s, func(r rune) int {
+		if unicode.IsSpace(r) {
+			return 2
+		}
+		return 0
+	})
+}
+
+// split groups consecutive runes of the same nonzero class.
+func split(s string, class func(rune) int) []string {
+	var out []string
+	start, prev := 0, 0
+	for i, r := range s {
+		c := class(r)
+		if i > start && (c == 0 || c != prev) {
+			out = append(out, s[start:i])
+			start = i
+		}
+		prev = c
+	}
+	if start < len(s) {
+		out = append(out, s[start:])
+	}
+	return out
+}
@@ -0,0 +1,263 @@
+package textdiff
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func lineDiff(t *testing.T, a, b string, opts Options) ([]string, []string, []Edit) {
+	t.Helper()
+	la, lb := Lines(a), Lines(b)
+	edits, err := Diff(la, lb, LineKey(opts))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return la, lb, edits
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name string
+		got  []string
+		want []string
+	}{
+		{"lines", Lines("a\nb\n\nc"), []string{"a\n", "b\n", "\n", "c"}},
+		{"lines trailing newline", Lines("a\n"), []string{"a\n"}},
+		{"lines empty", Lines(""), nil},
+		{"words", Words("foo_bar, baz  9x!"), []string{"foo_bar", ",", " ", "baz", "  ", "9x", "!"}},
+		{"chars", Chars("ab  c\n"), []string{"a", "b", "  ", "c", "\n"}},
+	}
+	for _, tt := range tests {
+		if !reflect.DeepEqual(tt.got, tt.want) {
+			t.Errorf("%s = %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestUnified(t *testing.T) {
+	a := "one\ntwo\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten\n"
+	tests := []struct {
+		name    string
+		b       string
+		context int
+		want    string
+	}{
+		{"single change", strings.Replace(a, "five", "FIVE", 1), 2, `--- a
++++ b
+@@ -3,5 +3,5 @@
+ three
+ four
+-five
++FIVE
+ six
+ seven
+`},
+		{"nearby changes share a hunk", strings.NewReplacer("two", "2", "five", "5").Replace(a), 1, `--- a
++++ b
+@@ -1,6 +1,6 @@
+ one
+-two
++2
+ three
+ four
+-five
++5
+ six
+`},
+		{"distant changes split", strings.NewReplacer("one", "1", "ten", "10").Replace(a), 1, `--- a
++++ b
+@@ -1,2 +1,2 @@
+-one
++1
+ two
+@@ -9,2 +9,2 @@
+ nine
+-ten
++10
+`},
+		{"insertion without context", strings.Replace(a, "three\n", "three\nthree and a half\n", 1), 0, `--- a
++++ b
+@@ -3,0 +4 @@
++three and a half
+`},
+		{"missing final newline", strings.TrimSuffix(a, "\n"), 1, `--- a
++++ b
+@@ -9,2 +9,2 @@
+ nine
+-ten
++ten
+\ No newline at end of file
+`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			la, lb, edits := lineDiff(t, a, tt.b, Options{})
+			if got := Unified(la, lb, "a", "b", Hunks(edits, tt.context)); got != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiff_Options(t *testing.T) {
+	_, _, edits := lineDiff(t, "Hello  World\nbye\n", "hello world\nbye\n", Options{IgnoreCase: true, IgnoreWhitespace: true})
+	if len(edits) != 1 || edits[0].Op != Equal {
+		t.Errorf("expected equal, got %+v", edits)
+	}
+	edits, err := Diff(Words("a  b c"), Words("a b C"), TokenKey(Options{IgnoreWhitespace: true}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ops []string
+	for _, e := range edits {
+		ops = append(ops, e.Op.String())
+	}
+	if got := strings.Join(ops, ","); got != "equal,delete,insert" {
+		t.Errorf("ops = %s", got)
+	}
+}
+
+func TestRows(t *testing.T) {
+	la, lb, edits := lineDiff(t, "a\nb\nc\nd\n", "a\nB\nC\nX\nd\n", Options{})
+	hunks := Hunks(edits, 1)
+	if len(hunks) != 1 {
+		t.Fatalf("hunks = %+v", hunks)
+	}
+	want := []Row{
+		{Op: Equal, ALine: 1, BLine: 1, A: "a", B: "a"},
+		{Op: Change, ALine: 2, BLine: 2, A: "b", B: "B"},
+		{Op: Change, ALine: 3, BLine: 3, A: "c", B: "C"},
+		{Op: Insert, BLine: 4, B: "X"},
+		{Op: Equal, ALine: 4, BLine: 5, A: "d", B: "d"},
+	}
+	if got := Rows(la, lb, hunks[0]); !reflect.DeepEqual(got, want) {
+		t.Errorf("rows = %+v", got)
+	}
+}
+
+func TestMerge3(t *testing.T) {
+	base := "a\nb\nc\nd\ne\n"
+	tests := []struct {
+		name      string
+		mine      string
+		theirs    string
+		merged    string // clean merges only
+		conflicts int
+	}{
+		{"disjoint edits", "A\nb\nc\nd\ne\n", "a\nb\nc\nd\nE\n", "A\nb\nc\nd\nE\n", 0},
+		{"same edit on both sides", "a\nB\nc\nd\ne\n", "a\nB\nc\nd\ne\n", "a\nB\nc\nd\ne\n", 0},
+		{"one side deletes", "a\nc\nd\ne\n", base, "a\nc\nd\ne\n", 0},
+		{"insert and edit elsewhere", "a\nb\nx\nc\nd\ne\n", "a\nb\nc\nd\nE\n", "a\nb\nx\nc\nd\nE\n", 0},
+		{"competing edits", "a\nb\nMINE\nd\ne\n", "a\nb\nTHEIRS\nd\ne\n", "", 1},
+		{"competing inserts", "a\nb\nc\nd\ne\nm\n", "a\nb\nc\nd\ne\nt\n", "", 1},
+		{"edit against delete", "a\nb\nC\nd\ne\n", "a\nb\nd\ne\n", "", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			regions, err := Merge3(Lines(base), Lines(tt.mine), Lines(tt.theirs), LineKey(Options{}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var merged strings.Builder
+			conflicts := 0
+			for _, r := range regions {
+				if r.Conflict {
+					conflicts++
+				}
+				merged.WriteString(strings.Join(r.Lines, ""))
+			}
+			if conflicts != tt.conflicts {
+				t.Fatalf("conflicts = %d, want %d (%+v)", conflicts, tt.conflicts, regions)
+			}
+			if conflicts == 0 && merged.String() != tt.merged {
+				t.Errorf("merged = %q, want %q", merged.String(), tt.merged)
+			}
+		})
+	}
+}
+
+func TestMerge3_ConflictRegion(t *testing.T) {
+	regions, err := Merge3(Lines("a\nb\nc\n"), Lines("a\nB1\nc\n"), Lines("a\nB2\nB3\nc\n"), LineKey(Options{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(regions) != 3 || !regions[1].Conflict {
+		t.Fatalf("regions = %+v", regions)
+	}
+	c := regions[1]
+	if c.BaseStart != 1 || c.BaseEnd != 2 || strings.Join(c.Base, "") != "b\n" ||
+		strings.Join(c.Mine, "") != "B1\n" || strings.Join(c.Theirs, "") != "B2\nB3\n" {
+		t.Errorf("conflict = %+v", c)
+	}
+}
+
+func TestParseUnified(t *testing.T) {
+	patch := "diff --git a/f.txt b/f.txt\nindex 1..2 100644\n--- a/f.txt\t2024-01-01\n+++ b/f.txt\n@@ -1,2 +1,2 @@\n a\n-b\n+B\n@@ -5 +5,0 @@\n-e\n\\ No newline at end of file"
+	files, err := ParseUnified(patch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0].OldName != "a/f.txt" || files[0].NewName != "b/f.txt" || len(files[0].Hunks) != 2 {
+		t.Fatalf("files = %+v", files)
+	}
+	h := files[0].Hunks[1]
+	if h.AStart != 5 || h.ALines != 1 || h.BStart != 5 || h.BLines != 0 || len(h.Lines) != 1 || h.Lines[0].Text != "e" {
+		t.Errorf("hunk = %+v", h)
+	}
+	if r := files[0].Hunks[0].Reverse(); r.Header != "@@ -1,2 +1,2 @@" || r.Lines[1].Op != Insert || r.Lines[2].Op != Delete {
+		t.Errorf("reverse = %+v", r)
+	}
+
+	for _, bad := range []string{"@@ -1,2 +1,2 @@\n a\n", "@@ -x @@\n", "@@ -1 +1 @@\n?a\n", "just text\n"} {
+		if _, err := ParseUnified(bad); err == nil {
+			t.Errorf("%q: expected an error", bad)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	hunks := func(patch string) []PatchHunk {
+		files, err := ParseUnified(patch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return files[0].Hunks
+	}
+	tests := []struct {
+		name   string
+		text   string
+		patch  string
+		fuzz   int
+		want   string
+		result []HunkResult
+	}{
+		{"exact", "a\nb\nc\n", "@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n", 0, "a\nB\nc\n",
+			[]HunkResult{{Applied, 0, 0, 1}}},
+		{"offset", "x\ny\na\nb\nc\n", "@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n", 0, "x\ny\na\nB\nc\n",
+			[]HunkResult{{Applied, 2, 0, 3}}},
+		{"fuzz", "a\nb\nC\n", "@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n", 1, "a\nB\nC\n",
+			[]HunkResult{{Fuzzy, 0, 1, 2}}},
+		{"no fuzz allowed", "a\nb\nC\n", "@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n", 0, "a\nb\nC\n",
+			[]HunkResult{{Status: Failed}}},
+		{"already applied", "a\nB\nc\n", "@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n", 2, "a\nB\nc\n",
+			[]HunkResult{{Status: AlreadyApplied}}},
+		{"second hunk follows the first's drift", "0\n1\n2\n3\n4\n5\n6\n7\n8\n9\n",
+			"@@ -1,2 +1,3 @@\n 0\n+0.5\n 1\n@@ -7,3 +8,3 @@\n 6\n-7\n+seven\n 8\n", 0,
+			"0\n0.5\n1\n2\n3\n4\n5\n6\nseven\n8\n9\n",
+			[]HunkResult{{Applied, 0, 0, 1}, {Applied, 0, 0, 7}}},
+		{"insert into empty", "", "@@ -0,0 +1,2 @@\n+new\n+file\n", 0, "new\nfile\n",
+			[]HunkResult{{Applied, 0, 0, 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, results := Apply(Lines(tt.text), hunks(tt.patch), tt.fuzz)
+			if got := strings.Join(out, ""); got != tt.want {
+				t.Errorf("result = %q, want %q", got, tt.want)
+			}
+			if !reflect.DeepEqual(results, tt.result) {
+				t.Errorf("hunks = %+v, want %+v", results, tt.result)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package textdiff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Hunk is a group of nearby line changes with up to context unchanged
+// lines around them. Starts are 0-based line indexes.
+type Hunk struct {
+	AStart, ALines int
+	BStart, BLines int
+	Edits          []Edit
+}
+
+// Header returns the unified diff range line, "@@ -1,3 +1,4 @@". Ranges
+// are 1-based; an empty range names the line before it, and a count of
+// one is omitted.
+func (h Hunk) Header() string {
+	return fmt.Sprintf("@@ -%s +%s @@", unifiedRange(h.AStart, h.ALines), unifiedRange(h.BStart, h.BLines))
+}
+
+func unifiedRange(start, n int) string {
+	switch n {
+	case 0:
+		return fmt.Sprintf("%d,0", start)
+	case 1:
+		return fmt.Sprint(start + 1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, n)
+}
+
+// Hunks groups line edits into hunks. Changes separated by no more than
+// 2*context unchanged lines share a hunk, as in diff -u.
+func Hunks(edits []Edit, context int) []Hunk {
+	context = max(context, 0)
+	var hunks []Hunk
+	var cur *Hunk
+	for i, e := range edits {
+		if e.Op == Equal {
+			continue
+		}
+		// Leading context comes from the equal run just before e.
+		lead := Edit{Op: Equal, AStart: e.AStart, AEnd: e.AStart, BStart: e.BStart, BEnd: e.BStart}
+		if i > 0 && edits[i-1].Op == Equal {
+			prev := edits[i-1]
+			n := min(context, prev.AEnd-prev.AStart)
+			if cur != nil && prev.AEnd-prev.AStart <= 2*context {
+				n = prev.AEnd - prev.AStart // close enough to join the current hunk
+			} else {
+				cur = nil
+			}
+			lead.AStart, lead.BStart = prev.AEnd-n, prev.BEnd-n
+		}
+		if cur == nil {
+			hunks = append(hunks, Hunk{AStart: lead.AStart, BStart: lead.BStart})
+			cur = &hunks[len(hunks)-1]
+		}
+		if lead.AEnd > lead.AStart {
+			cur.Edits = append(cur.Edits, lead)
+		}
+		cur.Edits = append(cur.Edits, e)
+		// Trailing context, trimmed again if the next change joins.
+		if i+1 < len(edits) && edits[i+1].Op == Equal {
+			next := edits[i+1]
+			n := min(context, next.AEnd-next.AStart)
+			joins := i+2 < len(edits) && next.AEnd-next.AStart <= 2*context
+			if n > 0 && !joins {
+				cur.Edits = append(cur.Edits, Edit{Op: Equal, AStart: next.AStart, AEnd: next.AStart + n, BStart: next.BStart, BEnd: next.BStart + n})
+			}
+		}
+	}
+	for i := range hunks {
+		h := &hunks[i]
+		last := h.Edits[len(h.Edits)-1]
+		h.ALines, h.BLines = last.AEnd-h.AStart, last.BEnd-h.BStart
+	}
+	return hunks
+}
+
+// Unified renders hunks of a line diff of a and b as a unified diff with
+// "---" and "+++" labels. It returns "" when there are no hunks.
+func Unified(a, b []string, aLabel, bLabel string, hunks []Hunk) string {
+	if len(hunks) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", aLabel, bLabel)
+	for _, h := range hunks {
+		sb.WriteString(h.Header())
+		sb.WriteByte('\n')
+		for _, e := range h.Edits {
+			switch e.Op {
+			case Equal:
+				writeLines(&sb, ' ', b[e.BStart:e.BEnd])
+			case Delete:
+				writeLines(&sb, '-', a[e.AStart:e.AEnd])
+			case Insert:
+				writeLines(&sb, '+', b[e.BStart:e.BEnd])
+			}
+		}
+	}
+	return sb.String()
+}
+
+func writeLines(sb *strings.Builder, prefix byte, lines []string) {
+	for _, l := range lines {
+		sb.WriteByte(prefix)
+		sb.WriteString(l)
+		if !strings.HasSuffix(l, "\n") {
+			sb.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+// Row is one line of a side-by-side view. ALine and BLine are 1-based, or
+// 0 on the side a row does not have.
+type Row struct {
+	Op           Op
+	ALine, BLine int
+	A, B         string // without line breaks
+}
+
+// Rows lays out a hunk side by side. A deletion followed by an insertion
+// pairs up line by line into Change rows; the longer side's extra lines
+// stay Delete or Insert rows.
+func Rows(a, b []string, h Hunk) []Row {
+	var rows []Row
+	edits := h.Edits
+	for i := 0; i < len(edits); i++ {
+		e := edits[i]
+		switch e.Op {
+		case Equal:
+			for k := range e.AEnd - e.AStart {
+				rows = append(rows, Row{Op: Equal, ALine: e.AStart + k + 1, BLine: e.BStart + k + 1,
+					A: trimEOL(a[e.AStart+k]), B: trimEOL(b[e.BStart+k])})
+			}
+		case Insert:
+			for k := e.BStart; k < e.BEnd; k++ {
+				rows = append(rows, Row{Op: Insert, BLine: k + 1, B: trimEOL(b[k])})
+			}
+		case Delete:
+			ins := Edit{BStart: e.BEnd, BEnd: e.BEnd}
+			if i+1 < len(edits) && edits[i+1].Op == Insert {
+				ins = edits[i+1]
+				i++
+			}
+			n := max(e.AEnd-e.AStart, ins.BEnd-ins.BStart)
+			for k := range n {
+				r := Row{Op: Change}
+				if ak := e.AStart + k; ak < e.AEnd {
+					r.ALine, r.A = ak+1, trimEOL(a[ak])
+				} else {
+					r.Op = Insert
+				}
+				if bk := ins.BStart + k; bk < ins.BEnd {
+					r.BLine, r.B = bk+1, trimEOL(b[bk])
+				} else {
+					r.Op = Delete
+				}
+				rows = append(rows, r)
+			}
+		}
+	}
+	return rows
+}
+
+func trimEOL(s string) string {
+	s = strings.TrimSuffix(s, "\n")
+	return strings.TrimSuffix(s, "\r")
+}
@@ -0,0 +1,174 @@
+package xmldoc
+
+import (
+	"strings"
+)
+
+// FormatOptions controls Format.
+type FormatOptions struct {
+	// Indent is the per-level indentation; empty minifies.
+	Indent string
+	// StripComments drops comments from the output.
+	StripComments bool
+}
+
+// Format writes the document back out. Whitespace-only text between
+// elements is replaced by the layout; elements with mixed content and those
+// under xml:space="preserve" are written exactly as parsed. Attribute order,
+// comments, CDATA sections and processing instructions are kept.
+func Format(doc *Node, opts FormatOptions) string {
+	w := &writer{opts: opts}
+	first := true
+	for _, c := range doc.Children {
+		if c.Type == CommentNode && opts.StripComments {
+			continue
+		}
+		if !first && opts.Indent != "" {
+			w.b.WriteByte('\n')
+		}
+		first = false
+		w.node(c, 0, false)
+	}
+	if opts.Indent != "" {
+		w.b.WriteByte('\n')
+	}
+	return w.b.String()
+}
+
+// Serialize writes a node as XML without changing any whitespace.
+func Serialize(n *Node) string {
+	w := &writer{}
+	switch n.Type {
+	case DocumentNode:
+		for _, c := range n.Children {
+			w.node(c, 0, true)
+		}
+	case AttributeNode:
+		w.attr(n)
+		return strings.TrimPrefix(w.b.String(), " ")
+	default:
+		w.node(n, 0, true)
+	}
+	return w.b.String()
+}
+
+type writer struct {
+	b    strings.Builder
+	opts FormatOptions
+}
+
+func (w *writer) newline(depth int) {
+	w.b.WriteByte('\n')
+	w.b.WriteString(strings.Repeat(w.opts.Indent, depth))
+}
+
+// node writes n; verbatim disables layout for n and its descendants.
+func (w *writer) node(n *Node, depth int, verbatim bool) {
+	switch n.Type {
+	case TextNode:
+		w.b.WriteString(escapeText(n.Value))
+	case CDataNode:
+		w.b.WriteString("<![CDATA[" + n.Value + "]]>")
+	case CommentNode:
+		w.b.WriteString("<!--" + n.Value + "-->")
+	case ProcInstNode:
+		w.b.WriteString("<?" + n.Name.Local)
+		if n.Value != "" {
+			w.b.WriteString(" " + n.Value)
+		}
+		w.b.WriteString("?>")
+	case DoctypeNode:
+		w.b.WriteString(n.Value)
+	case ElementNode:
+		w.element(n, depth, verbatim)
+	}
+}
+
+func (w *writer) element(n *Node, depth int, verbatim bool) {
+	w.b.WriteString("<" + n.Name.String())
+	for _, a := range n.Attrs {
+		w.attr(a)
+	}
+	children := w.visible(n.Children)
+	if len(children) == 0 {
+		w.b.WriteString("/>")
+		return
+	}
+	w.b.WriteByte('>')
+
+	if space, ok := n.Attr(XMLNamespace, "space"); ok {
+		verbatim = space == "preserve"
+	}
+	preserve := verbatim || isMixed(children)
+	if !preserve {
+		children = dropBlank(children)
+	}
+	inline := true
+	for _, c := range children {
+		if c.Type != TextNode && c.Type != CDataNode {
+			inline = false
+		}
+	}
+	indent := !preserve && !inline && w.opts.Indent != ""
+	for _, c := range children {
+		if indent {
+			w.newline(depth + 1)
+		}
+		w.node(c, depth+1, preserve)
+	}
+	if indent {
+		w.newline(depth)
+	}
+	w.b.WriteString("</" + n.Name.String() + ">")
+}
+
+func (w *writer) visible(nodes []*Node) []*Node {
+	if !w.opts.StripComments {
+		return nodes
+	}
+	out := make([]*Node, 0, len(nodes))
+	for _, c := range nodes {
+		if c.Type != CommentNode {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func (w *writer) attr(a *Node) {
+	w.b.WriteString(" " + a.Name.String() + `="` + escapeAttr(a.Value) + `"`)
+}
+
+// isMixed reports whether an element's content mixes non-blank text with
+// other nodes, where whitespace is significant.
+func isMixed(children []*Node) bool {
+	text, other := false, false
+	for _, c := range children {
+		switch {
+		case c.Type == CDataNode, c.Type == TextNode && strings.TrimSpace(c.Value) != "":
+			text = true
+		case c.Type != TextNode:
+			other = true
+		}
+	}
+	return text && other
+}
+
+func dropBlank(children []*Node) []*Node {
+	out := make([]*Node, 0, len(children))
+	for _, c := range children {
+		if c.Type != TextNode || strings.TrimSpace(c.Value) != "" || len(children) == 1 {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+var (
+	textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#13;")
+	attrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", `"`, "&quot;", "\t", "&#9;", "\n", "&#10;", "\r", "&#13;")
+)
+
+func escapeText(s string) string { return textEscaper.Replace(s) }
+
+func escapeAttr(s string) string { return attrEscaper.Replace(s) }
@@ -0,0 +1,58 @@
+package xmldoc
+
+import "maps"
+
+// NamespaceDecl is one xmlns declaration and how often its binding is used
+// by element and attribute names in its scope.
+type NamespaceDecl struct {
+	Prefix  string // "" for a default namespace declaration
+	URI     string
+	Element string // the declaring element's path
+	Line    int
+	Uses    int
+}
+
+// Namespaces lists the document's namespace declarations in document order.
+// A declaration that is shadowed by a redeclaration is not credited with
+// uses in the inner scope.
+func Namespaces(doc *Node) []NamespaceDecl {
+	var out []NamespaceDecl
+	var walk func(n *Node, inScope map[string]int)
+	walk = func(n *Node, inScope map[string]int) {
+		scope, copied := inScope, false
+		for _, a := range n.Attrs {
+			if !a.IsNamespaceDecl() {
+				continue
+			}
+			if !copied {
+				scope, copied = maps.Clone(inScope), true
+				if scope == nil {
+					scope = map[string]int{}
+				}
+			}
+			prefix := ""
+			if a.Name.Prefix == "xmlns" {
+				prefix = a.Name.Local
+			}
+			scope[prefix] = len(out)
+			out = append(out, NamespaceDecl{Prefix: prefix, URI: a.Value, Element: Path(n), Line: a.Line})
+		}
+		if i, ok := scope[n.Name.Prefix]; ok && n.Name.Space != "" {
+			out[i].Uses++
+		}
+		for _, a := range n.Attrs {
+			if a.Name.Prefix != "" && !a.IsNamespaceDecl() {
+				if i, ok := scope[a.Name.Prefix]; ok {
+					out[i].Uses++
+				}
+			}
+		}
+		for _, c := range n.Children {
+			if c.Type == ElementNode {
+				walk(c, scope)
+			}
+		}
+	}
+	walk(doc.Root(), nil)
+	return out
+}
@@ -0,0 +1,539 @@
+// Package xmldoc parses XML into a tree that keeps everything a formatter
+// needs to write the document back faithfully — attribute order, comments,
+// CDATA sections, processing instructions and the DOCTYPE — and evaluates
+// XPath 1.0 expressions and XSD structure checks over it. encoding/xml
+// cannot be used for this: it folds CDATA into text and drops the order of
+// namespace declarations.
+package xmldoc
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// NodeType identifies the kind of a Node.
+type NodeType int
+
+const (
+	DocumentNode NodeType = iota
+	ElementNode
+	AttributeNode
+	TextNode
+	CDataNode
+	CommentNode
+	ProcInstNode
+	DoctypeNode
+)
+
+// XMLNamespace is bound to the xml prefix in every document.
+const XMLNamespace = "http://www.w3.org/XML/1998/namespace"
+
+// Name is a qualified name with its resolved namespace URI.
+type Name struct {
+	Prefix, Local, Space string
+}
+
+// String returns the name as written.
+func (n Name) String() string {
+	if n.Prefix == "" {
+		return n.Local
+	}
+	return n.Prefix + ":" + n.Local
+}
+
+// Node is a node of the document tree. Attributes, including namespace
+// declarations, are kept in Attrs in source order.
+type Node struct {
+	Type     NodeType
+	Name     Name   // elements and attributes; Local holds a PI's target
+	Value    string // decoded text, attribute value, comment, PI data or raw DOCTYPE
+	Attrs    []*Node
+	Children []*Node
+	Parent   *Node
+	Line     int
+	Column   int
+
+	order int // position in document order
+}
+
+// IsNamespaceDecl reports whether an attribute is an xmlns declaration.
+func (n *Node) IsNamespaceDecl() bool {
+	return n.Type == AttributeNode && (n.Name.Prefix == "" && n.Name.Local == "xmlns" || n.Name.Prefix == "xmlns")
+}
+
+// Root returns the document element.
+func (n *Node) Root() *Node {
+	for n.Parent != nil {
+		n = n.Parent
+	}
+	for _, c := range n.Children {
+		if c.Type == ElementNode {
+			return c
+		}
+	}
+	return nil
+}
+
+// Attr returns the value of the attribute with the given namespace and
+// local name.
+func (n *Node) Attr(space, local string) (string, bool) {
+	for _, a := range n.Attrs {
+		if a.Name.Local == local && a.Name.Space == space && !a.IsNamespaceDecl() {
+			return a.Value, true
+		}
+	}
+	return "", false
+}
+
+// Text returns the string value: the concatenated text of all descendant
+// text and CDATA nodes for documents and elements, the value otherwise.
+func (n *Node) Text() string {
+	if n.Type != ElementNode && n.Type != DocumentNode {
+		return n.Value
+	}
+	var b strings.Builder
+	var walk func(*Node)
+	walk = func(n *Node) {
+		for _, c := range n.Children {
+			switch c.Type {
+			case TextNode, CDataNode:
+				b.WriteString(c.Value)
+			case ElementNode:
+				walk(c)
+			}
+		}
+	}
+	walk(n)
+	return b.String()
+}
+
+// Error is a parse error at a 1-based line and column.
+type Error struct {
+	Line, Column int
+	Msg          string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Msg)
+}
+
+type parser struct {
+	src        string
+	pos        int
+	lineStarts []int
+	entities   map[string]string
+	order      int
+	depth      int
+}
+
+const maxDepth = 1000
+
+// Parse reads a complete XML document.
+func Parse(src string) (*Node, error) {
+	p := &parser{src: src, lineStarts: []int{0}, entities: map[string]string{
+		"lt": "<", "gt": ">", "amp": "&", "apos": "'", "quot": `"`,
+	}}
+	for i := 0; i < len(src); i++ {
+		if src[i] == '\n' {
+			p.lineStarts = append(p.lineStarts, i+1)
+		}
+	}
+	doc := &Node{Type: DocumentNode, Line: 1, Column: 1}
+	if strings.HasPrefix(src, "\uFEFF") {
+		p.pos = len("\uFEFF")
+	}
+	if err := p.content(doc, nil); err != nil {
+		return nil, err
+	}
+	if doc.Root() == nil {
+		return nil, p.errorf(len(src), "no root element")
+	}
+	return doc, nil
+}
+
+func (p *parser) position(off int) (line, col int) {
+	i, found := slices.BinarySearch(p.lineStarts, off)
+	if !found {
+		i--
+	}
+	return i + 1, utf8.RuneCountInString(p.src[p.lineStarts[i]:off]) + 1
+}
+
+func (p *parser) errorf(off int, format string, args ...any) *Error {
+	line, col := p.position(min(off, len(p.src)))
+	return &Error{Line: line, Column: col, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) newNode(t NodeType, parent *Node, off int) *Node {
+	n := &Node{Type: t, Parent: parent}
+	n.Line, n.Column = p.position(off)
+	p.order++
+	n.order = p.order
+	return n
+}
+
+// scope maps prefixes to namespace URIs; "" is the default namespace.
+type scope map[string]string
+
+func (s scope) resolve(prefix string) (string, bool) {
+	if prefix == "xml" {
+		return XMLNamespace, true
+	}
+	uri, ok := s[prefix]
+	return uri, ok || prefix == ""
+}
+
+// content parses children of parent until its end tag, or the end of input
+// for the document.
+func (p *parser) content(parent *Node, ns scope) error {
+	isDoc := parent.Type == DocumentNode
+	for p.pos < len(p.src) {
+		start := p.pos
+		rest := p.src[p.pos:]
+		switch {
+		case strings.HasPrefix(rest, "</"):
+			if isDoc {
+				return p.errorf(start, "unexpected end tag")
+			}
+			p.pos += 2
+			name := p.name()
+			p.skipSpace()
+			if !strings.HasPrefix(p.src[p.pos:], ">") {
+				return p.errorf(p.pos, "expected > in end tag")
+			}
+			p.pos++
+			if name != parent.Name.String() {
+				return p.errorf(start, "end tag </%s> does not match <%s> on line %d", name, parent.Name, parent.Line)
+			}
+			return nil
+		case strings.HasPrefix(rest, "<!--"):
+			end := strings.Index(rest[4:], "-->")
+			if end < 0 {
+				return p.errorf(start, "unterminated comment")
+			}
+			c := p.newNode(CommentNode, parent, start)
+			c.Value = rest[4 : 4+end]
+			if strings.Contains(c.Value, "--") {
+				return p.errorf(start, `"--" is not allowed inside a comment`)
+			}
+			parent.Children = append(parent.Children, c)
+			p.pos += end + 7
+		case strings.HasPrefix(rest, "<![CDATA["):
+			if isDoc {
+				return p.errorf(start, "CDATA section outside the root element")
+			}
+			end := strings.Index(rest, "]]>")
+			if end < 0 {
+				return p.errorf(start, "unterminated CDATA section")
+			}
+			c := p.newNode(CDataNode, parent, start)
+			c.Value = rest[9:end]
+			parent.Children = append(parent.Children, c)
+			p.pos += end + 3
+		case strings.HasPrefix(rest, "<!DOCTYPE"):
+			if !isDoc || parent.Root() != nil {
+				return p.errorf(start, "DOCTYPE must come before the root element")
+			}
+			if err := p.doctype(parent); err != nil {
+				return err
+			}
+		case strings.HasPrefix(rest, "<?"):
+			if err := p.procInst(parent); err != nil {
+				return err
+			}
+		case strings.HasPrefix(rest, "<"):
+			if isDoc && parent.Root() != nil {
+				return p.errorf(start, "only one root element is allowed")
+			}
+			if err := p.element(parent, ns); err != nil {
+				return err
+			}
+		default:
+			end := strings.IndexByte(rest, '<')
+			if end < 0 {
+				end = len(rest)
+			}
+			raw := rest[:end]
+			if isDoc {
+				if strings.TrimSpace(raw) != "" {
+					return p.errorf(start+len(raw)-len(strings.TrimLeft(raw, " \t\r\n")), "text outside the root element")
+				}
+				p.pos += end
+				continue
+			}
+			if i := strings.Index(raw, "]]>"); i >= 0 {
+				return p.errorf(start+i, `"]]>" is not allowed in text`)
+			}
+			text, err := p.decode(raw, start)
+			if err != nil {
+				return err
+			}
+			t := p.newNode(TextNode, parent, start)
+			t.Value = strings.ReplaceAll(text, "\r\n", "\n")
+			parent.Children = append(parent.Children, t)
+			p.pos += end
+		}
+	}
+	if !isDoc {
+		return p.errorf(len(p.src), "element <%s> on line %d is not closed", parent.Name, parent.Line)
+	}
+	return nil
+}
+
+func (p *parser) element(parent *Node, outer scope) error {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > maxDepth {
+		return p.errorf(p.pos, "elements nested more than %d deep", maxDepth)
+	}
+	start := p.pos
+	p.pos++
+	qname := p.name()
+	if qname == "" {
+		return p.errorf(p.pos, "expected element name")
+	}
+	el := p.newNode(ElementNode, parent, start)
+	el.Name = splitName(qname)
+
+	var ns scope
+	selfClosing := false
+	for {
+		hadSpace := p.skipSpace()
+		if p.pos >= len(p.src) {
+			return p.errorf(start, "unterminated start tag <%s>", qname)
+		}
+		if strings.HasPrefix(p.src[p.pos:], "/>") {
+			p.pos += 2
+			selfClosing = true
+			break
+		}
+		if p.src[p.pos] == '>' {
+			p.pos++
+			break
+		}
+		if !hadSpace {
+			return p.errorf(p.pos, "expected whitespace before attribute")
+		}
+		attrStart := p.pos
+		aname := p.name()
+		if aname == "" {
+			return p.errorf(p.pos, "unexpected %q in start tag", p.src[p.pos])
+		}
+		p.skipSpace()
+		if p.pos >= len(p.src) || p.src[p.pos] != '=' {
+			return p.errorf(p.pos, "expected = after attribute %s", aname)
+		}
+		p.pos++
+		p.skipSpace()
+		if p.pos >= len(p.src) || (p.src[p.pos] != '"' && p.src[p.pos] != '\'') {
+			return p.errorf(p.pos, "attribute value must be quoted")
+		}
+		q := p.src[p.pos]
+		end := strings.IndexByte(p.src[p.pos+1:], q)
+		if end < 0 {
+			return p.errorf(p.pos, "unterminated attribute value")
+		}
+		raw := p.src[p.pos+1 : p.pos+1+end]
+		if i := strings.IndexByte(raw, '<'); i >= 0 {
+			return p.errorf(p.pos+1+i, `"<" is not allowed in attribute values`)
+		}
+		// Attribute value normalization turns literal whitespace into spaces;
+		// character references survive it.
+		value, err := p.decode(strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ", "\t", " ").Replace(raw), p.pos+1)
+		if err != nil {
+			return err
+		}
+		p.pos += end + 2
+		a := p.newNode(AttributeNode, el, attrStart)
+		a.Name, a.Value = splitName(aname), value
+		for _, prev := range el.Attrs {
+			if prev.Name.String() == aname {
+				return p.errorf(attrStart, "duplicate attribute %s", aname)
+			}
+		}
+		el.Attrs = append(el.Attrs, a)
+		if a.IsNamespaceDecl() {
+			if ns == nil {
+				ns = scope{}
+				for k, v := range outer {
+					ns[k] = v
+				}
+			}
+			prefix := ""
+			if a.Name.Prefix == "xmlns" {
+				prefix = a.Name.Local
+				if value == "" {
+					return p.errorf(attrStart, "prefix %s cannot be bound to an empty namespace", prefix)
+				}
+			}
+			ns[prefix] = value
+		}
+	}
+	if ns == nil {
+		ns = outer
+	}
+
+	var ok bool
+	if el.Name.Space, ok = ns.resolve(el.Name.Prefix); !ok {
+		return p.errorf(start+1, "undeclared namespace prefix %q", el.Name.Prefix)
+	}
+	for _, a := range el.Attrs {
+		switch {
+		case a.IsNamespaceDecl():
+			a.Name.Space = "http://www.w3.org/2000/xmlns/"
+		case a.Name.Prefix != "":
+			if a.Name.Space, ok = ns.resolve(a.Name.Prefix); !ok {
+				line, col := a.Line, a.Column
+				return &Error{Line: line, Column: col, Msg: fmt.Sprintf("undeclared namespace prefix %q", a.Name.Prefix)}
+			}
+		}
+	}
+	parent.Children = append(parent.Children, el)
+	if selfClosing {
+		return nil
+	}
+	return p.content(el, ns)
+}
+
+func (p *parser) procInst(parent *Node) error {
+	start := p.pos
+	end := strings.Index(p.src[p.pos:], "?>")
+	if end < 0 {
+		return p.errorf(start, "unterminated processing instruction")
+	}
+	body := p.src[p.pos+2 : p.pos+end]
+	p.pos += end + 2
+	target, data, _ := strings.Cut(body, " ")
+	if i := strings.IndexAny(body, " \t\r\n"); i >= 0 {
+		target, data = body[:i], strings.TrimLeft(body[i:], " \t\r\n")
+	}
+	if target == "" {
+		return p.errorf(start, "processing instruction without a target")
+	}
+	if strings.EqualFold(target, "xml") && (parent.Type != DocumentNode || len(parent.Children) > 0 || start > 3) {
+		return p.errorf(start, "the XML declaration must come first")
+	}
+	pi := p.newNode(ProcInstNode, parent, start)
+	pi.Name.Local, pi.Value = target, data
+	parent.Children = append(parent.Children, pi)
+	return nil
+}
+
+var entityDeclRe = regexp.MustCompile(`<!ENTITY\s+([\p{L}_][\p{L}\p{N}._-]*)\s+(?:"([^"]*)"|'([^']*)')\s*>`)
+
+// doctype keeps the declaration verbatim and picks up internal entities
+// with literal values.
+func (p *parser) doctype(doc *Node) error {
+	start := p.pos
+	depth, quote := 0, byte(0)
+	for i := p.pos; i < len(p.src); i++ {
+		c := p.src[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+		case c == '>' && depth == 0:
+			d := p.newNode(DoctypeNode, doc, start)
+			d.Value = p.src[start : i+1]
+			doc.Children = append(doc.Children, d)
+			for _, m := range entityDeclRe.FindAllStringSubmatch(d.Value, -1) {
+				if _, predefined := p.entities[m[1]]; !predefined {
+					p.entities[m[1]] = m[2] + m[3]
+				}
+			}
+			p.pos = i + 1
+			return nil
+		}
+	}
+	return p.errorf(start, "unterminated DOCTYPE")
+}
+
+// decode expands entity and character references in raw, which starts at
+// offset off.
+func (p *parser) decode(raw string, off int) (string, error) {
+	if !strings.Contains(raw, "&") {
+		return raw, nil
+	}
+	var b strings.Builder
+	for i := 0; i < len(raw); i++ {
+		if raw[i] != '&' {
+			b.WriteByte(raw[i])
+			continue
+		}
+		end := strings.IndexByte(raw[i:], ';')
+		if end < 0 {
+			return "", p.errorf(off+i, "unterminated entity reference")
+		}
+		ref := raw[i+1 : i+end]
+		switch {
+		case strings.HasPrefix(ref, "#x"), strings.HasPrefix(ref, "#"):
+			base, digits := 10, ref[1:]
+			if strings.HasPrefix(ref, "#x") {
+				base, digits = 16, ref[2:]
+			}
+			n, err := strconv.ParseUint(digits, base, 32)
+			if err != nil || !isXMLChar(rune(n)) {
+				return "", p.errorf(off+i, "invalid character reference &%s;", ref)
+			}
+			b.WriteRune(rune(n))
+		default:
+			v, ok := p.entities[ref]
+			if !ok {
+				return "", p.errorf(off+i, "undefined entity &%s;", ref)
+			}
+			b.WriteString(v)
+		}
+		i += end
+	}
+	return b.String(), nil
+}
+
+func isXMLChar(r rune) bool {
+	return r == 0x9 || r == 0xA || r == 0xD || r >= 0x20 && r <= 0xD7FF || r >= 0xE000 && r <= 0xFFFD || r >= 0x10000 && r <= 0x10FFFF
+}
+
+func (p *parser) skipSpace() bool {
+	start := p.pos
+	for p.pos < len(p.src) && strings.IndexByte(" \t\r\n", p.src[p.pos]) >= 0 {
+		p.pos++
+	}
+	return p.pos > start
+}
+
+func (p *parser) name() string {
+	start := p.pos
+	for p.pos < len(p.src) {
+		r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+		if !isNameChar(r, p.pos == start) {
+			break
+		}
+		p.pos += size
+	}
+	return p.src[start:p.pos]
+}
+
+func isNameChar(r rune, first bool) bool {
+	if unicode.IsLetter(r) || r == '_' || r == ':' {
+		return true
+	}
+	return !first && (unicode.IsDigit(r) || r == '-' || r == '.' || r == 0xB7 || unicode.Is(unicode.Mn, r))
+}
+
+func splitName(qname string) Name {
+	if prefix, local, ok := strings.Cut(qname, ":"); ok {
+		return Name{Prefix: prefix, Local: local}
+	}
+	return Name{Local: qname}
+}
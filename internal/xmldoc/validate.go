@@ -0,0 +1,360 @@
+package xmldoc
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Issue is a validation failure at a node of the instance document.
+type Issue struct {
+	Line, Column int
+	Path         string
+	Msg          string
+}
+
+// Report is the outcome of Validate.
+type Report struct {
+	// Roots lists the elements validated against a global declaration.
+	Roots  []string
+	Issues []Issue
+}
+
+const maxIssues = 200
+
+// Validate checks doc against the schema. When the document element has
+// no global declaration, as with a SOAP envelope around a payload, the
+// outermost descendants that do are validated instead.
+func (s *Schema) Validate(doc *Node) Report {
+	v := &validator{s: s}
+	var walk func(n *Node)
+	walk = func(n *Node) {
+		if d := s.global(n.Name.Space, n.Name.Local); d != nil {
+			v.report.Roots = append(v.report.Roots, Path(n))
+			v.element(n, d)
+			return
+		}
+		for _, c := range n.Children {
+			if c.Type == ElementNode {
+				walk(c)
+			}
+		}
+	}
+	walk(doc.Root())
+	if len(v.report.Roots) == 0 {
+		root := doc.Root()
+		var names []string
+		for _, name := range sortedKeys(s.elementNodes) {
+			names = append(names, fmt.Sprintf("{%s}%s", s.tns, name))
+		}
+		v.issue(root, "no element of the document is declared by the schema; it declares %s", strings.Join(names, ", "))
+	}
+	return v.report
+}
+
+func (s *Schema) global(space, local string) *elementDecl {
+	if n, ok := s.elementNodes[local]; ok && space == s.tns {
+		return s.globalElement(n)
+	}
+	return nil
+}
+
+type validator struct {
+	s      *Schema
+	report Report
+}
+
+func (v *validator) issue(n *Node, format string, args ...any) {
+	if len(v.report.Issues) >= maxIssues {
+		return
+	}
+	v.report.Issues = append(v.report.Issues, Issue{Line: n.Line, Column: n.Column, Path: Path(n), Msg: fmt.Sprintf(format, args...)})
+}
+
+func elementName(space, local string) string {
+	if space == "" {
+		return "<" + local + ">"
+	}
+	return "<{" + space + "}" + local + ">"
+}
+
+func (v *validator) element(n *Node, d *elementDecl) {
+	t := d.typ
+	if name, ok := n.Attr(XSINamespace, "type"); ok {
+		_, local, _ := strings.Cut(name, ":")
+		if local == "" {
+			local = name
+		}
+		if c, found := v.s.complexNodes[local]; found {
+			t = v.s.complexType(c)
+		} else if st, found := v.s.simpleNodes[local]; found {
+			t = &complexType{name: local, simple: v.s.simpleType(st)}
+		} else {
+			v.issue(n, "xsi:type %s is not defined in the schema", name)
+			return
+		}
+	}
+	if t == nil || t.any {
+		return
+	}
+	if nilled, _ := n.Attr(XSINamespace, "nil"); nilled == "true" || nilled == "1" {
+		if !d.nillable {
+			v.issue(n, "element is not nillable")
+		} else if len(elementChildren(n)) > 0 || strings.TrimSpace(n.Text()) != "" {
+			v.issue(n, "a nil element must be empty")
+		}
+		v.attributes(n, t)
+		return
+	}
+	v.attributes(n, t)
+
+	kids := elementChildren(n)
+	if t.simple != nil {
+		if len(kids) > 0 {
+			v.issue(kids[0], "element %s has simple content; child elements are not allowed", n.Name)
+			return
+		}
+		text := n.Text()
+		if d.fixed != nil && text == "" {
+			text = *d.fixed
+		}
+		if msg := t.simple.check(text); msg != "" {
+			v.issue(n, "%s", msg)
+		} else if d.fixed != nil && strings.TrimSpace(text) != strings.TrimSpace(*d.fixed) {
+			v.issue(n, "value must be %q", *d.fixed)
+		}
+		return
+	}
+	if !t.mixed {
+		for _, c := range n.Children {
+			if c.Type == CDataNode || c.Type == TextNode && strings.TrimSpace(c.Value) != "" {
+				v.issue(c, "text is not allowed in element %s, which has element-only content", n.Name)
+				break
+			}
+		}
+	}
+	if t.content == nil {
+		if len(kids) > 0 {
+			v.issue(kids[0], "unexpected element %s; %s must be empty", kids[0].Name, n.Name)
+		}
+		return
+	}
+
+	m := &matcher{kids: kids, assigned: make([]*particle, len(kids)), far: -1}
+	end, ok := m.repeat(t.content, 0)
+	switch {
+	case !ok || end < len(kids) && m.far >= end:
+		pos := max(m.far, end)
+		if pos >= len(kids) {
+			v.issue(n, "element %s is incomplete; expected %s", n.Name, strings.Join(m.expected, " or "))
+			break
+		}
+		msg := fmt.Sprintf("unexpected element %s", kids[pos].Name)
+		if pos == m.far && len(m.expected) > 0 {
+			msg += "; expected " + strings.Join(m.expected, " or ")
+		}
+		v.issue(kids[pos], "%s", msg)
+	case end < len(kids):
+		v.issue(kids[end], "unexpected element %s", kids[end].Name)
+	}
+	for i, kid := range kids {
+		p := m.assigned[i]
+		switch {
+		case p == nil:
+		case p.kind == "element":
+			v.element(kid, p.elem)
+		case !p.lax:
+			if g := v.s.global(kid.Name.Space, kid.Name.Local); g != nil {
+				v.element(kid, g)
+			} else {
+				v.issue(kid, "element %s matches a strict wildcard but is not declared", kid.Name)
+			}
+		default:
+			if g := v.s.global(kid.Name.Space, kid.Name.Local); g != nil {
+				v.element(kid, g)
+			}
+		}
+	}
+}
+
+func elementChildren(n *Node) []*Node {
+	var out []*Node
+	for _, c := range n.Children {
+		if c.Type == ElementNode {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func (v *validator) attributes(n *Node, t *complexType) {
+	seen := map[*attrDecl]bool{}
+	for _, a := range n.Attrs {
+		if a.IsNamespaceDecl() || a.Name.Space == XSINamespace {
+			continue
+		}
+		i := slices.IndexFunc(t.attrs, func(d *attrDecl) bool { return d.name == a.Name.Local && d.space == a.Name.Space })
+		if i < 0 {
+			if !t.anyAttrs && a.Name.Space != XMLNamespace {
+				v.issue(a, "attribute %s is not allowed on %s", a.Name, n.Name)
+			}
+			continue
+		}
+		d := t.attrs[i]
+		seen[d] = true
+		if msg := d.typ.check(a.Value); msg != "" {
+			v.issue(a, "attribute %s: %s", a.Name, msg)
+		} else if d.fixed != nil && strings.TrimSpace(a.Value) != strings.TrimSpace(*d.fixed) {
+			v.issue(a, "attribute %s must be %q", a.Name, *d.fixed)
+		}
+	}
+	for _, d := range t.attrs {
+		if d.required && !seen[d] {
+			name := d.name
+			if d.space != "" {
+				name = "{" + d.space + "}" + name
+			}
+			v.issue(n, "missing required attribute %s", name)
+		}
+	}
+}
+
+// matcher assigns child elements to particles greedily. XSD requires
+// content models to be deterministic (Unique Particle Attribution), which
+// makes a greedy match sufficient for schemas that satisfy it. The
+// furthest position a match failed at, with the names expected there,
+// drives the error message.
+type matcher struct {
+	kids     []*Node
+	assigned []*particle
+	far      int
+	expected []string
+}
+
+// repeat matches p between its minimum and maximum number of times from
+// position i.
+func (m *matcher) repeat(p *particle, i int) (int, bool) {
+	count, j := 0, i
+	for p.max < 0 || count < p.max {
+		k, ok := m.once(p, j)
+		if !ok || k == j {
+			if ok {
+				count = max(count, p.min) // an empty match satisfies any minimum
+			}
+			break
+		}
+		j = k
+		count++
+	}
+	if count < p.min {
+		return i, false
+	}
+	return j, true
+}
+
+func (m *matcher) once(p *particle, i int) (int, bool) {
+	switch p.kind {
+	case "element", "any":
+		if i < len(m.kids) && p.accepts(m.kids[i]) {
+			m.assigned[i] = p
+			return i + 1, true
+		}
+		m.fail(i, p)
+		return i, false
+	case "sequence":
+		j := i
+		for _, c := range p.children {
+			k, ok := m.repeat(c, j)
+			if !ok {
+				return i, false
+			}
+			j = k
+		}
+		return j, true
+	case "choice":
+		emptyOK := len(p.children) == 0
+		for _, c := range p.children {
+			k, ok := m.repeat(c, i)
+			if ok && k > i {
+				return k, true
+			}
+			emptyOK = emptyOK || ok
+		}
+		return i, emptyOK
+	case "all":
+		used := make([]bool, len(p.children))
+		j := i
+		for progress := true; progress && j < len(m.kids); {
+			progress = false
+			for ci, c := range p.children {
+				if !used[ci] && c.accepts(m.kids[j]) {
+					m.assigned[j] = c
+					used[ci], progress = true, true
+					j++
+					break
+				}
+			}
+		}
+		for ci, c := range p.children {
+			if !used[ci] && c.min > 0 {
+				m.fail(j, c)
+				return i, false
+			}
+		}
+		return j, true
+	}
+	return i, false
+}
+
+func (m *matcher) fail(i int, p *particle) {
+	if i < m.far {
+		return
+	}
+	if i > m.far {
+		m.far, m.expected = i, nil
+	}
+	var name string
+	switch {
+	case p.kind == "element":
+		name = elementName(p.elem.space, p.elem.name)
+	case p.anyNS == "##any":
+		name = "any element"
+	case p.anyNS == "##other":
+		name = "an element from another namespace"
+	default:
+		name = "an element from " + p.anyNS
+	}
+	if !slices.Contains(m.expected, name) {
+		m.expected = append(m.expected, name)
+	}
+}
+
+// accepts reports whether an element or wildcard particle matches n.
+func (p *particle) accepts(n *Node) bool {
+	if p.kind == "element" {
+		return n.Name.Local == p.elem.name && n.Name.Space == p.elem.space
+	}
+	if p.kind != "any" {
+		return false
+	}
+	switch p.anyNS {
+	case "##any":
+		return true
+	case "##local":
+		return n.Name.Space == ""
+	case "##other":
+		return n.Name.Space != "" && n.Name.Space != p.tns
+	}
+	for _, ns := range strings.Fields(p.anyNS) {
+		switch ns {
+		case "##local":
+			ns = ""
+		case "##targetNamespace":
+			ns = p.tns
+		}
+		if ns == n.Name.Space {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package xmldoc
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const soapSample = `<?xml version="1.0" encoding="UTF-8"?>
+<!-- request -->
+<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:m="urn:shop" xmlns:unused="urn:x">
+  <soap:Body>
+    <m:Order id="7" z="1" a="2">
+      <m:Item sku="A1" qty="2">Pen &amp; ink</m:Item>
+      <m:Item sku="B2" qty="1"><![CDATA[<b>bold</b>]]></m:Item>
+      <m:Note xml:space="preserve">  keep   this  </m:Note>
+      <p xmlns="urn:html">Hello <b>world</b>!</p>
+    </m:Order>
+  </soap:Body>
+</soap:Envelope>`
+
+func TestFormat(t *testing.T) {
+	doc, err := Parse(soapSample)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `<?xml version="1.0" encoding="UTF-8"?>
+<!-- request -->
+<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:m="urn:shop" xmlns:unused="urn:x">
+	<soap:Body>
+		<m:Order id="7" z="1" a="2">
+			<m:Item sku="A1" qty="2">Pen &amp; ink</m:Item>
+			<m:Item sku="B2" qty="1"><![CDATA[<b>bold</b>]]></m:Item>
+			<m:Note xml:space="preserve">  keep   this  </m:Note>
+			<p xmlns="urn:html">Hello <b>world</b>!</p>
+		</m:Order>
+	</soap:Body>
+</soap:Envelope>
+`
+	if got := Format(doc, FormatOptions{Indent: "\t"}); got != want {
+		t.Errorf("Format:\n%s\nwant:\n%s", got, want)
+	}
+
+	min := Format(doc, FormatOptions{StripComments: true})
+	if strings.Contains(min, "request") || strings.Contains(min, "\n") || !strings.Contains(min, "<soap:Body><m:Order") {
+		t.Errorf("minified: %s", min)
+	}
+	// Minified output parses back to the same formatted document.
+	again, err := Parse(min)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := Format(again, FormatOptions{Indent: "\t"}); got != strings.Replace(want, "<!-- request -->\n", "", 1) {
+		t.Errorf("round trip:\n%s", got)
+	}
+
+	doc, _ = Parse("<a t=\"x&#10;y\"><b/>\n<!--c-->\n</a>")
+	if got := Format(doc, FormatOptions{Indent: "  "}); got != "<a t=\"x&#10;y\">\n  <b/>\n  <!--c-->\n</a>\n" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		src       string
+		line, col int
+		msg       string
+	}{
+		{"<a><b></a>", 1, 7, "end tag </a> does not match <b>"},
+		{"<a>\n  <b x='1' x='2'/></a>", 2, 12, "duplicate attribute x"},
+		{"<a>&nbsp;</a>", 1, 4, "undefined entity &nbsp;"},
+		{"<p:a/>", 1, 2, `undeclared namespace prefix "p"`},
+		{"<a/><b/>", 1, 5, "only one root element"},
+		{"<a>", 1, 4, "element <a> on line 1 is not closed"},
+		{"<a x=1/>", 1, 6, "attribute value must be quoted"},
+		{"", 1, 1, "no root element"},
+	}
+	for _, tt := range tests {
+		_, err := Parse(tt.src)
+		e, ok := err.(*Error)
+		if !ok {
+			t.Errorf("%q: got %v", tt.src, err)
+			continue
+		}
+		if e.Line != tt.line || e.Column != tt.col || !strings.Contains(e.Msg, tt.msg) {
+			t.Errorf("%q: got %d:%d %s, want %d:%d %s", tt.src, e.Line, e.Column, e.Msg, tt.line, tt.col, tt.msg)
+		}
+	}
+
+	doc, err := Parse(`<!DOCTYPE a [<!ENTITY co "ACME">]><a>&co; &#x263A;</a>`)
+	if err != nil || doc.Root().Text() != "ACME ☺" {
+		t.Errorf("internal entity: %v", err)
+	}
+}
+
+func TestNamespaces(t *testing.T) {
+	doc, err := Parse(soapSample)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := Namespaces(doc)
+	want := []NamespaceDecl{
+		{Prefix: "soap", URI: "http://schemas.xmlsoap.org/soap/envelope/", Element: "/soap:Envelope", Line: 3, Uses: 2},
+		{Prefix: "m", URI: "urn:shop", Element: "/soap:Envelope", Line: 3, Uses: 4},
+		{Prefix: "unused", URI: "urn:x", Element: "/soap:Envelope", Line: 3, Uses: 0},
+		{Prefix: "", URI: "urn:html", Element: "/soap:Envelope/soap:Body/m:Order/p", Line: 9, Uses: 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got  %+v\nwant %+v", got, want)
+	}
+}
@@ -0,0 +1,970 @@
+package xmldoc
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ExprError is an XPath failure tied to a position in the expression.
+type ExprError struct {
+	Pos int // 0-based byte offset into the expression
+	Msg string
+}
+
+func (e *ExprError) Error() string {
+	return fmt.Sprintf("%s (at position %d)", e.Msg, e.Pos+1)
+}
+
+func exprErrorf(pos int, format string, args ...any) *ExprError {
+	return &ExprError{Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// Evaluate runs an XPath 1.0 expression with the document node as context.
+// The result is a node-set ([]*Node in document order), string, float64 or
+// bool. Prefixes resolve through bindings first and then through the
+// document's own declarations; as XPath 1.0 requires, unprefixed names only
+// match elements in no namespace, so elements in a default namespace need a
+// bound prefix. Variables are not supported.
+func Evaluate(doc *Node, expr string, bindings map[string]string) (any, error) {
+	ns := map[string]string{"xml": XMLNamespace}
+	for _, d := range Namespaces(doc) {
+		if _, ok := ns[d.Prefix]; !ok && d.Prefix != "" {
+			ns[d.Prefix] = d.URI
+		}
+	}
+	for k, v := range bindings {
+		ns[k] = v
+	}
+	toks, err := lexXPath(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &xpParser{toks: toks, ns: ns}
+	e, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tkEOF {
+		return nil, exprErrorf(t.pos, "unexpected %q", t.val)
+	}
+	return e.eval(&xpContext{node: doc, pos: 1, size: 1})
+}
+
+// ── Lexer ────────────────────────────────────────────────────────────────────
+
+type tokKind int
+
+const (
+	tkEOF tokKind = iota
+	tkNum
+	tkStr
+	tkName // QName, "*" or "prefix:*" as a name test
+	tkOp
+)
+
+type token struct {
+	kind tokKind
+	val  string
+	num  float64
+	pos  int
+}
+
+var xpOperators = map[string]bool{
+	"and": true, "or": true, "mod": true, "div": true, "*": true, "/": true, "//": true, "|": true,
+	"+": true, "-": true, "=": true, "!=": true, "<": true, "<=": true, ">": true, ">=": true,
+}
+
+func lexXPath(src string) ([]token, error) {
+	var toks []token
+	// An operator reading applies unless the previous token begins an
+	// operand position (XPath 1.0, section 3.7).
+	operatorPos := func() bool {
+		if len(toks) == 0 {
+			return false
+		}
+		prev := toks[len(toks)-1]
+		if prev.kind != tkOp {
+			return true
+		}
+		return !xpOperators[prev.val] && !slices.Contains([]string{"@", "::", "(", "[", ","}, prev.val)
+	}
+	for i := 0; i < len(src); {
+		c := src[i]
+		start := i
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+			continue
+		case c == '"' || c == '\'':
+			end := strings.IndexByte(src[i+1:], c)
+			if end < 0 {
+				return nil, exprErrorf(i, "unterminated string literal")
+			}
+			toks = append(toks, token{kind: tkStr, val: src[i+1 : i+1+end], pos: start})
+			i += end + 2
+			continue
+		case c >= '0' && c <= '9' || c == '.' && i+1 < len(src) && src[i+1] >= '0' && src[i+1] <= '9':
+			for i < len(src) && (src[i] >= '0' && src[i] <= '9' || src[i] == '.') {
+				i++
+			}
+			f, err := strconv.ParseFloat(src[start:i], 64)
+			if err != nil {
+				return nil, exprErrorf(start, "invalid number %q", src[start:i])
+			}
+			toks = append(toks, token{kind: tkNum, val: src[start:i], num: f, pos: start})
+			continue
+		case c == '$':
+			return nil, exprErrorf(i, "variables are not supported")
+		case c == '*':
+			i++
+			if operatorPos() {
+				toks = append(toks, token{kind: tkOp, val: "*", pos: start})
+			} else {
+				toks = append(toks, token{kind: tkName, val: "*", pos: start})
+			}
+			continue
+		}
+		for _, op := range []string{"//", "::", "..", "!=", "<=", ">=", "/", "(", ")", "[", "]", ".", "@", ",", "|", "+", "-", "=", "<", ">"} {
+			if strings.HasPrefix(src[i:], op) {
+				toks = append(toks, token{kind: tkOp, val: op, pos: start})
+				i += len(op)
+				break
+			}
+		}
+		if i > start {
+			continue
+		}
+		name := scanNCName(src[i:])
+		if name == "" {
+			r, _ := utf8.DecodeRuneInString(src[i:])
+			return nil, exprErrorf(i, "unexpected character %q", r)
+		}
+		i += len(name)
+		if operatorPos() {
+			if !slices.Contains([]string{"and", "or", "mod", "div"}, name) {
+				return nil, exprErrorf(start, "expected an operator, found %q", name)
+			}
+			toks = append(toks, token{kind: tkOp, val: name, pos: start})
+			continue
+		}
+		if strings.HasPrefix(src[i:], ":") && !strings.HasPrefix(src[i:], "::") {
+			if strings.HasPrefix(src[i+1:], "*") {
+				name += ":*"
+				i += 2
+			} else if local := scanNCName(src[i+1:]); local != "" {
+				name += ":" + local
+				i += 1 + len(local)
+			}
+		}
+		toks = append(toks, token{kind: tkName, val: name, pos: start})
+	}
+	return append(toks, token{kind: tkEOF, pos: len(src)}), nil
+}
+
+func scanNCName(s string) string {
+	i := 0
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == ':' || !isNameChar(r, i == 0) {
+			break
+		}
+		i += size
+	}
+	return s[:i]
+}
+
+// ── Parser ───────────────────────────────────────────────────────────────────
+
+type xpExpr interface {
+	eval(*xpContext) (any, error)
+}
+
+type xpContext struct {
+	node      *Node
+	pos, size int
+}
+
+type xpParser struct {
+	toks []token
+	i    int
+	ns   map[string]string
+}
+
+func (p *xpParser) peek() token { return p.toks[p.i] }
+
+func (p *xpParser) peekAt(n int) token { return p.toks[min(p.i+n, len(p.toks)-1)] }
+
+func (p *xpParser) next() token {
+	t := p.toks[p.i]
+	if t.kind != tkEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *xpParser) isOp(vals ...string) bool {
+	t := p.peek()
+	return t.kind == tkOp && slices.Contains(vals, t.val)
+}
+
+func (p *xpParser) expect(val string) error {
+	if !p.isOp(val) {
+		t := p.peek()
+		if t.kind == tkEOF {
+			return exprErrorf(t.pos, "expected %q, found end of expression", val)
+		}
+		return exprErrorf(t.pos, "expected %q, found %q", val, t.val)
+	}
+	p.next()
+	return nil
+}
+
+func (p *xpParser) expr() (xpExpr, error) {
+	return p.binary(0)
+}
+
+var xpPrecedence = [][]string{{"or"}, {"and"}, {"=", "!="}, {"<", "<=", ">", ">="}, {"+", "-"}, {"*", "div", "mod"}}
+
+func (p *xpParser) binary(level int) (xpExpr, error) {
+	if level == len(xpPrecedence) {
+		return p.unary()
+	}
+	l, err := p.binary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp(xpPrecedence[level]...) {
+		op := p.next().val
+		r, err := p.binary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		l = &binaryExpr{op: op, l: l, r: r}
+	}
+	return l, nil
+}
+
+func (p *xpParser) unary() (xpExpr, error) {
+	if p.isOp("-") {
+		p.next()
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return &negExpr{x}, nil
+	}
+	l, err := p.path()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("|") {
+		pos := p.next().pos
+		r, err := p.path()
+		if err != nil {
+			return nil, err
+		}
+		l = &unionExpr{l: l, r: r, pos: pos}
+	}
+	return l, nil
+}
+
+var nodeTypes = []string{"comment", "text", "processing-instruction", "node"}
+
+func (p *xpParser) path() (xpExpr, error) {
+	t := p.peek()
+	switch {
+	case p.isOp("/"):
+		p.next()
+		path := &pathExpr{absolute: true}
+		if p.startsStep() {
+			return path, p.steps(path)
+		}
+		return path, nil
+	case p.isOp("//"):
+		p.next()
+		path := &pathExpr{absolute: true, steps: []*step{descendantOrSelf()}}
+		return path, p.steps(path)
+	case t.kind == tkStr, t.kind == tkNum, p.isOp("("),
+		t.kind == tkName && p.peekAt(1).kind == tkOp && p.peekAt(1).val == "(" && !slices.Contains(nodeTypes, t.val):
+		prim, err := p.primary()
+		if err != nil {
+			return nil, err
+		}
+		var preds []xpExpr
+		for p.isOp("[") {
+			pred, err := p.predicate()
+			if err != nil {
+				return nil, err
+			}
+			preds = append(preds, pred)
+		}
+		var x xpExpr = prim
+		if len(preds) > 0 {
+			x = &filterExpr{primary: prim, preds: preds, pos: t.pos}
+		}
+		if !p.isOp("/", "//") {
+			return x, nil
+		}
+		path := &pathExpr{filter: x}
+		if p.next().val == "//" {
+			path.steps = append(path.steps, descendantOrSelf())
+		}
+		return path, p.steps(path)
+	}
+	if !p.startsStep() {
+		if t.kind == tkEOF {
+			return nil, exprErrorf(t.pos, "unexpected end of expression")
+		}
+		return nil, exprErrorf(t.pos, "unexpected %q", t.val)
+	}
+	path := &pathExpr{}
+	return path, p.steps(path)
+}
+
+func (p *xpParser) startsStep() bool {
+	return p.peek().kind == tkName || p.isOp(".", "..", "@")
+}
+
+// steps parses a relative location path onto path.
+func (p *xpParser) steps(path *pathExpr) error {
+	for {
+		s, err := p.step()
+		if err != nil {
+			return err
+		}
+		path.steps = append(path.steps, s)
+		switch {
+		case p.isOp("/"):
+			p.next()
+		case p.isOp("//"):
+			p.next()
+			path.steps = append(path.steps, descendantOrSelf())
+		default:
+			return nil
+		}
+	}
+}
+
+var axes = []string{
+	"ancestor", "ancestor-or-self", "attribute", "child", "descendant", "descendant-or-self",
+	"following", "following-sibling", "namespace", "parent", "preceding", "preceding-sibling", "self",
+}
+
+func descendantOrSelf() *step {
+	return &step{axis: "descendant-or-self", test: nodeTest{kind: "node"}}
+}
+
+func (p *xpParser) step() (*step, error) {
+	switch {
+	case p.isOp("."):
+		p.next()
+		return &step{axis: "self", test: nodeTest{kind: "node"}}, nil
+	case p.isOp(".."):
+		p.next()
+		return &step{axis: "parent", test: nodeTest{kind: "node"}}, nil
+	}
+	s := &step{axis: "child"}
+	if p.isOp("@") {
+		p.next()
+		s.axis = "attribute"
+	} else if t := p.peek(); t.kind == tkName && p.peekAt(1).kind == tkOp && p.peekAt(1).val == "::" {
+		if !slices.Contains(axes, t.val) {
+			return nil, exprErrorf(t.pos, "unknown axis %q", t.val)
+		}
+		if t.val == "namespace" {
+			return nil, exprErrorf(t.pos, "the namespace axis is not supported")
+		}
+		s.axis = t.val
+		p.next()
+		p.next()
+	}
+	t := p.next()
+	if t.kind != tkName {
+		if t.kind == tkEOF {
+			return nil, exprErrorf(t.pos, "expected a node test, found end of expression")
+		}
+		return nil, exprErrorf(t.pos, "expected a node test, found %q", t.val)
+	}
+	if slices.Contains(nodeTypes, t.val) && p.isOp("(") {
+		p.next()
+		s.test.kind = t.val
+		if t.val == "processing-instruction" && p.peek().kind == tkStr {
+			s.test.local = p.next().val
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+	} else {
+		s.test.kind = "name"
+		prefix, local, ok := strings.Cut(t.val, ":")
+		if !ok {
+			prefix, local = "", t.val
+		}
+		s.test.local = local
+		if prefix != "" {
+			uri, bound := p.ns[prefix]
+			if !bound {
+				return nil, exprErrorf(t.pos, "undeclared namespace prefix %q", prefix)
+			}
+			s.test.space = uri
+		}
+		s.test.anySpace = t.val == "*"
+	}
+	for p.isOp("[") {
+		pred, err := p.predicate()
+		if err != nil {
+			return nil, err
+		}
+		s.preds = append(s.preds, pred)
+	}
+	return s, nil
+}
+
+func (p *xpParser) predicate() (xpExpr, error) {
+	p.next()
+	e, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	return e, p.expect("]")
+}
+
+func (p *xpParser) primary() (xpExpr, error) {
+	t := p.next()
+	switch t.kind {
+	case tkStr:
+		return literalExpr{t.val}, nil
+	case tkNum:
+		return literalExpr{t.num}, nil
+	}
+	if t.val == "(" {
+		e, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		return e, p.expect(")")
+	}
+	fn, ok := xpFunctions[t.val]
+	if !ok {
+		return nil, exprErrorf(t.pos, "unknown function %s()", t.val)
+	}
+	p.next() // (
+	call := &callExpr{name: t.val, fn: fn, pos: t.pos}
+	for !p.isOp(")") {
+		if len(call.args) > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		arg, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		call.args = append(call.args, arg)
+	}
+	p.next()
+	if len(call.args) < fn.minArgs || fn.maxArgs >= 0 && len(call.args) > fn.maxArgs {
+		return nil, exprErrorf(t.pos, "wrong number of arguments to %s()", t.val)
+	}
+	return call, nil
+}
+
+// ── Evaluation ───────────────────────────────────────────────────────────────
+
+type literalExpr struct{ v any }
+
+func (e literalExpr) eval(*xpContext) (any, error) { return e.v, nil }
+
+type negExpr struct{ x xpExpr }
+
+func (e *negExpr) eval(ctx *xpContext) (any, error) {
+	v, err := e.x.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return -toNumber(v), nil
+}
+
+type unionExpr struct {
+	l, r xpExpr
+	pos  int
+}
+
+func (e *unionExpr) eval(ctx *xpContext) (any, error) {
+	l, err := e.l.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r, err := e.r.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ln, lok := l.([]*Node)
+	rn, rok := r.([]*Node)
+	if !lok || !rok {
+		return nil, exprErrorf(e.pos, "the operands of | must be node-sets")
+	}
+	return docOrder(append(slices.Clip(ln), rn...)), nil
+}
+
+type binaryExpr struct {
+	op   string
+	l, r xpExpr
+}
+
+func (e *binaryExpr) eval(ctx *xpContext) (any, error) {
+	l, err := e.l.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch e.op {
+	case "or", "and":
+		// Short-circuit: the right operand is not evaluated when the left
+		// one decides.
+		if toBool(l) == (e.op == "or") {
+			return e.op == "or", nil
+		}
+		r, err := e.r.eval(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return toBool(r), nil
+	}
+	r, err := e.r.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch e.op {
+	case "+":
+		return toNumber(l) + toNumber(r), nil
+	case "-":
+		return toNumber(l) - toNumber(r), nil
+	case "*":
+		return toNumber(l) * toNumber(r), nil
+	case "div":
+		return toNumber(l) / toNumber(r), nil
+	case "mod":
+		return math.Mod(toNumber(l), toNumber(r)), nil
+	}
+	return compare(e.op, l, r), nil
+}
+
+// compare implements the comparison rules of XPath 1.0, section 3.4: a
+// comparison involving node-sets holds if it holds for any member.
+func compare(op string, l, r any) bool {
+	if ln, ok := l.([]*Node); ok {
+		if _, rb := r.(bool); rb {
+			return compareAtoms(op, len(ln) > 0, r)
+		}
+		for _, n := range ln {
+			if compare(op, n.Text(), r) {
+				return true
+			}
+		}
+		return false
+	}
+	if rn, ok := r.([]*Node); ok {
+		if _, lb := l.(bool); lb {
+			return compareAtoms(op, l, len(rn) > 0)
+		}
+		for _, n := range rn {
+			if compare(op, l, n.Text()) {
+				return true
+			}
+		}
+		return false
+	}
+	return compareAtoms(op, l, r)
+}
+
+func compareAtoms(op string, l, r any) bool {
+	if op == "=" || op == "!=" {
+		_, lb := l.(bool)
+		_, rb := r.(bool)
+		_, lf := l.(float64)
+		_, rf := r.(float64)
+		var eq bool
+		switch {
+		case lb || rb:
+			eq = toBool(l) == toBool(r)
+		case lf || rf:
+			eq = toNumber(l) == toNumber(r)
+		default:
+			eq = toString(l) == toString(r)
+		}
+		return eq == (op == "=")
+	}
+	a, b := toNumber(l), toNumber(r)
+	switch op {
+	case "<":
+		return a < b
+	case "<=":
+		return a <= b
+	case ">":
+		return a > b
+	}
+	return a >= b
+}
+
+type filterExpr struct {
+	primary xpExpr
+	preds   []xpExpr
+	pos     int
+}
+
+func (e *filterExpr) eval(ctx *xpContext) (any, error) {
+	v, err := e.primary.eval(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nodes, ok := v.([]*Node)
+	if !ok {
+		return nil, exprErrorf(e.pos, "predicates can only filter node-sets")
+	}
+	for _, pred := range e.preds {
+		if nodes, err = applyPredicate(nodes, pred); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+type pathExpr struct {
+	filter   xpExpr // nil for location paths
+	absolute bool
+	steps    []*step
+}
+
+func (e *pathExpr) eval(ctx *xpContext) (any, error) {
+	var nodes []*Node
+	switch {
+	case e.filter != nil:
+		v, err := e.filter.eval(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var ok bool
+		if nodes, ok = v.([]*Node); !ok {
+			return nil, exprErrorf(0, "a path can only continue from a node-set")
+		}
+	case e.absolute:
+		root := ctx.node
+		for root.Parent != nil {
+			root = root.Parent
+		}
+		nodes = []*Node{root}
+	default:
+		nodes = []*Node{ctx.node}
+	}
+	for _, s := range e.steps {
+		var next []*Node
+		seen := map[*Node]bool{}
+		for _, n := range nodes {
+			matched, err := s.apply(n)
+			if err != nil {
+				return nil, err
+			}
+			for _, m := range matched {
+				if !seen[m] {
+					seen[m] = true
+					next = append(next, m)
+				}
+			}
+		}
+		nodes = docOrder(next)
+	}
+	return nodes, nil
+}
+
+type nodeTest struct {
+	kind     string // "name" or a node type
+	space    string
+	local    string // "*" matches any name; a PI target for processing-instruction
+	anySpace bool
+}
+
+type step struct {
+	axis  string
+	test  nodeTest
+	preds []xpExpr
+}
+
+// apply returns the step's nodes for one context node, in axis order.
+func (s *step) apply(n *Node) ([]*Node, error) {
+	var out []*Node
+	for _, c := range axisNodes(s.axis, n) {
+		if s.test.matches(c, s.axis == "attribute") {
+			out = append(out, c)
+		}
+	}
+	var err error
+	for _, pred := range s.preds {
+		if out, err = applyPredicate(out, pred); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func (t nodeTest) matches(n *Node, attrAxis bool) bool {
+	switch t.kind {
+	case "node":
+		return true
+	case "text":
+		return n.Type == TextNode || n.Type == CDataNode
+	case "comment":
+		return n.Type == CommentNode
+	case "processing-instruction":
+		return n.Type == ProcInstNode && (t.local == "" || n.Name.Local == t.local)
+	}
+	principal := ElementNode
+	if attrAxis {
+		principal = AttributeNode
+	}
+	if n.Type != principal {
+		return false
+	}
+	if t.anySpace {
+		return true
+	}
+	return n.Name.Space == t.space && (t.local == "*" || n.Name.Local == t.local)
+}
+
+func applyPredicate(nodes []*Node, pred xpExpr) ([]*Node, error) {
+	var out []*Node
+	for i, n := range nodes {
+		v, err := pred.eval(&xpContext{node: n, pos: i + 1, size: len(nodes)})
+		if err != nil {
+			return nil, err
+		}
+		keep := false
+		if f, ok := v.(float64); ok {
+			keep = f == float64(i+1)
+		} else {
+			keep = toBool(v)
+		}
+		if keep {
+			out = append(out, n)
+		}
+	}
+	return out, nil
+}
+
+// children returns the nodes XPath sees as n's children. The DOCTYPE and
+// the XML declaration are not part of the data model.
+func children(n *Node) []*Node {
+	if n.Type != DocumentNode {
+		return n.Children
+	}
+	out := make([]*Node, 0, len(n.Children))
+	for _, c := range n.Children {
+		if c.Type != DoctypeNode && !(c.Type == ProcInstNode && c.Name.Local == "xml") {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func descendants(n *Node, out []*Node) []*Node {
+	for _, c := range children(n) {
+		out = append(out, c)
+		out = descendants(c, out)
+	}
+	return out
+}
+
+// axisNodes lists an axis in axis order: reverse axes run from the context
+// node outwards.
+func axisNodes(axis string, n *Node) []*Node {
+	switch axis {
+	case "self":
+		return []*Node{n}
+	case "child":
+		return children(n)
+	case "attribute":
+		var out []*Node
+		for _, a := range n.Attrs {
+			if !a.IsNamespaceDecl() {
+				out = append(out, a)
+			}
+		}
+		return out
+	case "parent":
+		if n.Parent == nil {
+			return nil
+		}
+		return []*Node{n.Parent}
+	case "ancestor", "ancestor-or-self":
+		var out []*Node
+		if axis == "ancestor-or-self" {
+			out = append(out, n)
+		}
+		for p := n.Parent; p != nil; p = p.Parent {
+			out = append(out, p)
+		}
+		return out
+	case "descendant":
+		return descendants(n, nil)
+	case "descendant-or-self":
+		return descendants(n, []*Node{n})
+	case "following-sibling", "preceding-sibling":
+		if n.Parent == nil || n.Type == AttributeNode {
+			return nil
+		}
+		sibs := children(n.Parent)
+		i := slices.Index(sibs, n)
+		if axis == "following-sibling" {
+			return slices.Clone(sibs[i+1:])
+		}
+		out := slices.Clone(sibs[:max(i, 0)])
+		slices.Reverse(out)
+		return out
+	case "following":
+		var out []*Node
+		cur := n
+		if n.Type == AttributeNode {
+			out = descendants(n.Parent, nil)
+			cur = n.Parent
+		}
+		for ; cur.Parent != nil; cur = cur.Parent {
+			sibs := children(cur.Parent)
+			for _, s := range sibs[slices.Index(sibs, cur)+1:] {
+				out = append(out, s)
+				out = descendants(s, out)
+			}
+		}
+		return out
+	case "preceding":
+		var out []*Node
+		cur := n
+		if n.Type == AttributeNode {
+			cur = n.Parent
+		}
+		for ; cur.Parent != nil; cur = cur.Parent {
+			sibs := children(cur.Parent)
+			for i := slices.Index(sibs, cur) - 1; i >= 0; i-- {
+				sub := descendants(sibs[i], []*Node{sibs[i]})
+				slices.Reverse(sub)
+				out = append(out, sub...)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+func docOrder(nodes []*Node) []*Node {
+	slices.SortFunc(nodes, func(a, b *Node) int { return a.order - b.order })
+	return slices.CompactFunc(nodes, func(a, b *Node) bool { return a == b })
+}
+
+// ── Conversions ──────────────────────────────────────────────────────────────
+
+func toString(v any) string {
+	switch x := v.(type) {
+	case string:
+		return x
+	case bool:
+		return strconv.FormatBool(x)
+	case float64:
+		return FormatNumber(x)
+	case []*Node:
+		if len(x) == 0 {
+			return ""
+		}
+		return x[0].Text()
+	}
+	return ""
+}
+
+var xpNumberRe = regexp.MustCompile(`^[ \t\r\n]*-?(?:[0-9]+(?:\.[0-9]*)?|\.[0-9]+)[ \t\r\n]*$`)
+
+func toNumber(v any) float64 {
+	switch x := v.(type) {
+	case float64:
+		return x
+	case bool:
+		if x {
+			return 1
+		}
+		return 0
+	}
+	s := toString(v)
+	if !xpNumberRe.MatchString(s) {
+		return math.NaN()
+	}
+	f, _ := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	return f
+}
+
+func toBool(v any) bool {
+	switch x := v.(type) {
+	case bool:
+		return x
+	case float64:
+		return x != 0 && !math.IsNaN(x)
+	case string:
+		return x != ""
+	case []*Node:
+		return len(x) > 0
+	}
+	return false
+}
+
+// FormatNumber converts a number to a string the way XPath's string()
+// does: integers without a decimal point and never an exponent.
+func FormatNumber(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	case f == 0:
+		return "0"
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// Path returns an XPath locating n, with positions only where a name is
+// repeated among siblings.
+func Path(n *Node) string {
+	switch n.Type {
+	case DocumentNode:
+		return "/"
+	case AttributeNode:
+		return strings.TrimSuffix(Path(n.Parent), "/") + "/@" + n.Name.String()
+	}
+	var seg string
+	same := func(c *Node) bool { return c.Type == n.Type && c.Name == n.Name }
+	switch n.Type {
+	case ElementNode:
+		seg = n.Name.String()
+	case TextNode, CDataNode:
+		seg = "text()"
+		same = func(c *Node) bool { return c.Type == TextNode || c.Type == CDataNode }
+	case CommentNode:
+		seg = "comment()"
+	case ProcInstNode:
+		seg = "processing-instruction('" + n.Name.Local + "')"
+	}
+	count, index := 0, 0
+	for _, c := range children(n.Parent) {
+		if same(c) {
+			count++
+			if c == n {
+				index = count
+			}
+		}
+	}
+	if count > 1 {
+		seg += "[" + strconv.Itoa(index) + "]"
+	}
+	return strings.TrimSuffix(Path(n.Parent), "/") + "/" + seg
+}
@@ -0,0 +1,239 @@
+package xmldoc
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+type xpFunc struct {
+	minArgs, maxArgs int // maxArgs < 0 means variadic
+	call             func(ctx *xpContext, args []any) (any, error)
+}
+
+type callExpr struct {
+	name string
+	fn   xpFunc
+	args []xpExpr
+	pos  int
+}
+
+func (e *callExpr) eval(ctx *xpContext) (any, error) {
+	args := make([]any, len(e.args))
+	for i, a := range e.args {
+		v, err := a.eval(ctx)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+	v, err := e.fn.call(ctx, args)
+	if err != nil {
+		return nil, exprErrorf(e.pos, "%s(): %v", e.name, err)
+	}
+	return v, nil
+}
+
+type argError string
+
+func (e argError) Error() string { return string(e) }
+
+// nodeSetArg returns argument i as a node-set; absent optional arguments
+// default to the context node.
+func nodeSetArg(ctx *xpContext, args []any, i int) ([]*Node, error) {
+	if i >= len(args) {
+		return []*Node{ctx.node}, nil
+	}
+	nodes, ok := args[i].([]*Node)
+	if !ok {
+		return nil, argError("argument must be a node-set")
+	}
+	return nodes, nil
+}
+
+// stringArg returns argument i as a string; absent optional arguments
+// default to the context node's string value.
+func stringArg(ctx *xpContext, args []any, i int) string {
+	if i >= len(args) {
+		return ctx.node.Text()
+	}
+	return toString(args[i])
+}
+
+func firstNode(ctx *xpContext, args []any) (*Node, error) {
+	nodes, err := nodeSetArg(ctx, args, 0)
+	if err != nil || len(nodes) == 0 {
+		return nil, err
+	}
+	return docOrder(nodes)[0], nil
+}
+
+// xpRound rounds half up, as XPath's round() does.
+func xpRound(f float64) float64 {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return f
+	}
+	return math.Floor(f + 0.5)
+}
+
+var xpFunctions = map[string]xpFunc{
+	// Node-set functions.
+	"last":     {0, 0, func(ctx *xpContext, _ []any) (any, error) { return float64(ctx.size), nil }},
+	"position": {0, 0, func(ctx *xpContext, _ []any) (any, error) { return float64(ctx.pos), nil }},
+	"count": {1, 1, func(ctx *xpContext, args []any) (any, error) {
+		nodes, err := nodeSetArg(ctx, args, 0)
+		return float64(len(nodes)), err
+	}},
+	"id": {1, 1, func(ctx *xpContext, args []any) (any, error) {
+		var ids []string
+		if nodes, ok := args[0].([]*Node); ok {
+			for _, n := range nodes {
+				ids = append(ids, strings.Fields(n.Text())...)
+			}
+		} else {
+			ids = strings.Fields(toString(args[0]))
+		}
+		root := ctx.node
+		for root.Parent != nil {
+			root = root.Parent
+		}
+		var out []*Node
+		for _, n := range descendants(root, nil) {
+			if n.Type != ElementNode {
+				continue
+			}
+			for _, a := range n.Attrs {
+				if (a.Name.Local == "id" && (a.Name.Space == "" || a.Name.Space == XMLNamespace)) && slices.Contains(ids, a.Value) {
+					out = append(out, n)
+					break
+				}
+			}
+		}
+		return out, nil
+	}},
+	"local-name": {0, 1, func(ctx *xpContext, args []any) (any, error) {
+		n, err := firstNode(ctx, args)
+		if n == nil || err != nil {
+			return "", err
+		}
+		if n.Type == ElementNode || n.Type == AttributeNode || n.Type == ProcInstNode {
+			return n.Name.Local, nil
+		}
+		return "", nil
+	}},
+	"namespace-uri": {0, 1, func(ctx *xpContext, args []any) (any, error) {
+		n, err := firstNode(ctx, args)
+		if n == nil || err != nil {
+			return "", err
+		}
+		return n.Name.Space, nil
+	}},
+	"name": {0, 1, func(ctx *xpContext, args []any) (any, error) {
+		n, err := firstNode(ctx, args)
+		if n == nil || err != nil {
+			return "", err
+		}
+		if n.Type == ElementNode || n.Type == AttributeNode || n.Type == ProcInstNode {
+			return n.Name.String(), nil
+		}
+		return "", nil
+	}},
+
+	// String functions.
+	"string": {0, 1, func(ctx *xpContext, args []any) (any, error) { return stringArg(ctx, args, 0), nil }},
+	"concat": {2, -1, func(_ *xpContext, args []any) (any, error) {
+		var b strings.Builder
+		for _, a := range args {
+			b.WriteString(toString(a))
+		}
+		return b.String(), nil
+	}},
+	"starts-with": {2, 2, func(_ *xpContext, args []any) (any, error) {
+		return strings.HasPrefix(toString(args[0]), toString(args[1])), nil
+	}},
+	"contains": {2, 2, func(_ *xpContext, args []any) (any, error) {
+		return strings.Contains(toString(args[0]), toString(args[1])), nil
+	}},
+	"substring-before": {2, 2, func(_ *xpContext, args []any) (any, error) {
+		before, _, found := strings.Cut(toString(args[0]), toString(args[1]))
+		if !found {
+			return "", nil
+		}
+		return before, nil
+	}},
+	"substring-after": {2, 2, func(_ *xpContext, args []any) (any, error) {
+		_, after, _ := strings.Cut(toString(args[0]), toString(args[1]))
+		return after, nil
+	}},
+	"substring": {2, 3, func(_ *xpContext, args []any) (any, error) {
+		runes := []rune(toString(args[0]))
+		start := xpRound(toNumber(args[1]))
+		end := math.Inf(1)
+		if len(args) == 3 {
+			end = start + xpRound(toNumber(args[2]))
+		}
+		var b strings.Builder
+		for i, r := range runes {
+			if p := float64(i + 1); p >= start && p < end {
+				b.WriteRune(r)
+			}
+		}
+		return b.String(), nil
+	}},
+	"string-length": {0, 1, func(ctx *xpContext, args []any) (any, error) {
+		return float64(utf8.RuneCountInString(stringArg(ctx, args, 0))), nil
+	}},
+	"normalize-space": {0, 1, func(ctx *xpContext, args []any) (any, error) {
+		return strings.Join(strings.Fields(stringArg(ctx, args, 0)), " "), nil
+	}},
+	"translate": {3, 3, func(_ *xpContext, args []any) (any, error) {
+		from, to := []rune(toString(args[1])), []rune(toString(args[2]))
+		return strings.Map(func(r rune) rune {
+			for i, f := range from {
+				if f == r {
+					if i < len(to) {
+						return to[i]
+					}
+					return -1
+				}
+			}
+			return r
+		}, toString(args[0])), nil
+	}},
+
+	// Boolean functions.
+	"boolean": {1, 1, func(_ *xpContext, args []any) (any, error) { return toBool(args[0]), nil }},
+	"not":     {1, 1, func(_ *xpContext, args []any) (any, error) { return !toBool(args[0]), nil }},
+	"true":    {0, 0, func(*xpContext, []any) (any, error) { return true, nil }},
+	"false":   {0, 0, func(*xpContext, []any) (any, error) { return false, nil }},
+	"lang": {1, 1, func(ctx *xpContext, args []any) (any, error) {
+		want := strings.ToLower(toString(args[0]))
+		for n := ctx.node; n != nil; n = n.Parent {
+			if lang, ok := n.Attr(XMLNamespace, "lang"); ok {
+				lang = strings.ToLower(lang)
+				return lang == want || strings.HasPrefix(lang, want+"-"), nil
+			}
+		}
+		return false, nil
+	}},
+
+	// Number functions.
+	"number": {0, 1, func(ctx *xpContext, args []any) (any, error) {
+		if len(args) == 0 {
+			return toNumber(ctx.node.Text()), nil
+		}
+		return toNumber(args[0]), nil
+	}},
+	"sum": {1, 1, func(ctx *xpContext, args []any) (any, error) {
+		nodes, err := nodeSetArg(ctx, args, 0)
+		total := 0.0
+		for _, n := range nodes {
+			total += toNumber(n.Text())
+		}
+		return total, err
+	}},
+	"floor":   {1, 1, func(_ *xpContext, args []any) (any, error) { return math.Floor(toNumber(args[0])), nil }},
+	"ceiling": {1, 1, func(_ *xpContext, args []any) (any, error) { return math.Ceil(toNumber(args[0])), nil }},
+	"round":   {1, 1, func(_ *xpContext, args []any) (any, error) { return xpRound(toNumber(args[0])), nil }},
+}
@@ -0,0 +1,133 @@
+package xmldoc
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEvaluate(t *testing.T) {
+	doc, err := Parse(`<?xml version="1.0"?>
+<library xmlns:x="urn:extra" xml:lang="en">
+  <!-- catalogue -->
+  <book id="b1" year="1999"><title>Alpha</title><price>10.50</price></book>
+  <book id="b2" year="2005"><title>Beta</title><price>4</price><x:note>signed</x:note></book>
+  <book id="b3" year="2012"><title xml:lang="de-AT">Gamma</title><price>7</price></book>
+  <?render fast?>
+</library>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		expr string
+		want string // node-sets render as the names or values of their members
+	}{
+		{"/library/book/title", "Alpha|Beta|Gamma"},
+		{"//book[@year > 2000]/@id", "b2|b3"},
+		{"//book[last()]/title", "Gamma"},
+		{"//book[position() < 3][2]/title", "Beta"},
+		{"count(//book)", "3"},
+		{"sum(//price)", "21.5"},
+		{"sum(//price) div count(//price)", "7.166666666666667"},
+		{"7 mod 3 * -2", "-2"},
+		{"//title[. = 'Beta']/../@id", "b2"},
+		{"string(//book[2]/x:note)", "signed"},
+		{"//x:*", "signed"},
+		{"name(//*[namespace-uri() = 'urn:extra'])", "x:note"},
+		{"//book[title='Alpha']/following-sibling::book/@id", "b2|b3"},
+		{"//book[3]/preceding::title", "Alpha|Beta"},
+		{"(//book[3]/preceding::title)[1]", "Alpha"},
+		{"//book[3]/ancestor-or-self::*", "library|book"},
+		{"//title | //price[. > 5]", "Alpha|10.50|Beta|Gamma|7"},
+		{"//comment()", " catalogue "},
+		{"//processing-instruction('render')", "fast"},
+		{"/*/node()[2]", " catalogue "},
+		{"boolean(//book[@id='b9'])", "false"},
+		{"//price = 4", "true"},
+		{"//price != 4", "true"},
+		{"not(//price > 100)", "true"},
+		{"concat(substring('12345', 1.5, 2.6), '-', substring-after('a=b', '='))", "234-b"},
+		{"translate(normalize-space('  a  b  '), 'ab', 'B')", "B "},
+		{"string-length(//title[1])", "5"},
+		{"round(2.5) + floor(-1.5) + ceiling(1.2)", "3"},
+		{"1 div 0", "Infinity"},
+		{"number('abc')", "NaN"},
+		{"id('b1 b3')/title", "Alpha|Gamma"},
+		{"//title[lang('de')]", "Gamma"},
+		{"count(//book[lang('en')])", "3"},
+		{"local-name(/*)", "library"},
+		{"//book[starts-with(title, 'G') or contains(title, 'lph')]/@id", "b1|b3"},
+	}
+	for _, tt := range tests {
+		v, err := Evaluate(doc, tt.expr, nil)
+		if err != nil {
+			t.Errorf("%s: %v", tt.expr, err)
+			continue
+		}
+		var got string
+		if nodes, ok := v.([]*Node); ok {
+			parts := make([]string, len(nodes))
+			for i, n := range nodes {
+				if n.Type == ElementNode && len(elementChildren(n)) > 0 {
+					parts[i] = n.Name.String()
+				} else {
+					parts[i] = n.Text()
+				}
+			}
+			got = strings.Join(parts, "|")
+		} else {
+			got = toString(v)
+		}
+		if got != tt.want {
+			t.Errorf("%s = %q, want %q", tt.expr, got, tt.want)
+		}
+	}
+
+	errs := []struct{ expr, msg string }{
+		{"//book[", "unexpected end of expression"},
+		{"//@", "expected a node test"},
+		{"//p:a", `undeclared namespace prefix "p"`},
+		{"foo()", "unknown function foo()"},
+		{"count(1)", "count(): argument must be a node-set"},
+		{"$v", "variables are not supported"},
+		{"//a b", "expected an operator"},
+		{"substring('a')", "wrong number of arguments"},
+		{"'abc", "unterminated string literal"},
+	}
+	for _, tt := range errs {
+		_, err := Evaluate(doc, tt.expr, nil)
+		if err == nil || !strings.Contains(err.Error(), tt.msg) {
+			t.Errorf("%s: got %v, want %q", tt.expr, err, tt.msg)
+		}
+	}
+}
+
+func TestEvaluateDefaultNamespace(t *testing.T) {
+	doc, err := Parse(`<feed xmlns="http://www.w3.org/2005/Atom"><entry><title>t1</title></entry></feed>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Unprefixed names match no-namespace elements only.
+	if v, _ := Evaluate(doc, "count(//entry)", nil); v != 0.0 {
+		t.Errorf("unprefixed match: %v", v)
+	}
+	v, err := Evaluate(doc, "//a:entry/a:title", map[string]string{"a": "http://www.w3.org/2005/Atom"})
+	if err != nil || len(v.([]*Node)) != 1 {
+		t.Errorf("bound prefix: %v, %v", v, err)
+	}
+}
+
+func TestPath(t *testing.T) {
+	doc, err := Parse(`<r><a/><b k="v">x<!--c-->y</b><a/></r>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, _ := Evaluate(doc, "//a | //@k | //text() | //comment()", nil)
+	var got []string
+	for _, n := range v.([]*Node) {
+		got = append(got, Path(n))
+	}
+	want := "/r/a[1] /r/b/@k /r/b/text()[1] /r/b/comment() /r/b/text()[2] /r/a[2]"
+	if strings.Join(got, " ") != want {
+		t.Errorf("got  %s\nwant %s", strings.Join(got, " "), want)
+	}
+}
@@ -0,0 +1,634 @@
+package xmldoc
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	// XSDNamespace is the namespace of XML Schema definitions.
+	XSDNamespace = "http://www.w3.org/2001/XMLSchema"
+	// XSINamespace holds xsi:type, xsi:nil and the schema location hints.
+	XSINamespace = "http://www.w3.org/2001/XMLSchema-instance"
+)
+
+// Schema is a compiled subset of XML Schema 1.0 — enough to check the
+// structure of a payload offline: global and local element declarations,
+// named and anonymous complex types with sequence, choice and all groups,
+// occurrence bounds, model and attribute groups, attributes with use and
+// fixed values, simple and complex content derivation, element and
+// attribute wildcards, and simple types restricted by facets, lists and
+// unions over the built-in types. Imports and includes are not followed;
+// identity constraints, substitution groups and abstract types are
+// ignored.
+type Schema struct {
+	tns            string
+	qualifiedElems bool
+	qualifiedAttrs bool
+	elementNodes   map[string]*Node
+	complexNodes   map[string]*Node
+	simpleNodes    map[string]*Node
+	groupNodes     map[string]*Node
+	attrGroupNodes map[string]*Node
+	attributeNodes map[string]*Node
+	elements       map[*Node]*elementDecl
+	complexTypes   map[*Node]*complexType
+	simpleTypes    map[*Node]*simpleType
+	builtins       map[string]*simpleType
+	errs           []string
+	warned         map[string]bool
+	Warnings       []string
+}
+
+type elementDecl struct {
+	name, space string
+	typ         *complexType
+	nillable    bool
+	fixed       *string
+}
+
+// complexType also represents elements of simple type, which have a
+// simple content type and no attributes.
+type complexType struct {
+	name     string
+	any      bool        // xs:anyType: anything is allowed
+	simple   *simpleType // simple content
+	content  *particle   // nil for empty or simple content
+	mixed    bool
+	attrs    []*attrDecl
+	anyAttrs bool
+}
+
+type attrDecl struct {
+	name, space string
+	typ         *simpleType
+	required    bool
+	prohibited  bool
+	fixed       *string
+}
+
+type particle struct {
+	kind     string // "element", "sequence", "choice", "all" or "any"
+	elem     *elementDecl
+	children []*particle
+	min, max int    // max < 0 means unbounded
+	anyNS    string // a wildcard's namespace constraint
+	tns      string
+	lax      bool // processContents is lax or skip
+}
+
+var anyType = &complexType{name: "anyType", any: true, mixed: true, anyAttrs: true}
+
+// ParseSchema compiles an XSD document.
+func ParseSchema(doc *Node) (*Schema, error) {
+	root := doc.Root()
+	if root.Name.Space != XSDNamespace || root.Name.Local != "schema" {
+		return nil, fmt.Errorf("the schema's root element must be xs:schema in the %s namespace", XSDNamespace)
+	}
+	s := &Schema{
+		elementNodes: map[string]*Node{}, complexNodes: map[string]*Node{}, simpleNodes: map[string]*Node{},
+		groupNodes: map[string]*Node{}, attrGroupNodes: map[string]*Node{}, attributeNodes: map[string]*Node{},
+		elements: map[*Node]*elementDecl{}, complexTypes: map[*Node]*complexType{}, simpleTypes: map[*Node]*simpleType{},
+		builtins: map[string]*simpleType{}, warned: map[string]bool{},
+	}
+	s.tns, _ = root.Attr("", "targetNamespace")
+	form, _ := root.Attr("", "elementFormDefault")
+	s.qualifiedElems = form == "qualified"
+	form, _ = root.Attr("", "attributeFormDefault")
+	s.qualifiedAttrs = form == "qualified"
+
+	for _, c := range xsdChildren(root) {
+		name, _ := c.Attr("", "name")
+		table := map[string]map[string]*Node{
+			"element": s.elementNodes, "complexType": s.complexNodes, "simpleType": s.simpleNodes,
+			"group": s.groupNodes, "attributeGroup": s.attrGroupNodes, "attribute": s.attributeNodes,
+		}[c.Name.Local]
+		switch {
+		case table != nil:
+			if name == "" {
+				return nil, fmt.Errorf("line %d: global xs:%s without a name", c.Line, c.Name.Local)
+			}
+			table[name] = c
+		case c.Name.Local == "import", c.Name.Local == "include", c.Name.Local == "redefine", c.Name.Local == "override":
+			s.warnf("xs:%s on line %d is not followed; referenced components must be in this schema", c.Name.Local, c.Line)
+		case c.Name.Local == "notation":
+		default:
+			return nil, fmt.Errorf("line %d: unexpected xs:%s in xs:schema", c.Line, c.Name.Local)
+		}
+	}
+	if len(s.elementNodes) == 0 {
+		return nil, fmt.Errorf("the schema declares no global elements")
+	}
+	// Compile everything up front so that reference errors surface before
+	// validation starts.
+	for _, name := range sortedKeys(s.elementNodes) {
+		s.globalElement(s.elementNodes[name])
+	}
+	for _, name := range sortedKeys(s.complexNodes) {
+		s.complexType(s.complexNodes[name])
+	}
+	for _, name := range sortedKeys(s.simpleNodes) {
+		s.simpleType(s.simpleNodes[name])
+	}
+	if len(s.errs) > 0 {
+		return nil, fmt.Errorf("%s", strings.Join(s.errs, "; "))
+	}
+	return s, nil
+}
+
+func sortedKeys(m map[string]*Node) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func (s *Schema) errorf(n *Node, format string, args ...any) {
+	if len(s.errs) < 20 {
+		s.errs = append(s.errs, fmt.Sprintf("line %d: ", n.Line)+fmt.Sprintf(format, args...))
+	}
+}
+
+func (s *Schema) warnf(format string, args ...any) {
+	msg := fmt.Sprintf(format, args...)
+	if !s.warned[msg] {
+		s.warned[msg] = true
+		s.Warnings = append(s.Warnings, msg)
+	}
+}
+
+// xsdChildren returns the XSD element children of n, without annotations.
+func xsdChildren(n *Node) []*Node {
+	var out []*Node
+	for _, c := range n.Children {
+		if c.Type == ElementNode && c.Name.Space == XSDNamespace && c.Name.Local != "annotation" {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func xsdChild(n *Node, local string) *Node {
+	for _, c := range xsdChildren(n) {
+		if c.Name.Local == local {
+			return c
+		}
+	}
+	return nil
+}
+
+// resolvePrefix finds the namespace bound to prefix where n appears.
+func resolvePrefix(n *Node, prefix string) (string, bool) {
+	if prefix == "xml" {
+		return XMLNamespace, true
+	}
+	for ; n != nil; n = n.Parent {
+		for _, a := range n.Attrs {
+			if a.IsNamespaceDecl() && (prefix == "" && a.Name.Prefix == "" || a.Name.Prefix == "xmlns" && a.Name.Local == prefix) {
+				return a.Value, true
+			}
+		}
+	}
+	return "", prefix == ""
+}
+
+// qname resolves a QName-valued attribute of a schema component.
+func (s *Schema) qname(n *Node, attr string) (space, local string, ok bool) {
+	v, present := n.Attr("", attr)
+	if !present {
+		return "", "", false
+	}
+	prefix, local, found := strings.Cut(strings.TrimSpace(v), ":")
+	if !found {
+		prefix, local = "", prefix
+	}
+	space, bound := resolvePrefix(n, prefix)
+	if !bound {
+		s.errorf(n, "undeclared prefix %q in %s=%q", prefix, attr, v)
+		return "", "", false
+	}
+	return space, local, true
+}
+
+// lookup finds a named component, reporting references into namespaces
+// that this schema does not define.
+func (s *Schema) lookup(n *Node, table map[string]*Node, kind, space, local string) *Node {
+	if c, ok := table[local]; ok && space == s.tns {
+		return c
+	}
+	if space != s.tns {
+		s.warnf("%s {%s}%s is defined in another schema and is not checked", kind, space, local)
+		return nil
+	}
+	s.errorf(n, "undefined %s %s", kind, local)
+	return nil
+}
+
+func occurs(n *Node) (lo, hi int) {
+	lo, hi = 1, 1
+	if v, ok := n.Attr("", "minOccurs"); ok {
+		lo, _ = strconv.Atoi(strings.TrimSpace(v))
+	}
+	if v, ok := n.Attr("", "maxOccurs"); ok {
+		if strings.TrimSpace(v) == "unbounded" {
+			hi = -1
+		} else {
+			hi, _ = strconv.Atoi(strings.TrimSpace(v))
+		}
+	}
+	return lo, hi
+}
+
+func (s *Schema) globalElement(n *Node) *elementDecl {
+	if d, ok := s.elements[n]; ok {
+		return d
+	}
+	name, _ := n.Attr("", "name")
+	d := &elementDecl{name: name, space: s.tns}
+	s.elements[n] = d
+	s.fillElement(d, n)
+	return d
+}
+
+func (s *Schema) fillElement(d *elementDecl, n *Node) {
+	nillable, _ := n.Attr("", "nillable")
+	d.nillable = nillable == "true"
+	if v, ok := n.Attr("", "fixed"); ok {
+		d.fixed = &v
+	}
+	switch {
+	case xsdChild(n, "complexType") != nil:
+		d.typ = s.complexType(xsdChild(n, "complexType"))
+	case xsdChild(n, "simpleType") != nil:
+		d.typ = &complexType{name: d.name, simple: s.simpleType(xsdChild(n, "simpleType"))}
+	default:
+		d.typ = s.typeRef(n, "type")
+	}
+}
+
+// typeRef resolves a type attribute to a complex type, wrapping simple
+// types. A missing attribute means xs:anyType.
+func (s *Schema) typeRef(n *Node, attr string) *complexType {
+	space, local, ok := s.qname(n, attr)
+	if !ok {
+		return anyType
+	}
+	if space == XSDNamespace {
+		if local == "anyType" {
+			return anyType
+		}
+		if st := s.builtin(n, local); st != nil {
+			return &complexType{name: local, simple: st}
+		}
+		return anyType
+	}
+	if c, found := s.complexNodes[local]; found && space == s.tns {
+		return s.complexType(c)
+	}
+	if st := s.lookup(n, s.simpleNodes, "type", space, local); st != nil {
+		return &complexType{name: local, simple: s.simpleType(st)}
+	}
+	return anyType
+}
+
+func (s *Schema) builtin(n *Node, local string) *simpleType {
+	if st, ok := s.builtins[local]; ok {
+		return st
+	}
+	if _, ok := builtinCheck(local, ""); !ok {
+		s.errorf(n, "unknown built-in type xs:%s", local)
+		return nil
+	}
+	st := &simpleType{name: local, builtin: local}
+	s.builtins[local] = st
+	return st
+}
+
+// simpleTypeRef resolves a QName attribute to a simple type.
+func (s *Schema) simpleTypeRef(n *Node, attr string) *simpleType {
+	space, local, ok := s.qname(n, attr)
+	if !ok {
+		return s.builtin(n, "anySimpleType")
+	}
+	if space == XSDNamespace {
+		if st := s.builtin(n, local); st != nil {
+			return st
+		}
+	} else if st := s.lookup(n, s.simpleNodes, "simple type", space, local); st != nil {
+		return s.simpleType(st)
+	}
+	return s.builtin(n, "anySimpleType")
+}
+
+func (s *Schema) simpleType(n *Node) *simpleType {
+	if st, ok := s.simpleTypes[n]; ok {
+		return st
+	}
+	st := &simpleType{builtin: "anySimpleType"}
+	st.name, _ = n.Attr("", "name")
+	if st.name == "" {
+		st.name = "anonymous simple type"
+	}
+	s.simpleTypes[n] = st
+
+	if r := xsdChild(n, "restriction"); r != nil {
+		if inline := xsdChild(r, "simpleType"); inline != nil {
+			st.base = s.simpleType(inline)
+		} else {
+			st.base = s.simpleTypeRef(r, "base")
+		}
+		st.builtin = st.base.builtin
+		s.facets(st, r)
+	} else if l := xsdChild(n, "list"); l != nil {
+		if inline := xsdChild(l, "simpleType"); inline != nil {
+			st.list = s.simpleType(inline)
+		} else {
+			st.list = s.simpleTypeRef(l, "itemType")
+		}
+	} else if u := xsdChild(n, "union"); u != nil {
+		if members, ok := u.Attr("", "memberTypes"); ok {
+			for _, m := range strings.Fields(members) {
+				prefix, local, found := strings.Cut(m, ":")
+				if !found {
+					prefix, local = "", prefix
+				}
+				space, _ := resolvePrefix(u, prefix)
+				if space == XSDNamespace {
+					if b := s.builtin(u, local); b != nil {
+						st.union = append(st.union, b)
+					}
+				} else if c := s.lookup(u, s.simpleNodes, "simple type", space, local); c != nil {
+					st.union = append(st.union, s.simpleType(c))
+				}
+			}
+		}
+		for _, c := range xsdChildren(u) {
+			st.union = append(st.union, s.simpleType(c))
+		}
+		if len(st.union) == 0 {
+			st.union = []*simpleType{s.builtin(n, "anySimpleType")}
+		}
+	} else {
+		s.errorf(n, "xs:simpleType needs a restriction, list or union")
+	}
+	return st
+}
+
+// facets applies the constraining facets under a restriction.
+func (s *Schema) facets(st *simpleType, r *Node) {
+	intFacet := func(c *Node, v string) *int {
+		n, err := strconv.Atoi(strings.TrimSpace(v))
+		if err != nil || n < 0 {
+			s.errorf(c, "xs:%s needs a non-negative integer", c.Name.Local)
+			return nil
+		}
+		return &n
+	}
+	for _, c := range xsdChildren(r) {
+		v, _ := c.Attr("", "value")
+		switch c.Name.Local {
+		case "enumeration":
+			if st.builtin != "string" {
+				v = strings.Join(strings.Fields(v), " ")
+			}
+			st.enum = append(st.enum, v)
+		case "pattern":
+			re, err := translatePattern(v)
+			if err != nil {
+				s.warnf("pattern %q on line %d is not supported by this validator and is skipped", v, c.Line)
+				continue
+			}
+			st.patterns = append(st.patterns, re)
+			st.patternSrc = append(st.patternSrc, v)
+		case "length":
+			st.length = intFacet(c, v)
+		case "minLength":
+			st.minLen = intFacet(c, v)
+		case "maxLength":
+			st.maxLen = intFacet(c, v)
+		case "totalDigits":
+			st.totalDigits = intFacet(c, v)
+		case "fractionDigits":
+			st.fracDigit = intFacet(c, v)
+		case "minInclusive":
+			st.minInc = &v
+		case "maxInclusive":
+			st.maxInc = &v
+		case "minExclusive":
+			st.minExc = &v
+		case "maxExclusive":
+			st.maxExc = &v
+		case "whiteSpace", "simpleType", "attribute", "attributeGroup", "anyAttribute",
+			"sequence", "choice", "all", "group":
+		default:
+			s.warnf("facet xs:%s on line %d is not supported and is skipped", c.Name.Local, c.Line)
+		}
+	}
+}
+
+func (s *Schema) complexType(n *Node) *complexType {
+	if ct, ok := s.complexTypes[n]; ok {
+		return ct
+	}
+	ct := &complexType{}
+	ct.name, _ = n.Attr("", "name")
+	if ct.name == "" {
+		ct.name = "anonymous complex type"
+	}
+	s.complexTypes[n] = ct
+	mixed, _ := n.Attr("", "mixed")
+	ct.mixed = mixed == "true"
+
+	if sc := xsdChild(n, "simpleContent"); sc != nil {
+		deriv := xsdChild(sc, "extension")
+		if deriv == nil {
+			deriv = xsdChild(sc, "restriction")
+		}
+		if deriv == nil {
+			s.errorf(sc, "xs:simpleContent needs an extension or restriction")
+			return ct
+		}
+		base := s.typeRef(deriv, "base")
+		ct.attrs = slices.Clone(base.attrs)
+		ct.anyAttrs = base.anyAttrs
+		ct.simple = base.simple
+		if base.simple == nil {
+			ct.simple = s.builtin(n, "anySimpleType")
+		}
+		if deriv.Name.Local == "restriction" {
+			restricted := &simpleType{name: ct.name, base: ct.simple, builtin: ct.simple.builtin}
+			s.facets(restricted, deriv)
+			ct.simple = restricted
+		}
+		s.attributes(ct, deriv)
+		return ct
+	}
+	if cc := xsdChild(n, "complexContent"); cc != nil {
+		if m, ok := cc.Attr("", "mixed"); ok {
+			ct.mixed = m == "true"
+		}
+		deriv := xsdChild(cc, "extension")
+		if deriv == nil {
+			deriv = xsdChild(cc, "restriction")
+		}
+		if deriv == nil {
+			s.errorf(cc, "xs:complexContent needs an extension or restriction")
+			return ct
+		}
+		base := s.typeRef(deriv, "base")
+		if base.any && deriv.Name.Local == "extension" {
+			ct.any = true
+		}
+		ct.attrs = slices.Clone(base.attrs)
+		ct.anyAttrs = base.anyAttrs
+		own := s.modelGroup(deriv)
+		if deriv.Name.Local == "extension" && base.content != nil {
+			if own == nil {
+				ct.content = base.content
+			} else {
+				ct.content = &particle{kind: "sequence", children: []*particle{base.content, own}, min: 1, max: 1}
+			}
+		} else {
+			ct.content = own
+		}
+		s.attributes(ct, deriv)
+		return ct
+	}
+	ct.content = s.modelGroup(n)
+	s.attributes(ct, n)
+	return ct
+}
+
+// modelGroup compiles the content model directly under n, if any.
+func (s *Schema) modelGroup(n *Node) *particle {
+	for _, c := range xsdChildren(n) {
+		switch c.Name.Local {
+		case "sequence", "choice", "all", "group":
+			return s.particle(c)
+		}
+	}
+	return nil
+}
+
+func (s *Schema) particle(n *Node) *particle {
+	lo, hi := occurs(n)
+	p := &particle{kind: n.Name.Local, min: lo, max: hi}
+	switch n.Name.Local {
+	case "element":
+		if space, local, isRef := s.qname(n, "ref"); isRef {
+			if g := s.lookup(n, s.elementNodes, "element", space, local); g != nil {
+				p.elem = s.globalElement(g)
+			} else {
+				p.kind, p.anyNS, p.lax = "any", space, true
+			}
+			return p
+		}
+		name, _ := n.Attr("", "name")
+		d := &elementDecl{name: name}
+		form, hasForm := n.Attr("", "form")
+		if form == "qualified" || !hasForm && s.qualifiedElems {
+			d.space = s.tns
+		}
+		s.fillElement(d, n)
+		p.elem = d
+	case "sequence", "choice", "all":
+		for _, c := range xsdChildren(n) {
+			switch c.Name.Local {
+			case "element", "sequence", "choice", "group", "any":
+				p.children = append(p.children, s.particle(c))
+			}
+		}
+	case "group":
+		space, local, ok := s.qname(n, "ref")
+		if !ok {
+			// A named group definition compiled in place.
+			return s.modelGroup(n)
+		}
+		g := s.lookup(n, s.groupNodes, "group", space, local)
+		if g == nil {
+			return &particle{kind: "any", min: 0, max: -1, anyNS: "##any", lax: true}
+		}
+		inner := s.modelGroup(g)
+		if inner == nil {
+			return &particle{kind: "sequence", min: lo, max: hi}
+		}
+		return &particle{kind: "sequence", children: []*particle{inner}, min: lo, max: hi}
+	case "any":
+		p.tns = s.tns
+		p.anyNS, _ = n.Attr("", "namespace")
+		if p.anyNS == "" {
+			p.anyNS = "##any"
+		}
+		pc, _ := n.Attr("", "processContents")
+		p.lax = pc == "lax" || pc == "skip"
+	}
+	return p
+}
+
+// attributes adds the attribute declarations under n to ct, replacing
+// inherited declarations of the same name.
+func (s *Schema) attributes(ct *complexType, n *Node) {
+	for _, c := range xsdChildren(n) {
+		switch c.Name.Local {
+		case "attribute":
+			d := s.attribute(c)
+			if d == nil {
+				continue
+			}
+			ct.attrs = slices.DeleteFunc(ct.attrs, func(a *attrDecl) bool { return a.name == d.name && a.space == d.space })
+			if !d.prohibited {
+				ct.attrs = append(ct.attrs, d)
+			}
+		case "attributeGroup":
+			space, local, _ := s.qname(c, "ref")
+			if g := s.lookup(c, s.attrGroupNodes, "attribute group", space, local); g != nil {
+				s.attributes(ct, g)
+			}
+		case "anyAttribute":
+			ct.anyAttrs = true
+		}
+	}
+}
+
+func (s *Schema) attribute(n *Node) *attrDecl {
+	d := &attrDecl{}
+	use, _ := n.Attr("", "use")
+	d.required, d.prohibited = use == "required", use == "prohibited"
+	if v, ok := n.Attr("", "fixed"); ok {
+		d.fixed = &v
+	}
+	def := n
+	if space, local, isRef := s.qname(n, "ref"); isRef {
+		d.name, d.space = local, space
+		if space == XMLNamespace || space != s.tns {
+			d.typ = s.builtin(n, "anySimpleType")
+			return d
+		}
+		if def = s.lookup(n, s.attributeNodes, "attribute", space, local); def == nil {
+			return nil
+		}
+	} else {
+		d.name, _ = n.Attr("", "name")
+		form, hasForm := n.Attr("", "form")
+		if form == "qualified" || !hasForm && s.qualifiedAttrs || n.Parent.Name.Local == "schema" {
+			d.space = s.tns
+		}
+	}
+	if d.fixed == nil {
+		if v, ok := def.Attr("", "fixed"); ok {
+			d.fixed = &v
+		}
+	}
+	if inline := xsdChild(def, "simpleType"); inline != nil {
+		d.typ = s.simpleType(inline)
+	} else if _, ok := def.Attr("", "type"); ok {
+		d.typ = s.simpleTypeRef(def, "type")
+	} else {
+		d.typ = s.builtin(def, "anySimpleType")
+	}
+	return d
+}
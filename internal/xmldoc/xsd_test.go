@@ -0,0 +1,202 @@
+package xmldoc
+
+import (
+	"strings"
+	"testing"
+)
+
+const orderXSD = `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema" xmlns:tns="urn:shop"
+    targetNamespace="urn:shop" elementFormDefault="qualified">
+  <xs:element name="Order" type="tns:OrderType"/>
+  <xs:complexType name="OrderType">
+    <xs:sequence>
+      <xs:element name="Customer" type="xs:string"/>
+      <xs:element name="Item" type="tns:ItemType" maxOccurs="unbounded"/>
+      <xs:choice minOccurs="0">
+        <xs:element name="Email" type="tns:Email"/>
+        <xs:element name="Phone" type="xs:string"/>
+      </xs:choice>
+      <xs:element name="Placed" type="xs:date" minOccurs="0"/>
+    </xs:sequence>
+    <xs:attribute name="id" type="xs:positiveInteger" use="required"/>
+    <xs:attribute name="status" default="new">
+      <xs:simpleType>
+        <xs:restriction base="xs:token">
+          <xs:enumeration value="new"/>
+          <xs:enumeration value="paid"/>
+        </xs:restriction>
+      </xs:simpleType>
+    </xs:attribute>
+  </xs:complexType>
+  <xs:complexType name="ItemType">
+    <xs:simpleContent>
+      <xs:extension base="tns:Quantity">
+        <xs:attribute name="sku" type="tns:Sku" use="required"/>
+      </xs:extension>
+    </xs:simpleContent>
+  </xs:complexType>
+  <xs:simpleType name="Quantity">
+    <xs:restriction base="xs:int">
+      <xs:minInclusive value="1"/>
+      <xs:maxExclusive value="100"/>
+    </xs:restriction>
+  </xs:simpleType>
+  <xs:simpleType name="Sku">
+    <xs:restriction base="xs:string">
+      <xs:pattern value="[A-Z]{2}-\d{3}"/>
+    </xs:restriction>
+  </xs:simpleType>
+  <xs:simpleType name="Email">
+    <xs:restriction base="xs:string">
+      <xs:pattern value="[^@\s]+@[^@\s]+"/>
+      <xs:maxLength value="40"/>
+    </xs:restriction>
+  </xs:simpleType>
+</xs:schema>`
+
+func validate(t *testing.T, xsd, xml string) Report {
+	t.Helper()
+	sdoc, err := Parse(xsd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema, err := ParseSchema(sdoc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := Parse(xml)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return schema.Validate(doc)
+}
+
+func TestValidate(t *testing.T) {
+	valid := `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body>
+  <o:Order xmlns:o="urn:shop" id="12" status="paid">
+    <o:Customer>Ada</o:Customer>
+    <o:Item sku="AB-123">3</o:Item>
+    <o:Item sku="CD-456">99</o:Item>
+    <o:Email>ada@example.com</o:Email>
+    <o:Placed>2024-02-29</o:Placed>
+  </o:Order>
+</soap:Body></soap:Envelope>`
+	r := validate(t, orderXSD, valid)
+	if len(r.Issues) != 0 || len(r.Roots) != 1 || r.Roots[0] != "/soap:Envelope/soap:Body/o:Order" {
+		t.Fatalf("valid document: %+v", r)
+	}
+
+	tests := []struct {
+		name, xml string
+		line      int
+		msg       string
+	}{
+		{"missing attribute", `<Order xmlns="urn:shop"><Customer/><Item sku="AB-123">1</Item></Order>`,
+			1, "missing required attribute id"},
+		{"enumeration", `<Order xmlns="urn:shop" id="1" status="sent"><Customer/><Item sku="AB-123">1</Item></Order>`,
+			1, `attribute status: "sent" is not one of the allowed values: new, paid`},
+		{"unknown attribute", `<Order xmlns="urn:shop" id="1" extra="x"><Customer/><Item sku="AB-123">1</Item></Order>`,
+			1, "attribute extra is not allowed on Order"},
+		{"missing element", "<Order xmlns=\"urn:shop\" id=\"1\">\n<Customer/>\n</Order>",
+			1, "element Order is incomplete; expected <{urn:shop}Item>"},
+		{"wrong order", "<Order xmlns=\"urn:shop\" id=\"1\"><Customer/><Item sku=\"AB-123\">1</Item>\n<Placed>2024-01-01</Placed><Phone>1</Phone></Order>",
+			2, "unexpected element Phone"},
+		{"unexpected element", "<Order xmlns=\"urn:shop\" id=\"1\"><Customer/>\n<Price/></Order>",
+			2, "unexpected element Price; expected <{urn:shop}Item>"},
+		{"unqualified child", `<Order xmlns="urn:shop" id="1"><Customer xmlns=""/></Order>`,
+			1, "unexpected element Customer; expected <{urn:shop}Customer>"},
+		{"range", "<Order xmlns=\"urn:shop\" id=\"1\"><Customer/>\n<Item sku=\"AB-123\">100</Item></Order>",
+			2, `"100" must be less than 100`},
+		{"built-in", "<Order xmlns=\"urn:shop\" id=\"1\"><Customer/>\n<Item sku=\"AB-123\">x</Item></Order>",
+			2, `"x" is not a valid int`},
+		{"pattern", "<Order xmlns=\"urn:shop\" id=\"1\"><Customer/>\n<Item sku=\"ab-1\">1</Item></Order>",
+			2, `attribute sku: "ab-1" does not match the pattern [A-Z]{2}-\d{3}`},
+		{"date", "<Order xmlns=\"urn:shop\" id=\"1\"><Customer/><Item sku=\"AB-123\">1</Item>\n<Placed>2023-02-29</Placed></Order>",
+			2, `"2023-02-29" is not a valid date`},
+		{"positiveInteger", `<Order xmlns="urn:shop" id="0"><Customer/><Item sku="AB-123">1</Item></Order>`,
+			1, `attribute id: "0" is not a valid positiveInteger`},
+		{"text in element content", "<Order xmlns=\"urn:shop\" id=\"1\">oops<Customer/><Item sku=\"AB-123\">1</Item></Order>",
+			1, "text is not allowed in element Order"},
+		{"no declared element", `<Invoice/>`, 1, "no element of the document is declared by the schema"},
+	}
+	for _, tt := range tests {
+		r := validate(t, orderXSD, tt.xml)
+		if len(r.Issues) == 0 {
+			t.Errorf("%s: no issues", tt.name)
+			continue
+		}
+		if got := r.Issues[0]; got.Line != tt.line || !strings.Contains(got.Msg, tt.msg) {
+			t.Errorf("%s: got line %d %q, want line %d %q", tt.name, got.Line, got.Msg, tt.line, tt.msg)
+		}
+	}
+}
+
+func TestValidateModelGroups(t *testing.T) {
+	xsd := `<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema">
+  <xs:element name="cfg">
+    <xs:complexType>
+      <xs:complexContent>
+        <xs:extension base="base">
+          <xs:all>
+            <xs:element name="host" type="xs:string"/>
+            <xs:element name="port" type="xs:unsignedShort" minOccurs="0"/>
+          </xs:all>
+          <xs:attributeGroup ref="meta"/>
+        </xs:extension>
+      </xs:complexContent>
+    </xs:complexType>
+  </xs:element>
+  <xs:complexType name="base">
+    <xs:sequence>
+      <xs:group ref="header"/>
+    </xs:sequence>
+  </xs:complexType>
+  <xs:group name="header">
+    <xs:sequence>
+      <xs:element name="name" type="xs:NCName"/>
+      <xs:any namespace="##other" processContents="lax" minOccurs="0" maxOccurs="unbounded"/>
+    </xs:sequence>
+  </xs:group>
+  <xs:attributeGroup name="meta">
+    <xs:attribute name="version" type="xs:decimal" fixed="1.0"/>
+    <xs:anyAttribute/>
+  </xs:attributeGroup>
+  <xs:element name="tags">
+    <xs:simpleType><xs:list itemType="xs:NCName"/></xs:simpleType>
+  </xs:element>
+</xs:schema>`
+	ok := `<cfg version="1.0" x:any="1" xmlns:x="urn:x"><name>svc</name><x:ext/><port>8080</port><host>h</host></cfg>`
+	if r := validate(t, xsd, ok); len(r.Issues) != 0 {
+		t.Errorf("valid: %+v", r.Issues)
+	}
+	for xml, msg := range map[string]string{
+		`<cfg><name>svc</name></cfg>`:                          "incomplete; expected an element from another namespace or <host>",
+		`<cfg><name>svc</name><host/><host/></cfg>`:            "unexpected element host",
+		`<cfg version="2"><name>svc</name><host/></cfg>`:       `attribute version must be "1.0"`,
+		`<cfg><name>svc</name><port>70000</port><host/></cfg>`: `"70000" is not a valid unsignedShort`,
+		`<tags>a b 3c</tags>`:                                  `list item "3c" is not a valid NCName`,
+	} {
+		r := validate(t, xsd, xml)
+		if len(r.Issues) == 0 || !strings.Contains(r.Issues[0].Msg, msg) {
+			t.Errorf("%s: got %+v, want %q", xml, r.Issues, msg)
+		}
+	}
+}
+
+func TestParseSchemaErrors(t *testing.T) {
+	for src, msg := range map[string]string{
+		`<schema/>`: "root element must be xs:schema",
+		`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"><xs:complexType name="t"/></xs:schema>`:              "declares no global elements",
+		`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"><xs:element name="a" type="missing"/></xs:schema>`:   "undefined type missing",
+		`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"><xs:element name="a" type="xs:integr"/></xs:schema>`: "unknown built-in type xs:integr",
+		`<xs:schema xmlns:xs="http://www.w3.org/2001/XMLSchema"><xs:element name="a" type="q:t"/></xs:schema>`:       `undeclared prefix "q"`,
+	} {
+		doc, err := Parse(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ParseSchema(doc); err == nil || !strings.Contains(err.Error(), msg) {
+			t.Errorf("%s: got %v, want %q", src, err, msg)
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package xmldoc
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// simpleType is a built-in or derived XSD simple type.
+type simpleType struct {
+	name    string      // for messages
+	builtin string      // the built-in type it derives from
+	base    *simpleType // nil for built-ins
+	list    *simpleType // item type of a list
+	union   []*simpleType
+
+	enum                   []string
+	patterns               []*regexp.Regexp
+	patternSrc             []string
+	length, minLen, maxLen *int
+	minInc, maxInc         *string
+	minExc, maxExc         *string
+	totalDigits, fracDigit *int
+}
+
+var (
+	decimalRe  = regexp.MustCompile(`^[+-]?(?:[0-9]+(?:\.[0-9]*)?|\.[0-9]+)$`)
+	integerRe  = regexp.MustCompile(`^[+-]?[0-9]+$`)
+	floatRe    = regexp.MustCompile(`^(?:[+-]?(?:[0-9]+(?:\.[0-9]*)?|\.[0-9]+)(?:[eE][+-]?[0-9]+)?|-?INF|\+INF|NaN)$`)
+	tzRe       = `(?:Z|[+-](?:(?:0[0-9]|1[0-3]):[0-5][0-9]|14:00))?`
+	dateRe     = regexp.MustCompile(`^(-?[0-9]{4,})-([0-9]{2})-([0-9]{2})` + tzRe + `$`)
+	timeRe     = regexp.MustCompile(`^(?:[01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9](?:\.[0-9]+)?` + tzRe + `$|^24:00:00(?:\.0+)?` + tzRe + `$`)
+	durationRe = regexp.MustCompile(`^-?P(?:[0-9]+Y)?(?:[0-9]+M)?(?:[0-9]+D)?(?:T(?:[0-9]+H)?(?:[0-9]+M)?(?:[0-9]+(?:\.[0-9]+)?S)?)?$`)
+	gRes       = map[string]*regexp.Regexp{
+		"gYear":      regexp.MustCompile(`^-?[0-9]{4,}` + tzRe + `$`),
+		"gYearMonth": regexp.MustCompile(`^-?[0-9]{4,}-(?:0[1-9]|1[0-2])` + tzRe + `$`),
+		"gMonth":     regexp.MustCompile(`^--(?:0[1-9]|1[0-2])` + tzRe + `$`),
+		"gMonthDay":  regexp.MustCompile(`^--(?:0[1-9]|1[0-2])-(?:0[1-9]|[12][0-9]|3[01])` + tzRe + `$`),
+		"gDay":       regexp.MustCompile(`^---(?:0[1-9]|[12][0-9]|3[01])` + tzRe + `$`),
+	}
+	ncNameRe   = regexp.MustCompile(`^[\p{L}_][\p{L}\p{N}\p{Mn}._·-]*$`)
+	nameRe     = regexp.MustCompile(`^[\p{L}_:][\p{L}\p{N}\p{Mn}._:·-]*$`)
+	nmtokenRe  = regexp.MustCompile(`^[\p{L}\p{N}\p{Mn}._:·-]+$`)
+	languageRe = regexp.MustCompile(`^[a-zA-Z]{1,8}(?:-[a-zA-Z0-9]{1,8})*$`)
+)
+
+// integerRanges bounds the built-in integer types; nil means unbounded.
+var integerRanges = map[string][2]*big.Int{
+	"integer":            {nil, nil},
+	"long":               {big.NewInt(-1 << 63), big.NewInt(1<<63 - 1)},
+	"int":                {big.NewInt(-1 << 31), big.NewInt(1<<31 - 1)},
+	"short":              {big.NewInt(-1 << 15), big.NewInt(1<<15 - 1)},
+	"byte":               {big.NewInt(-1 << 7), big.NewInt(1<<7 - 1)},
+	"nonNegativeInteger": {big.NewInt(0), nil},
+	"positiveInteger":    {big.NewInt(1), nil},
+	"nonPositiveInteger": {nil, big.NewInt(0)},
+	"negativeInteger":    {nil, big.NewInt(-1)},
+	"unsignedLong":       {big.NewInt(0), new(big.Int).SetUint64(1<<64 - 1)},
+	"unsignedInt":        {big.NewInt(0), big.NewInt(1<<32 - 1)},
+	"unsignedShort":      {big.NewInt(0), big.NewInt(1<<16 - 1)},
+	"unsignedByte":       {big.NewInt(0), big.NewInt(1<<8 - 1)},
+}
+
+// builtinCheck validates a whitespace-collapsed value against a built-in
+// type. ok is false for names that are not built-in simple types.
+func builtinCheck(name, v string) (valid, ok bool) {
+	if r, isInt := integerRanges[name]; isInt {
+		if !integerRe.MatchString(v) {
+			return false, true
+		}
+		n, _ := new(big.Int).SetString(strings.TrimPrefix(v, "+"), 10)
+		return (r[0] == nil || n.Cmp(r[0]) >= 0) && (r[1] == nil || n.Cmp(r[1]) <= 0), true
+	}
+	if re, isG := gRes[name]; isG {
+		return re.MatchString(v), true
+	}
+	switch name {
+	case "string", "normalizedString", "token", "anySimpleType", "anyURI":
+		return true, true
+	case "boolean":
+		return v == "true" || v == "false" || v == "1" || v == "0", true
+	case "decimal":
+		return decimalRe.MatchString(v), true
+	case "float", "double":
+		return floatRe.MatchString(v), true
+	case "date":
+		return validDate(v), true
+	case "time":
+		return timeRe.MatchString(v), true
+	case "dateTime":
+		d, t, found := strings.Cut(v, "T")
+		return found && validDate(d) && timeRe.MatchString(t), true
+	case "duration":
+		return durationRe.MatchString(v) && v != "P" && v != "-P" && !strings.HasSuffix(v, "T"), true
+	case "Name":
+		return nameRe.MatchString(v), true
+	case "NCName", "ID", "IDREF", "ENTITY":
+		return ncNameRe.MatchString(v), true
+	case "QName":
+		prefix, local, found := strings.Cut(v, ":")
+		if !found {
+			return ncNameRe.MatchString(v), true
+		}
+		return ncNameRe.MatchString(prefix) && ncNameRe.MatchString(local), true
+	case "NMTOKEN":
+		return nmtokenRe.MatchString(v), true
+	case "NMTOKENS", "IDREFS", "ENTITIES":
+		item := map[string]string{"NMTOKENS": "NMTOKEN", "IDREFS": "IDREF", "ENTITIES": "ENTITY"}[name]
+		fields := strings.Fields(v)
+		for _, f := range fields {
+			if valid, _ := builtinCheck(item, f); !valid {
+				return false, true
+			}
+		}
+		return len(fields) > 0, true
+	case "language":
+		return languageRe.MatchString(v), true
+	case "base64Binary":
+		_, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(v), ""))
+		return err == nil, true
+	case "hexBinary":
+		_, err := hex.DecodeString(v)
+		return err == nil, true
+	}
+	return false, false
+}
+
+// validDate checks the date part including the day of the month. Dates
+// before year 1 or beyond 9999 are only checked syntactically.
+func validDate(v string) bool {
+	m := dateRe.FindStringSubmatch(v)
+	if m == nil {
+		return false
+	}
+	if len(m[1]) != 4 {
+		return true
+	}
+	_, err := time.Parse("2006-01-02", m[1]+"-"+m[2]+"-"+m[3])
+	return err == nil
+}
+
+// check validates a value and returns a description of the first problem.
+func (t *simpleType) check(v string) string {
+	if t.builtin != "string" {
+		v = strings.Join(strings.Fields(v), " ")
+	}
+	switch {
+	case t.list != nil:
+		items := strings.Fields(v)
+		for _, item := range items {
+			if msg := t.list.check(item); msg != "" {
+				return "list item " + msg
+			}
+		}
+		return t.checkLength(len(items), "items")
+	case t.union != nil:
+		for _, m := range t.union {
+			if m.check(v) == "" {
+				return ""
+			}
+		}
+		return fmt.Sprintf("%q matches none of the member types of %s", v, t.name)
+	case t.base != nil:
+		if msg := t.base.check(v); msg != "" {
+			return msg
+		}
+	default:
+		if valid, _ := builtinCheck(t.builtin, v); !valid {
+			return fmt.Sprintf("%q is not a valid %s", v, t.name)
+		}
+	}
+
+	if len(t.enum) > 0 && !slices.Contains(t.enum, v) {
+		return fmt.Sprintf("%q is not one of the allowed values: %s", v, strings.Join(t.enum, ", "))
+	}
+	for i, re := range t.patterns {
+		if !re.MatchString(v) {
+			return fmt.Sprintf("%q does not match the pattern %s", v, t.patternSrc[i])
+		}
+	}
+	if msg := t.checkLength(utf8.RuneCountInString(v), "characters"); msg != "" {
+		return fmt.Sprintf("%q %s", v, msg)
+	}
+	bound := func(limit *string, ok func(int) bool, rel string) string {
+		if limit == nil {
+			return ""
+		}
+		if c, comparable := compareValues(v, *limit); comparable && !ok(c) {
+			return fmt.Sprintf("%q must be %s %s", v, rel, *limit)
+		}
+		return ""
+	}
+	for _, msg := range []string{
+		bound(t.minInc, func(c int) bool { return c >= 0 }, "at least"),
+		bound(t.maxInc, func(c int) bool { return c <= 0 }, "at most"),
+		bound(t.minExc, func(c int) bool { return c > 0 }, "greater than"),
+		bound(t.maxExc, func(c int) bool { return c < 0 }, "less than"),
+	} {
+		if msg != "" {
+			return msg
+		}
+	}
+	if t.totalDigits != nil || t.fracDigit != nil {
+		digits := strings.TrimLeft(strings.TrimLeft(v, "+-"), "0")
+		intPart, frac, _ := strings.Cut(digits, ".")
+		frac = strings.TrimRight(frac, "0")
+		if t.totalDigits != nil && len(intPart)+len(frac) > *t.totalDigits {
+			return fmt.Sprintf("%q has more than %d digits", v, *t.totalDigits)
+		}
+		if t.fracDigit != nil && len(frac) > *t.fracDigit {
+			return fmt.Sprintf("%q has more than %d fraction digits", v, *t.fracDigit)
+		}
+	}
+	return ""
+}
+
+func (t *simpleType) checkLength(n int, unit string) string {
+	switch {
+	case t.length != nil && n != *t.length:
+		return fmt.Sprintf("must have exactly %d %s", *t.length, unit)
+	case t.minLen != nil && n < *t.minLen:
+		return fmt.Sprintf("must have at least %d %s", *t.minLen, unit)
+	case t.maxLen != nil && n > *t.maxLen:
+		return fmt.Sprintf("must have at most %d %s", *t.maxLen, unit)
+	}
+	return ""
+}
+
+// compareValues orders two values numerically when both are numbers and
+// lexically otherwise, which is right for dates and times in the same
+// time zone.
+func compareValues(a, b string) (int, bool) {
+	x, okA := new(big.Rat).SetString(a)
+	y, okB := new(big.Rat).SetString(b)
+	if okA && okB {
+		return x.Cmp(y), true
+	}
+	if okA != okB {
+		return 0, false
+	}
+	return strings.Compare(a, b), true
+}
+
+// translatePattern turns an XSD regular expression into an anchored Go one.
+// The multi-character escapes \i and \c (and their negations) have no Go
+// equivalent and are expanded.
+func translatePattern(p string) (*regexp.Regexp, error) {
+	r := strings.NewReplacer(
+		`\i`, `[\p{L}_:]`, `\I`, `[^\p{L}_:]`,
+		`\c`, `[\p{L}\p{N}\p{Mn}._:-]`, `\C`, `[^\p{L}\p{N}\p{Mn}._:-]`,
+	)
+	return regexp.Compile(`^(?:` + r.Replace(p) + `)$`)
+}
@@ -21,31 +21,119 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DiffMode int32
+
+const (
+	DiffMode_DIFF_CHARACTER DiffMode = 0
+	DiffMode_DIFF_WORD      DiffMode = 1
+	DiffMode_DIFF_LINE      DiffMode = 2
+)
+
+// Enum value maps for DiffMode.
+var (
+	DiffMode_name = map[int32]string{
+		0: "DIFF_CHARACTER",
+		1: "DIFF_WORD",
+		2: "DIFF_LINE",
+	}
+	DiffMode_value = map[string]int32{
+		"DIFF_CHARACTER": 0,
+		"DIFF_WORD":      1,
+		"DIFF_LINE":      2,
+	}
+)
+
+func (x DiffMode) Enum() *DiffMode {
+	p := new(DiffMode)
+	*p = x
+	return p
+}
+
+func (x DiffMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiffMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_privutil_proto_enumTypes[0].Descriptor()
+}
+
+func (DiffMode) Type() protoreflect.EnumType {
+	return &file_proto_privutil_proto_enumTypes[0]
+}
+
+func (x DiffMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiffMode.Descriptor instead.
+func (DiffMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{0}
+}
+
 type DataFormat int32
 
 const (
-	DataFormat_JSON DataFormat = 0
-	DataFormat_YAML DataFormat = 1
-	DataFormat_XML  DataFormat = 2
-	DataFormat_TOML DataFormat = 3
-	DataFormat_CSV  DataFormat = 4
+	DataFormat_JSON           DataFormat = 0
+	DataFormat_YAML           DataFormat = 1
+	DataFormat_XML            DataFormat = 2
+	DataFormat_TOML           DataFormat = 3
+	DataFormat_CSV            DataFormat = 4
+	DataFormat_NDJSON         DataFormat = 5 // JSON Lines: one value per line
+	DataFormat_TSV            DataFormat = 6
+	DataFormat_INI            DataFormat = 7
+	DataFormat_ENV            DataFormat = 8  // dotenv KEY=VALUE files
+	DataFormat_PROPERTIES     DataFormat = 9  // Java .properties; dotted keys map to nested objects
+	DataFormat_HCL            DataFormat = 10 // HashiCorp Configuration Language (Terraform)
+	DataFormat_JSON5          DataFormat = 11 // also accepts JSONC
+	DataFormat_MSGPACK        DataFormat = 12 // binary formats travel as base64 or hex text
+	DataFormat_CBOR           DataFormat = 13
+	DataFormat_BSON           DataFormat = 14
+	DataFormat_MARKDOWN_TABLE DataFormat = 15 // GitHub-flavored; also readable as input
+	DataFormat_HTML_TABLE     DataFormat = 16 // output only
+	DataFormat_ASCII_TABLE    DataFormat = 17 // box-drawn; output only
 )
 
 // Enum value maps for DataFormat.
 var (
 	DataFormat_name = map[int32]string{
-		0: "JSON",
-		1: "YAML",
-		2: "XML",
-		3: "TOML",
-		4: "CSV",
+		0:  "JSON",
+		1:  "YAML",
+		2:  "XML",
+		3:  "TOML",
+		4:  "CSV",
+		5:  "NDJSON",
+		6:  "TSV",
+		7:  "INI",
+		8:  "ENV",
+		9:  "PROPERTIES",
+		10: "HCL",
+		11: "JSON5",
+		12: "MSGPACK",
+		13: "CBOR",
+		14: "BSON",
+		15: "MARKDOWN_TABLE",
+		16: "HTML_TABLE",
+		17: "ASCII_TABLE",
 	}
 	DataFormat_value = map[string]int32{
-		"JSON": 0,
-		"YAML": 1,
-		"XML":  2,
-		"TOML": 3,
-		"CSV":  4,
+		"JSON":           0,
+		"YAML":           1,
+		"XML":            2,
+		"TOML":           3,
+		"CSV":            4,
+		"NDJSON":         5,
+		"TSV":            6,
+		"INI":            7,
+		"ENV":            8,
+		"PROPERTIES":     9,
+		"HCL":            10,
+		"JSON5":          11,
+		"MSGPACK":        12,
+		"CBOR":           13,
+		"BSON":           14,
+		"MARKDOWN_TABLE": 15,
+		"HTML_TABLE":     16,
+		"ASCII_TABLE":    17,
 	}
 )
 
@@ -60,11 +148,11 @@ func (x DataFormat) String() string {
 }
 
 func (DataFormat) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_privutil_proto_enumTypes[0].Descriptor()
+	return file_proto_privutil_proto_enumTypes[1].Descriptor()
 }
 
 func (DataFormat) Type() protoreflect.EnumType {
-	return &file_proto_privutil_proto_enumTypes[0]
+	return &file_proto_privutil_proto_enumTypes[1]
 }
 
 func (x DataFormat) Number() protoreflect.EnumNumber {
@@ -73,7 +161,348 @@ func (x DataFormat) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DataFormat.Descriptor instead.
 func (DataFormat) EnumDescriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{0}
+	return file_proto_privutil_proto_rawDescGZIP(), []int{1}
+}
+
+type BinaryEncoding int32
+
+const (
+	BinaryEncoding_BINARY_BASE64 BinaryEncoding = 0
+	BinaryEncoding_BINARY_HEX    BinaryEncoding = 1
+)
+
+// Enum value maps for BinaryEncoding.
+var (
+	BinaryEncoding_name = map[int32]string{
+		0: "BINARY_BASE64",
+		1: "BINARY_HEX",
+	}
+	BinaryEncoding_value = map[string]int32{
+		"BINARY_BASE64": 0,
+		"BINARY_HEX":    1,
+	}
+)
+
+func (x BinaryEncoding) Enum() *BinaryEncoding {
+	p := new(BinaryEncoding)
+	*p = x
+	return p
+}
+
+func (x BinaryEncoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BinaryEncoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_privutil_proto_enumTypes[2].Descriptor()
+}
+
+func (BinaryEncoding) Type() protoreflect.EnumType {
+	return &file_proto_privutil_proto_enumTypes[2]
+}
+
+func (x BinaryEncoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BinaryEncoding.Descriptor instead.
+func (BinaryEncoding) EnumDescriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{2}
+}
+
+// How binary-only types (bytes, timestamps, int64, tags) appear in text formats.
+type BinaryJsonStyle int32
+
+const (
+	BinaryJsonStyle_BINARY_JSON_EXTENDED BinaryJsonStyle = 0 // MongoDB Extended JSON wrappers; round-trips losslessly
+	BinaryJsonStyle_BINARY_JSON_PROTOBUF BinaryJsonStyle = 1 // proto3 JSON mapping: base64 strings, RFC 3339, int64 as strings
+)
+
+// Enum value maps for BinaryJsonStyle.
+var (
+	BinaryJsonStyle_name = map[int32]string{
+		0: "BINARY_JSON_EXTENDED",
+		1: "BINARY_JSON_PROTOBUF",
+	}
+	BinaryJsonStyle_value = map[string]int32{
+		"BINARY_JSON_EXTENDED": 0,
+		"BINARY_JSON_PROTOBUF": 1,
+	}
+)
+
+func (x BinaryJsonStyle) Enum() *BinaryJsonStyle {
+	p := new(BinaryJsonStyle)
+	*p = x
+	return p
+}
+
+func (x BinaryJsonStyle) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BinaryJsonStyle) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_privutil_proto_enumTypes[3].Descriptor()
+}
+
+func (BinaryJsonStyle) Type() protoreflect.EnumType {
+	return &file_proto_privutil_proto_enumTypes[3]
+}
+
+func (x BinaryJsonStyle) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BinaryJsonStyle.Descriptor instead.
+func (BinaryJsonStyle) EnumDescriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{3}
+}
+
+type CsvQuoting int32
+
+const (
+	CsvQuoting_CSV_QUOTE_MINIMAL     CsvQuoting = 0 // only fields that need it
+	CsvQuoting_CSV_QUOTE_ALL         CsvQuoting = 1
+	CsvQuoting_CSV_QUOTE_NON_NUMERIC CsvQuoting = 2 // everything except numbers, booleans and nulls
+)
+
+// Enum value maps for CsvQuoting.
+var (
+	CsvQuoting_name = map[int32]string{
+		0: "CSV_QUOTE_MINIMAL",
+		1: "CSV_QUOTE_ALL",
+		2: "CSV_QUOTE_NON_NUMERIC",
+	}
+	CsvQuoting_value = map[string]int32{
+		"CSV_QUOTE_MINIMAL":     0,
+		"CSV_QUOTE_ALL":         1,
+		"CSV_QUOTE_NON_NUMERIC": 2,
+	}
+)
+
+func (x CsvQuoting) Enum() *CsvQuoting {
+	p := new(CsvQuoting)
+	*p = x
+	return p
+}
+
+func (x CsvQuoting) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CsvQuoting) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_privutil_proto_enumTypes[4].Descriptor()
+}
+
+func (CsvQuoting) Type() protoreflect.EnumType {
+	return &file_proto_privutil_proto_enumTypes[4]
+}
+
+func (x CsvQuoting) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CsvQuoting.Descriptor instead.
+func (CsvQuoting) EnumDescriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{4}
+}
+
+type RegexEngine int32
+
+const (
+	RegexEngine_REGEX_RE2          RegexEngine = 0 // Go regexp: linear time, no lookaround or backreferences
+	RegexEngine_REGEX_BACKTRACKING RegexEngine = 1 // regexp2 with .NET syntax: lookaround, backreferences, atomic groups
+	RegexEngine_REGEX_ECMASCRIPT   RegexEngine = 2 // regexp2 with JavaScript syntax
+)
+
+// Enum value maps for RegexEngine.
+var (
+	RegexEngine_name = map[int32]string{
+		0: "REGEX_RE2",
+		1: "REGEX_BACKTRACKING",
+		2: "REGEX_ECMASCRIPT",
+	}
+	RegexEngine_value = map[string]int32{
+		"REGEX_RE2":          0,
+		"REGEX_BACKTRACKING": 1,
+		"REGEX_ECMASCRIPT":   2,
+	}
+)
+
+func (x RegexEngine) Enum() *RegexEngine {
+	p := new(RegexEngine)
+	*p = x
+	return p
+}
+
+func (x RegexEngine) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RegexEngine) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_privutil_proto_enumTypes[5].Descriptor()
+}
+
+func (RegexEngine) Type() protoreflect.EnumType {
+	return &file_proto_privutil_proto_enumTypes[5]
+}
+
+func (x RegexEngine) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RegexEngine.Descriptor instead.
+func (RegexEngine) EnumDescriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{5}
+}
+
+type SqlDialect int32
+
+const (
+	SqlDialect_SQL_STANDARD   SqlDialect = 0
+	SqlDialect_SQL_POSTGRESQL SqlDialect = 1 // dollar-quoted strings, E'' escapes, nested comments
+	SqlDialect_SQL_MYSQL      SqlDialect = 2 // backtick identifiers, "" strings, # comments
+	SqlDialect_SQL_SQLITE     SqlDialect = 3 // [bracket] identifiers, ?NNN / :name / $name parameters
+	SqlDialect_SQL_BIGQUERY   SqlDialect = 4 // backtick paths, triple-quoted and r'' strings, @params
+)
+
+// Enum value maps for SqlDialect.
+var (
+	SqlDialect_name = map[int32]string{
+		0: "SQL_STANDARD",
+		1: "SQL_POSTGRESQL",
+		2: "SQL_MYSQL",
+		3: "SQL_SQLITE",
+		4: "SQL_BIGQUERY",
+	}
+	SqlDialect_value = map[string]int32{
+		"SQL_STANDARD":   0,
+		"SQL_POSTGRESQL": 1,
+		"SQL_MYSQL":      2,
+		"SQL_SQLITE":     3,
+		"SQL_BIGQUERY":   4,
+	}
+)
+
+func (x SqlDialect) Enum() *SqlDialect {
+	p := new(SqlDialect)
+	*p = x
+	return p
+}
+
+func (x SqlDialect) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SqlDialect) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_privutil_proto_enumTypes[6].Descriptor()
+}
+
+func (SqlDialect) Type() protoreflect.EnumType {
+	return &file_proto_privutil_proto_enumTypes[6]
+}
+
+func (x SqlDialect) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SqlDialect.Descriptor instead.
+func (SqlDialect) EnumDescriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{6}
+}
+
+type SqlKeywordCase int32
+
+const (
+	SqlKeywordCase_SQL_KEYWORDS_UPPER    SqlKeywordCase = 0
+	SqlKeywordCase_SQL_KEYWORDS_LOWER    SqlKeywordCase = 1
+	SqlKeywordCase_SQL_KEYWORDS_PRESERVE SqlKeywordCase = 2
+)
+
+// Enum value maps for SqlKeywordCase.
+var (
+	SqlKeywordCase_name = map[int32]string{
+		0: "SQL_KEYWORDS_UPPER",
+		1: "SQL_KEYWORDS_LOWER",
+		2: "SQL_KEYWORDS_PRESERVE",
+	}
+	SqlKeywordCase_value = map[string]int32{
+		"SQL_KEYWORDS_UPPER":    0,
+		"SQL_KEYWORDS_LOWER":    1,
+		"SQL_KEYWORDS_PRESERVE": 2,
+	}
+)
+
+func (x SqlKeywordCase) Enum() *SqlKeywordCase {
+	p := new(SqlKeywordCase)
+	*p = x
+	return p
+}
+
+func (x SqlKeywordCase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SqlKeywordCase) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_privutil_proto_enumTypes[7].Descriptor()
+}
+
+func (SqlKeywordCase) Type() protoreflect.EnumType {
+	return &file_proto_privutil_proto_enumTypes[7]
+}
+
+func (x SqlKeywordCase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SqlKeywordCase.Descriptor instead.
+func (SqlKeywordCase) EnumDescriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{7}
+}
+
+type SqlLoadStyle int32
+
+const (
+	SqlLoadStyle_SQL_LOAD_INSERT SqlLoadStyle = 0 // batched multi-row INSERT statements
+	SqlLoadStyle_SQL_LOAD_COPY   SqlLoadStyle = 1 // PostgreSQL COPY … FROM stdin in text format
+)
+
+// Enum value maps for SqlLoadStyle.
+var (
+	SqlLoadStyle_name = map[int32]string{
+		0: "SQL_LOAD_INSERT",
+		1: "SQL_LOAD_COPY",
+	}
+	SqlLoadStyle_value = map[string]int32{
+		"SQL_LOAD_INSERT": 0,
+		"SQL_LOAD_COPY":   1,
+	}
+)
+
+func (x SqlLoadStyle) Enum() *SqlLoadStyle {
+	p := new(SqlLoadStyle)
+	*p = x
+	return p
+}
+
+func (x SqlLoadStyle) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SqlLoadStyle) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_privutil_proto_enumTypes[8].Descriptor()
+}
+
+func (SqlLoadStyle) Type() protoreflect.EnumType {
+	return &file_proto_privutil_proto_enumTypes[8]
+}
+
+func (x SqlLoadStyle) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SqlLoadStyle.Descriptor instead.
+func (SqlLoadStyle) EnumDescriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{8}
 }
 
 type TextAction int32
@@ -121,11 +550,11 @@ func (x TextAction) String() string {
 }
 
 func (TextAction) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_privutil_proto_enumTypes[1].Descriptor()
+	return file_proto_privutil_proto_enumTypes[9].Descriptor()
 }
 
 func (TextAction) Type() protoreflect.EnumType {
-	return &file_proto_privutil_proto_enumTypes[1]
+	return &file_proto_privutil_proto_enumTypes[9]
 }
 
 func (x TextAction) Number() protoreflect.EnumNumber {
@@ -134,7 +563,7 @@ func (x TextAction) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use TextAction.Descriptor instead.
 func (TextAction) EnumDescriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{1}
+	return file_proto_privutil_proto_rawDescGZIP(), []int{9}
 }
 
 type ListAction int32
@@ -194,11 +623,11 @@ func (x ListAction) String() string {
 }
 
 func (ListAction) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_privutil_proto_enumTypes[2].Descriptor()
+	return file_proto_privutil_proto_enumTypes[10].Descriptor()
 }
 
 func (ListAction) Type() protoreflect.EnumType {
-	return &file_proto_privutil_proto_enumTypes[2]
+	return &file_proto_privutil_proto_enumTypes[10]
 }
 
 func (x ListAction) Number() protoreflect.EnumNumber {
@@ -207,7 +636,7 @@ func (x ListAction) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ListAction.Descriptor instead.
 func (ListAction) EnumDescriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{2}
+	return file_proto_privutil_proto_rawDescGZIP(), []int{10}
 }
 
 type PercentMode int32
@@ -246,11 +675,11 @@ func (x PercentMode) String() string {
 }
 
 func (PercentMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_privutil_proto_enumTypes[3].Descriptor()
+	return file_proto_privutil_proto_enumTypes[11].Descriptor()
 }
 
 func (PercentMode) Type() protoreflect.EnumType {
-	return &file_proto_privutil_proto_enumTypes[3]
+	return &file_proto_privutil_proto_enumTypes[11]
 }
 
 func (x PercentMode) Number() protoreflect.EnumNumber {
@@ -259,7 +688,7 @@ func (x PercentMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PercentMode.Descriptor instead.
 func (PercentMode) EnumDescriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{3}
+	return file_proto_privutil_proto_rawDescGZIP(), []int{11}
 }
 
 type UnitCategory int32
@@ -304,11 +733,11 @@ func (x UnitCategory) String() string {
 }
 
 func (UnitCategory) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_privutil_proto_enumTypes[4].Descriptor()
+	return file_proto_privutil_proto_enumTypes[12].Descriptor()
 }
 
 func (UnitCategory) Type() protoreflect.EnumType {
-	return &file_proto_privutil_proto_enumTypes[4]
+	return &file_proto_privutil_proto_enumTypes[12]
 }
 
 func (x UnitCategory) Number() protoreflect.EnumNumber {
@@ -317,27 +746,330 @@ func (x UnitCategory) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UnitCategory.Descriptor instead.
 func (UnitCategory) EnumDescriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{4}
-}
-
-type DiffRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text1         string                 `protobuf:"bytes,1,opt,name=text1,proto3" json:"text1,omitempty"`
-	Text2         string                 `protobuf:"bytes,2,opt,name=text2,proto3" json:"text2,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return file_proto_privutil_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *DiffRequest) Reset() {
-	*x = DiffRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[0]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
+type SchemaDraft int32
 
-func (x *DiffRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+const (
+	SchemaDraft_DRAFT_2020_12 SchemaDraft = 0
+	SchemaDraft_DRAFT_2019_09 SchemaDraft = 1
+	SchemaDraft_DRAFT_07      SchemaDraft = 2
+	SchemaDraft_DRAFT_04      SchemaDraft = 3
+)
+
+// Enum value maps for SchemaDraft.
+var (
+	SchemaDraft_name = map[int32]string{
+		0: "DRAFT_2020_12",
+		1: "DRAFT_2019_09",
+		2: "DRAFT_07",
+		3: "DRAFT_04",
+	}
+	SchemaDraft_value = map[string]int32{
+		"DRAFT_2020_12": 0,
+		"DRAFT_2019_09": 1,
+		"DRAFT_07":      2,
+		"DRAFT_04":      3,
+	}
+)
+
+func (x SchemaDraft) Enum() *SchemaDraft {
+	p := new(SchemaDraft)
+	*p = x
+	return p
+}
+
+func (x SchemaDraft) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SchemaDraft) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_privutil_proto_enumTypes[13].Descriptor()
+}
+
+func (SchemaDraft) Type() protoreflect.EnumType {
+	return &file_proto_privutil_proto_enumTypes[13]
+}
+
+func (x SchemaDraft) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SchemaDraft.Descriptor instead.
+func (SchemaDraft) EnumDescriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{13}
+}
+
+type CodeTarget int32
+
+const (
+	CodeTarget_CODE_TYPESCRIPT       CodeTarget = 0 // TypeScript interfaces
+	CodeTarget_CODE_ZOD              CodeTarget = 1 // Zod schemas with inferred types
+	CodeTarget_CODE_RUST_SERDE       CodeTarget = 2 // Rust structs deriving serde
+	CodeTarget_CODE_PYTHON_DATACLASS CodeTarget = 3
+	CodeTarget_CODE_PYTHON_PYDANTIC  CodeTarget = 4
+	CodeTarget_CODE_KOTLIN           CodeTarget = 5 // kotlinx.serialization data classes
+	CodeTarget_CODE_PROTO3           CodeTarget = 6
+)
+
+// Enum value maps for CodeTarget.
+var (
+	CodeTarget_name = map[int32]string{
+		0: "CODE_TYPESCRIPT",
+		1: "CODE_ZOD",
+		2: "CODE_RUST_SERDE",
+		3: "CODE_PYTHON_DATACLASS",
+		4: "CODE_PYTHON_PYDANTIC",
+		5: "CODE_KOTLIN",
+		6: "CODE_PROTO3",
+	}
+	CodeTarget_value = map[string]int32{
+		"CODE_TYPESCRIPT":       0,
+		"CODE_ZOD":              1,
+		"CODE_RUST_SERDE":       2,
+		"CODE_PYTHON_DATACLASS": 3,
+		"CODE_PYTHON_PYDANTIC":  4,
+		"CODE_KOTLIN":           5,
+		"CODE_PROTO3":           6,
+	}
+)
+
+func (x CodeTarget) Enum() *CodeTarget {
+	p := new(CodeTarget)
+	*p = x
+	return p
+}
+
+func (x CodeTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CodeTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_privutil_proto_enumTypes[14].Descriptor()
+}
+
+func (CodeTarget) Type() protoreflect.EnumType {
+	return &file_proto_privutil_proto_enumTypes[14]
+}
+
+func (x CodeTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CodeTarget.Descriptor instead.
+func (CodeTarget) EnumDescriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{14}
+}
+
+type QueryLanguage int32
+
+const (
+	QueryLanguage_QUERY_JSONPATH QueryLanguage = 0 // RFC 9535
+	QueryLanguage_QUERY_JQ       QueryLanguage = 1 // jq, without $ENV, input or modules
+)
+
+// Enum value maps for QueryLanguage.
+var (
+	QueryLanguage_name = map[int32]string{
+		0: "QUERY_JSONPATH",
+		1: "QUERY_JQ",
+	}
+	QueryLanguage_value = map[string]int32{
+		"QUERY_JSONPATH": 0,
+		"QUERY_JQ":       1,
+	}
+)
+
+func (x QueryLanguage) Enum() *QueryLanguage {
+	p := new(QueryLanguage)
+	*p = x
+	return p
+}
+
+func (x QueryLanguage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueryLanguage) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_privutil_proto_enumTypes[15].Descriptor()
+}
+
+func (QueryLanguage) Type() protoreflect.EnumType {
+	return &file_proto_privutil_proto_enumTypes[15]
+}
+
+func (x QueryLanguage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueryLanguage.Descriptor instead.
+func (QueryLanguage) EnumDescriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{15}
+}
+
+type PatchType int32
+
+const (
+	PatchType_PATCH_JSON  PatchType = 0 // RFC 6902 JSON Patch
+	PatchType_PATCH_MERGE PatchType = 1 // RFC 7386 JSON Merge Patch
+)
+
+// Enum value maps for PatchType.
+var (
+	PatchType_name = map[int32]string{
+		0: "PATCH_JSON",
+		1: "PATCH_MERGE",
+	}
+	PatchType_value = map[string]int32{
+		"PATCH_JSON":  0,
+		"PATCH_MERGE": 1,
+	}
+)
+
+func (x PatchType) Enum() *PatchType {
+	p := new(PatchType)
+	*p = x
+	return p
+}
+
+func (x PatchType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PatchType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_privutil_proto_enumTypes[16].Descriptor()
+}
+
+func (PatchType) Type() protoreflect.EnumType {
+	return &file_proto_privutil_proto_enumTypes[16]
+}
+
+func (x PatchType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PatchType.Descriptor instead.
+func (PatchType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{16}
+}
+
+type MergeMode int32
+
+const (
+	MergeMode_MERGE_LINES      MergeMode = 0 // line-based, with git-style conflict markers
+	MergeMode_MERGE_STRUCTURED MergeMode = 1 // parse all three as documents and merge key by key
+)
+
+// Enum value maps for MergeMode.
+var (
+	MergeMode_name = map[int32]string{
+		0: "MERGE_LINES",
+		1: "MERGE_STRUCTURED",
+	}
+	MergeMode_value = map[string]int32{
+		"MERGE_LINES":      0,
+		"MERGE_STRUCTURED": 1,
+	}
+)
+
+func (x MergeMode) Enum() *MergeMode {
+	p := new(MergeMode)
+	*p = x
+	return p
+}
+
+func (x MergeMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MergeMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_privutil_proto_enumTypes[17].Descriptor()
+}
+
+func (MergeMode) Type() protoreflect.EnumType {
+	return &file_proto_privutil_proto_enumTypes[17]
+}
+
+func (x MergeMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MergeMode.Descriptor instead.
+func (MergeMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{17}
+}
+
+type TextPatchFormat int32
+
+const (
+	TextPatchFormat_TEXT_PATCH_UNIFIED TextPatchFormat = 0 // diff -u / git diff
+	TextPatchFormat_TEXT_PATCH_DMP     TextPatchFormat = 1 // diff-match-patch patch_toText
+)
+
+// Enum value maps for TextPatchFormat.
+var (
+	TextPatchFormat_name = map[int32]string{
+		0: "TEXT_PATCH_UNIFIED",
+		1: "TEXT_PATCH_DMP",
+	}
+	TextPatchFormat_value = map[string]int32{
+		"TEXT_PATCH_UNIFIED": 0,
+		"TEXT_PATCH_DMP":     1,
+	}
+)
+
+func (x TextPatchFormat) Enum() *TextPatchFormat {
+	p := new(TextPatchFormat)
+	*p = x
+	return p
+}
+
+func (x TextPatchFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TextPatchFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_privutil_proto_enumTypes[18].Descriptor()
+}
+
+func (TextPatchFormat) Type() protoreflect.EnumType {
+	return &file_proto_privutil_proto_enumTypes[18]
+}
+
+func (x TextPatchFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TextPatchFormat.Descriptor instead.
+func (TextPatchFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{18}
+}
+
+type DiffRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Text1            string                 `protobuf:"bytes,1,opt,name=text1,proto3" json:"text1,omitempty"`
+	Text2            string                 `protobuf:"bytes,2,opt,name=text2,proto3" json:"text2,omitempty"`
+	Mode             DiffMode               `protobuf:"varint,3,opt,name=mode,proto3,enum=privutil.DiffMode" json:"mode,omitempty"`                          // granularity of diff_html, inserted and deleted
+	IgnoreWhitespace bool                   `protobuf:"varint,4,opt,name=ignore_whitespace,json=ignoreWhitespace,proto3" json:"ignore_whitespace,omitempty"` // lines: ignore all whitespace; words and characters: any run matches any other
+	IgnoreCase       bool                   `protobuf:"varint,5,opt,name=ignore_case,json=ignoreCase,proto3" json:"ignore_case,omitempty"`
+	ContextLines     int32                  `protobuf:"varint,6,opt,name=context_lines,json=contextLines,proto3" json:"context_lines,omitempty"` // unchanged lines around each hunk; defaults to 3, -1 for none
+	Label1           string                 `protobuf:"bytes,7,opt,name=label1,proto3" json:"label1,omitempty"`                                  // "---" label of the unified diff; defaults to "a"
+	Label2           string                 `protobuf:"bytes,8,opt,name=label2,proto3" json:"label2,omitempty"`                                  // "+++" label; defaults to "b"
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DiffRequest) Reset() {
+	*x = DiffRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
 func (*DiffRequest) ProtoMessage() {}
 
@@ -372,9 +1104,59 @@ func (x *DiffRequest) GetText2() string {
 	return ""
 }
 
+func (x *DiffRequest) GetMode() DiffMode {
+	if x != nil {
+		return x.Mode
+	}
+	return DiffMode_DIFF_CHARACTER
+}
+
+func (x *DiffRequest) GetIgnoreWhitespace() bool {
+	if x != nil {
+		return x.IgnoreWhitespace
+	}
+	return false
+}
+
+func (x *DiffRequest) GetIgnoreCase() bool {
+	if x != nil {
+		return x.IgnoreCase
+	}
+	return false
+}
+
+func (x *DiffRequest) GetContextLines() int32 {
+	if x != nil {
+		return x.ContextLines
+	}
+	return 0
+}
+
+func (x *DiffRequest) GetLabel1() string {
+	if x != nil {
+		return x.Label1
+	}
+	return ""
+}
+
+func (x *DiffRequest) GetLabel2() string {
+	if x != nil {
+		return x.Label2
+	}
+	return ""
+}
+
 type DiffResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DiffHtml      string                 `protobuf:"bytes,1,opt,name=diff_html,json=diffHtml,proto3" json:"diff_html,omitempty"`
+	Unified       string                 `protobuf:"bytes,2,opt,name=unified,proto3" json:"unified,omitempty"`    // line-based; empty when identical
+	Hunks         []*DiffHunk            `protobuf:"bytes,3,rep,name=hunks,proto3" json:"hunks,omitempty"`        // the unified hunks, laid out side by side
+	Inserted      int32                  `protobuf:"varint,4,opt,name=inserted,proto3" json:"inserted,omitempty"` // characters, words or lines per mode; whitespace is not counted as words
+	Deleted       int32                  `protobuf:"varint,5,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	LinesAdded    int32                  `protobuf:"varint,6,opt,name=lines_added,json=linesAdded,proto3" json:"lines_added,omitempty"`
+	LinesRemoved  int32                  `protobuf:"varint,7,opt,name=lines_removed,json=linesRemoved,proto3" json:"lines_removed,omitempty"`
+	Identical     bool                   `protobuf:"varint,8,opt,name=identical,proto3" json:"identical,omitempty"` // no differences under the ignore options
+	Error         string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -416,28 +1198,88 @@ func (x *DiffResponse) GetDiffHtml() string {
 	return ""
 }
 
-type Base64Request struct {
+func (x *DiffResponse) GetUnified() string {
+	if x != nil {
+		return x.Unified
+	}
+	return ""
+}
+
+func (x *DiffResponse) GetHunks() []*DiffHunk {
+	if x != nil {
+		return x.Hunks
+	}
+	return nil
+}
+
+func (x *DiffResponse) GetInserted() int32 {
+	if x != nil {
+		return x.Inserted
+	}
+	return 0
+}
+
+func (x *DiffResponse) GetDeleted() int32 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+func (x *DiffResponse) GetLinesAdded() int32 {
+	if x != nil {
+		return x.LinesAdded
+	}
+	return 0
+}
+
+func (x *DiffResponse) GetLinesRemoved() int32 {
+	if x != nil {
+		return x.LinesRemoved
+	}
+	return 0
+}
+
+func (x *DiffResponse) GetIdentical() bool {
+	if x != nil {
+		return x.Identical
+	}
+	return false
+}
+
+func (x *DiffResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type DiffHunk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Raw           []byte                 `protobuf:"bytes,3,opt,name=raw,proto3" json:"raw,omitempty"` // binary input for file encode
+	OldStart      int32                  `protobuf:"varint,1,opt,name=old_start,json=oldStart,proto3" json:"old_start,omitempty"` // 1-based; with old_lines 0 it is the line the insertion follows
+	OldLines      int32                  `protobuf:"varint,2,opt,name=old_lines,json=oldLines,proto3" json:"old_lines,omitempty"`
+	NewStart      int32                  `protobuf:"varint,3,opt,name=new_start,json=newStart,proto3" json:"new_start,omitempty"`
+	NewLines      int32                  `protobuf:"varint,4,opt,name=new_lines,json=newLines,proto3" json:"new_lines,omitempty"`
+	Header        string                 `protobuf:"bytes,5,opt,name=header,proto3" json:"header,omitempty"` // "@@ -3,5 +3,6 @@"
+	Rows          []*DiffRow             `protobuf:"bytes,6,rep,name=rows,proto3" json:"rows,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Base64Request) Reset() {
-	*x = Base64Request{}
+func (x *DiffHunk) Reset() {
+	*x = DiffHunk{}
 	mi := &file_proto_privutil_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Base64Request) String() string {
+func (x *DiffHunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Base64Request) ProtoMessage() {}
+func (*DiffHunk) ProtoMessage() {}
 
-func (x *Base64Request) ProtoReflect() protoreflect.Message {
+func (x *DiffHunk) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_privutil_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -449,28 +1291,184 @@ func (x *Base64Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Base64Request.ProtoReflect.Descriptor instead.
-func (*Base64Request) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiffHunk.ProtoReflect.Descriptor instead.
+func (*DiffHunk) Descriptor() ([]byte, []int) {
 	return file_proto_privutil_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Base64Request) GetText() string {
+func (x *DiffHunk) GetOldStart() int32 {
 	if x != nil {
-		return x.Text
+		return x.OldStart
 	}
-	return ""
+	return 0
 }
 
-func (x *Base64Request) GetRaw() []byte {
+func (x *DiffHunk) GetOldLines() int32 {
 	if x != nil {
-		return x.Raw
+		return x.OldLines
 	}
-	return nil
+	return 0
 }
 
-type Base64Response struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"` // base64 string (encode output)
+func (x *DiffHunk) GetNewStart() int32 {
+	if x != nil {
+		return x.NewStart
+	}
+	return 0
+}
+
+func (x *DiffHunk) GetNewLines() int32 {
+	if x != nil {
+		return x.NewLines
+	}
+	return 0
+}
+
+func (x *DiffHunk) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+func (x *DiffHunk) GetRows() []*DiffRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type DiffRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`                       // "equal", "delete", "insert", or "change" pairing a removed line with its replacement
+	OldLine       int32                  `protobuf:"varint,2,opt,name=old_line,json=oldLine,proto3" json:"old_line,omitempty"` // 1-based; 0 on the side the row does not have
+	OldText       string                 `protobuf:"bytes,3,opt,name=old_text,json=oldText,proto3" json:"old_text,omitempty"`  // without the line break
+	NewLine       int32                  `protobuf:"varint,4,opt,name=new_line,json=newLine,proto3" json:"new_line,omitempty"`
+	NewText       string                 `protobuf:"bytes,5,opt,name=new_text,json=newText,proto3" json:"new_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffRow) Reset() {
+	*x = DiffRow{}
+	mi := &file_proto_privutil_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffRow) ProtoMessage() {}
+
+func (x *DiffRow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffRow.ProtoReflect.Descriptor instead.
+func (*DiffRow) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DiffRow) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *DiffRow) GetOldLine() int32 {
+	if x != nil {
+		return x.OldLine
+	}
+	return 0
+}
+
+func (x *DiffRow) GetOldText() string {
+	if x != nil {
+		return x.OldText
+	}
+	return ""
+}
+
+func (x *DiffRow) GetNewLine() int32 {
+	if x != nil {
+		return x.NewLine
+	}
+	return 0
+}
+
+func (x *DiffRow) GetNewText() string {
+	if x != nil {
+		return x.NewText
+	}
+	return ""
+}
+
+type Base64Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Raw           []byte                 `protobuf:"bytes,3,opt,name=raw,proto3" json:"raw,omitempty"` // binary input for file encode
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Base64Request) Reset() {
+	*x = Base64Request{}
+	mi := &file_proto_privutil_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Base64Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Base64Request) ProtoMessage() {}
+
+func (x *Base64Request) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Base64Request.ProtoReflect.Descriptor instead.
+func (*Base64Request) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Base64Request) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Base64Request) GetRaw() []byte {
+	if x != nil {
+		return x.Raw
+	}
+	return nil
+}
+
+type Base64Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"` // base64 string (encode output)
 	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`                         // decoded binary (decode output)
 	MimeType      string                 `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"` // detected MIME type of decoded data
@@ -480,7 +1478,7 @@ type Base64Response struct {
 
 func (x *Base64Response) Reset() {
 	*x = Base64Response{}
-	mi := &file_proto_privutil_proto_msgTypes[3]
+	mi := &file_proto_privutil_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -492,7 +1490,3849 @@ func (x *Base64Response) String() string {
 func (*Base64Response) ProtoMessage() {}
 
 func (x *Base64Response) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[3]
+	mi := &file_proto_privutil_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Base64Response.ProtoReflect.Descriptor instead.
+func (*Base64Response) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Base64Response) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Base64Response) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Base64Response) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Base64Response) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+type JsonFormatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Indent        string                 `protobuf:"bytes,2,opt,name=indent,proto3" json:"indent,omitempty"`                      // "2", "4", "tab", or "min" (minify)
+	SortKeys      bool                   `protobuf:"varint,3,opt,name=sort_keys,json=sortKeys,proto3" json:"sort_keys,omitempty"` // sort object keys recursively; source order otherwise
+	Canonical     bool                   `protobuf:"varint,4,opt,name=canonical,proto3" json:"canonical,omitempty"`               // RFC 8785 (JCS) output; implies sorted keys and minify
+	Lenient       bool                   `protobuf:"varint,5,opt,name=lenient,proto3" json:"lenient,omitempty"`                   // accept JSONC/JSON5: comments, trailing commas, single quotes…
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JsonFormatRequest) Reset() {
+	*x = JsonFormatRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JsonFormatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JsonFormatRequest) ProtoMessage() {}
+
+func (x *JsonFormatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JsonFormatRequest.ProtoReflect.Descriptor instead.
+func (*JsonFormatRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *JsonFormatRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *JsonFormatRequest) GetIndent() string {
+	if x != nil {
+		return x.Indent
+	}
+	return ""
+}
+
+func (x *JsonFormatRequest) GetSortKeys() bool {
+	if x != nil {
+		return x.SortKeys
+	}
+	return false
+}
+
+func (x *JsonFormatRequest) GetCanonical() bool {
+	if x != nil {
+		return x.Canonical
+	}
+	return false
+}
+
+func (x *JsonFormatRequest) GetLenient() bool {
+	if x != nil {
+		return x.Lenient
+	}
+	return false
+}
+
+type JsonFormatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorLine     int32                  `protobuf:"varint,3,opt,name=error_line,json=errorLine,proto3" json:"error_line,omitempty"`       // 1-based; 0 when unknown
+	ErrorColumn   int32                  `protobuf:"varint,4,opt,name=error_column,json=errorColumn,proto3" json:"error_column,omitempty"` // 1-based; 0 when unknown
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JsonFormatResponse) Reset() {
+	*x = JsonFormatResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JsonFormatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JsonFormatResponse) ProtoMessage() {}
+
+func (x *JsonFormatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JsonFormatResponse.ProtoReflect.Descriptor instead.
+func (*JsonFormatResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *JsonFormatResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *JsonFormatResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *JsonFormatResponse) GetErrorLine() int32 {
+	if x != nil {
+		return x.ErrorLine
+	}
+	return 0
+}
+
+func (x *JsonFormatResponse) GetErrorColumn() int32 {
+	if x != nil {
+		return x.ErrorColumn
+	}
+	return 0
+}
+
+type ConvertRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Data            string                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	SourceFormat    DataFormat             `protobuf:"varint,2,opt,name=source_format,json=sourceFormat,proto3,enum=privutil.DataFormat" json:"source_format,omitempty"`
+	TargetFormat    DataFormat             `protobuf:"varint,3,opt,name=target_format,json=targetFormat,proto3,enum=privutil.DataFormat" json:"target_format,omitempty"`
+	CsvDelimiter    string                 `protobuf:"bytes,4,opt,name=csv_delimiter,json=csvDelimiter,proto3" json:"csv_delimiter,omitempty"`                                           // single character; defaults to ","
+	CsvNoHeader     bool                   `protobuf:"varint,5,opt,name=csv_no_header,json=csvNoHeader,proto3" json:"csv_no_header,omitempty"`                                           // if true, CSV rows are arrays instead of objects
+	BinaryEncoding  BinaryEncoding         `protobuf:"varint,6,opt,name=binary_encoding,json=binaryEncoding,proto3,enum=privutil.BinaryEncoding" json:"binary_encoding,omitempty"`       // text encoding of MSGPACK/CBOR/BSON input and output
+	BinaryJsonStyle BinaryJsonStyle        `protobuf:"varint,7,opt,name=binary_json_style,json=binaryJsonStyle,proto3,enum=privutil.BinaryJsonStyle" json:"binary_json_style,omitempty"` // representation of decoded binary-only types
+	SortKeys        bool                   `protobuf:"varint,8,opt,name=sort_keys,json=sortKeys,proto3" json:"sort_keys,omitempty"`                                                      // otherwise JSON, YAML and CSV input keep their key order
+	XmlAttrPrefix   string                 `protobuf:"bytes,9,opt,name=xml_attr_prefix,json=xmlAttrPrefix,proto3" json:"xml_attr_prefix,omitempty"`                                      // key prefix marking attributes; defaults to "-"
+	XmlTextKey      string                 `protobuf:"bytes,10,opt,name=xml_text_key,json=xmlTextKey,proto3" json:"xml_text_key,omitempty"`                                              // key holding element text; defaults to "#text"
+	XmlRoot         string                 `protobuf:"bytes,11,opt,name=xml_root,json=xmlRoot,proto3" json:"xml_root,omitempty"`                                                         // root element name; defaults to the single top-level key, else "doc"
+	XmlArrayItem    string                 `protobuf:"bytes,12,opt,name=xml_array_item,json=xmlArrayItem,proto3" json:"xml_array_item,omitempty"`                                        // element name for root and nested array items; defaults to "item"
+	YamlIndent      int32                  `protobuf:"varint,13,opt,name=yaml_indent,json=yamlIndent,proto3" json:"yaml_indent,omitempty"`                                               // 2-9; defaults to 4
+	YamlFlowStyle   bool                   `protobuf:"varint,14,opt,name=yaml_flow_style,json=yamlFlowStyle,proto3" json:"yaml_flow_style,omitempty"`                                    // emit {a: 1, b: [x, y]} instead of block style
+	CsvQuoting      CsvQuoting             `protobuf:"varint,15,opt,name=csv_quoting,json=csvQuoting,proto3,enum=privutil.CsvQuoting" json:"csv_quoting,omitempty"`
+	CsvFlatten      bool                   `protobuf:"varint,16,opt,name=csv_flatten,json=csvFlatten,proto3" json:"csv_flatten,omitempty"`            // nested fields become "a.b" / "a[0]" columns, and back on input
+	CsvInferTypes   bool                   `protobuf:"varint,17,opt,name=csv_infer_types,json=csvInferTypes,proto3" json:"csv_infer_types,omitempty"` // read numbers, booleans and empty/null cells as typed values
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ConvertRequest) Reset() {
+	*x = ConvertRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertRequest) ProtoMessage() {}
+
+func (x *ConvertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertRequest.ProtoReflect.Descriptor instead.
+func (*ConvertRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ConvertRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *ConvertRequest) GetSourceFormat() DataFormat {
+	if x != nil {
+		return x.SourceFormat
+	}
+	return DataFormat_JSON
+}
+
+func (x *ConvertRequest) GetTargetFormat() DataFormat {
+	if x != nil {
+		return x.TargetFormat
+	}
+	return DataFormat_JSON
+}
+
+func (x *ConvertRequest) GetCsvDelimiter() string {
+	if x != nil {
+		return x.CsvDelimiter
+	}
+	return ""
+}
+
+func (x *ConvertRequest) GetCsvNoHeader() bool {
+	if x != nil {
+		return x.CsvNoHeader
+	}
+	return false
+}
+
+func (x *ConvertRequest) GetBinaryEncoding() BinaryEncoding {
+	if x != nil {
+		return x.BinaryEncoding
+	}
+	return BinaryEncoding_BINARY_BASE64
+}
+
+func (x *ConvertRequest) GetBinaryJsonStyle() BinaryJsonStyle {
+	if x != nil {
+		return x.BinaryJsonStyle
+	}
+	return BinaryJsonStyle_BINARY_JSON_EXTENDED
+}
+
+func (x *ConvertRequest) GetSortKeys() bool {
+	if x != nil {
+		return x.SortKeys
+	}
+	return false
+}
+
+func (x *ConvertRequest) GetXmlAttrPrefix() string {
+	if x != nil {
+		return x.XmlAttrPrefix
+	}
+	return ""
+}
+
+func (x *ConvertRequest) GetXmlTextKey() string {
+	if x != nil {
+		return x.XmlTextKey
+	}
+	return ""
+}
+
+func (x *ConvertRequest) GetXmlRoot() string {
+	if x != nil {
+		return x.XmlRoot
+	}
+	return ""
+}
+
+func (x *ConvertRequest) GetXmlArrayItem() string {
+	if x != nil {
+		return x.XmlArrayItem
+	}
+	return ""
+}
+
+func (x *ConvertRequest) GetYamlIndent() int32 {
+	if x != nil {
+		return x.YamlIndent
+	}
+	return 0
+}
+
+func (x *ConvertRequest) GetYamlFlowStyle() bool {
+	if x != nil {
+		return x.YamlFlowStyle
+	}
+	return false
+}
+
+func (x *ConvertRequest) GetCsvQuoting() CsvQuoting {
+	if x != nil {
+		return x.CsvQuoting
+	}
+	return CsvQuoting_CSV_QUOTE_MINIMAL
+}
+
+func (x *ConvertRequest) GetCsvFlatten() bool {
+	if x != nil {
+		return x.CsvFlatten
+	}
+	return false
+}
+
+func (x *ConvertRequest) GetCsvInferTypes() bool {
+	if x != nil {
+		return x.CsvInferTypes
+	}
+	return false
+}
+
+type ConvertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          string                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertResponse) Reset() {
+	*x = ConvertResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertResponse) ProtoMessage() {}
+
+func (x *ConvertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertResponse.ProtoReflect.Descriptor instead.
+func (*ConvertResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ConvertResponse) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *ConvertResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ValidateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          string                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Format        DataFormat             `protobuf:"varint,2,opt,name=format,proto3,enum=privutil.DataFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateRequest) Reset() {
+	*x = ValidateRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRequest) ProtoMessage() {}
+
+func (x *ValidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateRequest.ProtoReflect.Descriptor instead.
+func (*ValidateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ValidateRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *ValidateRequest) GetFormat() DataFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DataFormat_JSON
+}
+
+type ValidateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"` // first error; line and column locate it
+	Line          int32                  `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`
+	Column        int32                  `protobuf:"varint,4,opt,name=column,proto3" json:"column,omitempty"`
+	Errors        []*ValidationIssue     `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	Warnings      []*ValidationIssue     `protobuf:"bytes,6,rep,name=warnings,proto3" json:"warnings,omitempty"`    // reported even when valid
+	Documents     int32                  `protobuf:"varint,7,opt,name=documents,proto3" json:"documents,omitempty"` // documents read from a YAML stream
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateResponse) Reset() {
+	*x = ValidateResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateResponse) ProtoMessage() {}
+
+func (x *ValidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateResponse.ProtoReflect.Descriptor instead.
+func (*ValidateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ValidateResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ValidateResponse) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ValidateResponse) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *ValidateResponse) GetErrors() []*ValidationIssue {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetWarnings() []*ValidationIssue {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *ValidateResponse) GetDocuments() int32 {
+	if x != nil {
+		return x.Documents
+	}
+	return 0
+}
+
+type ValidationIssue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Line          int32                  `protobuf:"varint,2,opt,name=line,proto3" json:"line,omitempty"`         // 1-based; 0 when unknown
+	Column        int32                  `protobuf:"varint,3,opt,name=column,proto3" json:"column,omitempty"`     // 1-based; 0 when unknown
+	Document      int32                  `protobuf:"varint,4,opt,name=document,proto3" json:"document,omitempty"` // 1-based YAML document; 0 when not document-specific
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidationIssue) Reset() {
+	*x = ValidationIssue{}
+	mi := &file_proto_privutil_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidationIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidationIssue) ProtoMessage() {}
+
+func (x *ValidationIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidationIssue.ProtoReflect.Descriptor instead.
+func (*ValidationIssue) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ValidationIssue) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ValidationIssue) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ValidationIssue) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *ValidationIssue) GetDocument() int32 {
+	if x != nil {
+		return x.Document
+	}
+	return 0
+}
+
+type UuidRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hyphen        bool                   `protobuf:"varint,1,opt,name=hyphen,proto3" json:"hyphen,omitempty"`
+	Uppercase     bool                   `protobuf:"varint,2,opt,name=uppercase,proto3" json:"uppercase,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Version       string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`     // "v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8"
+	Namespace     string                 `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"` // For v3, v5, v8: "dns", "url", "oid", "x500" (default: "dns")
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UuidRequest) Reset() {
+	*x = UuidRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UuidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UuidRequest) ProtoMessage() {}
+
+func (x *UuidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UuidRequest.ProtoReflect.Descriptor instead.
+func (*UuidRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UuidRequest) GetHyphen() bool {
+	if x != nil {
+		return x.Hyphen
+	}
+	return false
+}
+
+func (x *UuidRequest) GetUppercase() bool {
+	if x != nil {
+		return x.Uppercase
+	}
+	return false
+}
+
+func (x *UuidRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *UuidRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *UuidRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type UuidResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Uuids         []string               `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UuidResponse) Reset() {
+	*x = UuidResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UuidResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UuidResponse) ProtoMessage() {}
+
+func (x *UuidResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UuidResponse.ProtoReflect.Descriptor instead.
+func (*UuidResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UuidResponse) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+type LoremRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // "word", "sentence", "paragraph"
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoremRequest) Reset() {
+	*x = LoremRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoremRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoremRequest) ProtoMessage() {}
+
+func (x *LoremRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoremRequest.ProtoReflect.Descriptor instead.
+func (*LoremRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LoremRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LoremRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type LoremResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoremResponse) Reset() {
+	*x = LoremResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoremResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoremResponse) ProtoMessage() {}
+
+func (x *LoremResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoremResponse.ProtoReflect.Descriptor instead.
+func (*LoremResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LoremResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type FakeDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schema        string                 `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`                                    // JSON Schema, or a field template such as {"name":"person.fullName"}
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`                                     // records to generate; defaults to 10, at most 10000
+	Format        DataFormat             `protobuf:"varint,3,opt,name=format,proto3,enum=privutil.DataFormat" json:"format,omitempty"`          // output format; records are an array (wrapped in "records" where needed)
+	Seed          int64                  `protobuf:"varint,4,opt,name=seed,proto3" json:"seed,omitempty"`                                       // 0 picks a random seed, returned in the response
+	Locale        string                 `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`                                    // e.g. "en_US" (default), "es_MX", "de_DE"
+	ReferenceDate string                 `protobuf:"bytes,6,opt,name=reference_date,json=referenceDate,proto3" json:"reference_date,omitempty"` // YYYY-MM-DD or RFC 3339 anchor for relative dates; defaults to today (UTC)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FakeDataRequest) Reset() {
+	*x = FakeDataRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FakeDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FakeDataRequest) ProtoMessage() {}
+
+func (x *FakeDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FakeDataRequest.ProtoReflect.Descriptor instead.
+func (*FakeDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *FakeDataRequest) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *FakeDataRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *FakeDataRequest) GetFormat() DataFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DataFormat_JSON
+}
+
+func (x *FakeDataRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *FakeDataRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *FakeDataRequest) GetReferenceDate() string {
+	if x != nil {
+		return x.ReferenceDate
+	}
+	return ""
+}
+
+type FakeDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          string                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Seed          int64                  `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Locales       []string               `protobuf:"bytes,4,rep,name=locales,proto3" json:"locales,omitempty"`       // available locale codes
+	Generators    []string               `protobuf:"bytes,5,rep,name=generators,proto3" json:"generators,omitempty"` // available template generators
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FakeDataResponse) Reset() {
+	*x = FakeDataResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FakeDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FakeDataResponse) ProtoMessage() {}
+
+func (x *FakeDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FakeDataResponse.ProtoReflect.Descriptor instead.
+func (*FakeDataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FakeDataResponse) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *FakeDataResponse) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *FakeDataResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *FakeDataResponse) GetLocales() []string {
+	if x != nil {
+		return x.Locales
+	}
+	return nil
+}
+
+func (x *FakeDataResponse) GetGenerators() []string {
+	if x != nil {
+		return x.Generators
+	}
+	return nil
+}
+
+type HashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Algo          string                 `protobuf:"bytes,2,opt,name=algo,proto3" json:"algo,omitempty"` // "md5", "sha1", "sha256", "sha512", "bcrypt"
+	Cost          *int32                 `protobuf:"varint,3,opt,name=cost,proto3,oneof" json:"cost,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HashRequest) Reset() {
+	*x = HashRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashRequest) ProtoMessage() {}
+
+func (x *HashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashRequest.ProtoReflect.Descriptor instead.
+func (*HashRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *HashRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *HashRequest) GetAlgo() string {
+	if x != nil {
+		return x.Algo
+	}
+	return ""
+}
+
+func (x *HashRequest) GetCost() int32 {
+	if x != nil && x.Cost != nil {
+		return *x.Cost
+	}
+	return 0
+}
+
+type HashResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HashResponse) Reset() {
+	*x = HashResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HashResponse) ProtoMessage() {}
+
+func (x *HashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HashResponse.ProtoReflect.Descriptor instead.
+func (*HashResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *HashResponse) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type TextRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextRequest) Reset() {
+	*x = TextRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextRequest) ProtoMessage() {}
+
+func (x *TextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextRequest.ProtoReflect.Descriptor instead.
+func (*TextRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TextRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type TextResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextResponse) Reset() {
+	*x = TextResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextResponse) ProtoMessage() {}
+
+func (x *TextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextResponse.ProtoReflect.Descriptor instead.
+func (*TextResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TextResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type TimeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         string                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"` // Can be timestamp (int string) or ISO date
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeRequest) Reset() {
+	*x = TimeRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeRequest) ProtoMessage() {}
+
+func (x *TimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeRequest.ProtoReflect.Descriptor instead.
+func (*TimeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TimeRequest) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+type TimeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Unix          int64                  `protobuf:"varint,1,opt,name=unix,proto3" json:"unix,omitempty"`
+	Utc           string                 `protobuf:"bytes,2,opt,name=utc,proto3" json:"utc,omitempty"`
+	Local         string                 `protobuf:"bytes,3,opt,name=local,proto3" json:"local,omitempty"`
+	Iso           string                 `protobuf:"bytes,4,opt,name=iso,proto3" json:"iso,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeResponse) Reset() {
+	*x = TimeResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeResponse) ProtoMessage() {}
+
+func (x *TimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeResponse.ProtoReflect.Descriptor instead.
+func (*TimeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TimeResponse) GetUnix() int64 {
+	if x != nil {
+		return x.Unix
+	}
+	return 0
+}
+
+func (x *TimeResponse) GetUtc() string {
+	if x != nil {
+		return x.Utc
+	}
+	return ""
+}
+
+func (x *TimeResponse) GetLocal() string {
+	if x != nil {
+		return x.Local
+	}
+	return ""
+}
+
+func (x *TimeResponse) GetIso() string {
+	if x != nil {
+		return x.Iso
+	}
+	return ""
+}
+
+type JwtRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JwtRequest) Reset() {
+	*x = JwtRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JwtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JwtRequest) ProtoMessage() {}
+
+func (x *JwtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JwtRequest.ProtoReflect.Descriptor instead.
+func (*JwtRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *JwtRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type JwtResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Header        string                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Payload       string                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JwtResponse) Reset() {
+	*x = JwtResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JwtResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JwtResponse) ProtoMessage() {}
+
+func (x *JwtResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JwtResponse.ProtoReflect.Descriptor instead.
+func (*JwtResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *JwtResponse) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+func (x *JwtResponse) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *JwtResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RegexRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pattern       string                 `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Flags         string                 `protobuf:"bytes,3,opt,name=flags,proto3" json:"flags,omitempty"` // any of i (ignore case), m (multi-line), s (. matches \n), U (ungreedy; RE2 only)
+	Engine        RegexEngine            `protobuf:"varint,4,opt,name=engine,proto3,enum=privutil.RegexEngine" json:"engine,omitempty"`
+	Replacement   *string                `protobuf:"bytes,5,opt,name=replacement,proto3,oneof" json:"replacement,omitempty"`         // preview replacing every match; $1, ${1}, ${name} and $$ expand
+	TimeoutMs     int32                  `protobuf:"varint,6,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"` // backtracking engines: 0 means 1000, at most 10000
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegexRequest) Reset() {
+	*x = RegexRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegexRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegexRequest) ProtoMessage() {}
+
+func (x *RegexRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegexRequest.ProtoReflect.Descriptor instead.
+func (*RegexRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RegexRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *RegexRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *RegexRequest) GetFlags() string {
+	if x != nil {
+		return x.Flags
+	}
+	return ""
+}
+
+func (x *RegexRequest) GetEngine() RegexEngine {
+	if x != nil {
+		return x.Engine
+	}
+	return RegexEngine_REGEX_RE2
+}
+
+func (x *RegexRequest) GetReplacement() string {
+	if x != nil && x.Replacement != nil {
+		return *x.Replacement
+	}
+	return ""
+}
+
+func (x *RegexRequest) GetTimeoutMs() int32 {
+	if x != nil {
+		return x.TimeoutMs
+	}
+	return 0
+}
+
+type RegexGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        int32                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`        // empty for unnamed groups
+	Matched       bool                   `protobuf:"varint,3,opt,name=matched,proto3" json:"matched,omitempty"` // false when the group took no part in the match
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Start         int32                  `protobuf:"varint,5,opt,name=start,proto3" json:"start,omitempty"` // byte offsets into text, end exclusive
+	End           int32                  `protobuf:"varint,6,opt,name=end,proto3" json:"end,omitempty"`
+	RuneStart     int32                  `protobuf:"varint,7,opt,name=rune_start,json=runeStart,proto3" json:"rune_start,omitempty"` // the same in code points
+	RuneEnd       int32                  `protobuf:"varint,8,opt,name=rune_end,json=runeEnd,proto3" json:"rune_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegexGroup) Reset() {
+	*x = RegexGroup{}
+	mi := &file_proto_privutil_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegexGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegexGroup) ProtoMessage() {}
+
+func (x *RegexGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegexGroup.ProtoReflect.Descriptor instead.
+func (*RegexGroup) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RegexGroup) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *RegexGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RegexGroup) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+func (x *RegexGroup) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *RegexGroup) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *RegexGroup) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *RegexGroup) GetRuneStart() int32 {
+	if x != nil {
+		return x.RuneStart
+	}
+	return 0
+}
+
+func (x *RegexGroup) GetRuneEnd() int32 {
+	if x != nil {
+		return x.RuneEnd
+	}
+	return 0
+}
+
+type RegexMatchDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*RegexGroup          `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"` // groups[0] is the whole match
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegexMatchDetail) Reset() {
+	*x = RegexMatchDetail{}
+	mi := &file_proto_privutil_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegexMatchDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegexMatchDetail) ProtoMessage() {}
+
+func (x *RegexMatchDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegexMatchDetail.ProtoReflect.Descriptor instead.
+func (*RegexMatchDetail) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RegexMatchDetail) GetGroups() []*RegexGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type RegexResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Match         bool                   `protobuf:"varint,1,opt,name=match,proto3" json:"match,omitempty"`
+	Matches       []string               `protobuf:"bytes,2,rep,name=matches,proto3" json:"matches,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Details       []*RegexMatchDetail    `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	GroupNames    []string               `protobuf:"bytes,5,rep,name=group_names,json=groupNames,proto3" json:"group_names,omitempty"` // by group number; empty for group 0 and unnamed groups
+	Replaced      string                 `protobuf:"bytes,6,opt,name=replaced,proto3" json:"replaced,omitempty"`                       // set when replacement is
+	Truncated     bool                   `protobuf:"varint,7,opt,name=truncated,proto3" json:"truncated,omitempty"`                    // only the first 1000 matches are listed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegexResponse) Reset() {
+	*x = RegexResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegexResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegexResponse) ProtoMessage() {}
+
+func (x *RegexResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegexResponse.ProtoReflect.Descriptor instead.
+func (*RegexResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RegexResponse) GetMatch() bool {
+	if x != nil {
+		return x.Match
+	}
+	return false
+}
+
+func (x *RegexResponse) GetMatches() []string {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *RegexResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RegexResponse) GetDetails() []*RegexMatchDetail {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *RegexResponse) GetGroupNames() []string {
+	if x != nil {
+		return x.GroupNames
+	}
+	return nil
+}
+
+func (x *RegexResponse) GetReplaced() string {
+	if x != nil {
+		return x.Replaced
+	}
+	return ""
+}
+
+func (x *RegexResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type JsonToGoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Json          string                 `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
+	StructName    string                 `protobuf:"bytes,2,opt,name=struct_name,json=structName,proto3" json:"struct_name,omitempty"`
+	InlineTypes   bool                   `protobuf:"varint,3,opt,name=inline_types,json=inlineTypes,proto3" json:"inline_types,omitempty"` // nest anonymous structs instead of emitting named types
+	UsePointers   bool                   `protobuf:"varint,4,opt,name=use_pointers,json=usePointers,proto3" json:"use_pointers,omitempty"` // optional/nullable fields become pointers (default: omitempty only)
+	YamlTags      bool                   `protobuf:"varint,5,opt,name=yaml_tags,json=yamlTags,proto3" json:"yaml_tags,omitempty"`
+	TomlTags      bool                   `protobuf:"varint,6,opt,name=toml_tags,json=tomlTags,proto3" json:"toml_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JsonToGoRequest) Reset() {
+	*x = JsonToGoRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JsonToGoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JsonToGoRequest) ProtoMessage() {}
+
+func (x *JsonToGoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JsonToGoRequest.ProtoReflect.Descriptor instead.
+func (*JsonToGoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *JsonToGoRequest) GetJson() string {
+	if x != nil {
+		return x.Json
+	}
+	return ""
+}
+
+func (x *JsonToGoRequest) GetStructName() string {
+	if x != nil {
+		return x.StructName
+	}
+	return ""
+}
+
+func (x *JsonToGoRequest) GetInlineTypes() bool {
+	if x != nil {
+		return x.InlineTypes
+	}
+	return false
+}
+
+func (x *JsonToGoRequest) GetUsePointers() bool {
+	if x != nil {
+		return x.UsePointers
+	}
+	return false
+}
+
+func (x *JsonToGoRequest) GetYamlTags() bool {
+	if x != nil {
+		return x.YamlTags
+	}
+	return false
+}
+
+func (x *JsonToGoRequest) GetTomlTags() bool {
+	if x != nil {
+		return x.TomlTags
+	}
+	return false
+}
+
+type JsonToGoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoCode        string                 `protobuf:"bytes,1,opt,name=go_code,json=goCode,proto3" json:"go_code,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JsonToGoResponse) Reset() {
+	*x = JsonToGoResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JsonToGoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JsonToGoResponse) ProtoMessage() {}
+
+func (x *JsonToGoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JsonToGoResponse.ProtoReflect.Descriptor instead.
+func (*JsonToGoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *JsonToGoResponse) GetGoCode() string {
+	if x != nil {
+		return x.GoCode
+	}
+	return ""
+}
+
+func (x *JsonToGoResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CronRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Expression    string                 `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CronRequest) Reset() {
+	*x = CronRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CronRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CronRequest) ProtoMessage() {}
+
+func (x *CronRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CronRequest.ProtoReflect.Descriptor instead.
+func (*CronRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CronRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+type CronResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Description   string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	NextRuns      string                 `protobuf:"bytes,2,opt,name=next_runs,json=nextRuns,proto3" json:"next_runs,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CronResponse) Reset() {
+	*x = CronResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CronResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CronResponse) ProtoMessage() {}
+
+func (x *CronResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CronResponse.ProtoReflect.Descriptor instead.
+func (*CronResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CronResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CronResponse) GetNextRuns() string {
+	if x != nil {
+		return x.NextRuns
+	}
+	return ""
+}
+
+func (x *CronResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          string                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // PEM
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CertRequest) Reset() {
+	*x = CertRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertRequest) ProtoMessage() {}
+
+func (x *CertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertRequest.ProtoReflect.Descriptor instead.
+func (*CertRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CertRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type CertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subject       string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Issuer        string                 `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	NotBefore     string                 `protobuf:"bytes,3,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	NotAfter      string                 `protobuf:"bytes,4,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	Sans          []string               `protobuf:"bytes,5,rep,name=sans,proto3" json:"sans,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CertResponse) Reset() {
+	*x = CertResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertResponse) ProtoMessage() {}
+
+func (x *CertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertResponse.ProtoReflect.Descriptor instead.
+func (*CertResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CertResponse) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *CertResponse) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *CertResponse) GetNotBefore() string {
+	if x != nil {
+		return x.NotBefore
+	}
+	return ""
+}
+
+func (x *CertResponse) GetNotAfter() string {
+	if x != nil {
+		return x.NotAfter
+	}
+	return ""
+}
+
+func (x *CertResponse) GetSans() []string {
+	if x != nil {
+		return x.Sans
+	}
+	return nil
+}
+
+func (x *CertResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ColorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         string                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"` // hex, CSS name, rgb(), hsl(), hsv(), hwb(), cmyk(), lab(), lch(), oklab() or oklch()
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ColorRequest) Reset() {
+	*x = ColorRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ColorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorRequest) ProtoMessage() {}
+
+func (x *ColorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorRequest.ProtoReflect.Descriptor instead.
+func (*ColorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ColorRequest) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+type ColorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hex           string                 `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"` // #rrggbb, alpha dropped
+	Rgb           string                 `protobuf:"bytes,2,opt,name=rgb,proto3" json:"rgb,omitempty"` // rgba() when translucent
+	Hsl           string                 `protobuf:"bytes,3,opt,name=hsl,proto3" json:"hsl,omitempty"` // hsla() when translucent
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Hex8          string                 `protobuf:"bytes,5,opt,name=hex8,proto3" json:"hex8,omitempty"` // #rrggbbaa
+	Hsv           string                 `protobuf:"bytes,6,opt,name=hsv,proto3" json:"hsv,omitempty"`
+	Hwb           string                 `protobuf:"bytes,7,opt,name=hwb,proto3" json:"hwb,omitempty"`
+	Cmyk          string                 `protobuf:"bytes,8,opt,name=cmyk,proto3" json:"cmyk,omitempty"`
+	Lab           string                 `protobuf:"bytes,9,opt,name=lab,proto3" json:"lab,omitempty"` // CIE Lab, D50 as in CSS
+	Lch           string                 `protobuf:"bytes,10,opt,name=lch,proto3" json:"lch,omitempty"`
+	Oklab         string                 `protobuf:"bytes,11,opt,name=oklab,proto3" json:"oklab,omitempty"`
+	Oklch         string                 `protobuf:"bytes,12,opt,name=oklch,proto3" json:"oklch,omitempty"`
+	Alpha         float64                `protobuf:"fixed64,13,opt,name=alpha,proto3" json:"alpha,omitempty"`
+	Name          string                 `protobuf:"bytes,14,opt,name=name,proto3" json:"name,omitempty"`                                  // CSS name of this exact color, if any
+	NearestName   string                 `protobuf:"bytes,15,opt,name=nearest_name,json=nearestName,proto3" json:"nearest_name,omitempty"` // closest CSS named color
+	OutOfGamut    bool                   `protobuf:"varint,16,opt,name=out_of_gamut,json=outOfGamut,proto3" json:"out_of_gamut,omitempty"` // the sRGB notations show the color mapped into sRGB
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ColorResponse) Reset() {
+	*x = ColorResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ColorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorResponse) ProtoMessage() {}
+
+func (x *ColorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorResponse.ProtoReflect.Descriptor instead.
+func (*ColorResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ColorResponse) GetHex() string {
+	if x != nil {
+		return x.Hex
+	}
+	return ""
+}
+
+func (x *ColorResponse) GetRgb() string {
+	if x != nil {
+		return x.Rgb
+	}
+	return ""
+}
+
+func (x *ColorResponse) GetHsl() string {
+	if x != nil {
+		return x.Hsl
+	}
+	return ""
+}
+
+func (x *ColorResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ColorResponse) GetHex8() string {
+	if x != nil {
+		return x.Hex8
+	}
+	return ""
+}
+
+func (x *ColorResponse) GetHsv() string {
+	if x != nil {
+		return x.Hsv
+	}
+	return ""
+}
+
+func (x *ColorResponse) GetHwb() string {
+	if x != nil {
+		return x.Hwb
+	}
+	return ""
+}
+
+func (x *ColorResponse) GetCmyk() string {
+	if x != nil {
+		return x.Cmyk
+	}
+	return ""
+}
+
+func (x *ColorResponse) GetLab() string {
+	if x != nil {
+		return x.Lab
+	}
+	return ""
+}
+
+func (x *ColorResponse) GetLch() string {
+	if x != nil {
+		return x.Lch
+	}
+	return ""
+}
+
+func (x *ColorResponse) GetOklab() string {
+	if x != nil {
+		return x.Oklab
+	}
+	return ""
+}
+
+func (x *ColorResponse) GetOklch() string {
+	if x != nil {
+		return x.Oklch
+	}
+	return ""
+}
+
+func (x *ColorResponse) GetAlpha() float64 {
+	if x != nil {
+		return x.Alpha
+	}
+	return 0
+}
+
+func (x *ColorResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ColorResponse) GetNearestName() string {
+	if x != nil {
+		return x.NearestName
+	}
+	return ""
+}
+
+func (x *ColorResponse) GetOutOfGamut() bool {
+	if x != nil {
+		return x.OutOfGamut
+	}
+	return false
+}
+
+type CaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaseRequest) Reset() {
+	*x = CaseRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaseRequest) ProtoMessage() {}
+
+func (x *CaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaseRequest.ProtoReflect.Descriptor instead.
+func (*CaseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CaseRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type CaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Camel         string                 `protobuf:"bytes,1,opt,name=camel,proto3" json:"camel,omitempty"`
+	Snake         string                 `protobuf:"bytes,2,opt,name=snake,proto3" json:"snake,omitempty"`
+	Pascal        string                 `protobuf:"bytes,3,opt,name=pascal,proto3" json:"pascal,omitempty"`
+	Kebab         string                 `protobuf:"bytes,4,opt,name=kebab,proto3" json:"kebab,omitempty"`
+	Constant      string                 `protobuf:"bytes,5,opt,name=constant,proto3" json:"constant,omitempty"` // UPPER_SNAKE_CASE
+	Title         string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CaseResponse) Reset() {
+	*x = CaseResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaseResponse) ProtoMessage() {}
+
+func (x *CaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaseResponse.ProtoReflect.Descriptor instead.
+func (*CaseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CaseResponse) GetCamel() string {
+	if x != nil {
+		return x.Camel
+	}
+	return ""
+}
+
+func (x *CaseResponse) GetSnake() string {
+	if x != nil {
+		return x.Snake
+	}
+	return ""
+}
+
+func (x *CaseResponse) GetPascal() string {
+	if x != nil {
+		return x.Pascal
+	}
+	return ""
+}
+
+func (x *CaseResponse) GetKebab() string {
+	if x != nil {
+		return x.Kebab
+	}
+	return ""
+}
+
+func (x *CaseResponse) GetConstant() string {
+	if x != nil {
+		return x.Constant
+	}
+	return ""
+}
+
+func (x *CaseResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CaseResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type EscapeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Mode          string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`     // "json", "java", "sql", "html_entity"
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"` // "escape", "unescape"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EscapeRequest) Reset() {
+	*x = EscapeRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EscapeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscapeRequest) ProtoMessage() {}
+
+func (x *EscapeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EscapeRequest.ProtoReflect.Descriptor instead.
+func (*EscapeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *EscapeRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *EscapeRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *EscapeRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type EscapeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EscapeResponse) Reset() {
+	*x = EscapeResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EscapeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscapeResponse) ProtoMessage() {}
+
+func (x *EscapeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EscapeResponse.ProtoReflect.Descriptor instead.
+func (*EscapeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *EscapeResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *EscapeResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SimilarityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text1         string                 `protobuf:"bytes,1,opt,name=text1,proto3" json:"text1,omitempty"`
+	Text2         string                 `protobuf:"bytes,2,opt,name=text2,proto3" json:"text2,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimilarityRequest) Reset() {
+	*x = SimilarityRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimilarityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarityRequest) ProtoMessage() {}
+
+func (x *SimilarityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarityRequest.ProtoReflect.Descriptor instead.
+func (*SimilarityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SimilarityRequest) GetText1() string {
+	if x != nil {
+		return x.Text1
+	}
+	return ""
+}
+
+func (x *SimilarityRequest) GetText2() string {
+	if x != nil {
+		return x.Text2
+	}
+	return ""
+}
+
+type SimilarityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Distance      int32                  `protobuf:"varint,1,opt,name=distance,proto3" json:"distance,omitempty"`
+	Similarity    float32                `protobuf:"fixed32,2,opt,name=similarity,proto3" json:"similarity,omitempty"` // 0.0 to 1.0
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimilarityResponse) Reset() {
+	*x = SimilarityResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimilarityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarityResponse) ProtoMessage() {}
+
+func (x *SimilarityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarityResponse.ProtoReflect.Descriptor instead.
+func (*SimilarityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SimilarityResponse) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *SimilarityResponse) GetSimilarity() float32 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+func (x *SimilarityResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SqlRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Dialect       SqlDialect             `protobuf:"varint,2,opt,name=dialect,proto3,enum=privutil.SqlDialect" json:"dialect,omitempty"`
+	KeywordCase   SqlKeywordCase         `protobuf:"varint,3,opt,name=keyword_case,json=keywordCase,proto3,enum=privutil.SqlKeywordCase" json:"keyword_case,omitempty"`
+	Indent        string                 `protobuf:"bytes,4,opt,name=indent,proto3" json:"indent,omitempty"`                         // "2" (default), "4" or "tab"
+	LineWidth     int32                  `protobuf:"varint,5,opt,name=line_width,json=lineWidth,proto3" json:"line_width,omitempty"` // 0 means 80
+	Minify        bool                   `protobuf:"varint,6,opt,name=minify,proto3" json:"minify,omitempty"`                        // one line; drops comments except optimizer hints
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SqlRequest) Reset() {
+	*x = SqlRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SqlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SqlRequest) ProtoMessage() {}
+
+func (x *SqlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SqlRequest.ProtoReflect.Descriptor instead.
+func (*SqlRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SqlRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SqlRequest) GetDialect() SqlDialect {
+	if x != nil {
+		return x.Dialect
+	}
+	return SqlDialect_SQL_STANDARD
+}
+
+func (x *SqlRequest) GetKeywordCase() SqlKeywordCase {
+	if x != nil {
+		return x.KeywordCase
+	}
+	return SqlKeywordCase_SQL_KEYWORDS_UPPER
+}
+
+func (x *SqlRequest) GetIndent() string {
+	if x != nil {
+		return x.Indent
+	}
+	return ""
+}
+
+func (x *SqlRequest) GetLineWidth() int32 {
+	if x != nil {
+		return x.LineWidth
+	}
+	return 0
+}
+
+func (x *SqlRequest) GetMinify() bool {
+	if x != nil {
+		return x.Minify
+	}
+	return false
+}
+
+type SqlResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Formatted     string                 `protobuf:"bytes,1,opt,name=formatted,proto3" json:"formatted,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorLine     int32                  `protobuf:"varint,3,opt,name=error_line,json=errorLine,proto3" json:"error_line,omitempty"` // 1-based; 0 when the error has no position
+	ErrorColumn   int32                  `protobuf:"varint,4,opt,name=error_column,json=errorColumn,proto3" json:"error_column,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SqlResponse) Reset() {
+	*x = SqlResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SqlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SqlResponse) ProtoMessage() {}
+
+func (x *SqlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SqlResponse.ProtoReflect.Descriptor instead.
+func (*SqlResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SqlResponse) GetFormatted() string {
+	if x != nil {
+		return x.Formatted
+	}
+	return ""
+}
+
+func (x *SqlResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SqlResponse) GetErrorLine() int32 {
+	if x != nil {
+		return x.ErrorLine
+	}
+	return 0
+}
+
+func (x *SqlResponse) GetErrorColumn() int32 {
+	if x != nil {
+		return x.ErrorColumn
+	}
+	return 0
+}
+
+type DataToSqlRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          string                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Format        DataFormat             `protobuf:"varint,2,opt,name=format,proto3,enum=privutil.DataFormat" json:"format,omitempty"` // anything Convert reads; rows are an array of objects
+	CsvDelimiter  string                 `protobuf:"bytes,3,opt,name=csv_delimiter,json=csvDelimiter,proto3" json:"csv_delimiter,omitempty"`
+	TableName     string                 `protobuf:"bytes,4,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"` // default "data"; may be schema-qualified
+	Dialect       SqlDialect             `protobuf:"varint,5,opt,name=dialect,proto3,enum=privutil.SqlDialect" json:"dialect,omitempty"`
+	Style         SqlLoadStyle           `protobuf:"varint,6,opt,name=style,proto3,enum=privutil.SqlLoadStyle" json:"style,omitempty"`
+	BatchSize     int32                  `protobuf:"varint,7,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"` // rows per INSERT; 0 means 100
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataToSqlRequest) Reset() {
+	*x = DataToSqlRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataToSqlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataToSqlRequest) ProtoMessage() {}
+
+func (x *DataToSqlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataToSqlRequest.ProtoReflect.Descriptor instead.
+func (*DataToSqlRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DataToSqlRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *DataToSqlRequest) GetFormat() DataFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DataFormat_JSON
+}
+
+func (x *DataToSqlRequest) GetCsvDelimiter() string {
+	if x != nil {
+		return x.CsvDelimiter
+	}
+	return ""
+}
+
+func (x *DataToSqlRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *DataToSqlRequest) GetDialect() SqlDialect {
+	if x != nil {
+		return x.Dialect
+	}
+	return SqlDialect_SQL_STANDARD
+}
+
+func (x *DataToSqlRequest) GetStyle() SqlLoadStyle {
+	if x != nil {
+		return x.Style
+	}
+	return SqlLoadStyle_SQL_LOAD_INSERT
+}
+
+func (x *DataToSqlRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+type SqlColumn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Nullable      bool                   `protobuf:"varint,3,opt,name=nullable,proto3" json:"nullable,omitempty"`
+	PrimaryKey    bool                   `protobuf:"varint,4,opt,name=primary_key,json=primaryKey,proto3" json:"primary_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SqlColumn) Reset() {
+	*x = SqlColumn{}
+	mi := &file_proto_privutil_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SqlColumn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SqlColumn) ProtoMessage() {}
+
+func (x *SqlColumn) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SqlColumn.ProtoReflect.Descriptor instead.
+func (*SqlColumn) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SqlColumn) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SqlColumn) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SqlColumn) GetNullable() bool {
+	if x != nil {
+		return x.Nullable
+	}
+	return false
+}
+
+func (x *SqlColumn) GetPrimaryKey() bool {
+	if x != nil {
+		return x.PrimaryKey
+	}
+	return false
+}
+
+type DataToSqlResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreateTable   string                 `protobuf:"bytes,1,opt,name=create_table,json=createTable,proto3" json:"create_table,omitempty"`
+	Statements    string                 `protobuf:"bytes,2,opt,name=statements,proto3" json:"statements,omitempty"`
+	Columns       []*SqlColumn           `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	Rows          int32                  `protobuf:"varint,4,opt,name=rows,proto3" json:"rows,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataToSqlResponse) Reset() {
+	*x = DataToSqlResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataToSqlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataToSqlResponse) ProtoMessage() {}
+
+func (x *DataToSqlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataToSqlResponse.ProtoReflect.Descriptor instead.
+func (*DataToSqlResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DataToSqlResponse) GetCreateTable() string {
+	if x != nil {
+		return x.CreateTable
+	}
+	return ""
+}
+
+func (x *DataToSqlResponse) GetStatements() string {
+	if x != nil {
+		return x.Statements
+	}
+	return ""
+}
+
+func (x *DataToSqlResponse) GetColumns() []*SqlColumn {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *DataToSqlResponse) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *DataToSqlResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SqlToGoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ddl           string                 `protobuf:"bytes,1,opt,name=ddl,proto3" json:"ddl,omitempty"` // one or more CREATE TABLE statements
+	Dialect       SqlDialect             `protobuf:"varint,2,opt,name=dialect,proto3,enum=privutil.SqlDialect" json:"dialect,omitempty"`
+	UsePointers   bool                   `protobuf:"varint,3,opt,name=use_pointers,json=usePointers,proto3" json:"use_pointers,omitempty"` // nullable columns become pointers instead of sql.Null types
+	JsonTags      bool                   `protobuf:"varint,4,opt,name=json_tags,json=jsonTags,proto3" json:"json_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SqlToGoRequest) Reset() {
+	*x = SqlToGoRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SqlToGoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SqlToGoRequest) ProtoMessage() {}
+
+func (x *SqlToGoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SqlToGoRequest.ProtoReflect.Descriptor instead.
+func (*SqlToGoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *SqlToGoRequest) GetDdl() string {
+	if x != nil {
+		return x.Ddl
+	}
+	return ""
+}
+
+func (x *SqlToGoRequest) GetDialect() SqlDialect {
+	if x != nil {
+		return x.Dialect
+	}
+	return SqlDialect_SQL_STANDARD
+}
+
+func (x *SqlToGoRequest) GetUsePointers() bool {
+	if x != nil {
+		return x.UsePointers
+	}
+	return false
+}
+
+func (x *SqlToGoRequest) GetJsonTags() bool {
+	if x != nil {
+		return x.JsonTags
+	}
+	return false
+}
+
+type SqlToGoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoCode        string                 `protobuf:"bytes,1,opt,name=go_code,json=goCode,proto3" json:"go_code,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SqlToGoResponse) Reset() {
+	*x = SqlToGoResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SqlToGoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SqlToGoResponse) ProtoMessage() {}
+
+func (x *SqlToGoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SqlToGoResponse.ProtoReflect.Descriptor instead.
+func (*SqlToGoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SqlToGoResponse) GetGoCode() string {
+	if x != nil {
+		return x.GoCode
+	}
+	return ""
+}
+
+func (x *SqlToGoResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type IpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cidr          string                 `protobuf:"bytes,1,opt,name=cidr,proto3" json:"cidr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IpRequest) Reset() {
+	*x = IpRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IpRequest) ProtoMessage() {}
+
+func (x *IpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IpRequest.ProtoReflect.Descriptor instead.
+func (*IpRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *IpRequest) GetCidr() string {
+	if x != nil {
+		return x.Cidr
+	}
+	return ""
+}
+
+type IpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Broadcast     string                 `protobuf:"bytes,2,opt,name=broadcast,proto3" json:"broadcast,omitempty"`
+	Netmask       string                 `protobuf:"bytes,3,opt,name=netmask,proto3" json:"netmask,omitempty"`
+	FirstIp       string                 `protobuf:"bytes,4,opt,name=first_ip,json=firstIp,proto3" json:"first_ip,omitempty"`
+	LastIp        string                 `protobuf:"bytes,5,opt,name=last_ip,json=lastIp,proto3" json:"last_ip,omitempty"`
+	NumHosts      int64                  `protobuf:"varint,6,opt,name=num_hosts,json=numHosts,proto3" json:"num_hosts,omitempty"`
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IpResponse) Reset() {
+	*x = IpResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IpResponse) ProtoMessage() {}
+
+func (x *IpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IpResponse.ProtoReflect.Descriptor instead.
+func (*IpResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *IpResponse) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *IpResponse) GetBroadcast() string {
+	if x != nil {
+		return x.Broadcast
+	}
+	return ""
+}
+
+func (x *IpResponse) GetNetmask() string {
+	if x != nil {
+		return x.Netmask
+	}
+	return ""
+}
+
+func (x *IpResponse) GetFirstIp() string {
+	if x != nil {
+		return x.FirstIp
+	}
+	return ""
+}
+
+func (x *IpResponse) GetLastIp() string {
+	if x != nil {
+		return x.LastIp
+	}
+	return ""
+}
+
+func (x *IpResponse) GetNumHosts() int64 {
+	if x != nil {
+		return x.NumHosts
+	}
+	return 0
+}
+
+func (x *IpResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type TextInspectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextInspectRequest) Reset() {
+	*x = TextInspectRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextInspectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextInspectRequest) ProtoMessage() {}
+
+func (x *TextInspectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextInspectRequest.ProtoReflect.Descriptor instead.
+func (*TextInspectRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *TextInspectRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type TextInspectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CharCount     int32                  `protobuf:"varint,1,opt,name=char_count,json=charCount,proto3" json:"char_count,omitempty"`
+	WordCount     int32                  `protobuf:"varint,2,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
+	LineCount     int32                  `protobuf:"varint,3,opt,name=line_count,json=lineCount,proto3" json:"line_count,omitempty"`
+	ByteCount     int32                  `protobuf:"varint,4,opt,name=byte_count,json=byteCount,proto3" json:"byte_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextInspectResponse) Reset() {
+	*x = TextInspectResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextInspectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextInspectResponse) ProtoMessage() {}
+
+func (x *TextInspectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextInspectResponse.ProtoReflect.Descriptor instead.
+func (*TextInspectResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TextInspectResponse) GetCharCount() int32 {
+	if x != nil {
+		return x.CharCount
+	}
+	return 0
+}
+
+func (x *TextInspectResponse) GetWordCount() int32 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+func (x *TextInspectResponse) GetLineCount() int32 {
+	if x != nil {
+		return x.LineCount
+	}
+	return 0
+}
+
+func (x *TextInspectResponse) GetByteCount() int32 {
+	if x != nil {
+		return x.ByteCount
+	}
+	return 0
+}
+
+type TextManipulateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Action        TextAction             `protobuf:"varint,2,opt,name=action,proto3,enum=privutil.TextAction" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextManipulateRequest) Reset() {
+	*x = TextManipulateRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextManipulateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextManipulateRequest) ProtoMessage() {}
+
+func (x *TextManipulateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextManipulateRequest.ProtoReflect.Descriptor instead.
+func (*TextManipulateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *TextManipulateRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *TextManipulateRequest) GetAction() TextAction {
+	if x != nil {
+		return x.Action
+	}
+	return TextAction_SORT_AZ
+}
+
+type TextManipulateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextManipulateResponse) Reset() {
+	*x = TextManipulateResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextManipulateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextManipulateResponse) ProtoMessage() {}
+
+func (x *TextManipulateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextManipulateResponse.ProtoReflect.Descriptor instead.
+func (*TextManipulateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *TextManipulateResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type PasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Length        int32                  `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	Uppercase     bool                   `protobuf:"varint,2,opt,name=uppercase,proto3" json:"uppercase,omitempty"`
+	Lowercase     bool                   `protobuf:"varint,3,opt,name=lowercase,proto3" json:"lowercase,omitempty"`
+	Numbers       bool                   `protobuf:"varint,4,opt,name=numbers,proto3" json:"numbers,omitempty"`
+	Symbols       bool                   `protobuf:"varint,5,opt,name=symbols,proto3" json:"symbols,omitempty"`
+	CustomChars   string                 `protobuf:"bytes,6,opt,name=custom_chars,json=customChars,proto3" json:"custom_chars,omitempty"` // Custom character set to use
+	Count         int32                  `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`                               // Number of passwords to generate
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasswordRequest) Reset() {
+	*x = PasswordRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordRequest) ProtoMessage() {}
+
+func (x *PasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordRequest.ProtoReflect.Descriptor instead.
+func (*PasswordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PasswordRequest) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *PasswordRequest) GetUppercase() bool {
+	if x != nil {
+		return x.Uppercase
+	}
+	return false
+}
+
+func (x *PasswordRequest) GetLowercase() bool {
+	if x != nil {
+		return x.Lowercase
+	}
+	return false
+}
+
+func (x *PasswordRequest) GetNumbers() bool {
+	if x != nil {
+		return x.Numbers
+	}
+	return false
+}
+
+func (x *PasswordRequest) GetSymbols() bool {
+	if x != nil {
+		return x.Symbols
+	}
+	return false
+}
+
+func (x *PasswordRequest) GetCustomChars() string {
+	if x != nil {
+		return x.CustomChars
+	}
+	return ""
+}
+
+func (x *PasswordRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Passwords     []string               `protobuf:"bytes,1,rep,name=passwords,proto3" json:"passwords,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasswordResponse) Reset() {
+	*x = PasswordResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordResponse) ProtoMessage() {}
+
+func (x *PasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordResponse.ProtoReflect.Descriptor instead.
+func (*PasswordResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *PasswordResponse) GetPasswords() []string {
+	if x != nil {
+		return x.Passwords
+	}
+	return nil
+}
+
+func (x *PasswordResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RsaKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bits          int32                  `protobuf:"varint,1,opt,name=bits,proto3" json:"bits,omitempty"` // e.g. 1024, 2048, 4096
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RsaKeyRequest) Reset() {
+	*x = RsaKeyRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RsaKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsaKeyRequest) ProtoMessage() {}
+
+func (x *RsaKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsaKeyRequest.ProtoReflect.Descriptor instead.
+func (*RsaKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RsaKeyRequest) GetBits() int32 {
+	if x != nil {
+		return x.Bits
+	}
+	return 0
+}
+
+type RsaKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PrivateKey    string                 `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RsaKeyResponse) Reset() {
+	*x = RsaKeyResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RsaKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsaKeyResponse) ProtoMessage() {}
+
+func (x *RsaKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsaKeyResponse.ProtoReflect.Descriptor instead.
+func (*RsaKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RsaKeyResponse) GetPrivateKey() string {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return ""
+}
+
+func (x *RsaKeyResponse) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *RsaKeyResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type BaseConvertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         string                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	SourceBase    int32                  `protobuf:"varint,2,opt,name=source_base,json=sourceBase,proto3" json:"source_base,omitempty"` // e.g., 10, 16, 2, 8, or 64
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BaseConvertRequest) Reset() {
+	*x = BaseConvertRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BaseConvertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaseConvertRequest) ProtoMessage() {}
+
+func (x *BaseConvertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BaseConvertRequest.ProtoReflect.Descriptor instead.
+func (*BaseConvertRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *BaseConvertRequest) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *BaseConvertRequest) GetSourceBase() int32 {
+	if x != nil {
+		return x.SourceBase
+	}
+	return 0
+}
+
+type BaseConvertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Decimal       string                 `protobuf:"bytes,1,opt,name=decimal,proto3" json:"decimal,omitempty"`
+	Hex           string                 `protobuf:"bytes,2,opt,name=hex,proto3" json:"hex,omitempty"`
+	Binary        string                 `protobuf:"bytes,3,opt,name=binary,proto3" json:"binary,omitempty"`
+	Octal         string                 `protobuf:"bytes,4,opt,name=octal,proto3" json:"octal,omitempty"`
+	Base64        string                 `protobuf:"bytes,5,opt,name=base64,proto3" json:"base64,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BaseConvertResponse) Reset() {
+	*x = BaseConvertResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BaseConvertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BaseConvertResponse) ProtoMessage() {}
+
+func (x *BaseConvertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -503,63 +5343,75 @@ func (x *Base64Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Base64Response.ProtoReflect.Descriptor instead.
-func (*Base64Response) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use BaseConvertResponse.ProtoReflect.Descriptor instead.
+func (*BaseConvertResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *Base64Response) GetText() string {
+func (x *BaseConvertResponse) GetDecimal() string {
 	if x != nil {
-		return x.Text
+		return x.Decimal
 	}
 	return ""
 }
 
-func (x *Base64Response) GetError() string {
+func (x *BaseConvertResponse) GetHex() string {
 	if x != nil {
-		return x.Error
+		return x.Hex
 	}
 	return ""
 }
 
-func (x *Base64Response) GetData() []byte {
+func (x *BaseConvertResponse) GetBinary() string {
 	if x != nil {
-		return x.Data
+		return x.Binary
 	}
-	return nil
+	return ""
 }
 
-func (x *Base64Response) GetMimeType() string {
+func (x *BaseConvertResponse) GetOctal() string {
 	if x != nil {
-		return x.MimeType
+		return x.Octal
 	}
 	return ""
 }
 
-type JsonFormatRequest struct {
+func (x *BaseConvertResponse) GetBase64() string {
+	if x != nil {
+		return x.Base64
+	}
+	return ""
+}
+
+func (x *BaseConvertResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ChmodRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Indent        string                 `protobuf:"bytes,2,opt,name=indent,proto3" json:"indent,omitempty"` // "2", "4", "tab", or "min" (minify)
-	SortKeys      bool                   `protobuf:"varint,3,opt,name=sort_keys,json=sortKeys,proto3" json:"sort_keys,omitempty"`
+	Input         string                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"` // octal "755" or symbolic "rwxr-xr-x"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JsonFormatRequest) Reset() {
-	*x = JsonFormatRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[4]
+func (x *ChmodRequest) Reset() {
+	*x = ChmodRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JsonFormatRequest) String() string {
+func (x *ChmodRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JsonFormatRequest) ProtoMessage() {}
+func (*ChmodRequest) ProtoMessage() {}
 
-func (x *JsonFormatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[4]
+func (x *ChmodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -570,55 +5422,56 @@ func (x *JsonFormatRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JsonFormatRequest.ProtoReflect.Descriptor instead.
-func (*JsonFormatRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *JsonFormatRequest) GetText() string {
-	if x != nil {
-		return x.Text
-	}
-	return ""
+// Deprecated: Use ChmodRequest.ProtoReflect.Descriptor instead.
+func (*ChmodRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *JsonFormatRequest) GetIndent() string {
+func (x *ChmodRequest) GetInput() string {
 	if x != nil {
-		return x.Indent
+		return x.Input
 	}
 	return ""
 }
 
-func (x *JsonFormatRequest) GetSortKeys() bool {
-	if x != nil {
-		return x.SortKeys
-	}
-	return false
-}
-
-type JsonFormatResponse struct {
+type ChmodResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Octal         string                 `protobuf:"bytes,1,opt,name=octal,proto3" json:"octal,omitempty"`
+	Symbolic      string                 `protobuf:"bytes,2,opt,name=symbolic,proto3" json:"symbolic,omitempty"` // "rwxr-xr-x"
+	Value         int32                  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`      // decimal value e.g. 493
+	OwnerRead     bool                   `protobuf:"varint,4,opt,name=owner_read,json=ownerRead,proto3" json:"owner_read,omitempty"`
+	OwnerWrite    bool                   `protobuf:"varint,5,opt,name=owner_write,json=ownerWrite,proto3" json:"owner_write,omitempty"`
+	OwnerExecute  bool                   `protobuf:"varint,6,opt,name=owner_execute,json=ownerExecute,proto3" json:"owner_execute,omitempty"`
+	GroupRead     bool                   `protobuf:"varint,7,opt,name=group_read,json=groupRead,proto3" json:"group_read,omitempty"`
+	GroupWrite    bool                   `protobuf:"varint,8,opt,name=group_write,json=groupWrite,proto3" json:"group_write,omitempty"`
+	GroupExecute  bool                   `protobuf:"varint,9,opt,name=group_execute,json=groupExecute,proto3" json:"group_execute,omitempty"`
+	OtherRead     bool                   `protobuf:"varint,10,opt,name=other_read,json=otherRead,proto3" json:"other_read,omitempty"`
+	OtherWrite    bool                   `protobuf:"varint,11,opt,name=other_write,json=otherWrite,proto3" json:"other_write,omitempty"`
+	OtherExecute  bool                   `protobuf:"varint,12,opt,name=other_execute,json=otherExecute,proto3" json:"other_execute,omitempty"`
+	Setuid        bool                   `protobuf:"varint,13,opt,name=setuid,proto3" json:"setuid,omitempty"`
+	Setgid        bool                   `protobuf:"varint,14,opt,name=setgid,proto3" json:"setgid,omitempty"`
+	Sticky        bool                   `protobuf:"varint,15,opt,name=sticky,proto3" json:"sticky,omitempty"`
+	Description   string                 `protobuf:"bytes,16,opt,name=description,proto3" json:"description,omitempty"`
+	Error         string                 `protobuf:"bytes,17,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JsonFormatResponse) Reset() {
-	*x = JsonFormatResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[5]
+func (x *ChmodResponse) Reset() {
+	*x = ChmodResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JsonFormatResponse) String() string {
+func (x *ChmodResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JsonFormatResponse) ProtoMessage() {}
+func (*ChmodResponse) ProtoMessage() {}
 
-func (x *JsonFormatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[5]
+func (x *ChmodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,176 +5482,152 @@ func (x *JsonFormatResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JsonFormatResponse.ProtoReflect.Descriptor instead.
-func (*JsonFormatResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ChmodResponse.ProtoReflect.Descriptor instead.
+func (*ChmodResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *JsonFormatResponse) GetText() string {
+func (x *ChmodResponse) GetOctal() string {
 	if x != nil {
-		return x.Text
+		return x.Octal
 	}
 	return ""
 }
 
-func (x *JsonFormatResponse) GetError() string {
+func (x *ChmodResponse) GetSymbolic() string {
 	if x != nil {
-		return x.Error
+		return x.Symbolic
 	}
 	return ""
 }
 
-type ConvertRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          string                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	SourceFormat  DataFormat             `protobuf:"varint,2,opt,name=source_format,json=sourceFormat,proto3,enum=privutil.DataFormat" json:"source_format,omitempty"`
-	TargetFormat  DataFormat             `protobuf:"varint,3,opt,name=target_format,json=targetFormat,proto3,enum=privutil.DataFormat" json:"target_format,omitempty"`
-	CsvDelimiter  string                 `protobuf:"bytes,4,opt,name=csv_delimiter,json=csvDelimiter,proto3" json:"csv_delimiter,omitempty"` // single character; defaults to ","
-	CsvNoHeader   bool                   `protobuf:"varint,5,opt,name=csv_no_header,json=csvNoHeader,proto3" json:"csv_no_header,omitempty"` // if true, CSV rows are arrays instead of objects
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ConvertRequest) Reset() {
-	*x = ConvertRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ConvertRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ChmodResponse) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
 }
 
-func (*ConvertRequest) ProtoMessage() {}
-
-func (x *ConvertRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[6]
+func (x *ChmodResponse) GetOwnerRead() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.OwnerRead
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use ConvertRequest.ProtoReflect.Descriptor instead.
-func (*ConvertRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{6}
+func (x *ChmodResponse) GetOwnerWrite() bool {
+	if x != nil {
+		return x.OwnerWrite
+	}
+	return false
 }
 
-func (x *ConvertRequest) GetData() string {
+func (x *ChmodResponse) GetOwnerExecute() bool {
 	if x != nil {
-		return x.Data
+		return x.OwnerExecute
 	}
-	return ""
+	return false
 }
 
-func (x *ConvertRequest) GetSourceFormat() DataFormat {
+func (x *ChmodResponse) GetGroupRead() bool {
 	if x != nil {
-		return x.SourceFormat
+		return x.GroupRead
 	}
-	return DataFormat_JSON
+	return false
 }
 
-func (x *ConvertRequest) GetTargetFormat() DataFormat {
+func (x *ChmodResponse) GetGroupWrite() bool {
 	if x != nil {
-		return x.TargetFormat
+		return x.GroupWrite
 	}
-	return DataFormat_JSON
+	return false
 }
 
-func (x *ConvertRequest) GetCsvDelimiter() string {
+func (x *ChmodResponse) GetGroupExecute() bool {
 	if x != nil {
-		return x.CsvDelimiter
+		return x.GroupExecute
 	}
-	return ""
+	return false
 }
 
-func (x *ConvertRequest) GetCsvNoHeader() bool {
+func (x *ChmodResponse) GetOtherRead() bool {
 	if x != nil {
-		return x.CsvNoHeader
+		return x.OtherRead
 	}
 	return false
 }
 
-type ConvertResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          string                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ChmodResponse) GetOtherWrite() bool {
+	if x != nil {
+		return x.OtherWrite
+	}
+	return false
 }
 
-func (x *ConvertResponse) Reset() {
-	*x = ConvertResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ChmodResponse) GetOtherExecute() bool {
+	if x != nil {
+		return x.OtherExecute
+	}
+	return false
 }
 
-func (x *ConvertResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ChmodResponse) GetSetuid() bool {
+	if x != nil {
+		return x.Setuid
+	}
+	return false
 }
 
-func (*ConvertResponse) ProtoMessage() {}
-
-func (x *ConvertResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[7]
+func (x *ChmodResponse) GetSetgid() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Setgid
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use ConvertResponse.ProtoReflect.Descriptor instead.
-func (*ConvertResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{7}
+func (x *ChmodResponse) GetSticky() bool {
+	if x != nil {
+		return x.Sticky
+	}
+	return false
 }
 
-func (x *ConvertResponse) GetData() string {
+func (x *ChmodResponse) GetDescription() string {
 	if x != nil {
-		return x.Data
+		return x.Description
 	}
 	return ""
 }
 
-func (x *ConvertResponse) GetError() string {
+func (x *ChmodResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type ValidateRequest struct {
+type Ipv4ConvertRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          string                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Format        DataFormat             `protobuf:"varint,2,opt,name=format,proto3,enum=privutil.DataFormat" json:"format,omitempty"` // JSON, YAML, XML, or TOML
+	Input         string                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"` // dotted "192.168.1.1", decimal "3232235777", hex "0xC0A80101", binary "11000000..."
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidateRequest) Reset() {
-	*x = ValidateRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[8]
+func (x *Ipv4ConvertRequest) Reset() {
+	*x = Ipv4ConvertRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateRequest) String() string {
+func (x *Ipv4ConvertRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateRequest) ProtoMessage() {}
+func (*Ipv4ConvertRequest) ProtoMessage() {}
 
-func (x *ValidateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[8]
+func (x *Ipv4ConvertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -809,50 +5638,44 @@ func (x *ValidateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateRequest.ProtoReflect.Descriptor instead.
-func (*ValidateRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use Ipv4ConvertRequest.ProtoReflect.Descriptor instead.
+func (*Ipv4ConvertRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *ValidateRequest) GetData() string {
+func (x *Ipv4ConvertRequest) GetInput() string {
 	if x != nil {
-		return x.Data
+		return x.Input
 	}
 	return ""
 }
 
-func (x *ValidateRequest) GetFormat() DataFormat {
-	if x != nil {
-		return x.Format
-	}
-	return DataFormat_JSON
-}
-
-type ValidateResponse struct {
+type Ipv4ConvertResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	Line          int32                  `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`
-	Column        int32                  `protobuf:"varint,4,opt,name=column,proto3" json:"column,omitempty"`
+	Dotted        string                 `protobuf:"bytes,1,opt,name=dotted,proto3" json:"dotted,omitempty"`   // "192.168.1.1"
+	Decimal       string                 `protobuf:"bytes,2,opt,name=decimal,proto3" json:"decimal,omitempty"` // "3232235777"
+	Hex           string                 `protobuf:"bytes,3,opt,name=hex,proto3" json:"hex,omitempty"`         // "0xC0A80101"
+	Binary        string                 `protobuf:"bytes,4,opt,name=binary,proto3" json:"binary,omitempty"`   // "11000000.10101000.00000001.00000001"
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ValidateResponse) Reset() {
-	*x = ValidateResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[9]
+func (x *Ipv4ConvertResponse) Reset() {
+	*x = Ipv4ConvertResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ValidateResponse) String() string {
+func (x *Ipv4ConvertResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ValidateResponse) ProtoMessage() {}
+func (*Ipv4ConvertResponse) ProtoMessage() {}
 
-func (x *ValidateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[9]
+func (x *Ipv4ConvertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -863,65 +5686,69 @@ func (x *ValidateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ValidateResponse.ProtoReflect.Descriptor instead.
-func (*ValidateResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use Ipv4ConvertResponse.ProtoReflect.Descriptor instead.
+func (*Ipv4ConvertResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *ValidateResponse) GetValid() bool {
+func (x *Ipv4ConvertResponse) GetDotted() string {
 	if x != nil {
-		return x.Valid
+		return x.Dotted
 	}
-	return false
+	return ""
 }
 
-func (x *ValidateResponse) GetError() string {
+func (x *Ipv4ConvertResponse) GetDecimal() string {
 	if x != nil {
-		return x.Error
+		return x.Decimal
 	}
 	return ""
 }
 
-func (x *ValidateResponse) GetLine() int32 {
+func (x *Ipv4ConvertResponse) GetHex() string {
 	if x != nil {
-		return x.Line
+		return x.Hex
 	}
-	return 0
+	return ""
 }
 
-func (x *ValidateResponse) GetColumn() int32 {
+func (x *Ipv4ConvertResponse) GetBinary() string {
 	if x != nil {
-		return x.Column
+		return x.Binary
 	}
-	return 0
+	return ""
 }
 
-type UuidRequest struct {
+func (x *Ipv4ConvertResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Ipv4RangeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hyphen        bool                   `protobuf:"varint,1,opt,name=hyphen,proto3" json:"hyphen,omitempty"`
-	Uppercase     bool                   `protobuf:"varint,2,opt,name=uppercase,proto3" json:"uppercase,omitempty"`
-	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	Version       string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`     // "v1", "v2", "v3", "v4", "v5", "v6", "v7", "v8"
-	Namespace     string                 `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"` // For v3, v5, v8: "dns", "url", "oid", "x500" (default: "dns")
+	Start         string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"` // dotted decimal
+	End           string                 `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`     // dotted decimal
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UuidRequest) Reset() {
-	*x = UuidRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[10]
+func (x *Ipv4RangeRequest) Reset() {
+	*x = Ipv4RangeRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UuidRequest) String() string {
+func (x *Ipv4RangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UuidRequest) ProtoMessage() {}
+func (*Ipv4RangeRequest) ProtoMessage() {}
 
-func (x *UuidRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[10]
+func (x *Ipv4RangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -932,68 +5759,50 @@ func (x *UuidRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UuidRequest.ProtoReflect.Descriptor instead.
-func (*UuidRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *UuidRequest) GetHyphen() bool {
-	if x != nil {
-		return x.Hyphen
-	}
-	return false
-}
-
-func (x *UuidRequest) GetUppercase() bool {
-	if x != nil {
-		return x.Uppercase
-	}
-	return false
-}
-
-func (x *UuidRequest) GetCount() int32 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
+// Deprecated: Use Ipv4RangeRequest.ProtoReflect.Descriptor instead.
+func (*Ipv4RangeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *UuidRequest) GetVersion() string {
+func (x *Ipv4RangeRequest) GetStart() string {
 	if x != nil {
-		return x.Version
+		return x.Start
 	}
 	return ""
 }
 
-func (x *UuidRequest) GetNamespace() string {
+func (x *Ipv4RangeRequest) GetEnd() string {
 	if x != nil {
-		return x.Namespace
+		return x.End
 	}
 	return ""
 }
 
-type UuidResponse struct {
+type Ipv4RangeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uuids         []string               `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	Addresses     []string               `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"` // individual IPs (capped at 256)
+	Cidrs         []string               `protobuf:"bytes,2,rep,name=cidrs,proto3" json:"cidrs,omitempty"`         // CIDR summary
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`        // total count in range
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UuidResponse) Reset() {
-	*x = UuidResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[11]
+func (x *Ipv4RangeResponse) Reset() {
+	*x = Ipv4RangeResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UuidResponse) String() string {
+func (x *Ipv4RangeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UuidResponse) ProtoMessage() {}
+func (*Ipv4RangeResponse) ProtoMessage() {}
 
-func (x *UuidResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[11]
+func (x *Ipv4RangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1004,41 +5813,64 @@ func (x *UuidResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UuidResponse.ProtoReflect.Descriptor instead.
-func (*UuidResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use Ipv4RangeResponse.ProtoReflect.Descriptor instead.
+func (*Ipv4RangeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *UuidResponse) GetUuids() []string {
+func (x *Ipv4RangeResponse) GetAddresses() []string {
 	if x != nil {
-		return x.Uuids
+		return x.Addresses
 	}
 	return nil
 }
 
-type LoremRequest struct {
+func (x *Ipv4RangeResponse) GetCidrs() []string {
+	if x != nil {
+		return x.Cidrs
+	}
+	return nil
+}
+
+func (x *Ipv4RangeResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Ipv4RangeResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type PortRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // "word", "sentence", "paragraph"
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Min           int32                  `protobuf:"varint,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max           int32                  `protobuf:"varint,3,opt,name=max,proto3" json:"max,omitempty"`
+	ExcludeSystem bool                   `protobuf:"varint,4,opt,name=exclude_system,json=excludeSystem,proto3" json:"exclude_system,omitempty"` // exclude ports 0–1023
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoremRequest) Reset() {
-	*x = LoremRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[12]
+func (x *PortRequest) Reset() {
+	*x = PortRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoremRequest) String() string {
+func (x *PortRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoremRequest) ProtoMessage() {}
+func (*PortRequest) ProtoMessage() {}
 
-func (x *LoremRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[12]
+func (x *PortRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1049,47 +5881,62 @@ func (x *LoremRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoremRequest.ProtoReflect.Descriptor instead.
-func (*LoremRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use PortRequest.ProtoReflect.Descriptor instead.
+func (*PortRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *LoremRequest) GetType() string {
+func (x *PortRequest) GetCount() int32 {
 	if x != nil {
-		return x.Type
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *LoremRequest) GetCount() int32 {
+func (x *PortRequest) GetMin() int32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *PortRequest) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *PortRequest) GetExcludeSystem() bool {
 	if x != nil {
-		return x.Count
+		return x.ExcludeSystem
 	}
-	return 0
+	return false
 }
 
-type LoremResponse struct {
+type PortResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Ports         []int32                `protobuf:"varint,1,rep,packed,name=ports,proto3" json:"ports,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoremResponse) Reset() {
-	*x = LoremResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[13]
+func (x *PortResponse) Reset() {
+	*x = PortResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoremResponse) String() string {
+func (x *PortResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoremResponse) ProtoMessage() {}
+func (*PortResponse) ProtoMessage() {}
 
-func (x *LoremResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[13]
+func (x *PortResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1100,42 +5947,52 @@ func (x *LoremResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoremResponse.ProtoReflect.Descriptor instead.
-func (*LoremResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use PortResponse.ProtoReflect.Descriptor instead.
+func (*PortResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *LoremResponse) GetText() string {
+func (x *PortResponse) GetPorts() []int32 {
 	if x != nil {
-		return x.Text
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *PortResponse) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-type HashRequest struct {
+type MacRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Algo          string                 `protobuf:"bytes,2,opt,name=algo,proto3" json:"algo,omitempty"` // "md5", "sha1", "sha256", "sha512", "bcrypt"
-	Cost          *int32                 `protobuf:"varint,3,opt,name=cost,proto3,oneof" json:"cost,omitempty"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Separator     string                 `protobuf:"bytes,2,opt,name=separator,proto3" json:"separator,omitempty"` // ":", "-", "." (Cisco), or "" (none)
+	Uppercase     bool                   `protobuf:"varint,3,opt,name=uppercase,proto3" json:"uppercase,omitempty"`
+	Oui           string                 `protobuf:"bytes,4,opt,name=oui,proto3" json:"oui,omitempty"`          // optional 3-byte OUI prefix e.g. "00:1A:2B"
+	Unicast       bool                   `protobuf:"varint,5,opt,name=unicast,proto3" json:"unicast,omitempty"` // ensure unicast bit is set (bit 0 of first byte = 0)
+	Local         bool                   `protobuf:"varint,6,opt,name=local,proto3" json:"local,omitempty"`     // set locally-administered bit (bit 1 of first byte = 1)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HashRequest) Reset() {
-	*x = HashRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[14]
+func (x *MacRequest) Reset() {
+	*x = MacRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HashRequest) String() string {
+func (x *MacRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HashRequest) ProtoMessage() {}
+func (*MacRequest) ProtoMessage() {}
 
-func (x *HashRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[14]
+func (x *MacRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1146,54 +6003,76 @@ func (x *HashRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HashRequest.ProtoReflect.Descriptor instead.
-func (*HashRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use MacRequest.ProtoReflect.Descriptor instead.
+func (*MacRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *HashRequest) GetText() string {
+func (x *MacRequest) GetCount() int32 {
 	if x != nil {
-		return x.Text
+		return x.Count
+	}
+	return 0
+}
+
+func (x *MacRequest) GetSeparator() string {
+	if x != nil {
+		return x.Separator
 	}
 	return ""
 }
 
-func (x *HashRequest) GetAlgo() string {
+func (x *MacRequest) GetUppercase() bool {
 	if x != nil {
-		return x.Algo
+		return x.Uppercase
+	}
+	return false
+}
+
+func (x *MacRequest) GetOui() string {
+	if x != nil {
+		return x.Oui
 	}
 	return ""
 }
 
-func (x *HashRequest) GetCost() int32 {
-	if x != nil && x.Cost != nil {
-		return *x.Cost
+func (x *MacRequest) GetUnicast() bool {
+	if x != nil {
+		return x.Unicast
 	}
-	return 0
+	return false
 }
 
-type HashResponse struct {
+func (x *MacRequest) GetLocal() bool {
+	if x != nil {
+		return x.Local
+	}
+	return false
+}
+
+type MacResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Addresses     []string               `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HashResponse) Reset() {
-	*x = HashResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[15]
+func (x *MacResponse) Reset() {
+	*x = MacResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HashResponse) String() string {
+func (x *MacResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HashResponse) ProtoMessage() {}
+func (*MacResponse) ProtoMessage() {}
 
-func (x *HashResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[15]
+func (x *MacResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1204,40 +6083,49 @@ func (x *HashResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HashResponse.ProtoReflect.Descriptor instead.
-func (*HashResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use MacResponse.ProtoReflect.Descriptor instead.
+func (*MacResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *HashResponse) GetHash() string {
+func (x *MacResponse) GetAddresses() []string {
 	if x != nil {
-		return x.Hash
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *MacResponse) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-type TextRequest struct {
+type HmacRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	Algo          string                 `protobuf:"bytes,3,opt,name=algo,proto3" json:"algo,omitempty"` // "sha256", "sha512", "sha1", "md5"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TextRequest) Reset() {
-	*x = TextRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[16]
+func (x *HmacRequest) Reset() {
+	*x = HmacRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TextRequest) String() string {
+func (x *HmacRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TextRequest) ProtoMessage() {}
+func (*HmacRequest) ProtoMessage() {}
 
-func (x *TextRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[16]
+func (x *HmacRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1248,40 +6136,56 @@ func (x *TextRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TextRequest.ProtoReflect.Descriptor instead.
-func (*TextRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use HmacRequest.ProtoReflect.Descriptor instead.
+func (*HmacRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *TextRequest) GetText() string {
+func (x *HmacRequest) GetMessage() string {
 	if x != nil {
-		return x.Text
+		return x.Message
 	}
 	return ""
 }
 
-type TextResponse struct {
+func (x *HmacRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *HmacRequest) GetAlgo() string {
+	if x != nil {
+		return x.Algo
+	}
+	return ""
+}
+
+type HmacResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Hex           string                 `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
+	Base64        string                 `protobuf:"bytes,2,opt,name=base64,proto3" json:"base64,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TextResponse) Reset() {
-	*x = TextResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[17]
+func (x *HmacResponse) Reset() {
+	*x = HmacResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TextResponse) String() string {
+func (x *HmacResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TextResponse) ProtoMessage() {}
+func (*HmacResponse) ProtoMessage() {}
 
-func (x *TextResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[17]
+func (x *HmacResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1292,40 +6196,60 @@ func (x *TextResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TextResponse.ProtoReflect.Descriptor instead.
-func (*TextResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use HmacResponse.ProtoReflect.Descriptor instead.
+func (*HmacResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *TextResponse) GetText() string {
+func (x *HmacResponse) GetHex() string {
 	if x != nil {
-		return x.Text
+		return x.Hex
 	}
 	return ""
 }
 
-type TimeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Input         string                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"` // Can be timestamp (int string) or ISO date
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *HmacResponse) GetBase64() string {
+	if x != nil {
+		return x.Base64
+	}
+	return ""
 }
 
-func (x *TimeRequest) Reset() {
-	*x = TimeRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[18]
+func (x *HmacResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type OtpRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Secret         string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`                                        // base32-encoded secret
+	Type           string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                                            // "totp" or "hotp"
+	Counter        int64                  `protobuf:"varint,3,opt,name=counter,proto3" json:"counter,omitempty"`                                     // HOTP counter
+	Digits         int32                  `protobuf:"varint,4,opt,name=digits,proto3" json:"digits,omitempty"`                                       // 6 or 8 (default 6)
+	Period         int32                  `protobuf:"varint,5,opt,name=period,proto3" json:"period,omitempty"`                                       // TOTP period seconds (default 30)
+	Algo           string                 `protobuf:"bytes,6,opt,name=algo,proto3" json:"algo,omitempty"`                                            // "sha1", "sha256", "sha512" (default sha1)
+	GenerateSecret bool                   `protobuf:"varint,7,opt,name=generate_secret,json=generateSecret,proto3" json:"generate_secret,omitempty"` // if true, generate a new random secret
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OtpRequest) Reset() {
+	*x = OtpRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TimeRequest) String() string {
+func (x *OtpRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimeRequest) ProtoMessage() {}
+func (*OtpRequest) ProtoMessage() {}
 
-func (x *TimeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[18]
+func (x *OtpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1336,108 +6260,87 @@ func (x *TimeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimeRequest.ProtoReflect.Descriptor instead.
-func (*TimeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use OtpRequest.ProtoReflect.Descriptor instead.
+func (*OtpRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *TimeRequest) GetInput() string {
+func (x *OtpRequest) GetSecret() string {
 	if x != nil {
-		return x.Input
+		return x.Secret
 	}
 	return ""
 }
 
-type TimeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Unix          int64                  `protobuf:"varint,1,opt,name=unix,proto3" json:"unix,omitempty"`
-	Utc           string                 `protobuf:"bytes,2,opt,name=utc,proto3" json:"utc,omitempty"`
-	Local         string                 `protobuf:"bytes,3,opt,name=local,proto3" json:"local,omitempty"`
-	Iso           string                 `protobuf:"bytes,4,opt,name=iso,proto3" json:"iso,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *TimeResponse) Reset() {
-	*x = TimeResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[19]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *TimeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*TimeResponse) ProtoMessage() {}
-
-func (x *TimeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[19]
+func (x *OtpRequest) GetType() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Type
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use TimeResponse.ProtoReflect.Descriptor instead.
-func (*TimeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{19}
+func (x *OtpRequest) GetCounter() int64 {
+	if x != nil {
+		return x.Counter
+	}
+	return 0
 }
 
-func (x *TimeResponse) GetUnix() int64 {
+func (x *OtpRequest) GetDigits() int32 {
 	if x != nil {
-		return x.Unix
+		return x.Digits
 	}
 	return 0
 }
 
-func (x *TimeResponse) GetUtc() string {
+func (x *OtpRequest) GetPeriod() int32 {
 	if x != nil {
-		return x.Utc
+		return x.Period
 	}
-	return ""
+	return 0
 }
 
-func (x *TimeResponse) GetLocal() string {
+func (x *OtpRequest) GetAlgo() string {
 	if x != nil {
-		return x.Local
+		return x.Algo
 	}
 	return ""
 }
 
-func (x *TimeResponse) GetIso() string {
+func (x *OtpRequest) GetGenerateSecret() bool {
 	if x != nil {
-		return x.Iso
+		return x.GenerateSecret
 	}
-	return ""
+	return false
 }
 
-type JwtRequest struct {
+type OtpResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`                                     // populated when generate_secret=true
+	ValidUntil    int64                  `protobuf:"varint,3,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`          // unix timestamp when current period ends
+	TimeRemaining int64                  `protobuf:"varint,4,opt,name=time_remaining,json=timeRemaining,proto3" json:"time_remaining,omitempty"` // seconds until next period
+	Uri           string                 `protobuf:"bytes,5,opt,name=uri,proto3" json:"uri,omitempty"`                                           // otpauth:// URI
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JwtRequest) Reset() {
-	*x = JwtRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[20]
+func (x *OtpResponse) Reset() {
+	*x = OtpResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JwtRequest) String() string {
+func (x *OtpResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JwtRequest) ProtoMessage() {}
+func (*OtpResponse) ProtoMessage() {}
 
-func (x *JwtRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[20]
+func (x *OtpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1448,101 +6351,79 @@ func (x *JwtRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JwtRequest.ProtoReflect.Descriptor instead.
-func (*JwtRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use OtpResponse.ProtoReflect.Descriptor instead.
+func (*OtpResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *JwtRequest) GetToken() string {
+func (x *OtpResponse) GetCode() string {
 	if x != nil {
-		return x.Token
+		return x.Code
 	}
 	return ""
 }
 
-type JwtResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Header        string                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Payload       string                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
-	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *JwtResponse) Reset() {
-	*x = JwtResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[21]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *JwtResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*JwtResponse) ProtoMessage() {}
-
-func (x *JwtResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[21]
+func (x *OtpResponse) GetSecret() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Secret
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use JwtResponse.ProtoReflect.Descriptor instead.
-func (*JwtResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{21}
+func (x *OtpResponse) GetValidUntil() int64 {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return 0
 }
 
-func (x *JwtResponse) GetHeader() string {
+func (x *OtpResponse) GetTimeRemaining() int64 {
 	if x != nil {
-		return x.Header
+		return x.TimeRemaining
 	}
-	return ""
+	return 0
 }
 
-func (x *JwtResponse) GetPayload() string {
+func (x *OtpResponse) GetUri() string {
 	if x != nil {
-		return x.Payload
+		return x.Uri
 	}
 	return ""
 }
 
-func (x *JwtResponse) GetError() string {
+func (x *OtpResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type RegexRequest struct {
+type OtpValidateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pattern       string                 `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
-	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Window        int32                  `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"` // allowed drift in periods (default 1)
+	Period        int32                  `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty"` // default 30
+	Algo          string                 `protobuf:"bytes,5,opt,name=algo,proto3" json:"algo,omitempty"`      // "sha1", "sha256", "sha512"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegexRequest) Reset() {
-	*x = RegexRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[22]
+func (x *OtpValidateRequest) Reset() {
+	*x = OtpValidateRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegexRequest) String() string {
+func (x *OtpValidateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegexRequest) ProtoMessage() {}
+func (*OtpValidateRequest) ProtoMessage() {}
 
-func (x *RegexRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[22]
+func (x *OtpValidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1553,49 +6434,69 @@ func (x *RegexRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegexRequest.ProtoReflect.Descriptor instead.
-func (*RegexRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use OtpValidateRequest.ProtoReflect.Descriptor instead.
+func (*OtpValidateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *RegexRequest) GetPattern() string {
+func (x *OtpValidateRequest) GetSecret() string {
 	if x != nil {
-		return x.Pattern
+		return x.Secret
 	}
 	return ""
 }
 
-func (x *RegexRequest) GetText() string {
+func (x *OtpValidateRequest) GetCode() string {
 	if x != nil {
-		return x.Text
+		return x.Code
 	}
 	return ""
 }
 
-type RegexResponse struct {
+func (x *OtpValidateRequest) GetWindow() int32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *OtpValidateRequest) GetPeriod() int32 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *OtpValidateRequest) GetAlgo() string {
+	if x != nil {
+		return x.Algo
+	}
+	return ""
+}
+
+type OtpValidateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Match         bool                   `protobuf:"varint,1,opt,name=match,proto3" json:"match,omitempty"`
-	Matches       []string               `protobuf:"bytes,2,rep,name=matches,proto3" json:"matches,omitempty"`
-	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegexResponse) Reset() {
-	*x = RegexResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[23]
+func (x *OtpValidateResponse) Reset() {
+	*x = OtpValidateResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegexResponse) String() string {
+func (x *OtpValidateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegexResponse) ProtoMessage() {}
+func (*OtpValidateResponse) ProtoMessage() {}
 
-func (x *RegexResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[23]
+func (x *OtpValidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1606,55 +6507,48 @@ func (x *RegexResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegexResponse.ProtoReflect.Descriptor instead.
-func (*RegexResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *RegexResponse) GetMatch() bool {
-	if x != nil {
-		return x.Match
-	}
-	return false
+// Deprecated: Use OtpValidateResponse.ProtoReflect.Descriptor instead.
+func (*OtpValidateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *RegexResponse) GetMatches() []string {
+func (x *OtpValidateResponse) GetValid() bool {
 	if x != nil {
-		return x.Matches
+		return x.Valid
 	}
-	return nil
+	return false
 }
 
-func (x *RegexResponse) GetError() string {
+func (x *OtpValidateResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type JsonToGoRequest struct {
+type UlidRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Json          string                 `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
-	StructName    string                 `protobuf:"bytes,2,opt,name=struct_name,json=structName,proto3" json:"struct_name,omitempty"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Monotonic     bool                   `protobuf:"varint,2,opt,name=monotonic,proto3" json:"monotonic,omitempty"` // use monotonic entropy for ordering within same millisecond
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JsonToGoRequest) Reset() {
-	*x = JsonToGoRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[24]
+func (x *UlidRequest) Reset() {
+	*x = UlidRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JsonToGoRequest) String() string {
+func (x *UlidRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JsonToGoRequest) ProtoMessage() {}
+func (*UlidRequest) ProtoMessage() {}
 
-func (x *JsonToGoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[24]
+func (x *UlidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1665,48 +6559,48 @@ func (x *JsonToGoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JsonToGoRequest.ProtoReflect.Descriptor instead.
-func (*JsonToGoRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use UlidRequest.ProtoReflect.Descriptor instead.
+func (*UlidRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *JsonToGoRequest) GetJson() string {
+func (x *UlidRequest) GetCount() int32 {
 	if x != nil {
-		return x.Json
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *JsonToGoRequest) GetStructName() string {
+func (x *UlidRequest) GetMonotonic() bool {
 	if x != nil {
-		return x.StructName
+		return x.Monotonic
 	}
-	return ""
+	return false
 }
 
-type JsonToGoResponse struct {
+type UlidResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GoCode        string                 `protobuf:"bytes,1,opt,name=go_code,json=goCode,proto3" json:"go_code,omitempty"`
+	Ulids         []string               `protobuf:"bytes,1,rep,name=ulids,proto3" json:"ulids,omitempty"`
 	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JsonToGoResponse) Reset() {
-	*x = JsonToGoResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[25]
+func (x *UlidResponse) Reset() {
+	*x = UlidResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JsonToGoResponse) String() string {
+func (x *UlidResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JsonToGoResponse) ProtoMessage() {}
+func (*UlidResponse) ProtoMessage() {}
 
-func (x *JsonToGoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[25]
+func (x *UlidResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1717,47 +6611,49 @@ func (x *JsonToGoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JsonToGoResponse.ProtoReflect.Descriptor instead.
-func (*JsonToGoResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use UlidResponse.ProtoReflect.Descriptor instead.
+func (*UlidResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *JsonToGoResponse) GetGoCode() string {
+func (x *UlidResponse) GetUlids() []string {
 	if x != nil {
-		return x.GoCode
+		return x.Ulids
 	}
-	return ""
+	return nil
 }
 
-func (x *JsonToGoResponse) GetError() string {
+func (x *UlidResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type CronRequest struct {
+type CaesarRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Expression    string                 `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Shift         int32                  `protobuf:"varint,2,opt,name=shift,proto3" json:"shift,omitempty"`  // 1–25; ROT13 = 13
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"` // "encode" or "decode"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CronRequest) Reset() {
-	*x = CronRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[26]
+func (x *CaesarRequest) Reset() {
+	*x = CaesarRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CronRequest) String() string {
+func (x *CaesarRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CronRequest) ProtoMessage() {}
+func (*CaesarRequest) ProtoMessage() {}
 
-func (x *CronRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[26]
+func (x *CaesarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1768,42 +6664,55 @@ func (x *CronRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CronRequest.ProtoReflect.Descriptor instead.
-func (*CronRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use CaesarRequest.ProtoReflect.Descriptor instead.
+func (*CaesarRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *CronRequest) GetExpression() string {
+func (x *CaesarRequest) GetText() string {
 	if x != nil {
-		return x.Expression
+		return x.Text
 	}
 	return ""
 }
 
-type CronResponse struct {
+func (x *CaesarRequest) GetShift() int32 {
+	if x != nil {
+		return x.Shift
+	}
+	return 0
+}
+
+func (x *CaesarRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type CaesarResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Description   string                 `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
-	NextRuns      string                 `protobuf:"bytes,2,opt,name=next_runs,json=nextRuns,proto3" json:"next_runs,omitempty"`
-	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CronResponse) Reset() {
-	*x = CronResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[27]
+func (x *CaesarResponse) Reset() {
+	*x = CaesarResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CronResponse) String() string {
+func (x *CaesarResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CronResponse) ProtoMessage() {}
+func (*CaesarResponse) ProtoMessage() {}
 
-func (x *CronResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[27]
+func (x *CaesarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1814,54 +6723,49 @@ func (x *CronResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CronResponse.ProtoReflect.Descriptor instead.
-func (*CronResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *CronResponse) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+// Deprecated: Use CaesarResponse.ProtoReflect.Descriptor instead.
+func (*CaesarResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *CronResponse) GetNextRuns() string {
+func (x *CaesarResponse) GetResult() string {
 	if x != nil {
-		return x.NextRuns
+		return x.Result
 	}
 	return ""
 }
 
-func (x *CronResponse) GetError() string {
+func (x *CaesarResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type CertRequest struct {
+type TextEncodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          string                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // PEM
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // "encode" or "decode"
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"` // "binary", "hex", "octal", "decimal"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CertRequest) Reset() {
-	*x = CertRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[28]
+func (x *TextEncodeRequest) Reset() {
+	*x = TextEncodeRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CertRequest) String() string {
+func (x *TextEncodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CertRequest) ProtoMessage() {}
+func (*TextEncodeRequest) ProtoMessage() {}
 
-func (x *CertRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[28]
+func (x *TextEncodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1872,45 +6776,55 @@ func (x *CertRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CertRequest.ProtoReflect.Descriptor instead.
-func (*CertRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use TextEncodeRequest.ProtoReflect.Descriptor instead.
+func (*TextEncodeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *CertRequest) GetData() string {
+func (x *TextEncodeRequest) GetText() string {
 	if x != nil {
-		return x.Data
+		return x.Text
 	}
 	return ""
 }
 
-type CertResponse struct {
+func (x *TextEncodeRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TextEncodeRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type TextEncodeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Subject       string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
-	Issuer        string                 `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
-	NotBefore     string                 `protobuf:"bytes,3,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
-	NotAfter      string                 `protobuf:"bytes,4,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
-	Sans          []string               `protobuf:"bytes,5,rep,name=sans,proto3" json:"sans,omitempty"`
-	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CertResponse) Reset() {
-	*x = CertResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[29]
+func (x *TextEncodeResponse) Reset() {
+	*x = TextEncodeResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CertResponse) String() string {
+func (x *TextEncodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CertResponse) ProtoMessage() {}
+func (*TextEncodeResponse) ProtoMessage() {}
 
-func (x *CertResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[29]
+func (x *TextEncodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1921,75 +6835,48 @@ func (x *CertResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CertResponse.ProtoReflect.Descriptor instead.
-func (*CertResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *CertResponse) GetSubject() string {
-	if x != nil {
-		return x.Subject
-	}
-	return ""
-}
-
-func (x *CertResponse) GetIssuer() string {
-	if x != nil {
-		return x.Issuer
-	}
-	return ""
-}
-
-func (x *CertResponse) GetNotBefore() string {
-	if x != nil {
-		return x.NotBefore
-	}
-	return ""
+// Deprecated: Use TextEncodeResponse.ProtoReflect.Descriptor instead.
+func (*TextEncodeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *CertResponse) GetNotAfter() string {
+func (x *TextEncodeResponse) GetResult() string {
 	if x != nil {
-		return x.NotAfter
+		return x.Result
 	}
 	return ""
 }
 
-func (x *CertResponse) GetSans() []string {
-	if x != nil {
-		return x.Sans
-	}
-	return nil
-}
-
-func (x *CertResponse) GetError() string {
+func (x *TextEncodeResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type ColorRequest struct {
+type MorseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Input         string                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"` // Any format
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // "encode" or "decode"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ColorRequest) Reset() {
-	*x = ColorRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[30]
+func (x *MorseRequest) Reset() {
+	*x = MorseRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ColorRequest) String() string {
+func (x *MorseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ColorRequest) ProtoMessage() {}
+func (*MorseRequest) ProtoMessage() {}
 
-func (x *ColorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[30]
+func (x *MorseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2000,43 +6887,48 @@ func (x *ColorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ColorRequest.ProtoReflect.Descriptor instead.
-func (*ColorRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use MorseRequest.ProtoReflect.Descriptor instead.
+func (*MorseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *ColorRequest) GetInput() string {
+func (x *MorseRequest) GetText() string {
 	if x != nil {
-		return x.Input
+		return x.Text
 	}
 	return ""
 }
 
-type ColorResponse struct {
+func (x *MorseRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type MorseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hex           string                 `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
-	Rgb           string                 `protobuf:"bytes,2,opt,name=rgb,proto3" json:"rgb,omitempty"`
-	Hsl           string                 `protobuf:"bytes,3,opt,name=hsl,proto3" json:"hsl,omitempty"`
-	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ColorResponse) Reset() {
-	*x = ColorResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[31]
+func (x *MorseResponse) Reset() {
+	*x = MorseResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ColorResponse) String() string {
+func (x *MorseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ColorResponse) ProtoMessage() {}
+func (*MorseResponse) ProtoMessage() {}
 
-func (x *ColorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[31]
+func (x *MorseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2047,61 +6939,48 @@ func (x *ColorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ColorResponse.ProtoReflect.Descriptor instead.
-func (*ColorResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *ColorResponse) GetHex() string {
-	if x != nil {
-		return x.Hex
-	}
-	return ""
-}
-
-func (x *ColorResponse) GetRgb() string {
-	if x != nil {
-		return x.Rgb
-	}
-	return ""
+// Deprecated: Use MorseResponse.ProtoReflect.Descriptor instead.
+func (*MorseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *ColorResponse) GetHsl() string {
+func (x *MorseResponse) GetResult() string {
 	if x != nil {
-		return x.Hsl
+		return x.Result
 	}
 	return ""
 }
 
-func (x *ColorResponse) GetError() string {
+func (x *MorseResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type CaseRequest struct {
+type BasicAuthRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CaseRequest) Reset() {
-	*x = CaseRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[32]
+func (x *BasicAuthRequest) Reset() {
+	*x = BasicAuthRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CaseRequest) String() string {
+func (x *BasicAuthRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CaseRequest) ProtoMessage() {}
+func (*BasicAuthRequest) ProtoMessage() {}
 
-func (x *CaseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[32]
+func (x *BasicAuthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2112,46 +6991,50 @@ func (x *CaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CaseRequest.ProtoReflect.Descriptor instead.
-func (*CaseRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use BasicAuthRequest.ProtoReflect.Descriptor instead.
+func (*BasicAuthRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *CaseRequest) GetText() string {
+func (x *BasicAuthRequest) GetUsername() string {
 	if x != nil {
-		return x.Text
+		return x.Username
 	}
 	return ""
 }
 
-type CaseResponse struct {
+func (x *BasicAuthRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type BasicAuthResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Camel         string                 `protobuf:"bytes,1,opt,name=camel,proto3" json:"camel,omitempty"`
-	Snake         string                 `protobuf:"bytes,2,opt,name=snake,proto3" json:"snake,omitempty"`
-	Pascal        string                 `protobuf:"bytes,3,opt,name=pascal,proto3" json:"pascal,omitempty"`
-	Kebab         string                 `protobuf:"bytes,4,opt,name=kebab,proto3" json:"kebab,omitempty"`
-	Constant      string                 `protobuf:"bytes,5,opt,name=constant,proto3" json:"constant,omitempty"` // UPPER_SNAKE_CASE
-	Title         string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
-	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	Header        string                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`   // "Basic <token>"
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`     // base64 part only
+	Decoded       string                 `protobuf:"bytes,3,opt,name=decoded,proto3" json:"decoded,omitempty"` // "username:password" (for reverse direction)
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CaseResponse) Reset() {
-	*x = CaseResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[33]
+func (x *BasicAuthResponse) Reset() {
+	*x = BasicAuthResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CaseResponse) String() string {
+func (x *BasicAuthResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CaseResponse) ProtoMessage() {}
+func (*BasicAuthResponse) ProtoMessage() {}
 
-func (x *CaseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[33]
+func (x *BasicAuthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2162,84 +7045,64 @@ func (x *CaseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CaseResponse.ProtoReflect.Descriptor instead.
-func (*CaseResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *CaseResponse) GetCamel() string {
-	if x != nil {
-		return x.Camel
-	}
-	return ""
-}
-
-func (x *CaseResponse) GetSnake() string {
-	if x != nil {
-		return x.Snake
-	}
-	return ""
-}
-
-func (x *CaseResponse) GetPascal() string {
-	if x != nil {
-		return x.Pascal
-	}
-	return ""
+// Deprecated: Use BasicAuthResponse.ProtoReflect.Descriptor instead.
+func (*BasicAuthResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *CaseResponse) GetKebab() string {
+func (x *BasicAuthResponse) GetHeader() string {
 	if x != nil {
-		return x.Kebab
+		return x.Header
 	}
 	return ""
 }
 
-func (x *CaseResponse) GetConstant() string {
+func (x *BasicAuthResponse) GetToken() string {
 	if x != nil {
-		return x.Constant
+		return x.Token
 	}
 	return ""
 }
 
-func (x *CaseResponse) GetTitle() string {
+func (x *BasicAuthResponse) GetDecoded() string {
 	if x != nil {
-		return x.Title
+		return x.Decoded
 	}
 	return ""
 }
 
-func (x *CaseResponse) GetError() string {
+func (x *BasicAuthResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type EscapeRequest struct {
+type SlugifyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Mode          string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`     // "json", "java", "sql", "html_entity"
-	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"` // "escape", "unescape"
+	Separator     string                 `protobuf:"bytes,2,opt,name=separator,proto3" json:"separator,omitempty"`          // "-" (default), "_", or "" (no separator)
+	Uppercase     bool                   `protobuf:"varint,3,opt,name=uppercase,proto3" json:"uppercase,omitempty"`         // default false → lowercase
+	MaxLen        int32                  `protobuf:"varint,4,opt,name=max_len,json=maxLen,proto3" json:"max_len,omitempty"` // 0 = unlimited
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EscapeRequest) Reset() {
-	*x = EscapeRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[34]
+func (x *SlugifyRequest) Reset() {
+	*x = SlugifyRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EscapeRequest) String() string {
+func (x *SlugifyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EscapeRequest) ProtoMessage() {}
+func (*SlugifyRequest) ProtoMessage() {}
 
-func (x *EscapeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[34]
+func (x *SlugifyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2250,33 +7113,40 @@ func (x *EscapeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EscapeRequest.ProtoReflect.Descriptor instead.
-func (*EscapeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use SlugifyRequest.ProtoReflect.Descriptor instead.
+func (*SlugifyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *EscapeRequest) GetText() string {
+func (x *SlugifyRequest) GetText() string {
 	if x != nil {
 		return x.Text
 	}
 	return ""
 }
 
-func (x *EscapeRequest) GetMode() string {
+func (x *SlugifyRequest) GetSeparator() string {
 	if x != nil {
-		return x.Mode
+		return x.Separator
 	}
 	return ""
 }
 
-func (x *EscapeRequest) GetAction() string {
+func (x *SlugifyRequest) GetUppercase() bool {
 	if x != nil {
-		return x.Action
+		return x.Uppercase
 	}
-	return ""
+	return false
 }
 
-type EscapeResponse struct {
+func (x *SlugifyRequest) GetMaxLen() int32 {
+	if x != nil {
+		return x.MaxLen
+	}
+	return 0
+}
+
+type SlugifyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
 	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
@@ -2284,21 +7154,21 @@ type EscapeResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EscapeResponse) Reset() {
-	*x = EscapeResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[35]
+func (x *SlugifyResponse) Reset() {
+	*x = SlugifyResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EscapeResponse) String() string {
+func (x *SlugifyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EscapeResponse) ProtoMessage() {}
+func (*SlugifyResponse) ProtoMessage() {}
 
-func (x *EscapeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[35]
+func (x *SlugifyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2309,48 +7179,47 @@ func (x *EscapeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EscapeResponse.ProtoReflect.Descriptor instead.
-func (*EscapeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use SlugifyResponse.ProtoReflect.Descriptor instead.
+func (*SlugifyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *EscapeResponse) GetResult() string {
+func (x *SlugifyResponse) GetResult() string {
 	if x != nil {
 		return x.Result
 	}
 	return ""
 }
 
-func (x *EscapeResponse) GetError() string {
+func (x *SlugifyResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type SimilarityRequest struct {
+type HiddenCharsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text1         string                 `protobuf:"bytes,1,opt,name=text1,proto3" json:"text1,omitempty"`
-	Text2         string                 `protobuf:"bytes,2,opt,name=text2,proto3" json:"text2,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SimilarityRequest) Reset() {
-	*x = SimilarityRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[36]
+func (x *HiddenCharsRequest) Reset() {
+	*x = HiddenCharsRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SimilarityRequest) String() string {
+func (x *HiddenCharsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimilarityRequest) ProtoMessage() {}
+func (*HiddenCharsRequest) ProtoMessage() {}
 
-func (x *SimilarityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[36]
+func (x *HiddenCharsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2361,49 +7230,42 @@ func (x *SimilarityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimilarityRequest.ProtoReflect.Descriptor instead.
-func (*SimilarityRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *SimilarityRequest) GetText1() string {
-	if x != nil {
-		return x.Text1
-	}
-	return ""
+// Deprecated: Use HiddenCharsRequest.ProtoReflect.Descriptor instead.
+func (*HiddenCharsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *SimilarityRequest) GetText2() string {
+func (x *HiddenCharsRequest) GetText() string {
 	if x != nil {
-		return x.Text2
+		return x.Text
 	}
 	return ""
 }
 
-type SimilarityResponse struct {
+type HiddenCharInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Distance      int32                  `protobuf:"varint,1,opt,name=distance,proto3" json:"distance,omitempty"`
-	Similarity    float32                `protobuf:"fixed32,2,opt,name=similarity,proto3" json:"similarity,omitempty"` // 0.0 to 1.0
-	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Codepoint     string                 `protobuf:"bytes,2,opt,name=codepoint,proto3" json:"codepoint,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SimilarityResponse) Reset() {
-	*x = SimilarityResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[37]
+func (x *HiddenCharInfo) Reset() {
+	*x = HiddenCharInfo{}
+	mi := &file_proto_privutil_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SimilarityResponse) String() string {
+func (x *HiddenCharInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimilarityResponse) ProtoMessage() {}
+func (*HiddenCharInfo) ProtoMessage() {}
 
-func (x *SimilarityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[37]
+func (x *HiddenCharInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2414,54 +7276,58 @@ func (x *SimilarityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimilarityResponse.ProtoReflect.Descriptor instead.
-func (*SimilarityResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use HiddenCharInfo.ProtoReflect.Descriptor instead.
+func (*HiddenCharInfo) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *SimilarityResponse) GetDistance() int32 {
+func (x *HiddenCharInfo) GetName() string {
 	if x != nil {
-		return x.Distance
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *SimilarityResponse) GetSimilarity() float32 {
+func (x *HiddenCharInfo) GetCodepoint() string {
 	if x != nil {
-		return x.Similarity
+		return x.Codepoint
 	}
-	return 0
+	return ""
 }
 
-func (x *SimilarityResponse) GetError() string {
+func (x *HiddenCharInfo) GetCount() int32 {
 	if x != nil {
-		return x.Error
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type SqlRequest struct {
+type HiddenCharsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	HasHidden     bool                   `protobuf:"varint,1,opt,name=has_hidden,json=hasHidden,proto3" json:"has_hidden,omitempty"`
+	Chars         []*HiddenCharInfo      `protobuf:"bytes,2,rep,name=chars,proto3" json:"chars,omitempty"`
+	Annotated     string                 `protobuf:"bytes,3,opt,name=annotated,proto3" json:"annotated,omitempty"` // original with [U+XXXX] markers
+	Cleaned       string                 `protobuf:"bytes,4,opt,name=cleaned,proto3" json:"cleaned,omitempty"`     // original with hidden chars removed
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SqlRequest) Reset() {
-	*x = SqlRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[38]
+func (x *HiddenCharsResponse) Reset() {
+	*x = HiddenCharsResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SqlRequest) String() string {
+func (x *HiddenCharsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SqlRequest) ProtoMessage() {}
+func (*HiddenCharsResponse) ProtoMessage() {}
 
-func (x *SqlRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[38]
+func (x *HiddenCharsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2472,41 +7338,72 @@ func (x *SqlRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SqlRequest.ProtoReflect.Descriptor instead.
-func (*SqlRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use HiddenCharsResponse.ProtoReflect.Descriptor instead.
+func (*HiddenCharsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *SqlRequest) GetQuery() string {
+func (x *HiddenCharsResponse) GetHasHidden() bool {
 	if x != nil {
-		return x.Query
+		return x.HasHidden
+	}
+	return false
+}
+
+func (x *HiddenCharsResponse) GetChars() []*HiddenCharInfo {
+	if x != nil {
+		return x.Chars
+	}
+	return nil
+}
+
+func (x *HiddenCharsResponse) GetAnnotated() string {
+	if x != nil {
+		return x.Annotated
 	}
 	return ""
 }
 
-type SqlResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Formatted     string                 `protobuf:"bytes,1,opt,name=formatted,proto3" json:"formatted,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *HiddenCharsResponse) GetCleaned() string {
+	if x != nil {
+		return x.Cleaned
+	}
+	return ""
 }
 
-func (x *SqlResponse) Reset() {
-	*x = SqlResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[39]
+func (x *HiddenCharsResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type TextReplaceRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Text            string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Find            string                 `protobuf:"bytes,2,opt,name=find,proto3" json:"find,omitempty"`
+	ReplaceWith     string                 `protobuf:"bytes,3,opt,name=replace_with,json=replaceWith,proto3" json:"replace_with,omitempty"`
+	UseRegex        bool                   `protobuf:"varint,4,opt,name=use_regex,json=useRegex,proto3" json:"use_regex,omitempty"`
+	CaseInsensitive bool                   `protobuf:"varint,5,opt,name=case_insensitive,json=caseInsensitive,proto3" json:"case_insensitive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TextReplaceRequest) Reset() {
+	*x = TextReplaceRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SqlResponse) String() string {
+func (x *TextReplaceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SqlResponse) ProtoMessage() {}
+func (*TextReplaceRequest) ProtoMessage() {}
 
-func (x *SqlResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[39]
+func (x *TextReplaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2517,47 +7414,70 @@ func (x *SqlResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SqlResponse.ProtoReflect.Descriptor instead.
-func (*SqlResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use TextReplaceRequest.ProtoReflect.Descriptor instead.
+func (*TextReplaceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *SqlResponse) GetFormatted() string {
+func (x *TextReplaceRequest) GetText() string {
 	if x != nil {
-		return x.Formatted
+		return x.Text
 	}
 	return ""
 }
 
-func (x *SqlResponse) GetError() string {
+func (x *TextReplaceRequest) GetFind() string {
 	if x != nil {
-		return x.Error
+		return x.Find
 	}
 	return ""
 }
 
-type IpRequest struct {
+func (x *TextReplaceRequest) GetReplaceWith() string {
+	if x != nil {
+		return x.ReplaceWith
+	}
+	return ""
+}
+
+func (x *TextReplaceRequest) GetUseRegex() bool {
+	if x != nil {
+		return x.UseRegex
+	}
+	return false
+}
+
+func (x *TextReplaceRequest) GetCaseInsensitive() bool {
+	if x != nil {
+		return x.CaseInsensitive
+	}
+	return false
+}
+
+type TextReplaceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cidr          string                 `protobuf:"bytes,1,opt,name=cidr,proto3" json:"cidr,omitempty"`
+	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IpRequest) Reset() {
-	*x = IpRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[40]
+func (x *TextReplaceResponse) Reset() {
+	*x = TextReplaceResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IpRequest) String() string {
+func (x *TextReplaceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IpRequest) ProtoMessage() {}
+func (*TextReplaceResponse) ProtoMessage() {}
 
-func (x *IpRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[40]
+func (x *TextReplaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2568,46 +7488,57 @@ func (x *IpRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IpRequest.ProtoReflect.Descriptor instead.
-func (*IpRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use TextReplaceResponse.ProtoReflect.Descriptor instead.
+func (*TextReplaceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *TextReplaceResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *TextReplaceResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
 }
 
-func (x *IpRequest) GetCidr() string {
+func (x *TextReplaceResponse) GetError() string {
 	if x != nil {
-		return x.Cidr
+		return x.Error
 	}
 	return ""
 }
 
-type IpResponse struct {
+type StringObfuscateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Broadcast     string                 `protobuf:"bytes,2,opt,name=broadcast,proto3" json:"broadcast,omitempty"`
-	Netmask       string                 `protobuf:"bytes,3,opt,name=netmask,proto3" json:"netmask,omitempty"`
-	FirstIp       string                 `protobuf:"bytes,4,opt,name=first_ip,json=firstIp,proto3" json:"first_ip,omitempty"`
-	LastIp        string                 `protobuf:"bytes,5,opt,name=last_ip,json=lastIp,proto3" json:"last_ip,omitempty"`
-	NumHosts      int64                  `protobuf:"varint,6,opt,name=num_hosts,json=numHosts,proto3" json:"num_hosts,omitempty"`
-	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	KeepStart     int32                  `protobuf:"varint,2,opt,name=keep_start,json=keepStart,proto3" json:"keep_start,omitempty"`
+	KeepEnd       int32                  `protobuf:"varint,3,opt,name=keep_end,json=keepEnd,proto3" json:"keep_end,omitempty"`
+	MaskChar      string                 `protobuf:"bytes,4,opt,name=mask_char,json=maskChar,proto3" json:"mask_char,omitempty"` // default "*"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IpResponse) Reset() {
-	*x = IpResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[41]
+func (x *StringObfuscateRequest) Reset() {
+	*x = StringObfuscateRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IpResponse) String() string {
+func (x *StringObfuscateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IpResponse) ProtoMessage() {}
+func (*StringObfuscateRequest) ProtoMessage() {}
 
-func (x *IpResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[41]
+func (x *StringObfuscateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2618,82 +7549,62 @@ func (x *IpResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IpResponse.ProtoReflect.Descriptor instead.
-func (*IpResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{41}
-}
-
-func (x *IpResponse) GetNetwork() string {
-	if x != nil {
-		return x.Network
-	}
-	return ""
-}
-
-func (x *IpResponse) GetBroadcast() string {
-	if x != nil {
-		return x.Broadcast
-	}
-	return ""
-}
-
-func (x *IpResponse) GetNetmask() string {
-	if x != nil {
-		return x.Netmask
-	}
-	return ""
+// Deprecated: Use StringObfuscateRequest.ProtoReflect.Descriptor instead.
+func (*StringObfuscateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *IpResponse) GetFirstIp() string {
+func (x *StringObfuscateRequest) GetText() string {
 	if x != nil {
-		return x.FirstIp
+		return x.Text
 	}
 	return ""
 }
 
-func (x *IpResponse) GetLastIp() string {
+func (x *StringObfuscateRequest) GetKeepStart() int32 {
 	if x != nil {
-		return x.LastIp
+		return x.KeepStart
 	}
-	return ""
+	return 0
 }
 
-func (x *IpResponse) GetNumHosts() int64 {
+func (x *StringObfuscateRequest) GetKeepEnd() int32 {
 	if x != nil {
-		return x.NumHosts
+		return x.KeepEnd
 	}
 	return 0
 }
 
-func (x *IpResponse) GetError() string {
+func (x *StringObfuscateRequest) GetMaskChar() string {
 	if x != nil {
-		return x.Error
+		return x.MaskChar
 	}
 	return ""
 }
 
-type TextInspectRequest struct {
+type StringObfuscateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TextInspectRequest) Reset() {
-	*x = TextInspectRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[42]
+func (x *StringObfuscateResponse) Reset() {
+	*x = StringObfuscateResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TextInspectRequest) String() string {
+func (x *StringObfuscateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TextInspectRequest) ProtoMessage() {}
+func (*StringObfuscateResponse) ProtoMessage() {}
 
-func (x *TextInspectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[42]
+func (x *StringObfuscateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2704,43 +7615,47 @@ func (x *TextInspectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TextInspectRequest.ProtoReflect.Descriptor instead.
-func (*TextInspectRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use StringObfuscateResponse.ProtoReflect.Descriptor instead.
+func (*StringObfuscateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *TextInspectRequest) GetText() string {
+func (x *StringObfuscateResponse) GetResult() string {
 	if x != nil {
-		return x.Text
+		return x.Result
 	}
 	return ""
 }
 
-type TextInspectResponse struct {
+func (x *StringObfuscateResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type NumeronymRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CharCount     int32                  `protobuf:"varint,1,opt,name=char_count,json=charCount,proto3" json:"char_count,omitempty"`
-	WordCount     int32                  `protobuf:"varint,2,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
-	LineCount     int32                  `protobuf:"varint,3,opt,name=line_count,json=lineCount,proto3" json:"line_count,omitempty"`
-	ByteCount     int32                  `protobuf:"varint,4,opt,name=byte_count,json=byteCount,proto3" json:"byte_count,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TextInspectResponse) Reset() {
-	*x = TextInspectResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[43]
+func (x *NumeronymRequest) Reset() {
+	*x = NumeronymRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TextInspectResponse) String() string {
+func (x *NumeronymRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TextInspectResponse) ProtoMessage() {}
+func (*NumeronymRequest) ProtoMessage() {}
 
-func (x *TextInspectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[43]
+func (x *NumeronymRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2751,62 +7666,42 @@ func (x *TextInspectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TextInspectResponse.ProtoReflect.Descriptor instead.
-func (*TextInspectResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{43}
-}
-
-func (x *TextInspectResponse) GetCharCount() int32 {
-	if x != nil {
-		return x.CharCount
-	}
-	return 0
-}
-
-func (x *TextInspectResponse) GetWordCount() int32 {
-	if x != nil {
-		return x.WordCount
-	}
-	return 0
-}
-
-func (x *TextInspectResponse) GetLineCount() int32 {
-	if x != nil {
-		return x.LineCount
-	}
-	return 0
+// Deprecated: Use NumeronymRequest.ProtoReflect.Descriptor instead.
+func (*NumeronymRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *TextInspectResponse) GetByteCount() int32 {
+func (x *NumeronymRequest) GetText() string {
 	if x != nil {
-		return x.ByteCount
+		return x.Text
 	}
-	return 0
+	return ""
 }
 
-type TextManipulateRequest struct {
+type NumeronymResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Action        TextAction             `protobuf:"varint,2,opt,name=action,proto3,enum=privutil.TextAction" json:"action,omitempty"`
+	Words         []string               `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`   // numeronym per word
+	Result        string                 `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"` // space-joined
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TextManipulateRequest) Reset() {
-	*x = TextManipulateRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[44]
+func (x *NumeronymResponse) Reset() {
+	*x = NumeronymResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TextManipulateRequest) String() string {
+func (x *NumeronymResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TextManipulateRequest) ProtoMessage() {}
+func (*NumeronymResponse) ProtoMessage() {}
 
-func (x *TextManipulateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[44]
+func (x *NumeronymResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2817,47 +7712,55 @@ func (x *TextManipulateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TextManipulateRequest.ProtoReflect.Descriptor instead.
-func (*TextManipulateRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use NumeronymResponse.ProtoReflect.Descriptor instead.
+func (*NumeronymResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *TextManipulateRequest) GetText() string {
+func (x *NumeronymResponse) GetWords() []string {
 	if x != nil {
-		return x.Text
+		return x.Words
+	}
+	return nil
+}
+
+func (x *NumeronymResponse) GetResult() string {
+	if x != nil {
+		return x.Result
 	}
 	return ""
 }
 
-func (x *TextManipulateRequest) GetAction() TextAction {
+func (x *NumeronymResponse) GetError() string {
 	if x != nil {
-		return x.Action
+		return x.Error
 	}
-	return TextAction_SORT_AZ
+	return ""
 }
 
-type TextManipulateResponse struct {
+type NatoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // "encode" or "decode"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TextManipulateResponse) Reset() {
-	*x = TextManipulateResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[45]
+func (x *NatoRequest) Reset() {
+	*x = NatoRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TextManipulateResponse) String() string {
+func (x *NatoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TextManipulateResponse) ProtoMessage() {}
+func (*NatoRequest) ProtoMessage() {}
 
-func (x *TextManipulateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[45]
+func (x *NatoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2868,46 +7771,48 @@ func (x *TextManipulateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TextManipulateResponse.ProtoReflect.Descriptor instead.
-func (*TextManipulateResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use NatoRequest.ProtoReflect.Descriptor instead.
+func (*NatoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *TextManipulateResponse) GetText() string {
+func (x *NatoRequest) GetText() string {
 	if x != nil {
 		return x.Text
 	}
 	return ""
 }
 
-type PasswordRequest struct {
+func (x *NatoRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type NatoResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Length        int32                  `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
-	Uppercase     bool                   `protobuf:"varint,2,opt,name=uppercase,proto3" json:"uppercase,omitempty"`
-	Lowercase     bool                   `protobuf:"varint,3,opt,name=lowercase,proto3" json:"lowercase,omitempty"`
-	Numbers       bool                   `protobuf:"varint,4,opt,name=numbers,proto3" json:"numbers,omitempty"`
-	Symbols       bool                   `protobuf:"varint,5,opt,name=symbols,proto3" json:"symbols,omitempty"`
-	CustomChars   string                 `protobuf:"bytes,6,opt,name=custom_chars,json=customChars,proto3" json:"custom_chars,omitempty"` // Custom character set to use
-	Count         int32                  `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`                               // Number of passwords to generate
+	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PasswordRequest) Reset() {
-	*x = PasswordRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[46]
+func (x *NatoResponse) Reset() {
+	*x = NatoResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PasswordRequest) String() string {
+func (x *NatoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordRequest) ProtoMessage() {}
+func (*NatoResponse) ProtoMessage() {}
 
-func (x *PasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[46]
+func (x *NatoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2918,83 +7823,49 @@ func (x *PasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordRequest.ProtoReflect.Descriptor instead.
-func (*PasswordRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{46}
-}
-
-func (x *PasswordRequest) GetLength() int32 {
-	if x != nil {
-		return x.Length
-	}
-	return 0
-}
-
-func (x *PasswordRequest) GetUppercase() bool {
-	if x != nil {
-		return x.Uppercase
-	}
-	return false
-}
-
-func (x *PasswordRequest) GetLowercase() bool {
-	if x != nil {
-		return x.Lowercase
-	}
-	return false
-}
-
-func (x *PasswordRequest) GetNumbers() bool {
-	if x != nil {
-		return x.Numbers
-	}
-	return false
-}
-
-func (x *PasswordRequest) GetSymbols() bool {
-	if x != nil {
-		return x.Symbols
-	}
-	return false
+// Deprecated: Use NatoResponse.ProtoReflect.Descriptor instead.
+func (*NatoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *PasswordRequest) GetCustomChars() string {
+func (x *NatoResponse) GetResult() string {
 	if x != nil {
-		return x.CustomChars
+		return x.Result
 	}
 	return ""
 }
 
-func (x *PasswordRequest) GetCount() int32 {
+func (x *NatoResponse) GetError() string {
 	if x != nil {
-		return x.Count
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-type PasswordResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Passwords     []string               `protobuf:"bytes,1,rep,name=passwords,proto3" json:"passwords,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Text            string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Action          ListAction             `protobuf:"varint,2,opt,name=action,proto3,enum=privutil.ListAction" json:"action,omitempty"`
+	CaseInsensitive bool                   `protobuf:"varint,3,opt,name=case_insensitive,json=caseInsensitive,proto3" json:"case_insensitive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *PasswordResponse) Reset() {
-	*x = PasswordResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[47]
+func (x *ListRequest) Reset() {
+	*x = ListRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PasswordResponse) String() string {
+func (x *ListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PasswordResponse) ProtoMessage() {}
+func (*ListRequest) ProtoMessage() {}
 
-func (x *PasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[47]
+func (x *ListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3005,47 +7876,55 @@ func (x *PasswordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PasswordResponse.ProtoReflect.Descriptor instead.
-func (*PasswordResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *PasswordResponse) GetPasswords() []string {
+func (x *ListRequest) GetText() string {
 	if x != nil {
-		return x.Passwords
+		return x.Text
 	}
-	return nil
+	return ""
 }
 
-func (x *PasswordResponse) GetError() string {
+func (x *ListRequest) GetAction() ListAction {
 	if x != nil {
-		return x.Error
+		return x.Action
 	}
-	return ""
+	return ListAction_LIST_SORT_AZ
 }
 
-type RsaKeyRequest struct {
+func (x *ListRequest) GetCaseInsensitive() bool {
+	if x != nil {
+		return x.CaseInsensitive
+	}
+	return false
+}
+
+type ListFreqItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Bits          int32                  `protobuf:"varint,1,opt,name=bits,proto3" json:"bits,omitempty"` // e.g. 1024, 2048, 4096
+	Line          string                 `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RsaKeyRequest) Reset() {
-	*x = RsaKeyRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[48]
+func (x *ListFreqItem) Reset() {
+	*x = ListFreqItem{}
+	mi := &file_proto_privutil_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RsaKeyRequest) String() string {
+func (x *ListFreqItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RsaKeyRequest) ProtoMessage() {}
+func (*ListFreqItem) ProtoMessage() {}
 
-func (x *RsaKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[48]
+func (x *ListFreqItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3056,42 +7935,51 @@ func (x *RsaKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RsaKeyRequest.ProtoReflect.Descriptor instead.
-func (*RsaKeyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use ListFreqItem.ProtoReflect.Descriptor instead.
+func (*ListFreqItem) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *RsaKeyRequest) GetBits() int32 {
+func (x *ListFreqItem) GetLine() string {
 	if x != nil {
-		return x.Bits
+		return x.Line
+	}
+	return ""
+}
+
+func (x *ListFreqItem) GetCount() int32 {
+	if x != nil {
+		return x.Count
 	}
 	return 0
 }
 
-type RsaKeyResponse struct {
+type ListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PrivateKey    string                 `protobuf:"bytes,1,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
-	PublicKey     string                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	InputCount    int32                  `protobuf:"varint,2,opt,name=input_count,json=inputCount,proto3" json:"input_count,omitempty"`
+	OutputCount   int32                  `protobuf:"varint,3,opt,name=output_count,json=outputCount,proto3" json:"output_count,omitempty"`
+	Frequency     []*ListFreqItem        `protobuf:"bytes,4,rep,name=frequency,proto3" json:"frequency,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RsaKeyResponse) Reset() {
-	*x = RsaKeyResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[49]
+func (x *ListResponse) Reset() {
+	*x = ListResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RsaKeyResponse) String() string {
+func (x *ListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RsaKeyResponse) ProtoMessage() {}
+func (*ListResponse) ProtoMessage() {}
 
-func (x *RsaKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[49]
+func (x *ListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3102,55 +7990,69 @@ func (x *RsaKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RsaKeyResponse.ProtoReflect.Descriptor instead.
-func (*RsaKeyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
+func (*ListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *RsaKeyResponse) GetPrivateKey() string {
+func (x *ListResponse) GetResult() string {
 	if x != nil {
-		return x.PrivateKey
+		return x.Result
 	}
 	return ""
 }
 
-func (x *RsaKeyResponse) GetPublicKey() string {
+func (x *ListResponse) GetInputCount() int32 {
 	if x != nil {
-		return x.PublicKey
+		return x.InputCount
 	}
-	return ""
+	return 0
 }
 
-func (x *RsaKeyResponse) GetError() string {
+func (x *ListResponse) GetOutputCount() int32 {
+	if x != nil {
+		return x.OutputCount
+	}
+	return 0
+}
+
+func (x *ListResponse) GetFrequency() []*ListFreqItem {
+	if x != nil {
+		return x.Frequency
+	}
+	return nil
+}
+
+func (x *ListResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type BaseConvertRequest struct {
+type MathVariable struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Input         string                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
-	SourceBase    int32                  `protobuf:"varint,2,opt,name=source_base,json=sourceBase,proto3" json:"source_base,omitempty"` // e.g., 10, 16, 2, 8, or 64
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BaseConvertRequest) Reset() {
-	*x = BaseConvertRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[50]
+func (x *MathVariable) Reset() {
+	*x = MathVariable{}
+	mi := &file_proto_privutil_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BaseConvertRequest) String() string {
+func (x *MathVariable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BaseConvertRequest) ProtoMessage() {}
+func (*MathVariable) ProtoMessage() {}
 
-func (x *BaseConvertRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[50]
+func (x *MathVariable) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3161,52 +8063,50 @@ func (x *BaseConvertRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BaseConvertRequest.ProtoReflect.Descriptor instead.
-func (*BaseConvertRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use MathVariable.ProtoReflect.Descriptor instead.
+func (*MathVariable) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *BaseConvertRequest) GetInput() string {
+func (x *MathVariable) GetName() string {
 	if x != nil {
-		return x.Input
+		return x.Name
 	}
 	return ""
 }
 
-func (x *BaseConvertRequest) GetSourceBase() int32 {
+func (x *MathVariable) GetValue() float64 {
 	if x != nil {
-		return x.SourceBase
+		return x.Value
 	}
 	return 0
 }
 
-type BaseConvertResponse struct {
+type MathEvalRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Decimal       string                 `protobuf:"bytes,1,opt,name=decimal,proto3" json:"decimal,omitempty"`
-	Hex           string                 `protobuf:"bytes,2,opt,name=hex,proto3" json:"hex,omitempty"`
-	Binary        string                 `protobuf:"bytes,3,opt,name=binary,proto3" json:"binary,omitempty"`
-	Octal         string                 `protobuf:"bytes,4,opt,name=octal,proto3" json:"octal,omitempty"`
-	Base64        string                 `protobuf:"bytes,5,opt,name=base64,proto3" json:"base64,omitempty"`
-	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	Expression    string                 `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	Variables     []*MathVariable        `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty"`
+	Precision     int32                  `protobuf:"varint,3,opt,name=precision,proto3" json:"precision,omitempty"` // 0-15 decimal places, default 10
+	Degrees       bool                   `protobuf:"varint,4,opt,name=degrees,proto3" json:"degrees,omitempty"`     // if true, trig input/output in degrees
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BaseConvertResponse) Reset() {
-	*x = BaseConvertResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[51]
+func (x *MathEvalRequest) Reset() {
+	*x = MathEvalRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BaseConvertResponse) String() string {
+func (x *MathEvalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BaseConvertResponse) ProtoMessage() {}
+func (*MathEvalRequest) ProtoMessage() {}
 
-func (x *BaseConvertResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[51]
+func (x *MathEvalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3217,75 +8117,63 @@ func (x *BaseConvertResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BaseConvertResponse.ProtoReflect.Descriptor instead.
-func (*BaseConvertResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{51}
-}
-
-func (x *BaseConvertResponse) GetDecimal() string {
-	if x != nil {
-		return x.Decimal
-	}
-	return ""
-}
-
-func (x *BaseConvertResponse) GetHex() string {
-	if x != nil {
-		return x.Hex
-	}
-	return ""
+// Deprecated: Use MathEvalRequest.ProtoReflect.Descriptor instead.
+func (*MathEvalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *BaseConvertResponse) GetBinary() string {
+func (x *MathEvalRequest) GetExpression() string {
 	if x != nil {
-		return x.Binary
+		return x.Expression
 	}
 	return ""
 }
 
-func (x *BaseConvertResponse) GetOctal() string {
+func (x *MathEvalRequest) GetVariables() []*MathVariable {
 	if x != nil {
-		return x.Octal
+		return x.Variables
 	}
-	return ""
+	return nil
 }
 
-func (x *BaseConvertResponse) GetBase64() string {
+func (x *MathEvalRequest) GetPrecision() int32 {
 	if x != nil {
-		return x.Base64
+		return x.Precision
 	}
-	return ""
+	return 0
 }
 
-func (x *BaseConvertResponse) GetError() string {
+func (x *MathEvalRequest) GetDegrees() bool {
 	if x != nil {
-		return x.Error
+		return x.Degrees
 	}
-	return ""
+	return false
 }
 
-type ChmodRequest struct {
+type MathEvalResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Input         string                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"` // octal "755" or symbolic "rwxr-xr-x"
+	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	RawValue      float64                `protobuf:"fixed64,2,opt,name=raw_value,json=rawValue,proto3" json:"raw_value,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChmodRequest) Reset() {
-	*x = ChmodRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[52]
+func (x *MathEvalResponse) Reset() {
+	*x = MathEvalResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChmodRequest) String() string {
+func (x *MathEvalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChmodRequest) ProtoMessage() {}
+func (*MathEvalResponse) ProtoMessage() {}
 
-func (x *ChmodRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[52]
+func (x *MathEvalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3296,56 +8184,56 @@ func (x *ChmodRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChmodRequest.ProtoReflect.Descriptor instead.
-func (*ChmodRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use MathEvalResponse.ProtoReflect.Descriptor instead.
+func (*MathEvalResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *ChmodRequest) GetInput() string {
+func (x *MathEvalResponse) GetResult() string {
 	if x != nil {
-		return x.Input
+		return x.Result
 	}
 	return ""
 }
 
-type ChmodResponse struct {
+func (x *MathEvalResponse) GetRawValue() float64 {
+	if x != nil {
+		return x.RawValue
+	}
+	return 0
+}
+
+func (x *MathEvalResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type PercentageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Octal         string                 `protobuf:"bytes,1,opt,name=octal,proto3" json:"octal,omitempty"`
-	Symbolic      string                 `protobuf:"bytes,2,opt,name=symbolic,proto3" json:"symbolic,omitempty"` // "rwxr-xr-x"
-	Value         int32                  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`      // decimal value e.g. 493
-	OwnerRead     bool                   `protobuf:"varint,4,opt,name=owner_read,json=ownerRead,proto3" json:"owner_read,omitempty"`
-	OwnerWrite    bool                   `protobuf:"varint,5,opt,name=owner_write,json=ownerWrite,proto3" json:"owner_write,omitempty"`
-	OwnerExecute  bool                   `protobuf:"varint,6,opt,name=owner_execute,json=ownerExecute,proto3" json:"owner_execute,omitempty"`
-	GroupRead     bool                   `protobuf:"varint,7,opt,name=group_read,json=groupRead,proto3" json:"group_read,omitempty"`
-	GroupWrite    bool                   `protobuf:"varint,8,opt,name=group_write,json=groupWrite,proto3" json:"group_write,omitempty"`
-	GroupExecute  bool                   `protobuf:"varint,9,opt,name=group_execute,json=groupExecute,proto3" json:"group_execute,omitempty"`
-	OtherRead     bool                   `protobuf:"varint,10,opt,name=other_read,json=otherRead,proto3" json:"other_read,omitempty"`
-	OtherWrite    bool                   `protobuf:"varint,11,opt,name=other_write,json=otherWrite,proto3" json:"other_write,omitempty"`
-	OtherExecute  bool                   `protobuf:"varint,12,opt,name=other_execute,json=otherExecute,proto3" json:"other_execute,omitempty"`
-	Setuid        bool                   `protobuf:"varint,13,opt,name=setuid,proto3" json:"setuid,omitempty"`
-	Setgid        bool                   `protobuf:"varint,14,opt,name=setgid,proto3" json:"setgid,omitempty"`
-	Sticky        bool                   `protobuf:"varint,15,opt,name=sticky,proto3" json:"sticky,omitempty"`
-	Description   string                 `protobuf:"bytes,16,opt,name=description,proto3" json:"description,omitempty"`
-	Error         string                 `protobuf:"bytes,17,opt,name=error,proto3" json:"error,omitempty"`
+	Mode          PercentMode            `protobuf:"varint,1,opt,name=mode,proto3,enum=privutil.PercentMode" json:"mode,omitempty"`
+	A             float64                `protobuf:"fixed64,2,opt,name=a,proto3" json:"a,omitempty"`
+	B             float64                `protobuf:"fixed64,3,opt,name=b,proto3" json:"b,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChmodResponse) Reset() {
-	*x = ChmodResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[53]
+func (x *PercentageRequest) Reset() {
+	*x = PercentageRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChmodResponse) String() string {
+func (x *PercentageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChmodResponse) ProtoMessage() {}
+func (*PercentageRequest) ProtoMessage() {}
 
-func (x *ChmodResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[53]
+func (x *PercentageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3356,152 +8244,123 @@ func (x *ChmodResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChmodResponse.ProtoReflect.Descriptor instead.
-func (*ChmodResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{53}
-}
-
-func (x *ChmodResponse) GetOctal() string {
-	if x != nil {
-		return x.Octal
-	}
-	return ""
+// Deprecated: Use PercentageRequest.ProtoReflect.Descriptor instead.
+func (*PercentageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *ChmodResponse) GetSymbolic() string {
+func (x *PercentageRequest) GetMode() PercentMode {
 	if x != nil {
-		return x.Symbolic
+		return x.Mode
 	}
-	return ""
+	return PercentMode_PCT_X_OF_Y
 }
 
-func (x *ChmodResponse) GetValue() int32 {
+func (x *PercentageRequest) GetA() float64 {
 	if x != nil {
-		return x.Value
+		return x.A
 	}
 	return 0
 }
 
-func (x *ChmodResponse) GetOwnerRead() bool {
-	if x != nil {
-		return x.OwnerRead
-	}
-	return false
-}
-
-func (x *ChmodResponse) GetOwnerWrite() bool {
-	if x != nil {
-		return x.OwnerWrite
-	}
-	return false
-}
-
-func (x *ChmodResponse) GetOwnerExecute() bool {
-	if x != nil {
-		return x.OwnerExecute
-	}
-	return false
-}
-
-func (x *ChmodResponse) GetGroupRead() bool {
+func (x *PercentageRequest) GetB() float64 {
 	if x != nil {
-		return x.GroupRead
+		return x.B
 	}
-	return false
+	return 0
 }
 
-func (x *ChmodResponse) GetGroupWrite() bool {
-	if x != nil {
-		return x.GroupWrite
-	}
-	return false
+type PercentageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        float64                `protobuf:"fixed64,1,opt,name=result,proto3" json:"result,omitempty"`
+	Formatted     string                 `protobuf:"bytes,2,opt,name=formatted,proto3" json:"formatted,omitempty"` // human-readable sentence
+	Formula       string                 `protobuf:"bytes,3,opt,name=formula,proto3" json:"formula,omitempty"`     // the formula used
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChmodResponse) GetGroupExecute() bool {
-	if x != nil {
-		return x.GroupExecute
-	}
-	return false
+func (x *PercentageResponse) Reset() {
+	*x = PercentageResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChmodResponse) GetOtherRead() bool {
-	if x != nil {
-		return x.OtherRead
-	}
-	return false
+func (x *PercentageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ChmodResponse) GetOtherWrite() bool {
-	if x != nil {
-		return x.OtherWrite
-	}
-	return false
-}
+func (*PercentageResponse) ProtoMessage() {}
 
-func (x *ChmodResponse) GetOtherExecute() bool {
+func (x *PercentageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[110]
 	if x != nil {
-		return x.OtherExecute
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *ChmodResponse) GetSetuid() bool {
-	if x != nil {
-		return x.Setuid
-	}
-	return false
+// Deprecated: Use PercentageResponse.ProtoReflect.Descriptor instead.
+func (*PercentageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *ChmodResponse) GetSetgid() bool {
+func (x *PercentageResponse) GetResult() float64 {
 	if x != nil {
-		return x.Setgid
+		return x.Result
 	}
-	return false
+	return 0
 }
 
-func (x *ChmodResponse) GetSticky() bool {
+func (x *PercentageResponse) GetFormatted() string {
 	if x != nil {
-		return x.Sticky
+		return x.Formatted
 	}
-	return false
+	return ""
 }
 
-func (x *ChmodResponse) GetDescription() string {
+func (x *PercentageResponse) GetFormula() string {
 	if x != nil {
-		return x.Description
+		return x.Formula
 	}
 	return ""
 }
 
-func (x *ChmodResponse) GetError() string {
+func (x *PercentageResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type Ipv4ConvertRequest struct {
+type TempConvertRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Input         string                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"` // dotted "192.168.1.1", decimal "3232235777", hex "0xC0A80101", binary "11000000..."
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	FromUnit      string                 `protobuf:"bytes,2,opt,name=from_unit,json=fromUnit,proto3" json:"from_unit,omitempty"` // "c", "f", "k"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Ipv4ConvertRequest) Reset() {
-	*x = Ipv4ConvertRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[54]
+func (x *TempConvertRequest) Reset() {
+	*x = TempConvertRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Ipv4ConvertRequest) String() string {
+func (x *TempConvertRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Ipv4ConvertRequest) ProtoMessage() {}
+func (*TempConvertRequest) ProtoMessage() {}
 
-func (x *Ipv4ConvertRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[54]
+func (x *TempConvertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3512,44 +8371,50 @@ func (x *Ipv4ConvertRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Ipv4ConvertRequest.ProtoReflect.Descriptor instead.
-func (*Ipv4ConvertRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use TempConvertRequest.ProtoReflect.Descriptor instead.
+func (*TempConvertRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *Ipv4ConvertRequest) GetInput() string {
+func (x *TempConvertRequest) GetValue() float64 {
 	if x != nil {
-		return x.Input
+		return x.Value
+	}
+	return 0
+}
+
+func (x *TempConvertRequest) GetFromUnit() string {
+	if x != nil {
+		return x.FromUnit
 	}
 	return ""
 }
 
-type Ipv4ConvertResponse struct {
+type TempConvertResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Dotted        string                 `protobuf:"bytes,1,opt,name=dotted,proto3" json:"dotted,omitempty"`   // "192.168.1.1"
-	Decimal       string                 `protobuf:"bytes,2,opt,name=decimal,proto3" json:"decimal,omitempty"` // "3232235777"
-	Hex           string                 `protobuf:"bytes,3,opt,name=hex,proto3" json:"hex,omitempty"`         // "0xC0A80101"
-	Binary        string                 `protobuf:"bytes,4,opt,name=binary,proto3" json:"binary,omitempty"`   // "11000000.10101000.00000001.00000001"
-	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Celsius       float64                `protobuf:"fixed64,1,opt,name=celsius,proto3" json:"celsius,omitempty"`
+	Fahrenheit    float64                `protobuf:"fixed64,2,opt,name=fahrenheit,proto3" json:"fahrenheit,omitempty"`
+	Kelvin        float64                `protobuf:"fixed64,3,opt,name=kelvin,proto3" json:"kelvin,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Ipv4ConvertResponse) Reset() {
-	*x = Ipv4ConvertResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[55]
+func (x *TempConvertResponse) Reset() {
+	*x = TempConvertResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Ipv4ConvertResponse) String() string {
+func (x *TempConvertResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Ipv4ConvertResponse) ProtoMessage() {}
+func (*TempConvertResponse) ProtoMessage() {}
 
-func (x *Ipv4ConvertResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[55]
+func (x *TempConvertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3560,69 +8425,63 @@ func (x *Ipv4ConvertResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Ipv4ConvertResponse.ProtoReflect.Descriptor instead.
-func (*Ipv4ConvertResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{55}
-}
-
-func (x *Ipv4ConvertResponse) GetDotted() string {
-	if x != nil {
-		return x.Dotted
-	}
-	return ""
+// Deprecated: Use TempConvertResponse.ProtoReflect.Descriptor instead.
+func (*TempConvertResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *Ipv4ConvertResponse) GetDecimal() string {
+func (x *TempConvertResponse) GetCelsius() float64 {
 	if x != nil {
-		return x.Decimal
+		return x.Celsius
 	}
-	return ""
+	return 0
 }
 
-func (x *Ipv4ConvertResponse) GetHex() string {
+func (x *TempConvertResponse) GetFahrenheit() float64 {
 	if x != nil {
-		return x.Hex
+		return x.Fahrenheit
 	}
-	return ""
+	return 0
 }
 
-func (x *Ipv4ConvertResponse) GetBinary() string {
+func (x *TempConvertResponse) GetKelvin() float64 {
 	if x != nil {
-		return x.Binary
+		return x.Kelvin
 	}
-	return ""
+	return 0
 }
 
-func (x *Ipv4ConvertResponse) GetError() string {
+func (x *TempConvertResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type Ipv4RangeRequest struct {
+type UnitConvertRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Start         string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"` // dotted decimal
-	End           string                 `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`     // dotted decimal
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+	FromUnit      string                 `protobuf:"bytes,2,opt,name=from_unit,json=fromUnit,proto3" json:"from_unit,omitempty"`
+	Category      UnitCategory           `protobuf:"varint,3,opt,name=category,proto3,enum=privutil.UnitCategory" json:"category,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Ipv4RangeRequest) Reset() {
-	*x = Ipv4RangeRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[56]
+func (x *UnitConvertRequest) Reset() {
+	*x = UnitConvertRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Ipv4RangeRequest) String() string {
+func (x *UnitConvertRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Ipv4RangeRequest) ProtoMessage() {}
+func (*UnitConvertRequest) ProtoMessage() {}
 
-func (x *Ipv4RangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[56]
+func (x *UnitConvertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3633,50 +8492,57 @@ func (x *Ipv4RangeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Ipv4RangeRequest.ProtoReflect.Descriptor instead.
-func (*Ipv4RangeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use UnitConvertRequest.ProtoReflect.Descriptor instead.
+func (*UnitConvertRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *Ipv4RangeRequest) GetStart() string {
+func (x *UnitConvertRequest) GetValue() float64 {
 	if x != nil {
-		return x.Start
+		return x.Value
 	}
-	return ""
+	return 0
 }
 
-func (x *Ipv4RangeRequest) GetEnd() string {
+func (x *UnitConvertRequest) GetFromUnit() string {
 	if x != nil {
-		return x.End
+		return x.FromUnit
 	}
 	return ""
 }
 
-type Ipv4RangeResponse struct {
+func (x *UnitConvertRequest) GetCategory() UnitCategory {
+	if x != nil {
+		return x.Category
+	}
+	return UnitCategory_UNIT_BYTES
+}
+
+type UnitResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Addresses     []string               `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"` // individual IPs (capped at 256)
-	Cidrs         []string               `protobuf:"bytes,2,rep,name=cidrs,proto3" json:"cidrs,omitempty"`         // CIDR summary
-	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`        // total count in range
-	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Unit          string                 `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Value         float64                `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	Formatted     string                 `protobuf:"bytes,4,opt,name=formatted,proto3" json:"formatted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Ipv4RangeResponse) Reset() {
-	*x = Ipv4RangeResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[57]
+func (x *UnitResult) Reset() {
+	*x = UnitResult{}
+	mi := &file_proto_privutil_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Ipv4RangeResponse) String() string {
+func (x *UnitResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Ipv4RangeResponse) ProtoMessage() {}
+func (*UnitResult) ProtoMessage() {}
 
-func (x *Ipv4RangeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[57]
+func (x *UnitResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3687,64 +8553,62 @@ func (x *Ipv4RangeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Ipv4RangeResponse.ProtoReflect.Descriptor instead.
-func (*Ipv4RangeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use UnitResult.ProtoReflect.Descriptor instead.
+func (*UnitResult) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *Ipv4RangeResponse) GetAddresses() []string {
+func (x *UnitResult) GetUnit() string {
 	if x != nil {
-		return x.Addresses
+		return x.Unit
 	}
-	return nil
+	return ""
 }
 
-func (x *Ipv4RangeResponse) GetCidrs() []string {
+func (x *UnitResult) GetLabel() string {
 	if x != nil {
-		return x.Cidrs
+		return x.Label
 	}
-	return nil
+	return ""
 }
 
-func (x *Ipv4RangeResponse) GetTotal() int64 {
+func (x *UnitResult) GetValue() float64 {
 	if x != nil {
-		return x.Total
+		return x.Value
 	}
 	return 0
 }
 
-func (x *Ipv4RangeResponse) GetError() string {
+func (x *UnitResult) GetFormatted() string {
 	if x != nil {
-		return x.Error
+		return x.Formatted
 	}
 	return ""
 }
 
-type PortRequest struct {
+type UnitConvertResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	Min           int32                  `protobuf:"varint,2,opt,name=min,proto3" json:"min,omitempty"`
-	Max           int32                  `protobuf:"varint,3,opt,name=max,proto3" json:"max,omitempty"`
-	ExcludeSystem bool                   `protobuf:"varint,4,opt,name=exclude_system,json=excludeSystem,proto3" json:"exclude_system,omitempty"` // exclude ports 0–1023
+	Results       []*UnitResult          `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PortRequest) Reset() {
-	*x = PortRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[58]
+func (x *UnitConvertResponse) Reset() {
+	*x = UnitConvertResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PortRequest) String() string {
+func (x *UnitConvertResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PortRequest) ProtoMessage() {}
+func (*UnitConvertResponse) ProtoMessage() {}
 
-func (x *PortRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[58]
+func (x *UnitConvertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3755,62 +8619,48 @@ func (x *PortRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PortRequest.ProtoReflect.Descriptor instead.
-func (*PortRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{58}
-}
-
-func (x *PortRequest) GetCount() int32 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-func (x *PortRequest) GetMin() int32 {
-	if x != nil {
-		return x.Min
-	}
-	return 0
+// Deprecated: Use UnitConvertResponse.ProtoReflect.Descriptor instead.
+func (*UnitConvertResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *PortRequest) GetMax() int32 {
+func (x *UnitConvertResponse) GetResults() []*UnitResult {
 	if x != nil {
-		return x.Max
+		return x.Results
 	}
-	return 0
+	return nil
 }
 
-func (x *PortRequest) GetExcludeSystem() bool {
+func (x *UnitConvertResponse) GetError() string {
 	if x != nil {
-		return x.ExcludeSystem
+		return x.Error
 	}
-	return false
+	return ""
 }
 
-type PortResponse struct {
+type DateDiffRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ports         []int32                `protobuf:"varint,1,rep,packed,name=ports,proto3" json:"ports,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	FromDate      string                 `protobuf:"bytes,1,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"` // any parseable date/datetime string
+	ToDate        string                 `protobuf:"bytes,2,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PortResponse) Reset() {
-	*x = PortResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[59]
+func (x *DateDiffRequest) Reset() {
+	*x = DateDiffRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PortResponse) String() string {
+func (x *DateDiffRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PortResponse) ProtoMessage() {}
+func (*DateDiffRequest) ProtoMessage() {}
 
-func (x *PortResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[59]
+func (x *DateDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3821,52 +8671,59 @@ func (x *PortResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PortResponse.ProtoReflect.Descriptor instead.
-func (*PortResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use DateDiffRequest.ProtoReflect.Descriptor instead.
+func (*DateDiffRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *PortResponse) GetPorts() []int32 {
+func (x *DateDiffRequest) GetFromDate() string {
 	if x != nil {
-		return x.Ports
+		return x.FromDate
 	}
-	return nil
+	return ""
 }
 
-func (x *PortResponse) GetError() string {
+func (x *DateDiffRequest) GetToDate() string {
 	if x != nil {
-		return x.Error
+		return x.ToDate
 	}
 	return ""
 }
 
-type MacRequest struct {
+type DateDiffResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	Separator     string                 `protobuf:"bytes,2,opt,name=separator,proto3" json:"separator,omitempty"` // ":", "-", "." (Cisco), or "" (none)
-	Uppercase     bool                   `protobuf:"varint,3,opt,name=uppercase,proto3" json:"uppercase,omitempty"`
-	Oui           string                 `protobuf:"bytes,4,opt,name=oui,proto3" json:"oui,omitempty"`          // optional 3-byte OUI prefix e.g. "00:1A:2B"
-	Unicast       bool                   `protobuf:"varint,5,opt,name=unicast,proto3" json:"unicast,omitempty"` // ensure unicast bit is set (bit 0 of first byte = 0)
-	Local         bool                   `protobuf:"varint,6,opt,name=local,proto3" json:"local,omitempty"`     // set locally-administered bit (bit 1 of first byte = 1)
+	Years         int64                  `protobuf:"varint,1,opt,name=years,proto3" json:"years,omitempty"`
+	Months        int64                  `protobuf:"varint,2,opt,name=months,proto3" json:"months,omitempty"`
+	Weeks         int64                  `protobuf:"varint,3,opt,name=weeks,proto3" json:"weeks,omitempty"`
+	Days          int64                  `protobuf:"varint,4,opt,name=days,proto3" json:"days,omitempty"`
+	Hours         int64                  `protobuf:"varint,5,opt,name=hours,proto3" json:"hours,omitempty"`
+	Minutes       int64                  `protobuf:"varint,6,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	Seconds       int64                  `protobuf:"varint,7,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	TotalDays     int64                  `protobuf:"varint,8,opt,name=total_days,json=totalDays,proto3" json:"total_days,omitempty"`
+	TotalHours    int64                  `protobuf:"varint,9,opt,name=total_hours,json=totalHours,proto3" json:"total_hours,omitempty"`
+	TotalSecs     int64                  `protobuf:"varint,10,opt,name=total_secs,json=totalSecs,proto3" json:"total_secs,omitempty"`
+	Negative      bool                   `protobuf:"varint,11,opt,name=negative,proto3" json:"negative,omitempty"` // true when from is after to
+	Human         string                 `protobuf:"bytes,12,opt,name=human,proto3" json:"human,omitempty"`        // e.g. "2 years, 3 months, 5 days"
+	Error         string                 `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MacRequest) Reset() {
-	*x = MacRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[60]
+func (x *DateDiffResponse) Reset() {
+	*x = DateDiffResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MacRequest) String() string {
+func (x *DateDiffResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MacRequest) ProtoMessage() {}
+func (*DateDiffResponse) ProtoMessage() {}
 
-func (x *MacRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[60]
+func (x *DateDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3877,76 +8734,124 @@ func (x *MacRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MacRequest.ProtoReflect.Descriptor instead.
-func (*MacRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use DateDiffResponse.ProtoReflect.Descriptor instead.
+func (*DateDiffResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *MacRequest) GetCount() int32 {
+func (x *DateDiffResponse) GetYears() int64 {
 	if x != nil {
-		return x.Count
+		return x.Years
 	}
 	return 0
 }
 
-func (x *MacRequest) GetSeparator() string {
+func (x *DateDiffResponse) GetMonths() int64 {
 	if x != nil {
-		return x.Separator
+		return x.Months
 	}
-	return ""
+	return 0
 }
 
-func (x *MacRequest) GetUppercase() bool {
+func (x *DateDiffResponse) GetWeeks() int64 {
 	if x != nil {
-		return x.Uppercase
+		return x.Weeks
 	}
-	return false
+	return 0
 }
 
-func (x *MacRequest) GetOui() string {
+func (x *DateDiffResponse) GetDays() int64 {
 	if x != nil {
-		return x.Oui
+		return x.Days
 	}
-	return ""
+	return 0
 }
 
-func (x *MacRequest) GetUnicast() bool {
+func (x *DateDiffResponse) GetHours() int64 {
 	if x != nil {
-		return x.Unicast
+		return x.Hours
 	}
-	return false
+	return 0
 }
 
-func (x *MacRequest) GetLocal() bool {
+func (x *DateDiffResponse) GetMinutes() int64 {
 	if x != nil {
-		return x.Local
+		return x.Minutes
+	}
+	return 0
+}
+
+func (x *DateDiffResponse) GetSeconds() int64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+func (x *DateDiffResponse) GetTotalDays() int64 {
+	if x != nil {
+		return x.TotalDays
+	}
+	return 0
+}
+
+func (x *DateDiffResponse) GetTotalHours() int64 {
+	if x != nil {
+		return x.TotalHours
+	}
+	return 0
+}
+
+func (x *DateDiffResponse) GetTotalSecs() int64 {
+	if x != nil {
+		return x.TotalSecs
+	}
+	return 0
+}
+
+func (x *DateDiffResponse) GetNegative() bool {
+	if x != nil {
+		return x.Negative
 	}
 	return false
 }
 
-type MacResponse struct {
+func (x *DateDiffResponse) GetHuman() string {
+	if x != nil {
+		return x.Human
+	}
+	return ""
+}
+
+func (x *DateDiffResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type LeapYearRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Addresses     []string               `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Input         string                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"` // single year, comma list, or "YYYY-YYYY" range
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MacResponse) Reset() {
-	*x = MacResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[61]
+func (x *LeapYearRequest) Reset() {
+	*x = LeapYearRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MacResponse) String() string {
+func (x *LeapYearRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MacResponse) ProtoMessage() {}
+func (*LeapYearRequest) ProtoMessage() {}
 
-func (x *MacResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[61]
+func (x *LeapYearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3957,49 +8862,41 @@ func (x *MacResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MacResponse.ProtoReflect.Descriptor instead.
-func (*MacResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{61}
-}
-
-func (x *MacResponse) GetAddresses() []string {
-	if x != nil {
-		return x.Addresses
-	}
-	return nil
+// Deprecated: Use LeapYearRequest.ProtoReflect.Descriptor instead.
+func (*LeapYearRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *MacResponse) GetError() string {
+func (x *LeapYearRequest) GetInput() string {
 	if x != nil {
-		return x.Error
+		return x.Input
 	}
 	return ""
 }
 
-type HmacRequest struct {
+type LeapYearEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
-	Algo          string                 `protobuf:"bytes,3,opt,name=algo,proto3" json:"algo,omitempty"` // "sha256", "sha512", "sha1", "md5"
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	IsLeap        bool                   `protobuf:"varint,2,opt,name=is_leap,json=isLeap,proto3" json:"is_leap,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HmacRequest) Reset() {
-	*x = HmacRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[62]
+func (x *LeapYearEntry) Reset() {
+	*x = LeapYearEntry{}
+	mi := &file_proto_privutil_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HmacRequest) String() string {
+func (x *LeapYearEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HmacRequest) ProtoMessage() {}
+func (*LeapYearEntry) ProtoMessage() {}
 
-func (x *HmacRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[62]
+func (x *LeapYearEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4010,56 +8907,49 @@ func (x *HmacRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HmacRequest.ProtoReflect.Descriptor instead.
-func (*HmacRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{62}
-}
-
-func (x *HmacRequest) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use LeapYearEntry.ProtoReflect.Descriptor instead.
+func (*LeapYearEntry) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *HmacRequest) GetSecret() string {
+func (x *LeapYearEntry) GetYear() int32 {
 	if x != nil {
-		return x.Secret
+		return x.Year
 	}
-	return ""
+	return 0
 }
 
-func (x *HmacRequest) GetAlgo() string {
+func (x *LeapYearEntry) GetIsLeap() bool {
 	if x != nil {
-		return x.Algo
+		return x.IsLeap
 	}
-	return ""
+	return false
 }
 
-type HmacResponse struct {
+type LeapYearResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hex           string                 `protobuf:"bytes,1,opt,name=hex,proto3" json:"hex,omitempty"`
-	Base64        string                 `protobuf:"bytes,2,opt,name=base64,proto3" json:"base64,omitempty"`
+	Results       []*LeapYearEntry       `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	LeapCount     int32                  `protobuf:"varint,2,opt,name=leap_count,json=leapCount,proto3" json:"leap_count,omitempty"`
 	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HmacResponse) Reset() {
-	*x = HmacResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[63]
+func (x *LeapYearResponse) Reset() {
+	*x = LeapYearResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HmacResponse) String() string {
+func (x *LeapYearResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HmacResponse) ProtoMessage() {}
+func (*LeapYearResponse) ProtoMessage() {}
 
-func (x *HmacResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[63]
+func (x *LeapYearResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4070,60 +8960,61 @@ func (x *HmacResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HmacResponse.ProtoReflect.Descriptor instead.
-func (*HmacResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{63}
-}
-
-func (x *HmacResponse) GetHex() string {
+// Deprecated: Use LeapYearResponse.ProtoReflect.Descriptor instead.
+func (*LeapYearResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *LeapYearResponse) GetResults() []*LeapYearEntry {
 	if x != nil {
-		return x.Hex
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-func (x *HmacResponse) GetBase64() string {
+func (x *LeapYearResponse) GetLeapCount() int32 {
 	if x != nil {
-		return x.Base64
+		return x.LeapCount
 	}
-	return ""
+	return 0
 }
 
-func (x *HmacResponse) GetError() string {
+func (x *LeapYearResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type OtpRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Secret         string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`                                        // base32-encoded secret
-	Type           string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`                                            // "totp" or "hotp"
-	Counter        int64                  `protobuf:"varint,3,opt,name=counter,proto3" json:"counter,omitempty"`                                     // HOTP counter
-	Digits         int32                  `protobuf:"varint,4,opt,name=digits,proto3" json:"digits,omitempty"`                                       // 6 or 8 (default 6)
-	Period         int32                  `protobuf:"varint,5,opt,name=period,proto3" json:"period,omitempty"`                                       // TOTP period seconds (default 30)
-	Algo           string                 `protobuf:"bytes,6,opt,name=algo,proto3" json:"algo,omitempty"`                                            // "sha1", "sha256", "sha512" (default sha1)
-	GenerateSecret bool                   `protobuf:"varint,7,opt,name=generate_secret,json=generateSecret,proto3" json:"generate_secret,omitempty"` // if true, generate a new random secret
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type DateAddRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Years         int32                  `protobuf:"varint,2,opt,name=years,proto3" json:"years,omitempty"`
+	Months        int32                  `protobuf:"varint,3,opt,name=months,proto3" json:"months,omitempty"`
+	Weeks         int32                  `protobuf:"varint,4,opt,name=weeks,proto3" json:"weeks,omitempty"`
+	Days          int32                  `protobuf:"varint,5,opt,name=days,proto3" json:"days,omitempty"`
+	Hours         int32                  `protobuf:"varint,6,opt,name=hours,proto3" json:"hours,omitempty"`
+	Minutes       int32                  `protobuf:"varint,7,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	Seconds       int32                  `protobuf:"varint,8,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OtpRequest) Reset() {
-	*x = OtpRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[64]
+func (x *DateAddRequest) Reset() {
+	*x = DateAddRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OtpRequest) String() string {
+func (x *DateAddRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OtpRequest) ProtoMessage() {}
+func (*DateAddRequest) ProtoMessage() {}
 
-func (x *OtpRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[64]
+func (x *DateAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4134,87 +9025,96 @@ func (x *OtpRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OtpRequest.ProtoReflect.Descriptor instead.
-func (*OtpRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use DateAddRequest.ProtoReflect.Descriptor instead.
+func (*DateAddRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *OtpRequest) GetSecret() string {
+func (x *DateAddRequest) GetDate() string {
 	if x != nil {
-		return x.Secret
+		return x.Date
 	}
 	return ""
 }
 
-func (x *OtpRequest) GetType() string {
+func (x *DateAddRequest) GetYears() int32 {
 	if x != nil {
-		return x.Type
+		return x.Years
 	}
-	return ""
+	return 0
 }
 
-func (x *OtpRequest) GetCounter() int64 {
+func (x *DateAddRequest) GetMonths() int32 {
 	if x != nil {
-		return x.Counter
+		return x.Months
 	}
 	return 0
 }
 
-func (x *OtpRequest) GetDigits() int32 {
+func (x *DateAddRequest) GetWeeks() int32 {
 	if x != nil {
-		return x.Digits
+		return x.Weeks
 	}
 	return 0
 }
 
-func (x *OtpRequest) GetPeriod() int32 {
+func (x *DateAddRequest) GetDays() int32 {
 	if x != nil {
-		return x.Period
+		return x.Days
 	}
 	return 0
 }
 
-func (x *OtpRequest) GetAlgo() string {
+func (x *DateAddRequest) GetHours() int32 {
 	if x != nil {
-		return x.Algo
+		return x.Hours
 	}
-	return ""
+	return 0
 }
 
-func (x *OtpRequest) GetGenerateSecret() bool {
+func (x *DateAddRequest) GetMinutes() int32 {
 	if x != nil {
-		return x.GenerateSecret
+		return x.Minutes
 	}
-	return false
+	return 0
 }
 
-type OtpResponse struct {
+func (x *DateAddRequest) GetSeconds() int32 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+type DateAddResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`                                     // populated when generate_secret=true
-	ValidUntil    int64                  `protobuf:"varint,3,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`          // unix timestamp when current period ends
-	TimeRemaining int64                  `protobuf:"varint,4,opt,name=time_remaining,json=timeRemaining,proto3" json:"time_remaining,omitempty"` // seconds until next period
-	Uri           string                 `protobuf:"bytes,5,opt,name=uri,proto3" json:"uri,omitempty"`                                           // otpauth:// URI
-	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	Iso           string                 `protobuf:"bytes,1,opt,name=iso,proto3" json:"iso,omitempty"`                        // YYYY-MM-DD
+	IsoFull       string                 `protobuf:"bytes,2,opt,name=iso_full,json=isoFull,proto3" json:"iso_full,omitempty"` // RFC3339
+	Unix          string                 `protobuf:"bytes,3,opt,name=unix,proto3" json:"unix,omitempty"`
+	Weekday       string                 `protobuf:"bytes,4,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	DayOfYear     int32                  `protobuf:"varint,5,opt,name=day_of_year,json=dayOfYear,proto3" json:"day_of_year,omitempty"`
+	IsoWeek       int32                  `protobuf:"varint,6,opt,name=iso_week,json=isoWeek,proto3" json:"iso_week,omitempty"`
+	Formatted     string                 `protobuf:"bytes,7,opt,name=formatted,proto3" json:"formatted,omitempty"` // "Monday, 15 January 2024"
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OtpResponse) Reset() {
-	*x = OtpResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[65]
+func (x *DateAddResponse) Reset() {
+	*x = DateAddResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OtpResponse) String() string {
+func (x *DateAddResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OtpResponse) ProtoMessage() {}
+func (*DateAddResponse) ProtoMessage() {}
 
-func (x *OtpResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[65]
+func (x *DateAddResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4225,79 +9125,90 @@ func (x *OtpResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OtpResponse.ProtoReflect.Descriptor instead.
-func (*OtpResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use DateAddResponse.ProtoReflect.Descriptor instead.
+func (*DateAddResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *OtpResponse) GetCode() string {
+func (x *DateAddResponse) GetIso() string {
 	if x != nil {
-		return x.Code
+		return x.Iso
 	}
 	return ""
 }
 
-func (x *OtpResponse) GetSecret() string {
+func (x *DateAddResponse) GetIsoFull() string {
 	if x != nil {
-		return x.Secret
+		return x.IsoFull
 	}
 	return ""
 }
 
-func (x *OtpResponse) GetValidUntil() int64 {
+func (x *DateAddResponse) GetUnix() string {
 	if x != nil {
-		return x.ValidUntil
+		return x.Unix
+	}
+	return ""
+}
+
+func (x *DateAddResponse) GetWeekday() string {
+	if x != nil {
+		return x.Weekday
+	}
+	return ""
+}
+
+func (x *DateAddResponse) GetDayOfYear() int32 {
+	if x != nil {
+		return x.DayOfYear
 	}
 	return 0
 }
 
-func (x *OtpResponse) GetTimeRemaining() int64 {
+func (x *DateAddResponse) GetIsoWeek() int32 {
 	if x != nil {
-		return x.TimeRemaining
+		return x.IsoWeek
 	}
 	return 0
 }
 
-func (x *OtpResponse) GetUri() string {
+func (x *DateAddResponse) GetFormatted() string {
 	if x != nil {
-		return x.Uri
+		return x.Formatted
 	}
 	return ""
 }
 
-func (x *OtpResponse) GetError() string {
+func (x *DateAddResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type OtpValidateRequest struct {
+type DateFormatRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Window        int32                  `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"` // allowed drift in periods (default 1)
-	Period        int32                  `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty"` // default 30
-	Algo          string                 `protobuf:"bytes,5,opt,name=algo,proto3" json:"algo,omitempty"`      // "sha1", "sha256", "sha512"
+	DateStr       string                 `protobuf:"bytes,1,opt,name=date_str,json=dateStr,proto3" json:"date_str,omitempty"`
+	Timezone      string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA tz name, default "UTC"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OtpValidateRequest) Reset() {
-	*x = OtpValidateRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[66]
+func (x *DateFormatRequest) Reset() {
+	*x = DateFormatRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OtpValidateRequest) String() string {
+func (x *DateFormatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OtpValidateRequest) ProtoMessage() {}
+func (*DateFormatRequest) ProtoMessage() {}
 
-func (x *OtpValidateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[66]
+func (x *DateFormatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4308,69 +9219,100 @@ func (x *OtpValidateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OtpValidateRequest.ProtoReflect.Descriptor instead.
-func (*OtpValidateRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use DateFormatRequest.ProtoReflect.Descriptor instead.
+func (*DateFormatRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *OtpValidateRequest) GetSecret() string {
+func (x *DateFormatRequest) GetDateStr() string {
 	if x != nil {
-		return x.Secret
+		return x.DateStr
 	}
 	return ""
 }
 
-func (x *OtpValidateRequest) GetCode() string {
+func (x *DateFormatRequest) GetTimezone() string {
 	if x != nil {
-		return x.Code
+		return x.Timezone
 	}
 	return ""
 }
 
-func (x *OtpValidateRequest) GetWindow() int32 {
+type DateFormatEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Result        string                 `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DateFormatEntry) Reset() {
+	*x = DateFormatEntry{}
+	mi := &file_proto_privutil_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateFormatEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateFormatEntry) ProtoMessage() {}
+
+func (x *DateFormatEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[124]
 	if x != nil {
-		return x.Window
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *OtpValidateRequest) GetPeriod() int32 {
+// Deprecated: Use DateFormatEntry.ProtoReflect.Descriptor instead.
+func (*DateFormatEntry) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *DateFormatEntry) GetLabel() string {
 	if x != nil {
-		return x.Period
+		return x.Label
 	}
-	return 0
+	return ""
 }
 
-func (x *OtpValidateRequest) GetAlgo() string {
+func (x *DateFormatEntry) GetResult() string {
 	if x != nil {
-		return x.Algo
+		return x.Result
 	}
 	return ""
 }
 
-type OtpValidateResponse struct {
+type DateFormatResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Formats       []*DateFormatEntry     `protobuf:"bytes,1,rep,name=formats,proto3" json:"formats,omitempty"`
 	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OtpValidateResponse) Reset() {
-	*x = OtpValidateResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[67]
+func (x *DateFormatResponse) Reset() {
+	*x = DateFormatResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OtpValidateResponse) String() string {
+func (x *DateFormatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OtpValidateResponse) ProtoMessage() {}
+func (*DateFormatResponse) ProtoMessage() {}
 
-func (x *OtpValidateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[67]
+func (x *DateFormatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4381,48 +9323,47 @@ func (x *OtpValidateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OtpValidateResponse.ProtoReflect.Descriptor instead.
-func (*OtpValidateResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use DateFormatResponse.ProtoReflect.Descriptor instead.
+func (*DateFormatResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *OtpValidateResponse) GetValid() bool {
+func (x *DateFormatResponse) GetFormats() []*DateFormatEntry {
 	if x != nil {
-		return x.Valid
+		return x.Formats
 	}
-	return false
+	return nil
 }
 
-func (x *OtpValidateResponse) GetError() string {
+func (x *DateFormatResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type UlidRequest struct {
+type DateInfoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
-	Monotonic     bool                   `protobuf:"varint,2,opt,name=monotonic,proto3" json:"monotonic,omitempty"` // use monotonic entropy for ordering within same millisecond
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UlidRequest) Reset() {
-	*x = UlidRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[68]
+func (x *DateInfoRequest) Reset() {
+	*x = DateInfoRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UlidRequest) String() string {
+func (x *DateInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UlidRequest) ProtoMessage() {}
+func (*DateInfoRequest) ProtoMessage() {}
 
-func (x *UlidRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[68]
+func (x *DateInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4433,48 +9374,54 @@ func (x *UlidRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UlidRequest.ProtoReflect.Descriptor instead.
-func (*UlidRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{68}
-}
-
-func (x *UlidRequest) GetCount() int32 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
+// Deprecated: Use DateInfoRequest.ProtoReflect.Descriptor instead.
+func (*DateInfoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *UlidRequest) GetMonotonic() bool {
+func (x *DateInfoRequest) GetDate() string {
 	if x != nil {
-		return x.Monotonic
+		return x.Date
 	}
-	return false
+	return ""
 }
 
-type UlidResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ulids         []string               `protobuf:"bytes,1,rep,name=ulids,proto3" json:"ulids,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DateInfoResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Weekday        string                 `protobuf:"bytes,1,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	IsWeekend      bool                   `protobuf:"varint,2,opt,name=is_weekend,json=isWeekend,proto3" json:"is_weekend,omitempty"`
+	DayOfYear      int32                  `protobuf:"varint,3,opt,name=day_of_year,json=dayOfYear,proto3" json:"day_of_year,omitempty"`
+	DaysInYear     int32                  `protobuf:"varint,4,opt,name=days_in_year,json=daysInYear,proto3" json:"days_in_year,omitempty"`
+	DaysLeftYear   int32                  `protobuf:"varint,5,opt,name=days_left_year,json=daysLeftYear,proto3" json:"days_left_year,omitempty"`
+	IsoWeek        int32                  `protobuf:"varint,6,opt,name=iso_week,json=isoWeek,proto3" json:"iso_week,omitempty"`
+	IsoYear        int32                  `protobuf:"varint,7,opt,name=iso_year,json=isoYear,proto3" json:"iso_year,omitempty"`
+	Quarter        string                 `protobuf:"bytes,8,opt,name=quarter,proto3" json:"quarter,omitempty"` // "Q1"…"Q4"
+	DaysInMonth    int32                  `protobuf:"varint,9,opt,name=days_in_month,json=daysInMonth,proto3" json:"days_in_month,omitempty"`
+	DaysLeftMonth  int32                  `protobuf:"varint,10,opt,name=days_left_month,json=daysLeftMonth,proto3" json:"days_left_month,omitempty"`
+	UnixSec        int64                  `protobuf:"varint,11,opt,name=unix_sec,json=unixSec,proto3" json:"unix_sec,omitempty"`
+	Zodiac         string                 `protobuf:"bytes,12,opt,name=zodiac,proto3" json:"zodiac,omitempty"`
+	Season         string                 `protobuf:"bytes,13,opt,name=season,proto3" json:"season,omitempty"` // Northern hemisphere
+	DaysSinceEpoch int64                  `protobuf:"varint,14,opt,name=days_since_epoch,json=daysSinceEpoch,proto3" json:"days_since_epoch,omitempty"`
+	Error          string                 `protobuf:"bytes,15,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UlidResponse) Reset() {
-	*x = UlidResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[69]
+func (x *DateInfoResponse) Reset() {
+	*x = DateInfoResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UlidResponse) String() string {
+func (x *DateInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UlidResponse) ProtoMessage() {}
+func (*DateInfoResponse) ProtoMessage() {}
 
-func (x *UlidResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[69]
+func (x *DateInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4485,108 +9432,139 @@ func (x *UlidResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UlidResponse.ProtoReflect.Descriptor instead.
-func (*UlidResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use DateInfoResponse.ProtoReflect.Descriptor instead.
+func (*DateInfoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *UlidResponse) GetUlids() []string {
+func (x *DateInfoResponse) GetWeekday() string {
 	if x != nil {
-		return x.Ulids
+		return x.Weekday
 	}
-	return nil
+	return ""
 }
 
-func (x *UlidResponse) GetError() string {
+func (x *DateInfoResponse) GetIsWeekend() bool {
 	if x != nil {
-		return x.Error
+		return x.IsWeekend
 	}
-	return ""
+	return false
 }
 
-type CaesarRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Shift         int32                  `protobuf:"varint,2,opt,name=shift,proto3" json:"shift,omitempty"`  // 1–25; ROT13 = 13
-	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"` // "encode" or "decode"
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DateInfoResponse) GetDayOfYear() int32 {
+	if x != nil {
+		return x.DayOfYear
+	}
+	return 0
 }
 
-func (x *CaesarRequest) Reset() {
-	*x = CaesarRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[70]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *DateInfoResponse) GetDaysInYear() int32 {
+	if x != nil {
+		return x.DaysInYear
+	}
+	return 0
 }
 
-func (x *CaesarRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DateInfoResponse) GetDaysLeftYear() int32 {
+	if x != nil {
+		return x.DaysLeftYear
+	}
+	return 0
 }
 
-func (*CaesarRequest) ProtoMessage() {}
+func (x *DateInfoResponse) GetIsoWeek() int32 {
+	if x != nil {
+		return x.IsoWeek
+	}
+	return 0
+}
 
-func (x *CaesarRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[70]
+func (x *DateInfoResponse) GetIsoYear() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.IsoYear
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CaesarRequest.ProtoReflect.Descriptor instead.
-func (*CaesarRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{70}
+func (x *DateInfoResponse) GetQuarter() string {
+	if x != nil {
+		return x.Quarter
+	}
+	return ""
 }
 
-func (x *CaesarRequest) GetText() string {
+func (x *DateInfoResponse) GetDaysInMonth() int32 {
 	if x != nil {
-		return x.Text
+		return x.DaysInMonth
+	}
+	return 0
+}
+
+func (x *DateInfoResponse) GetDaysLeftMonth() int32 {
+	if x != nil {
+		return x.DaysLeftMonth
+	}
+	return 0
+}
+
+func (x *DateInfoResponse) GetUnixSec() int64 {
+	if x != nil {
+		return x.UnixSec
+	}
+	return 0
+}
+
+func (x *DateInfoResponse) GetZodiac() string {
+	if x != nil {
+		return x.Zodiac
 	}
 	return ""
 }
 
-func (x *CaesarRequest) GetShift() int32 {
+func (x *DateInfoResponse) GetSeason() string {
 	if x != nil {
-		return x.Shift
+		return x.Season
+	}
+	return ""
+}
+
+func (x *DateInfoResponse) GetDaysSinceEpoch() int64 {
+	if x != nil {
+		return x.DaysSinceEpoch
 	}
 	return 0
 }
 
-func (x *CaesarRequest) GetAction() string {
+func (x *DateInfoResponse) GetError() string {
 	if x != nil {
-		return x.Action
+		return x.Error
 	}
 	return ""
 }
 
-type CaesarResponse struct {
+type QueryParam struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CaesarResponse) Reset() {
-	*x = CaesarResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[71]
+func (x *QueryParam) Reset() {
+	*x = QueryParam{}
+	mi := &file_proto_privutil_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CaesarResponse) String() string {
+func (x *QueryParam) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CaesarResponse) ProtoMessage() {}
+func (*QueryParam) ProtoMessage() {}
 
-func (x *CaesarResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[71]
+func (x *QueryParam) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4597,49 +9575,47 @@ func (x *CaesarResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CaesarResponse.ProtoReflect.Descriptor instead.
-func (*CaesarResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use QueryParam.ProtoReflect.Descriptor instead.
+func (*QueryParam) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *CaesarResponse) GetResult() string {
+func (x *QueryParam) GetKey() string {
 	if x != nil {
-		return x.Result
+		return x.Key
 	}
 	return ""
 }
 
-func (x *CaesarResponse) GetError() string {
+func (x *QueryParam) GetValue() string {
 	if x != nil {
-		return x.Error
+		return x.Value
 	}
 	return ""
 }
 
-type TextEncodeRequest struct {
+type UrlParseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // "encode" or "decode"
-	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"` // "binary", "hex", "octal", "decimal"
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TextEncodeRequest) Reset() {
-	*x = TextEncodeRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[72]
+func (x *UrlParseRequest) Reset() {
+	*x = UrlParseRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TextEncodeRequest) String() string {
+func (x *UrlParseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TextEncodeRequest) ProtoMessage() {}
+func (*UrlParseRequest) ProtoMessage() {}
 
-func (x *TextEncodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[72]
+func (x *UrlParseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4650,55 +9626,52 @@ func (x *TextEncodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TextEncodeRequest.ProtoReflect.Descriptor instead.
-func (*TextEncodeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{72}
-}
-
-func (x *TextEncodeRequest) GetText() string {
-	if x != nil {
-		return x.Text
-	}
-	return ""
-}
-
-func (x *TextEncodeRequest) GetAction() string {
-	if x != nil {
-		return x.Action
-	}
-	return ""
+// Deprecated: Use UrlParseRequest.ProtoReflect.Descriptor instead.
+func (*UrlParseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *TextEncodeRequest) GetFormat() string {
+func (x *UrlParseRequest) GetUrl() string {
 	if x != nil {
-		return x.Format
+		return x.Url
 	}
 	return ""
 }
 
-type TextEncodeResponse struct {
+type UrlParseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Scheme        string                 `protobuf:"bytes,1,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Host          string                 `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"` // hostname:port
+	Hostname      string                 `protobuf:"bytes,5,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Port          string                 `protobuf:"bytes,6,opt,name=port,proto3" json:"port,omitempty"`
+	Path          string                 `protobuf:"bytes,7,opt,name=path,proto3" json:"path,omitempty"`
+	Query         string                 `protobuf:"bytes,8,opt,name=query,proto3" json:"query,omitempty"` // raw query string
+	QueryParams   []*QueryParam          `protobuf:"bytes,9,rep,name=query_params,json=queryParams,proto3" json:"query_params,omitempty"`
+	Fragment      string                 `protobuf:"bytes,10,opt,name=fragment,proto3" json:"fragment,omitempty"`
+	Normalized    string                 `protobuf:"bytes,11,opt,name=normalized,proto3" json:"normalized,omitempty"`
+	IsValid       bool                   `protobuf:"varint,12,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
+	Error         string                 `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TextEncodeResponse) Reset() {
-	*x = TextEncodeResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[73]
+func (x *UrlParseResponse) Reset() {
+	*x = UrlParseResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TextEncodeResponse) String() string {
+func (x *UrlParseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TextEncodeResponse) ProtoMessage() {}
+func (*UrlParseResponse) ProtoMessage() {}
 
-func (x *TextEncodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[73]
+func (x *UrlParseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4709,100 +9682,124 @@ func (x *TextEncodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TextEncodeResponse.ProtoReflect.Descriptor instead.
-func (*TextEncodeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use UrlParseResponse.ProtoReflect.Descriptor instead.
+func (*UrlParseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *TextEncodeResponse) GetResult() string {
+func (x *UrlParseResponse) GetScheme() string {
 	if x != nil {
-		return x.Result
+		return x.Scheme
 	}
 	return ""
 }
 
-func (x *TextEncodeResponse) GetError() string {
+func (x *UrlParseResponse) GetUsername() string {
 	if x != nil {
-		return x.Error
+		return x.Username
 	}
 	return ""
 }
 
-type MorseRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // "encode" or "decode"
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UrlParseResponse) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
 }
 
-func (x *MorseRequest) Reset() {
-	*x = MorseRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[74]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UrlParseResponse) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
 }
 
-func (x *MorseRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UrlParseResponse) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
 }
 
-func (*MorseRequest) ProtoMessage() {}
+func (x *UrlParseResponse) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
 
-func (x *MorseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[74]
+func (x *UrlParseResponse) GetPath() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Path
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use MorseRequest.ProtoReflect.Descriptor instead.
-func (*MorseRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{74}
+func (x *UrlParseResponse) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
 }
 
-func (x *MorseRequest) GetText() string {
+func (x *UrlParseResponse) GetQueryParams() []*QueryParam {
 	if x != nil {
-		return x.Text
+		return x.QueryParams
+	}
+	return nil
+}
+
+func (x *UrlParseResponse) GetFragment() string {
+	if x != nil {
+		return x.Fragment
 	}
 	return ""
 }
 
-func (x *MorseRequest) GetAction() string {
+func (x *UrlParseResponse) GetNormalized() string {
 	if x != nil {
-		return x.Action
+		return x.Normalized
 	}
 	return ""
 }
 
-type MorseResponse struct {
+func (x *UrlParseResponse) GetIsValid() bool {
+	if x != nil {
+		return x.IsValid
+	}
+	return false
+}
+
+func (x *UrlParseResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type UserAgentParseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,1,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MorseResponse) Reset() {
-	*x = MorseResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[75]
+func (x *UserAgentParseRequest) Reset() {
+	*x = UserAgentParseRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MorseResponse) String() string {
+func (x *UserAgentParseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MorseResponse) ProtoMessage() {}
+func (*UserAgentParseRequest) ProtoMessage() {}
 
-func (x *MorseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[75]
+func (x *UserAgentParseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4813,48 +9810,41 @@ func (x *MorseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MorseResponse.ProtoReflect.Descriptor instead.
-func (*MorseResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{75}
-}
-
-func (x *MorseResponse) GetResult() string {
-	if x != nil {
-		return x.Result
-	}
-	return ""
+// Deprecated: Use UserAgentParseRequest.ProtoReflect.Descriptor instead.
+func (*UserAgentParseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *MorseResponse) GetError() string {
+func (x *UserAgentParseRequest) GetUserAgent() string {
 	if x != nil {
-		return x.Error
+		return x.UserAgent
 	}
 	return ""
 }
 
-type BasicAuthRequest struct {
+type UAParsedField struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BasicAuthRequest) Reset() {
-	*x = BasicAuthRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[76]
+func (x *UAParsedField) Reset() {
+	*x = UAParsedField{}
+	mi := &file_proto_privutil_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BasicAuthRequest) String() string {
+func (x *UAParsedField) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BasicAuthRequest) ProtoMessage() {}
+func (*UAParsedField) ProtoMessage() {}
 
-func (x *BasicAuthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[76]
+func (x *UAParsedField) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4865,50 +9855,57 @@ func (x *BasicAuthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BasicAuthRequest.ProtoReflect.Descriptor instead.
-func (*BasicAuthRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use UAParsedField.ProtoReflect.Descriptor instead.
+func (*UAParsedField) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *BasicAuthRequest) GetUsername() string {
+func (x *UAParsedField) GetLabel() string {
 	if x != nil {
-		return x.Username
+		return x.Label
 	}
 	return ""
 }
 
-func (x *BasicAuthRequest) GetPassword() string {
+func (x *UAParsedField) GetValue() string {
 	if x != nil {
-		return x.Password
+		return x.Value
 	}
-	return ""
-}
-
-type BasicAuthResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Header        string                 `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`   // "Basic <token>"
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`     // base64 part only
-	Decoded       string                 `protobuf:"bytes,3,opt,name=decoded,proto3" json:"decoded,omitempty"` // "username:password" (for reverse direction)
-	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *BasicAuthResponse) Reset() {
-	*x = BasicAuthResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[77]
+type UserAgentParseResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BrowserName    string                 `protobuf:"bytes,1,opt,name=browser_name,json=browserName,proto3" json:"browser_name,omitempty"`
+	BrowserVersion string                 `protobuf:"bytes,2,opt,name=browser_version,json=browserVersion,proto3" json:"browser_version,omitempty"`
+	OsName         string                 `protobuf:"bytes,3,opt,name=os_name,json=osName,proto3" json:"os_name,omitempty"`
+	OsVersion      string                 `protobuf:"bytes,4,opt,name=os_version,json=osVersion,proto3" json:"os_version,omitempty"`
+	DeviceType     string                 `protobuf:"bytes,5,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"` // "desktop", "mobile", "tablet", "bot"
+	Engine         string                 `protobuf:"bytes,6,opt,name=engine,proto3" json:"engine,omitempty"`
+	EngineVersion  string                 `protobuf:"bytes,7,opt,name=engine_version,json=engineVersion,proto3" json:"engine_version,omitempty"`
+	IsBot          bool                   `protobuf:"varint,8,opt,name=is_bot,json=isBot,proto3" json:"is_bot,omitempty"`
+	IsMobile       bool                   `protobuf:"varint,9,opt,name=is_mobile,json=isMobile,proto3" json:"is_mobile,omitempty"`
+	Fields         []*UAParsedField       `protobuf:"bytes,10,rep,name=fields,proto3" json:"fields,omitempty"`
+	Error          string                 `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UserAgentParseResponse) Reset() {
+	*x = UserAgentParseResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BasicAuthResponse) String() string {
+func (x *UserAgentParseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BasicAuthResponse) ProtoMessage() {}
+func (*UserAgentParseResponse) ProtoMessage() {}
 
-func (x *BasicAuthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[77]
+func (x *UserAgentParseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4919,130 +9916,111 @@ func (x *BasicAuthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BasicAuthResponse.ProtoReflect.Descriptor instead.
-func (*BasicAuthResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use UserAgentParseResponse.ProtoReflect.Descriptor instead.
+func (*UserAgentParseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *BasicAuthResponse) GetHeader() string {
+func (x *UserAgentParseResponse) GetBrowserName() string {
 	if x != nil {
-		return x.Header
+		return x.BrowserName
 	}
 	return ""
 }
 
-func (x *BasicAuthResponse) GetToken() string {
+func (x *UserAgentParseResponse) GetBrowserVersion() string {
 	if x != nil {
-		return x.Token
+		return x.BrowserVersion
 	}
 	return ""
 }
 
-func (x *BasicAuthResponse) GetDecoded() string {
+func (x *UserAgentParseResponse) GetOsName() string {
 	if x != nil {
-		return x.Decoded
+		return x.OsName
 	}
 	return ""
 }
 
-func (x *BasicAuthResponse) GetError() string {
+func (x *UserAgentParseResponse) GetOsVersion() string {
 	if x != nil {
-		return x.Error
+		return x.OsVersion
 	}
 	return ""
 }
 
-type SlugifyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Separator     string                 `protobuf:"bytes,2,opt,name=separator,proto3" json:"separator,omitempty"`          // "-" (default), "_", or "" (no separator)
-	Uppercase     bool                   `protobuf:"varint,3,opt,name=uppercase,proto3" json:"uppercase,omitempty"`         // default false → lowercase
-	MaxLen        int32                  `protobuf:"varint,4,opt,name=max_len,json=maxLen,proto3" json:"max_len,omitempty"` // 0 = unlimited
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SlugifyRequest) Reset() {
-	*x = SlugifyRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[78]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *SlugifyRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SlugifyRequest) ProtoMessage() {}
-
-func (x *SlugifyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[78]
+func (x *UserAgentParseResponse) GetDeviceType() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DeviceType
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use SlugifyRequest.ProtoReflect.Descriptor instead.
-func (*SlugifyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{78}
+func (x *UserAgentParseResponse) GetEngine() string {
+	if x != nil {
+		return x.Engine
+	}
+	return ""
 }
 
-func (x *SlugifyRequest) GetText() string {
+func (x *UserAgentParseResponse) GetEngineVersion() string {
 	if x != nil {
-		return x.Text
+		return x.EngineVersion
 	}
 	return ""
 }
 
-func (x *SlugifyRequest) GetSeparator() string {
+func (x *UserAgentParseResponse) GetIsBot() bool {
 	if x != nil {
-		return x.Separator
+		return x.IsBot
 	}
-	return ""
+	return false
 }
 
-func (x *SlugifyRequest) GetUppercase() bool {
+func (x *UserAgentParseResponse) GetIsMobile() bool {
 	if x != nil {
-		return x.Uppercase
+		return x.IsMobile
 	}
 	return false
 }
 
-func (x *SlugifyRequest) GetMaxLen() int32 {
+func (x *UserAgentParseResponse) GetFields() []*UAParsedField {
 	if x != nil {
-		return x.MaxLen
+		return x.Fields
 	}
-	return 0
+	return nil
 }
 
-type SlugifyResponse struct {
+func (x *UserAgentParseResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type HttpStatusSearchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`       // code number, name keyword, or "" for all
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"` // "1xx", "2xx", "3xx", "4xx", "5xx", or "" for all
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SlugifyResponse) Reset() {
-	*x = SlugifyResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[79]
+func (x *HttpStatusSearchRequest) Reset() {
+	*x = HttpStatusSearchRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SlugifyResponse) String() string {
+func (x *HttpStatusSearchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SlugifyResponse) ProtoMessage() {}
+func (*HttpStatusSearchRequest) ProtoMessage() {}
 
-func (x *SlugifyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[79]
+func (x *HttpStatusSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5053,47 +10031,50 @@ func (x *SlugifyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SlugifyResponse.ProtoReflect.Descriptor instead.
-func (*SlugifyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use HttpStatusSearchRequest.ProtoReflect.Descriptor instead.
+func (*HttpStatusSearchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *SlugifyResponse) GetResult() string {
+func (x *HttpStatusSearchRequest) GetQuery() string {
 	if x != nil {
-		return x.Result
+		return x.Query
 	}
 	return ""
 }
 
-func (x *SlugifyResponse) GetError() string {
+func (x *HttpStatusSearchRequest) GetCategory() string {
 	if x != nil {
-		return x.Error
+		return x.Category
 	}
 	return ""
 }
 
-type HiddenCharsRequest struct {
+type HttpStatusEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HiddenCharsRequest) Reset() {
-	*x = HiddenCharsRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[80]
+func (x *HttpStatusEntry) Reset() {
+	*x = HttpStatusEntry{}
+	mi := &file_proto_privutil_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HiddenCharsRequest) String() string {
+func (x *HttpStatusEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HiddenCharsRequest) ProtoMessage() {}
+func (*HttpStatusEntry) ProtoMessage() {}
 
-func (x *HiddenCharsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[80]
+func (x *HttpStatusEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5104,42 +10085,61 @@ func (x *HiddenCharsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HiddenCharsRequest.ProtoReflect.Descriptor instead.
-func (*HiddenCharsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use HttpStatusEntry.ProtoReflect.Descriptor instead.
+func (*HttpStatusEntry) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *HiddenCharsRequest) GetText() string {
+func (x *HttpStatusEntry) GetCode() int32 {
 	if x != nil {
-		return x.Text
+		return x.Code
+	}
+	return 0
+}
+
+func (x *HttpStatusEntry) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-type HiddenCharInfo struct {
+func (x *HttpStatusEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *HttpStatusEntry) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type HttpStatusSearchResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Codepoint     string                 `protobuf:"bytes,2,opt,name=codepoint,proto3" json:"codepoint,omitempty"`
-	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Entries       []*HttpStatusEntry     `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HiddenCharInfo) Reset() {
-	*x = HiddenCharInfo{}
-	mi := &file_proto_privutil_proto_msgTypes[81]
+func (x *HttpStatusSearchResponse) Reset() {
+	*x = HttpStatusSearchResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HiddenCharInfo) String() string {
+func (x *HttpStatusSearchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HiddenCharInfo) ProtoMessage() {}
+func (*HttpStatusSearchResponse) ProtoMessage() {}
 
-func (x *HiddenCharInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[81]
+func (x *HttpStatusSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5150,58 +10150,40 @@ func (x *HiddenCharInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HiddenCharInfo.ProtoReflect.Descriptor instead.
-func (*HiddenCharInfo) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{81}
-}
-
-func (x *HiddenCharInfo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *HiddenCharInfo) GetCodepoint() string {
-	if x != nil {
-		return x.Codepoint
-	}
-	return ""
+// Deprecated: Use HttpStatusSearchResponse.ProtoReflect.Descriptor instead.
+func (*HttpStatusSearchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *HiddenCharInfo) GetCount() int32 {
+func (x *HttpStatusSearchResponse) GetEntries() []*HttpStatusEntry {
 	if x != nil {
-		return x.Count
+		return x.Entries
 	}
-	return 0
+	return nil
 }
 
-type HiddenCharsResponse struct {
+type MimeLookupRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	HasHidden     bool                   `protobuf:"varint,1,opt,name=has_hidden,json=hasHidden,proto3" json:"has_hidden,omitempty"`
-	Chars         []*HiddenCharInfo      `protobuf:"bytes,2,rep,name=chars,proto3" json:"chars,omitempty"`
-	Annotated     string                 `protobuf:"bytes,3,opt,name=annotated,proto3" json:"annotated,omitempty"` // original with [U+XXXX] markers
-	Cleaned       string                 `protobuf:"bytes,4,opt,name=cleaned,proto3" json:"cleaned,omitempty"`     // original with hidden chars removed
-	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"` // extension (with or without dot) or MIME type or partial
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HiddenCharsResponse) Reset() {
-	*x = HiddenCharsResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[82]
+func (x *MimeLookupRequest) Reset() {
+	*x = MimeLookupRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HiddenCharsResponse) String() string {
+func (x *MimeLookupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HiddenCharsResponse) ProtoMessage() {}
+func (*MimeLookupRequest) ProtoMessage() {}
 
-func (x *HiddenCharsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[82]
+func (x *MimeLookupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5212,72 +10194,43 @@ func (x *HiddenCharsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HiddenCharsResponse.ProtoReflect.Descriptor instead.
-func (*HiddenCharsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{82}
-}
-
-func (x *HiddenCharsResponse) GetHasHidden() bool {
-	if x != nil {
-		return x.HasHidden
-	}
-	return false
-}
-
-func (x *HiddenCharsResponse) GetChars() []*HiddenCharInfo {
-	if x != nil {
-		return x.Chars
-	}
-	return nil
-}
-
-func (x *HiddenCharsResponse) GetAnnotated() string {
-	if x != nil {
-		return x.Annotated
-	}
-	return ""
-}
-
-func (x *HiddenCharsResponse) GetCleaned() string {
-	if x != nil {
-		return x.Cleaned
-	}
-	return ""
+// Deprecated: Use MimeLookupRequest.ProtoReflect.Descriptor instead.
+func (*MimeLookupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *HiddenCharsResponse) GetError() string {
+func (x *MimeLookupRequest) GetQuery() string {
 	if x != nil {
-		return x.Error
+		return x.Query
 	}
 	return ""
 }
 
-type TextReplaceRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Text            string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Find            string                 `protobuf:"bytes,2,opt,name=find,proto3" json:"find,omitempty"`
-	ReplaceWith     string                 `protobuf:"bytes,3,opt,name=replace_with,json=replaceWith,proto3" json:"replace_with,omitempty"`
-	UseRegex        bool                   `protobuf:"varint,4,opt,name=use_regex,json=useRegex,proto3" json:"use_regex,omitempty"`
-	CaseInsensitive bool                   `protobuf:"varint,5,opt,name=case_insensitive,json=caseInsensitive,proto3" json:"case_insensitive,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type MimeEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MimeType      string                 `protobuf:"bytes,1,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Extensions    string                 `protobuf:"bytes,2,opt,name=extensions,proto3" json:"extensions,omitempty"` // comma-separated
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TextReplaceRequest) Reset() {
-	*x = TextReplaceRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[83]
+func (x *MimeEntry) Reset() {
+	*x = MimeEntry{}
+	mi := &file_proto_privutil_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TextReplaceRequest) String() string {
+func (x *MimeEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TextReplaceRequest) ProtoMessage() {}
+func (*MimeEntry) ProtoMessage() {}
 
-func (x *TextReplaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[83]
+func (x *MimeEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5288,70 +10241,62 @@ func (x *TextReplaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TextReplaceRequest.ProtoReflect.Descriptor instead.
-func (*TextReplaceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use MimeEntry.ProtoReflect.Descriptor instead.
+func (*MimeEntry) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *TextReplaceRequest) GetText() string {
+func (x *MimeEntry) GetMimeType() string {
 	if x != nil {
-		return x.Text
+		return x.MimeType
 	}
 	return ""
 }
 
-func (x *TextReplaceRequest) GetFind() string {
+func (x *MimeEntry) GetExtensions() string {
 	if x != nil {
-		return x.Find
+		return x.Extensions
 	}
 	return ""
 }
 
-func (x *TextReplaceRequest) GetReplaceWith() string {
+func (x *MimeEntry) GetCategory() string {
 	if x != nil {
-		return x.ReplaceWith
+		return x.Category
 	}
 	return ""
 }
 
-func (x *TextReplaceRequest) GetUseRegex() bool {
-	if x != nil {
-		return x.UseRegex
-	}
-	return false
-}
-
-func (x *TextReplaceRequest) GetCaseInsensitive() bool {
+func (x *MimeEntry) GetDescription() string {
 	if x != nil {
-		return x.CaseInsensitive
+		return x.Description
 	}
-	return false
+	return ""
 }
 
-type TextReplaceResponse struct {
+type MimeLookupResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Entries       []*MimeEntry           `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TextReplaceResponse) Reset() {
-	*x = TextReplaceResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[84]
+func (x *MimeLookupResponse) Reset() {
+	*x = MimeLookupResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TextReplaceResponse) String() string {
+func (x *MimeLookupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TextReplaceResponse) ProtoMessage() {}
+func (*MimeLookupResponse) ProtoMessage() {}
 
-func (x *TextReplaceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[84]
+func (x *MimeLookupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5362,57 +10307,47 @@ func (x *TextReplaceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TextReplaceResponse.ProtoReflect.Descriptor instead.
-func (*TextReplaceResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{84}
-}
-
-func (x *TextReplaceResponse) GetResult() string {
-	if x != nil {
-		return x.Result
-	}
-	return ""
+// Deprecated: Use MimeLookupResponse.ProtoReflect.Descriptor instead.
+func (*MimeLookupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *TextReplaceResponse) GetCount() int32 {
+func (x *MimeLookupResponse) GetEntries() []*MimeEntry {
 	if x != nil {
-		return x.Count
+		return x.Entries
 	}
-	return 0
+	return nil
 }
 
-func (x *TextReplaceResponse) GetError() string {
+func (x *MimeLookupResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type StringObfuscateRequest struct {
+type DockerRunToComposeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	KeepStart     int32                  `protobuf:"varint,2,opt,name=keep_start,json=keepStart,proto3" json:"keep_start,omitempty"`
-	KeepEnd       int32                  `protobuf:"varint,3,opt,name=keep_end,json=keepEnd,proto3" json:"keep_end,omitempty"`
-	MaskChar      string                 `protobuf:"bytes,4,opt,name=mask_char,json=maskChar,proto3" json:"mask_char,omitempty"` // default "*"
+	Command       string                 `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"` // full docker run command string
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StringObfuscateRequest) Reset() {
-	*x = StringObfuscateRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[85]
+func (x *DockerRunToComposeRequest) Reset() {
+	*x = DockerRunToComposeRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StringObfuscateRequest) String() string {
+func (x *DockerRunToComposeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StringObfuscateRequest) ProtoMessage() {}
+func (*DockerRunToComposeRequest) ProtoMessage() {}
 
-func (x *StringObfuscateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[85]
+func (x *DockerRunToComposeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5423,113 +10358,117 @@ func (x *StringObfuscateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StringObfuscateRequest.ProtoReflect.Descriptor instead.
-func (*StringObfuscateRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{85}
-}
-
-func (x *StringObfuscateRequest) GetText() string {
-	if x != nil {
-		return x.Text
-	}
-	return ""
-}
-
-func (x *StringObfuscateRequest) GetKeepStart() int32 {
-	if x != nil {
-		return x.KeepStart
-	}
-	return 0
-}
-
-func (x *StringObfuscateRequest) GetKeepEnd() int32 {
-	if x != nil {
-		return x.KeepEnd
-	}
-	return 0
+// Deprecated: Use DockerRunToComposeRequest.ProtoReflect.Descriptor instead.
+func (*DockerRunToComposeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *StringObfuscateRequest) GetMaskChar() string {
+func (x *DockerRunToComposeRequest) GetCommand() string {
 	if x != nil {
-		return x.MaskChar
+		return x.Command
 	}
 	return ""
 }
 
-type StringObfuscateResponse struct {
+type DockerRunToComposeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	ComposeYaml   string                 `protobuf:"bytes,1,opt,name=compose_yaml,json=composeYaml,proto3" json:"compose_yaml,omitempty"`
+	Warnings      []string               `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	ServiceName   string                 `protobuf:"bytes,3,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	Image         string                 `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StringObfuscateResponse) Reset() {
-	*x = StringObfuscateResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[86]
+func (x *DockerRunToComposeResponse) Reset() {
+	*x = DockerRunToComposeResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StringObfuscateResponse) String() string {
+func (x *DockerRunToComposeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StringObfuscateResponse) ProtoMessage() {}
-
-func (x *StringObfuscateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[86]
+func (*DockerRunToComposeResponse) ProtoMessage() {}
+
+func (x *DockerRunToComposeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DockerRunToComposeResponse.ProtoReflect.Descriptor instead.
+func (*DockerRunToComposeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *DockerRunToComposeResponse) GetComposeYaml() string {
+	if x != nil {
+		return x.ComposeYaml
+	}
+	return ""
+}
+
+func (x *DockerRunToComposeResponse) GetWarnings() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Warnings
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use StringObfuscateResponse.ProtoReflect.Descriptor instead.
-func (*StringObfuscateResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{86}
+func (x *DockerRunToComposeResponse) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
 }
 
-func (x *StringObfuscateResponse) GetResult() string {
+func (x *DockerRunToComposeResponse) GetImage() string {
 	if x != nil {
-		return x.Result
+		return x.Image
 	}
 	return ""
 }
 
-func (x *StringObfuscateResponse) GetError() string {
+func (x *DockerRunToComposeResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type NumeronymRequest struct {
+type GitCheatSheetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`       // search term (empty = all)
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"` // filter by category name (empty = all)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NumeronymRequest) Reset() {
-	*x = NumeronymRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[87]
+func (x *GitCheatSheetRequest) Reset() {
+	*x = GitCheatSheetRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NumeronymRequest) String() string {
+func (x *GitCheatSheetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NumeronymRequest) ProtoMessage() {}
+func (*GitCheatSheetRequest) ProtoMessage() {}
 
-func (x *NumeronymRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[87]
+func (x *GitCheatSheetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5540,42 +10479,49 @@ func (x *NumeronymRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NumeronymRequest.ProtoReflect.Descriptor instead.
-func (*NumeronymRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use GitCheatSheetRequest.ProtoReflect.Descriptor instead.
+func (*GitCheatSheetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *NumeronymRequest) GetText() string {
+func (x *GitCheatSheetRequest) GetQuery() string {
 	if x != nil {
-		return x.Text
+		return x.Query
 	}
 	return ""
 }
 
-type NumeronymResponse struct {
+func (x *GitCheatSheetRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type GitCmd struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Words         []string               `protobuf:"bytes,1,rep,name=words,proto3" json:"words,omitempty"`   // numeronym per word
-	Result        string                 `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"` // space-joined
-	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Command       string                 `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Examples      []string               `protobuf:"bytes,3,rep,name=examples,proto3" json:"examples,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NumeronymResponse) Reset() {
-	*x = NumeronymResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[88]
+func (x *GitCmd) Reset() {
+	*x = GitCmd{}
+	mi := &file_proto_privutil_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NumeronymResponse) String() string {
+func (x *GitCmd) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NumeronymResponse) ProtoMessage() {}
+func (*GitCmd) ProtoMessage() {}
 
-func (x *NumeronymResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[88]
+func (x *GitCmd) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5586,55 +10532,55 @@ func (x *NumeronymResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NumeronymResponse.ProtoReflect.Descriptor instead.
-func (*NumeronymResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use GitCmd.ProtoReflect.Descriptor instead.
+func (*GitCmd) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *NumeronymResponse) GetWords() []string {
+func (x *GitCmd) GetCommand() string {
 	if x != nil {
-		return x.Words
+		return x.Command
 	}
-	return nil
+	return ""
 }
 
-func (x *NumeronymResponse) GetResult() string {
+func (x *GitCmd) GetDescription() string {
 	if x != nil {
-		return x.Result
+		return x.Description
 	}
 	return ""
 }
 
-func (x *NumeronymResponse) GetError() string {
+func (x *GitCmd) GetExamples() []string {
 	if x != nil {
-		return x.Error
+		return x.Examples
 	}
-	return ""
+	return nil
 }
 
-type NatoRequest struct {
+type GitCmdCategory struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // "encode" or "decode"
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Commands      []*GitCmd              `protobuf:"bytes,2,rep,name=commands,proto3" json:"commands,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NatoRequest) Reset() {
-	*x = NatoRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[89]
+func (x *GitCmdCategory) Reset() {
+	*x = GitCmdCategory{}
+	mi := &file_proto_privutil_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NatoRequest) String() string {
+func (x *GitCmdCategory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NatoRequest) ProtoMessage() {}
+func (*GitCmdCategory) ProtoMessage() {}
 
-func (x *NatoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[89]
+func (x *GitCmdCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5645,48 +10591,47 @@ func (x *NatoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NatoRequest.ProtoReflect.Descriptor instead.
-func (*NatoRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use GitCmdCategory.ProtoReflect.Descriptor instead.
+func (*GitCmdCategory) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *NatoRequest) GetText() string {
+func (x *GitCmdCategory) GetName() string {
 	if x != nil {
-		return x.Text
+		return x.Name
 	}
 	return ""
 }
 
-func (x *NatoRequest) GetAction() string {
+func (x *GitCmdCategory) GetCommands() []*GitCmd {
 	if x != nil {
-		return x.Action
+		return x.Commands
 	}
-	return ""
+	return nil
 }
 
-type NatoResponse struct {
+type GitCheatSheetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Categories    []*GitCmdCategory      `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NatoResponse) Reset() {
-	*x = NatoResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[90]
+func (x *GitCheatSheetResponse) Reset() {
+	*x = GitCheatSheetResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NatoResponse) String() string {
+func (x *GitCheatSheetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NatoResponse) ProtoMessage() {}
+func (*GitCheatSheetResponse) ProtoMessage() {}
 
-func (x *NatoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[90]
+func (x *GitCheatSheetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5697,49 +10642,51 @@ func (x *NatoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NatoResponse.ProtoReflect.Descriptor instead.
-func (*NatoResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{90}
-}
-
-func (x *NatoResponse) GetResult() string {
-	if x != nil {
-		return x.Result
-	}
-	return ""
+// Deprecated: Use GitCheatSheetResponse.ProtoReflect.Descriptor instead.
+func (*GitCheatSheetResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *NatoResponse) GetError() string {
+func (x *GitCheatSheetResponse) GetCategories() []*GitCmdCategory {
 	if x != nil {
-		return x.Error
+		return x.Categories
 	}
-	return ""
+	return nil
 }
 
-type ListRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Text            string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Action          ListAction             `protobuf:"varint,2,opt,name=action,proto3,enum=privutil.ListAction" json:"action,omitempty"`
-	CaseInsensitive bool                   `protobuf:"varint,3,opt,name=case_insensitive,json=caseInsensitive,proto3" json:"case_insensitive,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type SvgOptimizeRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Svg    string                 `protobuf:"bytes,1,opt,name=svg,proto3" json:"svg,omitempty"`
+	Preset string                 `protobuf:"bytes,2,opt,name=preset,proto3" json:"preset,omitempty"` // "safe" (default), "aggressive", "minimal", "custom"
+	// Only used when preset = "custom":
+	RemoveComments     bool `protobuf:"varint,3,opt,name=remove_comments,json=removeComments,proto3" json:"remove_comments,omitempty"`
+	RemoveXmlDecl      bool `protobuf:"varint,4,opt,name=remove_xml_decl,json=removeXmlDecl,proto3" json:"remove_xml_decl,omitempty"`
+	RemoveDoctype      bool `protobuf:"varint,5,opt,name=remove_doctype,json=removeDoctype,proto3" json:"remove_doctype,omitempty"`
+	RemoveMetadata     bool `protobuf:"varint,6,opt,name=remove_metadata,json=removeMetadata,proto3" json:"remove_metadata,omitempty"`
+	RemoveTitle        bool `protobuf:"varint,7,opt,name=remove_title,json=removeTitle,proto3" json:"remove_title,omitempty"`
+	RemoveDesc         bool `protobuf:"varint,8,opt,name=remove_desc,json=removeDesc,proto3" json:"remove_desc,omitempty"`
+	RemoveEmptyGroups  bool `protobuf:"varint,9,opt,name=remove_empty_groups,json=removeEmptyGroups,proto3" json:"remove_empty_groups,omitempty"`
+	CollapseWhitespace bool `protobuf:"varint,10,opt,name=collapse_whitespace,json=collapseWhitespace,proto3" json:"collapse_whitespace,omitempty"`
+	RemoveEmptyAttrs   bool `protobuf:"varint,11,opt,name=remove_empty_attrs,json=removeEmptyAttrs,proto3" json:"remove_empty_attrs,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *ListRequest) Reset() {
-	*x = ListRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[91]
+func (x *SvgOptimizeRequest) Reset() {
+	*x = SvgOptimizeRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRequest) String() string {
+func (x *SvgOptimizeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRequest) ProtoMessage() {}
+func (*SvgOptimizeRequest) ProtoMessage() {}
 
-func (x *ListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[91]
+func (x *SvgOptimizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5750,110 +10697,115 @@ func (x *ListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
-func (*ListRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use SvgOptimizeRequest.ProtoReflect.Descriptor instead.
+func (*SvgOptimizeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *ListRequest) GetText() string {
+func (x *SvgOptimizeRequest) GetSvg() string {
 	if x != nil {
-		return x.Text
+		return x.Svg
 	}
 	return ""
 }
 
-func (x *ListRequest) GetAction() ListAction {
+func (x *SvgOptimizeRequest) GetPreset() string {
 	if x != nil {
-		return x.Action
+		return x.Preset
 	}
-	return ListAction_LIST_SORT_AZ
+	return ""
 }
 
-func (x *ListRequest) GetCaseInsensitive() bool {
+func (x *SvgOptimizeRequest) GetRemoveComments() bool {
 	if x != nil {
-		return x.CaseInsensitive
+		return x.RemoveComments
 	}
 	return false
 }
 
-type ListFreqItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Line          string                 `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SvgOptimizeRequest) GetRemoveXmlDecl() bool {
+	if x != nil {
+		return x.RemoveXmlDecl
+	}
+	return false
+}
+
+func (x *SvgOptimizeRequest) GetRemoveDoctype() bool {
+	if x != nil {
+		return x.RemoveDoctype
+	}
+	return false
 }
 
-func (x *ListFreqItem) Reset() {
-	*x = ListFreqItem{}
-	mi := &file_proto_privutil_proto_msgTypes[92]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SvgOptimizeRequest) GetRemoveMetadata() bool {
+	if x != nil {
+		return x.RemoveMetadata
+	}
+	return false
 }
 
-func (x *ListFreqItem) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SvgOptimizeRequest) GetRemoveTitle() bool {
+	if x != nil {
+		return x.RemoveTitle
+	}
+	return false
 }
 
-func (*ListFreqItem) ProtoMessage() {}
-
-func (x *ListFreqItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[92]
+func (x *SvgOptimizeRequest) GetRemoveDesc() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RemoveDesc
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use ListFreqItem.ProtoReflect.Descriptor instead.
-func (*ListFreqItem) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{92}
+func (x *SvgOptimizeRequest) GetRemoveEmptyGroups() bool {
+	if x != nil {
+		return x.RemoveEmptyGroups
+	}
+	return false
 }
 
-func (x *ListFreqItem) GetLine() string {
+func (x *SvgOptimizeRequest) GetCollapseWhitespace() bool {
 	if x != nil {
-		return x.Line
+		return x.CollapseWhitespace
 	}
-	return ""
+	return false
 }
 
-func (x *ListFreqItem) GetCount() int32 {
+func (x *SvgOptimizeRequest) GetRemoveEmptyAttrs() bool {
 	if x != nil {
-		return x.Count
+		return x.RemoveEmptyAttrs
 	}
-	return 0
+	return false
 }
 
-type ListResponse struct {
+type SvgOptimizeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	InputCount    int32                  `protobuf:"varint,2,opt,name=input_count,json=inputCount,proto3" json:"input_count,omitempty"`
-	OutputCount   int32                  `protobuf:"varint,3,opt,name=output_count,json=outputCount,proto3" json:"output_count,omitempty"`
-	Frequency     []*ListFreqItem        `protobuf:"bytes,4,rep,name=frequency,proto3" json:"frequency,omitempty"`
-	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	OriginalSize  int32                  `protobuf:"varint,2,opt,name=original_size,json=originalSize,proto3" json:"original_size,omitempty"`
+	OptimizedSize int32                  `protobuf:"varint,3,opt,name=optimized_size,json=optimizedSize,proto3" json:"optimized_size,omitempty"`
+	SavingsPct    float32                `protobuf:"fixed32,4,opt,name=savings_pct,json=savingsPct,proto3" json:"savings_pct,omitempty"`
+	Applied       []string               `protobuf:"bytes,5,rep,name=applied,proto3" json:"applied,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListResponse) Reset() {
-	*x = ListResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[93]
+func (x *SvgOptimizeResponse) Reset() {
+	*x = SvgOptimizeResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListResponse) String() string {
+func (x *SvgOptimizeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListResponse) ProtoMessage() {}
+func (*SvgOptimizeResponse) ProtoMessage() {}
 
-func (x *ListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[93]
+func (x *SvgOptimizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5864,69 +10816,76 @@ func (x *ListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
-func (*ListResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use SvgOptimizeResponse.ProtoReflect.Descriptor instead.
+func (*SvgOptimizeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{147}
 }
 
-func (x *ListResponse) GetResult() string {
+func (x *SvgOptimizeResponse) GetResult() string {
 	if x != nil {
 		return x.Result
 	}
 	return ""
 }
 
-func (x *ListResponse) GetInputCount() int32 {
+func (x *SvgOptimizeResponse) GetOriginalSize() int32 {
 	if x != nil {
-		return x.InputCount
+		return x.OriginalSize
 	}
 	return 0
 }
 
-func (x *ListResponse) GetOutputCount() int32 {
+func (x *SvgOptimizeResponse) GetOptimizedSize() int32 {
 	if x != nil {
-		return x.OutputCount
+		return x.OptimizedSize
 	}
 	return 0
 }
 
-func (x *ListResponse) GetFrequency() []*ListFreqItem {
+func (x *SvgOptimizeResponse) GetSavingsPct() float32 {
 	if x != nil {
-		return x.Frequency
+		return x.SavingsPct
+	}
+	return 0
+}
+
+func (x *SvgOptimizeResponse) GetApplied() []string {
+	if x != nil {
+		return x.Applied
 	}
 	return nil
 }
 
-func (x *ListResponse) GetError() string {
+func (x *SvgOptimizeResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type MathVariable struct {
+type ExifReadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MathVariable) Reset() {
-	*x = MathVariable{}
-	mi := &file_proto_privutil_proto_msgTypes[94]
+func (x *ExifReadRequest) Reset() {
+	*x = ExifReadRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MathVariable) String() string {
+func (x *ExifReadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MathVariable) ProtoMessage() {}
+func (*ExifReadRequest) ProtoMessage() {}
 
-func (x *MathVariable) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[94]
+func (x *ExifReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5937,50 +10896,49 @@ func (x *MathVariable) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MathVariable.ProtoReflect.Descriptor instead.
-func (*MathVariable) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use ExifReadRequest.ProtoReflect.Descriptor instead.
+func (*ExifReadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *MathVariable) GetName() string {
+func (x *ExifReadRequest) GetData() []byte {
 	if x != nil {
-		return x.Name
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *MathVariable) GetValue() float64 {
+func (x *ExifReadRequest) GetFilename() string {
 	if x != nil {
-		return x.Value
+		return x.Filename
 	}
-	return 0
+	return ""
 }
 
-type MathEvalRequest struct {
+type ExifField struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Expression    string                 `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
-	Variables     []*MathVariable        `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty"`
-	Precision     int32                  `protobuf:"varint,3,opt,name=precision,proto3" json:"precision,omitempty"` // 0-15 decimal places, default 10
-	Degrees       bool                   `protobuf:"varint,4,opt,name=degrees,proto3" json:"degrees,omitempty"`     // if true, trig input/output in degrees
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Group         string                 `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MathEvalRequest) Reset() {
-	*x = MathEvalRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[95]
+func (x *ExifField) Reset() {
+	*x = ExifField{}
+	mi := &file_proto_privutil_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MathEvalRequest) String() string {
+func (x *ExifField) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MathEvalRequest) ProtoMessage() {}
+func (*ExifField) ProtoMessage() {}
 
-func (x *MathEvalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[95]
+func (x *ExifField) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5991,123 +10949,156 @@ func (x *MathEvalRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MathEvalRequest.ProtoReflect.Descriptor instead.
-func (*MathEvalRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use ExifField.ProtoReflect.Descriptor instead.
+func (*ExifField) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *MathEvalRequest) GetExpression() string {
+func (x *ExifField) GetLabel() string {
 	if x != nil {
-		return x.Expression
+		return x.Label
 	}
 	return ""
 }
 
-func (x *MathEvalRequest) GetVariables() []*MathVariable {
-	if x != nil {
-		return x.Variables
-	}
-	return nil
-}
-
-func (x *MathEvalRequest) GetPrecision() int32 {
+func (x *ExifField) GetValue() string {
 	if x != nil {
-		return x.Precision
+		return x.Value
 	}
-	return 0
+	return ""
 }
 
-func (x *MathEvalRequest) GetDegrees() bool {
+func (x *ExifField) GetGroup() string {
 	if x != nil {
-		return x.Degrees
+		return x.Group
 	}
-	return false
+	return ""
 }
 
-type MathEvalResponse struct {
+type ExifReadResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	RawValue      float64                `protobuf:"fixed64,2,opt,name=raw_value,json=rawValue,proto3" json:"raw_value,omitempty"`
-	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Width         int32                  `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	GpsDecimal    string                 `protobuf:"bytes,4,opt,name=gps_decimal,json=gpsDecimal,proto3" json:"gps_decimal,omitempty"` // "lat, lng" in decimal degrees (empty if no GPS)
+	GpsDms        string                 `protobuf:"bytes,5,opt,name=gps_dms,json=gpsDms,proto3" json:"gps_dms,omitempty"`             // "48°51'30\"N, 2°17'40\"E" style
+	MapsUrl       string                 `protobuf:"bytes,6,opt,name=maps_url,json=mapsUrl,proto3" json:"maps_url,omitempty"`          // Google Maps link
+	Fields        []*ExifField           `protobuf:"bytes,7,rep,name=fields,proto3" json:"fields,omitempty"`
+	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MathEvalResponse) Reset() {
-	*x = MathEvalResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[96]
+func (x *ExifReadResponse) Reset() {
+	*x = ExifReadResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MathEvalResponse) String() string {
+func (x *ExifReadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MathEvalResponse) ProtoMessage() {}
+func (*ExifReadResponse) ProtoMessage() {}
+
+func (x *ExifReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExifReadResponse.ProtoReflect.Descriptor instead.
+func (*ExifReadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *ExifReadResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExifReadResponse) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ExifReadResponse) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
 
-func (x *MathEvalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[96]
+func (x *ExifReadResponse) GetGpsDecimal() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.GpsDecimal
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use MathEvalResponse.ProtoReflect.Descriptor instead.
-func (*MathEvalResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{96}
+func (x *ExifReadResponse) GetGpsDms() string {
+	if x != nil {
+		return x.GpsDms
+	}
+	return ""
 }
 
-func (x *MathEvalResponse) GetResult() string {
+func (x *ExifReadResponse) GetMapsUrl() string {
 	if x != nil {
-		return x.Result
+		return x.MapsUrl
 	}
 	return ""
 }
 
-func (x *MathEvalResponse) GetRawValue() float64 {
+func (x *ExifReadResponse) GetFields() []*ExifField {
 	if x != nil {
-		return x.RawValue
+		return x.Fields
 	}
-	return 0
+	return nil
 }
 
-func (x *MathEvalResponse) GetError() string {
+func (x *ExifReadResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type PercentageRequest struct {
+type FileToBase64Request struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Mode          PercentMode            `protobuf:"varint,1,opt,name=mode,proto3,enum=privutil.PercentMode" json:"mode,omitempty"`
-	A             float64                `protobuf:"fixed64,2,opt,name=a,proto3" json:"a,omitempty"`
-	B             float64                `protobuf:"fixed64,3,opt,name=b,proto3" json:"b,omitempty"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	WrapUri       bool                   `protobuf:"varint,3,opt,name=wrap_uri,json=wrapUri,proto3" json:"wrap_uri,omitempty"` // prefix result with "data:<mime>;base64,"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PercentageRequest) Reset() {
-	*x = PercentageRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[97]
+func (x *FileToBase64Request) Reset() {
+	*x = FileToBase64Request{}
+	mi := &file_proto_privutil_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PercentageRequest) String() string {
+func (x *FileToBase64Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PercentageRequest) ProtoMessage() {}
+func (*FileToBase64Request) ProtoMessage() {}
 
-func (x *PercentageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[97]
+func (x *FileToBase64Request) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6118,57 +11109,58 @@ func (x *PercentageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PercentageRequest.ProtoReflect.Descriptor instead.
-func (*PercentageRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use FileToBase64Request.ProtoReflect.Descriptor instead.
+func (*FileToBase64Request) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *PercentageRequest) GetMode() PercentMode {
+func (x *FileToBase64Request) GetData() []byte {
 	if x != nil {
-		return x.Mode
+		return x.Data
 	}
-	return PercentMode_PCT_X_OF_Y
+	return nil
 }
 
-func (x *PercentageRequest) GetA() float64 {
+func (x *FileToBase64Request) GetFilename() string {
 	if x != nil {
-		return x.A
+		return x.Filename
 	}
-	return 0
+	return ""
 }
 
-func (x *PercentageRequest) GetB() float64 {
+func (x *FileToBase64Request) GetWrapUri() bool {
 	if x != nil {
-		return x.B
+		return x.WrapUri
 	}
-	return 0
+	return false
 }
 
-type PercentageResponse struct {
+type FileToBase64Response struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        float64                `protobuf:"fixed64,1,opt,name=result,proto3" json:"result,omitempty"`
-	Formatted     string                 `protobuf:"bytes,2,opt,name=formatted,proto3" json:"formatted,omitempty"` // human-readable sentence
-	Formula       string                 `protobuf:"bytes,3,opt,name=formula,proto3" json:"formula,omitempty"`     // the formula used
-	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Encoded       string                 `protobuf:"bytes,1,opt,name=encoded,proto3" json:"encoded,omitempty"`                // raw base64 (no prefix)
+	DataUri       string                 `protobuf:"bytes,2,opt,name=data_uri,json=dataUri,proto3" json:"data_uri,omitempty"` // full data URI
+	MimeType      string                 `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Size          int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PercentageResponse) Reset() {
-	*x = PercentageResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[98]
+func (x *FileToBase64Response) Reset() {
+	*x = FileToBase64Response{}
+	mi := &file_proto_privutil_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PercentageResponse) String() string {
+func (x *FileToBase64Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PercentageResponse) ProtoMessage() {}
+func (*FileToBase64Response) ProtoMessage() {}
 
-func (x *PercentageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[98]
+func (x *FileToBase64Response) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6179,62 +11171,69 @@ func (x *PercentageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PercentageResponse.ProtoReflect.Descriptor instead.
-func (*PercentageResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use FileToBase64Response.ProtoReflect.Descriptor instead.
+func (*FileToBase64Response) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *PercentageResponse) GetResult() float64 {
+func (x *FileToBase64Response) GetEncoded() string {
 	if x != nil {
-		return x.Result
+		return x.Encoded
 	}
-	return 0
+	return ""
 }
 
-func (x *PercentageResponse) GetFormatted() string {
+func (x *FileToBase64Response) GetDataUri() string {
 	if x != nil {
-		return x.Formatted
+		return x.DataUri
 	}
 	return ""
 }
 
-func (x *PercentageResponse) GetFormula() string {
+func (x *FileToBase64Response) GetMimeType() string {
 	if x != nil {
-		return x.Formula
+		return x.MimeType
 	}
 	return ""
 }
 
-func (x *PercentageResponse) GetError() string {
+func (x *FileToBase64Response) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileToBase64Response) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type TempConvertRequest struct {
+type Base64ToFileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
-	FromUnit      string                 `protobuf:"bytes,2,opt,name=from_unit,json=fromUnit,proto3" json:"from_unit,omitempty"` // "c", "f", "k"
+	Encoded       string                 `protobuf:"bytes,1,opt,name=encoded,proto3" json:"encoded,omitempty"`   // raw base64 or full data URI
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"` // optional suggested output filename
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TempConvertRequest) Reset() {
-	*x = TempConvertRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[99]
+func (x *Base64ToFileRequest) Reset() {
+	*x = Base64ToFileRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TempConvertRequest) String() string {
+func (x *Base64ToFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TempConvertRequest) ProtoMessage() {}
+func (*Base64ToFileRequest) ProtoMessage() {}
 
-func (x *TempConvertRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[99]
+func (x *Base64ToFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6245,50 +11244,51 @@ func (x *TempConvertRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TempConvertRequest.ProtoReflect.Descriptor instead.
-func (*TempConvertRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{99}
+// Deprecated: Use Base64ToFileRequest.ProtoReflect.Descriptor instead.
+func (*Base64ToFileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *TempConvertRequest) GetValue() float64 {
+func (x *Base64ToFileRequest) GetEncoded() string {
 	if x != nil {
-		return x.Value
+		return x.Encoded
 	}
-	return 0
+	return ""
 }
 
-func (x *TempConvertRequest) GetFromUnit() string {
+func (x *Base64ToFileRequest) GetFilename() string {
 	if x != nil {
-		return x.FromUnit
+		return x.Filename
 	}
 	return ""
 }
 
-type TempConvertResponse struct {
+type Base64ToFileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Celsius       float64                `protobuf:"fixed64,1,opt,name=celsius,proto3" json:"celsius,omitempty"`
-	Fahrenheit    float64                `protobuf:"fixed64,2,opt,name=fahrenheit,proto3" json:"fahrenheit,omitempty"`
-	Kelvin        float64                `protobuf:"fixed64,3,opt,name=kelvin,proto3" json:"kelvin,omitempty"`
-	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	MimeType      string                 `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	Size          int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TempConvertResponse) Reset() {
-	*x = TempConvertResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[100]
+func (x *Base64ToFileResponse) Reset() {
+	*x = Base64ToFileResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TempConvertResponse) String() string {
+func (x *Base64ToFileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TempConvertResponse) ProtoMessage() {}
+func (*Base64ToFileResponse) ProtoMessage() {}
 
-func (x *TempConvertResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[100]
+func (x *Base64ToFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6299,63 +11299,69 @@ func (x *TempConvertResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TempConvertResponse.ProtoReflect.Descriptor instead.
-func (*TempConvertResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use Base64ToFileResponse.ProtoReflect.Descriptor instead.
+func (*Base64ToFileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *TempConvertResponse) GetCelsius() float64 {
+func (x *Base64ToFileResponse) GetData() []byte {
 	if x != nil {
-		return x.Celsius
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-func (x *TempConvertResponse) GetFahrenheit() float64 {
+func (x *Base64ToFileResponse) GetMimeType() string {
 	if x != nil {
-		return x.Fahrenheit
+		return x.MimeType
 	}
-	return 0
+	return ""
 }
 
-func (x *TempConvertResponse) GetKelvin() float64 {
+func (x *Base64ToFileResponse) GetFilename() string {
 	if x != nil {
-		return x.Kelvin
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *Base64ToFileResponse) GetSize() int32 {
+	if x != nil {
+		return x.Size
 	}
 	return 0
 }
 
-func (x *TempConvertResponse) GetError() string {
+func (x *Base64ToFileResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type UnitConvertRequest struct {
+type TokenCountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
-	FromUnit      string                 `protobuf:"bytes,2,opt,name=from_unit,json=fromUnit,proto3" json:"from_unit,omitempty"`
-	Category      UnitCategory           `protobuf:"varint,3,opt,name=category,proto3,enum=privutil.UnitCategory" json:"category,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Strategy      string                 `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"` // optional: strategy id filter (empty = return all)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnitConvertRequest) Reset() {
-	*x = UnitConvertRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[101]
+func (x *TokenCountRequest) Reset() {
+	*x = TokenCountRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnitConvertRequest) String() string {
+func (x *TokenCountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnitConvertRequest) ProtoMessage() {}
+func (*TokenCountRequest) ProtoMessage() {}
 
-func (x *UnitConvertRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[101]
+func (x *TokenCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6366,57 +11372,53 @@ func (x *UnitConvertRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnitConvertRequest.ProtoReflect.Descriptor instead.
-func (*UnitConvertRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{101}
-}
-
-func (x *UnitConvertRequest) GetValue() float64 {
-	if x != nil {
-		return x.Value
-	}
-	return 0
+// Deprecated: Use TokenCountRequest.ProtoReflect.Descriptor instead.
+func (*TokenCountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *UnitConvertRequest) GetFromUnit() string {
+func (x *TokenCountRequest) GetText() string {
 	if x != nil {
-		return x.FromUnit
+		return x.Text
 	}
 	return ""
 }
 
-func (x *UnitConvertRequest) GetCategory() UnitCategory {
+func (x *TokenCountRequest) GetStrategy() string {
 	if x != nil {
-		return x.Category
+		return x.Strategy
 	}
-	return UnitCategory_UNIT_BYTES
+	return ""
 }
 
-type UnitResult struct {
+type TokenStrategy struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Unit          string                 `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty"`
-	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Value         float64                `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
-	Formatted     string                 `protobuf:"bytes,4,opt,name=formatted,proto3" json:"formatted,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`   // strategy id
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"` // human-friendly name
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Sample        []string               `protobuf:"bytes,4,rep,name=sample,proto3" json:"sample,omitempty"`     // first N tokens as preview (capped at 200)
+	Exact         bool                   `protobuf:"varint,5,opt,name=exact,proto3" json:"exact,omitempty"`      // true = exact BPE tokenization, false = heuristic estimate
+	Encoding      string                 `protobuf:"bytes,6,opt,name=encoding,proto3" json:"encoding,omitempty"` // underlying encoding used (e.g. "o200k_base")
+	Group         string                 `protobuf:"bytes,7,opt,name=group,proto3" json:"group,omitempty"`       // grouping: "openai", "anthropic", "meta", "google", "mistral", "classic"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnitResult) Reset() {
-	*x = UnitResult{}
-	mi := &file_proto_privutil_proto_msgTypes[102]
+func (x *TokenStrategy) Reset() {
+	*x = TokenStrategy{}
+	mi := &file_proto_privutil_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnitResult) String() string {
+func (x *TokenStrategy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnitResult) ProtoMessage() {}
+func (*TokenStrategy) ProtoMessage() {}
 
-func (x *UnitResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[102]
+func (x *TokenStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6427,62 +11429,85 @@ func (x *UnitResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnitResult.ProtoReflect.Descriptor instead.
-func (*UnitResult) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{102}
+// Deprecated: Use TokenStrategy.ProtoReflect.Descriptor instead.
+func (*TokenStrategy) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *UnitResult) GetUnit() string {
+func (x *TokenStrategy) GetName() string {
 	if x != nil {
-		return x.Unit
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UnitResult) GetLabel() string {
+func (x *TokenStrategy) GetLabel() string {
 	if x != nil {
 		return x.Label
 	}
 	return ""
 }
 
-func (x *UnitResult) GetValue() float64 {
+func (x *TokenStrategy) GetCount() int32 {
 	if x != nil {
-		return x.Value
+		return x.Count
 	}
 	return 0
 }
 
-func (x *UnitResult) GetFormatted() string {
+func (x *TokenStrategy) GetSample() []string {
 	if x != nil {
-		return x.Formatted
+		return x.Sample
+	}
+	return nil
+}
+
+func (x *TokenStrategy) GetExact() bool {
+	if x != nil {
+		return x.Exact
+	}
+	return false
+}
+
+func (x *TokenStrategy) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
 	}
 	return ""
 }
 
-type UnitConvertResponse struct {
+func (x *TokenStrategy) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+type TokenCountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Results       []*UnitResult          `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Strategies    []*TokenStrategy       `protobuf:"bytes,1,rep,name=strategies,proto3" json:"strategies,omitempty"`
+	CharCount     int32                  `protobuf:"varint,2,opt,name=char_count,json=charCount,proto3" json:"char_count,omitempty"`
+	ByteCount     int32                  `protobuf:"varint,3,opt,name=byte_count,json=byteCount,proto3" json:"byte_count,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnitConvertResponse) Reset() {
-	*x = UnitConvertResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[103]
+func (x *TokenCountResponse) Reset() {
+	*x = TokenCountResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnitConvertResponse) String() string {
+func (x *TokenCountResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnitConvertResponse) ProtoMessage() {}
+func (*TokenCountResponse) ProtoMessage() {}
 
-func (x *UnitConvertResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[103]
+func (x *TokenCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6493,48 +11518,62 @@ func (x *UnitConvertResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnitConvertResponse.ProtoReflect.Descriptor instead.
-func (*UnitConvertResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{103}
+// Deprecated: Use TokenCountResponse.ProtoReflect.Descriptor instead.
+func (*TokenCountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *UnitConvertResponse) GetResults() []*UnitResult {
+func (x *TokenCountResponse) GetStrategies() []*TokenStrategy {
 	if x != nil {
-		return x.Results
+		return x.Strategies
 	}
 	return nil
 }
 
-func (x *UnitConvertResponse) GetError() string {
+func (x *TokenCountResponse) GetCharCount() int32 {
+	if x != nil {
+		return x.CharCount
+	}
+	return 0
+}
+
+func (x *TokenCountResponse) GetByteCount() int32 {
+	if x != nil {
+		return x.ByteCount
+	}
+	return 0
+}
+
+func (x *TokenCountResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type DateDiffRequest struct {
+type SpellCheckRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FromDate      string                 `protobuf:"bytes,1,opt,name=from_date,json=fromDate,proto3" json:"from_date,omitempty"` // any parseable date/datetime string
-	ToDate        string                 `protobuf:"bytes,2,opt,name=to_date,json=toDate,proto3" json:"to_date,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Language      string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"` // language code, e.g. "en", "es" (default "en")
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DateDiffRequest) Reset() {
-	*x = DateDiffRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[104]
+func (x *SpellCheckRequest) Reset() {
+	*x = SpellCheckRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DateDiffRequest) String() string {
+func (x *SpellCheckRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DateDiffRequest) ProtoMessage() {}
+func (*SpellCheckRequest) ProtoMessage() {}
 
-func (x *DateDiffRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[104]
+func (x *SpellCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6545,59 +11584,56 @@ func (x *DateDiffRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DateDiffRequest.ProtoReflect.Descriptor instead.
-func (*DateDiffRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{104}
+// Deprecated: Use SpellCheckRequest.ProtoReflect.Descriptor instead.
+func (*SpellCheckRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *DateDiffRequest) GetFromDate() string {
+func (x *SpellCheckRequest) GetText() string {
 	if x != nil {
-		return x.FromDate
+		return x.Text
 	}
 	return ""
 }
 
-func (x *DateDiffRequest) GetToDate() string {
+func (x *SpellCheckRequest) GetLanguage() string {
 	if x != nil {
-		return x.ToDate
+		return x.Language
 	}
 	return ""
 }
 
-type DateDiffResponse struct {
+type SpellIssue struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Years         int64                  `protobuf:"varint,1,opt,name=years,proto3" json:"years,omitempty"`
-	Months        int64                  `protobuf:"varint,2,opt,name=months,proto3" json:"months,omitempty"`
-	Weeks         int64                  `protobuf:"varint,3,opt,name=weeks,proto3" json:"weeks,omitempty"`
-	Days          int64                  `protobuf:"varint,4,opt,name=days,proto3" json:"days,omitempty"`
-	Hours         int64                  `protobuf:"varint,5,opt,name=hours,proto3" json:"hours,omitempty"`
-	Minutes       int64                  `protobuf:"varint,6,opt,name=minutes,proto3" json:"minutes,omitempty"`
-	Seconds       int64                  `protobuf:"varint,7,opt,name=seconds,proto3" json:"seconds,omitempty"`
-	TotalDays     int64                  `protobuf:"varint,8,opt,name=total_days,json=totalDays,proto3" json:"total_days,omitempty"`
-	TotalHours    int64                  `protobuf:"varint,9,opt,name=total_hours,json=totalHours,proto3" json:"total_hours,omitempty"`
-	TotalSecs     int64                  `protobuf:"varint,10,opt,name=total_secs,json=totalSecs,proto3" json:"total_secs,omitempty"`
-	Negative      bool                   `protobuf:"varint,11,opt,name=negative,proto3" json:"negative,omitempty"` // true when from is after to
-	Human         string                 `protobuf:"bytes,12,opt,name=human,proto3" json:"human,omitempty"`        // e.g. "2 years, 3 months, 5 days"
-	Error         string                 `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                              // stable id for this issue within the response
+	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`                                     // rune offset of the flagged span in text
+	Length        int32                  `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`                                     // rune length of the flagged span
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`                                          // the flagged substring
+	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`                                          // "spelling" | "grammar" | "punctuation" | "style"
+	Rule          string                 `protobuf:"bytes,6,opt,name=rule,proto3" json:"rule,omitempty"`                                          // rule identifier
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`                                    // human-readable description of the issue
+	Replacements  []string               `protobuf:"bytes,8,rep,name=replacements,proto3" json:"replacements,omitempty"`                          // suggested corrections (may be empty)
+	Context       string                 `protobuf:"bytes,9,opt,name=context,proto3" json:"context,omitempty"`                                    // surrounding text snippet
+	ContextOffset int32                  `protobuf:"varint,10,opt,name=context_offset,json=contextOffset,proto3" json:"context_offset,omitempty"` // rune offset of the flagged span within context
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DateDiffResponse) Reset() {
-	*x = DateDiffResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[105]
+func (x *SpellIssue) Reset() {
+	*x = SpellIssue{}
+	mi := &file_proto_privutil_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DateDiffResponse) String() string {
+func (x *SpellIssue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DateDiffResponse) ProtoMessage() {}
+func (*SpellIssue) ProtoMessage() {}
 
-func (x *DateDiffResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[105]
+func (x *SpellIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6608,124 +11644,170 @@ func (x *DateDiffResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DateDiffResponse.ProtoReflect.Descriptor instead.
-func (*DateDiffResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{105}
+// Deprecated: Use SpellIssue.ProtoReflect.Descriptor instead.
+func (*SpellIssue) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *DateDiffResponse) GetYears() int64 {
+func (x *SpellIssue) GetId() string {
 	if x != nil {
-		return x.Years
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *DateDiffResponse) GetMonths() int64 {
+func (x *SpellIssue) GetOffset() int32 {
 	if x != nil {
-		return x.Months
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *DateDiffResponse) GetWeeks() int64 {
+func (x *SpellIssue) GetLength() int32 {
 	if x != nil {
-		return x.Weeks
+		return x.Length
 	}
 	return 0
 }
 
-func (x *DateDiffResponse) GetDays() int64 {
+func (x *SpellIssue) GetText() string {
 	if x != nil {
-		return x.Days
+		return x.Text
 	}
-	return 0
+	return ""
 }
 
-func (x *DateDiffResponse) GetHours() int64 {
+func (x *SpellIssue) GetType() string {
 	if x != nil {
-		return x.Hours
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *DateDiffResponse) GetMinutes() int64 {
+func (x *SpellIssue) GetRule() string {
 	if x != nil {
-		return x.Minutes
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *SpellIssue) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SpellIssue) GetReplacements() []string {
+	if x != nil {
+		return x.Replacements
+	}
+	return nil
+}
+
+func (x *SpellIssue) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+func (x *SpellIssue) GetContextOffset() int32 {
+	if x != nil {
+		return x.ContextOffset
 	}
 	return 0
 }
 
-func (x *DateDiffResponse) GetSeconds() int64 {
-	if x != nil {
-		return x.Seconds
-	}
-	return 0
+type SpellCheckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issues        []*SpellIssue          `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	Language      string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"` // resolved language code
+	WordCount     int32                  `protobuf:"varint,3,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SpellCheckResponse) Reset() {
+	*x = SpellCheckResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpellCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DateDiffResponse) GetTotalDays() int64 {
+func (*SpellCheckResponse) ProtoMessage() {}
+
+func (x *SpellCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[160]
 	if x != nil {
-		return x.TotalDays
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *DateDiffResponse) GetTotalHours() int64 {
-	if x != nil {
-		return x.TotalHours
-	}
-	return 0
+// Deprecated: Use SpellCheckResponse.ProtoReflect.Descriptor instead.
+func (*SpellCheckResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *DateDiffResponse) GetTotalSecs() int64 {
+func (x *SpellCheckResponse) GetIssues() []*SpellIssue {
 	if x != nil {
-		return x.TotalSecs
+		return x.Issues
 	}
-	return 0
+	return nil
 }
 
-func (x *DateDiffResponse) GetNegative() bool {
+func (x *SpellCheckResponse) GetLanguage() string {
 	if x != nil {
-		return x.Negative
+		return x.Language
 	}
-	return false
+	return ""
 }
 
-func (x *DateDiffResponse) GetHuman() string {
+func (x *SpellCheckResponse) GetWordCount() int32 {
 	if x != nil {
-		return x.Human
+		return x.WordCount
 	}
-	return ""
+	return 0
 }
 
-func (x *DateDiffResponse) GetError() string {
+func (x *SpellCheckResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type LeapYearRequest struct {
+type SpellLanguagesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Input         string                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"` // single year, comma list, or "YYYY-YYYY" range
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LeapYearRequest) Reset() {
-	*x = LeapYearRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[106]
+func (x *SpellLanguagesRequest) Reset() {
+	*x = SpellLanguagesRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeapYearRequest) String() string {
+func (x *SpellLanguagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeapYearRequest) ProtoMessage() {}
+func (*SpellLanguagesRequest) ProtoMessage() {}
 
-func (x *LeapYearRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[106]
+func (x *SpellLanguagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6736,41 +11818,34 @@ func (x *LeapYearRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeapYearRequest.ProtoReflect.Descriptor instead.
-func (*LeapYearRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{106}
-}
-
-func (x *LeapYearRequest) GetInput() string {
-	if x != nil {
-		return x.Input
-	}
-	return ""
+// Deprecated: Use SpellLanguagesRequest.ProtoReflect.Descriptor instead.
+func (*SpellLanguagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{161}
 }
 
-type LeapYearEntry struct {
+type SpellLanguage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
-	IsLeap        bool                   `protobuf:"varint,2,opt,name=is_leap,json=isLeap,proto3" json:"is_leap,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LeapYearEntry) Reset() {
-	*x = LeapYearEntry{}
-	mi := &file_proto_privutil_proto_msgTypes[107]
+func (x *SpellLanguage) Reset() {
+	*x = SpellLanguage{}
+	mi := &file_proto_privutil_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeapYearEntry) String() string {
+func (x *SpellLanguage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeapYearEntry) ProtoMessage() {}
+func (*SpellLanguage) ProtoMessage() {}
 
-func (x *LeapYearEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[107]
+func (x *SpellLanguage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6781,49 +11856,47 @@ func (x *LeapYearEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeapYearEntry.ProtoReflect.Descriptor instead.
-func (*LeapYearEntry) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{107}
+// Deprecated: Use SpellLanguage.ProtoReflect.Descriptor instead.
+func (*SpellLanguage) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *LeapYearEntry) GetYear() int32 {
+func (x *SpellLanguage) GetCode() string {
 	if x != nil {
-		return x.Year
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-func (x *LeapYearEntry) GetIsLeap() bool {
+func (x *SpellLanguage) GetLabel() string {
 	if x != nil {
-		return x.IsLeap
+		return x.Label
 	}
-	return false
+	return ""
 }
 
-type LeapYearResponse struct {
+type SpellLanguagesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Results       []*LeapYearEntry       `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	LeapCount     int32                  `protobuf:"varint,2,opt,name=leap_count,json=leapCount,proto3" json:"leap_count,omitempty"`
-	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Languages     []*SpellLanguage       `protobuf:"bytes,1,rep,name=languages,proto3" json:"languages,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LeapYearResponse) Reset() {
-	*x = LeapYearResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[108]
+func (x *SpellLanguagesResponse) Reset() {
+	*x = SpellLanguagesResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LeapYearResponse) String() string {
+func (x *SpellLanguagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LeapYearResponse) ProtoMessage() {}
+func (*SpellLanguagesResponse) ProtoMessage() {}
 
-func (x *LeapYearResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[108]
+func (x *SpellLanguagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6834,61 +11907,46 @@ func (x *LeapYearResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LeapYearResponse.ProtoReflect.Descriptor instead.
-func (*LeapYearResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{108}
+// Deprecated: Use SpellLanguagesResponse.ProtoReflect.Descriptor instead.
+func (*SpellLanguagesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *LeapYearResponse) GetResults() []*LeapYearEntry {
+func (x *SpellLanguagesResponse) GetLanguages() []*SpellLanguage {
 	if x != nil {
-		return x.Results
+		return x.Languages
 	}
 	return nil
 }
 
-func (x *LeapYearResponse) GetLeapCount() int32 {
-	if x != nil {
-		return x.LeapCount
-	}
-	return 0
-}
-
-func (x *LeapYearResponse) GetError() string {
-	if x != nil {
-		return x.Error
-	}
-	return ""
-}
-
-type DateAddRequest struct {
+type InferSchemaRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	Years         int32                  `protobuf:"varint,2,opt,name=years,proto3" json:"years,omitempty"`
-	Months        int32                  `protobuf:"varint,3,opt,name=months,proto3" json:"months,omitempty"`
-	Weeks         int32                  `protobuf:"varint,4,opt,name=weeks,proto3" json:"weeks,omitempty"`
-	Days          int32                  `protobuf:"varint,5,opt,name=days,proto3" json:"days,omitempty"`
-	Hours         int32                  `protobuf:"varint,6,opt,name=hours,proto3" json:"hours,omitempty"`
-	Minutes       int32                  `protobuf:"varint,7,opt,name=minutes,proto3" json:"minutes,omitempty"`
-	Seconds       int32                  `protobuf:"varint,8,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	Samples       []string               `protobuf:"bytes,1,rep,name=samples,proto3" json:"samples,omitempty"`                         // one document per entry
+	Format        DataFormat             `protobuf:"varint,2,opt,name=format,proto3,enum=privutil.DataFormat" json:"format,omitempty"` // format of every sample (JSON, YAML, TOML, XML, CSV)
+	Draft         SchemaDraft            `protobuf:"varint,3,opt,name=draft,proto3,enum=privutil.SchemaDraft" json:"draft,omitempty"`
+	Strict        bool                   `protobuf:"varint,4,opt,name=strict,proto3" json:"strict,omitempty"`                                      // emit "additionalProperties": false on every object
+	Title         string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`                                         // optional root title
+	MaxEnumValues int32                  `protobuf:"varint,6,opt,name=max_enum_values,json=maxEnumValues,proto3" json:"max_enum_values,omitempty"` // distinct strings allowed for enum detection; 0 = default (5), negative disables
+	NoFormats     bool                   `protobuf:"varint,7,opt,name=no_formats,json=noFormats,proto3" json:"no_formats,omitempty"`               // skip string format detection (date-time, uuid, email, uri…)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DateAddRequest) Reset() {
-	*x = DateAddRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[109]
+func (x *InferSchemaRequest) Reset() {
+	*x = InferSchemaRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DateAddRequest) String() string {
+func (x *InferSchemaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DateAddRequest) ProtoMessage() {}
+func (*InferSchemaRequest) ProtoMessage() {}
 
-func (x *DateAddRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[109]
+func (x *InferSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6899,96 +11957,84 @@ func (x *DateAddRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DateAddRequest.ProtoReflect.Descriptor instead.
-func (*DateAddRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{109}
-}
-
-func (x *DateAddRequest) GetDate() string {
-	if x != nil {
-		return x.Date
-	}
-	return ""
+// Deprecated: Use InferSchemaRequest.ProtoReflect.Descriptor instead.
+func (*InferSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *DateAddRequest) GetYears() int32 {
+func (x *InferSchemaRequest) GetSamples() []string {
 	if x != nil {
-		return x.Years
+		return x.Samples
 	}
-	return 0
+	return nil
 }
 
-func (x *DateAddRequest) GetMonths() int32 {
+func (x *InferSchemaRequest) GetFormat() DataFormat {
 	if x != nil {
-		return x.Months
+		return x.Format
 	}
-	return 0
+	return DataFormat_JSON
 }
 
-func (x *DateAddRequest) GetWeeks() int32 {
+func (x *InferSchemaRequest) GetDraft() SchemaDraft {
 	if x != nil {
-		return x.Weeks
+		return x.Draft
 	}
-	return 0
+	return SchemaDraft_DRAFT_2020_12
 }
 
-func (x *DateAddRequest) GetDays() int32 {
+func (x *InferSchemaRequest) GetStrict() bool {
 	if x != nil {
-		return x.Days
+		return x.Strict
 	}
-	return 0
+	return false
 }
 
-func (x *DateAddRequest) GetHours() int32 {
+func (x *InferSchemaRequest) GetTitle() string {
 	if x != nil {
-		return x.Hours
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *DateAddRequest) GetMinutes() int32 {
+func (x *InferSchemaRequest) GetMaxEnumValues() int32 {
 	if x != nil {
-		return x.Minutes
+		return x.MaxEnumValues
 	}
 	return 0
 }
 
-func (x *DateAddRequest) GetSeconds() int32 {
+func (x *InferSchemaRequest) GetNoFormats() bool {
 	if x != nil {
-		return x.Seconds
+		return x.NoFormats
 	}
-	return 0
+	return false
 }
 
-type DateAddResponse struct {
+type InferSchemaResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Iso           string                 `protobuf:"bytes,1,opt,name=iso,proto3" json:"iso,omitempty"`                        // YYYY-MM-DD
-	IsoFull       string                 `protobuf:"bytes,2,opt,name=iso_full,json=isoFull,proto3" json:"iso_full,omitempty"` // RFC3339
-	Unix          string                 `protobuf:"bytes,3,opt,name=unix,proto3" json:"unix,omitempty"`
-	Weekday       string                 `protobuf:"bytes,4,opt,name=weekday,proto3" json:"weekday,omitempty"`
-	DayOfYear     int32                  `protobuf:"varint,5,opt,name=day_of_year,json=dayOfYear,proto3" json:"day_of_year,omitempty"`
-	IsoWeek       int32                  `protobuf:"varint,6,opt,name=iso_week,json=isoWeek,proto3" json:"iso_week,omitempty"`
-	Formatted     string                 `protobuf:"bytes,7,opt,name=formatted,proto3" json:"formatted,omitempty"` // "Monday, 15 January 2024"
-	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	Schema        string                 `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"` // pretty-printed JSON Schema document
+	SampleCount   int32                  `protobuf:"varint,2,opt,name=sample_count,json=sampleCount,proto3" json:"sample_count,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DateAddResponse) Reset() {
-	*x = DateAddResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[110]
+func (x *InferSchemaResponse) Reset() {
+	*x = InferSchemaResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DateAddResponse) String() string {
+func (x *InferSchemaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DateAddResponse) ProtoMessage() {}
+func (*InferSchemaResponse) ProtoMessage() {}
 
-func (x *DateAddResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[110]
+func (x *InferSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6999,90 +12045,115 @@ func (x *DateAddResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DateAddResponse.ProtoReflect.Descriptor instead.
-func (*DateAddResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{110}
+// Deprecated: Use InferSchemaResponse.ProtoReflect.Descriptor instead.
+func (*InferSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *DateAddResponse) GetIso() string {
+func (x *InferSchemaResponse) GetSchema() string {
 	if x != nil {
-		return x.Iso
+		return x.Schema
 	}
 	return ""
 }
 
-func (x *DateAddResponse) GetIsoFull() string {
+func (x *InferSchemaResponse) GetSampleCount() int32 {
 	if x != nil {
-		return x.IsoFull
+		return x.SampleCount
 	}
-	return ""
+	return 0
 }
 
-func (x *DateAddResponse) GetUnix() string {
+func (x *InferSchemaResponse) GetError() string {
 	if x != nil {
-		return x.Unix
+		return x.Error
 	}
 	return ""
 }
 
-func (x *DateAddResponse) GetWeekday() string {
-	if x != nil {
-		return x.Weekday
-	}
-	return ""
+type JsonToCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Json          string                 `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
+	TypeName      string                 `protobuf:"bytes,2,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"` // root type name; default "AutoGenerated"
+	Target        CodeTarget             `protobuf:"varint,3,opt,name=target,proto3,enum=privutil.CodeTarget" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DateAddResponse) GetDayOfYear() int32 {
+func (x *JsonToCodeRequest) Reset() {
+	*x = JsonToCodeRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JsonToCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JsonToCodeRequest) ProtoMessage() {}
+
+func (x *JsonToCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[166]
 	if x != nil {
-		return x.DayOfYear
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *DateAddResponse) GetIsoWeek() int32 {
+// Deprecated: Use JsonToCodeRequest.ProtoReflect.Descriptor instead.
+func (*JsonToCodeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *JsonToCodeRequest) GetJson() string {
 	if x != nil {
-		return x.IsoWeek
+		return x.Json
 	}
-	return 0
+	return ""
 }
 
-func (x *DateAddResponse) GetFormatted() string {
+func (x *JsonToCodeRequest) GetTypeName() string {
 	if x != nil {
-		return x.Formatted
+		return x.TypeName
 	}
 	return ""
 }
 
-func (x *DateAddResponse) GetError() string {
+func (x *JsonToCodeRequest) GetTarget() CodeTarget {
 	if x != nil {
-		return x.Error
+		return x.Target
 	}
-	return ""
+	return CodeTarget_CODE_TYPESCRIPT
 }
 
-type DateFormatRequest struct {
+type JsonToCodeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DateStr       string                 `protobuf:"bytes,1,opt,name=date_str,json=dateStr,proto3" json:"date_str,omitempty"`
-	Timezone      string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA tz name, default "UTC"
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DateFormatRequest) Reset() {
-	*x = DateFormatRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[111]
+func (x *JsonToCodeResponse) Reset() {
+	*x = JsonToCodeResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DateFormatRequest) String() string {
+func (x *JsonToCodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DateFormatRequest) ProtoMessage() {}
+func (*JsonToCodeResponse) ProtoMessage() {}
 
-func (x *DateFormatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[111]
+func (x *JsonToCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7093,48 +12164,53 @@ func (x *DateFormatRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DateFormatRequest.ProtoReflect.Descriptor instead.
-func (*DateFormatRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{111}
+// Deprecated: Use JsonToCodeResponse.ProtoReflect.Descriptor instead.
+func (*JsonToCodeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{167}
 }
 
-func (x *DateFormatRequest) GetDateStr() string {
+func (x *JsonToCodeResponse) GetCode() string {
 	if x != nil {
-		return x.DateStr
+		return x.Code
 	}
 	return ""
 }
 
-func (x *DateFormatRequest) GetTimezone() string {
+func (x *JsonToCodeResponse) GetError() string {
 	if x != nil {
-		return x.Timezone
+		return x.Error
 	}
 	return ""
 }
 
-type DateFormatEntry struct {
+type DataQueryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
-	Result        string                 `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	Data          string                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Format        DataFormat             `protobuf:"varint,2,opt,name=format,proto3,enum=privutil.DataFormat" json:"format,omitempty"` // format of data
+	Expression    string                 `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
+	Language      QueryLanguage          `protobuf:"varint,4,opt,name=language,proto3,enum=privutil.QueryLanguage" json:"language,omitempty"`
+	OutputFormat  DataFormat             `protobuf:"varint,5,opt,name=output_format,json=outputFormat,proto3,enum=privutil.DataFormat" json:"output_format,omitempty"` // format of the rendered result
+	CsvDelimiter  string                 `protobuf:"bytes,6,opt,name=csv_delimiter,json=csvDelimiter,proto3" json:"csv_delimiter,omitempty"`                           // applies to CSV input and output
+	RawOutput     bool                   `protobuf:"varint,7,opt,name=raw_output,json=rawOutput,proto3" json:"raw_output,omitempty"`                                   // print string results without quotes, one per line
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DateFormatEntry) Reset() {
-	*x = DateFormatEntry{}
-	mi := &file_proto_privutil_proto_msgTypes[112]
+func (x *DataQueryRequest) Reset() {
+	*x = DataQueryRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DateFormatEntry) String() string {
+func (x *DataQueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DateFormatEntry) ProtoMessage() {}
+func (*DataQueryRequest) ProtoMessage() {}
 
-func (x *DateFormatEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[112]
+func (x *DataQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7145,48 +12221,86 @@ func (x *DateFormatEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DateFormatEntry.ProtoReflect.Descriptor instead.
-func (*DateFormatEntry) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{112}
+// Deprecated: Use DataQueryRequest.ProtoReflect.Descriptor instead.
+func (*DataQueryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *DateFormatEntry) GetLabel() string {
+func (x *DataQueryRequest) GetData() string {
 	if x != nil {
-		return x.Label
+		return x.Data
 	}
 	return ""
 }
 
-func (x *DateFormatEntry) GetResult() string {
+func (x *DataQueryRequest) GetFormat() DataFormat {
 	if x != nil {
-		return x.Result
+		return x.Format
+	}
+	return DataFormat_JSON
+}
+
+func (x *DataQueryRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
 	}
 	return ""
 }
 
-type DateFormatResponse struct {
+func (x *DataQueryRequest) GetLanguage() QueryLanguage {
+	if x != nil {
+		return x.Language
+	}
+	return QueryLanguage_QUERY_JSONPATH
+}
+
+func (x *DataQueryRequest) GetOutputFormat() DataFormat {
+	if x != nil {
+		return x.OutputFormat
+	}
+	return DataFormat_JSON
+}
+
+func (x *DataQueryRequest) GetCsvDelimiter() string {
+	if x != nil {
+		return x.CsvDelimiter
+	}
+	return ""
+}
+
+func (x *DataQueryRequest) GetRawOutput() bool {
+	if x != nil {
+		return x.RawOutput
+	}
+	return false
+}
+
+type DataQueryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Formats       []*DateFormatEntry     `protobuf:"bytes,1,rep,name=formats,proto3" json:"formats,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // number of results
+	Paths         []string               `protobuf:"bytes,3,rep,name=paths,proto3" json:"paths,omitempty"`  // normalized paths of JSONPath matches
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorPosition int32                  `protobuf:"varint,5,opt,name=error_position,json=errorPosition,proto3" json:"error_position,omitempty"` // 1-based offset into the expression; 0 when not applicable
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DateFormatResponse) Reset() {
-	*x = DateFormatResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[113]
+func (x *DataQueryResponse) Reset() {
+	*x = DataQueryResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DateFormatResponse) String() string {
+func (x *DataQueryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DateFormatResponse) ProtoMessage() {}
+func (*DataQueryResponse) ProtoMessage() {}
 
-func (x *DateFormatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[113]
+func (x *DataQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7197,47 +12311,72 @@ func (x *DateFormatResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DateFormatResponse.ProtoReflect.Descriptor instead.
-func (*DateFormatResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{113}
+// Deprecated: Use DataQueryResponse.ProtoReflect.Descriptor instead.
+func (*DataQueryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{169}
 }
 
-func (x *DateFormatResponse) GetFormats() []*DateFormatEntry {
+func (x *DataQueryResponse) GetResult() string {
 	if x != nil {
-		return x.Formats
+		return x.Result
+	}
+	return ""
+}
+
+func (x *DataQueryResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DataQueryResponse) GetPaths() []string {
+	if x != nil {
+		return x.Paths
 	}
 	return nil
 }
 
-func (x *DateFormatResponse) GetError() string {
+func (x *DataQueryResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type DateInfoRequest struct {
+func (x *DataQueryResponse) GetErrorPosition() int32 {
+	if x != nil {
+		return x.ErrorPosition
+	}
+	return 0
+}
+
+type DataDiffRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Left          string                 `protobuf:"bytes,1,opt,name=left,proto3" json:"left,omitempty"`
+	Right         string                 `protobuf:"bytes,2,opt,name=right,proto3" json:"right,omitempty"`
+	LeftFormat    DataFormat             `protobuf:"varint,3,opt,name=left_format,json=leftFormat,proto3,enum=privutil.DataFormat" json:"left_format,omitempty"`
+	RightFormat   DataFormat             `protobuf:"varint,4,opt,name=right_format,json=rightFormat,proto3,enum=privutil.DataFormat" json:"right_format,omitempty"`
+	ArrayKey      string                 `protobuf:"bytes,5,opt,name=array_key,json=arrayKey,proto3" json:"array_key,omitempty"` // match array elements of objects by this field instead of by index
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DateInfoRequest) Reset() {
-	*x = DateInfoRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[114]
+func (x *DataDiffRequest) Reset() {
+	*x = DataDiffRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DateInfoRequest) String() string {
+func (x *DataDiffRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DateInfoRequest) ProtoMessage() {}
+func (*DataDiffRequest) ProtoMessage() {}
 
-func (x *DateInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[114]
+func (x *DataDiffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7248,54 +12387,72 @@ func (x *DateInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DateInfoRequest.ProtoReflect.Descriptor instead.
-func (*DateInfoRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{114}
+// Deprecated: Use DataDiffRequest.ProtoReflect.Descriptor instead.
+func (*DataDiffRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *DateInfoRequest) GetDate() string {
+func (x *DataDiffRequest) GetLeft() string {
 	if x != nil {
-		return x.Date
+		return x.Left
 	}
 	return ""
 }
 
-type DateInfoResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Weekday        string                 `protobuf:"bytes,1,opt,name=weekday,proto3" json:"weekday,omitempty"`
-	IsWeekend      bool                   `protobuf:"varint,2,opt,name=is_weekend,json=isWeekend,proto3" json:"is_weekend,omitempty"`
-	DayOfYear      int32                  `protobuf:"varint,3,opt,name=day_of_year,json=dayOfYear,proto3" json:"day_of_year,omitempty"`
-	DaysInYear     int32                  `protobuf:"varint,4,opt,name=days_in_year,json=daysInYear,proto3" json:"days_in_year,omitempty"`
-	DaysLeftYear   int32                  `protobuf:"varint,5,opt,name=days_left_year,json=daysLeftYear,proto3" json:"days_left_year,omitempty"`
-	IsoWeek        int32                  `protobuf:"varint,6,opt,name=iso_week,json=isoWeek,proto3" json:"iso_week,omitempty"`
-	IsoYear        int32                  `protobuf:"varint,7,opt,name=iso_year,json=isoYear,proto3" json:"iso_year,omitempty"`
-	Quarter        string                 `protobuf:"bytes,8,opt,name=quarter,proto3" json:"quarter,omitempty"` // "Q1"…"Q4"
-	DaysInMonth    int32                  `protobuf:"varint,9,opt,name=days_in_month,json=daysInMonth,proto3" json:"days_in_month,omitempty"`
-	DaysLeftMonth  int32                  `protobuf:"varint,10,opt,name=days_left_month,json=daysLeftMonth,proto3" json:"days_left_month,omitempty"`
-	UnixSec        int64                  `protobuf:"varint,11,opt,name=unix_sec,json=unixSec,proto3" json:"unix_sec,omitempty"`
-	Zodiac         string                 `protobuf:"bytes,12,opt,name=zodiac,proto3" json:"zodiac,omitempty"`
-	Season         string                 `protobuf:"bytes,13,opt,name=season,proto3" json:"season,omitempty"` // Northern hemisphere
-	DaysSinceEpoch int64                  `protobuf:"varint,14,opt,name=days_since_epoch,json=daysSinceEpoch,proto3" json:"days_since_epoch,omitempty"`
-	Error          string                 `protobuf:"bytes,15,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *DataDiffRequest) GetRight() string {
+	if x != nil {
+		return x.Right
+	}
+	return ""
 }
 
-func (x *DateInfoResponse) Reset() {
-	*x = DateInfoResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[115]
+func (x *DataDiffRequest) GetLeftFormat() DataFormat {
+	if x != nil {
+		return x.LeftFormat
+	}
+	return DataFormat_JSON
+}
+
+func (x *DataDiffRequest) GetRightFormat() DataFormat {
+	if x != nil {
+		return x.RightFormat
+	}
+	return DataFormat_JSON
+}
+
+func (x *DataDiffRequest) GetArrayKey() string {
+	if x != nil {
+		return x.ArrayKey
+	}
+	return ""
+}
+
+type DataChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Op            string                 `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`                             // "add", "remove", "replace" or "move"
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`                         // JSON Pointer of the changed location
+	From          string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`                         // source pointer for "move"
+	OldValue      string                 `protobuf:"bytes,4,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"` // compact JSON; empty for "add"
+	NewValue      string                 `protobuf:"bytes,5,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"` // compact JSON; empty for "remove" and "move"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataChange) Reset() {
+	*x = DataChange{}
+	mi := &file_proto_privutil_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DateInfoResponse) String() string {
+func (x *DataChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DateInfoResponse) ProtoMessage() {}
+func (*DataChange) ProtoMessage() {}
 
-func (x *DateInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[115]
+func (x *DataChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7306,139 +12463,155 @@ func (x *DateInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DateInfoResponse.ProtoReflect.Descriptor instead.
-func (*DateInfoResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{115}
+// Deprecated: Use DataChange.ProtoReflect.Descriptor instead.
+func (*DataChange) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{171}
 }
 
-func (x *DateInfoResponse) GetWeekday() string {
+func (x *DataChange) GetOp() string {
 	if x != nil {
-		return x.Weekday
+		return x.Op
 	}
 	return ""
 }
 
-func (x *DateInfoResponse) GetIsWeekend() bool {
+func (x *DataChange) GetPath() string {
 	if x != nil {
-		return x.IsWeekend
+		return x.Path
 	}
-	return false
+	return ""
 }
 
-func (x *DateInfoResponse) GetDayOfYear() int32 {
+func (x *DataChange) GetFrom() string {
 	if x != nil {
-		return x.DayOfYear
+		return x.From
 	}
-	return 0
+	return ""
 }
 
-func (x *DateInfoResponse) GetDaysInYear() int32 {
+func (x *DataChange) GetOldValue() string {
 	if x != nil {
-		return x.DaysInYear
+		return x.OldValue
 	}
-	return 0
+	return ""
 }
 
-func (x *DateInfoResponse) GetDaysLeftYear() int32 {
+func (x *DataChange) GetNewValue() string {
 	if x != nil {
-		return x.DaysLeftYear
+		return x.NewValue
 	}
-	return 0
+	return ""
 }
 
-func (x *DateInfoResponse) GetIsoWeek() int32 {
-	if x != nil {
-		return x.IsoWeek
-	}
-	return 0
+type DataDiffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Equal         bool                   `protobuf:"varint,1,opt,name=equal,proto3" json:"equal,omitempty"`
+	Summary       string                 `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`                         // one human-readable line per change
+	JsonPatch     string                 `protobuf:"bytes,3,opt,name=json_patch,json=jsonPatch,proto3" json:"json_patch,omitempty"`    // RFC 6902
+	MergePatch    string                 `protobuf:"bytes,4,opt,name=merge_patch,json=mergePatch,proto3" json:"merge_patch,omitempty"` // RFC 7386
+	Changes       []*DataChange          `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DateInfoResponse) GetIsoYear() int32 {
-	if x != nil {
-		return x.IsoYear
-	}
-	return 0
+func (x *DataDiffResponse) Reset() {
+	*x = DataDiffResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DateInfoResponse) GetQuarter() string {
-	if x != nil {
-		return x.Quarter
-	}
-	return ""
+func (x *DataDiffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DateInfoResponse) GetDaysInMonth() int32 {
+func (*DataDiffResponse) ProtoMessage() {}
+
+func (x *DataDiffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[172]
 	if x != nil {
-		return x.DaysInMonth
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *DateInfoResponse) GetDaysLeftMonth() int32 {
+// Deprecated: Use DataDiffResponse.ProtoReflect.Descriptor instead.
+func (*DataDiffResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *DataDiffResponse) GetEqual() bool {
 	if x != nil {
-		return x.DaysLeftMonth
+		return x.Equal
 	}
-	return 0
+	return false
 }
 
-func (x *DateInfoResponse) GetUnixSec() int64 {
+func (x *DataDiffResponse) GetSummary() string {
 	if x != nil {
-		return x.UnixSec
+		return x.Summary
 	}
-	return 0
+	return ""
 }
 
-func (x *DateInfoResponse) GetZodiac() string {
+func (x *DataDiffResponse) GetJsonPatch() string {
 	if x != nil {
-		return x.Zodiac
+		return x.JsonPatch
 	}
 	return ""
 }
 
-func (x *DateInfoResponse) GetSeason() string {
+func (x *DataDiffResponse) GetMergePatch() string {
 	if x != nil {
-		return x.Season
+		return x.MergePatch
 	}
 	return ""
 }
 
-func (x *DateInfoResponse) GetDaysSinceEpoch() int64 {
+func (x *DataDiffResponse) GetChanges() []*DataChange {
 	if x != nil {
-		return x.DaysSinceEpoch
+		return x.Changes
 	}
-	return 0
+	return nil
 }
 
-func (x *DateInfoResponse) GetError() string {
+func (x *DataDiffResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type QueryParam struct {
+type DataPatchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Document      string                 `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	Format        DataFormat             `protobuf:"varint,2,opt,name=format,proto3,enum=privutil.DataFormat" json:"format,omitempty"` // format of document; the result uses the same format
+	Patch         string                 `protobuf:"bytes,3,opt,name=patch,proto3" json:"patch,omitempty"`                             // always JSON
+	PatchType     PatchType              `protobuf:"varint,4,opt,name=patch_type,json=patchType,proto3,enum=privutil.PatchType" json:"patch_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryParam) Reset() {
-	*x = QueryParam{}
-	mi := &file_proto_privutil_proto_msgTypes[116]
+func (x *DataPatchRequest) Reset() {
+	*x = DataPatchRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryParam) String() string {
+func (x *DataPatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParam) ProtoMessage() {}
+func (*DataPatchRequest) ProtoMessage() {}
 
-func (x *QueryParam) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[116]
+func (x *DataPatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7449,47 +12622,62 @@ func (x *QueryParam) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryParam.ProtoReflect.Descriptor instead.
-func (*QueryParam) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{116}
+// Deprecated: Use DataPatchRequest.ProtoReflect.Descriptor instead.
+func (*DataPatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{173}
 }
 
-func (x *QueryParam) GetKey() string {
+func (x *DataPatchRequest) GetDocument() string {
 	if x != nil {
-		return x.Key
+		return x.Document
 	}
 	return ""
 }
 
-func (x *QueryParam) GetValue() string {
+func (x *DataPatchRequest) GetFormat() DataFormat {
 	if x != nil {
-		return x.Value
+		return x.Format
+	}
+	return DataFormat_JSON
+}
+
+func (x *DataPatchRequest) GetPatch() string {
+	if x != nil {
+		return x.Patch
 	}
 	return ""
 }
 
-type UrlParseRequest struct {
+func (x *DataPatchRequest) GetPatchType() PatchType {
+	if x != nil {
+		return x.PatchType
+	}
+	return PatchType_PATCH_JSON
+}
+
+type DataPatchResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UrlParseRequest) Reset() {
-	*x = UrlParseRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[117]
+func (x *DataPatchResponse) Reset() {
+	*x = DataPatchResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UrlParseRequest) String() string {
+func (x *DataPatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UrlParseRequest) ProtoMessage() {}
+func (*DataPatchResponse) ProtoMessage() {}
 
-func (x *UrlParseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[117]
+func (x *DataPatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7500,52 +12688,49 @@ func (x *UrlParseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UrlParseRequest.ProtoReflect.Descriptor instead.
-func (*UrlParseRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{117}
+// Deprecated: Use DataPatchResponse.ProtoReflect.Descriptor instead.
+func (*DataPatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{174}
 }
 
-func (x *UrlParseRequest) GetUrl() string {
+func (x *DataPatchResponse) GetResult() string {
 	if x != nil {
-		return x.Url
+		return x.Result
 	}
 	return ""
 }
 
-type UrlParseResponse struct {
+func (x *DataPatchResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type XmlFormatRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Scheme        string                 `protobuf:"bytes,1,opt,name=scheme,proto3" json:"scheme,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	Host          string                 `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"` // hostname:port
-	Hostname      string                 `protobuf:"bytes,5,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Port          string                 `protobuf:"bytes,6,opt,name=port,proto3" json:"port,omitempty"`
-	Path          string                 `protobuf:"bytes,7,opt,name=path,proto3" json:"path,omitempty"`
-	Query         string                 `protobuf:"bytes,8,opt,name=query,proto3" json:"query,omitempty"` // raw query string
-	QueryParams   []*QueryParam          `protobuf:"bytes,9,rep,name=query_params,json=queryParams,proto3" json:"query_params,omitempty"`
-	Fragment      string                 `protobuf:"bytes,10,opt,name=fragment,proto3" json:"fragment,omitempty"`
-	Normalized    string                 `protobuf:"bytes,11,opt,name=normalized,proto3" json:"normalized,omitempty"`
-	IsValid       bool                   `protobuf:"varint,12,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
-	Error         string                 `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Indent        string                 `protobuf:"bytes,2,opt,name=indent,proto3" json:"indent,omitempty"` // "2", "4", "tab", or "min" (minify)
+	StripComments bool                   `protobuf:"varint,3,opt,name=strip_comments,json=stripComments,proto3" json:"strip_comments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UrlParseResponse) Reset() {
-	*x = UrlParseResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[118]
+func (x *XmlFormatRequest) Reset() {
+	*x = XmlFormatRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UrlParseResponse) String() string {
+func (x *XmlFormatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UrlParseResponse) ProtoMessage() {}
+func (*XmlFormatRequest) ProtoMessage() {}
 
-func (x *UrlParseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[118]
+func (x *XmlFormatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7556,124 +12741,134 @@ func (x *UrlParseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UrlParseResponse.ProtoReflect.Descriptor instead.
-func (*UrlParseResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{118}
+// Deprecated: Use XmlFormatRequest.ProtoReflect.Descriptor instead.
+func (*XmlFormatRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{175}
 }
 
-func (x *UrlParseResponse) GetScheme() string {
+func (x *XmlFormatRequest) GetText() string {
 	if x != nil {
-		return x.Scheme
+		return x.Text
 	}
 	return ""
 }
 
-func (x *UrlParseResponse) GetUsername() string {
+func (x *XmlFormatRequest) GetIndent() string {
 	if x != nil {
-		return x.Username
+		return x.Indent
 	}
 	return ""
 }
 
-func (x *UrlParseResponse) GetPassword() string {
+func (x *XmlFormatRequest) GetStripComments() bool {
 	if x != nil {
-		return x.Password
+		return x.StripComments
 	}
-	return ""
+	return false
 }
 
-func (x *UrlParseResponse) GetHost() string {
-	if x != nil {
-		return x.Host
-	}
-	return ""
+type XmlNamespace struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"` // empty for a default namespace declaration
+	Uri           string                 `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	Element       string                 `protobuf:"bytes,3,opt,name=element,proto3" json:"element,omitempty"` // path of the declaring element
+	Line          int32                  `protobuf:"varint,4,opt,name=line,proto3" json:"line,omitempty"`
+	Uses          int32                  `protobuf:"varint,5,opt,name=uses,proto3" json:"uses,omitempty"` // element and attribute names bound to this declaration
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UrlParseResponse) GetHostname() string {
-	if x != nil {
-		return x.Hostname
-	}
-	return ""
+func (x *XmlNamespace) Reset() {
+	*x = XmlNamespace{}
+	mi := &file_proto_privutil_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UrlParseResponse) GetPort() string {
-	if x != nil {
-		return x.Port
-	}
-	return ""
+func (x *XmlNamespace) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UrlParseResponse) GetPath() string {
+func (*XmlNamespace) ProtoMessage() {}
+
+func (x *XmlNamespace) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[176]
 	if x != nil {
-		return x.Path
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UrlParseResponse) GetQuery() string {
-	if x != nil {
-		return x.Query
-	}
-	return ""
+// Deprecated: Use XmlNamespace.ProtoReflect.Descriptor instead.
+func (*XmlNamespace) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{176}
 }
 
-func (x *UrlParseResponse) GetQueryParams() []*QueryParam {
+func (x *XmlNamespace) GetPrefix() string {
 	if x != nil {
-		return x.QueryParams
+		return x.Prefix
 	}
-	return nil
+	return ""
 }
 
-func (x *UrlParseResponse) GetFragment() string {
+func (x *XmlNamespace) GetUri() string {
 	if x != nil {
-		return x.Fragment
+		return x.Uri
 	}
 	return ""
 }
 
-func (x *UrlParseResponse) GetNormalized() string {
+func (x *XmlNamespace) GetElement() string {
 	if x != nil {
-		return x.Normalized
+		return x.Element
 	}
 	return ""
 }
 
-func (x *UrlParseResponse) GetIsValid() bool {
+func (x *XmlNamespace) GetLine() int32 {
 	if x != nil {
-		return x.IsValid
+		return x.Line
 	}
-	return false
+	return 0
 }
 
-func (x *UrlParseResponse) GetError() string {
+func (x *XmlNamespace) GetUses() int32 {
 	if x != nil {
-		return x.Error
+		return x.Uses
 	}
-	return ""
+	return 0
 }
 
-type UserAgentParseRequest struct {
+type XmlFormatResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserAgent     string                 `protobuf:"bytes,1,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Namespaces    []*XmlNamespace        `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorLine     int32                  `protobuf:"varint,4,opt,name=error_line,json=errorLine,proto3" json:"error_line,omitempty"`       // 1-based; 0 when unknown
+	ErrorColumn   int32                  `protobuf:"varint,5,opt,name=error_column,json=errorColumn,proto3" json:"error_column,omitempty"` // 1-based; 0 when unknown
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserAgentParseRequest) Reset() {
-	*x = UserAgentParseRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[119]
+func (x *XmlFormatResponse) Reset() {
+	*x = XmlFormatResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserAgentParseRequest) String() string {
+func (x *XmlFormatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserAgentParseRequest) ProtoMessage() {}
+func (*XmlFormatResponse) ProtoMessage() {}
 
-func (x *UserAgentParseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[119]
+func (x *XmlFormatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7684,41 +12879,70 @@ func (x *UserAgentParseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserAgentParseRequest.ProtoReflect.Descriptor instead.
-func (*UserAgentParseRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{119}
+// Deprecated: Use XmlFormatResponse.ProtoReflect.Descriptor instead.
+func (*XmlFormatResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{177}
 }
 
-func (x *UserAgentParseRequest) GetUserAgent() string {
+func (x *XmlFormatResponse) GetText() string {
 	if x != nil {
-		return x.UserAgent
+		return x.Text
 	}
 	return ""
 }
 
-type UAParsedField struct {
+func (x *XmlFormatResponse) GetNamespaces() []*XmlNamespace {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *XmlFormatResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *XmlFormatResponse) GetErrorLine() int32 {
+	if x != nil {
+		return x.ErrorLine
+	}
+	return 0
+}
+
+func (x *XmlFormatResponse) GetErrorColumn() int32 {
+	if x != nil {
+		return x.ErrorColumn
+	}
+	return 0
+}
+
+type XPathRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
-	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Xml           string                 `protobuf:"bytes,1,opt,name=xml,proto3" json:"xml,omitempty"`
+	Expression    string                 `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"` // XPath 1.0
+	Namespaces    []string               `protobuf:"bytes,3,rep,name=namespaces,proto3" json:"namespaces,omitempty"` // extra "prefix=uri" bindings; prefixes declared in the document are bound already
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UAParsedField) Reset() {
-	*x = UAParsedField{}
-	mi := &file_proto_privutil_proto_msgTypes[120]
+func (x *XPathRequest) Reset() {
+	*x = XPathRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UAParsedField) String() string {
+func (x *XPathRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UAParsedField) ProtoMessage() {}
+func (*XPathRequest) ProtoMessage() {}
 
-func (x *UAParsedField) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[120]
+func (x *XPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7729,57 +12953,58 @@ func (x *UAParsedField) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UAParsedField.ProtoReflect.Descriptor instead.
-func (*UAParsedField) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{120}
+// Deprecated: Use XPathRequest.ProtoReflect.Descriptor instead.
+func (*XPathRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{178}
 }
 
-func (x *UAParsedField) GetLabel() string {
+func (x *XPathRequest) GetXml() string {
 	if x != nil {
-		return x.Label
+		return x.Xml
 	}
 	return ""
 }
 
-func (x *UAParsedField) GetValue() string {
+func (x *XPathRequest) GetExpression() string {
 	if x != nil {
-		return x.Value
+		return x.Expression
 	}
 	return ""
 }
 
-type UserAgentParseResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	BrowserName    string                 `protobuf:"bytes,1,opt,name=browser_name,json=browserName,proto3" json:"browser_name,omitempty"`
-	BrowserVersion string                 `protobuf:"bytes,2,opt,name=browser_version,json=browserVersion,proto3" json:"browser_version,omitempty"`
-	OsName         string                 `protobuf:"bytes,3,opt,name=os_name,json=osName,proto3" json:"os_name,omitempty"`
-	OsVersion      string                 `protobuf:"bytes,4,opt,name=os_version,json=osVersion,proto3" json:"os_version,omitempty"`
-	DeviceType     string                 `protobuf:"bytes,5,opt,name=device_type,json=deviceType,proto3" json:"device_type,omitempty"` // "desktop", "mobile", "tablet", "bot"
-	Engine         string                 `protobuf:"bytes,6,opt,name=engine,proto3" json:"engine,omitempty"`
-	EngineVersion  string                 `protobuf:"bytes,7,opt,name=engine_version,json=engineVersion,proto3" json:"engine_version,omitempty"`
-	IsBot          bool                   `protobuf:"varint,8,opt,name=is_bot,json=isBot,proto3" json:"is_bot,omitempty"`
-	IsMobile       bool                   `protobuf:"varint,9,opt,name=is_mobile,json=isMobile,proto3" json:"is_mobile,omitempty"`
-	Fields         []*UAParsedField       `protobuf:"bytes,10,rep,name=fields,proto3" json:"fields,omitempty"`
-	Error          string                 `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *XPathRequest) GetNamespaces() []string {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
 }
 
-func (x *UserAgentParseResponse) Reset() {
-	*x = UserAgentParseResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[121]
+type XPathNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // "element", "attribute", "text", "comment", "processing-instruction" or "document"
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"` // XPath string value
+	Xml           string                 `protobuf:"bytes,4,opt,name=xml,proto3" json:"xml,omitempty"`     // the node serialized as written
+	Line          int32                  `protobuf:"varint,5,opt,name=line,proto3" json:"line,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XPathNode) Reset() {
+	*x = XPathNode{}
+	mi := &file_proto_privutil_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserAgentParseResponse) String() string {
+func (x *XPathNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserAgentParseResponse) ProtoMessage() {}
+func (*XPathNode) ProtoMessage() {}
 
-func (x *UserAgentParseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[121]
+func (x *XPathNode) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7790,111 +13015,153 @@ func (x *UserAgentParseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserAgentParseResponse.ProtoReflect.Descriptor instead.
-func (*UserAgentParseResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{121}
+// Deprecated: Use XPathNode.ProtoReflect.Descriptor instead.
+func (*XPathNode) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{179}
 }
 
-func (x *UserAgentParseResponse) GetBrowserName() string {
+func (x *XPathNode) GetType() string {
 	if x != nil {
-		return x.BrowserName
+		return x.Type
 	}
 	return ""
 }
 
-func (x *UserAgentParseResponse) GetBrowserVersion() string {
+func (x *XPathNode) GetPath() string {
 	if x != nil {
-		return x.BrowserVersion
+		return x.Path
 	}
 	return ""
 }
 
-func (x *UserAgentParseResponse) GetOsName() string {
+func (x *XPathNode) GetValue() string {
 	if x != nil {
-		return x.OsName
+		return x.Value
 	}
 	return ""
 }
 
-func (x *UserAgentParseResponse) GetOsVersion() string {
+func (x *XPathNode) GetXml() string {
 	if x != nil {
-		return x.OsVersion
+		return x.Xml
 	}
 	return ""
 }
 
-func (x *UserAgentParseResponse) GetDeviceType() string {
+func (x *XPathNode) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+type XPathResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResultType    string                 `protobuf:"bytes,1,opt,name=result_type,json=resultType,proto3" json:"result_type,omitempty"` // "node-set", "string", "number" or "boolean"
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`                             // the result converted with string()
+	Nodes         []*XPathNode           `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Count         int32                  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"` // number of nodes in a node-set result
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorPosition int32                  `protobuf:"varint,6,opt,name=error_position,json=errorPosition,proto3" json:"error_position,omitempty"` // 1-based offset into the expression; 0 when not applicable
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *XPathResponse) Reset() {
+	*x = XPathResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *XPathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*XPathResponse) ProtoMessage() {}
+
+func (x *XPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[180]
 	if x != nil {
-		return x.DeviceType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserAgentParseResponse) GetEngine() string {
+// Deprecated: Use XPathResponse.ProtoReflect.Descriptor instead.
+func (*XPathResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *XPathResponse) GetResultType() string {
 	if x != nil {
-		return x.Engine
+		return x.ResultType
 	}
 	return ""
 }
 
-func (x *UserAgentParseResponse) GetEngineVersion() string {
+func (x *XPathResponse) GetValue() string {
 	if x != nil {
-		return x.EngineVersion
+		return x.Value
 	}
 	return ""
 }
 
-func (x *UserAgentParseResponse) GetIsBot() bool {
+func (x *XPathResponse) GetNodes() []*XPathNode {
 	if x != nil {
-		return x.IsBot
+		return x.Nodes
 	}
-	return false
+	return nil
 }
 
-func (x *UserAgentParseResponse) GetIsMobile() bool {
+func (x *XPathResponse) GetCount() int32 {
 	if x != nil {
-		return x.IsMobile
+		return x.Count
 	}
-	return false
+	return 0
 }
 
-func (x *UserAgentParseResponse) GetFields() []*UAParsedField {
+func (x *XPathResponse) GetError() string {
 	if x != nil {
-		return x.Fields
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-func (x *UserAgentParseResponse) GetError() string {
+func (x *XPathResponse) GetErrorPosition() int32 {
 	if x != nil {
-		return x.Error
+		return x.ErrorPosition
 	}
-	return ""
+	return 0
 }
 
-type HttpStatusSearchRequest struct {
+type XmlValidateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`       // code number, name keyword, or "" for all
-	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"` // "1xx", "2xx", "3xx", "4xx", "5xx", or "" for all
+	Xml           string                 `protobuf:"bytes,1,opt,name=xml,proto3" json:"xml,omitempty"`
+	Xsd           string                 `protobuf:"bytes,2,opt,name=xsd,proto3" json:"xsd,omitempty"` // empty checks well-formedness only
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HttpStatusSearchRequest) Reset() {
-	*x = HttpStatusSearchRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[122]
+func (x *XmlValidateRequest) Reset() {
+	*x = XmlValidateRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HttpStatusSearchRequest) String() string {
+func (x *XmlValidateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HttpStatusSearchRequest) ProtoMessage() {}
+func (*XmlValidateRequest) ProtoMessage() {}
 
-func (x *HttpStatusSearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[122]
+func (x *XmlValidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7905,50 +13172,50 @@ func (x *HttpStatusSearchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HttpStatusSearchRequest.ProtoReflect.Descriptor instead.
-func (*HttpStatusSearchRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{122}
+// Deprecated: Use XmlValidateRequest.ProtoReflect.Descriptor instead.
+func (*XmlValidateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{181}
 }
 
-func (x *HttpStatusSearchRequest) GetQuery() string {
+func (x *XmlValidateRequest) GetXml() string {
 	if x != nil {
-		return x.Query
+		return x.Xml
 	}
 	return ""
 }
 
-func (x *HttpStatusSearchRequest) GetCategory() string {
+func (x *XmlValidateRequest) GetXsd() string {
 	if x != nil {
-		return x.Category
+		return x.Xsd
 	}
 	return ""
 }
 
-type HttpStatusEntry struct {
+type XmlIssue struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Category      string                 `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Column        int32                  `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HttpStatusEntry) Reset() {
-	*x = HttpStatusEntry{}
-	mi := &file_proto_privutil_proto_msgTypes[123]
+func (x *XmlIssue) Reset() {
+	*x = XmlIssue{}
+	mi := &file_proto_privutil_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HttpStatusEntry) String() string {
+func (x *XmlIssue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HttpStatusEntry) ProtoMessage() {}
+func (*XmlIssue) ProtoMessage() {}
 
-func (x *HttpStatusEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[123]
+func (x *XmlIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7959,61 +13226,65 @@ func (x *HttpStatusEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HttpStatusEntry.ProtoReflect.Descriptor instead.
-func (*HttpStatusEntry) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{123}
+// Deprecated: Use XmlIssue.ProtoReflect.Descriptor instead.
+func (*XmlIssue) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{182}
 }
 
-func (x *HttpStatusEntry) GetCode() int32 {
+func (x *XmlIssue) GetLine() int32 {
 	if x != nil {
-		return x.Code
+		return x.Line
 	}
 	return 0
 }
 
-func (x *HttpStatusEntry) GetName() string {
+func (x *XmlIssue) GetColumn() int32 {
 	if x != nil {
-		return x.Name
+		return x.Column
 	}
-	return ""
+	return 0
 }
 
-func (x *HttpStatusEntry) GetDescription() string {
+func (x *XmlIssue) GetPath() string {
 	if x != nil {
-		return x.Description
+		return x.Path
 	}
 	return ""
 }
 
-func (x *HttpStatusEntry) GetCategory() string {
+func (x *XmlIssue) GetMessage() string {
 	if x != nil {
-		return x.Category
+		return x.Message
 	}
 	return ""
 }
 
-type HttpStatusSearchResponse struct {
+type XmlValidateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entries       []*HttpStatusEntry     `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Roots         []string               `protobuf:"bytes,2,rep,name=roots,proto3" json:"roots,omitempty"` // elements validated against a global declaration
+	Issues        []*XmlIssue            `protobuf:"bytes,3,rep,name=issues,proto3" json:"issues,omitempty"`
+	Warnings      []string               `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"` // schema features that were not checked
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`       // the schema could not be used
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HttpStatusSearchResponse) Reset() {
-	*x = HttpStatusSearchResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[124]
+func (x *XmlValidateResponse) Reset() {
+	*x = XmlValidateResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HttpStatusSearchResponse) String() string {
+func (x *XmlValidateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HttpStatusSearchResponse) ProtoMessage() {}
+func (*XmlValidateResponse) ProtoMessage() {}
 
-func (x *HttpStatusSearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[124]
+func (x *XmlValidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8024,40 +13295,72 @@ func (x *HttpStatusSearchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HttpStatusSearchResponse.ProtoReflect.Descriptor instead.
-func (*HttpStatusSearchResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{124}
+// Deprecated: Use XmlValidateResponse.ProtoReflect.Descriptor instead.
+func (*XmlValidateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{183}
 }
 
-func (x *HttpStatusSearchResponse) GetEntries() []*HttpStatusEntry {
+func (x *XmlValidateResponse) GetValid() bool {
 	if x != nil {
-		return x.Entries
+		return x.Valid
+	}
+	return false
+}
+
+func (x *XmlValidateResponse) GetRoots() []string {
+	if x != nil {
+		return x.Roots
 	}
 	return nil
 }
 
-type MimeLookupRequest struct {
+func (x *XmlValidateResponse) GetIssues() []*XmlIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *XmlValidateResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *XmlValidateResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ProtobufDecodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"` // extension (with or without dot) or MIME type or partial
+	Data          string                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`                                  // wire bytes as base64 or hex; protojson when encode is set
+	Encoding      string                 `protobuf:"bytes,2,opt,name=encoding,proto3" json:"encoding,omitempty"`                          // "base64", "hex" or empty to detect
+	Schema        string                 `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`                              // optional .proto source, or a base64/hex FileDescriptorSet
+	MessageType   string                 `protobuf:"bytes,4,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"` // full or trailing name; may be empty when the schema has one message
+	Encode        bool                   `protobuf:"varint,5,opt,name=encode,proto3" json:"encode,omitempty"`                             // convert protojson in data to binary instead of decoding
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MimeLookupRequest) Reset() {
-	*x = MimeLookupRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[125]
+func (x *ProtobufDecodeRequest) Reset() {
+	*x = ProtobufDecodeRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MimeLookupRequest) String() string {
+func (x *ProtobufDecodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MimeLookupRequest) ProtoMessage() {}
+func (*ProtobufDecodeRequest) ProtoMessage() {}
 
-func (x *MimeLookupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[125]
+func (x *ProtobufDecodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8068,43 +13371,76 @@ func (x *MimeLookupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MimeLookupRequest.ProtoReflect.Descriptor instead.
-func (*MimeLookupRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{125}
+// Deprecated: Use ProtobufDecodeRequest.ProtoReflect.Descriptor instead.
+func (*ProtobufDecodeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{184}
 }
 
-func (x *MimeLookupRequest) GetQuery() string {
+func (x *ProtobufDecodeRequest) GetData() string {
 	if x != nil {
-		return x.Query
+		return x.Data
 	}
 	return ""
 }
 
-type MimeEntry struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MimeType      string                 `protobuf:"bytes,1,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
-	Extensions    string                 `protobuf:"bytes,2,opt,name=extensions,proto3" json:"extensions,omitempty"` // comma-separated
-	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ProtobufDecodeRequest) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
 }
 
-func (x *MimeEntry) Reset() {
-	*x = MimeEntry{}
-	mi := &file_proto_privutil_proto_msgTypes[126]
+func (x *ProtobufDecodeRequest) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+func (x *ProtobufDecodeRequest) GetMessageType() string {
+	if x != nil {
+		return x.MessageType
+	}
+	return ""
+}
+
+func (x *ProtobufDecodeRequest) GetEncode() bool {
+	if x != nil {
+		return x.Encode
+	}
+	return false
+}
+
+type ProtobufField struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Path            string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"` // field numbers from the root, e.g. "3.1"
+	Depth           int32                  `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	Number          int32                  `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+	WireType        string                 `protobuf:"bytes,4,opt,name=wire_type,json=wireType,proto3" json:"wire_type,omitempty"` // VARINT, I64, LEN, SGROUP or I32
+	Offset          int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`                    // byte offset of the tag in the payload
+	Length          int32                  `protobuf:"varint,6,opt,name=length,proto3" json:"length,omitempty"`                    // bytes including the tag
+	Kind            string                 `protobuf:"bytes,7,opt,name=kind,proto3" json:"kind,omitempty"`                         // chosen reading: varint, fixed64, fixed32, message, group, string or bytes
+	Value           string                 `protobuf:"bytes,8,opt,name=value,proto3" json:"value,omitempty"`
+	Interpretations []string               `protobuf:"bytes,9,rep,name=interpretations,proto3" json:"interpretations,omitempty"` // other plausible readings
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ProtobufField) Reset() {
+	*x = ProtobufField{}
+	mi := &file_proto_privutil_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MimeEntry) String() string {
+func (x *ProtobufField) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MimeEntry) ProtoMessage() {}
+func (*ProtobufField) ProtoMessage() {}
 
-func (x *MimeEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[126]
+func (x *ProtobufField) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8115,62 +13451,102 @@ func (x *MimeEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MimeEntry.ProtoReflect.Descriptor instead.
-func (*MimeEntry) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{126}
+// Deprecated: Use ProtobufField.ProtoReflect.Descriptor instead.
+func (*ProtobufField) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{185}
 }
 
-func (x *MimeEntry) GetMimeType() string {
+func (x *ProtobufField) GetPath() string {
 	if x != nil {
-		return x.MimeType
+		return x.Path
 	}
 	return ""
 }
 
-func (x *MimeEntry) GetExtensions() string {
+func (x *ProtobufField) GetDepth() int32 {
 	if x != nil {
-		return x.Extensions
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *ProtobufField) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *ProtobufField) GetWireType() string {
+	if x != nil {
+		return x.WireType
 	}
 	return ""
 }
 
-func (x *MimeEntry) GetCategory() string {
+func (x *ProtobufField) GetOffset() int32 {
 	if x != nil {
-		return x.Category
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ProtobufField) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *ProtobufField) GetKind() string {
+	if x != nil {
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *MimeEntry) GetDescription() string {
+func (x *ProtobufField) GetValue() string {
 	if x != nil {
-		return x.Description
+		return x.Value
 	}
 	return ""
 }
 
-type MimeLookupResponse struct {
+func (x *ProtobufField) GetInterpretations() []string {
+	if x != nil {
+		return x.Interpretations
+	}
+	return nil
+}
+
+type ProtobufDecodeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Entries       []*MimeEntry           `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Json          string                 `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`     // protojson with a schema, a field-number tree without one
+	Base64        string                 `protobuf:"bytes,2,opt,name=base64,proto3" json:"base64,omitempty"` // the payload that was decoded, or the encoded message
+	Hex           string                 `protobuf:"bytes,3,opt,name=hex,proto3" json:"hex,omitempty"`
+	Fields        []*ProtobufField       `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`                                 // schemaless decoding, depth first
+	MessageTypes  []string               `protobuf:"bytes,5,rep,name=message_types,json=messageTypes,proto3" json:"message_types,omitempty"` // message types declared by the schema
+	Warnings      []string               `protobuf:"bytes,6,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MimeLookupResponse) Reset() {
-	*x = MimeLookupResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[127]
+func (x *ProtobufDecodeResponse) Reset() {
+	*x = ProtobufDecodeResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MimeLookupResponse) String() string {
+func (x *ProtobufDecodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MimeLookupResponse) ProtoMessage() {}
+func (*ProtobufDecodeResponse) ProtoMessage() {}
 
-func (x *MimeLookupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[127]
+func (x *ProtobufDecodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8181,47 +13557,83 @@ func (x *MimeLookupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MimeLookupResponse.ProtoReflect.Descriptor instead.
-func (*MimeLookupResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{127}
+// Deprecated: Use ProtobufDecodeResponse.ProtoReflect.Descriptor instead.
+func (*ProtobufDecodeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *ProtobufDecodeResponse) GetJson() string {
+	if x != nil {
+		return x.Json
+	}
+	return ""
+}
+
+func (x *ProtobufDecodeResponse) GetBase64() string {
+	if x != nil {
+		return x.Base64
+	}
+	return ""
+}
+
+func (x *ProtobufDecodeResponse) GetHex() string {
+	if x != nil {
+		return x.Hex
+	}
+	return ""
+}
+
+func (x *ProtobufDecodeResponse) GetFields() []*ProtobufField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ProtobufDecodeResponse) GetMessageTypes() []string {
+	if x != nil {
+		return x.MessageTypes
+	}
+	return nil
 }
 
-func (x *MimeLookupResponse) GetEntries() []*MimeEntry {
+func (x *ProtobufDecodeResponse) GetWarnings() []string {
 	if x != nil {
-		return x.Entries
+		return x.Warnings
 	}
 	return nil
 }
 
-func (x *MimeLookupResponse) GetError() string {
+func (x *ProtobufDecodeResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type DockerRunToComposeRequest struct {
+type ColorContrastRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Command       string                 `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"` // full docker run command string
+	Foreground    string                 `protobuf:"bytes,1,opt,name=foreground,proto3" json:"foreground,omitempty"` // any notation ColorConvert accepts
+	Background    string                 `protobuf:"bytes,2,opt,name=background,proto3" json:"background,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DockerRunToComposeRequest) Reset() {
-	*x = DockerRunToComposeRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[128]
+func (x *ColorContrastRequest) Reset() {
+	*x = ColorContrastRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DockerRunToComposeRequest) String() string {
+func (x *ColorContrastRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DockerRunToComposeRequest) ProtoMessage() {}
+func (*ColorContrastRequest) ProtoMessage() {}
 
-func (x *DockerRunToComposeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[128]
+func (x *ColorContrastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8232,44 +13644,56 @@ func (x *DockerRunToComposeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DockerRunToComposeRequest.ProtoReflect.Descriptor instead.
-func (*DockerRunToComposeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{128}
+// Deprecated: Use ColorContrastRequest.ProtoReflect.Descriptor instead.
+func (*ColorContrastRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{187}
 }
 
-func (x *DockerRunToComposeRequest) GetCommand() string {
+func (x *ColorContrastRequest) GetForeground() string {
 	if x != nil {
-		return x.Command
+		return x.Foreground
 	}
 	return ""
 }
 
-type DockerRunToComposeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ComposeYaml   string                 `protobuf:"bytes,1,opt,name=compose_yaml,json=composeYaml,proto3" json:"compose_yaml,omitempty"`
-	Warnings      []string               `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
-	ServiceName   string                 `protobuf:"bytes,3,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
-	Image         string                 `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`
-	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ColorContrastRequest) GetBackground() string {
+	if x != nil {
+		return x.Background
+	}
+	return ""
 }
 
-func (x *DockerRunToComposeResponse) Reset() {
-	*x = DockerRunToComposeResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[129]
+type ColorContrastResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Ratio              float64                `protobuf:"fixed64,1,opt,name=ratio,proto3" json:"ratio,omitempty"`                                 // WCAG 2.x contrast ratio, 1 to 21
+	Aa                 bool                   `protobuf:"varint,2,opt,name=aa,proto3" json:"aa,omitempty"`                                        // normal text, 4.5:1
+	AaLarge            bool                   `protobuf:"varint,3,opt,name=aa_large,json=aaLarge,proto3" json:"aa_large,omitempty"`               // large text and UI components, 3:1
+	Aaa                bool                   `protobuf:"varint,4,opt,name=aaa,proto3" json:"aaa,omitempty"`                                      // normal text, 7:1
+	AaaLarge           bool                   `protobuf:"varint,5,opt,name=aaa_large,json=aaaLarge,proto3" json:"aaa_large,omitempty"`            // large text, 4.5:1
+	SuggestionAa       string                 `protobuf:"bytes,6,opt,name=suggestion_aa,json=suggestionAa,proto3" json:"suggestion_aa,omitempty"` // nearest foreground shade passing AA; empty when it passes or none can
+	SuggestionAaRatio  float64                `protobuf:"fixed64,7,opt,name=suggestion_aa_ratio,json=suggestionAaRatio,proto3" json:"suggestion_aa_ratio,omitempty"`
+	SuggestionAaa      string                 `protobuf:"bytes,8,opt,name=suggestion_aaa,json=suggestionAaa,proto3" json:"suggestion_aaa,omitempty"` // nearest foreground shade passing AAA
+	SuggestionAaaRatio float64                `protobuf:"fixed64,9,opt,name=suggestion_aaa_ratio,json=suggestionAaaRatio,proto3" json:"suggestion_aaa_ratio,omitempty"`
+	Error              string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ColorContrastResponse) Reset() {
+	*x = ColorContrastResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DockerRunToComposeResponse) String() string {
+func (x *ColorContrastResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DockerRunToComposeResponse) ProtoMessage() {}
+func (*ColorContrastResponse) ProtoMessage() {}
 
-func (x *DockerRunToComposeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[129]
+func (x *ColorContrastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8280,122 +13704,106 @@ func (x *DockerRunToComposeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DockerRunToComposeResponse.ProtoReflect.Descriptor instead.
-func (*DockerRunToComposeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{129}
+// Deprecated: Use ColorContrastResponse.ProtoReflect.Descriptor instead.
+func (*ColorContrastResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{188}
 }
 
-func (x *DockerRunToComposeResponse) GetComposeYaml() string {
+func (x *ColorContrastResponse) GetRatio() float64 {
 	if x != nil {
-		return x.ComposeYaml
+		return x.Ratio
 	}
-	return ""
+	return 0
 }
 
-func (x *DockerRunToComposeResponse) GetWarnings() []string {
+func (x *ColorContrastResponse) GetAa() bool {
 	if x != nil {
-		return x.Warnings
+		return x.Aa
 	}
-	return nil
+	return false
 }
 
-func (x *DockerRunToComposeResponse) GetServiceName() string {
+func (x *ColorContrastResponse) GetAaLarge() bool {
 	if x != nil {
-		return x.ServiceName
+		return x.AaLarge
 	}
-	return ""
+	return false
 }
 
-func (x *DockerRunToComposeResponse) GetImage() string {
+func (x *ColorContrastResponse) GetAaa() bool {
 	if x != nil {
-		return x.Image
+		return x.Aaa
 	}
-	return ""
+	return false
 }
 
-func (x *DockerRunToComposeResponse) GetError() string {
+func (x *ColorContrastResponse) GetAaaLarge() bool {
 	if x != nil {
-		return x.Error
+		return x.AaaLarge
 	}
-	return ""
-}
-
-type GitCheatSheetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`       // search term (empty = all)
-	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"` // filter by category name (empty = all)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GitCheatSheetRequest) Reset() {
-	*x = GitCheatSheetRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[130]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return false
 }
 
-func (x *GitCheatSheetRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ColorContrastResponse) GetSuggestionAa() string {
+	if x != nil {
+		return x.SuggestionAa
+	}
+	return ""
 }
 
-func (*GitCheatSheetRequest) ProtoMessage() {}
-
-func (x *GitCheatSheetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[130]
+func (x *ColorContrastResponse) GetSuggestionAaRatio() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SuggestionAaRatio
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GitCheatSheetRequest.ProtoReflect.Descriptor instead.
-func (*GitCheatSheetRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{130}
+func (x *ColorContrastResponse) GetSuggestionAaa() string {
+	if x != nil {
+		return x.SuggestionAaa
+	}
+	return ""
 }
 
-func (x *GitCheatSheetRequest) GetQuery() string {
+func (x *ColorContrastResponse) GetSuggestionAaaRatio() float64 {
 	if x != nil {
-		return x.Query
+		return x.SuggestionAaaRatio
 	}
-	return ""
+	return 0
 }
 
-func (x *GitCheatSheetRequest) GetCategory() string {
+func (x *ColorContrastResponse) GetError() string {
 	if x != nil {
-		return x.Category
+		return x.Error
 	}
 	return ""
 }
 
-type GitCmd struct {
+type ColorPaletteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Command       string                 `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Examples      []string               `protobuf:"bytes,3,rep,name=examples,proto3" json:"examples,omitempty"`
+	Color         string                 `protobuf:"bytes,1,opt,name=color,proto3" json:"color,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`    // tints, shades, complementary, analogous, triadic, split-complementary, tetradic, gradient or scale
+	Steps         int32                  `protobuf:"varint,3,opt,name=steps,proto3" json:"steps,omitempty"` // tints, shades and gradient; default 5
+	End           string                 `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`      // gradient end color
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GitCmd) Reset() {
-	*x = GitCmd{}
-	mi := &file_proto_privutil_proto_msgTypes[131]
+func (x *ColorPaletteRequest) Reset() {
+	*x = ColorPaletteRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GitCmd) String() string {
+func (x *ColorPaletteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitCmd) ProtoMessage() {}
+func (*ColorPaletteRequest) ProtoMessage() {}
 
-func (x *GitCmd) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[131]
+func (x *ColorPaletteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8406,55 +13814,64 @@ func (x *GitCmd) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitCmd.ProtoReflect.Descriptor instead.
-func (*GitCmd) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{131}
+// Deprecated: Use ColorPaletteRequest.ProtoReflect.Descriptor instead.
+func (*ColorPaletteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{189}
 }
 
-func (x *GitCmd) GetCommand() string {
+func (x *ColorPaletteRequest) GetColor() string {
 	if x != nil {
-		return x.Command
+		return x.Color
 	}
 	return ""
 }
 
-func (x *GitCmd) GetDescription() string {
+func (x *ColorPaletteRequest) GetKind() string {
 	if x != nil {
-		return x.Description
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *GitCmd) GetExamples() []string {
+func (x *ColorPaletteRequest) GetSteps() int32 {
 	if x != nil {
-		return x.Examples
+		return x.Steps
 	}
-	return nil
+	return 0
 }
 
-type GitCmdCategory struct {
+func (x *ColorPaletteRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+type PaletteColor struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Commands      []*GitCmd              `protobuf:"bytes,2,rep,name=commands,proto3" json:"commands,omitempty"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"` // "base", "tint 20%", "+120°", "500"…
+	Hex           string                 `protobuf:"bytes,2,opt,name=hex,proto3" json:"hex,omitempty"`
+	Rgb           string                 `protobuf:"bytes,3,opt,name=rgb,proto3" json:"rgb,omitempty"`
+	Oklch         string                 `protobuf:"bytes,4,opt,name=oklch,proto3" json:"oklch,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GitCmdCategory) Reset() {
-	*x = GitCmdCategory{}
-	mi := &file_proto_privutil_proto_msgTypes[132]
+func (x *PaletteColor) Reset() {
+	*x = PaletteColor{}
+	mi := &file_proto_privutil_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GitCmdCategory) String() string {
+func (x *PaletteColor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitCmdCategory) ProtoMessage() {}
+func (*PaletteColor) ProtoMessage() {}
 
-func (x *GitCmdCategory) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[132]
+func (x *PaletteColor) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8465,47 +13882,62 @@ func (x *GitCmdCategory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitCmdCategory.ProtoReflect.Descriptor instead.
-func (*GitCmdCategory) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{132}
+// Deprecated: Use PaletteColor.ProtoReflect.Descriptor instead.
+func (*PaletteColor) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{190}
 }
 
-func (x *GitCmdCategory) GetName() string {
+func (x *PaletteColor) GetLabel() string {
 	if x != nil {
-		return x.Name
+		return x.Label
 	}
 	return ""
 }
 
-func (x *GitCmdCategory) GetCommands() []*GitCmd {
+func (x *PaletteColor) GetHex() string {
 	if x != nil {
-		return x.Commands
+		return x.Hex
 	}
-	return nil
+	return ""
 }
 
-type GitCheatSheetResponse struct {
+func (x *PaletteColor) GetRgb() string {
+	if x != nil {
+		return x.Rgb
+	}
+	return ""
+}
+
+func (x *PaletteColor) GetOklch() string {
+	if x != nil {
+		return x.Oklch
+	}
+	return ""
+}
+
+type ColorPaletteResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Categories    []*GitCmdCategory      `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	Colors        []*PaletteColor        `protobuf:"bytes,1,rep,name=colors,proto3" json:"colors,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GitCheatSheetResponse) Reset() {
-	*x = GitCheatSheetResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[133]
+func (x *ColorPaletteResponse) Reset() {
+	*x = ColorPaletteResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GitCheatSheetResponse) String() string {
+func (x *ColorPaletteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GitCheatSheetResponse) ProtoMessage() {}
+func (*ColorPaletteResponse) ProtoMessage() {}
 
-func (x *GitCheatSheetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[133]
+func (x *ColorPaletteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8516,51 +13948,49 @@ func (x *GitCheatSheetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GitCheatSheetResponse.ProtoReflect.Descriptor instead.
-func (*GitCheatSheetResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{133}
+// Deprecated: Use ColorPaletteResponse.ProtoReflect.Descriptor instead.
+func (*ColorPaletteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{191}
 }
 
-func (x *GitCheatSheetResponse) GetCategories() []*GitCmdCategory {
+func (x *ColorPaletteResponse) GetColors() []*PaletteColor {
 	if x != nil {
-		return x.Categories
+		return x.Colors
 	}
 	return nil
 }
 
-type SvgOptimizeRequest struct {
-	state  protoimpl.MessageState `protogen:"open.v1"`
-	Svg    string                 `protobuf:"bytes,1,opt,name=svg,proto3" json:"svg,omitempty"`
-	Preset string                 `protobuf:"bytes,2,opt,name=preset,proto3" json:"preset,omitempty"` // "safe" (default), "aggressive", "minimal", "custom"
-	// Only used when preset = "custom":
-	RemoveComments     bool `protobuf:"varint,3,opt,name=remove_comments,json=removeComments,proto3" json:"remove_comments,omitempty"`
-	RemoveXmlDecl      bool `protobuf:"varint,4,opt,name=remove_xml_decl,json=removeXmlDecl,proto3" json:"remove_xml_decl,omitempty"`
-	RemoveDoctype      bool `protobuf:"varint,5,opt,name=remove_doctype,json=removeDoctype,proto3" json:"remove_doctype,omitempty"`
-	RemoveMetadata     bool `protobuf:"varint,6,opt,name=remove_metadata,json=removeMetadata,proto3" json:"remove_metadata,omitempty"`
-	RemoveTitle        bool `protobuf:"varint,7,opt,name=remove_title,json=removeTitle,proto3" json:"remove_title,omitempty"`
-	RemoveDesc         bool `protobuf:"varint,8,opt,name=remove_desc,json=removeDesc,proto3" json:"remove_desc,omitempty"`
-	RemoveEmptyGroups  bool `protobuf:"varint,9,opt,name=remove_empty_groups,json=removeEmptyGroups,proto3" json:"remove_empty_groups,omitempty"`
-	CollapseWhitespace bool `protobuf:"varint,10,opt,name=collapse_whitespace,json=collapseWhitespace,proto3" json:"collapse_whitespace,omitempty"`
-	RemoveEmptyAttrs   bool `protobuf:"varint,11,opt,name=remove_empty_attrs,json=removeEmptyAttrs,proto3" json:"remove_empty_attrs,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *ColorPaletteResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-func (x *SvgOptimizeRequest) Reset() {
-	*x = SvgOptimizeRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[134]
+type ColorBlindnessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Colors        []string               `protobuf:"bytes,1,rep,name=colors,proto3" json:"colors,omitempty"`         // any notation ColorConvert accepts
+	Threshold     float64                `protobuf:"fixed64,2,opt,name=threshold,proto3" json:"threshold,omitempty"` // CIEDE2000 below which two colors count as indistinguishable; default 10
+	Severity      float64                `protobuf:"fixed64,3,opt,name=severity,proto3" json:"severity,omitempty"`   // 0 to 1; default 1 (full dichromacy)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ColorBlindnessRequest) Reset() {
+	*x = ColorBlindnessRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SvgOptimizeRequest) String() string {
+func (x *ColorBlindnessRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SvgOptimizeRequest) ProtoMessage() {}
+func (*ColorBlindnessRequest) ProtoMessage() {}
 
-func (x *SvgOptimizeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[134]
+func (x *ColorBlindnessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8571,115 +14001,111 @@ func (x *SvgOptimizeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SvgOptimizeRequest.ProtoReflect.Descriptor instead.
-func (*SvgOptimizeRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{134}
-}
-
-func (x *SvgOptimizeRequest) GetSvg() string {
-	if x != nil {
-		return x.Svg
-	}
-	return ""
-}
-
-func (x *SvgOptimizeRequest) GetPreset() string {
-	if x != nil {
-		return x.Preset
-	}
-	return ""
-}
-
-func (x *SvgOptimizeRequest) GetRemoveComments() bool {
-	if x != nil {
-		return x.RemoveComments
-	}
-	return false
-}
-
-func (x *SvgOptimizeRequest) GetRemoveXmlDecl() bool {
-	if x != nil {
-		return x.RemoveXmlDecl
-	}
-	return false
-}
-
-func (x *SvgOptimizeRequest) GetRemoveDoctype() bool {
-	if x != nil {
-		return x.RemoveDoctype
-	}
-	return false
+// Deprecated: Use ColorBlindnessRequest.ProtoReflect.Descriptor instead.
+func (*ColorBlindnessRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{192}
 }
 
-func (x *SvgOptimizeRequest) GetRemoveMetadata() bool {
+func (x *ColorBlindnessRequest) GetColors() []string {
 	if x != nil {
-		return x.RemoveMetadata
+		return x.Colors
 	}
-	return false
+	return nil
 }
 
-func (x *SvgOptimizeRequest) GetRemoveTitle() bool {
+func (x *ColorBlindnessRequest) GetThreshold() float64 {
 	if x != nil {
-		return x.RemoveTitle
+		return x.Threshold
 	}
-	return false
+	return 0
 }
 
-func (x *SvgOptimizeRequest) GetRemoveDesc() bool {
+func (x *ColorBlindnessRequest) GetSeverity() float64 {
 	if x != nil {
-		return x.RemoveDesc
+		return x.Severity
 	}
-	return false
+	return 0
 }
 
-func (x *SvgOptimizeRequest) GetRemoveEmptyGroups() bool {
+type CvdSimulation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deficiency    string                 `protobuf:"bytes,1,opt,name=deficiency,proto3" json:"deficiency,omitempty"` // normal, protanopia, deuteranopia, tritanopia or achromatopsia
+	Colors        []string               `protobuf:"bytes,2,rep,name=colors,proto3" json:"colors,omitempty"`         // hex, in request order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CvdSimulation) Reset() {
+	*x = CvdSimulation{}
+	mi := &file_proto_privutil_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CvdSimulation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CvdSimulation) ProtoMessage() {}
+
+func (x *CvdSimulation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[193]
 	if x != nil {
-		return x.RemoveEmptyGroups
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *SvgOptimizeRequest) GetCollapseWhitespace() bool {
+// Deprecated: Use CvdSimulation.ProtoReflect.Descriptor instead.
+func (*CvdSimulation) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *CvdSimulation) GetDeficiency() string {
 	if x != nil {
-		return x.CollapseWhitespace
+		return x.Deficiency
 	}
-	return false
+	return ""
 }
 
-func (x *SvgOptimizeRequest) GetRemoveEmptyAttrs() bool {
+func (x *CvdSimulation) GetColors() []string {
 	if x != nil {
-		return x.RemoveEmptyAttrs
+		return x.Colors
 	}
-	return false
+	return nil
 }
 
-type SvgOptimizeResponse struct {
+type CvdConflict struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	OriginalSize  int32                  `protobuf:"varint,2,opt,name=original_size,json=originalSize,proto3" json:"original_size,omitempty"`
-	OptimizedSize int32                  `protobuf:"varint,3,opt,name=optimized_size,json=optimizedSize,proto3" json:"optimized_size,omitempty"`
-	SavingsPct    float32                `protobuf:"fixed32,4,opt,name=savings_pct,json=savingsPct,proto3" json:"savings_pct,omitempty"`
-	Applied       []string               `protobuf:"bytes,5,rep,name=applied,proto3" json:"applied,omitempty"`
-	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	Deficiency    string                 `protobuf:"bytes,1,opt,name=deficiency,proto3" json:"deficiency,omitempty"`
+	IndexA        int32                  `protobuf:"varint,2,opt,name=index_a,json=indexA,proto3" json:"index_a,omitempty"` // 0-based positions in the request
+	IndexB        int32                  `protobuf:"varint,3,opt,name=index_b,json=indexB,proto3" json:"index_b,omitempty"`
+	ColorA        string                 `protobuf:"bytes,4,opt,name=color_a,json=colorA,proto3" json:"color_a,omitempty"` // original hex
+	ColorB        string                 `protobuf:"bytes,5,opt,name=color_b,json=colorB,proto3" json:"color_b,omitempty"`
+	DeltaE        float64                `protobuf:"fixed64,6,opt,name=delta_e,json=deltaE,proto3" json:"delta_e,omitempty"` // CIEDE2000 between the simulated colors
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SvgOptimizeResponse) Reset() {
-	*x = SvgOptimizeResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[135]
+func (x *CvdConflict) Reset() {
+	*x = CvdConflict{}
+	mi := &file_proto_privutil_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SvgOptimizeResponse) String() string {
+func (x *CvdConflict) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SvgOptimizeResponse) ProtoMessage() {}
+func (*CvdConflict) ProtoMessage() {}
 
-func (x *SvgOptimizeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[135]
+func (x *CvdConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[194]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8690,76 +14116,77 @@ func (x *SvgOptimizeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SvgOptimizeResponse.ProtoReflect.Descriptor instead.
-func (*SvgOptimizeResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{135}
+// Deprecated: Use CvdConflict.ProtoReflect.Descriptor instead.
+func (*CvdConflict) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{194}
 }
 
-func (x *SvgOptimizeResponse) GetResult() string {
+func (x *CvdConflict) GetDeficiency() string {
 	if x != nil {
-		return x.Result
+		return x.Deficiency
 	}
 	return ""
 }
 
-func (x *SvgOptimizeResponse) GetOriginalSize() int32 {
+func (x *CvdConflict) GetIndexA() int32 {
 	if x != nil {
-		return x.OriginalSize
+		return x.IndexA
 	}
 	return 0
 }
 
-func (x *SvgOptimizeResponse) GetOptimizedSize() int32 {
+func (x *CvdConflict) GetIndexB() int32 {
 	if x != nil {
-		return x.OptimizedSize
+		return x.IndexB
 	}
 	return 0
 }
 
-func (x *SvgOptimizeResponse) GetSavingsPct() float32 {
+func (x *CvdConflict) GetColorA() string {
 	if x != nil {
-		return x.SavingsPct
+		return x.ColorA
 	}
-	return 0
+	return ""
 }
 
-func (x *SvgOptimizeResponse) GetApplied() []string {
+func (x *CvdConflict) GetColorB() string {
 	if x != nil {
-		return x.Applied
+		return x.ColorB
 	}
-	return nil
+	return ""
 }
 
-func (x *SvgOptimizeResponse) GetError() string {
+func (x *CvdConflict) GetDeltaE() float64 {
 	if x != nil {
-		return x.Error
+		return x.DeltaE
 	}
-	return ""
+	return 0
 }
 
-type ExifReadRequest struct {
+type ColorBlindnessResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Simulations   []*CvdSimulation       `protobuf:"bytes,1,rep,name=simulations,proto3" json:"simulations,omitempty"`
+	Conflicts     []*CvdConflict         `protobuf:"bytes,2,rep,name=conflicts,proto3" json:"conflicts,omitempty"` // closest pairs first within each deficiency
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExifReadRequest) Reset() {
-	*x = ExifReadRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[136]
+func (x *ColorBlindnessResponse) Reset() {
+	*x = ColorBlindnessResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExifReadRequest) String() string {
+func (x *ColorBlindnessResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExifReadRequest) ProtoMessage() {}
+func (*ColorBlindnessResponse) ProtoMessage() {}
 
-func (x *ExifReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[136]
+func (x *ColorBlindnessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8770,49 +14197,58 @@ func (x *ExifReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExifReadRequest.ProtoReflect.Descriptor instead.
-func (*ExifReadRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{136}
+// Deprecated: Use ColorBlindnessResponse.ProtoReflect.Descriptor instead.
+func (*ColorBlindnessResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{195}
 }
 
-func (x *ExifReadRequest) GetData() []byte {
+func (x *ColorBlindnessResponse) GetSimulations() []*CvdSimulation {
 	if x != nil {
-		return x.Data
+		return x.Simulations
 	}
 	return nil
 }
 
-func (x *ExifReadRequest) GetFilename() string {
+func (x *ColorBlindnessResponse) GetConflicts() []*CvdConflict {
 	if x != nil {
-		return x.Filename
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *ColorBlindnessResponse) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-type ExifField struct {
+type TableQueryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
-	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	Group         string                 `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	Data          string                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Format        DataFormat             `protobuf:"varint,2,opt,name=format,proto3,enum=privutil.DataFormat" json:"format,omitempty"`                                 // format of data: an array of rows, or an object holding one
+	Query         string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`                                                             // SELECT ... WHERE ... GROUP BY ... HAVING ... ORDER BY ... LIMIT
+	OutputFormat  DataFormat             `protobuf:"varint,4,opt,name=output_format,json=outputFormat,proto3,enum=privutil.DataFormat" json:"output_format,omitempty"` // any DataFormat; the *_TABLE formats render a grid
+	CsvDelimiter  string                 `protobuf:"bytes,5,opt,name=csv_delimiter,json=csvDelimiter,proto3" json:"csv_delimiter,omitempty"`                           // applies to CSV input and output
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExifField) Reset() {
-	*x = ExifField{}
-	mi := &file_proto_privutil_proto_msgTypes[137]
+func (x *TableQueryRequest) Reset() {
+	*x = TableQueryRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExifField) String() string {
+func (x *TableQueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExifField) ProtoMessage() {}
+func (*TableQueryRequest) ProtoMessage() {}
 
-func (x *ExifField) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[137]
+func (x *TableQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8823,61 +14259,73 @@ func (x *ExifField) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExifField.ProtoReflect.Descriptor instead.
-func (*ExifField) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{137}
+// Deprecated: Use TableQueryRequest.ProtoReflect.Descriptor instead.
+func (*TableQueryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{196}
 }
 
-func (x *ExifField) GetLabel() string {
+func (x *TableQueryRequest) GetData() string {
 	if x != nil {
-		return x.Label
+		return x.Data
 	}
 	return ""
 }
 
-func (x *ExifField) GetValue() string {
+func (x *TableQueryRequest) GetFormat() DataFormat {
 	if x != nil {
-		return x.Value
+		return x.Format
+	}
+	return DataFormat_JSON
+}
+
+func (x *TableQueryRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
 	}
 	return ""
 }
 
-func (x *ExifField) GetGroup() string {
+func (x *TableQueryRequest) GetOutputFormat() DataFormat {
 	if x != nil {
-		return x.Group
+		return x.OutputFormat
+	}
+	return DataFormat_JSON
+}
+
+func (x *TableQueryRequest) GetCsvDelimiter() string {
+	if x != nil {
+		return x.CsvDelimiter
 	}
 	return ""
 }
 
-type ExifReadResponse struct {
+type TableQueryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
-	Width         int32                  `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
-	Height        int32                  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
-	GpsDecimal    string                 `protobuf:"bytes,4,opt,name=gps_decimal,json=gpsDecimal,proto3" json:"gps_decimal,omitempty"` // "lat, lng" in decimal degrees (empty if no GPS)
-	GpsDms        string                 `protobuf:"bytes,5,opt,name=gps_dms,json=gpsDms,proto3" json:"gps_dms,omitempty"`             // "48°51'30\"N, 2°17'40\"E" style
-	MapsUrl       string                 `protobuf:"bytes,6,opt,name=maps_url,json=mapsUrl,proto3" json:"maps_url,omitempty"`          // Google Maps link
-	Fields        []*ExifField           `protobuf:"bytes,7,rep,name=fields,proto3" json:"fields,omitempty"`
-	Error         string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Columns       []string               `protobuf:"bytes,2,rep,name=columns,proto3" json:"columns,omitempty"`
+	RowCount      int32                  `protobuf:"varint,3,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`    // rows in the result
+	InputRows     int32                  `protobuf:"varint,4,opt,name=input_rows,json=inputRows,proto3" json:"input_rows,omitempty"` // rows read from data
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorPosition int32                  `protobuf:"varint,6,opt,name=error_position,json=errorPosition,proto3" json:"error_position,omitempty"` // 1-based offset into the query; 0 when not applicable
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExifReadResponse) Reset() {
-	*x = ExifReadResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[138]
+func (x *TableQueryResponse) Reset() {
+	*x = TableQueryResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExifReadResponse) String() string {
+func (x *TableQueryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExifReadResponse) ProtoMessage() {}
+func (*TableQueryResponse) ProtoMessage() {}
 
-func (x *ExifReadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[138]
+func (x *TableQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8888,91 +14336,83 @@ func (x *ExifReadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExifReadResponse.ProtoReflect.Descriptor instead.
-func (*ExifReadResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{138}
+// Deprecated: Use TableQueryResponse.ProtoReflect.Descriptor instead.
+func (*TableQueryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{197}
 }
 
-func (x *ExifReadResponse) GetFormat() string {
+func (x *TableQueryResponse) GetResult() string {
 	if x != nil {
-		return x.Format
+		return x.Result
 	}
 	return ""
 }
 
-func (x *ExifReadResponse) GetWidth() int32 {
+func (x *TableQueryResponse) GetColumns() []string {
 	if x != nil {
-		return x.Width
+		return x.Columns
 	}
-	return 0
+	return nil
 }
 
-func (x *ExifReadResponse) GetHeight() int32 {
+func (x *TableQueryResponse) GetRowCount() int32 {
 	if x != nil {
-		return x.Height
+		return x.RowCount
 	}
 	return 0
 }
 
-func (x *ExifReadResponse) GetGpsDecimal() string {
-	if x != nil {
-		return x.GpsDecimal
-	}
-	return ""
-}
-
-func (x *ExifReadResponse) GetGpsDms() string {
+func (x *TableQueryResponse) GetInputRows() int32 {
 	if x != nil {
-		return x.GpsDms
+		return x.InputRows
 	}
-	return ""
+	return 0
 }
 
-func (x *ExifReadResponse) GetMapsUrl() string {
+func (x *TableQueryResponse) GetError() string {
 	if x != nil {
-		return x.MapsUrl
+		return x.Error
 	}
 	return ""
 }
 
-func (x *ExifReadResponse) GetFields() []*ExifField {
-	if x != nil {
-		return x.Fields
-	}
-	return nil
-}
-
-func (x *ExifReadResponse) GetError() string {
+func (x *TableQueryResponse) GetErrorPosition() int32 {
 	if x != nil {
-		return x.Error
+		return x.ErrorPosition
 	}
-	return ""
+	return 0
 }
 
-type FileToBase64Request struct {
+type Merge3Request struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
-	WrapUri       bool                   `protobuf:"varint,3,opt,name=wrap_uri,json=wrapUri,proto3" json:"wrap_uri,omitempty"` // prefix result with "data:<mime>;base64,"
+	Base          string                 `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Mine          string                 `protobuf:"bytes,2,opt,name=mine,proto3" json:"mine,omitempty"`
+	Theirs        string                 `protobuf:"bytes,3,opt,name=theirs,proto3" json:"theirs,omitempty"`
+	Mode          MergeMode              `protobuf:"varint,4,opt,name=mode,proto3,enum=privutil.MergeMode" json:"mode,omitempty"`
+	Format        DataFormat             `protobuf:"varint,5,opt,name=format,proto3,enum=privutil.DataFormat" json:"format,omitempty"`  // structured mode: format of all three documents and of the result
+	Diff3Style    bool                   `protobuf:"varint,6,opt,name=diff3_style,json=diff3Style,proto3" json:"diff3_style,omitempty"` // lines mode: show the base between ||||||| and =======
+	MineLabel     string                 `protobuf:"bytes,7,opt,name=mine_label,json=mineLabel,proto3" json:"mine_label,omitempty"`     // marker labels; default to "mine", "base" and "theirs"
+	BaseLabel     string                 `protobuf:"bytes,8,opt,name=base_label,json=baseLabel,proto3" json:"base_label,omitempty"`
+	TheirsLabel   string                 `protobuf:"bytes,9,opt,name=theirs_label,json=theirsLabel,proto3" json:"theirs_label,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FileToBase64Request) Reset() {
-	*x = FileToBase64Request{}
-	mi := &file_proto_privutil_proto_msgTypes[139]
+func (x *Merge3Request) Reset() {
+	*x = Merge3Request{}
+	mi := &file_proto_privutil_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FileToBase64Request) String() string {
+func (x *Merge3Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileToBase64Request) ProtoMessage() {}
+func (*Merge3Request) ProtoMessage() {}
 
-func (x *FileToBase64Request) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[139]
+func (x *Merge3Request) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8983,131 +14423,102 @@ func (x *FileToBase64Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileToBase64Request.ProtoReflect.Descriptor instead.
-func (*FileToBase64Request) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{139}
-}
-
-func (x *FileToBase64Request) GetData() []byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
+// Deprecated: Use Merge3Request.ProtoReflect.Descriptor instead.
+func (*Merge3Request) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{198}
 }
 
-func (x *FileToBase64Request) GetFilename() string {
+func (x *Merge3Request) GetBase() string {
 	if x != nil {
-		return x.Filename
+		return x.Base
 	}
 	return ""
 }
 
-func (x *FileToBase64Request) GetWrapUri() bool {
+func (x *Merge3Request) GetMine() string {
 	if x != nil {
-		return x.WrapUri
+		return x.Mine
 	}
-	return false
-}
-
-type FileToBase64Response struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Encoded       string                 `protobuf:"bytes,1,opt,name=encoded,proto3" json:"encoded,omitempty"`                // raw base64 (no prefix)
-	DataUri       string                 `protobuf:"bytes,2,opt,name=data_uri,json=dataUri,proto3" json:"data_uri,omitempty"` // full data URI
-	MimeType      string                 `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
-	Size          int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
-	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *FileToBase64Response) Reset() {
-	*x = FileToBase64Response{}
-	mi := &file_proto_privutil_proto_msgTypes[140]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *FileToBase64Response) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*FileToBase64Response) ProtoMessage() {}
-
-func (x *FileToBase64Response) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[140]
+func (x *Merge3Request) GetTheirs() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Theirs
 	}
-	return mi.MessageOf(x)
+	return ""
 }
-
-// Deprecated: Use FileToBase64Response.ProtoReflect.Descriptor instead.
-func (*FileToBase64Response) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{140}
+
+func (x *Merge3Request) GetMode() MergeMode {
+	if x != nil {
+		return x.Mode
+	}
+	return MergeMode_MERGE_LINES
 }
 
-func (x *FileToBase64Response) GetEncoded() string {
+func (x *Merge3Request) GetFormat() DataFormat {
 	if x != nil {
-		return x.Encoded
+		return x.Format
 	}
-	return ""
+	return DataFormat_JSON
 }
 
-func (x *FileToBase64Response) GetDataUri() string {
+func (x *Merge3Request) GetDiff3Style() bool {
 	if x != nil {
-		return x.DataUri
+		return x.Diff3Style
 	}
-	return ""
+	return false
 }
 
-func (x *FileToBase64Response) GetMimeType() string {
+func (x *Merge3Request) GetMineLabel() string {
 	if x != nil {
-		return x.MimeType
+		return x.MineLabel
 	}
 	return ""
 }
 
-func (x *FileToBase64Response) GetSize() int32 {
+func (x *Merge3Request) GetBaseLabel() string {
 	if x != nil {
-		return x.Size
+		return x.BaseLabel
 	}
-	return 0
+	return ""
 }
 
-func (x *FileToBase64Response) GetError() string {
+func (x *Merge3Request) GetTheirsLabel() string {
 	if x != nil {
-		return x.Error
+		return x.TheirsLabel
 	}
 	return ""
 }
 
-type Base64ToFileRequest struct {
+type MergeConflict struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Encoded       string                 `protobuf:"bytes,1,opt,name=encoded,proto3" json:"encoded,omitempty"`   // raw base64 or full data URI
-	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"` // optional suggested output filename
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`                            // lines mode: 1-based line of the <<<<<<< marker in merged
+	BaseStart     int32                  `protobuf:"varint,2,opt,name=base_start,json=baseStart,proto3" json:"base_start,omitempty"` // lines mode: 1-based first base line; with base_lines 0, the line the insertions follow
+	BaseLines     int32                  `protobuf:"varint,3,opt,name=base_lines,json=baseLines,proto3" json:"base_lines,omitempty"`
+	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"` // structured mode: JSON Pointer of the contested value
+	Base          string                 `protobuf:"bytes,5,opt,name=base,proto3" json:"base,omitempty"` // the competing texts; compact JSON in structured mode, empty where absent
+	Mine          string                 `protobuf:"bytes,6,opt,name=mine,proto3" json:"mine,omitempty"`
+	Theirs        string                 `protobuf:"bytes,7,opt,name=theirs,proto3" json:"theirs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Base64ToFileRequest) Reset() {
-	*x = Base64ToFileRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[141]
+func (x *MergeConflict) Reset() {
+	*x = MergeConflict{}
+	mi := &file_proto_privutil_proto_msgTypes[199]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Base64ToFileRequest) String() string {
+func (x *MergeConflict) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Base64ToFileRequest) ProtoMessage() {}
+func (*MergeConflict) ProtoMessage() {}
 
-func (x *Base64ToFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[141]
+func (x *MergeConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[199]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9118,51 +14529,85 @@ func (x *Base64ToFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Base64ToFileRequest.ProtoReflect.Descriptor instead.
-func (*Base64ToFileRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{141}
+// Deprecated: Use MergeConflict.ProtoReflect.Descriptor instead.
+func (*MergeConflict) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{199}
 }
 
-func (x *Base64ToFileRequest) GetEncoded() string {
+func (x *MergeConflict) GetLine() int32 {
 	if x != nil {
-		return x.Encoded
+		return x.Line
+	}
+	return 0
+}
+
+func (x *MergeConflict) GetBaseStart() int32 {
+	if x != nil {
+		return x.BaseStart
+	}
+	return 0
+}
+
+func (x *MergeConflict) GetBaseLines() int32 {
+	if x != nil {
+		return x.BaseLines
+	}
+	return 0
+}
+
+func (x *MergeConflict) GetPath() string {
+	if x != nil {
+		return x.Path
 	}
 	return ""
 }
 
-func (x *Base64ToFileRequest) GetFilename() string {
+func (x *MergeConflict) GetBase() string {
 	if x != nil {
-		return x.Filename
+		return x.Base
 	}
 	return ""
 }
 
-type Base64ToFileResponse struct {
+func (x *MergeConflict) GetMine() string {
+	if x != nil {
+		return x.Mine
+	}
+	return ""
+}
+
+func (x *MergeConflict) GetTheirs() string {
+	if x != nil {
+		return x.Theirs
+	}
+	return ""
+}
+
+type Merge3Response struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	MimeType      string                 `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
-	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
-	Size          int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
-	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Merged        string                 `protobuf:"bytes,1,opt,name=merged,proto3" json:"merged,omitempty"` // structured conflicts keep mine's value
+	Clean         bool                   `protobuf:"varint,2,opt,name=clean,proto3" json:"clean,omitempty"`  // no conflicts
+	Conflicts     []*MergeConflict       `protobuf:"bytes,3,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Base64ToFileResponse) Reset() {
-	*x = Base64ToFileResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[142]
+func (x *Merge3Response) Reset() {
+	*x = Merge3Response{}
+	mi := &file_proto_privutil_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Base64ToFileResponse) String() string {
+func (x *Merge3Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Base64ToFileResponse) ProtoMessage() {}
+func (*Merge3Response) ProtoMessage() {}
 
-func (x *Base64ToFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[142]
+func (x *Merge3Response) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9173,69 +14618,66 @@ func (x *Base64ToFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Base64ToFileResponse.ProtoReflect.Descriptor instead.
-func (*Base64ToFileResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{142}
-}
-
-func (x *Base64ToFileResponse) GetData() []byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
+// Deprecated: Use Merge3Response.ProtoReflect.Descriptor instead.
+func (*Merge3Response) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{200}
 }
 
-func (x *Base64ToFileResponse) GetMimeType() string {
+func (x *Merge3Response) GetMerged() string {
 	if x != nil {
-		return x.MimeType
+		return x.Merged
 	}
 	return ""
 }
 
-func (x *Base64ToFileResponse) GetFilename() string {
+func (x *Merge3Response) GetClean() bool {
 	if x != nil {
-		return x.Filename
+		return x.Clean
 	}
-	return ""
+	return false
 }
 
-func (x *Base64ToFileResponse) GetSize() int32 {
+func (x *Merge3Response) GetConflicts() []*MergeConflict {
 	if x != nil {
-		return x.Size
+		return x.Conflicts
 	}
-	return 0
+	return nil
 }
 
-func (x *Base64ToFileResponse) GetError() string {
+func (x *Merge3Response) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type TokenCountRequest struct {
+type PatchApplyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Strategy      string                 `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"` // optional: strategy id filter (empty = return all)
+	Patch         string                 `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
+	Format        TextPatchFormat        `protobuf:"varint,3,opt,name=format,proto3,enum=privutil.TextPatchFormat" json:"format,omitempty"`
+	Reverse       bool                   `protobuf:"varint,4,opt,name=reverse,proto3" json:"reverse,omitempty"` // undo the patch, like patch -R
+	Fuzz          int32                  `protobuf:"varint,5,opt,name=fuzz,proto3" json:"fuzz,omitempty"`       // unified: context lines a hunk may ignore at each end; 0 means 2, -1 exact
+	File          string                 `protobuf:"bytes,6,opt,name=file,proto3" json:"file,omitempty"`        // unified: the file to take from a multi-file patch, with or without a/ and b/
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TokenCountRequest) Reset() {
-	*x = TokenCountRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[143]
+func (x *PatchApplyRequest) Reset() {
+	*x = PatchApplyRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TokenCountRequest) String() string {
+func (x *PatchApplyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenCountRequest) ProtoMessage() {}
+func (*PatchApplyRequest) ProtoMessage() {}
 
-func (x *TokenCountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[143]
+func (x *PatchApplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9246,53 +14688,80 @@ func (x *TokenCountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenCountRequest.ProtoReflect.Descriptor instead.
-func (*TokenCountRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{143}
+// Deprecated: Use PatchApplyRequest.ProtoReflect.Descriptor instead.
+func (*PatchApplyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{201}
 }
 
-func (x *TokenCountRequest) GetText() string {
+func (x *PatchApplyRequest) GetText() string {
 	if x != nil {
 		return x.Text
 	}
 	return ""
 }
 
-func (x *TokenCountRequest) GetStrategy() string {
+func (x *PatchApplyRequest) GetPatch() string {
 	if x != nil {
-		return x.Strategy
+		return x.Patch
 	}
 	return ""
 }
 
-type TokenStrategy struct {
+func (x *PatchApplyRequest) GetFormat() TextPatchFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TextPatchFormat_TEXT_PATCH_UNIFIED
+}
+
+func (x *PatchApplyRequest) GetReverse() bool {
+	if x != nil {
+		return x.Reverse
+	}
+	return false
+}
+
+func (x *PatchApplyRequest) GetFuzz() int32 {
+	if x != nil {
+		return x.Fuzz
+	}
+	return 0
+}
+
+func (x *PatchApplyRequest) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+type PatchHunkResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`   // strategy id
-	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"` // human-friendly name
-	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	Sample        []string               `protobuf:"bytes,4,rep,name=sample,proto3" json:"sample,omitempty"`     // first N tokens as preview (capped at 200)
-	Exact         bool                   `protobuf:"varint,5,opt,name=exact,proto3" json:"exact,omitempty"`      // true = exact BPE tokenization, false = heuristic estimate
-	Encoding      string                 `protobuf:"bytes,6,opt,name=encoding,proto3" json:"encoding,omitempty"` // underlying encoding used (e.g. "o200k_base")
-	Group         string                 `protobuf:"bytes,7,opt,name=group,proto3" json:"group,omitempty"`       // grouping: "openai", "anthropic", "meta", "google", "mistral", "classic"
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // 1-based position in the patch
+	Header        string                 `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`  // applied, fuzzy, already applied or failed
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"` // lines from where the header placed the hunk
+	Fuzz          int32                  `protobuf:"varint,5,opt,name=fuzz,proto3" json:"fuzz,omitempty"`     // context lines ignored
+	Line          int32                  `protobuf:"varint,6,opt,name=line,proto3" json:"line,omitempty"`     // 1-based input line the hunk matched at
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TokenStrategy) Reset() {
-	*x = TokenStrategy{}
-	mi := &file_proto_privutil_proto_msgTypes[144]
+func (x *PatchHunkResult) Reset() {
+	*x = PatchHunkResult{}
+	mi := &file_proto_privutil_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TokenStrategy) String() string {
+func (x *PatchHunkResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenStrategy) ProtoMessage() {}
+func (*PatchHunkResult) ProtoMessage() {}
 
-func (x *TokenStrategy) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[144]
+func (x *PatchHunkResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9303,85 +14772,79 @@ func (x *TokenStrategy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenStrategy.ProtoReflect.Descriptor instead.
-func (*TokenStrategy) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{144}
+// Deprecated: Use PatchHunkResult.ProtoReflect.Descriptor instead.
+func (*PatchHunkResult) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{202}
 }
 
-func (x *TokenStrategy) GetName() string {
+func (x *PatchHunkResult) GetIndex() int32 {
 	if x != nil {
-		return x.Name
+		return x.Index
 	}
-	return ""
+	return 0
 }
 
-func (x *TokenStrategy) GetLabel() string {
+func (x *PatchHunkResult) GetHeader() string {
 	if x != nil {
-		return x.Label
+		return x.Header
 	}
 	return ""
 }
 
-func (x *TokenStrategy) GetCount() int32 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-func (x *TokenStrategy) GetSample() []string {
+func (x *PatchHunkResult) GetStatus() string {
 	if x != nil {
-		return x.Sample
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *TokenStrategy) GetExact() bool {
+func (x *PatchHunkResult) GetOffset() int32 {
 	if x != nil {
-		return x.Exact
+		return x.Offset
 	}
-	return false
+	return 0
 }
 
-func (x *TokenStrategy) GetEncoding() string {
+func (x *PatchHunkResult) GetFuzz() int32 {
 	if x != nil {
-		return x.Encoding
+		return x.Fuzz
 	}
-	return ""
+	return 0
 }
 
-func (x *TokenStrategy) GetGroup() string {
+func (x *PatchHunkResult) GetLine() int32 {
 	if x != nil {
-		return x.Group
+		return x.Line
 	}
-	return ""
+	return 0
 }
 
-type TokenCountResponse struct {
+type PatchApplyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Strategies    []*TokenStrategy       `protobuf:"bytes,1,rep,name=strategies,proto3" json:"strategies,omitempty"`
-	CharCount     int32                  `protobuf:"varint,2,opt,name=char_count,json=charCount,proto3" json:"char_count,omitempty"`
-	ByteCount     int32                  `protobuf:"varint,3,opt,name=byte_count,json=byteCount,proto3" json:"byte_count,omitempty"`
-	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Result        string                 `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"` // failed hunks are left out
+	Clean         bool                   `protobuf:"varint,2,opt,name=clean,proto3" json:"clean,omitempty"`  // every hunk applied
+	Hunks         []*PatchHunkResult     `protobuf:"bytes,3,rep,name=hunks,proto3" json:"hunks,omitempty"`
+	ReversePatch  string                 `protobuf:"bytes,4,opt,name=reverse_patch,json=reversePatch,proto3" json:"reverse_patch,omitempty"` // takes result back to text, in the request's format
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TokenCountResponse) Reset() {
-	*x = TokenCountResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[145]
+func (x *PatchApplyResponse) Reset() {
+	*x = PatchApplyResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TokenCountResponse) String() string {
+func (x *PatchApplyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenCountResponse) ProtoMessage() {}
+func (*PatchApplyResponse) ProtoMessage() {}
 
-func (x *TokenCountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[145]
+func (x *PatchApplyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9392,62 +14855,70 @@ func (x *TokenCountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenCountResponse.ProtoReflect.Descriptor instead.
-func (*TokenCountResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{145}
+// Deprecated: Use PatchApplyResponse.ProtoReflect.Descriptor instead.
+func (*PatchApplyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{203}
 }
 
-func (x *TokenCountResponse) GetStrategies() []*TokenStrategy {
+func (x *PatchApplyResponse) GetResult() string {
 	if x != nil {
-		return x.Strategies
+		return x.Result
 	}
-	return nil
+	return ""
 }
 
-func (x *TokenCountResponse) GetCharCount() int32 {
+func (x *PatchApplyResponse) GetClean() bool {
 	if x != nil {
-		return x.CharCount
+		return x.Clean
 	}
-	return 0
+	return false
 }
 
-func (x *TokenCountResponse) GetByteCount() int32 {
+func (x *PatchApplyResponse) GetHunks() []*PatchHunkResult {
 	if x != nil {
-		return x.ByteCount
+		return x.Hunks
 	}
-	return 0
+	return nil
 }
 
-func (x *TokenCountResponse) GetError() string {
+func (x *PatchApplyResponse) GetReversePatch() string {
+	if x != nil {
+		return x.ReversePatch
+	}
+	return ""
+}
+
+func (x *PatchApplyResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type SpellCheckRequest struct {
+type RegexExplainRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	Language      string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"` // language code, e.g. "en", "es" (default "en")
+	Pattern       string                 `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Flags         string                 `protobuf:"bytes,2,opt,name=flags,proto3" json:"flags,omitempty"`                              // as in RegexRequest
+	Engine        RegexEngine            `protobuf:"varint,3,opt,name=engine,proto3,enum=privutil.RegexEngine" json:"engine,omitempty"` // the dialect to read the pattern in
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SpellCheckRequest) Reset() {
-	*x = SpellCheckRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[146]
+func (x *RegexExplainRequest) Reset() {
+	*x = RegexExplainRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[204]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SpellCheckRequest) String() string {
+func (x *RegexExplainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SpellCheckRequest) ProtoMessage() {}
+func (*RegexExplainRequest) ProtoMessage() {}
 
-func (x *SpellCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[146]
+func (x *RegexExplainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[204]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9458,56 +14929,59 @@ func (x *SpellCheckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SpellCheckRequest.ProtoReflect.Descriptor instead.
-func (*SpellCheckRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{146}
+// Deprecated: Use RegexExplainRequest.ProtoReflect.Descriptor instead.
+func (*RegexExplainRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{204}
 }
 
-func (x *SpellCheckRequest) GetText() string {
+func (x *RegexExplainRequest) GetPattern() string {
 	if x != nil {
-		return x.Text
+		return x.Pattern
 	}
 	return ""
 }
 
-func (x *SpellCheckRequest) GetLanguage() string {
+func (x *RegexExplainRequest) GetFlags() string {
 	if x != nil {
-		return x.Language
+		return x.Flags
 	}
 	return ""
 }
 
-type SpellIssue struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                              // stable id for this issue within the response
-	Offset        int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`                                     // rune offset of the flagged span in text
-	Length        int32                  `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`                                     // rune length of the flagged span
-	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`                                          // the flagged substring
-	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`                                          // "spelling" | "grammar" | "punctuation" | "style"
-	Rule          string                 `protobuf:"bytes,6,opt,name=rule,proto3" json:"rule,omitempty"`                                          // rule identifier
-	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`                                    // human-readable description of the issue
-	Replacements  []string               `protobuf:"bytes,8,rep,name=replacements,proto3" json:"replacements,omitempty"`                          // suggested corrections (may be empty)
-	Context       string                 `protobuf:"bytes,9,opt,name=context,proto3" json:"context,omitempty"`                                    // surrounding text snippet
-	ContextOffset int32                  `protobuf:"varint,10,opt,name=context_offset,json=contextOffset,proto3" json:"context_offset,omitempty"` // rune offset of the flagged span within context
+func (x *RegexExplainRequest) GetEngine() RegexEngine {
+	if x != nil {
+		return x.Engine
+	}
+	return RegexEngine_REGEX_RE2
+}
+
+type RegexToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`    // e.g. literal, class, group, quantifier, alternation, sequence
+	Start         int32                  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"` // byte offsets into the pattern, end exclusive
+	End           int32                  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Children      []*RegexToken          `protobuf:"bytes,6,rep,name=children,proto3" json:"children,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SpellIssue) Reset() {
-	*x = SpellIssue{}
-	mi := &file_proto_privutil_proto_msgTypes[147]
+func (x *RegexToken) Reset() {
+	*x = RegexToken{}
+	mi := &file_proto_privutil_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SpellIssue) String() string {
+func (x *RegexToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SpellIssue) ProtoMessage() {}
+func (*RegexToken) ProtoMessage() {}
 
-func (x *SpellIssue) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[147]
+func (x *RegexToken) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9518,106 +14992,147 @@ func (x *SpellIssue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SpellIssue.ProtoReflect.Descriptor instead.
-func (*SpellIssue) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{147}
+// Deprecated: Use RegexToken.ProtoReflect.Descriptor instead.
+func (*RegexToken) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{205}
 }
 
-func (x *SpellIssue) GetId() string {
+func (x *RegexToken) GetKind() string {
 	if x != nil {
-		return x.Id
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *SpellIssue) GetOffset() int32 {
+func (x *RegexToken) GetStart() int32 {
 	if x != nil {
-		return x.Offset
+		return x.Start
 	}
 	return 0
 }
 
-func (x *SpellIssue) GetLength() int32 {
+func (x *RegexToken) GetEnd() int32 {
 	if x != nil {
-		return x.Length
+		return x.End
 	}
 	return 0
 }
 
-func (x *SpellIssue) GetText() string {
+func (x *RegexToken) GetText() string {
 	if x != nil {
 		return x.Text
 	}
 	return ""
 }
 
-func (x *SpellIssue) GetType() string {
+func (x *RegexToken) GetDescription() string {
 	if x != nil {
-		return x.Type
+		return x.Description
 	}
 	return ""
 }
 
-func (x *SpellIssue) GetRule() string {
+func (x *RegexToken) GetChildren() []*RegexToken {
 	if x != nil {
-		return x.Rule
+		return x.Children
 	}
-	return ""
+	return nil
 }
 
-func (x *SpellIssue) GetMessage() string {
+type RegexWarning struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // e.g. catastrophic-backtracking, unescaped-dot, suspicious-range
+	Start         int32                  `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegexWarning) Reset() {
+	*x = RegexWarning{}
+	mi := &file_proto_privutil_proto_msgTypes[206]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegexWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegexWarning) ProtoMessage() {}
+
+func (x *RegexWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[206]
 	if x != nil {
-		return x.Message
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SpellIssue) GetReplacements() []string {
+// Deprecated: Use RegexWarning.ProtoReflect.Descriptor instead.
+func (*RegexWarning) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{206}
+}
+
+func (x *RegexWarning) GetCode() string {
 	if x != nil {
-		return x.Replacements
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-func (x *SpellIssue) GetContext() string {
+func (x *RegexWarning) GetStart() int32 {
 	if x != nil {
-		return x.Context
+		return x.Start
 	}
-	return ""
+	return 0
 }
 
-func (x *SpellIssue) GetContextOffset() int32 {
+func (x *RegexWarning) GetEnd() int32 {
 	if x != nil {
-		return x.ContextOffset
+		return x.End
 	}
 	return 0
 }
 
-type SpellCheckResponse struct {
+func (x *RegexWarning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RegexExplainResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Issues        []*SpellIssue          `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
-	Language      string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"` // resolved language code
-	WordCount     int32                  `protobuf:"varint,3,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
-	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Tree          *RegexToken            `protobuf:"bytes,1,opt,name=tree,proto3" json:"tree,omitempty"`
+	Summary       string                 `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"` // the whole pattern in one sentence
+	GroupCount    int32                  `protobuf:"varint,3,opt,name=group_count,json=groupCount,proto3" json:"group_count,omitempty"`
+	Warnings      []*RegexWarning        `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SpellCheckResponse) Reset() {
-	*x = SpellCheckResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[148]
+func (x *RegexExplainResponse) Reset() {
+	*x = RegexExplainResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SpellCheckResponse) String() string {
+func (x *RegexExplainResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SpellCheckResponse) ProtoMessage() {}
+func (*RegexExplainResponse) ProtoMessage() {}
 
-func (x *SpellCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[148]
+func (x *RegexExplainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9628,60 +15143,72 @@ func (x *SpellCheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SpellCheckResponse.ProtoReflect.Descriptor instead.
-func (*SpellCheckResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{148}
+// Deprecated: Use RegexExplainResponse.ProtoReflect.Descriptor instead.
+func (*RegexExplainResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{207}
 }
 
-func (x *SpellCheckResponse) GetIssues() []*SpellIssue {
+func (x *RegexExplainResponse) GetTree() *RegexToken {
 	if x != nil {
-		return x.Issues
+		return x.Tree
 	}
 	return nil
 }
 
-func (x *SpellCheckResponse) GetLanguage() string {
+func (x *RegexExplainResponse) GetSummary() string {
 	if x != nil {
-		return x.Language
+		return x.Summary
 	}
 	return ""
 }
 
-func (x *SpellCheckResponse) GetWordCount() int32 {
+func (x *RegexExplainResponse) GetGroupCount() int32 {
 	if x != nil {
-		return x.WordCount
+		return x.GroupCount
 	}
 	return 0
 }
 
-func (x *SpellCheckResponse) GetError() string {
+func (x *RegexExplainResponse) GetWarnings() []*RegexWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *RegexExplainResponse) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-type SpellLanguagesRequest struct {
+type RegexGenerateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pattern       string                 `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`                       // RE2 syntax
+	Flags         string                 `protobuf:"bytes,2,opt,name=flags,proto3" json:"flags,omitempty"`                           // as in RegexRequest
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`                          // strings of each kind; 0 means 10, at most 1000
+	MaxRepeat     int32                  `protobuf:"varint,4,opt,name=max_repeat,json=maxRepeat,proto3" json:"max_repeat,omitempty"` // repetitions allowed past a quantifier's minimum; 0 means 5, -1 none, at most 100
+	Seed          int64                  `protobuf:"varint,5,opt,name=seed,proto3" json:"seed,omitempty"`                            // 0 picks a random seed, returned in the response
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SpellLanguagesRequest) Reset() {
-	*x = SpellLanguagesRequest{}
-	mi := &file_proto_privutil_proto_msgTypes[149]
+func (x *RegexGenerateRequest) Reset() {
+	*x = RegexGenerateRequest{}
+	mi := &file_proto_privutil_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SpellLanguagesRequest) String() string {
+func (x *RegexGenerateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SpellLanguagesRequest) ProtoMessage() {}
+func (*RegexGenerateRequest) ProtoMessage() {}
 
-func (x *SpellLanguagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[149]
+func (x *RegexGenerateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9692,34 +15219,70 @@ func (x *SpellLanguagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SpellLanguagesRequest.ProtoReflect.Descriptor instead.
-func (*SpellLanguagesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{149}
+// Deprecated: Use RegexGenerateRequest.ProtoReflect.Descriptor instead.
+func (*RegexGenerateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{208}
 }
 
-type SpellLanguage struct {
+func (x *RegexGenerateRequest) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *RegexGenerateRequest) GetFlags() string {
+	if x != nil {
+		return x.Flags
+	}
+	return ""
+}
+
+func (x *RegexGenerateRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *RegexGenerateRequest) GetMaxRepeat() int32 {
+	if x != nil {
+		return x.MaxRepeat
+	}
+	return 0
+}
+
+func (x *RegexGenerateRequest) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type RegexNearMiss struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Source        string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"` // the matching string it was made from
+	Change        string                 `protobuf:"bytes,3,opt,name=change,proto3" json:"change,omitempty"` // the single edit, e.g. "deleted '7' at character 3"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SpellLanguage) Reset() {
-	*x = SpellLanguage{}
-	mi := &file_proto_privutil_proto_msgTypes[150]
+func (x *RegexNearMiss) Reset() {
+	*x = RegexNearMiss{}
+	mi := &file_proto_privutil_proto_msgTypes[209]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SpellLanguage) String() string {
+func (x *RegexNearMiss) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SpellLanguage) ProtoMessage() {}
+func (*RegexNearMiss) ProtoMessage() {}
 
-func (x *SpellLanguage) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[150]
+func (x *RegexNearMiss) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[209]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9730,47 +15293,58 @@ func (x *SpellLanguage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SpellLanguage.ProtoReflect.Descriptor instead.
-func (*SpellLanguage) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{150}
+// Deprecated: Use RegexNearMiss.ProtoReflect.Descriptor instead.
+func (*RegexNearMiss) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{209}
 }
 
-func (x *SpellLanguage) GetCode() string {
+func (x *RegexNearMiss) GetText() string {
 	if x != nil {
-		return x.Code
+		return x.Text
 	}
 	return ""
 }
 
-func (x *SpellLanguage) GetLabel() string {
+func (x *RegexNearMiss) GetSource() string {
 	if x != nil {
-		return x.Label
+		return x.Source
 	}
 	return ""
 }
 
-type SpellLanguagesResponse struct {
+func (x *RegexNearMiss) GetChange() string {
+	if x != nil {
+		return x.Change
+	}
+	return ""
+}
+
+type RegexGenerateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Languages     []*SpellLanguage       `protobuf:"bytes,1,rep,name=languages,proto3" json:"languages,omitempty"`
+	Matches       []string               `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`                         // distinct full matches; fewer than count when the pattern has fewer
+	NearMisses    []*RegexNearMiss       `protobuf:"bytes,2,rep,name=near_misses,json=nearMisses,proto3" json:"near_misses,omitempty"` // contain no match, or when the pattern matches "" are no full match
+	Seed          int64                  `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Truncated     bool                   `protobuf:"varint,5,opt,name=truncated,proto3" json:"truncated,omitempty"` // the work limit was reached before count of each
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SpellLanguagesResponse) Reset() {
-	*x = SpellLanguagesResponse{}
-	mi := &file_proto_privutil_proto_msgTypes[151]
+func (x *RegexGenerateResponse) Reset() {
+	*x = RegexGenerateResponse{}
+	mi := &file_proto_privutil_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SpellLanguagesResponse) String() string {
+func (x *RegexGenerateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SpellLanguagesResponse) ProtoMessage() {}
+func (*RegexGenerateResponse) ProtoMessage() {}
 
-func (x *SpellLanguagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_privutil_proto_msgTypes[151]
+func (x *RegexGenerateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_privutil_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9781,28 +15355,85 @@ func (x *SpellLanguagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SpellLanguagesResponse.ProtoReflect.Descriptor instead.
-func (*SpellLanguagesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_privutil_proto_rawDescGZIP(), []int{151}
+// Deprecated: Use RegexGenerateResponse.ProtoReflect.Descriptor instead.
+func (*RegexGenerateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_privutil_proto_rawDescGZIP(), []int{210}
 }
 
-func (x *SpellLanguagesResponse) GetLanguages() []*SpellLanguage {
+func (x *RegexGenerateResponse) GetMatches() []string {
 	if x != nil {
-		return x.Languages
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *RegexGenerateResponse) GetNearMisses() []*RegexNearMiss {
+	if x != nil {
+		return x.NearMisses
 	}
 	return nil
 }
 
+func (x *RegexGenerateResponse) GetSeed() int64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *RegexGenerateResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RegexGenerateResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
 var File_proto_privutil_proto protoreflect.FileDescriptor
 
 const file_proto_privutil_proto_rawDesc = "" +
 	"\n" +
-	"\x14proto/privutil.proto\x12\bprivutil\"9\n" +
+	"\x14proto/privutil.proto\x12\bprivutil\"\x84\x02\n" +
 	"\vDiffRequest\x12\x14\n" +
 	"\x05text1\x18\x01 \x01(\tR\x05text1\x12\x14\n" +
-	"\x05text2\x18\x02 \x01(\tR\x05text2\"+\n" +
+	"\x05text2\x18\x02 \x01(\tR\x05text2\x12&\n" +
+	"\x04mode\x18\x03 \x01(\x0e2\x12.privutil.DiffModeR\x04mode\x12+\n" +
+	"\x11ignore_whitespace\x18\x04 \x01(\bR\x10ignoreWhitespace\x12\x1f\n" +
+	"\vignore_case\x18\x05 \x01(\bR\n" +
+	"ignoreCase\x12#\n" +
+	"\rcontext_lines\x18\x06 \x01(\x05R\fcontextLines\x12\x16\n" +
+	"\x06label1\x18\a \x01(\tR\x06label1\x12\x16\n" +
+	"\x06label2\x18\b \x01(\tR\x06label2\"\x9f\x02\n" +
 	"\fDiffResponse\x12\x1b\n" +
-	"\tdiff_html\x18\x01 \x01(\tR\bdiffHtml\"5\n" +
+	"\tdiff_html\x18\x01 \x01(\tR\bdiffHtml\x12\x18\n" +
+	"\aunified\x18\x02 \x01(\tR\aunified\x12(\n" +
+	"\x05hunks\x18\x03 \x03(\v2\x12.privutil.DiffHunkR\x05hunks\x12\x1a\n" +
+	"\binserted\x18\x04 \x01(\x05R\binserted\x12\x18\n" +
+	"\adeleted\x18\x05 \x01(\x05R\adeleted\x12\x1f\n" +
+	"\vlines_added\x18\x06 \x01(\x05R\n" +
+	"linesAdded\x12#\n" +
+	"\rlines_removed\x18\a \x01(\x05R\flinesRemoved\x12\x1c\n" +
+	"\tidentical\x18\b \x01(\bR\tidentical\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\"\xbd\x01\n" +
+	"\bDiffHunk\x12\x1b\n" +
+	"\told_start\x18\x01 \x01(\x05R\boldStart\x12\x1b\n" +
+	"\told_lines\x18\x02 \x01(\x05R\boldLines\x12\x1b\n" +
+	"\tnew_start\x18\x03 \x01(\x05R\bnewStart\x12\x1b\n" +
+	"\tnew_lines\x18\x04 \x01(\x05R\bnewLines\x12\x16\n" +
+	"\x06header\x18\x05 \x01(\tR\x06header\x12%\n" +
+	"\x04rows\x18\x06 \x03(\v2\x11.privutil.DiffRowR\x04rows\"\x89\x01\n" +
+	"\aDiffRow\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x19\n" +
+	"\bold_line\x18\x02 \x01(\x05R\aoldLine\x12\x19\n" +
+	"\bold_text\x18\x03 \x01(\tR\aoldText\x12\x19\n" +
+	"\bnew_line\x18\x04 \x01(\x05R\anewLine\x12\x19\n" +
+	"\bnew_text\x18\x05 \x01(\tR\anewText\"5\n" +
 	"\rBase64Request\x12\x12\n" +
 	"\x04text\x18\x01 \x01(\tR\x04text\x12\x10\n" +
 	"\x03raw\x18\x03 \x01(\fR\x03raw\"k\n" +
@@ -9810,31 +15441,61 @@ const file_proto_privutil_proto_rawDesc = "" +
 	"\x04text\x18\x01 \x01(\tR\x04text\x12\x14\n" +
 	"\x05error\x18\x02 \x01(\tR\x05error\x12\x12\n" +
 	"\x04data\x18\x03 \x01(\fR\x04data\x12\x1b\n" +
-	"\tmime_type\x18\x04 \x01(\tR\bmimeType\"\\\n" +
+	"\tmime_type\x18\x04 \x01(\tR\bmimeType\"\x94\x01\n" +
 	"\x11JsonFormatRequest\x12\x12\n" +
 	"\x04text\x18\x01 \x01(\tR\x04text\x12\x16\n" +
 	"\x06indent\x18\x02 \x01(\tR\x06indent\x12\x1b\n" +
-	"\tsort_keys\x18\x03 \x01(\bR\bsortKeys\">\n" +
+	"\tsort_keys\x18\x03 \x01(\bR\bsortKeys\x12\x1c\n" +
+	"\tcanonical\x18\x04 \x01(\bR\tcanonical\x12\x18\n" +
+	"\alenient\x18\x05 \x01(\bR\alenient\"\x80\x01\n" +
 	"\x12JsonFormatResponse\x12\x12\n" +
 	"\x04text\x18\x01 \x01(\tR\x04text\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\"\xe3\x01\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"error_line\x18\x03 \x01(\x05R\terrorLine\x12!\n" +
+	"\ferror_column\x18\x04 \x01(\x05R\verrorColumn\"\xde\x05\n" +
 	"\x0eConvertRequest\x12\x12\n" +
 	"\x04data\x18\x01 \x01(\tR\x04data\x129\n" +
 	"\rsource_format\x18\x02 \x01(\x0e2\x14.privutil.DataFormatR\fsourceFormat\x129\n" +
 	"\rtarget_format\x18\x03 \x01(\x0e2\x14.privutil.DataFormatR\ftargetFormat\x12#\n" +
 	"\rcsv_delimiter\x18\x04 \x01(\tR\fcsvDelimiter\x12\"\n" +
-	"\rcsv_no_header\x18\x05 \x01(\bR\vcsvNoHeader\";\n" +
+	"\rcsv_no_header\x18\x05 \x01(\bR\vcsvNoHeader\x12A\n" +
+	"\x0fbinary_encoding\x18\x06 \x01(\x0e2\x18.privutil.BinaryEncodingR\x0ebinaryEncoding\x12E\n" +
+	"\x11binary_json_style\x18\a \x01(\x0e2\x19.privutil.BinaryJsonStyleR\x0fbinaryJsonStyle\x12\x1b\n" +
+	"\tsort_keys\x18\b \x01(\bR\bsortKeys\x12&\n" +
+	"\x0fxml_attr_prefix\x18\t \x01(\tR\rxmlAttrPrefix\x12 \n" +
+	"\fxml_text_key\x18\n" +
+	" \x01(\tR\n" +
+	"xmlTextKey\x12\x19\n" +
+	"\bxml_root\x18\v \x01(\tR\axmlRoot\x12$\n" +
+	"\x0exml_array_item\x18\f \x01(\tR\fxmlArrayItem\x12\x1f\n" +
+	"\vyaml_indent\x18\r \x01(\x05R\n" +
+	"yamlIndent\x12&\n" +
+	"\x0fyaml_flow_style\x18\x0e \x01(\bR\ryamlFlowStyle\x125\n" +
+	"\vcsv_quoting\x18\x0f \x01(\x0e2\x14.privutil.CsvQuotingR\n" +
+	"csvQuoting\x12\x1f\n" +
+	"\vcsv_flatten\x18\x10 \x01(\bR\n" +
+	"csvFlatten\x12&\n" +
+	"\x0fcsv_infer_types\x18\x11 \x01(\bR\rcsvInferTypes\";\n" +
 	"\x0fConvertResponse\x12\x12\n" +
 	"\x04data\x18\x01 \x01(\tR\x04data\x12\x14\n" +
 	"\x05error\x18\x02 \x01(\tR\x05error\"S\n" +
 	"\x0fValidateRequest\x12\x12\n" +
 	"\x04data\x18\x01 \x01(\tR\x04data\x12,\n" +
-	"\x06format\x18\x02 \x01(\x0e2\x14.privutil.DataFormatR\x06format\"j\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x14.privutil.DataFormatR\x06format\"\xf2\x01\n" +
 	"\x10ValidateResponse\x12\x14\n" +
 	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x14\n" +
 	"\x05error\x18\x02 \x01(\tR\x05error\x12\x12\n" +
 	"\x04line\x18\x03 \x01(\x05R\x04line\x12\x16\n" +
-	"\x06column\x18\x04 \x01(\x05R\x06column\"\x91\x01\n" +
+	"\x06column\x18\x04 \x01(\x05R\x06column\x121\n" +
+	"\x06errors\x18\x05 \x03(\v2\x19.privutil.ValidationIssueR\x06errors\x125\n" +
+	"\bwarnings\x18\x06 \x03(\v2\x19.privutil.ValidationIssueR\bwarnings\x12\x1c\n" +
+	"\tdocuments\x18\a \x01(\x05R\tdocuments\"s\n" +
+	"\x0fValidationIssue\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04line\x18\x02 \x01(\x05R\x04line\x12\x16\n" +
+	"\x06column\x18\x03 \x01(\x05R\x06column\x12\x1a\n" +
+	"\bdocument\x18\x04 \x01(\x05R\bdocument\"\x91\x01\n" +
 	"\vUuidRequest\x12\x16\n" +
 	"\x06hyphen\x18\x01 \x01(\bR\x06hyphen\x12\x1c\n" +
 	"\tuppercase\x18\x02 \x01(\bR\tuppercase\x12\x14\n" +
@@ -9847,7 +15508,22 @@ const file_proto_privutil_proto_rawDesc = "" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
 	"\x05count\x18\x02 \x01(\x05R\x05count\"#\n" +
 	"\rLoremResponse\x12\x12\n" +
-	"\x04text\x18\x01 \x01(\tR\x04text\"W\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\"\xc0\x01\n" +
+	"\x0fFakeDataRequest\x12\x16\n" +
+	"\x06schema\x18\x01 \x01(\tR\x06schema\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12,\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x14.privutil.DataFormatR\x06format\x12\x12\n" +
+	"\x04seed\x18\x04 \x01(\x03R\x04seed\x12\x16\n" +
+	"\x06locale\x18\x05 \x01(\tR\x06locale\x12%\n" +
+	"\x0ereference_date\x18\x06 \x01(\tR\rreferenceDate\"\x8a\x01\n" +
+	"\x10FakeDataResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\tR\x04data\x12\x12\n" +
+	"\x04seed\x18\x02 \x01(\x03R\x04seed\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x18\n" +
+	"\alocales\x18\x04 \x03(\tR\alocales\x12\x1e\n" +
+	"\n" +
+	"generators\x18\x05 \x03(\tR\n" +
+	"generators\"W\n" +
 	"\vHashRequest\x12\x12\n" +
 	"\x04text\x18\x01 \x01(\tR\x04text\x12\x12\n" +
 	"\x04algo\x18\x02 \x01(\tR\x04algo\x12\x17\n" +
@@ -9872,18 +15548,46 @@ const file_proto_privutil_proto_rawDesc = "" +
 	"\vJwtResponse\x12\x16\n" +
 	"\x06header\x18\x01 \x01(\tR\x06header\x12\x18\n" +
 	"\apayload\x18\x02 \x01(\tR\apayload\x12\x14\n" +
-	"\x05error\x18\x03 \x01(\tR\x05error\"<\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\xd7\x01\n" +
 	"\fRegexRequest\x12\x18\n" +
 	"\apattern\x18\x01 \x01(\tR\apattern\x12\x12\n" +
-	"\x04text\x18\x02 \x01(\tR\x04text\"U\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x14\n" +
+	"\x05flags\x18\x03 \x01(\tR\x05flags\x12-\n" +
+	"\x06engine\x18\x04 \x01(\x0e2\x15.privutil.RegexEngineR\x06engine\x12%\n" +
+	"\vreplacement\x18\x05 \x01(\tH\x00R\vreplacement\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x06 \x01(\x05R\ttimeoutMsB\x0e\n" +
+	"\f_replacement\"\xc8\x01\n" +
+	"\n" +
+	"RegexGroup\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x05R\x06number\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\amatched\x18\x03 \x01(\bR\amatched\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12\x14\n" +
+	"\x05start\x18\x05 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x06 \x01(\x05R\x03end\x12\x1d\n" +
+	"\n" +
+	"rune_start\x18\a \x01(\x05R\truneStart\x12\x19\n" +
+	"\brune_end\x18\b \x01(\x05R\aruneEnd\"@\n" +
+	"\x10RegexMatchDetail\x12,\n" +
+	"\x06groups\x18\x01 \x03(\v2\x14.privutil.RegexGroupR\x06groups\"\xe6\x01\n" +
 	"\rRegexResponse\x12\x14\n" +
 	"\x05match\x18\x01 \x01(\bR\x05match\x12\x18\n" +
 	"\amatches\x18\x02 \x03(\tR\amatches\x12\x14\n" +
-	"\x05error\x18\x03 \x01(\tR\x05error\"F\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x124\n" +
+	"\adetails\x18\x04 \x03(\v2\x1a.privutil.RegexMatchDetailR\adetails\x12\x1f\n" +
+	"\vgroup_names\x18\x05 \x03(\tR\n" +
+	"groupNames\x12\x1a\n" +
+	"\breplaced\x18\x06 \x01(\tR\breplaced\x12\x1c\n" +
+	"\ttruncated\x18\a \x01(\bR\ttruncated\"\xc6\x01\n" +
 	"\x0fJsonToGoRequest\x12\x12\n" +
 	"\x04json\x18\x01 \x01(\tR\x04json\x12\x1f\n" +
 	"\vstruct_name\x18\x02 \x01(\tR\n" +
-	"structName\"A\n" +
+	"structName\x12!\n" +
+	"\finline_types\x18\x03 \x01(\bR\vinlineTypes\x12!\n" +
+	"\fuse_pointers\x18\x04 \x01(\bR\vusePointers\x12\x1b\n" +
+	"\tyaml_tags\x18\x05 \x01(\bR\byamlTags\x12\x1b\n" +
+	"\ttoml_tags\x18\x06 \x01(\bR\btomlTags\"A\n" +
 	"\x10JsonToGoResponse\x12\x17\n" +
 	"\ago_code\x18\x01 \x01(\tR\x06goCode\x12\x14\n" +
 	"\x05error\x18\x02 \x01(\tR\x05error\"-\n" +
@@ -9906,12 +15610,26 @@ const file_proto_privutil_proto_rawDesc = "" +
 	"\x04sans\x18\x05 \x03(\tR\x04sans\x12\x14\n" +
 	"\x05error\x18\x06 \x01(\tR\x05error\"$\n" +
 	"\fColorRequest\x12\x14\n" +
-	"\x05input\x18\x01 \x01(\tR\x05input\"[\n" +
+	"\x05input\x18\x01 \x01(\tR\x05input\"\xe6\x02\n" +
 	"\rColorResponse\x12\x10\n" +
 	"\x03hex\x18\x01 \x01(\tR\x03hex\x12\x10\n" +
 	"\x03rgb\x18\x02 \x01(\tR\x03rgb\x12\x10\n" +
 	"\x03hsl\x18\x03 \x01(\tR\x03hsl\x12\x14\n" +
-	"\x05error\x18\x04 \x01(\tR\x05error\"!\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x12\n" +
+	"\x04hex8\x18\x05 \x01(\tR\x04hex8\x12\x10\n" +
+	"\x03hsv\x18\x06 \x01(\tR\x03hsv\x12\x10\n" +
+	"\x03hwb\x18\a \x01(\tR\x03hwb\x12\x12\n" +
+	"\x04cmyk\x18\b \x01(\tR\x04cmyk\x12\x10\n" +
+	"\x03lab\x18\t \x01(\tR\x03lab\x12\x10\n" +
+	"\x03lch\x18\n" +
+	" \x01(\tR\x03lch\x12\x14\n" +
+	"\x05oklab\x18\v \x01(\tR\x05oklab\x12\x14\n" +
+	"\x05oklch\x18\f \x01(\tR\x05oklch\x12\x14\n" +
+	"\x05alpha\x18\r \x01(\x01R\x05alpha\x12\x12\n" +
+	"\x04name\x18\x0e \x01(\tR\x04name\x12!\n" +
+	"\fnearest_name\x18\x0f \x01(\tR\vnearestName\x12 \n" +
+	"\fout_of_gamut\x18\x10 \x01(\bR\n" +
+	"outOfGamut\"!\n" +
 	"\vCaseRequest\x12\x12\n" +
 	"\x04text\x18\x01 \x01(\tR\x04text\"\xb0\x01\n" +
 	"\fCaseResponse\x12\x14\n" +
@@ -9937,12 +15655,53 @@ const file_proto_privutil_proto_rawDesc = "" +
 	"\n" +
 	"similarity\x18\x02 \x01(\x02R\n" +
 	"similarity\x12\x14\n" +
-	"\x05error\x18\x03 \x01(\tR\x05error\"\"\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\xde\x01\n" +
 	"\n" +
 	"SqlRequest\x12\x14\n" +
-	"\x05query\x18\x01 \x01(\tR\x05query\"A\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12.\n" +
+	"\adialect\x18\x02 \x01(\x0e2\x14.privutil.SqlDialectR\adialect\x12;\n" +
+	"\fkeyword_case\x18\x03 \x01(\x0e2\x18.privutil.SqlKeywordCaseR\vkeywordCase\x12\x16\n" +
+	"\x06indent\x18\x04 \x01(\tR\x06indent\x12\x1d\n" +
+	"\n" +
+	"line_width\x18\x05 \x01(\x05R\tlineWidth\x12\x16\n" +
+	"\x06minify\x18\x06 \x01(\bR\x06minify\"\x83\x01\n" +
 	"\vSqlResponse\x12\x1c\n" +
 	"\tformatted\x18\x01 \x01(\tR\tformatted\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"error_line\x18\x03 \x01(\x05R\terrorLine\x12!\n" +
+	"\ferror_column\x18\x04 \x01(\x05R\verrorColumn\"\x95\x02\n" +
+	"\x10DataToSqlRequest\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\tR\x04data\x12,\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x14.privutil.DataFormatR\x06format\x12#\n" +
+	"\rcsv_delimiter\x18\x03 \x01(\tR\fcsvDelimiter\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x04 \x01(\tR\ttableName\x12.\n" +
+	"\adialect\x18\x05 \x01(\x0e2\x14.privutil.SqlDialectR\adialect\x12,\n" +
+	"\x05style\x18\x06 \x01(\x0e2\x16.privutil.SqlLoadStyleR\x05style\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\a \x01(\x05R\tbatchSize\"p\n" +
+	"\tSqlColumn\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1a\n" +
+	"\bnullable\x18\x03 \x01(\bR\bnullable\x12\x1f\n" +
+	"\vprimary_key\x18\x04 \x01(\bR\n" +
+	"primaryKey\"\xaf\x01\n" +
+	"\x11DataToSqlResponse\x12!\n" +
+	"\fcreate_table\x18\x01 \x01(\tR\vcreateTable\x12\x1e\n" +
+	"\n" +
+	"statements\x18\x02 \x01(\tR\n" +
+	"statements\x12-\n" +
+	"\acolumns\x18\x03 \x03(\v2\x13.privutil.SqlColumnR\acolumns\x12\x12\n" +
+	"\x04rows\x18\x04 \x01(\x05R\x04rows\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\x92\x01\n" +
+	"\x0eSqlToGoRequest\x12\x10\n" +
+	"\x03ddl\x18\x01 \x01(\tR\x03ddl\x12.\n" +
+	"\adialect\x18\x02 \x01(\x0e2\x14.privutil.SqlDialectR\adialect\x12!\n" +
+	"\fuse_pointers\x18\x03 \x01(\bR\vusePointers\x12\x1b\n" +
+	"\tjson_tags\x18\x04 \x01(\bR\bjsonTags\"@\n" +
+	"\x0fSqlToGoResponse\x12\x17\n" +
+	"\ago_code\x18\x01 \x01(\tR\x06goCode\x12\x14\n" +
 	"\x05error\x18\x02 \x01(\tR\x05error\"\x1f\n" +
 	"\tIpRequest\x12\x12\n" +
 	"\x04cidr\x18\x01 \x01(\tR\x04cidr\"\xc5\x01\n" +
@@ -10515,14 +16274,372 @@ const file_proto_privutil_proto_rawDesc = "" +
 	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\"O\n" +
 	"\x16SpellLanguagesResponse\x125\n" +
-	"\tlanguages\x18\x01 \x03(\v2\x17.privutil.SpellLanguageR\tlanguages*<\n" +
+	"\tlanguages\x18\x01 \x03(\v2\x17.privutil.SpellLanguageR\tlanguages\"\xfe\x01\n" +
+	"\x12InferSchemaRequest\x12\x18\n" +
+	"\asamples\x18\x01 \x03(\tR\asamples\x12,\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x14.privutil.DataFormatR\x06format\x12+\n" +
+	"\x05draft\x18\x03 \x01(\x0e2\x15.privutil.SchemaDraftR\x05draft\x12\x16\n" +
+	"\x06strict\x18\x04 \x01(\bR\x06strict\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12&\n" +
+	"\x0fmax_enum_values\x18\x06 \x01(\x05R\rmaxEnumValues\x12\x1d\n" +
+	"\n" +
+	"no_formats\x18\a \x01(\bR\tnoFormats\"f\n" +
+	"\x13InferSchemaResponse\x12\x16\n" +
+	"\x06schema\x18\x01 \x01(\tR\x06schema\x12!\n" +
+	"\fsample_count\x18\x02 \x01(\x05R\vsampleCount\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"r\n" +
+	"\x11JsonToCodeRequest\x12\x12\n" +
+	"\x04json\x18\x01 \x01(\tR\x04json\x12\x1b\n" +
+	"\ttype_name\x18\x02 \x01(\tR\btypeName\x12,\n" +
+	"\x06target\x18\x03 \x01(\x0e2\x14.privutil.CodeTargetR\x06target\">\n" +
+	"\x12JsonToCodeResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xa8\x02\n" +
+	"\x10DataQueryRequest\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\tR\x04data\x12,\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x14.privutil.DataFormatR\x06format\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x03 \x01(\tR\n" +
+	"expression\x123\n" +
+	"\blanguage\x18\x04 \x01(\x0e2\x17.privutil.QueryLanguageR\blanguage\x129\n" +
+	"\routput_format\x18\x05 \x01(\x0e2\x14.privutil.DataFormatR\foutputFormat\x12#\n" +
+	"\rcsv_delimiter\x18\x06 \x01(\tR\fcsvDelimiter\x12\x1d\n" +
+	"\n" +
+	"raw_output\x18\a \x01(\bR\trawOutput\"\x94\x01\n" +
+	"\x11DataQueryResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\tR\x06result\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x14\n" +
+	"\x05paths\x18\x03 \x03(\tR\x05paths\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12%\n" +
+	"\x0eerror_position\x18\x05 \x01(\x05R\rerrorPosition\"\xc8\x01\n" +
+	"\x0fDataDiffRequest\x12\x12\n" +
+	"\x04left\x18\x01 \x01(\tR\x04left\x12\x14\n" +
+	"\x05right\x18\x02 \x01(\tR\x05right\x125\n" +
+	"\vleft_format\x18\x03 \x01(\x0e2\x14.privutil.DataFormatR\n" +
+	"leftFormat\x127\n" +
+	"\fright_format\x18\x04 \x01(\x0e2\x14.privutil.DataFormatR\vrightFormat\x12\x1b\n" +
+	"\tarray_key\x18\x05 \x01(\tR\barrayKey\"~\n" +
+	"\n" +
+	"DataChange\x12\x0e\n" +
+	"\x02op\x18\x01 \x01(\tR\x02op\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x1b\n" +
+	"\told_value\x18\x04 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\x05 \x01(\tR\bnewValue\"\xc8\x01\n" +
+	"\x10DataDiffResponse\x12\x14\n" +
+	"\x05equal\x18\x01 \x01(\bR\x05equal\x12\x18\n" +
+	"\asummary\x18\x02 \x01(\tR\asummary\x12\x1d\n" +
+	"\n" +
+	"json_patch\x18\x03 \x01(\tR\tjsonPatch\x12\x1f\n" +
+	"\vmerge_patch\x18\x04 \x01(\tR\n" +
+	"mergePatch\x12.\n" +
+	"\achanges\x18\x05 \x03(\v2\x14.privutil.DataChangeR\achanges\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"\xa6\x01\n" +
+	"\x10DataPatchRequest\x12\x1a\n" +
+	"\bdocument\x18\x01 \x01(\tR\bdocument\x12,\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x14.privutil.DataFormatR\x06format\x12\x14\n" +
+	"\x05patch\x18\x03 \x01(\tR\x05patch\x122\n" +
+	"\n" +
+	"patch_type\x18\x04 \x01(\x0e2\x13.privutil.PatchTypeR\tpatchType\"A\n" +
+	"\x11DataPatchResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\tR\x06result\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"e\n" +
+	"\x10XmlFormatRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x16\n" +
+	"\x06indent\x18\x02 \x01(\tR\x06indent\x12%\n" +
+	"\x0estrip_comments\x18\x03 \x01(\bR\rstripComments\"z\n" +
+	"\fXmlNamespace\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x10\n" +
+	"\x03uri\x18\x02 \x01(\tR\x03uri\x12\x18\n" +
+	"\aelement\x18\x03 \x01(\tR\aelement\x12\x12\n" +
+	"\x04line\x18\x04 \x01(\x05R\x04line\x12\x12\n" +
+	"\x04uses\x18\x05 \x01(\x05R\x04uses\"\xb7\x01\n" +
+	"\x11XmlFormatResponse\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x126\n" +
+	"\n" +
+	"namespaces\x18\x02 \x03(\v2\x16.privutil.XmlNamespaceR\n" +
+	"namespaces\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"error_line\x18\x04 \x01(\x05R\terrorLine\x12!\n" +
+	"\ferror_column\x18\x05 \x01(\x05R\verrorColumn\"`\n" +
+	"\fXPathRequest\x12\x10\n" +
+	"\x03xml\x18\x01 \x01(\tR\x03xml\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x02 \x01(\tR\n" +
+	"expression\x12\x1e\n" +
+	"\n" +
+	"namespaces\x18\x03 \x03(\tR\n" +
+	"namespaces\"o\n" +
+	"\tXPathNode\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x10\n" +
+	"\x03xml\x18\x04 \x01(\tR\x03xml\x12\x12\n" +
+	"\x04line\x18\x05 \x01(\x05R\x04line\"\xc4\x01\n" +
+	"\rXPathResponse\x12\x1f\n" +
+	"\vresult_type\x18\x01 \x01(\tR\n" +
+	"resultType\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12)\n" +
+	"\x05nodes\x18\x03 \x03(\v2\x13.privutil.XPathNodeR\x05nodes\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12%\n" +
+	"\x0eerror_position\x18\x06 \x01(\x05R\rerrorPosition\"8\n" +
+	"\x12XmlValidateRequest\x12\x10\n" +
+	"\x03xml\x18\x01 \x01(\tR\x03xml\x12\x10\n" +
+	"\x03xsd\x18\x02 \x01(\tR\x03xsd\"d\n" +
+	"\bXmlIssue\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x16\n" +
+	"\x06column\x18\x02 \x01(\x05R\x06column\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x9f\x01\n" +
+	"\x13XmlValidateResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x14\n" +
+	"\x05roots\x18\x02 \x03(\tR\x05roots\x12*\n" +
+	"\x06issues\x18\x03 \x03(\v2\x12.privutil.XmlIssueR\x06issues\x12\x1a\n" +
+	"\bwarnings\x18\x04 \x03(\tR\bwarnings\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\x9a\x01\n" +
+	"\x15ProtobufDecodeRequest\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\tR\x04data\x12\x1a\n" +
+	"\bencoding\x18\x02 \x01(\tR\bencoding\x12\x16\n" +
+	"\x06schema\x18\x03 \x01(\tR\x06schema\x12!\n" +
+	"\fmessage_type\x18\x04 \x01(\tR\vmessageType\x12\x16\n" +
+	"\x06encode\x18\x05 \x01(\bR\x06encode\"\xf2\x01\n" +
+	"\rProtobufField\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x14\n" +
+	"\x05depth\x18\x02 \x01(\x05R\x05depth\x12\x16\n" +
+	"\x06number\x18\x03 \x01(\x05R\x06number\x12\x1b\n" +
+	"\twire_type\x18\x04 \x01(\tR\bwireType\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\x12\x16\n" +
+	"\x06length\x18\x06 \x01(\x05R\x06length\x12\x12\n" +
+	"\x04kind\x18\a \x01(\tR\x04kind\x12\x14\n" +
+	"\x05value\x18\b \x01(\tR\x05value\x12(\n" +
+	"\x0finterpretations\x18\t \x03(\tR\x0finterpretations\"\xde\x01\n" +
+	"\x16ProtobufDecodeResponse\x12\x12\n" +
+	"\x04json\x18\x01 \x01(\tR\x04json\x12\x16\n" +
+	"\x06base64\x18\x02 \x01(\tR\x06base64\x12\x10\n" +
+	"\x03hex\x18\x03 \x01(\tR\x03hex\x12/\n" +
+	"\x06fields\x18\x04 \x03(\v2\x17.privutil.ProtobufFieldR\x06fields\x12#\n" +
+	"\rmessage_types\x18\x05 \x03(\tR\fmessageTypes\x12\x1a\n" +
+	"\bwarnings\x18\x06 \x03(\tR\bwarnings\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\"V\n" +
+	"\x14ColorContrastRequest\x12\x1e\n" +
+	"\n" +
+	"foreground\x18\x01 \x01(\tR\n" +
+	"foreground\x12\x1e\n" +
+	"\n" +
+	"background\x18\x02 \x01(\tR\n" +
+	"background\"\xcb\x02\n" +
+	"\x15ColorContrastResponse\x12\x14\n" +
+	"\x05ratio\x18\x01 \x01(\x01R\x05ratio\x12\x0e\n" +
+	"\x02aa\x18\x02 \x01(\bR\x02aa\x12\x19\n" +
+	"\baa_large\x18\x03 \x01(\bR\aaaLarge\x12\x10\n" +
+	"\x03aaa\x18\x04 \x01(\bR\x03aaa\x12\x1b\n" +
+	"\taaa_large\x18\x05 \x01(\bR\baaaLarge\x12#\n" +
+	"\rsuggestion_aa\x18\x06 \x01(\tR\fsuggestionAa\x12.\n" +
+	"\x13suggestion_aa_ratio\x18\a \x01(\x01R\x11suggestionAaRatio\x12%\n" +
+	"\x0esuggestion_aaa\x18\b \x01(\tR\rsuggestionAaa\x120\n" +
+	"\x14suggestion_aaa_ratio\x18\t \x01(\x01R\x12suggestionAaaRatio\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\"g\n" +
+	"\x13ColorPaletteRequest\x12\x14\n" +
+	"\x05color\x18\x01 \x01(\tR\x05color\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05steps\x18\x03 \x01(\x05R\x05steps\x12\x10\n" +
+	"\x03end\x18\x04 \x01(\tR\x03end\"^\n" +
+	"\fPaletteColor\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x10\n" +
+	"\x03hex\x18\x02 \x01(\tR\x03hex\x12\x10\n" +
+	"\x03rgb\x18\x03 \x01(\tR\x03rgb\x12\x14\n" +
+	"\x05oklch\x18\x04 \x01(\tR\x05oklch\"\\\n" +
+	"\x14ColorPaletteResponse\x12.\n" +
+	"\x06colors\x18\x01 \x03(\v2\x16.privutil.PaletteColorR\x06colors\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"i\n" +
+	"\x15ColorBlindnessRequest\x12\x16\n" +
+	"\x06colors\x18\x01 \x03(\tR\x06colors\x12\x1c\n" +
+	"\tthreshold\x18\x02 \x01(\x01R\tthreshold\x12\x1a\n" +
+	"\bseverity\x18\x03 \x01(\x01R\bseverity\"G\n" +
+	"\rCvdSimulation\x12\x1e\n" +
+	"\n" +
+	"deficiency\x18\x01 \x01(\tR\n" +
+	"deficiency\x12\x16\n" +
+	"\x06colors\x18\x02 \x03(\tR\x06colors\"\xaa\x01\n" +
+	"\vCvdConflict\x12\x1e\n" +
+	"\n" +
+	"deficiency\x18\x01 \x01(\tR\n" +
+	"deficiency\x12\x17\n" +
+	"\aindex_a\x18\x02 \x01(\x05R\x06indexA\x12\x17\n" +
+	"\aindex_b\x18\x03 \x01(\x05R\x06indexB\x12\x17\n" +
+	"\acolor_a\x18\x04 \x01(\tR\x06colorA\x12\x17\n" +
+	"\acolor_b\x18\x05 \x01(\tR\x06colorB\x12\x17\n" +
+	"\adelta_e\x18\x06 \x01(\x01R\x06deltaE\"\x9e\x01\n" +
+	"\x16ColorBlindnessResponse\x129\n" +
+	"\vsimulations\x18\x01 \x03(\v2\x17.privutil.CvdSimulationR\vsimulations\x123\n" +
+	"\tconflicts\x18\x02 \x03(\v2\x15.privutil.CvdConflictR\tconflicts\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\xcb\x01\n" +
+	"\x11TableQueryRequest\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\tR\x04data\x12,\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x14.privutil.DataFormatR\x06format\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x129\n" +
+	"\routput_format\x18\x04 \x01(\x0e2\x14.privutil.DataFormatR\foutputFormat\x12#\n" +
+	"\rcsv_delimiter\x18\x05 \x01(\tR\fcsvDelimiter\"\xbf\x01\n" +
+	"\x12TableQueryResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\tR\x06result\x12\x18\n" +
+	"\acolumns\x18\x02 \x03(\tR\acolumns\x12\x1b\n" +
+	"\trow_count\x18\x03 \x01(\x05R\browCount\x12\x1d\n" +
+	"\n" +
+	"input_rows\x18\x04 \x01(\x05R\tinputRows\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12%\n" +
+	"\x0eerror_position\x18\x06 \x01(\x05R\rerrorPosition\"\xa8\x02\n" +
+	"\rMerge3Request\x12\x12\n" +
+	"\x04base\x18\x01 \x01(\tR\x04base\x12\x12\n" +
+	"\x04mine\x18\x02 \x01(\tR\x04mine\x12\x16\n" +
+	"\x06theirs\x18\x03 \x01(\tR\x06theirs\x12'\n" +
+	"\x04mode\x18\x04 \x01(\x0e2\x13.privutil.MergeModeR\x04mode\x12,\n" +
+	"\x06format\x18\x05 \x01(\x0e2\x14.privutil.DataFormatR\x06format\x12\x1f\n" +
+	"\vdiff3_style\x18\x06 \x01(\bR\n" +
+	"diff3Style\x12\x1d\n" +
+	"\n" +
+	"mine_label\x18\a \x01(\tR\tmineLabel\x12\x1d\n" +
+	"\n" +
+	"base_label\x18\b \x01(\tR\tbaseLabel\x12!\n" +
+	"\ftheirs_label\x18\t \x01(\tR\vtheirsLabel\"\xb5\x01\n" +
+	"\rMergeConflict\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x1d\n" +
+	"\n" +
+	"base_start\x18\x02 \x01(\x05R\tbaseStart\x12\x1d\n" +
+	"\n" +
+	"base_lines\x18\x03 \x01(\x05R\tbaseLines\x12\x12\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path\x12\x12\n" +
+	"\x04base\x18\x05 \x01(\tR\x04base\x12\x12\n" +
+	"\x04mine\x18\x06 \x01(\tR\x04mine\x12\x16\n" +
+	"\x06theirs\x18\a \x01(\tR\x06theirs\"\x8b\x01\n" +
+	"\x0eMerge3Response\x12\x16\n" +
+	"\x06merged\x18\x01 \x01(\tR\x06merged\x12\x14\n" +
+	"\x05clean\x18\x02 \x01(\bR\x05clean\x125\n" +
+	"\tconflicts\x18\x03 \x03(\v2\x17.privutil.MergeConflictR\tconflicts\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xb2\x01\n" +
+	"\x11PatchApplyRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x14\n" +
+	"\x05patch\x18\x02 \x01(\tR\x05patch\x121\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x19.privutil.TextPatchFormatR\x06format\x12\x18\n" +
+	"\areverse\x18\x04 \x01(\bR\areverse\x12\x12\n" +
+	"\x04fuzz\x18\x05 \x01(\x05R\x04fuzz\x12\x12\n" +
+	"\x04file\x18\x06 \x01(\tR\x04file\"\x97\x01\n" +
+	"\x0fPatchHunkResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x16\n" +
+	"\x06header\x18\x02 \x01(\tR\x06header\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12\x12\n" +
+	"\x04fuzz\x18\x05 \x01(\x05R\x04fuzz\x12\x12\n" +
+	"\x04line\x18\x06 \x01(\x05R\x04line\"\xae\x01\n" +
+	"\x12PatchApplyResponse\x12\x16\n" +
+	"\x06result\x18\x01 \x01(\tR\x06result\x12\x14\n" +
+	"\x05clean\x18\x02 \x01(\bR\x05clean\x12/\n" +
+	"\x05hunks\x18\x03 \x03(\v2\x19.privutil.PatchHunkResultR\x05hunks\x12#\n" +
+	"\rreverse_patch\x18\x04 \x01(\tR\freversePatch\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"t\n" +
+	"\x13RegexExplainRequest\x12\x18\n" +
+	"\apattern\x18\x01 \x01(\tR\apattern\x12\x14\n" +
+	"\x05flags\x18\x02 \x01(\tR\x05flags\x12-\n" +
+	"\x06engine\x18\x03 \x01(\x0e2\x15.privutil.RegexEngineR\x06engine\"\xb0\x01\n" +
+	"\n" +
+	"RegexToken\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\x05R\x03end\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x120\n" +
+	"\bchildren\x18\x06 \x03(\v2\x14.privutil.RegexTokenR\bchildren\"d\n" +
+	"\fRegexWarning\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\x05R\x03end\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xc5\x01\n" +
+	"\x14RegexExplainResponse\x12(\n" +
+	"\x04tree\x18\x01 \x01(\v2\x14.privutil.RegexTokenR\x04tree\x12\x18\n" +
+	"\asummary\x18\x02 \x01(\tR\asummary\x12\x1f\n" +
+	"\vgroup_count\x18\x03 \x01(\x05R\n" +
+	"groupCount\x122\n" +
+	"\bwarnings\x18\x04 \x03(\v2\x16.privutil.RegexWarningR\bwarnings\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\x8f\x01\n" +
+	"\x14RegexGenerateRequest\x12\x18\n" +
+	"\apattern\x18\x01 \x01(\tR\apattern\x12\x14\n" +
+	"\x05flags\x18\x02 \x01(\tR\x05flags\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x1d\n" +
+	"\n" +
+	"max_repeat\x18\x04 \x01(\x05R\tmaxRepeat\x12\x12\n" +
+	"\x04seed\x18\x05 \x01(\x03R\x04seed\"S\n" +
+	"\rRegexNearMiss\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x16\n" +
+	"\x06change\x18\x03 \x01(\tR\x06change\"\xb3\x01\n" +
+	"\x15RegexGenerateResponse\x12\x18\n" +
+	"\amatches\x18\x01 \x03(\tR\amatches\x128\n" +
+	"\vnear_misses\x18\x02 \x03(\v2\x17.privutil.RegexNearMissR\n" +
+	"nearMisses\x12\x12\n" +
+	"\x04seed\x18\x03 \x01(\x03R\x04seed\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x1c\n" +
+	"\ttruncated\x18\x05 \x01(\bR\ttruncated*<\n" +
+	"\bDiffMode\x12\x12\n" +
+	"\x0eDIFF_CHARACTER\x10\x00\x12\r\n" +
+	"\tDIFF_WORD\x10\x01\x12\r\n" +
+	"\tDIFF_LINE\x10\x02*\xdd\x01\n" +
 	"\n" +
 	"DataFormat\x12\b\n" +
 	"\x04JSON\x10\x00\x12\b\n" +
 	"\x04YAML\x10\x01\x12\a\n" +
 	"\x03XML\x10\x02\x12\b\n" +
 	"\x04TOML\x10\x03\x12\a\n" +
-	"\x03CSV\x10\x04*m\n" +
+	"\x03CSV\x10\x04\x12\n" +
+	"\n" +
+	"\x06NDJSON\x10\x05\x12\a\n" +
+	"\x03TSV\x10\x06\x12\a\n" +
+	"\x03INI\x10\a\x12\a\n" +
+	"\x03ENV\x10\b\x12\x0e\n" +
+	"\n" +
+	"PROPERTIES\x10\t\x12\a\n" +
+	"\x03HCL\x10\n" +
+	"\x12\t\n" +
+	"\x05JSON5\x10\v\x12\v\n" +
+	"\aMSGPACK\x10\f\x12\b\n" +
+	"\x04CBOR\x10\r\x12\b\n" +
+	"\x04BSON\x10\x0e\x12\x12\n" +
+	"\x0eMARKDOWN_TABLE\x10\x0f\x12\x0e\n" +
+	"\n" +
+	"HTML_TABLE\x10\x10\x12\x0f\n" +
+	"\vASCII_TABLE\x10\x11*3\n" +
+	"\x0eBinaryEncoding\x12\x11\n" +
+	"\rBINARY_BASE64\x10\x00\x12\x0e\n" +
+	"\n" +
+	"BINARY_HEX\x10\x01*E\n" +
+	"\x0fBinaryJsonStyle\x12\x18\n" +
+	"\x14BINARY_JSON_EXTENDED\x10\x00\x12\x18\n" +
+	"\x14BINARY_JSON_PROTOBUF\x10\x01*Q\n" +
+	"\n" +
+	"CsvQuoting\x12\x15\n" +
+	"\x11CSV_QUOTE_MINIMAL\x10\x00\x12\x11\n" +
+	"\rCSV_QUOTE_ALL\x10\x01\x12\x19\n" +
+	"\x15CSV_QUOTE_NON_NUMERIC\x10\x02*J\n" +
+	"\vRegexEngine\x12\r\n" +
+	"\tREGEX_RE2\x10\x00\x12\x16\n" +
+	"\x12REGEX_BACKTRACKING\x10\x01\x12\x14\n" +
+	"\x10REGEX_ECMASCRIPT\x10\x02*c\n" +
+	"\n" +
+	"SqlDialect\x12\x10\n" +
+	"\fSQL_STANDARD\x10\x00\x12\x12\n" +
+	"\x0eSQL_POSTGRESQL\x10\x01\x12\r\n" +
+	"\tSQL_MYSQL\x10\x02\x12\x0e\n" +
+	"\n" +
+	"SQL_SQLITE\x10\x03\x12\x10\n" +
+	"\fSQL_BIGQUERY\x10\x04*[\n" +
+	"\x0eSqlKeywordCase\x12\x16\n" +
+	"\x12SQL_KEYWORDS_UPPER\x10\x00\x12\x16\n" +
+	"\x12SQL_KEYWORDS_LOWER\x10\x01\x12\x19\n" +
+	"\x15SQL_KEYWORDS_PRESERVE\x10\x02*6\n" +
+	"\fSqlLoadStyle\x12\x13\n" +
+	"\x0fSQL_LOAD_INSERT\x10\x00\x12\x11\n" +
+	"\rSQL_LOAD_COPY\x10\x01*m\n" +
 	"\n" +
 	"TextAction\x12\v\n" +
 	"\aSORT_AZ\x10\x00\x12\v\n" +
@@ -10562,7 +16679,34 @@ const file_proto_privutil_proto_rawDesc = "" +
 	"\tUNIT_AREA\x10\x03\x12\x0f\n" +
 	"\vUNIT_VOLUME\x10\x04\x12\x0e\n" +
 	"\n" +
-	"UNIT_SPEED\x10\x052\xc0)\n" +
+	"UNIT_SPEED\x10\x05*O\n" +
+	"\vSchemaDraft\x12\x11\n" +
+	"\rDRAFT_2020_12\x10\x00\x12\x11\n" +
+	"\rDRAFT_2019_09\x10\x01\x12\f\n" +
+	"\bDRAFT_07\x10\x02\x12\f\n" +
+	"\bDRAFT_04\x10\x03*\x9b\x01\n" +
+	"\n" +
+	"CodeTarget\x12\x13\n" +
+	"\x0fCODE_TYPESCRIPT\x10\x00\x12\f\n" +
+	"\bCODE_ZOD\x10\x01\x12\x13\n" +
+	"\x0fCODE_RUST_SERDE\x10\x02\x12\x19\n" +
+	"\x15CODE_PYTHON_DATACLASS\x10\x03\x12\x18\n" +
+	"\x14CODE_PYTHON_PYDANTIC\x10\x04\x12\x0f\n" +
+	"\vCODE_KOTLIN\x10\x05\x12\x0f\n" +
+	"\vCODE_PROTO3\x10\x06*1\n" +
+	"\rQueryLanguage\x12\x12\n" +
+	"\x0eQUERY_JSONPATH\x10\x00\x12\f\n" +
+	"\bQUERY_JQ\x10\x01*,\n" +
+	"\tPatchType\x12\x0e\n" +
+	"\n" +
+	"PATCH_JSON\x10\x00\x12\x0f\n" +
+	"\vPATCH_MERGE\x10\x01*2\n" +
+	"\tMergeMode\x12\x0f\n" +
+	"\vMERGE_LINES\x10\x00\x12\x14\n" +
+	"\x10MERGE_STRUCTURED\x10\x01*=\n" +
+	"\x0fTextPatchFormat\x12\x16\n" +
+	"\x12TEXT_PATCH_UNIFIED\x10\x00\x12\x12\n" +
+	"\x0eTEXT_PATCH_DMP\x10\x012\xa75\n" +
 	"\x0fPrivUtilService\x127\n" +
 	"\x04Diff\x12\x15.privutil.DiffRequest\x1a\x16.privutil.DiffResponse\"\x00\x12C\n" +
 	"\fBase64Encode\x12\x17.privutil.Base64Request\x1a\x18.privutil.Base64Response\"\x00\x12C\n" +
@@ -10572,7 +16716,8 @@ const file_proto_privutil_proto_rawDesc = "" +
 	"\aConvert\x12\x18.privutil.ConvertRequest\x1a\x19.privutil.ConvertResponse\"\x00\x12G\n" +
 	"\fValidateData\x12\x19.privutil.ValidateRequest\x1a\x1a.privutil.ValidateResponse\"\x00\x12?\n" +
 	"\fGenerateUuid\x12\x15.privutil.UuidRequest\x1a\x16.privutil.UuidResponse\"\x00\x12B\n" +
-	"\rGenerateLorem\x12\x16.privutil.LoremRequest\x1a\x17.privutil.LoremResponse\"\x00\x12@\n" +
+	"\rGenerateLorem\x12\x16.privutil.LoremRequest\x1a\x17.privutil.LoremResponse\"\x00\x12K\n" +
+	"\x10GenerateFakeData\x12\x19.privutil.FakeDataRequest\x1a\x1a.privutil.FakeDataResponse\"\x00\x12@\n" +
 	"\rCalculateHash\x12\x15.privutil.HashRequest\x1a\x16.privutil.HashResponse\"\x00\x12L\n" +
 	"\vTextInspect\x12\x1c.privutil.TextInspectRequest\x1a\x1d.privutil.TextInspectResponse\"\x00\x12U\n" +
 	"\x0eTextManipulate\x12\x1f.privutil.TextManipulateRequest\x1a .privutil.TextManipulateResponse\"\x00\x12<\n" +
@@ -10592,7 +16737,9 @@ const file_proto_privutil_proto_rawDesc = "" +
 	"\vCaseConvert\x12\x15.privutil.CaseRequest\x1a\x16.privutil.CaseResponse\"\x00\x12C\n" +
 	"\fStringEscape\x12\x17.privutil.EscapeRequest\x1a\x18.privutil.EscapeResponse\"\x00\x12M\n" +
 	"\x0eTextSimilarity\x12\x1b.privutil.SimilarityRequest\x1a\x1c.privutil.SimilarityResponse\"\x00\x12:\n" +
-	"\tSqlFormat\x12\x14.privutil.SqlRequest\x1a\x15.privutil.SqlResponse\"\x00\x125\n" +
+	"\tSqlFormat\x12\x14.privutil.SqlRequest\x1a\x15.privutil.SqlResponse\"\x00\x12F\n" +
+	"\tDataToSql\x12\x1a.privutil.DataToSqlRequest\x1a\x1b.privutil.DataToSqlResponse\"\x00\x12@\n" +
+	"\aSqlToGo\x12\x18.privutil.SqlToGoRequest\x1a\x19.privutil.SqlToGoResponse\"\x00\x125\n" +
 	"\x06IpCalc\x12\x13.privutil.IpRequest\x1a\x14.privutil.IpResponse\"\x00\x12K\n" +
 	"\x10GeneratePassword\x12\x19.privutil.PasswordRequest\x1a\x1a.privutil.PasswordResponse\"\x00\x12I\n" +
 	"\x12GenerateRsaKeyPair\x12\x17.privutil.RsaKeyRequest\x1a\x18.privutil.RsaKeyResponse\"\x00\x12L\n" +
@@ -10645,7 +16792,27 @@ const file_proto_privutil_proto_rawDesc = "" +
 	"TokenCount\x12\x1b.privutil.TokenCountRequest\x1a\x1c.privutil.TokenCountResponse\"\x00\x12I\n" +
 	"\n" +
 	"SpellCheck\x12\x1b.privutil.SpellCheckRequest\x1a\x1c.privutil.SpellCheckResponse\"\x00\x12U\n" +
-	"\x0eSpellLanguages\x12\x1f.privutil.SpellLanguagesRequest\x1a .privutil.SpellLanguagesResponse\"\x00B'Z%github.com/odinnordico/privutil/protob\x06proto3"
+	"\x0eSpellLanguages\x12\x1f.privutil.SpellLanguagesRequest\x1a .privutil.SpellLanguagesResponse\"\x00\x12L\n" +
+	"\vInferSchema\x12\x1c.privutil.InferSchemaRequest\x1a\x1d.privutil.InferSchemaResponse\"\x00\x12I\n" +
+	"\n" +
+	"JsonToCode\x12\x1b.privutil.JsonToCodeRequest\x1a\x1c.privutil.JsonToCodeResponse\"\x00\x12F\n" +
+	"\tDataQuery\x12\x1a.privutil.DataQueryRequest\x1a\x1b.privutil.DataQueryResponse\"\x00\x12C\n" +
+	"\bDataDiff\x12\x19.privutil.DataDiffRequest\x1a\x1a.privutil.DataDiffResponse\"\x00\x12F\n" +
+	"\tDataPatch\x12\x1a.privutil.DataPatchRequest\x1a\x1b.privutil.DataPatchResponse\"\x00\x12F\n" +
+	"\tXmlFormat\x12\x1a.privutil.XmlFormatRequest\x1a\x1b.privutil.XmlFormatResponse\"\x00\x12=\n" +
+	"\bXmlXPath\x12\x16.privutil.XPathRequest\x1a\x17.privutil.XPathResponse\"\x00\x12L\n" +
+	"\vXmlValidate\x12\x1c.privutil.XmlValidateRequest\x1a\x1d.privutil.XmlValidateResponse\"\x00\x12U\n" +
+	"\x0eProtobufDecode\x12\x1f.privutil.ProtobufDecodeRequest\x1a .privutil.ProtobufDecodeResponse\"\x00\x12R\n" +
+	"\rColorContrast\x12\x1e.privutil.ColorContrastRequest\x1a\x1f.privutil.ColorContrastResponse\"\x00\x12O\n" +
+	"\fColorPalette\x12\x1d.privutil.ColorPaletteRequest\x1a\x1e.privutil.ColorPaletteResponse\"\x00\x12U\n" +
+	"\x0eColorBlindness\x12\x1f.privutil.ColorBlindnessRequest\x1a .privutil.ColorBlindnessResponse\"\x00\x12I\n" +
+	"\n" +
+	"TableQuery\x12\x1b.privutil.TableQueryRequest\x1a\x1c.privutil.TableQueryResponse\"\x00\x12=\n" +
+	"\x06Merge3\x12\x17.privutil.Merge3Request\x1a\x18.privutil.Merge3Response\"\x00\x12I\n" +
+	"\n" +
+	"PatchApply\x12\x1b.privutil.PatchApplyRequest\x1a\x1c.privutil.PatchApplyResponse\"\x00\x12O\n" +
+	"\fRegexExplain\x12\x1d.privutil.RegexExplainRequest\x1a\x1e.privutil.RegexExplainResponse\"\x00\x12R\n" +
+	"\rRegexGenerate\x12\x1e.privutil.RegexGenerateRequest\x1a\x1f.privutil.RegexGenerateResponse\"\x00B'Z%github.com/odinnordico/privutil/protob\x06proto3"
 
 var (
 	file_proto_privutil_proto_rawDescOnce sync.Once
@@ -10659,344 +16826,506 @@ func file_proto_privutil_proto_rawDescGZIP() []byte {
 	return file_proto_privutil_proto_rawDescData
 }
 
-var file_proto_privutil_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_proto_privutil_proto_msgTypes = make([]protoimpl.MessageInfo, 152)
+var file_proto_privutil_proto_enumTypes = make([]protoimpl.EnumInfo, 19)
+var file_proto_privutil_proto_msgTypes = make([]protoimpl.MessageInfo, 211)
 var file_proto_privutil_proto_goTypes = []any{
-	(DataFormat)(0),                    // 0: privutil.DataFormat
-	(TextAction)(0),                    // 1: privutil.TextAction
-	(ListAction)(0),                    // 2: privutil.ListAction
-	(PercentMode)(0),                   // 3: privutil.PercentMode
-	(UnitCategory)(0),                  // 4: privutil.UnitCategory
-	(*DiffRequest)(nil),                // 5: privutil.DiffRequest
-	(*DiffResponse)(nil),               // 6: privutil.DiffResponse
-	(*Base64Request)(nil),              // 7: privutil.Base64Request
-	(*Base64Response)(nil),             // 8: privutil.Base64Response
-	(*JsonFormatRequest)(nil),          // 9: privutil.JsonFormatRequest
-	(*JsonFormatResponse)(nil),         // 10: privutil.JsonFormatResponse
-	(*ConvertRequest)(nil),             // 11: privutil.ConvertRequest
-	(*ConvertResponse)(nil),            // 12: privutil.ConvertResponse
-	(*ValidateRequest)(nil),            // 13: privutil.ValidateRequest
-	(*ValidateResponse)(nil),           // 14: privutil.ValidateResponse
-	(*UuidRequest)(nil),                // 15: privutil.UuidRequest
-	(*UuidResponse)(nil),               // 16: privutil.UuidResponse
-	(*LoremRequest)(nil),               // 17: privutil.LoremRequest
-	(*LoremResponse)(nil),              // 18: privutil.LoremResponse
-	(*HashRequest)(nil),                // 19: privutil.HashRequest
-	(*HashResponse)(nil),               // 20: privutil.HashResponse
-	(*TextRequest)(nil),                // 21: privutil.TextRequest
-	(*TextResponse)(nil),               // 22: privutil.TextResponse
-	(*TimeRequest)(nil),                // 23: privutil.TimeRequest
-	(*TimeResponse)(nil),               // 24: privutil.TimeResponse
-	(*JwtRequest)(nil),                 // 25: privutil.JwtRequest
-	(*JwtResponse)(nil),                // 26: privutil.JwtResponse
-	(*RegexRequest)(nil),               // 27: privutil.RegexRequest
-	(*RegexResponse)(nil),              // 28: privutil.RegexResponse
-	(*JsonToGoRequest)(nil),            // 29: privutil.JsonToGoRequest
-	(*JsonToGoResponse)(nil),           // 30: privutil.JsonToGoResponse
-	(*CronRequest)(nil),                // 31: privutil.CronRequest
-	(*CronResponse)(nil),               // 32: privutil.CronResponse
-	(*CertRequest)(nil),                // 33: privutil.CertRequest
-	(*CertResponse)(nil),               // 34: privutil.CertResponse
-	(*ColorRequest)(nil),               // 35: privutil.ColorRequest
-	(*ColorResponse)(nil),              // 36: privutil.ColorResponse
-	(*CaseRequest)(nil),                // 37: privutil.CaseRequest
-	(*CaseResponse)(nil),               // 38: privutil.CaseResponse
-	(*EscapeRequest)(nil),              // 39: privutil.EscapeRequest
-	(*EscapeResponse)(nil),             // 40: privutil.EscapeResponse
-	(*SimilarityRequest)(nil),          // 41: privutil.SimilarityRequest
-	(*SimilarityResponse)(nil),         // 42: privutil.SimilarityResponse
-	(*SqlRequest)(nil),                 // 43: privutil.SqlRequest
-	(*SqlResponse)(nil),                // 44: privutil.SqlResponse
-	(*IpRequest)(nil),                  // 45: privutil.IpRequest
-	(*IpResponse)(nil),                 // 46: privutil.IpResponse
-	(*TextInspectRequest)(nil),         // 47: privutil.TextInspectRequest
-	(*TextInspectResponse)(nil),        // 48: privutil.TextInspectResponse
-	(*TextManipulateRequest)(nil),      // 49: privutil.TextManipulateRequest
-	(*TextManipulateResponse)(nil),     // 50: privutil.TextManipulateResponse
-	(*PasswordRequest)(nil),            // 51: privutil.PasswordRequest
-	(*PasswordResponse)(nil),           // 52: privutil.PasswordResponse
-	(*RsaKeyRequest)(nil),              // 53: privutil.RsaKeyRequest
-	(*RsaKeyResponse)(nil),             // 54: privutil.RsaKeyResponse
-	(*BaseConvertRequest)(nil),         // 55: privutil.BaseConvertRequest
-	(*BaseConvertResponse)(nil),        // 56: privutil.BaseConvertResponse
-	(*ChmodRequest)(nil),               // 57: privutil.ChmodRequest
-	(*ChmodResponse)(nil),              // 58: privutil.ChmodResponse
-	(*Ipv4ConvertRequest)(nil),         // 59: privutil.Ipv4ConvertRequest
-	(*Ipv4ConvertResponse)(nil),        // 60: privutil.Ipv4ConvertResponse
-	(*Ipv4RangeRequest)(nil),           // 61: privutil.Ipv4RangeRequest
-	(*Ipv4RangeResponse)(nil),          // 62: privutil.Ipv4RangeResponse
-	(*PortRequest)(nil),                // 63: privutil.PortRequest
-	(*PortResponse)(nil),               // 64: privutil.PortResponse
-	(*MacRequest)(nil),                 // 65: privutil.MacRequest
-	(*MacResponse)(nil),                // 66: privutil.MacResponse
-	(*HmacRequest)(nil),                // 67: privutil.HmacRequest
-	(*HmacResponse)(nil),               // 68: privutil.HmacResponse
-	(*OtpRequest)(nil),                 // 69: privutil.OtpRequest
-	(*OtpResponse)(nil),                // 70: privutil.OtpResponse
-	(*OtpValidateRequest)(nil),         // 71: privutil.OtpValidateRequest
-	(*OtpValidateResponse)(nil),        // 72: privutil.OtpValidateResponse
-	(*UlidRequest)(nil),                // 73: privutil.UlidRequest
-	(*UlidResponse)(nil),               // 74: privutil.UlidResponse
-	(*CaesarRequest)(nil),              // 75: privutil.CaesarRequest
-	(*CaesarResponse)(nil),             // 76: privutil.CaesarResponse
-	(*TextEncodeRequest)(nil),          // 77: privutil.TextEncodeRequest
-	(*TextEncodeResponse)(nil),         // 78: privutil.TextEncodeResponse
-	(*MorseRequest)(nil),               // 79: privutil.MorseRequest
-	(*MorseResponse)(nil),              // 80: privutil.MorseResponse
-	(*BasicAuthRequest)(nil),           // 81: privutil.BasicAuthRequest
-	(*BasicAuthResponse)(nil),          // 82: privutil.BasicAuthResponse
-	(*SlugifyRequest)(nil),             // 83: privutil.SlugifyRequest
-	(*SlugifyResponse)(nil),            // 84: privutil.SlugifyResponse
-	(*HiddenCharsRequest)(nil),         // 85: privutil.HiddenCharsRequest
-	(*HiddenCharInfo)(nil),             // 86: privutil.HiddenCharInfo
-	(*HiddenCharsResponse)(nil),        // 87: privutil.HiddenCharsResponse
-	(*TextReplaceRequest)(nil),         // 88: privutil.TextReplaceRequest
-	(*TextReplaceResponse)(nil),        // 89: privutil.TextReplaceResponse
-	(*StringObfuscateRequest)(nil),     // 90: privutil.StringObfuscateRequest
-	(*StringObfuscateResponse)(nil),    // 91: privutil.StringObfuscateResponse
-	(*NumeronymRequest)(nil),           // 92: privutil.NumeronymRequest
-	(*NumeronymResponse)(nil),          // 93: privutil.NumeronymResponse
-	(*NatoRequest)(nil),                // 94: privutil.NatoRequest
-	(*NatoResponse)(nil),               // 95: privutil.NatoResponse
-	(*ListRequest)(nil),                // 96: privutil.ListRequest
-	(*ListFreqItem)(nil),               // 97: privutil.ListFreqItem
-	(*ListResponse)(nil),               // 98: privutil.ListResponse
-	(*MathVariable)(nil),               // 99: privutil.MathVariable
-	(*MathEvalRequest)(nil),            // 100: privutil.MathEvalRequest
-	(*MathEvalResponse)(nil),           // 101: privutil.MathEvalResponse
-	(*PercentageRequest)(nil),          // 102: privutil.PercentageRequest
-	(*PercentageResponse)(nil),         // 103: privutil.PercentageResponse
-	(*TempConvertRequest)(nil),         // 104: privutil.TempConvertRequest
-	(*TempConvertResponse)(nil),        // 105: privutil.TempConvertResponse
-	(*UnitConvertRequest)(nil),         // 106: privutil.UnitConvertRequest
-	(*UnitResult)(nil),                 // 107: privutil.UnitResult
-	(*UnitConvertResponse)(nil),        // 108: privutil.UnitConvertResponse
-	(*DateDiffRequest)(nil),            // 109: privutil.DateDiffRequest
-	(*DateDiffResponse)(nil),           // 110: privutil.DateDiffResponse
-	(*LeapYearRequest)(nil),            // 111: privutil.LeapYearRequest
-	(*LeapYearEntry)(nil),              // 112: privutil.LeapYearEntry
-	(*LeapYearResponse)(nil),           // 113: privutil.LeapYearResponse
-	(*DateAddRequest)(nil),             // 114: privutil.DateAddRequest
-	(*DateAddResponse)(nil),            // 115: privutil.DateAddResponse
-	(*DateFormatRequest)(nil),          // 116: privutil.DateFormatRequest
-	(*DateFormatEntry)(nil),            // 117: privutil.DateFormatEntry
-	(*DateFormatResponse)(nil),         // 118: privutil.DateFormatResponse
-	(*DateInfoRequest)(nil),            // 119: privutil.DateInfoRequest
-	(*DateInfoResponse)(nil),           // 120: privutil.DateInfoResponse
-	(*QueryParam)(nil),                 // 121: privutil.QueryParam
-	(*UrlParseRequest)(nil),            // 122: privutil.UrlParseRequest
-	(*UrlParseResponse)(nil),           // 123: privutil.UrlParseResponse
-	(*UserAgentParseRequest)(nil),      // 124: privutil.UserAgentParseRequest
-	(*UAParsedField)(nil),              // 125: privutil.UAParsedField
-	(*UserAgentParseResponse)(nil),     // 126: privutil.UserAgentParseResponse
-	(*HttpStatusSearchRequest)(nil),    // 127: privutil.HttpStatusSearchRequest
-	(*HttpStatusEntry)(nil),            // 128: privutil.HttpStatusEntry
-	(*HttpStatusSearchResponse)(nil),   // 129: privutil.HttpStatusSearchResponse
-	(*MimeLookupRequest)(nil),          // 130: privutil.MimeLookupRequest
-	(*MimeEntry)(nil),                  // 131: privutil.MimeEntry
-	(*MimeLookupResponse)(nil),         // 132: privutil.MimeLookupResponse
-	(*DockerRunToComposeRequest)(nil),  // 133: privutil.DockerRunToComposeRequest
-	(*DockerRunToComposeResponse)(nil), // 134: privutil.DockerRunToComposeResponse
-	(*GitCheatSheetRequest)(nil),       // 135: privutil.GitCheatSheetRequest
-	(*GitCmd)(nil),                     // 136: privutil.GitCmd
-	(*GitCmdCategory)(nil),             // 137: privutil.GitCmdCategory
-	(*GitCheatSheetResponse)(nil),      // 138: privutil.GitCheatSheetResponse
-	(*SvgOptimizeRequest)(nil),         // 139: privutil.SvgOptimizeRequest
-	(*SvgOptimizeResponse)(nil),        // 140: privutil.SvgOptimizeResponse
-	(*ExifReadRequest)(nil),            // 141: privutil.ExifReadRequest
-	(*ExifField)(nil),                  // 142: privutil.ExifField
-	(*ExifReadResponse)(nil),           // 143: privutil.ExifReadResponse
-	(*FileToBase64Request)(nil),        // 144: privutil.FileToBase64Request
-	(*FileToBase64Response)(nil),       // 145: privutil.FileToBase64Response
-	(*Base64ToFileRequest)(nil),        // 146: privutil.Base64ToFileRequest
-	(*Base64ToFileResponse)(nil),       // 147: privutil.Base64ToFileResponse
-	(*TokenCountRequest)(nil),          // 148: privutil.TokenCountRequest
-	(*TokenStrategy)(nil),              // 149: privutil.TokenStrategy
-	(*TokenCountResponse)(nil),         // 150: privutil.TokenCountResponse
-	(*SpellCheckRequest)(nil),          // 151: privutil.SpellCheckRequest
-	(*SpellIssue)(nil),                 // 152: privutil.SpellIssue
-	(*SpellCheckResponse)(nil),         // 153: privutil.SpellCheckResponse
-	(*SpellLanguagesRequest)(nil),      // 154: privutil.SpellLanguagesRequest
-	(*SpellLanguage)(nil),              // 155: privutil.SpellLanguage
-	(*SpellLanguagesResponse)(nil),     // 156: privutil.SpellLanguagesResponse
+	(DiffMode)(0),                      // 0: privutil.DiffMode
+	(DataFormat)(0),                    // 1: privutil.DataFormat
+	(BinaryEncoding)(0),                // 2: privutil.BinaryEncoding
+	(BinaryJsonStyle)(0),               // 3: privutil.BinaryJsonStyle
+	(CsvQuoting)(0),                    // 4: privutil.CsvQuoting
+	(RegexEngine)(0),                   // 5: privutil.RegexEngine
+	(SqlDialect)(0),                    // 6: privutil.SqlDialect
+	(SqlKeywordCase)(0),                // 7: privutil.SqlKeywordCase
+	(SqlLoadStyle)(0),                  // 8: privutil.SqlLoadStyle
+	(TextAction)(0),                    // 9: privutil.TextAction
+	(ListAction)(0),                    // 10: privutil.ListAction
+	(PercentMode)(0),                   // 11: privutil.PercentMode
+	(UnitCategory)(0),                  // 12: privutil.UnitCategory
+	(SchemaDraft)(0),                   // 13: privutil.SchemaDraft
+	(CodeTarget)(0),                    // 14: privutil.CodeTarget
+	(QueryLanguage)(0),                 // 15: privutil.QueryLanguage
+	(PatchType)(0),                     // 16: privutil.PatchType
+	(MergeMode)(0),                     // 17: privutil.MergeMode
+	(TextPatchFormat)(0),               // 18: privutil.TextPatchFormat
+	(*DiffRequest)(nil),                // 19: privutil.DiffRequest
+	(*DiffResponse)(nil),               // 20: privutil.DiffResponse
+	(*DiffHunk)(nil),                   // 21: privutil.DiffHunk
+	(*DiffRow)(nil),                    // 22: privutil.DiffRow
+	(*Base64Request)(nil),              // 23: privutil.Base64Request
+	(*Base64Response)(nil),             // 24: privutil.Base64Response
+	(*JsonFormatRequest)(nil),          // 25: privutil.JsonFormatRequest
+	(*JsonFormatResponse)(nil),         // 26: privutil.JsonFormatResponse
+	(*ConvertRequest)(nil),             // 27: privutil.ConvertRequest
+	(*ConvertResponse)(nil),            // 28: privutil.ConvertResponse
+	(*ValidateRequest)(nil),            // 29: privutil.ValidateRequest
+	(*ValidateResponse)(nil),           // 30: privutil.ValidateResponse
+	(*ValidationIssue)(nil),            // 31: privutil.ValidationIssue
+	(*UuidRequest)(nil),                // 32: privutil.UuidRequest
+	(*UuidResponse)(nil),               // 33: privutil.UuidResponse
+	(*LoremRequest)(nil),               // 34: privutil.LoremRequest
+	(*LoremResponse)(nil),              // 35: privutil.LoremResponse
+	(*FakeDataRequest)(nil),            // 36: privutil.FakeDataRequest
+	(*FakeDataResponse)(nil),           // 37: privutil.FakeDataResponse
+	(*HashRequest)(nil),                // 38: privutil.HashRequest
+	(*HashResponse)(nil),               // 39: privutil.HashResponse
+	(*TextRequest)(nil),                // 40: privutil.TextRequest
+	(*TextResponse)(nil),               // 41: privutil.TextResponse
+	(*TimeRequest)(nil),                // 42: privutil.TimeRequest
+	(*TimeResponse)(nil),               // 43: privutil.TimeResponse
+	(*JwtRequest)(nil),                 // 44: privutil.JwtRequest
+	(*JwtResponse)(nil),                // 45: privutil.JwtResponse
+	(*RegexRequest)(nil),               // 46: privutil.RegexRequest
+	(*RegexGroup)(nil),                 // 47: privutil.RegexGroup
+	(*RegexMatchDetail)(nil),           // 48: privutil.RegexMatchDetail
+	(*RegexResponse)(nil),              // 49: privutil.RegexResponse
+	(*JsonToGoRequest)(nil),            // 50: privutil.JsonToGoRequest
+	(*JsonToGoResponse)(nil),           // 51: privutil.JsonToGoResponse
+	(*CronRequest)(nil),                // 52: privutil.CronRequest
+	(*CronResponse)(nil),               // 53: privutil.CronResponse
+	(*CertRequest)(nil),                // 54: privutil.CertRequest
+	(*CertResponse)(nil),               // 55: privutil.CertResponse
+	(*ColorRequest)(nil),               // 56: privutil.ColorRequest
+	(*ColorResponse)(nil),              // 57: privutil.ColorResponse
+	(*CaseRequest)(nil),                // 58: privutil.CaseRequest
+	(*CaseResponse)(nil),               // 59: privutil.CaseResponse
+	(*EscapeRequest)(nil),              // 60: privutil.EscapeRequest
+	(*EscapeResponse)(nil),             // 61: privutil.EscapeResponse
+	(*SimilarityRequest)(nil),          // 62: privutil.SimilarityRequest
+	(*SimilarityResponse)(nil),         // 63: privutil.SimilarityResponse
+	(*SqlRequest)(nil),                 // 64: privutil.SqlRequest
+	(*SqlResponse)(nil),                // 65: privutil.SqlResponse
+	(*DataToSqlRequest)(nil),           // 66: privutil.DataToSqlRequest
+	(*SqlColumn)(nil),                  // 67: privutil.SqlColumn
+	(*DataToSqlResponse)(nil),          // 68: privutil.DataToSqlResponse
+	(*SqlToGoRequest)(nil),             // 69: privutil.SqlToGoRequest
+	(*SqlToGoResponse)(nil),            // 70: privutil.SqlToGoResponse
+	(*IpRequest)(nil),                  // 71: privutil.IpRequest
+	(*IpResponse)(nil),                 // 72: privutil.IpResponse
+	(*TextInspectRequest)(nil),         // 73: privutil.TextInspectRequest
+	(*TextInspectResponse)(nil),        // 74: privutil.TextInspectResponse
+	(*TextManipulateRequest)(nil),      // 75: privutil.TextManipulateRequest
+	(*TextManipulateResponse)(nil),     // 76: privutil.TextManipulateResponse
+	(*PasswordRequest)(nil),            // 77: privutil.PasswordRequest
+	(*PasswordResponse)(nil),           // 78: privutil.PasswordResponse
+	(*RsaKeyRequest)(nil),              // 79: privutil.RsaKeyRequest
+	(*RsaKeyResponse)(nil),             // 80: privutil.RsaKeyResponse
+	(*BaseConvertRequest)(nil),         // 81: privutil.BaseConvertRequest
+	(*BaseConvertResponse)(nil),        // 82: privutil.BaseConvertResponse
+	(*ChmodRequest)(nil),               // 83: privutil.ChmodRequest
+	(*ChmodResponse)(nil),              // 84: privutil.ChmodResponse
+	(*Ipv4ConvertRequest)(nil),         // 85: privutil.Ipv4ConvertRequest
+	(*Ipv4ConvertResponse)(nil),        // 86: privutil.Ipv4ConvertResponse
+	(*Ipv4RangeRequest)(nil),           // 87: privutil.Ipv4RangeRequest
+	(*Ipv4RangeResponse)(nil),          // 88: privutil.Ipv4RangeResponse
+	(*PortRequest)(nil),                // 89: privutil.PortRequest
+	(*PortResponse)(nil),               // 90: privutil.PortResponse
+	(*MacRequest)(nil),                 // 91: privutil.MacRequest
+	(*MacResponse)(nil),                // 92: privutil.MacResponse
+	(*HmacRequest)(nil),                // 93: privutil.HmacRequest
+	(*HmacResponse)(nil),               // 94: privutil.HmacResponse
+	(*OtpRequest)(nil),                 // 95: privutil.OtpRequest
+	(*OtpResponse)(nil),                // 96: privutil.OtpResponse
+	(*OtpValidateRequest)(nil),         // 97: privutil.OtpValidateRequest
+	(*OtpValidateResponse)(nil),        // 98: privutil.OtpValidateResponse
+	(*UlidRequest)(nil),                // 99: privutil.UlidRequest
+	(*UlidResponse)(nil),               // 100: privutil.UlidResponse
+	(*CaesarRequest)(nil),              // 101: privutil.CaesarRequest
+	(*CaesarResponse)(nil),             // 102: privutil.CaesarResponse
+	(*TextEncodeRequest)(nil),          // 103: privutil.TextEncodeRequest
+	(*TextEncodeResponse)(nil),         // 104: privutil.TextEncodeResponse
+	(*MorseRequest)(nil),               // 105: privutil.MorseRequest
+	(*MorseResponse)(nil),              // 106: privutil.MorseResponse
+	(*BasicAuthRequest)(nil),           // 107: privutil.BasicAuthRequest
+	(*BasicAuthResponse)(nil),          // 108: privutil.BasicAuthResponse
+	(*SlugifyRequest)(nil),             // 109: privutil.SlugifyRequest
+	(*SlugifyResponse)(nil),            // 110: privutil.SlugifyResponse
+	(*HiddenCharsRequest)(nil),         // 111: privutil.HiddenCharsRequest
+	(*HiddenCharInfo)(nil),             // 112: privutil.HiddenCharInfo
+	(*HiddenCharsResponse)(nil),        // 113: privutil.HiddenCharsResponse
+	(*TextReplaceRequest)(nil),         // 114: privutil.TextReplaceRequest
+	(*TextReplaceResponse)(nil),        // 115: privutil.TextReplaceResponse
+	(*StringObfuscateRequest)(nil),     // 116: privutil.StringObfuscateRequest
+	(*StringObfuscateResponse)(nil),    // 117: privutil.StringObfuscateResponse
+	(*NumeronymRequest)(nil),           // 118: privutil.NumeronymRequest
+	(*NumeronymResponse)(nil),          // 119: privutil.NumeronymResponse
+	(*NatoRequest)(nil),                // 120: privutil.NatoRequest
+	(*NatoResponse)(nil),               // 121: privutil.NatoResponse
+	(*ListRequest)(nil),                // 122: privutil.ListRequest
+	(*ListFreqItem)(nil),               // 123: privutil.ListFreqItem
+	(*ListResponse)(nil),               // 124: privutil.ListResponse
+	(*MathVariable)(nil),               // 125: privutil.MathVariable
+	(*MathEvalRequest)(nil),            // 126: privutil.MathEvalRequest
+	(*MathEvalResponse)(nil),           // 127: privutil.MathEvalResponse
+	(*PercentageRequest)(nil),          // 128: privutil.PercentageRequest
+	(*PercentageResponse)(nil),         // 129: privutil.PercentageResponse
+	(*TempConvertRequest)(nil),         // 130: privutil.TempConvertRequest
+	(*TempConvertResponse)(nil),        // 131: privutil.TempConvertResponse
+	(*UnitConvertRequest)(nil),         // 132: privutil.UnitConvertRequest
+	(*UnitResult)(nil),                 // 133: privutil.UnitResult
+	(*UnitConvertResponse)(nil),        // 134: privutil.UnitConvertResponse
+	(*DateDiffRequest)(nil),            // 135: privutil.DateDiffRequest
+	(*DateDiffResponse)(nil),           // 136: privutil.DateDiffResponse
+	(*LeapYearRequest)(nil),            // 137: privutil.LeapYearRequest
+	(*LeapYearEntry)(nil),              // 138: privutil.LeapYearEntry
+	(*LeapYearResponse)(nil),           // 139: privutil.LeapYearResponse
+	(*DateAddRequest)(nil),             // 140: privutil.DateAddRequest
+	(*DateAddResponse)(nil),            // 141: privutil.DateAddResponse
+	(*DateFormatRequest)(nil),          // 142: privutil.DateFormatRequest
+	(*DateFormatEntry)(nil),            // 143: privutil.DateFormatEntry
+	(*DateFormatResponse)(nil),         // 144: privutil.DateFormatResponse
+	(*DateInfoRequest)(nil),            // 145: privutil.DateInfoRequest
+	(*DateInfoResponse)(nil),           // 146: privutil.DateInfoResponse
+	(*QueryParam)(nil),                 // 147: privutil.QueryParam
+	(*UrlParseRequest)(nil),            // 148: privutil.UrlParseRequest
+	(*UrlParseResponse)(nil),           // 149: privutil.UrlParseResponse
+	(*UserAgentParseRequest)(nil),      // 150: privutil.UserAgentParseRequest
+	(*UAParsedField)(nil),              // 151: privutil.UAParsedField
+	(*UserAgentParseResponse)(nil),     // 152: privutil.UserAgentParseResponse
+	(*HttpStatusSearchRequest)(nil),    // 153: privutil.HttpStatusSearchRequest
+	(*HttpStatusEntry)(nil),            // 154: privutil.HttpStatusEntry
+	(*HttpStatusSearchResponse)(nil),   // 155: privutil.HttpStatusSearchResponse
+	(*MimeLookupRequest)(nil),          // 156: privutil.MimeLookupRequest
+	(*MimeEntry)(nil),                  // 157: privutil.MimeEntry
+	(*MimeLookupResponse)(nil),         // 158: privutil.MimeLookupResponse
+	(*DockerRunToComposeRequest)(nil),  // 159: privutil.DockerRunToComposeRequest
+	(*DockerRunToComposeResponse)(nil), // 160: privutil.DockerRunToComposeResponse
+	(*GitCheatSheetRequest)(nil),       // 161: privutil.GitCheatSheetRequest
+	(*GitCmd)(nil),                     // 162: privutil.GitCmd
+	(*GitCmdCategory)(nil),             // 163: privutil.GitCmdCategory
+	(*GitCheatSheetResponse)(nil),      // 164: privutil.GitCheatSheetResponse
+	(*SvgOptimizeRequest)(nil),         // 165: privutil.SvgOptimizeRequest
+	(*SvgOptimizeResponse)(nil),        // 166: privutil.SvgOptimizeResponse
+	(*ExifReadRequest)(nil),            // 167: privutil.ExifReadRequest
+	(*ExifField)(nil),                  // 168: privutil.ExifField
+	(*ExifReadResponse)(nil),           // 169: privutil.ExifReadResponse
+	(*FileToBase64Request)(nil),        // 170: privutil.FileToBase64Request
+	(*FileToBase64Response)(nil),       // 171: privutil.FileToBase64Response
+	(*Base64ToFileRequest)(nil),        // 172: privutil.Base64ToFileRequest
+	(*Base64ToFileResponse)(nil),       // 173: privutil.Base64ToFileResponse
+	(*TokenCountRequest)(nil),          // 174: privutil.TokenCountRequest
+	(*TokenStrategy)(nil),              // 175: privutil.TokenStrategy
+	(*TokenCountResponse)(nil),         // 176: privutil.TokenCountResponse
+	(*SpellCheckRequest)(nil),          // 177: privutil.SpellCheckRequest
+	(*SpellIssue)(nil),                 // 178: privutil.SpellIssue
+	(*SpellCheckResponse)(nil),         // 179: privutil.SpellCheckResponse
+	(*SpellLanguagesRequest)(nil),      // 180: privutil.SpellLanguagesRequest
+	(*SpellLanguage)(nil),              // 181: privutil.SpellLanguage
+	(*SpellLanguagesResponse)(nil),     // 182: privutil.SpellLanguagesResponse
+	(*InferSchemaRequest)(nil),         // 183: privutil.InferSchemaRequest
+	(*InferSchemaResponse)(nil),        // 184: privutil.InferSchemaResponse
+	(*JsonToCodeRequest)(nil),          // 185: privutil.JsonToCodeRequest
+	(*JsonToCodeResponse)(nil),         // 186: privutil.JsonToCodeResponse
+	(*DataQueryRequest)(nil),           // 187: privutil.DataQueryRequest
+	(*DataQueryResponse)(nil),          // 188: privutil.DataQueryResponse
+	(*DataDiffRequest)(nil),            // 189: privutil.DataDiffRequest
+	(*DataChange)(nil),                 // 190: privutil.DataChange
+	(*DataDiffResponse)(nil),           // 191: privutil.DataDiffResponse
+	(*DataPatchRequest)(nil),           // 192: privutil.DataPatchRequest
+	(*DataPatchResponse)(nil),          // 193: privutil.DataPatchResponse
+	(*XmlFormatRequest)(nil),           // 194: privutil.XmlFormatRequest
+	(*XmlNamespace)(nil),               // 195: privutil.XmlNamespace
+	(*XmlFormatResponse)(nil),          // 196: privutil.XmlFormatResponse
+	(*XPathRequest)(nil),               // 197: privutil.XPathRequest
+	(*XPathNode)(nil),                  // 198: privutil.XPathNode
+	(*XPathResponse)(nil),              // 199: privutil.XPathResponse
+	(*XmlValidateRequest)(nil),         // 200: privutil.XmlValidateRequest
+	(*XmlIssue)(nil),                   // 201: privutil.XmlIssue
+	(*XmlValidateResponse)(nil),        // 202: privutil.XmlValidateResponse
+	(*ProtobufDecodeRequest)(nil),      // 203: privutil.ProtobufDecodeRequest
+	(*ProtobufField)(nil),              // 204: privutil.ProtobufField
+	(*ProtobufDecodeResponse)(nil),     // 205: privutil.ProtobufDecodeResponse
+	(*ColorContrastRequest)(nil),       // 206: privutil.ColorContrastRequest
+	(*ColorContrastResponse)(nil),      // 207: privutil.ColorContrastResponse
+	(*ColorPaletteRequest)(nil),        // 208: privutil.ColorPaletteRequest
+	(*PaletteColor)(nil),               // 209: privutil.PaletteColor
+	(*ColorPaletteResponse)(nil),       // 210: privutil.ColorPaletteResponse
+	(*ColorBlindnessRequest)(nil),      // 211: privutil.ColorBlindnessRequest
+	(*CvdSimulation)(nil),              // 212: privutil.CvdSimulation
+	(*CvdConflict)(nil),                // 213: privutil.CvdConflict
+	(*ColorBlindnessResponse)(nil),     // 214: privutil.ColorBlindnessResponse
+	(*TableQueryRequest)(nil),          // 215: privutil.TableQueryRequest
+	(*TableQueryResponse)(nil),         // 216: privutil.TableQueryResponse
+	(*Merge3Request)(nil),              // 217: privutil.Merge3Request
+	(*MergeConflict)(nil),              // 218: privutil.MergeConflict
+	(*Merge3Response)(nil),             // 219: privutil.Merge3Response
+	(*PatchApplyRequest)(nil),          // 220: privutil.PatchApplyRequest
+	(*PatchHunkResult)(nil),            // 221: privutil.PatchHunkResult
+	(*PatchApplyResponse)(nil),         // 222: privutil.PatchApplyResponse
+	(*RegexExplainRequest)(nil),        // 223: privutil.RegexExplainRequest
+	(*RegexToken)(nil),                 // 224: privutil.RegexToken
+	(*RegexWarning)(nil),               // 225: privutil.RegexWarning
+	(*RegexExplainResponse)(nil),       // 226: privutil.RegexExplainResponse
+	(*RegexGenerateRequest)(nil),       // 227: privutil.RegexGenerateRequest
+	(*RegexNearMiss)(nil),              // 228: privutil.RegexNearMiss
+	(*RegexGenerateResponse)(nil),      // 229: privutil.RegexGenerateResponse
 }
 var file_proto_privutil_proto_depIdxs = []int32{
-	0,   // 0: privutil.ConvertRequest.source_format:type_name -> privutil.DataFormat
-	0,   // 1: privutil.ConvertRequest.target_format:type_name -> privutil.DataFormat
-	0,   // 2: privutil.ValidateRequest.format:type_name -> privutil.DataFormat
-	1,   // 3: privutil.TextManipulateRequest.action:type_name -> privutil.TextAction
-	86,  // 4: privutil.HiddenCharsResponse.chars:type_name -> privutil.HiddenCharInfo
-	2,   // 5: privutil.ListRequest.action:type_name -> privutil.ListAction
-	97,  // 6: privutil.ListResponse.frequency:type_name -> privutil.ListFreqItem
-	99,  // 7: privutil.MathEvalRequest.variables:type_name -> privutil.MathVariable
-	3,   // 8: privutil.PercentageRequest.mode:type_name -> privutil.PercentMode
-	4,   // 9: privutil.UnitConvertRequest.category:type_name -> privutil.UnitCategory
-	107, // 10: privutil.UnitConvertResponse.results:type_name -> privutil.UnitResult
-	112, // 11: privutil.LeapYearResponse.results:type_name -> privutil.LeapYearEntry
-	117, // 12: privutil.DateFormatResponse.formats:type_name -> privutil.DateFormatEntry
-	121, // 13: privutil.UrlParseResponse.query_params:type_name -> privutil.QueryParam
-	125, // 14: privutil.UserAgentParseResponse.fields:type_name -> privutil.UAParsedField
-	128, // 15: privutil.HttpStatusSearchResponse.entries:type_name -> privutil.HttpStatusEntry
-	131, // 16: privutil.MimeLookupResponse.entries:type_name -> privutil.MimeEntry
-	136, // 17: privutil.GitCmdCategory.commands:type_name -> privutil.GitCmd
-	137, // 18: privutil.GitCheatSheetResponse.categories:type_name -> privutil.GitCmdCategory
-	142, // 19: privutil.ExifReadResponse.fields:type_name -> privutil.ExifField
-	149, // 20: privutil.TokenCountResponse.strategies:type_name -> privutil.TokenStrategy
-	152, // 21: privutil.SpellCheckResponse.issues:type_name -> privutil.SpellIssue
-	155, // 22: privutil.SpellLanguagesResponse.languages:type_name -> privutil.SpellLanguage
-	5,   // 23: privutil.PrivUtilService.Diff:input_type -> privutil.DiffRequest
-	7,   // 24: privutil.PrivUtilService.Base64Encode:input_type -> privutil.Base64Request
-	7,   // 25: privutil.PrivUtilService.Base64Decode:input_type -> privutil.Base64Request
-	9,   // 26: privutil.PrivUtilService.JsonFormat:input_type -> privutil.JsonFormatRequest
-	11,  // 27: privutil.PrivUtilService.Convert:input_type -> privutil.ConvertRequest
-	13,  // 28: privutil.PrivUtilService.ValidateData:input_type -> privutil.ValidateRequest
-	15,  // 29: privutil.PrivUtilService.GenerateUuid:input_type -> privutil.UuidRequest
-	17,  // 30: privutil.PrivUtilService.GenerateLorem:input_type -> privutil.LoremRequest
-	19,  // 31: privutil.PrivUtilService.CalculateHash:input_type -> privutil.HashRequest
-	47,  // 32: privutil.PrivUtilService.TextInspect:input_type -> privutil.TextInspectRequest
-	49,  // 33: privutil.PrivUtilService.TextManipulate:input_type -> privutil.TextManipulateRequest
-	21,  // 34: privutil.PrivUtilService.UrlEncode:input_type -> privutil.TextRequest
-	21,  // 35: privutil.PrivUtilService.UrlDecode:input_type -> privutil.TextRequest
-	21,  // 36: privutil.PrivUtilService.HtmlEncode:input_type -> privutil.TextRequest
-	21,  // 37: privutil.PrivUtilService.HtmlDecode:input_type -> privutil.TextRequest
-	23,  // 38: privutil.PrivUtilService.TimeConvert:input_type -> privutil.TimeRequest
-	25,  // 39: privutil.PrivUtilService.JwtDecode:input_type -> privutil.JwtRequest
-	27,  // 40: privutil.PrivUtilService.RegexTest:input_type -> privutil.RegexRequest
-	29,  // 41: privutil.PrivUtilService.JsonToGo:input_type -> privutil.JsonToGoRequest
-	31,  // 42: privutil.PrivUtilService.CronExplain:input_type -> privutil.CronRequest
-	33,  // 43: privutil.PrivUtilService.CertParse:input_type -> privutil.CertRequest
-	35,  // 44: privutil.PrivUtilService.ColorConvert:input_type -> privutil.ColorRequest
-	37,  // 45: privutil.PrivUtilService.CaseConvert:input_type -> privutil.CaseRequest
-	39,  // 46: privutil.PrivUtilService.StringEscape:input_type -> privutil.EscapeRequest
-	41,  // 47: privutil.PrivUtilService.TextSimilarity:input_type -> privutil.SimilarityRequest
-	43,  // 48: privutil.PrivUtilService.SqlFormat:input_type -> privutil.SqlRequest
-	45,  // 49: privutil.PrivUtilService.IpCalc:input_type -> privutil.IpRequest
-	51,  // 50: privutil.PrivUtilService.GeneratePassword:input_type -> privutil.PasswordRequest
-	53,  // 51: privutil.PrivUtilService.GenerateRsaKeyPair:input_type -> privutil.RsaKeyRequest
-	55,  // 52: privutil.PrivUtilService.BaseConvert:input_type -> privutil.BaseConvertRequest
-	21,  // 53: privutil.PrivUtilService.MarkdownToHtml:input_type -> privutil.TextRequest
-	21,  // 54: privutil.PrivUtilService.HtmlToMarkdown:input_type -> privutil.TextRequest
-	67,  // 55: privutil.PrivUtilService.HmacGenerate:input_type -> privutil.HmacRequest
-	69,  // 56: privutil.PrivUtilService.OtpGenerate:input_type -> privutil.OtpRequest
-	71,  // 57: privutil.PrivUtilService.OtpValidate:input_type -> privutil.OtpValidateRequest
-	73,  // 58: privutil.PrivUtilService.UlidGenerate:input_type -> privutil.UlidRequest
-	75,  // 59: privutil.PrivUtilService.CaesarCipher:input_type -> privutil.CaesarRequest
-	77,  // 60: privutil.PrivUtilService.TextEncode:input_type -> privutil.TextEncodeRequest
-	79,  // 61: privutil.PrivUtilService.MorseCode:input_type -> privutil.MorseRequest
-	81,  // 62: privutil.PrivUtilService.BasicAuthGenerate:input_type -> privutil.BasicAuthRequest
-	57,  // 63: privutil.PrivUtilService.ChmodCalc:input_type -> privutil.ChmodRequest
-	59,  // 64: privutil.PrivUtilService.Ipv4Convert:input_type -> privutil.Ipv4ConvertRequest
-	61,  // 65: privutil.PrivUtilService.Ipv4RangeExpand:input_type -> privutil.Ipv4RangeRequest
-	63,  // 66: privutil.PrivUtilService.GeneratePort:input_type -> privutil.PortRequest
-	65,  // 67: privutil.PrivUtilService.GenerateMac:input_type -> privutil.MacRequest
-	83,  // 68: privutil.PrivUtilService.Slugify:input_type -> privutil.SlugifyRequest
-	85,  // 69: privutil.PrivUtilService.HiddenChars:input_type -> privutil.HiddenCharsRequest
-	88,  // 70: privutil.PrivUtilService.TextReplace:input_type -> privutil.TextReplaceRequest
-	90,  // 71: privutil.PrivUtilService.StringObfuscate:input_type -> privutil.StringObfuscateRequest
-	92,  // 72: privutil.PrivUtilService.NumeronymGenerate:input_type -> privutil.NumeronymRequest
-	94,  // 73: privutil.PrivUtilService.NatoAlphabet:input_type -> privutil.NatoRequest
-	96,  // 74: privutil.PrivUtilService.ListProcess:input_type -> privutil.ListRequest
-	100, // 75: privutil.PrivUtilService.MathEval:input_type -> privutil.MathEvalRequest
-	102, // 76: privutil.PrivUtilService.PercentageCalc:input_type -> privutil.PercentageRequest
-	104, // 77: privutil.PrivUtilService.TempConvert:input_type -> privutil.TempConvertRequest
-	106, // 78: privutil.PrivUtilService.UnitConvert:input_type -> privutil.UnitConvertRequest
-	109, // 79: privutil.PrivUtilService.DateDiff:input_type -> privutil.DateDiffRequest
-	111, // 80: privutil.PrivUtilService.LeapYear:input_type -> privutil.LeapYearRequest
-	114, // 81: privutil.PrivUtilService.DateAdd:input_type -> privutil.DateAddRequest
-	116, // 82: privutil.PrivUtilService.DateFormat:input_type -> privutil.DateFormatRequest
-	119, // 83: privutil.PrivUtilService.DateInfo:input_type -> privutil.DateInfoRequest
-	122, // 84: privutil.PrivUtilService.UrlParse:input_type -> privutil.UrlParseRequest
-	124, // 85: privutil.PrivUtilService.UserAgentParse:input_type -> privutil.UserAgentParseRequest
-	127, // 86: privutil.PrivUtilService.HttpStatusSearch:input_type -> privutil.HttpStatusSearchRequest
-	130, // 87: privutil.PrivUtilService.MimeLookup:input_type -> privutil.MimeLookupRequest
-	133, // 88: privutil.PrivUtilService.DockerRunToCompose:input_type -> privutil.DockerRunToComposeRequest
-	135, // 89: privutil.PrivUtilService.GitCheatSheet:input_type -> privutil.GitCheatSheetRequest
-	139, // 90: privutil.PrivUtilService.SvgOptimize:input_type -> privutil.SvgOptimizeRequest
-	141, // 91: privutil.PrivUtilService.ExifRead:input_type -> privutil.ExifReadRequest
-	144, // 92: privutil.PrivUtilService.FileToBase64:input_type -> privutil.FileToBase64Request
-	146, // 93: privutil.PrivUtilService.Base64ToFile:input_type -> privutil.Base64ToFileRequest
-	148, // 94: privutil.PrivUtilService.TokenCount:input_type -> privutil.TokenCountRequest
-	151, // 95: privutil.PrivUtilService.SpellCheck:input_type -> privutil.SpellCheckRequest
-	154, // 96: privutil.PrivUtilService.SpellLanguages:input_type -> privutil.SpellLanguagesRequest
-	6,   // 97: privutil.PrivUtilService.Diff:output_type -> privutil.DiffResponse
-	8,   // 98: privutil.PrivUtilService.Base64Encode:output_type -> privutil.Base64Response
-	8,   // 99: privutil.PrivUtilService.Base64Decode:output_type -> privutil.Base64Response
-	10,  // 100: privutil.PrivUtilService.JsonFormat:output_type -> privutil.JsonFormatResponse
-	12,  // 101: privutil.PrivUtilService.Convert:output_type -> privutil.ConvertResponse
-	14,  // 102: privutil.PrivUtilService.ValidateData:output_type -> privutil.ValidateResponse
-	16,  // 103: privutil.PrivUtilService.GenerateUuid:output_type -> privutil.UuidResponse
-	18,  // 104: privutil.PrivUtilService.GenerateLorem:output_type -> privutil.LoremResponse
-	20,  // 105: privutil.PrivUtilService.CalculateHash:output_type -> privutil.HashResponse
-	48,  // 106: privutil.PrivUtilService.TextInspect:output_type -> privutil.TextInspectResponse
-	50,  // 107: privutil.PrivUtilService.TextManipulate:output_type -> privutil.TextManipulateResponse
-	22,  // 108: privutil.PrivUtilService.UrlEncode:output_type -> privutil.TextResponse
-	22,  // 109: privutil.PrivUtilService.UrlDecode:output_type -> privutil.TextResponse
-	22,  // 110: privutil.PrivUtilService.HtmlEncode:output_type -> privutil.TextResponse
-	22,  // 111: privutil.PrivUtilService.HtmlDecode:output_type -> privutil.TextResponse
-	24,  // 112: privutil.PrivUtilService.TimeConvert:output_type -> privutil.TimeResponse
-	26,  // 113: privutil.PrivUtilService.JwtDecode:output_type -> privutil.JwtResponse
-	28,  // 114: privutil.PrivUtilService.RegexTest:output_type -> privutil.RegexResponse
-	30,  // 115: privutil.PrivUtilService.JsonToGo:output_type -> privutil.JsonToGoResponse
-	32,  // 116: privutil.PrivUtilService.CronExplain:output_type -> privutil.CronResponse
-	34,  // 117: privutil.PrivUtilService.CertParse:output_type -> privutil.CertResponse
-	36,  // 118: privutil.PrivUtilService.ColorConvert:output_type -> privutil.ColorResponse
-	38,  // 119: privutil.PrivUtilService.CaseConvert:output_type -> privutil.CaseResponse
-	40,  // 120: privutil.PrivUtilService.StringEscape:output_type -> privutil.EscapeResponse
-	42,  // 121: privutil.PrivUtilService.TextSimilarity:output_type -> privutil.SimilarityResponse
-	44,  // 122: privutil.PrivUtilService.SqlFormat:output_type -> privutil.SqlResponse
-	46,  // 123: privutil.PrivUtilService.IpCalc:output_type -> privutil.IpResponse
-	52,  // 124: privutil.PrivUtilService.GeneratePassword:output_type -> privutil.PasswordResponse
-	54,  // 125: privutil.PrivUtilService.GenerateRsaKeyPair:output_type -> privutil.RsaKeyResponse
-	56,  // 126: privutil.PrivUtilService.BaseConvert:output_type -> privutil.BaseConvertResponse
-	22,  // 127: privutil.PrivUtilService.MarkdownToHtml:output_type -> privutil.TextResponse
-	22,  // 128: privutil.PrivUtilService.HtmlToMarkdown:output_type -> privutil.TextResponse
-	68,  // 129: privutil.PrivUtilService.HmacGenerate:output_type -> privutil.HmacResponse
-	70,  // 130: privutil.PrivUtilService.OtpGenerate:output_type -> privutil.OtpResponse
-	72,  // 131: privutil.PrivUtilService.OtpValidate:output_type -> privutil.OtpValidateResponse
-	74,  // 132: privutil.PrivUtilService.UlidGenerate:output_type -> privutil.UlidResponse
-	76,  // 133: privutil.PrivUtilService.CaesarCipher:output_type -> privutil.CaesarResponse
-	78,  // 134: privutil.PrivUtilService.TextEncode:output_type -> privutil.TextEncodeResponse
-	80,  // 135: privutil.PrivUtilService.MorseCode:output_type -> privutil.MorseResponse
-	82,  // 136: privutil.PrivUtilService.BasicAuthGenerate:output_type -> privutil.BasicAuthResponse
-	58,  // 137: privutil.PrivUtilService.ChmodCalc:output_type -> privutil.ChmodResponse
-	60,  // 138: privutil.PrivUtilService.Ipv4Convert:output_type -> privutil.Ipv4ConvertResponse
-	62,  // 139: privutil.PrivUtilService.Ipv4RangeExpand:output_type -> privutil.Ipv4RangeResponse
-	64,  // 140: privutil.PrivUtilService.GeneratePort:output_type -> privutil.PortResponse
-	66,  // 141: privutil.PrivUtilService.GenerateMac:output_type -> privutil.MacResponse
-	84,  // 142: privutil.PrivUtilService.Slugify:output_type -> privutil.SlugifyResponse
-	87,  // 143: privutil.PrivUtilService.HiddenChars:output_type -> privutil.HiddenCharsResponse
-	89,  // 144: privutil.PrivUtilService.TextReplace:output_type -> privutil.TextReplaceResponse
-	91,  // 145: privutil.PrivUtilService.StringObfuscate:output_type -> privutil.StringObfuscateResponse
-	93,  // 146: privutil.PrivUtilService.NumeronymGenerate:output_type -> privutil.NumeronymResponse
-	95,  // 147: privutil.PrivUtilService.NatoAlphabet:output_type -> privutil.NatoResponse
-	98,  // 148: privutil.PrivUtilService.ListProcess:output_type -> privutil.ListResponse
-	101, // 149: privutil.PrivUtilService.MathEval:output_type -> privutil.MathEvalResponse
-	103, // 150: privutil.PrivUtilService.PercentageCalc:output_type -> privutil.PercentageResponse
-	105, // 151: privutil.PrivUtilService.TempConvert:output_type -> privutil.TempConvertResponse
-	108, // 152: privutil.PrivUtilService.UnitConvert:output_type -> privutil.UnitConvertResponse
-	110, // 153: privutil.PrivUtilService.DateDiff:output_type -> privutil.DateDiffResponse
-	113, // 154: privutil.PrivUtilService.LeapYear:output_type -> privutil.LeapYearResponse
-	115, // 155: privutil.PrivUtilService.DateAdd:output_type -> privutil.DateAddResponse
-	118, // 156: privutil.PrivUtilService.DateFormat:output_type -> privutil.DateFormatResponse
-	120, // 157: privutil.PrivUtilService.DateInfo:output_type -> privutil.DateInfoResponse
-	123, // 158: privutil.PrivUtilService.UrlParse:output_type -> privutil.UrlParseResponse
-	126, // 159: privutil.PrivUtilService.UserAgentParse:output_type -> privutil.UserAgentParseResponse
-	129, // 160: privutil.PrivUtilService.HttpStatusSearch:output_type -> privutil.HttpStatusSearchResponse
-	132, // 161: privutil.PrivUtilService.MimeLookup:output_type -> privutil.MimeLookupResponse
-	134, // 162: privutil.PrivUtilService.DockerRunToCompose:output_type -> privutil.DockerRunToComposeResponse
-	138, // 163: privutil.PrivUtilService.GitCheatSheet:output_type -> privutil.GitCheatSheetResponse
-	140, // 164: privutil.PrivUtilService.SvgOptimize:output_type -> privutil.SvgOptimizeResponse
-	143, // 165: privutil.PrivUtilService.ExifRead:output_type -> privutil.ExifReadResponse
-	145, // 166: privutil.PrivUtilService.FileToBase64:output_type -> privutil.FileToBase64Response
-	147, // 167: privutil.PrivUtilService.Base64ToFile:output_type -> privutil.Base64ToFileResponse
-	150, // 168: privutil.PrivUtilService.TokenCount:output_type -> privutil.TokenCountResponse
-	153, // 169: privutil.PrivUtilService.SpellCheck:output_type -> privutil.SpellCheckResponse
-	156, // 170: privutil.PrivUtilService.SpellLanguages:output_type -> privutil.SpellLanguagesResponse
-	97,  // [97:171] is the sub-list for method output_type
-	23,  // [23:97] is the sub-list for method input_type
-	23,  // [23:23] is the sub-list for extension type_name
-	23,  // [23:23] is the sub-list for extension extendee
-	0,   // [0:23] is the sub-list for field type_name
+	0,   // 0: privutil.DiffRequest.mode:type_name -> privutil.DiffMode
+	21,  // 1: privutil.DiffResponse.hunks:type_name -> privutil.DiffHunk
+	22,  // 2: privutil.DiffHunk.rows:type_name -> privutil.DiffRow
+	1,   // 3: privutil.ConvertRequest.source_format:type_name -> privutil.DataFormat
+	1,   // 4: privutil.ConvertRequest.target_format:type_name -> privutil.DataFormat
+	2,   // 5: privutil.ConvertRequest.binary_encoding:type_name -> privutil.BinaryEncoding
+	3,   // 6: privutil.ConvertRequest.binary_json_style:type_name -> privutil.BinaryJsonStyle
+	4,   // 7: privutil.ConvertRequest.csv_quoting:type_name -> privutil.CsvQuoting
+	1,   // 8: privutil.ValidateRequest.format:type_name -> privutil.DataFormat
+	31,  // 9: privutil.ValidateResponse.errors:type_name -> privutil.ValidationIssue
+	31,  // 10: privutil.ValidateResponse.warnings:type_name -> privutil.ValidationIssue
+	1,   // 11: privutil.FakeDataRequest.format:type_name -> privutil.DataFormat
+	5,   // 12: privutil.RegexRequest.engine:type_name -> privutil.RegexEngine
+	47,  // 13: privutil.RegexMatchDetail.groups:type_name -> privutil.RegexGroup
+	48,  // 14: privutil.RegexResponse.details:type_name -> privutil.RegexMatchDetail
+	6,   // 15: privutil.SqlRequest.dialect:type_name -> privutil.SqlDialect
+	7,   // 16: privutil.SqlRequest.keyword_case:type_name -> privutil.SqlKeywordCase
+	1,   // 17: privutil.DataToSqlRequest.format:type_name -> privutil.DataFormat
+	6,   // 18: privutil.DataToSqlRequest.dialect:type_name -> privutil.SqlDialect
+	8,   // 19: privutil.DataToSqlRequest.style:type_name -> privutil.SqlLoadStyle
+	67,  // 20: privutil.DataToSqlResponse.columns:type_name -> privutil.SqlColumn
+	6,   // 21: privutil.SqlToGoRequest.dialect:type_name -> privutil.SqlDialect
+	9,   // 22: privutil.TextManipulateRequest.action:type_name -> privutil.TextAction
+	112, // 23: privutil.HiddenCharsResponse.chars:type_name -> privutil.HiddenCharInfo
+	10,  // 24: privutil.ListRequest.action:type_name -> privutil.ListAction
+	123, // 25: privutil.ListResponse.frequency:type_name -> privutil.ListFreqItem
+	125, // 26: privutil.MathEvalRequest.variables:type_name -> privutil.MathVariable
+	11,  // 27: privutil.PercentageRequest.mode:type_name -> privutil.PercentMode
+	12,  // 28: privutil.UnitConvertRequest.category:type_name -> privutil.UnitCategory
+	133, // 29: privutil.UnitConvertResponse.results:type_name -> privutil.UnitResult
+	138, // 30: privutil.LeapYearResponse.results:type_name -> privutil.LeapYearEntry
+	143, // 31: privutil.DateFormatResponse.formats:type_name -> privutil.DateFormatEntry
+	147, // 32: privutil.UrlParseResponse.query_params:type_name -> privutil.QueryParam
+	151, // 33: privutil.UserAgentParseResponse.fields:type_name -> privutil.UAParsedField
+	154, // 34: privutil.HttpStatusSearchResponse.entries:type_name -> privutil.HttpStatusEntry
+	157, // 35: privutil.MimeLookupResponse.entries:type_name -> privutil.MimeEntry
+	162, // 36: privutil.GitCmdCategory.commands:type_name -> privutil.GitCmd
+	163, // 37: privutil.GitCheatSheetResponse.categories:type_name -> privutil.GitCmdCategory
+	168, // 38: privutil.ExifReadResponse.fields:type_name -> privutil.ExifField
+	175, // 39: privutil.TokenCountResponse.strategies:type_name -> privutil.TokenStrategy
+	178, // 40: privutil.SpellCheckResponse.issues:type_name -> privutil.SpellIssue
+	181, // 41: privutil.SpellLanguagesResponse.languages:type_name -> privutil.SpellLanguage
+	1,   // 42: privutil.InferSchemaRequest.format:type_name -> privutil.DataFormat
+	13,  // 43: privutil.InferSchemaRequest.draft:type_name -> privutil.SchemaDraft
+	14,  // 44: privutil.JsonToCodeRequest.target:type_name -> privutil.CodeTarget
+	1,   // 45: privutil.DataQueryRequest.format:type_name -> privutil.DataFormat
+	15,  // 46: privutil.DataQueryRequest.language:type_name -> privutil.QueryLanguage
+	1,   // 47: privutil.DataQueryRequest.output_format:type_name -> privutil.DataFormat
+	1,   // 48: privutil.DataDiffRequest.left_format:type_name -> privutil.DataFormat
+	1,   // 49: privutil.DataDiffRequest.right_format:type_name -> privutil.DataFormat
+	190, // 50: privutil.DataDiffResponse.changes:type_name -> privutil.DataChange
+	1,   // 51: privutil.DataPatchRequest.format:type_name -> privutil.DataFormat
+	16,  // 52: privutil.DataPatchRequest.patch_type:type_name -> privutil.PatchType
+	195, // 53: privutil.XmlFormatResponse.namespaces:type_name -> privutil.XmlNamespace
+	198, // 54: privutil.XPathResponse.nodes:type_name -> privutil.XPathNode
+	201, // 55: privutil.XmlValidateResponse.issues:type_name -> privutil.XmlIssue
+	204, // 56: privutil.ProtobufDecodeResponse.fields:type_name -> privutil.ProtobufField
+	209, // 57: privutil.ColorPaletteResponse.colors:type_name -> privutil.PaletteColor
+	212, // 58: privutil.ColorBlindnessResponse.simulations:type_name -> privutil.CvdSimulation
+	213, // 59: privutil.ColorBlindnessResponse.conflicts:type_name -> privutil.CvdConflict
+	1,   // 60: privutil.TableQueryRequest.format:type_name -> privutil.DataFormat
+	1,   // 61: privutil.TableQueryRequest.output_format:type_name -> privutil.DataFormat
+	17,  // 62: privutil.Merge3Request.mode:type_name -> privutil.MergeMode
+	1,   // 63: privutil.Merge3Request.format:type_name -> privutil.DataFormat
+	218, // 64: privutil.Merge3Response.conflicts:type_name -> privutil.MergeConflict
+	18,  // 65: privutil.PatchApplyRequest.format:type_name -> privutil.TextPatchFormat
+	221, // 66: privutil.PatchApplyResponse.hunks:type_name -> privutil.PatchHunkResult
+	5,   // 67: privutil.RegexExplainRequest.engine:type_name -> privutil.RegexEngine
+	224, // 68: privutil.RegexToken.children:type_name -> privutil.RegexToken
+	224, // 69: privutil.RegexExplainResponse.tree:type_name -> privutil.RegexToken
+	225, // 70: privutil.RegexExplainResponse.warnings:type_name -> privutil.RegexWarning
+	228, // 71: privutil.RegexGenerateResponse.near_misses:type_name -> privutil.RegexNearMiss
+	19,  // 72: privutil.PrivUtilService.Diff:input_type -> privutil.DiffRequest
+	23,  // 73: privutil.PrivUtilService.Base64Encode:input_type -> privutil.Base64Request
+	23,  // 74: privutil.PrivUtilService.Base64Decode:input_type -> privutil.Base64Request
+	25,  // 75: privutil.PrivUtilService.JsonFormat:input_type -> privutil.JsonFormatRequest
+	27,  // 76: privutil.PrivUtilService.Convert:input_type -> privutil.ConvertRequest
+	29,  // 77: privutil.PrivUtilService.ValidateData:input_type -> privutil.ValidateRequest
+	32,  // 78: privutil.PrivUtilService.GenerateUuid:input_type -> privutil.UuidRequest
+	34,  // 79: privutil.PrivUtilService.GenerateLorem:input_type -> privutil.LoremRequest
+	36,  // 80: privutil.PrivUtilService.GenerateFakeData:input_type -> privutil.FakeDataRequest
+	38,  // 81: privutil.PrivUtilService.CalculateHash:input_type -> privutil.HashRequest
+	73,  // 82: privutil.PrivUtilService.TextInspect:input_type -> privutil.TextInspectRequest
+	75,  // 83: privutil.PrivUtilService.TextManipulate:input_type -> privutil.TextManipulateRequest
+	40,  // 84: privutil.PrivUtilService.UrlEncode:input_type -> privutil.TextRequest
+	40,  // 85: privutil.PrivUtilService.UrlDecode:input_type -> privutil.TextRequest
+	40,  // 86: privutil.PrivUtilService.HtmlEncode:input_type -> privutil.TextRequest
+	40,  // 87: privutil.PrivUtilService.HtmlDecode:input_type -> privutil.TextRequest
+	42,  // 88: privutil.PrivUtilService.TimeConvert:input_type -> privutil.TimeRequest
+	44,  // 89: privutil.PrivUtilService.JwtDecode:input_type -> privutil.JwtRequest
+	46,  // 90: privutil.PrivUtilService.RegexTest:input_type -> privutil.RegexRequest
+	50,  // 91: privutil.PrivUtilService.JsonToGo:input_type -> privutil.JsonToGoRequest
+	52,  // 92: privutil.PrivUtilService.CronExplain:input_type -> privutil.CronRequest
+	54,  // 93: privutil.PrivUtilService.CertParse:input_type -> privutil.CertRequest
+	56,  // 94: privutil.PrivUtilService.ColorConvert:input_type -> privutil.ColorRequest
+	58,  // 95: privutil.PrivUtilService.CaseConvert:input_type -> privutil.CaseRequest
+	60,  // 96: privutil.PrivUtilService.StringEscape:input_type -> privutil.EscapeRequest
+	62,  // 97: privutil.PrivUtilService.TextSimilarity:input_type -> privutil.SimilarityRequest
+	64,  // 98: privutil.PrivUtilService.SqlFormat:input_type -> privutil.SqlRequest
+	66,  // 99: privutil.PrivUtilService.DataToSql:input_type -> privutil.DataToSqlRequest
+	69,  // 100: privutil.PrivUtilService.SqlToGo:input_type -> privutil.SqlToGoRequest
+	71,  // 101: privutil.PrivUtilService.IpCalc:input_type -> privutil.IpRequest
+	77,  // 102: privutil.PrivUtilService.GeneratePassword:input_type -> privutil.PasswordRequest
+	79,  // 103: privutil.PrivUtilService.GenerateRsaKeyPair:input_type -> privutil.RsaKeyRequest
+	81,  // 104: privutil.PrivUtilService.BaseConvert:input_type -> privutil.BaseConvertRequest
+	40,  // 105: privutil.PrivUtilService.MarkdownToHtml:input_type -> privutil.TextRequest
+	40,  // 106: privutil.PrivUtilService.HtmlToMarkdown:input_type -> privutil.TextRequest
+	93,  // 107: privutil.PrivUtilService.HmacGenerate:input_type -> privutil.HmacRequest
+	95,  // 108: privutil.PrivUtilService.OtpGenerate:input_type -> privutil.OtpRequest
+	97,  // 109: privutil.PrivUtilService.OtpValidate:input_type -> privutil.OtpValidateRequest
+	99,  // 110: privutil.PrivUtilService.UlidGenerate:input_type -> privutil.UlidRequest
+	101, // 111: privutil.PrivUtilService.CaesarCipher:input_type -> privutil.CaesarRequest
+	103, // 112: privutil.PrivUtilService.TextEncode:input_type -> privutil.TextEncodeRequest
+	105, // 113: privutil.PrivUtilService.MorseCode:input_type -> privutil.MorseRequest
+	107, // 114: privutil.PrivUtilService.BasicAuthGenerate:input_type -> privutil.BasicAuthRequest
+	83,  // 115: privutil.PrivUtilService.ChmodCalc:input_type -> privutil.ChmodRequest
+	85,  // 116: privutil.PrivUtilService.Ipv4Convert:input_type -> privutil.Ipv4ConvertRequest
+	87,  // 117: privutil.PrivUtilService.Ipv4RangeExpand:input_type -> privutil.Ipv4RangeRequest
+	89,  // 118: privutil.PrivUtilService.GeneratePort:input_type -> privutil.PortRequest
+	91,  // 119: privutil.PrivUtilService.GenerateMac:input_type -> privutil.MacRequest
+	109, // 120: privutil.PrivUtilService.Slugify:input_type -> privutil.SlugifyRequest
+	111, // 121: privutil.PrivUtilService.HiddenChars:input_type -> privutil.HiddenCharsRequest
+	114, // 122: privutil.PrivUtilService.TextReplace:input_type -> privutil.TextReplaceRequest
+	116, // 123: privutil.PrivUtilService.StringObfuscate:input_type -> privutil.StringObfuscateRequest
+	118, // 124: privutil.PrivUtilService.NumeronymGenerate:input_type -> privutil.NumeronymRequest
+	120, // 125: privutil.PrivUtilService.NatoAlphabet:input_type -> privutil.NatoRequest
+	122, // 126: privutil.PrivUtilService.ListProcess:input_type -> privutil.ListRequest
+	126, // 127: privutil.PrivUtilService.MathEval:input_type -> privutil.MathEvalRequest
+	128, // 128: privutil.PrivUtilService.PercentageCalc:input_type -> privutil.PercentageRequest
+	130, // 129: privutil.PrivUtilService.TempConvert:input_type -> privutil.TempConvertRequest
+	132, // 130: privutil.PrivUtilService.UnitConvert:input_type -> privutil.UnitConvertRequest
+	135, // 131: privutil.PrivUtilService.DateDiff:input_type -> privutil.DateDiffRequest
+	137, // 132: privutil.PrivUtilService.LeapYear:input_type -> privutil.LeapYearRequest
+	140, // 133: privutil.PrivUtilService.DateAdd:input_type -> privutil.DateAddRequest
+	142, // 134: privutil.PrivUtilService.DateFormat:input_type -> privutil.DateFormatRequest
+	145, // 135: privutil.PrivUtilService.DateInfo:input_type -> privutil.DateInfoRequest
+	148, // 136: privutil.PrivUtilService.UrlParse:input_type -> privutil.UrlParseRequest
+	150, // 137: privutil.PrivUtilService.UserAgentParse:input_type -> privutil.UserAgentParseRequest
+	153, // 138: privutil.PrivUtilService.HttpStatusSearch:input_type -> privutil.HttpStatusSearchRequest
+	156, // 139: privutil.PrivUtilService.MimeLookup:input_type -> privutil.MimeLookupRequest
+	159, // 140: privutil.PrivUtilService.DockerRunToCompose:input_type -> privutil.DockerRunToComposeRequest
+	161, // 141: privutil.PrivUtilService.GitCheatSheet:input_type -> privutil.GitCheatSheetRequest
+	165, // 142: privutil.PrivUtilService.SvgOptimize:input_type -> privutil.SvgOptimizeRequest
+	167, // 143: privutil.PrivUtilService.ExifRead:input_type -> privutil.ExifReadRequest
+	170, // 144: privutil.PrivUtilService.FileToBase64:input_type -> privutil.FileToBase64Request
+	172, // 145: privutil.PrivUtilService.Base64ToFile:input_type -> privutil.Base64ToFileRequest
+	174, // 146: privutil.PrivUtilService.TokenCount:input_type -> privutil.TokenCountRequest
+	177, // 147: privutil.PrivUtilService.SpellCheck:input_type -> privutil.SpellCheckRequest
+	180, // 148: privutil.PrivUtilService.SpellLanguages:input_type -> privutil.SpellLanguagesRequest
+	183, // 149: privutil.PrivUtilService.InferSchema:input_type -> privutil.InferSchemaRequest
+	185, // 150: privutil.PrivUtilService.JsonToCode:input_type -> privutil.JsonToCodeRequest
+	187, // 151: privutil.PrivUtilService.DataQuery:input_type -> privutil.DataQueryRequest
+	189, // 152: privutil.PrivUtilService.DataDiff:input_type -> privutil.DataDiffRequest
+	192, // 153: privutil.PrivUtilService.DataPatch:input_type -> privutil.DataPatchRequest
+	194, // 154: privutil.PrivUtilService.XmlFormat:input_type -> privutil.XmlFormatRequest
+	197, // 155: privutil.PrivUtilService.XmlXPath:input_type -> privutil.XPathRequest
+	200, // 156: privutil.PrivUtilService.XmlValidate:input_type -> privutil.XmlValidateRequest
+	203, // 157: privutil.PrivUtilService.ProtobufDecode:input_type -> privutil.ProtobufDecodeRequest
+	206, // 158: privutil.PrivUtilService.ColorContrast:input_type -> privutil.ColorContrastRequest
+	208, // 159: privutil.PrivUtilService.ColorPalette:input_type -> privutil.ColorPaletteRequest
+	211, // 160: privutil.PrivUtilService.ColorBlindness:input_type -> privutil.ColorBlindnessRequest
+	215, // 161: privutil.PrivUtilService.TableQuery:input_type -> privutil.TableQueryRequest
+	217, // 162: privutil.PrivUtilService.Merge3:input_type -> privutil.Merge3Request
+	220, // 163: privutil.PrivUtilService.PatchApply:input_type -> privutil.PatchApplyRequest
+	223, // 164: privutil.PrivUtilService.RegexExplain:input_type -> privutil.RegexExplainRequest
+	227, // 165: privutil.PrivUtilService.RegexGenerate:input_type -> privutil.RegexGenerateRequest
+	20,  // 166: privutil.PrivUtilService.Diff:output_type -> privutil.DiffResponse
+	24,  // 167: privutil.PrivUtilService.Base64Encode:output_type -> privutil.Base64Response
+	24,  // 168: privutil.PrivUtilService.Base64Decode:output_type -> privutil.Base64Response
+	26,  // 169: privutil.PrivUtilService.JsonFormat:output_type -> privutil.JsonFormatResponse
+	28,  // 170: privutil.PrivUtilService.Convert:output_type -> privutil.ConvertResponse
+	30,  // 171: privutil.PrivUtilService.ValidateData:output_type -> privutil.ValidateResponse
+	33,  // 172: privutil.PrivUtilService.GenerateUuid:output_type -> privutil.UuidResponse
+	35,  // 173: privutil.PrivUtilService.GenerateLorem:output_type -> privutil.LoremResponse
+	37,  // 174: privutil.PrivUtilService.GenerateFakeData:output_type -> privutil.FakeDataResponse
+	39,  // 175: privutil.PrivUtilService.CalculateHash:output_type -> privutil.HashResponse
+	74,  // 176: privutil.PrivUtilService.TextInspect:output_type -> privutil.TextInspectResponse
+	76,  // 177: privutil.PrivUtilService.TextManipulate:output_type -> privutil.TextManipulateResponse
+	41,  // 178: privutil.PrivUtilService.UrlEncode:output_type -> privutil.TextResponse
+	41,  // 179: privutil.PrivUtilService.UrlDecode:output_type -> privutil.TextResponse
+	41,  // 180: privutil.PrivUtilService.HtmlEncode:output_type -> privutil.TextResponse
+	41,  // 181: privutil.PrivUtilService.HtmlDecode:output_type -> privutil.TextResponse
+	43,  // 182: privutil.PrivUtilService.TimeConvert:output_type -> privutil.TimeResponse
+	45,  // 183: privutil.PrivUtilService.JwtDecode:output_type -> privutil.JwtResponse
+	49,  // 184: privutil.PrivUtilService.RegexTest:output_type -> privutil.RegexResponse
+	51,  // 185: privutil.PrivUtilService.JsonToGo:output_type -> privutil.JsonToGoResponse
+	53,  // 186: privutil.PrivUtilService.CronExplain:output_type -> privutil.CronResponse
+	55,  // 187: privutil.PrivUtilService.CertParse:output_type -> privutil.CertResponse
+	57,  // 188: privutil.PrivUtilService.ColorConvert:output_type -> privutil.ColorResponse
+	59,  // 189: privutil.PrivUtilService.CaseConvert:output_type -> privutil.CaseResponse
+	61,  // 190: privutil.PrivUtilService.StringEscape:output_type -> privutil.EscapeResponse
+	63,  // 191: privutil.PrivUtilService.TextSimilarity:output_type -> privutil.SimilarityResponse
+	65,  // 192: privutil.PrivUtilService.SqlFormat:output_type -> privutil.SqlResponse
+	68,  // 193: privutil.PrivUtilService.DataToSql:output_type -> privutil.DataToSqlResponse
+	70,  // 194: privutil.PrivUtilService.SqlToGo:output_type -> privutil.SqlToGoResponse
+	72,  // 195: privutil.PrivUtilService.IpCalc:output_type -> privutil.IpResponse
+	78,  // 196: privutil.PrivUtilService.GeneratePassword:output_type -> privutil.PasswordResponse
+	80,  // 197: privutil.PrivUtilService.GenerateRsaKeyPair:output_type -> privutil.RsaKeyResponse
+	82,  // 198: privutil.PrivUtilService.BaseConvert:output_type -> privutil.BaseConvertResponse
+	41,  // 199: privutil.PrivUtilService.MarkdownToHtml:output_type -> privutil.TextResponse
+	41,  // 200: privutil.PrivUtilService.HtmlToMarkdown:output_type -> privutil.TextResponse
+	94,  // 201: privutil.PrivUtilService.HmacGenerate:output_type -> privutil.HmacResponse
+	96,  // 202: privutil.PrivUtilService.OtpGenerate:output_type -> privutil.OtpResponse
+	98,  // 203: privutil.PrivUtilService.OtpValidate:output_type -> privutil.OtpValidateResponse
+	100, // 204: privutil.PrivUtilService.UlidGenerate:output_type -> privutil.UlidResponse
+	102, // 205: privutil.PrivUtilService.CaesarCipher:output_type -> privutil.CaesarResponse
+	104, // 206: privutil.PrivUtilService.TextEncode:output_type -> privutil.TextEncodeResponse
+	106, // 207: privutil.PrivUtilService.MorseCode:output_type -> privutil.MorseResponse
+	108, // 208: privutil.PrivUtilService.BasicAuthGenerate:output_type -> privutil.BasicAuthResponse
+	84,  // 209: privutil.PrivUtilService.ChmodCalc:output_type -> privutil.ChmodResponse
+	86,  // 210: privutil.PrivUtilService.Ipv4Convert:output_type -> privutil.Ipv4ConvertResponse
+	88,  // 211: privutil.PrivUtilService.Ipv4RangeExpand:output_type -> privutil.Ipv4RangeResponse
+	90,  // 212: privutil.PrivUtilService.GeneratePort:output_type -> privutil.PortResponse
+	92,  // 213: privutil.PrivUtilService.GenerateMac:output_type -> privutil.MacResponse
+	110, // 214: privutil.PrivUtilService.Slugify:output_type -> privutil.SlugifyResponse
+	113, // 215: privutil.PrivUtilService.HiddenChars:output_type -> privutil.HiddenCharsResponse
+	115, // 216: privutil.PrivUtilService.TextReplace:output_type -> privutil.TextReplaceResponse
+	117, // 217: privutil.PrivUtilService.StringObfuscate:output_type -> privutil.StringObfuscateResponse
+	119, // 218: privutil.PrivUtilService.NumeronymGenerate:output_type -> privutil.NumeronymResponse
+	121, // 219: privutil.PrivUtilService.NatoAlphabet:output_type -> privutil.NatoResponse
+	124, // 220: privutil.PrivUtilService.ListProcess:output_type -> privutil.ListResponse
+	127, // 221: privutil.PrivUtilService.MathEval:output_type -> privutil.MathEvalResponse
+	129, // 222: privutil.PrivUtilService.PercentageCalc:output_type -> privutil.PercentageResponse
+	131, // 223: privutil.PrivUtilService.TempConvert:output_type -> privutil.TempConvertResponse
+	134, // 224: privutil.PrivUtilService.UnitConvert:output_type -> privutil.UnitConvertResponse
+	136, // 225: privutil.PrivUtilService.DateDiff:output_type -> privutil.DateDiffResponse
+	139, // 226: privutil.PrivUtilService.LeapYear:output_type -> privutil.LeapYearResponse
+	141, // 227: privutil.PrivUtilService.DateAdd:output_type -> privutil.DateAddResponse
+	144, // 228: privutil.PrivUtilService.DateFormat:output_type -> privutil.DateFormatResponse
+	146, // 229: privutil.PrivUtilService.DateInfo:output_type -> privutil.DateInfoResponse
+	149, // 230: privutil.PrivUtilService.UrlParse:output_type -> privutil.UrlParseResponse
+	152, // 231: privutil.PrivUtilService.UserAgentParse:output_type -> privutil.UserAgentParseResponse
+	155, // 232: privutil.PrivUtilService.HttpStatusSearch:output_type -> privutil.HttpStatusSearchResponse
+	158, // 233: privutil.PrivUtilService.MimeLookup:output_type -> privutil.MimeLookupResponse
+	160, // 234: privutil.PrivUtilService.DockerRunToCompose:output_type -> privutil.DockerRunToComposeResponse
+	164, // 235: privutil.PrivUtilService.GitCheatSheet:output_type -> privutil.GitCheatSheetResponse
+	166, // 236: privutil.PrivUtilService.SvgOptimize:output_type -> privutil.SvgOptimizeResponse
+	169, // 237: privutil.PrivUtilService.ExifRead:output_type -> privutil.ExifReadResponse
+	171, // 238: privutil.PrivUtilService.FileToBase64:output_type -> privutil.FileToBase64Response
+	173, // 239: privutil.PrivUtilService.Base64ToFile:output_type -> privutil.Base64ToFileResponse
+	176, // 240: privutil.PrivUtilService.TokenCount:output_type -> privutil.TokenCountResponse
+	179, // 241: privutil.PrivUtilService.SpellCheck:output_type -> privutil.SpellCheckResponse
+	182, // 242: privutil.PrivUtilService.SpellLanguages:output_type -> privutil.SpellLanguagesResponse
+	184, // 243: privutil.PrivUtilService.InferSchema:output_type -> privutil.InferSchemaResponse
+	186, // 244: privutil.PrivUtilService.JsonToCode:output_type -> privutil.JsonToCodeResponse
+	188, // 245: privutil.PrivUtilService.DataQuery:output_type -> privutil.DataQueryResponse
+	191, // 246: privutil.PrivUtilService.DataDiff:output_type -> privutil.DataDiffResponse
+	193, // 247: privutil.PrivUtilService.DataPatch:output_type -> privutil.DataPatchResponse
+	196, // 248: privutil.PrivUtilService.XmlFormat:output_type -> privutil.XmlFormatResponse
+	199, // 249: privutil.PrivUtilService.XmlXPath:output_type -> privutil.XPathResponse
+	202, // 250: privutil.PrivUtilService.XmlValidate:output_type -> privutil.XmlValidateResponse
+	205, // 251: privutil.PrivUtilService.ProtobufDecode:output_type -> privutil.ProtobufDecodeResponse
+	207, // 252: privutil.PrivUtilService.ColorContrast:output_type -> privutil.ColorContrastResponse
+	210, // 253: privutil.PrivUtilService.ColorPalette:output_type -> privutil.ColorPaletteResponse
+	214, // 254: privutil.PrivUtilService.ColorBlindness:output_type -> privutil.ColorBlindnessResponse
+	216, // 255: privutil.PrivUtilService.TableQuery:output_type -> privutil.TableQueryResponse
+	219, // 256: privutil.PrivUtilService.Merge3:output_type -> privutil.Merge3Response
+	222, // 257: privutil.PrivUtilService.PatchApply:output_type -> privutil.PatchApplyResponse
+	226, // 258: privutil.PrivUtilService.RegexExplain:output_type -> privutil.RegexExplainResponse
+	229, // 259: privutil.PrivUtilService.RegexGenerate:output_type -> privutil.RegexGenerateResponse
+	166, // [166:260] is the sub-list for method output_type
+	72,  // [72:166] is the sub-list for method input_type
+	72,  // [72:72] is the sub-list for extension type_name
+	72,  // [72:72] is the sub-list for extension extendee
+	0,   // [0:72] is the sub-list for field type_name
 }
 
 func init() { file_proto_privutil_proto_init() }
@@ -11004,14 +17333,15 @@ func file_proto_privutil_proto_init() {
 	if File_proto_privutil_proto != nil {
 		return
 	}
-	file_proto_privutil_proto_msgTypes[14].OneofWrappers = []any{}
+	file_proto_privutil_proto_msgTypes[19].OneofWrappers = []any{}
+	file_proto_privutil_proto_msgTypes[27].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_privutil_proto_rawDesc), len(file_proto_privutil_proto_rawDesc)),
-			NumEnums:      5,
-			NumMessages:   152,
+			NumEnums:      19,
+			NumMessages:   211,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
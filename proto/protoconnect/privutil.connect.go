@@ -55,6 +55,9 @@ const (
 	// PrivUtilServiceGenerateLoremProcedure is the fully-qualified name of the PrivUtilService's
 	// GenerateLorem RPC.
 	PrivUtilServiceGenerateLoremProcedure = "/privutil.PrivUtilService/GenerateLorem"
+	// PrivUtilServiceGenerateFakeDataProcedure is the fully-qualified name of the PrivUtilService's
+	// GenerateFakeData RPC.
+	PrivUtilServiceGenerateFakeDataProcedure = "/privutil.PrivUtilService/GenerateFakeData"
 	// PrivUtilServiceCalculateHashProcedure is the fully-qualified name of the PrivUtilService's
 	// CalculateHash RPC.
 	PrivUtilServiceCalculateHashProcedure = "/privutil.PrivUtilService/CalculateHash"
@@ -109,6 +112,11 @@ const (
 	// PrivUtilServiceSqlFormatProcedure is the fully-qualified name of the PrivUtilService's SqlFormat
 	// RPC.
 	PrivUtilServiceSqlFormatProcedure = "/privutil.PrivUtilService/SqlFormat"
+	// PrivUtilServiceDataToSqlProcedure is the fully-qualified name of the PrivUtilService's DataToSql
+	// RPC.
+	PrivUtilServiceDataToSqlProcedure = "/privutil.PrivUtilService/DataToSql"
+	// PrivUtilServiceSqlToGoProcedure is the fully-qualified name of the PrivUtilService's SqlToGo RPC.
+	PrivUtilServiceSqlToGoProcedure = "/privutil.PrivUtilService/SqlToGo"
 	// PrivUtilServiceIpCalcProcedure is the fully-qualified name of the PrivUtilService's IpCalc RPC.
 	PrivUtilServiceIpCalcProcedure = "/privutil.PrivUtilService/IpCalc"
 	// PrivUtilServiceGeneratePasswordProcedure is the fully-qualified name of the PrivUtilService's
@@ -250,6 +258,56 @@ const (
 	// PrivUtilServiceSpellLanguagesProcedure is the fully-qualified name of the PrivUtilService's
 	// SpellLanguages RPC.
 	PrivUtilServiceSpellLanguagesProcedure = "/privutil.PrivUtilService/SpellLanguages"
+	// PrivUtilServiceInferSchemaProcedure is the fully-qualified name of the PrivUtilService's
+	// InferSchema RPC.
+	PrivUtilServiceInferSchemaProcedure = "/privutil.PrivUtilService/InferSchema"
+	// PrivUtilServiceJsonToCodeProcedure is the fully-qualified name of the PrivUtilService's
+	// JsonToCode RPC.
+	PrivUtilServiceJsonToCodeProcedure = "/privutil.PrivUtilService/JsonToCode"
+	// PrivUtilServiceDataQueryProcedure is the fully-qualified name of the PrivUtilService's DataQuery
+	// RPC.
+	PrivUtilServiceDataQueryProcedure = "/privutil.PrivUtilService/DataQuery"
+	// PrivUtilServiceDataDiffProcedure is the fully-qualified name of the PrivUtilService's DataDiff
+	// RPC.
+	PrivUtilServiceDataDiffProcedure = "/privutil.PrivUtilService/DataDiff"
+	// PrivUtilServiceDataPatchProcedure is the fully-qualified name of the PrivUtilService's DataPatch
+	// RPC.
+	PrivUtilServiceDataPatchProcedure = "/privutil.PrivUtilService/DataPatch"
+	// PrivUtilServiceXmlFormatProcedure is the fully-qualified name of the PrivUtilService's XmlFormat
+	// RPC.
+	PrivUtilServiceXmlFormatProcedure = "/privutil.PrivUtilService/XmlFormat"
+	// PrivUtilServiceXmlXPathProcedure is the fully-qualified name of the PrivUtilService's XmlXPath
+	// RPC.
+	PrivUtilServiceXmlXPathProcedure = "/privutil.PrivUtilService/XmlXPath"
+	// PrivUtilServiceXmlValidateProcedure is the fully-qualified name of the PrivUtilService's
+	// XmlValidate RPC.
+	PrivUtilServiceXmlValidateProcedure = "/privutil.PrivUtilService/XmlValidate"
+	// PrivUtilServiceProtobufDecodeProcedure is the fully-qualified name of the PrivUtilService's
+	// ProtobufDecode RPC.
+	PrivUtilServiceProtobufDecodeProcedure = "/privutil.PrivUtilService/ProtobufDecode"
+	// PrivUtilServiceColorContrastProcedure is the fully-qualified name of the PrivUtilService's
+	// ColorContrast RPC.
+	PrivUtilServiceColorContrastProcedure = "/privutil.PrivUtilService/ColorContrast"
+	// PrivUtilServiceColorPaletteProcedure is the fully-qualified name of the PrivUtilService's
+	// ColorPalette RPC.
+	PrivUtilServiceColorPaletteProcedure = "/privutil.PrivUtilService/ColorPalette"
+	// PrivUtilServiceColorBlindnessProcedure is the fully-qualified name of the PrivUtilService's
+	// ColorBlindness RPC.
+	PrivUtilServiceColorBlindnessProcedure = "/privutil.PrivUtilService/ColorBlindness"
+	// PrivUtilServiceTableQueryProcedure is the fully-qualified name of the PrivUtilService's
+	// TableQuery RPC.
+	PrivUtilServiceTableQueryProcedure = "/privutil.PrivUtilService/TableQuery"
+	// PrivUtilServiceMerge3Procedure is the fully-qualified name of the PrivUtilService's Merge3 RPC.
+	PrivUtilServiceMerge3Procedure = "/privutil.PrivUtilService/Merge3"
+	// PrivUtilServicePatchApplyProcedure is the fully-qualified name of the PrivUtilService's
+	// PatchApply RPC.
+	PrivUtilServicePatchApplyProcedure = "/privutil.PrivUtilService/PatchApply"
+	// PrivUtilServiceRegexExplainProcedure is the fully-qualified name of the PrivUtilService's
+	// RegexExplain RPC.
+	PrivUtilServiceRegexExplainProcedure = "/privutil.PrivUtilService/RegexExplain"
+	// PrivUtilServiceRegexGenerateProcedure is the fully-qualified name of the PrivUtilService's
+	// RegexGenerate RPC.
+	PrivUtilServiceRegexGenerateProcedure = "/privutil.PrivUtilService/RegexGenerate"
 )
 
 // PrivUtilServiceClient is a client for the privutil.PrivUtilService service.
@@ -262,6 +320,7 @@ type PrivUtilServiceClient interface {
 	ValidateData(context.Context, *connect.Request[proto.ValidateRequest]) (*connect.Response[proto.ValidateResponse], error)
 	GenerateUuid(context.Context, *connect.Request[proto.UuidRequest]) (*connect.Response[proto.UuidResponse], error)
 	GenerateLorem(context.Context, *connect.Request[proto.LoremRequest]) (*connect.Response[proto.LoremResponse], error)
+	GenerateFakeData(context.Context, *connect.Request[proto.FakeDataRequest]) (*connect.Response[proto.FakeDataResponse], error)
 	CalculateHash(context.Context, *connect.Request[proto.HashRequest]) (*connect.Response[proto.HashResponse], error)
 	TextInspect(context.Context, *connect.Request[proto.TextInspectRequest]) (*connect.Response[proto.TextInspectResponse], error)
 	TextManipulate(context.Context, *connect.Request[proto.TextManipulateRequest]) (*connect.Response[proto.TextManipulateResponse], error)
@@ -280,6 +339,8 @@ type PrivUtilServiceClient interface {
 	StringEscape(context.Context, *connect.Request[proto.EscapeRequest]) (*connect.Response[proto.EscapeResponse], error)
 	TextSimilarity(context.Context, *connect.Request[proto.SimilarityRequest]) (*connect.Response[proto.SimilarityResponse], error)
 	SqlFormat(context.Context, *connect.Request[proto.SqlRequest]) (*connect.Response[proto.SqlResponse], error)
+	DataToSql(context.Context, *connect.Request[proto.DataToSqlRequest]) (*connect.Response[proto.DataToSqlResponse], error)
+	SqlToGo(context.Context, *connect.Request[proto.SqlToGoRequest]) (*connect.Response[proto.SqlToGoResponse], error)
 	IpCalc(context.Context, *connect.Request[proto.IpRequest]) (*connect.Response[proto.IpResponse], error)
 	GeneratePassword(context.Context, *connect.Request[proto.PasswordRequest]) (*connect.Response[proto.PasswordResponse], error)
 	GenerateRsaKeyPair(context.Context, *connect.Request[proto.RsaKeyRequest]) (*connect.Response[proto.RsaKeyResponse], error)
@@ -328,6 +389,23 @@ type PrivUtilServiceClient interface {
 	TokenCount(context.Context, *connect.Request[proto.TokenCountRequest]) (*connect.Response[proto.TokenCountResponse], error)
 	SpellCheck(context.Context, *connect.Request[proto.SpellCheckRequest]) (*connect.Response[proto.SpellCheckResponse], error)
 	SpellLanguages(context.Context, *connect.Request[proto.SpellLanguagesRequest]) (*connect.Response[proto.SpellLanguagesResponse], error)
+	InferSchema(context.Context, *connect.Request[proto.InferSchemaRequest]) (*connect.Response[proto.InferSchemaResponse], error)
+	JsonToCode(context.Context, *connect.Request[proto.JsonToCodeRequest]) (*connect.Response[proto.JsonToCodeResponse], error)
+	DataQuery(context.Context, *connect.Request[proto.DataQueryRequest]) (*connect.Response[proto.DataQueryResponse], error)
+	DataDiff(context.Context, *connect.Request[proto.DataDiffRequest]) (*connect.Response[proto.DataDiffResponse], error)
+	DataPatch(context.Context, *connect.Request[proto.DataPatchRequest]) (*connect.Response[proto.DataPatchResponse], error)
+	XmlFormat(context.Context, *connect.Request[proto.XmlFormatRequest]) (*connect.Response[proto.XmlFormatResponse], error)
+	XmlXPath(context.Context, *connect.Request[proto.XPathRequest]) (*connect.Response[proto.XPathResponse], error)
+	XmlValidate(context.Context, *connect.Request[proto.XmlValidateRequest]) (*connect.Response[proto.XmlValidateResponse], error)
+	ProtobufDecode(context.Context, *connect.Request[proto.ProtobufDecodeRequest]) (*connect.Response[proto.ProtobufDecodeResponse], error)
+	ColorContrast(context.Context, *connect.Request[proto.ColorContrastRequest]) (*connect.Response[proto.ColorContrastResponse], error)
+	ColorPalette(context.Context, *connect.Request[proto.ColorPaletteRequest]) (*connect.Response[proto.ColorPaletteResponse], error)
+	ColorBlindness(context.Context, *connect.Request[proto.ColorBlindnessRequest]) (*connect.Response[proto.ColorBlindnessResponse], error)
+	TableQuery(context.Context, *connect.Request[proto.TableQueryRequest]) (*connect.Response[proto.TableQueryResponse], error)
+	Merge3(context.Context, *connect.Request[proto.Merge3Request]) (*connect.Response[proto.Merge3Response], error)
+	PatchApply(context.Context, *connect.Request[proto.PatchApplyRequest]) (*connect.Response[proto.PatchApplyResponse], error)
+	RegexExplain(context.Context, *connect.Request[proto.RegexExplainRequest]) (*connect.Response[proto.RegexExplainResponse], error)
+	RegexGenerate(context.Context, *connect.Request[proto.RegexGenerateRequest]) (*connect.Response[proto.RegexGenerateResponse], error)
 }
 
 // NewPrivUtilServiceClient constructs a client for the privutil.PrivUtilService service. By
@@ -389,6 +467,12 @@ func NewPrivUtilServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(privUtilServiceMethods.ByName("GenerateLorem")),
 			connect.WithClientOptions(opts...),
 		),
+		generateFakeData: connect.NewClient[proto.FakeDataRequest, proto.FakeDataResponse](
+			httpClient,
+			baseURL+PrivUtilServiceGenerateFakeDataProcedure,
+			connect.WithSchema(privUtilServiceMethods.ByName("GenerateFakeData")),
+			connect.WithClientOptions(opts...),
+		),
 		calculateHash: connect.NewClient[proto.HashRequest, proto.HashResponse](
 			httpClient,
 			baseURL+PrivUtilServiceCalculateHashProcedure,
@@ -497,6 +581,18 @@ func NewPrivUtilServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(privUtilServiceMethods.ByName("SqlFormat")),
 			connect.WithClientOptions(opts...),
 		),
+		dataToSql: connect.NewClient[proto.DataToSqlRequest, proto.DataToSqlResponse](
+			httpClient,
+			baseURL+PrivUtilServiceDataToSqlProcedure,
+			connect.WithSchema(privUtilServiceMethods.ByName("DataToSql")),
+			connect.WithClientOptions(opts...),
+		),
+		sqlToGo: connect.NewClient[proto.SqlToGoRequest, proto.SqlToGoResponse](
+			httpClient,
+			baseURL+PrivUtilServiceSqlToGoProcedure,
+			connect.WithSchema(privUtilServiceMethods.ByName("SqlToGo")),
+			connect.WithClientOptions(opts...),
+		),
 		ipCalc: connect.NewClient[proto.IpRequest, proto.IpResponse](
 			httpClient,
 			baseURL+PrivUtilServiceIpCalcProcedure,
@@ -785,6 +881,108 @@ func NewPrivUtilServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(privUtilServiceMethods.ByName("SpellLanguages")),
 			connect.WithClientOptions(opts...),
 		),
+		inferSchema: connect.NewClient[proto.InferSchemaRequest, proto.InferSchemaResponse](
+			httpClient,
+			baseURL+PrivUtilServiceInferSchemaProcedure,
+			connect.WithSchema(privUtilServiceMethods.ByName("InferSchema")),
+			connect.WithClientOptions(opts...),
+		),
+		jsonToCode: connect.NewClient[proto.JsonToCodeRequest, proto.JsonToCodeResponse](
+			httpClient,
+			baseURL+PrivUtilServiceJsonToCodeProcedure,
+			connect.WithSchema(privUtilServiceMethods.ByName("JsonToCode")),
+			connect.WithClientOptions(opts...),
+		),
+		dataQuery: connect.NewClient[proto.DataQueryRequest, proto.DataQueryResponse](
+			httpClient,
+			baseURL+PrivUtilServiceDataQueryProcedure,
+			connect.WithSchema(privUtilServiceMethods.ByName("DataQuery")),
+			connect.WithClientOptions(opts...),
+		),
+		dataDiff: connect.NewClient[proto.DataDiffRequest, proto.DataDiffResponse](
+			httpClient,
+			baseURL+PrivUtilServiceDataDiffProcedure,
+			connect.WithSchema(privUtilServiceMethods.ByName("DataDiff")),
+			connect.WithClientOptions(opts...),
+		),
+		dataPatch: connect.NewClient[proto.DataPatchRequest, proto.DataPatchResponse](
+			httpClient,
+			baseURL+PrivUtilServiceDataPatchProcedure,
+			connect.WithSchema(privUtilServiceMethods.ByName("DataPatch")),
+			connect.WithClientOptions(opts...),
+		),
+		xmlFormat: connect.NewClient[proto.XmlFormatRequest, proto.XmlFormatResponse](
+			httpClient,
+			baseURL+PrivUtilServiceXmlFormatProcedure,
+			connect.WithSchema(privUtilServiceMethods.ByName("XmlFormat")),
+			connect.WithClientOptions(opts...),
+		),
+		xmlXPath: connect.NewClient[proto.XPathRequest, proto.XPathResponse](
+			httpClient,
+			baseURL+PrivUtilServiceXmlXPathProcedure,
+			connect.WithSchema(privUtilServiceMethods.ByName("XmlXPath")),
+			connect.WithClientOptions(opts...),
+		),
+		xmlValidate: connect.NewClient[proto.XmlValidateRequest, proto.XmlValidateResponse](
+			httpClient,
+			baseURL+PrivUtilServiceXmlValidateProcedure,
+			connect.WithSchema(privUtilServiceMethods.ByName("XmlValidate")),
+			connect.WithClientOptions(opts...),
+		),
+		protobufDecode: connect.NewClient[proto.ProtobufDecodeRequest, proto.ProtobufDecodeResponse](
+			httpClient,
+			baseURL+PrivUtilServiceProtobufDecodeProcedure,
+			connect.WithSchema(privUtilServiceMethods.ByName("ProtobufDecode")),
+			connect.WithClientOptions(opts...),
+		),
+		colorContrast: connect.NewClient[proto.ColorContrastRequest, proto.ColorContrastResponse](
+			httpClient,
+			baseURL+PrivUtilServiceColorContrastProcedure,
+			connect.WithSchema(privUtilServiceMethods.ByName("ColorContrast")),
+			connect.WithClientOptions(opts...),
+		),
+		colorPalette: connect.NewClient[proto.ColorPaletteRequest, proto.ColorPaletteResponse](
+			httpClient,
+			baseURL+PrivUtilServiceColorPaletteProcedure,
+			connect.WithSchema(privUtilServiceMethods.ByName("ColorPalette")),
+			connect.WithClientOptions(opts...),
+		),
+		colorBlindness: connect.NewClient[proto.ColorBlindnessRequest, proto.ColorBlindnessResponse](
+			httpClient,
+			baseURL+PrivUtilServiceColorBlindnessProcedure,
+			connect.WithSchema(privUtilServiceMethods.ByName("ColorBlindness")),
+			connect.WithClientOptions(opts...),
+		),
+		tableQuery: connect.NewClient[proto.TableQueryRequest, proto.TableQueryResponse](
+			httpClient,
+			baseURL+PrivUtilServiceTableQueryProcedure,
+			connect.WithSchema(privUtilServiceMethods.ByName("TableQuery")),
+			connect.WithClientOptions(opts...),
+		),
+		merge3: connect.NewClient[proto.Merge3Request, proto.Merge3Response](
+			httpClient,
+			baseURL+PrivUtilServiceMerge3Procedure,
+			connect.WithSchema(privUtilServiceMethods.ByName("Merge3")),
+			connect.WithClientOptions(opts...),
+		),
+		patchApply: connect.NewClient[proto.PatchApplyRequest, proto.PatchApplyResponse](
+			httpClient,
+			baseURL+PrivUtilServicePatchApplyProcedure,
+			connect.WithSchema(privUtilServiceMethods.ByName("PatchApply")),
+			connect.WithClientOptions(opts...),
+		),
+		regexExplain: connect.NewClient[proto.RegexExplainRequest, proto.RegexExplainResponse](
+			httpClient,
+			baseURL+PrivUtilServiceRegexExplainProcedure,
+			connect.WithSchema(privUtilServiceMethods.ByName("RegexExplain")),
+			connect.WithClientOptions(opts...),
+		),
+		regexGenerate: connect.NewClient[proto.RegexGenerateRequest, proto.RegexGenerateResponse](
+			httpClient,
+			baseURL+PrivUtilServiceRegexGenerateProcedure,
+			connect.WithSchema(privUtilServiceMethods.ByName("RegexGenerate")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -798,6 +996,7 @@ type privUtilServiceClient struct {
 	validateData       *connect.Client[proto.ValidateRequest, proto.ValidateResponse]
 	generateUuid       *connect.Client[proto.UuidRequest, proto.UuidResponse]
 	generateLorem      *connect.Client[proto.LoremRequest, proto.LoremResponse]
+	generateFakeData   *connect.Client[proto.FakeDataRequest, proto.FakeDataResponse]
 	calculateHash      *connect.Client[proto.HashRequest, proto.HashResponse]
 	textInspect        *connect.Client[proto.TextInspectRequest, proto.TextInspectResponse]
 	textManipulate     *connect.Client[proto.TextManipulateRequest, proto.TextManipulateResponse]
@@ -816,6 +1015,8 @@ type privUtilServiceClient struct {
 	stringEscape       *connect.Client[proto.EscapeRequest, proto.EscapeResponse]
 	textSimilarity     *connect.Client[proto.SimilarityRequest, proto.SimilarityResponse]
 	sqlFormat          *connect.Client[proto.SqlRequest, proto.SqlResponse]
+	dataToSql          *connect.Client[proto.DataToSqlRequest, proto.DataToSqlResponse]
+	sqlToGo            *connect.Client[proto.SqlToGoRequest, proto.SqlToGoResponse]
 	ipCalc             *connect.Client[proto.IpRequest, proto.IpResponse]
 	generatePassword   *connect.Client[proto.PasswordRequest, proto.PasswordResponse]
 	generateRsaKeyPair *connect.Client[proto.RsaKeyRequest, proto.RsaKeyResponse]
@@ -864,6 +1065,23 @@ type privUtilServiceClient struct {
 	tokenCount         *connect.Client[proto.TokenCountRequest, proto.TokenCountResponse]
 	spellCheck         *connect.Client[proto.SpellCheckRequest, proto.SpellCheckResponse]
 	spellLanguages     *connect.Client[proto.SpellLanguagesRequest, proto.SpellLanguagesResponse]
+	inferSchema        *connect.Client[proto.InferSchemaRequest, proto.InferSchemaResponse]
+	jsonToCode         *connect.Client[proto.JsonToCodeRequest, proto.JsonToCodeResponse]
+	dataQuery          *connect.Client[proto.DataQueryRequest, proto.DataQueryResponse]
+	dataDiff           *connect.Client[proto.DataDiffRequest, proto.DataDiffResponse]
+	dataPatch          *connect.Client[proto.DataPatchRequest, proto.DataPatchResponse]
+	xmlFormat          *connect.Client[proto.XmlFormatRequest, proto.XmlFormatResponse]
+	xmlXPath           *connect.Client[proto.XPathRequest, proto.XPathResponse]
+	xmlValidate        *connect.Client[proto.XmlValidateRequest, proto.XmlValidateResponse]
+	protobufDecode     *connect.Client[proto.ProtobufDecodeRequest, proto.ProtobufDecodeResponse]
+	colorContrast      *connect.Client[proto.ColorContrastRequest, proto.ColorContrastResponse]
+	colorPalette       *connect.Client[proto.ColorPaletteRequest, proto.ColorPaletteResponse]
+	colorBlindness     *connect.Client[proto.ColorBlindnessRequest, proto.ColorBlindnessResponse]
+	tableQuery         *connect.Client[proto.TableQueryRequest, proto.TableQueryResponse]
+	merge3             *connect.Client[proto.Merge3Request, proto.Merge3Response]
+	patchApply         *connect.Client[proto.PatchApplyRequest, proto.PatchApplyResponse]
+	regexExplain       *connect.Client[proto.RegexExplainRequest, proto.RegexExplainResponse]
+	regexGenerate      *connect.Client[proto.RegexGenerateRequest, proto.RegexGenerateResponse]
 }
 
 // Diff calls privutil.PrivUtilService.Diff.
@@ -906,6 +1124,11 @@ func (c *privUtilServiceClient) GenerateLorem(ctx context.Context, req *connect.
 	return c.generateLorem.CallUnary(ctx, req)
 }
 
+// GenerateFakeData calls privutil.PrivUtilService.GenerateFakeData.
+func (c *privUtilServiceClient) GenerateFakeData(ctx context.Context, req *connect.Request[proto.FakeDataRequest]) (*connect.Response[proto.FakeDataResponse], error) {
+	return c.generateFakeData.CallUnary(ctx, req)
+}
+
 // CalculateHash calls privutil.PrivUtilService.CalculateHash.
 func (c *privUtilServiceClient) CalculateHash(ctx context.Context, req *connect.Request[proto.HashRequest]) (*connect.Response[proto.HashResponse], error) {
 	return c.calculateHash.CallUnary(ctx, req)
@@ -996,6 +1219,16 @@ func (c *privUtilServiceClient) SqlFormat(ctx context.Context, req *connect.Requ
 	return c.sqlFormat.CallUnary(ctx, req)
 }
 
+// DataToSql calls privutil.PrivUtilService.DataToSql.
+func (c *privUtilServiceClient) DataToSql(ctx context.Context, req *connect.Request[proto.DataToSqlRequest]) (*connect.Response[proto.DataToSqlResponse], error) {
+	return c.dataToSql.CallUnary(ctx, req)
+}
+
+// SqlToGo calls privutil.PrivUtilService.SqlToGo.
+func (c *privUtilServiceClient) SqlToGo(ctx context.Context, req *connect.Request[proto.SqlToGoRequest]) (*connect.Response[proto.SqlToGoResponse], error) {
+	return c.sqlToGo.CallUnary(ctx, req)
+}
+
 // IpCalc calls privutil.PrivUtilService.IpCalc.
 func (c *privUtilServiceClient) IpCalc(ctx context.Context, req *connect.Request[proto.IpRequest]) (*connect.Response[proto.IpResponse], error) {
 	return c.ipCalc.CallUnary(ctx, req)
@@ -1236,6 +1469,91 @@ func (c *privUtilServiceClient) SpellLanguages(ctx context.Context, req *connect
 	return c.spellLanguages.CallUnary(ctx, req)
 }
 
+// InferSchema calls privutil.PrivUtilService.InferSchema.
+func (c *privUtilServiceClient) InferSchema(ctx context.Context, req *connect.Request[proto.InferSchemaRequest]) (*connect.Response[proto.InferSchemaResponse], error) {
+	return c.inferSchema.CallUnary(ctx, req)
+}
+
+// JsonToCode calls privutil.PrivUtilService.JsonToCode.
+func (c *privUtilServiceClient) JsonToCode(ctx context.Context, req *connect.Request[proto.JsonToCodeRequest]) (*connect.Response[proto.JsonToCodeResponse], error) {
+	return c.jsonToCode.CallUnary(ctx, req)
+}
+
+// DataQuery calls privutil.PrivUtilService.DataQuery.
+func (c *privUtilServiceClient) DataQuery(ctx context.Context, req *connect.Request[proto.DataQueryRequest]) (*connect.Response[proto.DataQueryResponse], error) {
+	return c.dataQuery.CallUnary(ctx, req)
+}
+
+// DataDiff calls privutil.PrivUtilService.DataDiff.
+func (c *privUtilServiceClient) DataDiff(ctx context.Context, req *connect.Request[proto.DataDiffRequest]) (*connect.Response[proto.DataDiffResponse], error) {
+	return c.dataDiff.CallUnary(ctx, req)
+}
+
+// DataPatch calls privutil.PrivUtilService.DataPatch.
+func (c *privUtilServiceClient) DataPatch(ctx context.Context, req *connect.Request[proto.DataPatchRequest]) (*connect.Response[proto.DataPatchResponse], error) {
+	return c.dataPatch.CallUnary(ctx, req)
+}
+
+// XmlFormat calls privutil.PrivUtilService.XmlFormat.
+func (c *privUtilServiceClient) XmlFormat(ctx context.Context, req *connect.Request[proto.XmlFormatRequest]) (*connect.Response[proto.XmlFormatResponse], error) {
+	return c.xmlFormat.CallUnary(ctx, req)
+}
+
+// XmlXPath calls privutil.PrivUtilService.XmlXPath.
+func (c *privUtilServiceClient) XmlXPath(ctx context.Context, req *connect.Request[proto.XPathRequest]) (*connect.Response[proto.XPathResponse], error) {
+	return c.xmlXPath.CallUnary(ctx, req)
+}
+
+// XmlValidate calls privutil.PrivUtilService.XmlValidate.
+func (c *privUtilServiceClient) XmlValidate(ctx context.Context, req *connect.Request[proto.XmlValidateRequest]) (*connect.Response[proto.XmlValidateResponse], error) {
+	return c.xmlValidate.CallUnary(ctx, req)
+}
+
+// ProtobufDecode calls privutil.PrivUtilService.ProtobufDecode.
+func (c *privUtilServiceClient) ProtobufDecode(ctx context.Context, req *connect.Request[proto.ProtobufDecodeRequest]) (*connect.Response[proto.ProtobufDecodeResponse], error) {
+	return c.protobufDecode.CallUnary(ctx, req)
+}
+
+// ColorContrast calls privutil.PrivUtilService.ColorContrast.
+func (c *privUtilServiceClient) ColorContrast(ctx context.Context, req *connect.Request[proto.ColorContrastRequest]) (*connect.Response[proto.ColorContrastResponse], error) {
+	return c.colorContrast.CallUnary(ctx, req)
+}
+
+// ColorPalette calls privutil.PrivUtilService.ColorPalette.
+func (c *privUtilServiceClient) ColorPalette(ctx context.Context, req *connect.Request[proto.ColorPaletteRequest]) (*connect.Response[proto.ColorPaletteResponse], error) {
+	return c.colorPalette.CallUnary(ctx, req)
+}
+
+// ColorBlindness calls privutil.PrivUtilService.ColorBlindness.
+func (c *privUtilServiceClient) ColorBlindness(ctx context.Context, req *connect.Request[proto.ColorBlindnessRequest]) (*connect.Response[proto.ColorBlindnessResponse], error) {
+	return c.colorBlindness.CallUnary(ctx, req)
+}
+
+// TableQuery calls privutil.PrivUtilService.TableQuery.
+func (c *privUtilServiceClient) TableQuery(ctx context.Context, req *connect.Request[proto.TableQueryRequest]) (*connect.Response[proto.TableQueryResponse], error) {
+	return c.tableQuery.CallUnary(ctx, req)
+}
+
+// Merge3 calls privutil.PrivUtilService.Merge3.
+func (c *privUtilServiceClient) Merge3(ctx context.Context, req *connect.Request[proto.Merge3Request]) (*connect.Response[proto.Merge3Response], error) {
+	return c.merge3.CallUnary(ctx, req)
+}
+
+// PatchApply calls privutil.PrivUtilService.PatchApply.
+func (c *privUtilServiceClient) PatchApply(ctx context.Context, req *connect.Request[proto.PatchApplyRequest]) (*connect.Response[proto.PatchApplyResponse], error) {
+	return c.patchApply.CallUnary(ctx, req)
+}
+
+// RegexExplain calls privutil.PrivUtilService.RegexExplain.
+func (c *privUtilServiceClient) RegexExplain(ctx context.Context, req *connect.Request[proto.RegexExplainRequest]) (*connect.Response[proto.RegexExplainResponse], error) {
+	return c.regexExplain.CallUnary(ctx, req)
+}
+
+// RegexGenerate calls privutil.PrivUtilService.RegexGenerate.
+func (c *privUtilServiceClient) RegexGenerate(ctx context.Context, req *connect.Request[proto.RegexGenerateRequest]) (*connect.Response[proto.RegexGenerateResponse], error) {
+	return c.regexGenerate.CallUnary(ctx, req)
+}
+
 // PrivUtilServiceHandler is an implementation of the privutil.PrivUtilService service.
 type PrivUtilServiceHandler interface {
 	Diff(context.Context, *connect.Request[proto.DiffRequest]) (*connect.Response[proto.DiffResponse], error)
@@ -1246,6 +1564,7 @@ type PrivUtilServiceHandler interface {
 	ValidateData(context.Context, *connect.Request[proto.ValidateRequest]) (*connect.Response[proto.ValidateResponse], error)
 	GenerateUuid(context.Context, *connect.Request[proto.UuidRequest]) (*connect.Response[proto.UuidResponse], error)
 	GenerateLorem(context.Context, *connect.Request[proto.LoremRequest]) (*connect.Response[proto.LoremResponse], error)
+	GenerateFakeData(context.Context, *connect.Request[proto.FakeDataRequest]) (*connect.Response[proto.FakeDataResponse], error)
 	CalculateHash(context.Context, *connect.Request[proto.HashRequest]) (*connect.Response[proto.HashResponse], error)
 	TextInspect(context.Context, *connect.Request[proto.TextInspectRequest]) (*connect.Response[proto.TextInspectResponse], error)
 	TextManipulate(context.Context, *connect.Request[proto.TextManipulateRequest]) (*connect.Response[proto.TextManipulateResponse], error)
@@ -1264,6 +1583,8 @@ type PrivUtilServiceHandler interface {
 	StringEscape(context.Context, *connect.Request[proto.EscapeRequest]) (*connect.Response[proto.EscapeResponse], error)
 	TextSimilarity(context.Context, *connect.Request[proto.SimilarityRequest]) (*connect.Response[proto.SimilarityResponse], error)
 	SqlFormat(context.Context, *connect.Request[proto.SqlRequest]) (*connect.Response[proto.SqlResponse], error)
+	DataToSql(context.Context, *connect.Request[proto.DataToSqlRequest]) (*connect.Response[proto.DataToSqlResponse], error)
+	SqlToGo(context.Context, *connect.Request[proto.SqlToGoRequest]) (*connect.Response[proto.SqlToGoResponse], error)
 	IpCalc(context.Context, *connect.Request[proto.IpRequest]) (*connect.Response[proto.IpResponse], error)
 	GeneratePassword(context.Context, *connect.Request[proto.PasswordRequest]) (*connect.Response[proto.PasswordResponse], error)
 	GenerateRsaKeyPair(context.Context, *connect.Request[proto.RsaKeyRequest]) (*connect.Response[proto.RsaKeyResponse], error)
@@ -1312,6 +1633,23 @@ type PrivUtilServiceHandler interface {
 	TokenCount(context.Context, *connect.Request[proto.TokenCountRequest]) (*connect.Response[proto.TokenCountResponse], error)
 	SpellCheck(context.Context, *connect.Request[proto.SpellCheckRequest]) (*connect.Response[proto.SpellCheckResponse], error)
 	SpellLanguages(context.Context, *connect.Request[proto.SpellLanguagesRequest]) (*connect.Response[proto.SpellLanguagesResponse], error)
+	InferSchema(context.Context, *connect.Request[proto.InferSchemaRequest]) (*connect.Response[proto.InferSchemaResponse], error)
+	JsonToCode(context.Context, *connect.Request[proto.JsonToCodeRequest]) (*connect.Response[proto.JsonToCodeResponse], error)
+	DataQuery(context.Context, *connect.Request[proto.DataQueryRequest]) (*connect.Response[proto.DataQueryResponse], error)
+	DataDiff(context.Context, *connect.Request[proto.DataDiffRequest]) (*connect.Response[proto.DataDiffResponse], error)
+	DataPatch(context.Context, *connect.Request[proto.DataPatchRequest]) (*connect.Response[proto.DataPatchResponse], error)
+	XmlFormat(context.Context, *connect.Request[proto.XmlFormatRequest]) (*connect.Response[proto.XmlFormatResponse], error)
+	XmlXPath(context.Context, *connect.Request[proto.XPathRequest]) (*connect.Response[proto.XPathResponse], error)
+	XmlValidate(context.Context, *connect.Request[proto.XmlValidateRequest]) (*connect.Response[proto.XmlValidateResponse], error)
+	ProtobufDecode(context.Context, *connect.Request[proto.ProtobufDecodeRequest]) (*connect.Response[proto.ProtobufDecodeResponse], error)
+	ColorContrast(context.Context, *connect.Request[proto.ColorContrastRequest]) (*connect.Response[proto.ColorContrastResponse], error)
+	ColorPalette(context.Context, *connect.Request[proto.ColorPaletteRequest]) (*connect.Response[proto.ColorPaletteResponse], error)
+	ColorBlindness(context.Context, *connect.Request[proto.ColorBlindnessRequest]) (*connect.Response[proto.ColorBlindnessResponse], error)
+	TableQuery(context.Context, *connect.Request[proto.TableQueryRequest]) (*connect.Response[proto.TableQueryResponse], error)
+	Merge3(context.Context, *connect.Request[proto.Merge3Request]) (*connect.Response[proto.Merge3Response], error)
+	PatchApply(context.Context, *connect.Request[proto.PatchApplyRequest]) (*connect.Response[proto.PatchApplyResponse], error)
+	RegexExplain(context.Context, *connect.Request[proto.RegexExplainRequest]) (*connect.Response[proto.RegexExplainResponse], error)
+	RegexGenerate(context.Context, *connect.Request[proto.RegexGenerateRequest]) (*connect.Response[proto.RegexGenerateResponse], error)
 }
 
 // NewPrivUtilServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -1369,6 +1707,12 @@ func NewPrivUtilServiceHandler(svc PrivUtilServiceHandler, opts ...connect.Handl
 		connect.WithSchema(privUtilServiceMethods.ByName("GenerateLorem")),
 		connect.WithHandlerOptions(opts...),
 	)
+	privUtilServiceGenerateFakeDataHandler := connect.NewUnaryHandler(
+		PrivUtilServiceGenerateFakeDataProcedure,
+		svc.GenerateFakeData,
+		connect.WithSchema(privUtilServiceMethods.ByName("GenerateFakeData")),
+		connect.WithHandlerOptions(opts...),
+	)
 	privUtilServiceCalculateHashHandler := connect.NewUnaryHandler(
 		PrivUtilServiceCalculateHashProcedure,
 		svc.CalculateHash,
@@ -1477,6 +1821,18 @@ func NewPrivUtilServiceHandler(svc PrivUtilServiceHandler, opts ...connect.Handl
 		connect.WithSchema(privUtilServiceMethods.ByName("SqlFormat")),
 		connect.WithHandlerOptions(opts...),
 	)
+	privUtilServiceDataToSqlHandler := connect.NewUnaryHandler(
+		PrivUtilServiceDataToSqlProcedure,
+		svc.DataToSql,
+		connect.WithSchema(privUtilServiceMethods.ByName("DataToSql")),
+		connect.WithHandlerOptions(opts...),
+	)
+	privUtilServiceSqlToGoHandler := connect.NewUnaryHandler(
+		PrivUtilServiceSqlToGoProcedure,
+		svc.SqlToGo,
+		connect.WithSchema(privUtilServiceMethods.ByName("SqlToGo")),
+		connect.WithHandlerOptions(opts...),
+	)
 	privUtilServiceIpCalcHandler := connect.NewUnaryHandler(
 		PrivUtilServiceIpCalcProcedure,
 		svc.IpCalc,
@@ -1765,6 +2121,108 @@ func NewPrivUtilServiceHandler(svc PrivUtilServiceHandler, opts ...connect.Handl
 		connect.WithSchema(privUtilServiceMethods.ByName("SpellLanguages")),
 		connect.WithHandlerOptions(opts...),
 	)
+	privUtilServiceInferSchemaHandler := connect.NewUnaryHandler(
+		PrivUtilServiceInferSchemaProcedure,
+		svc.InferSchema,
+		connect.WithSchema(privUtilServiceMethods.ByName("InferSchema")),
+		connect.WithHandlerOptions(opts...),
+	)
+	privUtilServiceJsonToCodeHandler := connect.NewUnaryHandler(
+		PrivUtilServiceJsonToCodeProcedure,
+		svc.JsonToCode,
+		connect.WithSchema(privUtilServiceMethods.ByName("JsonToCode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	privUtilServiceDataQueryHandler := connect.NewUnaryHandler(
+		PrivUtilServiceDataQueryProcedure,
+		svc.DataQuery,
+		connect.WithSchema(privUtilServiceMethods.ByName("DataQuery")),
+		connect.WithHandlerOptions(opts...),
+	)
+	privUtilServiceDataDiffHandler := connect.NewUnaryHandler(
+		PrivUtilServiceDataDiffProcedure,
+		svc.DataDiff,
+		connect.WithSchema(privUtilServiceMethods.ByName("DataDiff")),
+		connect.WithHandlerOptions(opts...),
+	)
+	privUtilServiceDataPatchHandler := connect.NewUnaryHandler(
+		PrivUtilServiceDataPatchProcedure,
+		svc.DataPatch,
+		connect.WithSchema(privUtilServiceMethods.ByName("DataPatch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	privUtilServiceXmlFormatHandler := connect.NewUnaryHandler(
+		PrivUtilServiceXmlFormatProcedure,
+		svc.XmlFormat,
+		connect.WithSchema(privUtilServiceMethods.ByName("XmlFormat")),
+		connect.WithHandlerOptions(opts...),
+	)
+	privUtilServiceXmlXPathHandler := connect.NewUnaryHandler(
+		PrivUtilServiceXmlXPathProcedure,
+		svc.XmlXPath,
+		connect.WithSchema(privUtilServiceMethods.ByName("XmlXPath")),
+		connect.WithHandlerOptions(opts...),
+	)
+	privUtilServiceXmlValidateHandler := connect.NewUnaryHandler(
+		PrivUtilServiceXmlValidateProcedure,
+		svc.XmlValidate,
+		connect.WithSchema(privUtilServiceMethods.ByName("XmlValidate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	privUtilServiceProtobufDecodeHandler := connect.NewUnaryHandler(
+		PrivUtilServiceProtobufDecodeProcedure,
+		svc.ProtobufDecode,
+		connect.WithSchema(privUtilServiceMethods.ByName("ProtobufDecode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	privUtilServiceColorContrastHandler := connect.NewUnaryHandler(
+		PrivUtilServiceColorContrastProcedure,
+		svc.ColorContrast,
+		connect.WithSchema(privUtilServiceMethods.ByName("ColorContrast")),
+		connect.WithHandlerOptions(opts...),
+	)
+	privUtilServiceColorPaletteHandler := connect.NewUnaryHandler(
+		PrivUtilServiceColorPaletteProcedure,
+		svc.ColorPalette,
+		connect.WithSchema(privUtilServiceMethods.ByName("ColorPalette")),
+		connect.WithHandlerOptions(opts...),
+	)
+	privUtilServiceColorBlindnessHandler := connect.NewUnaryHandler(
+		PrivUtilServiceColorBlindnessProcedure,
+		svc.ColorBlindness,
+		connect.WithSchema(privUtilServiceMethods.ByName("ColorBlindness")),
+		connect.WithHandlerOptions(opts...),
+	)
+	privUtilServiceTableQueryHandler := connect.NewUnaryHandler(
+		PrivUtilServiceTableQueryProcedure,
+		svc.TableQuery,
+		connect.WithSchema(privUtilServiceMethods.ByName("TableQuery")),
+		connect.WithHandlerOptions(opts...),
+	)
+	privUtilServiceMerge3Handler := connect.NewUnaryHandler(
+		PrivUtilServiceMerge3Procedure,
+		svc.Merge3,
+		connect.WithSchema(privUtilServiceMethods.ByName("Merge3")),
+		connect.WithHandlerOptions(opts...),
+	)
+	privUtilServicePatchApplyHandler := connect.NewUnaryHandler(
+		PrivUtilServicePatchApplyProcedure,
+		svc.PatchApply,
+		connect.WithSchema(privUtilServiceMethods.ByName("PatchApply")),
+		connect.WithHandlerOptions(opts...),
+	)
+	privUtilServiceRegexExplainHandler := connect.NewUnaryHandler(
+		PrivUtilServiceRegexExplainProcedure,
+		svc.RegexExplain,
+		connect.WithSchema(privUtilServiceMethods.ByName("RegexExplain")),
+		connect.WithHandlerOptions(opts...),
+	)
+	privUtilServiceRegexGenerateHandler := connect.NewUnaryHandler(
+		PrivUtilServiceRegexGenerateProcedure,
+		svc.RegexGenerate,
+		connect.WithSchema(privUtilServiceMethods.ByName("RegexGenerate")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/privutil.PrivUtilService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case PrivUtilServiceDiffProcedure:
@@ -1783,6 +2241,8 @@ func NewPrivUtilServiceHandler(svc PrivUtilServiceHandler, opts ...connect.Handl
 			privUtilServiceGenerateUuidHandler.ServeHTTP(w, r)
 		case PrivUtilServiceGenerateLoremProcedure:
 			privUtilServiceGenerateLoremHandler.ServeHTTP(w, r)
+		case PrivUtilServiceGenerateFakeDataProcedure:
+			privUtilServiceGenerateFakeDataHandler.ServeHTTP(w, r)
 		case PrivUtilServiceCalculateHashProcedure:
 			privUtilServiceCalculateHashHandler.ServeHTTP(w, r)
 		case PrivUtilServiceTextInspectProcedure:
@@ -1819,6 +2279,10 @@ func NewPrivUtilServiceHandler(svc PrivUtilServiceHandler, opts ...connect.Handl
 			privUtilServiceTextSimilarityHandler.ServeHTTP(w, r)
 		case PrivUtilServiceSqlFormatProcedure:
 			privUtilServiceSqlFormatHandler.ServeHTTP(w, r)
+		case PrivUtilServiceDataToSqlProcedure:
+			privUtilServiceDataToSqlHandler.ServeHTTP(w, r)
+		case PrivUtilServiceSqlToGoProcedure:
+			privUtilServiceSqlToGoHandler.ServeHTTP(w, r)
 		case PrivUtilServiceIpCalcProcedure:
 			privUtilServiceIpCalcHandler.ServeHTTP(w, r)
 		case PrivUtilServiceGeneratePasswordProcedure:
@@ -1915,6 +2379,40 @@ func NewPrivUtilServiceHandler(svc PrivUtilServiceHandler, opts ...connect.Handl
 			privUtilServiceSpellCheckHandler.ServeHTTP(w, r)
 		case PrivUtilServiceSpellLanguagesProcedure:
 			privUtilServiceSpellLanguagesHandler.ServeHTTP(w, r)
+		case PrivUtilServiceInferSchemaProcedure:
+			privUtilServiceInferSchemaHandler.ServeHTTP(w, r)
+		case PrivUtilServiceJsonToCodeProcedure:
+			privUtilServiceJsonToCodeHandler.ServeHTTP(w, r)
+		case PrivUtilServiceDataQueryProcedure:
+			privUtilServiceDataQueryHandler.ServeHTTP(w, r)
+		case PrivUtilServiceDataDiffProcedure:
+			privUtilServiceDataDiffHandler.ServeHTTP(w, r)
+		case PrivUtilServiceDataPatchProcedure:
+			privUtilServiceDataPatchHandler.ServeHTTP(w, r)
+		case PrivUtilServiceXmlFormatProcedure:
+			privUtilServiceXmlFormatHandler.ServeHTTP(w, r)
+		case PrivUtilServiceXmlXPathProcedure:
+			privUtilServiceXmlXPathHandler.ServeHTTP(w, r)
+		case PrivUtilServiceXmlValidateProcedure:
+			privUtilServiceXmlValidateHandler.ServeHTTP(w, r)
+		case PrivUtilServiceProtobufDecodeProcedure:
+			privUtilServiceProtobufDecodeHandler.ServeHTTP(w, r)
+		case PrivUtilServiceColorContrastProcedure:
+			privUtilServiceColorContrastHandler.ServeHTTP(w, r)
+		case PrivUtilServiceColorPaletteProcedure:
+			privUtilServiceColorPaletteHandler.ServeHTTP(w, r)
+		case PrivUtilServiceColorBlindnessProcedure:
+			privUtilServiceColorBlindnessHandler.ServeHTTP(w, r)
+		case PrivUtilServiceTableQueryProcedure:
+			privUtilServiceTableQueryHandler.ServeHTTP(w, r)
+		case PrivUtilServiceMerge3Procedure:
+			privUtilServiceMerge3Handler.ServeHTTP(w, r)
+		case PrivUtilServicePatchApplyProcedure:
+			privUtilServicePatchApplyHandler.ServeHTTP(w, r)
+		case PrivUtilServiceRegexExplainProcedure:
+			privUtilServiceRegexExplainHandler.ServeHTTP(w, r)
+		case PrivUtilServiceRegexGenerateProcedure:
+			privUtilServiceRegexGenerateHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1956,6 +2454,10 @@ func (UnimplementedPrivUtilServiceHandler) GenerateLorem(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.GenerateLorem is not implemented"))
 }
 
+func (UnimplementedPrivUtilServiceHandler) GenerateFakeData(context.Context, *connect.Request[proto.FakeDataRequest]) (*connect.Response[proto.FakeDataResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.GenerateFakeData is not implemented"))
+}
+
 func (UnimplementedPrivUtilServiceHandler) CalculateHash(context.Context, *connect.Request[proto.HashRequest]) (*connect.Response[proto.HashResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.CalculateHash is not implemented"))
 }
@@ -2028,6 +2530,14 @@ func (UnimplementedPrivUtilServiceHandler) SqlFormat(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.SqlFormat is not implemented"))
 }
 
+func (UnimplementedPrivUtilServiceHandler) DataToSql(context.Context, *connect.Request[proto.DataToSqlRequest]) (*connect.Response[proto.DataToSqlResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.DataToSql is not implemented"))
+}
+
+func (UnimplementedPrivUtilServiceHandler) SqlToGo(context.Context, *connect.Request[proto.SqlToGoRequest]) (*connect.Response[proto.SqlToGoResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.SqlToGo is not implemented"))
+}
+
 func (UnimplementedPrivUtilServiceHandler) IpCalc(context.Context, *connect.Request[proto.IpRequest]) (*connect.Response[proto.IpResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.IpCalc is not implemented"))
 }
@@ -2219,3 +2729,71 @@ func (UnimplementedPrivUtilServiceHandler) SpellCheck(context.Context, *connect.
 func (UnimplementedPrivUtilServiceHandler) SpellLanguages(context.Context, *connect.Request[proto.SpellLanguagesRequest]) (*connect.Response[proto.SpellLanguagesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.SpellLanguages is not implemented"))
 }
+
+func (UnimplementedPrivUtilServiceHandler) InferSchema(context.Context, *connect.Request[proto.InferSchemaRequest]) (*connect.Response[proto.InferSchemaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.InferSchema is not implemented"))
+}
+
+func (UnimplementedPrivUtilServiceHandler) JsonToCode(context.Context, *connect.Request[proto.JsonToCodeRequest]) (*connect.Response[proto.JsonToCodeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.JsonToCode is not implemented"))
+}
+
+func (UnimplementedPrivUtilServiceHandler) DataQuery(context.Context, *connect.Request[proto.DataQueryRequest]) (*connect.Response[proto.DataQueryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.DataQuery is not implemented"))
+}
+
+func (UnimplementedPrivUtilServiceHandler) DataDiff(context.Context, *connect.Request[proto.DataDiffRequest]) (*connect.Response[proto.DataDiffResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.DataDiff is not implemented"))
+}
+
+func (UnimplementedPrivUtilServiceHandler) DataPatch(context.Context, *connect.Request[proto.DataPatchRequest]) (*connect.Response[proto.DataPatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.DataPatch is not implemented"))
+}
+
+func (UnimplementedPrivUtilServiceHandler) XmlFormat(context.Context, *connect.Request[proto.XmlFormatRequest]) (*connect.Response[proto.XmlFormatResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.XmlFormat is not implemented"))
+}
+
+func (UnimplementedPrivUtilServiceHandler) XmlXPath(context.Context, *connect.Request[proto.XPathRequest]) (*connect.Response[proto.XPathResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.XmlXPath is not implemented"))
+}
+
+func (UnimplementedPrivUtilServiceHandler) XmlValidate(context.Context, *connect.Request[proto.XmlValidateRequest]) (*connect.Response[proto.XmlValidateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.XmlValidate is not implemented"))
+}
+
+func (UnimplementedPrivUtilServiceHandler) ProtobufDecode(context.Context, *connect.Request[proto.ProtobufDecodeRequest]) (*connect.Response[proto.ProtobufDecodeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.ProtobufDecode is not implemented"))
+}
+
+func (UnimplementedPrivUtilServiceHandler) ColorContrast(context.Context, *connect.Request[proto.ColorContrastRequest]) (*connect.Response[proto.ColorContrastResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.ColorContrast is not implemented"))
+}
+
+func (UnimplementedPrivUtilServiceHandler) ColorPalette(context.Context, *connect.Request[proto.ColorPaletteRequest]) (*connect.Response[proto.ColorPaletteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.ColorPalette is not implemented"))
+}
+
+func (UnimplementedPrivUtilServiceHandler) ColorBlindness(context.Context, *connect.Request[proto.ColorBlindnessRequest]) (*connect.Response[proto.ColorBlindnessResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.ColorBlindness is not implemented"))
+}
+
+func (UnimplementedPrivUtilServiceHandler) TableQuery(context.Context, *connect.Request[proto.TableQueryRequest]) (*connect.Response[proto.TableQueryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.TableQuery is not implemented"))
+}
+
+func (UnimplementedPrivUtilServiceHandler) Merge3(context.Context, *connect.Request[proto.Merge3Request]) (*connect.Response[proto.Merge3Response], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.Merge3 is not implemented"))
+}
+
+func (UnimplementedPrivUtilServiceHandler) PatchApply(context.Context, *connect.Request[proto.PatchApplyRequest]) (*connect.Response[proto.PatchApplyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.PatchApply is not implemented"))
+}
+
+func (UnimplementedPrivUtilServiceHandler) RegexExplain(context.Context, *connect.Request[proto.RegexExplainRequest]) (*connect.Response[proto.RegexExplainResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.RegexExplain is not implemented"))
+}
+
+func (UnimplementedPrivUtilServiceHandler) RegexGenerate(context.Context, *connect.Request[proto.RegexGenerateRequest]) (*connect.Response[proto.RegexGenerateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("privutil.PrivUtilService.RegexGenerate is not implemented"))
+}
@@ -9,10 +9,8 @@ export function ColorTool() {
 
   const convert = async (val: string) => {
     setInput(val);
-    // Simple debounce or just call on change if light enough? Let's verify input length for hex
-    if (val.startsWith('#') && (val.length === 4 || val.length === 7)) {
-       doConvert(val);
-    } else if (val.startsWith('rgb')) {
+    // Incomplete input just comes back with an error, which is ignored.
+    if (val.trim()) {
        doConvert(val);
     }
   };
@@ -32,7 +30,7 @@ export function ColorTool() {
       </h2>
       
       <p className="text-gray-400">
-        Convert colors between common formats. Enter a HEX code (e.g. #3b82f6) or RGB value (e.g. rgb(59, 130, 246)) to see conversions and previews.
+        Convert colors between formats. Enter a HEX code (e.g. #3b82f6), a CSS color name, or any of rgb(), hsl(), hsv(), hwb(), cmyk(), lab(), lch(), oklab() and oklch() to see conversions and previews.
       </p>
 
       <div className="flex gap-4 items-center">
@@ -56,6 +54,13 @@ export function ColorTool() {
           <ColorRow label="HEX" value={res.hex} color={res.hex} />
           <ColorRow label="RGB" value={res.rgb} color={res.hex} />
           <ColorRow label="HSL" value={res.hsl} color={res.hex} />
+          <ColorRow label="HSV" value={res.hsv} color={res.hex} />
+          <ColorRow label="HWB" value={res.hwb} color={res.hex} />
+          <ColorRow label="CMYK" value={res.cmyk} color={res.hex} />
+          <ColorRow label="LAB" value={res.lab} color={res.hex} />
+          <ColorRow label="LCH" value={res.lch} color={res.hex} />
+          <ColorRow label="OKLAB" value={res.oklab} color={res.hex} />
+          <ColorRow label="OKLCH" value={res.oklch} color={res.hex} />
         </div>
       )}
     </div>
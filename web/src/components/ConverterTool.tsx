@@ -5,11 +5,24 @@ import { ArrowLeftRight, Copy, Check } from 'lucide-react';
 import { cn } from '../lib/utils';
 
 const FORMATS = [
-  { value: DataFormat.JSON,  label: 'JSON' },
-  { value: DataFormat.YAML,  label: 'YAML' },
-  { value: DataFormat.XML,   label: 'XML' },
-  { value: DataFormat.TOML,  label: 'TOML' },
-  { value: DataFormat.CSV,   label: 'CSV' },
+  { value: DataFormat.JSON,       label: 'JSON' },
+  { value: DataFormat.YAML,       label: 'YAML' },
+  { value: DataFormat.XML,        label: 'XML' },
+  { value: DataFormat.TOML,       label: 'TOML' },
+  { value: DataFormat.CSV,        label: 'CSV' },
+  { value: DataFormat.TSV,        label: 'TSV' },
+  { value: DataFormat.NDJSON,     label: 'NDJSON' },
+  { value: DataFormat.JSON5,      label: 'JSON5' },
+  { value: DataFormat.INI,        label: 'INI' },
+  { value: DataFormat.ENV,        label: '.env' },
+  { value: DataFormat.PROPERTIES, label: 'Properties' },
+  { value: DataFormat.HCL,        label: 'HCL' },
+  { value: DataFormat.MSGPACK,    label: 'MessagePack' },
+  { value: DataFormat.CBOR,       label: 'CBOR' },
+  { value: DataFormat.BSON,       label: 'BSON' },
+  { value: DataFormat.MARKDOWN_TABLE, label: 'Markdown table' },
+  { value: DataFormat.HTML_TABLE,     label: 'HTML table' },
+  { value: DataFormat.ASCII_TABLE,    label: 'ASCII table' },
 ] as const;
 
 const DELIMITERS = [
@@ -20,11 +33,22 @@ const DELIMITERS = [
 ] as const;
 
 const PLACEHOLDERS: Record<number, string> = {
-  [DataFormat.JSON]: '{\n  "name": "Alice",\n  "age": 30\n}',
-  [DataFormat.YAML]: 'name: Alice\nage: 30',
-  [DataFormat.XML]:  '<person>\n  <name>Alice</name>\n  <age>30</age>\n</person>',
-  [DataFormat.TOML]: 'name = "Alice"\nage = 30',
-  [DataFormat.CSV]:  'name,age\nAlice,30\nBob,25',
+  [DataFormat.JSON]:       '{\n  "name": "Alice",\n  "age": 30\n}',
+  [DataFormat.YAML]:       'name: Alice\nage: 30',
+  [DataFormat.XML]:        '<person>\n  <name>Alice</name>\n  <age>30</age>\n</person>',
+  [DataFormat.TOML]:       'name = "Alice"\nage = 30',
+  [DataFormat.CSV]:        'name,age\nAlice,30\nBob,25',
+  [DataFormat.TSV]:        'name\tage\nAlice\t30\nBob\t25',
+  [DataFormat.NDJSON]:     '{"name":"Alice","age":30}\n{"name":"Bob","age":25}',
+  [DataFormat.JSON5]:      '// comments allowed\n{\n  name: \'Alice\',\n  age: 30,\n}',
+  [DataFormat.INI]:        '[person]\nname = Alice\nage = 30',
+  [DataFormat.ENV]:        'NAME=Alice\nAGE=30',
+  [DataFormat.PROPERTIES]: 'person.name=Alice\nperson.age=30',
+  [DataFormat.HCL]:        'person {\n  name = "Alice"\n  age  = 30\n}',
+  [DataFormat.MSGPACK]:    'gqRuYW1lpUFsaWNlo2FnZR4=',
+  [DataFormat.CBOR]:       'omRuYW1lZUFsaWNlY2FnZRge',
+  [DataFormat.BSON]:       'HgAAAAJuYW1lAAYAAABBbGljZQAQYWdlAB4AAAAA',
+  [DataFormat.MARKDOWN_TABLE]: '| name  | age |\n| ----- | --: |\n| Alice |  30 |\n| Bob   |  25 |',
 };
 
 function formatLabel(fmt: DataFormat): string {